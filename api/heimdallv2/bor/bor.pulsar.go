@@ -801,6 +801,7 @@ var (
 	fd_Params_sprint_duration protoreflect.FieldDescriptor
 	fd_Params_span_duration   protoreflect.FieldDescriptor
 	fd_Params_producer_count  protoreflect.FieldDescriptor
+	fd_Params_span_retention  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -809,6 +810,7 @@ func init() {
 	fd_Params_sprint_duration = md_Params.Fields().ByName("sprint_duration")
 	fd_Params_span_duration = md_Params.Fields().ByName("span_duration")
 	fd_Params_producer_count = md_Params.Fields().ByName("producer_count")
+	fd_Params_span_retention = md_Params.Fields().ByName("span_retention")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -894,6 +896,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SpanRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpanRetention)
+		if !f(fd_Params_span_retention, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -915,6 +923,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SpanDuration != uint64(0)
 	case "heimdallv2.bor.Params.producer_count":
 		return x.ProducerCount != uint64(0)
+	case "heimdallv2.bor.Params.span_retention":
+		return x.SpanRetention != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -937,6 +947,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SpanDuration = uint64(0)
 	case "heimdallv2.bor.Params.producer_count":
 		x.ProducerCount = uint64(0)
+	case "heimdallv2.bor.Params.span_retention":
+		x.SpanRetention = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -962,6 +974,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "heimdallv2.bor.Params.producer_count":
 		value := x.ProducerCount
 		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.Params.span_retention":
+		value := x.SpanRetention
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -988,6 +1003,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SpanDuration = value.Uint()
 	case "heimdallv2.bor.Params.producer_count":
 		x.ProducerCount = value.Uint()
+	case "heimdallv2.bor.Params.span_retention":
+		x.SpanRetention = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -1014,6 +1031,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field span_duration of message heimdallv2.bor.Params is not mutable"))
 	case "heimdallv2.bor.Params.producer_count":
 		panic(fmt.Errorf("field producer_count of message heimdallv2.bor.Params is not mutable"))
+	case "heimdallv2.bor.Params.span_retention":
+		panic(fmt.Errorf("field span_retention of message heimdallv2.bor.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -1033,6 +1052,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "heimdallv2.bor.Params.producer_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.Params.span_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.Params"))
@@ -1111,6 +1132,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ProducerCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProducerCount))
 		}
+		if x.SpanRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpanRetention))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1140,6 +1164,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SpanRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpanRetention))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.ProducerCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProducerCount))
 			i--
@@ -1261,6 +1290,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpanRetention", wireType)
+				}
+				x.SpanRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpanRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2284,182 +2332,2192 @@ func (x *fastReflection_BlockRange) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: heimdallv2/bor/bor.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ValidatorPerformance                 protoreflect.MessageDescriptor
+	fd_ValidatorPerformance_span_id         protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_validator_id    protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_score           protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_blocks_assigned protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_blocks_produced protoreflect.FieldDescriptor
 )
 
-// Span represents a range of blocks on the Bor chain with an associated
-// validator set. Spans are used to organize block production into epochs, with
-// each span having a specific set of selected producers who take turns
-// producing blocks.
-type Span struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Unique identifier for this span. Spans are numbered sequentially starting
-	// from 0.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// First block number included in this span (inclusive).
-	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
-	// Last block number included in this span (inclusive).
-	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
-	// Complete validator set at the time this span was created.
-	// This represents all active validators, not just the selected producers.
-	ValidatorSet *stake.ValidatorSet `protobuf:"bytes,4,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
-	// Subset of validators selected to produce blocks during this span.
-	// The number of producers is determined by the producer_count parameter.
-	SelectedProducers []*stake.Validator `protobuf:"bytes,5,rep,name=selected_producers,json=selectedProducers,proto3" json:"selected_producers,omitempty"`
-	// Chain ID of the Bor chain this span applies to.
-	BorChainId string `protobuf:"bytes,6,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
-}
-
-func (x *Span) Reset() {
-	*x = Span{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_bor_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_heimdallv2_bor_bor_proto_init()
+	md_ValidatorPerformance = File_heimdallv2_bor_bor_proto.Messages().ByName("ValidatorPerformance")
+	fd_ValidatorPerformance_span_id = md_ValidatorPerformance.Fields().ByName("span_id")
+	fd_ValidatorPerformance_validator_id = md_ValidatorPerformance.Fields().ByName("validator_id")
+	fd_ValidatorPerformance_score = md_ValidatorPerformance.Fields().ByName("score")
+	fd_ValidatorPerformance_blocks_assigned = md_ValidatorPerformance.Fields().ByName("blocks_assigned")
+	fd_ValidatorPerformance_blocks_produced = md_ValidatorPerformance.Fields().ByName("blocks_produced")
 }
 
-func (x *Span) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_ValidatorPerformance)(nil)
 
-func (*Span) ProtoMessage() {}
+type fastReflection_ValidatorPerformance ValidatorPerformance
 
-// Deprecated: Use Span.ProtoReflect.Descriptor instead.
-func (*Span) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{0}
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(x)
 }
 
-func (x *Span) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *ValidatorPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_bor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Span) GetStartBlock() uint64 {
-	if x != nil {
-		return x.StartBlock
-	}
-	return 0
-}
+var _fastReflection_ValidatorPerformance_messageType fastReflection_ValidatorPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPerformance_messageType{}
 
-func (x *Span) GetEndBlock() uint64 {
-	if x != nil {
-		return x.EndBlock
-	}
-	return 0
-}
+type fastReflection_ValidatorPerformance_messageType struct{}
 
-func (x *Span) GetValidatorSet() *stake.ValidatorSet {
-	if x != nil {
-		return x.ValidatorSet
-	}
-	return nil
+func (x fastReflection_ValidatorPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(nil)
 }
-
-func (x *Span) GetSelectedProducers() []*stake.Validator {
-	if x != nil {
-		return x.SelectedProducers
-	}
-	return nil
+func (x fastReflection_ValidatorPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
 }
-
-func (x *Span) GetBorChainId() string {
-	if x != nil {
-		return x.BorChainId
-	}
-	return ""
+func (x fastReflection_ValidatorPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
 }
 
-// Params defines the parameters for the bor module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Duration of a sprint in blocks. A sprint is a period where a single
-	// producer creates all blocks before the next producer takes over.
-	SprintDuration uint64 `protobuf:"varint,1,opt,name=sprint_duration,json=sprintDuration,proto3" json:"sprint_duration,omitempty"`
-	// Duration of a span in blocks. A span typically contains multiple sprints.
-	SpanDuration uint64 `protobuf:"varint,2,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
-	// Number of producers to select from the validator set for each span.
-	// Producers are selected based on their voting power.
-	ProducerCount uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_bor_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPerformance_messageType
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPerformance) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPerformance) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPerformance)(x)
 }
 
-func (x *Params) GetSprintDuration() uint64 {
-	if x != nil {
-		return x.SprintDuration
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SpanId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpanId)
+		if !f(fd_ValidatorPerformance_span_id, value) {
+			return
+		}
+	}
+	if x.ValidatorId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorId)
+		if !f(fd_ValidatorPerformance_validator_id, value) {
+			return
+		}
+	}
+	if x.Score != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Score)
+		if !f(fd_ValidatorPerformance_score, value) {
+			return
+		}
+	}
+	if x.BlocksAssigned != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksAssigned)
+		if !f(fd_ValidatorPerformance_blocks_assigned, value) {
+			return
+		}
+	}
+	if x.BlocksProduced != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksProduced)
+		if !f(fd_ValidatorPerformance_blocks_produced, value) {
+			return
+		}
 	}
-	return 0
 }
 
-func (x *Params) GetSpanDuration() uint64 {
-	if x != nil {
-		return x.SpanDuration
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		return x.SpanId != uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		return x.ValidatorId != uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		return x.Score != uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		return x.BlocksAssigned != uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		return x.BlocksProduced != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *Params) GetProducerCount() uint64 {
-	if x != nil {
-		return x.ProducerCount
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		x.SpanId = uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		x.ValidatorId = uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		x.Score = uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		x.BlocksAssigned = uint64(0)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		x.BlocksProduced = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-// ProducerVotes contains the validator IDs that a validator has voted for
-// to be included in the next span's producer set.
-type ProducerVotes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// List of validator IDs that this validator votes for.
-	// Validators can vote for up to producer_count validators.
-	Votes []uint64 `protobuf:"varint,1,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		value := x.SpanId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		value := x.ValidatorId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		value := x.Score
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		value := x.BlocksAssigned
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		value := x.BlocksProduced
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func (x *ProducerVotes) Reset() {
-	*x = ProducerVotes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_bor_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		x.SpanId = value.Uint()
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		x.ValidatorId = value.Uint()
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		x.Score = value.Uint()
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		x.BlocksAssigned = value.Uint()
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		x.BlocksProduced = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		panic(fmt.Errorf("field span_id of message heimdallv2.bor.ValidatorPerformance is not mutable"))
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		panic(fmt.Errorf("field validator_id of message heimdallv2.bor.ValidatorPerformance is not mutable"))
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		panic(fmt.Errorf("field score of message heimdallv2.bor.ValidatorPerformance is not mutable"))
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		panic(fmt.Errorf("field blocks_assigned of message heimdallv2.bor.ValidatorPerformance is not mutable"))
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		panic(fmt.Errorf("field blocks_produced of message heimdallv2.bor.ValidatorPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ValidatorPerformance.span_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.ValidatorPerformance.validator_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.ValidatorPerformance.score":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.ValidatorPerformance.blocks_assigned":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.ValidatorPerformance.blocks_produced":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.ValidatorPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SpanId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpanId))
+		}
+		if x.ValidatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorId))
+		}
+		if x.Score != 0 {
+			n += 1 + runtime.Sov(uint64(x.Score))
+		}
+		if x.BlocksAssigned != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksAssigned))
+		}
+		if x.BlocksProduced != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksProduced))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlocksProduced != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksProduced))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BlocksAssigned != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksAssigned))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Score != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Score))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ValidatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SpanId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpanId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+				}
+				x.SpanId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpanId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+				}
+				x.ValidatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				x.Score = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Score |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksAssigned", wireType)
+				}
+				x.BlocksAssigned = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksAssigned |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksProduced", wireType)
+				}
+				x.BlocksProduced = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksProduced |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SpanSummary_4_list)(nil)
+
+type _SpanSummary_4_list struct {
+	list *[]uint64
+}
+
+func (x *_SpanSummary_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpanSummary_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_SpanSummary_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpanSummary_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpanSummary_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SpanSummary at list field ProducerIds as it is not of Message kind"))
+}
+
+func (x *_SpanSummary_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpanSummary_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_SpanSummary_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SpanSummary_5_list)(nil)
+
+type _SpanSummary_5_list struct {
+	list *[]string
+}
+
+func (x *_SpanSummary_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpanSummary_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SpanSummary_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpanSummary_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpanSummary_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SpanSummary at list field ProducerSigners as it is not of Message kind"))
+}
+
+func (x *_SpanSummary_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpanSummary_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SpanSummary_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SpanSummary                  protoreflect.MessageDescriptor
+	fd_SpanSummary_id               protoreflect.FieldDescriptor
+	fd_SpanSummary_start_block      protoreflect.FieldDescriptor
+	fd_SpanSummary_end_block        protoreflect.FieldDescriptor
+	fd_SpanSummary_producer_ids     protoreflect.FieldDescriptor
+	fd_SpanSummary_producer_signers protoreflect.FieldDescriptor
+	fd_SpanSummary_seed             protoreflect.FieldDescriptor
+	fd_SpanSummary_bor_chain_id     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_bor_proto_init()
+	md_SpanSummary = File_heimdallv2_bor_bor_proto.Messages().ByName("SpanSummary")
+	fd_SpanSummary_id = md_SpanSummary.Fields().ByName("id")
+	fd_SpanSummary_start_block = md_SpanSummary.Fields().ByName("start_block")
+	fd_SpanSummary_end_block = md_SpanSummary.Fields().ByName("end_block")
+	fd_SpanSummary_producer_ids = md_SpanSummary.Fields().ByName("producer_ids")
+	fd_SpanSummary_producer_signers = md_SpanSummary.Fields().ByName("producer_signers")
+	fd_SpanSummary_seed = md_SpanSummary.Fields().ByName("seed")
+	fd_SpanSummary_bor_chain_id = md_SpanSummary.Fields().ByName("bor_chain_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpanSummary)(nil)
+
+type fastReflection_SpanSummary SpanSummary
+
+func (x *SpanSummary) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpanSummary)(x)
+}
+
+func (x *SpanSummary) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_bor_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpanSummary_messageType fastReflection_SpanSummary_messageType
+var _ protoreflect.MessageType = fastReflection_SpanSummary_messageType{}
+
+type fastReflection_SpanSummary_messageType struct{}
+
+func (x fastReflection_SpanSummary_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpanSummary)(nil)
+}
+func (x fastReflection_SpanSummary_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpanSummary)
+}
+func (x fastReflection_SpanSummary_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpanSummary
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpanSummary) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpanSummary
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpanSummary) Type() protoreflect.MessageType {
+	return _fastReflection_SpanSummary_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpanSummary) New() protoreflect.Message {
+	return new(fastReflection_SpanSummary)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpanSummary) Interface() protoreflect.ProtoMessage {
+	return (*SpanSummary)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpanSummary) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SpanSummary_id, value) {
+			return
+		}
+	}
+	if x.StartBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartBlock)
+		if !f(fd_SpanSummary_start_block, value) {
+			return
+		}
+	}
+	if x.EndBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndBlock)
+		if !f(fd_SpanSummary_end_block, value) {
+			return
+		}
+	}
+	if len(x.ProducerIds) != 0 {
+		value := protoreflect.ValueOfList(&_SpanSummary_4_list{list: &x.ProducerIds})
+		if !f(fd_SpanSummary_producer_ids, value) {
+			return
+		}
+	}
+	if len(x.ProducerSigners) != 0 {
+		value := protoreflect.ValueOfList(&_SpanSummary_5_list{list: &x.ProducerSigners})
+		if !f(fd_SpanSummary_producer_signers, value) {
+			return
+		}
+	}
+	if len(x.Seed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seed)
+		if !f(fd_SpanSummary_seed, value) {
+			return
+		}
+	}
+	if x.BorChainId != "" {
+		value := protoreflect.ValueOfString(x.BorChainId)
+		if !f(fd_SpanSummary_bor_chain_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpanSummary) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSummary.id":
+		return x.Id != uint64(0)
+	case "heimdallv2.bor.SpanSummary.start_block":
+		return x.StartBlock != uint64(0)
+	case "heimdallv2.bor.SpanSummary.end_block":
+		return x.EndBlock != uint64(0)
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		return len(x.ProducerIds) != 0
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		return len(x.ProducerSigners) != 0
+	case "heimdallv2.bor.SpanSummary.seed":
+		return len(x.Seed) != 0
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		return x.BorChainId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSummary) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSummary.id":
+		x.Id = uint64(0)
+	case "heimdallv2.bor.SpanSummary.start_block":
+		x.StartBlock = uint64(0)
+	case "heimdallv2.bor.SpanSummary.end_block":
+		x.EndBlock = uint64(0)
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		x.ProducerIds = nil
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		x.ProducerSigners = nil
+	case "heimdallv2.bor.SpanSummary.seed":
+		x.Seed = nil
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		x.BorChainId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpanSummary) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.SpanSummary.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SpanSummary.start_block":
+		value := x.StartBlock
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SpanSummary.end_block":
+		value := x.EndBlock
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		if len(x.ProducerIds) == 0 {
+			return protoreflect.ValueOfList(&_SpanSummary_4_list{})
+		}
+		listValue := &_SpanSummary_4_list{list: &x.ProducerIds}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		if len(x.ProducerSigners) == 0 {
+			return protoreflect.ValueOfList(&_SpanSummary_5_list{})
+		}
+		listValue := &_SpanSummary_5_list{list: &x.ProducerSigners}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.bor.SpanSummary.seed":
+		value := x.Seed
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		value := x.BorChainId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSummary) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSummary.id":
+		x.Id = value.Uint()
+	case "heimdallv2.bor.SpanSummary.start_block":
+		x.StartBlock = value.Uint()
+	case "heimdallv2.bor.SpanSummary.end_block":
+		x.EndBlock = value.Uint()
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		lv := value.List()
+		clv := lv.(*_SpanSummary_4_list)
+		x.ProducerIds = *clv.list
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		lv := value.List()
+		clv := lv.(*_SpanSummary_5_list)
+		x.ProducerSigners = *clv.list
+	case "heimdallv2.bor.SpanSummary.seed":
+		x.Seed = value.Bytes()
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		x.BorChainId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSummary) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		if x.ProducerIds == nil {
+			x.ProducerIds = []uint64{}
+		}
+		value := &_SpanSummary_4_list{list: &x.ProducerIds}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		if x.ProducerSigners == nil {
+			x.ProducerSigners = []string{}
+		}
+		value := &_SpanSummary_5_list{list: &x.ProducerSigners}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.SpanSummary.id":
+		panic(fmt.Errorf("field id of message heimdallv2.bor.SpanSummary is not mutable"))
+	case "heimdallv2.bor.SpanSummary.start_block":
+		panic(fmt.Errorf("field start_block of message heimdallv2.bor.SpanSummary is not mutable"))
+	case "heimdallv2.bor.SpanSummary.end_block":
+		panic(fmt.Errorf("field end_block of message heimdallv2.bor.SpanSummary is not mutable"))
+	case "heimdallv2.bor.SpanSummary.seed":
+		panic(fmt.Errorf("field seed of message heimdallv2.bor.SpanSummary is not mutable"))
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		panic(fmt.Errorf("field bor_chain_id of message heimdallv2.bor.SpanSummary is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpanSummary) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSummary.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SpanSummary.start_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SpanSummary.end_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SpanSummary.producer_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_SpanSummary_4_list{list: &list})
+	case "heimdallv2.bor.SpanSummary.producer_signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SpanSummary_5_list{list: &list})
+	case "heimdallv2.bor.SpanSummary.seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.bor.SpanSummary.bor_chain_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSummary"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpanSummary) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.SpanSummary", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpanSummary) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSummary) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpanSummary) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpanSummary) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpanSummary)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.StartBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartBlock))
+		}
+		if x.EndBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndBlock))
+		}
+		if len(x.ProducerIds) > 0 {
+			l = 0
+			for _, e := range x.ProducerIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.ProducerSigners) > 0 {
+			for _, s := range x.ProducerSigners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Seed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpanSummary)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorChainId) > 0 {
+			i -= len(x.BorChainId)
+			copy(dAtA[i:], x.BorChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorChainId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Seed) > 0 {
+			i -= len(x.Seed)
+			copy(dAtA[i:], x.Seed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ProducerSigners) > 0 {
+			for iNdEx := len(x.ProducerSigners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ProducerSigners[iNdEx])
+				copy(dAtA[i:], x.ProducerSigners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProducerSigners[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ProducerIds) > 0 {
+			var pksize2 int
+			for _, num := range x.ProducerIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ProducerIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartBlock))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpanSummary)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpanSummary: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpanSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				}
+				x.StartBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				}
+				x.EndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ProducerIds = append(x.ProducerIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ProducerIds) == 0 {
+						x.ProducerIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ProducerIds = append(x.ProducerIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerIds", wireType)
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerSigners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProducerSigners = append(x.ProducerSigners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seed = append(x.Seed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seed == nil {
+					x.Seed = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SpanSeed         protoreflect.MessageDescriptor
+	fd_SpanSeed_span_id protoreflect.FieldDescriptor
+	fd_SpanSeed_seed    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_bor_proto_init()
+	md_SpanSeed = File_heimdallv2_bor_bor_proto.Messages().ByName("SpanSeed")
+	fd_SpanSeed_span_id = md_SpanSeed.Fields().ByName("span_id")
+	fd_SpanSeed_seed = md_SpanSeed.Fields().ByName("seed")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpanSeed)(nil)
+
+type fastReflection_SpanSeed SpanSeed
+
+func (x *SpanSeed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpanSeed)(x)
+}
+
+func (x *SpanSeed) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_bor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpanSeed_messageType fastReflection_SpanSeed_messageType
+var _ protoreflect.MessageType = fastReflection_SpanSeed_messageType{}
+
+type fastReflection_SpanSeed_messageType struct{}
+
+func (x fastReflection_SpanSeed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpanSeed)(nil)
+}
+func (x fastReflection_SpanSeed_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpanSeed)
+}
+func (x fastReflection_SpanSeed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpanSeed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpanSeed) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpanSeed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpanSeed) Type() protoreflect.MessageType {
+	return _fastReflection_SpanSeed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpanSeed) New() protoreflect.Message {
+	return new(fastReflection_SpanSeed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpanSeed) Interface() protoreflect.ProtoMessage {
+	return (*SpanSeed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpanSeed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SpanId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpanId)
+		if !f(fd_SpanSeed_span_id, value) {
+			return
+		}
+	}
+	if len(x.Seed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seed)
+		if !f(fd_SpanSeed_seed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpanSeed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		return x.SpanId != uint64(0)
+	case "heimdallv2.bor.SpanSeed.seed":
+		return len(x.Seed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSeed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		x.SpanId = uint64(0)
+	case "heimdallv2.bor.SpanSeed.seed":
+		x.Seed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpanSeed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		value := x.SpanId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SpanSeed.seed":
+		value := x.Seed
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSeed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		x.SpanId = value.Uint()
+	case "heimdallv2.bor.SpanSeed.seed":
+		x.Seed = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSeed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		panic(fmt.Errorf("field span_id of message heimdallv2.bor.SpanSeed is not mutable"))
+	case "heimdallv2.bor.SpanSeed.seed":
+		panic(fmt.Errorf("field seed of message heimdallv2.bor.SpanSeed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpanSeed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SpanSeed.span_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SpanSeed.seed":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SpanSeed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SpanSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpanSeed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.SpanSeed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpanSeed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpanSeed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpanSeed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpanSeed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpanSeed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SpanId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpanId))
+		}
+		l = len(x.Seed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpanSeed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Seed) > 0 {
+			i -= len(x.Seed)
+			copy(dAtA[i:], x.Seed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SpanId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpanId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpanSeed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpanSeed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpanSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+				}
+				x.SpanId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpanId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seed = append(x.Seed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seed == nil {
+					x.Seed = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: heimdallv2/bor/bor.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Span represents a range of blocks on the Bor chain with an associated
+// validator set. Spans are used to organize block production into epochs, with
+// each span having a specific set of selected producers who take turns
+// producing blocks.
+type Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier for this span. Spans are numbered sequentially starting
+	// from 0.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// First block number included in this span (inclusive).
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// Last block number included in this span (inclusive).
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// Complete validator set at the time this span was created.
+	// This represents all active validators, not just the selected producers.
+	ValidatorSet *stake.ValidatorSet `protobuf:"bytes,4,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// Subset of validators selected to produce blocks during this span.
+	// The number of producers is determined by the producer_count parameter.
+	SelectedProducers []*stake.Validator `protobuf:"bytes,5,rep,name=selected_producers,json=selectedProducers,proto3" json:"selected_producers,omitempty"`
+	// Chain ID of the Bor chain this span applies to.
+	BorChainId string `protobuf:"bytes,6,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Span) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Span) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *Span) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *Span) GetValidatorSet() *stake.ValidatorSet {
+	if x != nil {
+		return x.ValidatorSet
+	}
+	return nil
+}
+
+func (x *Span) GetSelectedProducers() []*stake.Validator {
+	if x != nil {
+		return x.SelectedProducers
+	}
+	return nil
+}
+
+func (x *Span) GetBorChainId() string {
+	if x != nil {
+		return x.BorChainId
+	}
+	return ""
+}
+
+// Params defines the parameters for the bor module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Duration of a sprint in blocks. A sprint is a period where a single
+	// producer creates all blocks before the next producer takes over.
+	SprintDuration uint64 `protobuf:"varint,1,opt,name=sprint_duration,json=sprintDuration,proto3" json:"sprint_duration,omitempty"`
+	// Duration of a span in blocks. A span typically contains multiple sprints.
+	SpanDuration uint64 `protobuf:"varint,2,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
+	// Number of producers to select from the validator set for each span.
+	// Producers are selected based on their voting power.
+	ProducerCount uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
+	// Number of the most recent spans kept with their full body. The older
+	// spans are pruned into span summaries. Zero disables the pruning.
+	SpanRetention uint64 `protobuf:"varint,4,opt,name=span_retention,json=spanRetention,proto3" json:"span_retention,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetSprintDuration() uint64 {
+	if x != nil {
+		return x.SprintDuration
+	}
+	return 0
+}
+
+func (x *Params) GetSpanDuration() uint64 {
+	if x != nil {
+		return x.SpanDuration
+	}
+	return 0
+}
+
+func (x *Params) GetProducerCount() uint64 {
+	if x != nil {
+		return x.ProducerCount
+	}
+	return 0
+}
+
+func (x *Params) GetSpanRetention() uint64 {
+	if x != nil {
+		return x.SpanRetention
+	}
+	return 0
+}
+
+// ProducerVotes contains the validator IDs that a validator has voted for
+// to be included in the next span's producer set.
+type ProducerVotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of validator IDs that this validator votes for.
+	// Validators can vote for up to producer_count validators.
+	Votes []uint64 `protobuf:"varint,1,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *ProducerVotes) Reset() {
+	*x = ProducerVotes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -2528,6 +4586,224 @@ func (x *BlockRange) GetEndBlock() uint64 {
 	return 0
 }
 
+// ValidatorPerformance is the performance of a validator during a span,
+// recorded from the milestones finalizing the blocks of the span.
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the span.
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	// ID of the validator.
+	ValidatorId uint64 `protobuf:"varint,2,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Number of blocks of the span finalized by the milestones the validator
+	// supported, the span counterpart of the validator performance score.
+	Score uint64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Number of blocks of the span assigned to the validator as producer,
+	// excluding the blocks taken over by a later span.
+	BlocksAssigned uint64 `protobuf:"varint,4,opt,name=blocks_assigned,json=blocksAssigned,proto3" json:"blocks_assigned,omitempty"`
+	// Number of blocks of the span produced by the validator and finalized by
+	// a milestone.
+	BlocksProduced uint64 `protobuf:"varint,5,opt,name=blocks_produced,json=blocksProduced,proto3" json:"blocks_produced,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidatorPerformance) GetSpanId() uint64 {
+	if x != nil {
+		return x.SpanId
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetBlocksAssigned() uint64 {
+	if x != nil {
+		return x.BlocksAssigned
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetBlocksProduced() uint64 {
+	if x != nil {
+		return x.BlocksProduced
+	}
+	return 0
+}
+
+// SpanSummary is the compact record of a span whose full body was pruned,
+// keeping what the lookups of the producers of its blocks need.
+type SpanSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the span.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// First block number included in the span (inclusive).
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// Last block number included in the span (inclusive).
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// IDs of the selected producers of the span, in the span order.
+	ProducerIds []uint64 `protobuf:"varint,4,rep,packed,name=producer_ids,json=producerIds,proto3" json:"producer_ids,omitempty"`
+	// Signers of the selected producers at the time of the span, in the same
+	// order, as the validators can rotate their signers later.
+	ProducerSigners []string `protobuf:"bytes,5,rep,name=producer_signers,json=producerSigners,proto3" json:"producer_signers,omitempty"`
+	// Seed of the producer selection, empty for the spans not selected from a
+	// seed.
+	Seed []byte `protobuf:"bytes,6,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Chain ID of the Bor chain the span applies to.
+	BorChainId string `protobuf:"bytes,7,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
+}
+
+func (x *SpanSummary) Reset() {
+	*x = SpanSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpanSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpanSummary) ProtoMessage() {}
+
+// Deprecated: Use SpanSummary.ProtoReflect.Descriptor instead.
+func (*SpanSummary) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SpanSummary) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpanSummary) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *SpanSummary) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *SpanSummary) GetProducerIds() []uint64 {
+	if x != nil {
+		return x.ProducerIds
+	}
+	return nil
+}
+
+func (x *SpanSummary) GetProducerSigners() []string {
+	if x != nil {
+		return x.ProducerSigners
+	}
+	return nil
+}
+
+func (x *SpanSummary) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *SpanSummary) GetBorChainId() string {
+	if x != nil {
+		return x.BorChainId
+	}
+	return ""
+}
+
+// SpanSeed is the seed of the producer selection of a span, kept until the
+// span is pruned into its summary.
+type SpanSeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the span.
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	// Seed of the producer selection.
+	Seed []byte `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *SpanSeed) Reset() {
+	*x = SpanSeed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_bor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpanSeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpanSeed) ProtoMessage() {}
+
+// Deprecated: Use SpanSeed.ProtoReflect.Descriptor instead.
+func (*SpanSeed) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_bor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SpanSeed) GetSpanId() uint64 {
+	if x != nil {
+		return x.SpanId
+	}
+	return 0
+}
+
+func (x *SpanSeed) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
 var File_heimdallv2_bor_bor_proto protoreflect.FileDescriptor
 
 var file_heimdallv2_bor_bor_proto_rawDesc = []byte{
@@ -2558,7 +4834,7 @@ var file_heimdallv2_bor_bor_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x5f,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
 	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x22, 0x98, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0f,
+	0x64, 0x22, 0xc6, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0f,
 	0x73, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x73, 0x70,
 	0x72, 0x69, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x0d,
@@ -2567,27 +4843,66 @@ var file_heimdallv2_bor_bor_proto_rawDesc = []byte{
 	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64,
 	0x75, 0x63, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x2c, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a,
-	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x0a, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x22, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0xac, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69,
-	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x42, 0x08, 0x42, 0x6f, 0x72,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65,
-	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65,
-	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x62, 0x6f, 0x72, 0xa2, 0x02, 0x03, 0x48,
-	0x42, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
-	0x42, 0x6f, 0x72, 0xca, 0x02, 0x0e, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32,
-	0x5c, 0x42, 0x6f, 0x72, 0xe2, 0x02, 0x1a, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
-	0x32, 0x5c, 0x42, 0x6f, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a,
-	0x42, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x73, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x2c, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22,
+	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x73,
+	0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0c, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x2e, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x15, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x22, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x30, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x19, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0c,
+	0x62, 0x6f, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x42, 0xac, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x62, 0x6f, 0x72, 0x42, 0x08, 0x42, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f,
+	0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76,
+	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32,
+	0x2f, 0x62, 0x6f, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x72, 0xca, 0x02, 0x0e, 0x48, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0xe2, 0x02, 0x1a, 0x48,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x42, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2602,18 +4917,21 @@ func file_heimdallv2_bor_bor_proto_rawDescGZIP() []byte {
 	return file_heimdallv2_bor_bor_proto_rawDescData
 }
 
-var file_heimdallv2_bor_bor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_heimdallv2_bor_bor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_heimdallv2_bor_bor_proto_goTypes = []interface{}{
-	(*Span)(nil),               // 0: heimdallv2.bor.Span
-	(*Params)(nil),             // 1: heimdallv2.bor.Params
-	(*ProducerVotes)(nil),      // 2: heimdallv2.bor.ProducerVotes
-	(*BlockRange)(nil),         // 3: heimdallv2.bor.BlockRange
-	(*stake.ValidatorSet)(nil), // 4: heimdallv2.stake.ValidatorSet
-	(*stake.Validator)(nil),    // 5: heimdallv2.stake.Validator
+	(*Span)(nil),                 // 0: heimdallv2.bor.Span
+	(*Params)(nil),               // 1: heimdallv2.bor.Params
+	(*ProducerVotes)(nil),        // 2: heimdallv2.bor.ProducerVotes
+	(*BlockRange)(nil),           // 3: heimdallv2.bor.BlockRange
+	(*ValidatorPerformance)(nil), // 4: heimdallv2.bor.ValidatorPerformance
+	(*SpanSummary)(nil),          // 5: heimdallv2.bor.SpanSummary
+	(*SpanSeed)(nil),             // 6: heimdallv2.bor.SpanSeed
+	(*stake.ValidatorSet)(nil),   // 7: heimdallv2.stake.ValidatorSet
+	(*stake.Validator)(nil),      // 8: heimdallv2.stake.Validator
 }
 var file_heimdallv2_bor_bor_proto_depIdxs = []int32{
-	4, // 0: heimdallv2.bor.Span.validator_set:type_name -> heimdallv2.stake.ValidatorSet
-	5, // 1: heimdallv2.bor.Span.selected_producers:type_name -> heimdallv2.stake.Validator
+	7, // 0: heimdallv2.bor.Span.validator_set:type_name -> heimdallv2.stake.ValidatorSet
+	8, // 1: heimdallv2.bor.Span.selected_producers:type_name -> heimdallv2.stake.Validator
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -2675,6 +4993,42 @@ func file_heimdallv2_bor_bor_proto_init() {
 				return nil
 			}
 		}
+		file_heimdallv2_bor_bor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_bor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpanSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_bor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpanSeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2682,7 +5036,7 @@ func file_heimdallv2_bor_bor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_heimdallv2_bor_bor_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -8106,23 +8106,25 @@ func (x *fastReflection_QueryProducerPlannedDowntimeResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryValidatorPerformanceScoreRequest protoreflect.MessageDescriptor
+	md_QueryProducerPlannedDowntimesRequest             protoreflect.MessageDescriptor
+	fd_QueryProducerPlannedDowntimesRequest_producer_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_heimdallv2_bor_query_proto_init()
-	md_QueryValidatorPerformanceScoreRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceScoreRequest")
+	md_QueryProducerPlannedDowntimesRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QueryProducerPlannedDowntimesRequest")
+	fd_QueryProducerPlannedDowntimesRequest_producer_id = md_QueryProducerPlannedDowntimesRequest.Fields().ByName("producer_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceScoreRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProducerPlannedDowntimesRequest)(nil)
 
-type fastReflection_QueryValidatorPerformanceScoreRequest QueryValidatorPerformanceScoreRequest
+type fastReflection_QueryProducerPlannedDowntimesRequest QueryProducerPlannedDowntimesRequest
 
-func (x *QueryValidatorPerformanceScoreRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPerformanceScoreRequest)(x)
+func (x *QueryProducerPlannedDowntimesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProducerPlannedDowntimesRequest)(x)
 }
 
-func (x *QueryValidatorPerformanceScoreRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProducerPlannedDowntimesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_heimdallv2_bor_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8134,43 +8136,43 @@ func (x *QueryValidatorPerformanceScoreRequest) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorPerformanceScoreRequest_messageType fastReflection_QueryValidatorPerformanceScoreRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceScoreRequest_messageType{}
+var _fastReflection_QueryProducerPlannedDowntimesRequest_messageType fastReflection_QueryProducerPlannedDowntimesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProducerPlannedDowntimesRequest_messageType{}
 
-type fastReflection_QueryValidatorPerformanceScoreRequest_messageType struct{}
+type fastReflection_QueryProducerPlannedDowntimesRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPerformanceScoreRequest)(nil)
+func (x fastReflection_QueryProducerPlannedDowntimesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProducerPlannedDowntimesRequest)(nil)
 }
-func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPerformanceScoreRequest)
+func (x fastReflection_QueryProducerPlannedDowntimesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProducerPlannedDowntimesRequest)
 }
-func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPerformanceScoreRequest
+func (x fastReflection_QueryProducerPlannedDowntimesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProducerPlannedDowntimesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPerformanceScoreRequest
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProducerPlannedDowntimesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorPerformanceScoreRequest_messageType
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProducerPlannedDowntimesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPerformanceScoreRequest)
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProducerPlannedDowntimesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorPerformanceScoreRequest)(x)
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProducerPlannedDowntimesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8178,7 +8180,13 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProducerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProducerId)
+		if !f(fd_QueryProducerPlannedDowntimesRequest_producer_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8192,13 +8200,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		return x.ProducerId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8208,13 +8218,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		x.ProducerId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8224,13 +8236,16 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		value := x.ProducerId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8244,13 +8259,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		x.ProducerId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8264,36 +8281,40 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		panic(fmt.Errorf("field producer_id of message heimdallv2.bor.QueryProducerPlannedDowntimesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesRequest.producer_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesRequest"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceScoreRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryProducerPlannedDowntimesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8301,7 +8322,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8312,7 +8333,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8324,7 +8345,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) IsValid() bool {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8334,9 +8355,9 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProducerPlannedDowntimesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8348,6 +8369,9 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
+		if x.ProducerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProducerId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8358,7 +8382,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8377,6 +8401,11 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ProducerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProducerId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8388,7 +8417,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8420,12 +8449,31 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProducerPlannedDowntimesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProducerPlannedDowntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+				}
+				x.ProducerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProducerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8461,108 +8509,77 @@ func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *p
 	}
 }
 
-var _ protoreflect.Map = (*_QueryValidatorPerformanceScoreResponse_1_map)(nil)
+var _ protoreflect.List = (*_QueryProducerPlannedDowntimesResponse_1_list)(nil)
 
-type _QueryValidatorPerformanceScoreResponse_1_map struct {
-	m *map[uint64]uint64
+type _QueryProducerPlannedDowntimesResponse_1_list struct {
+	list *[]*BlockRange
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Len() int {
-	if x.m == nil {
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) Len() int {
+	if x.list == nil {
 		return 0
 	}
-	return len(*x.m)
+	return len(*x.list)
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
-		mapValue := protoreflect.ValueOfUint64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Uint()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockRange)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockRange)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfUint64(v)
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BlockRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) NewValue() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BlockRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryValidatorPerformanceScoreResponse_1_map) IsValid() bool {
-	return x.m != nil
+func (x *_QueryProducerPlannedDowntimesResponse_1_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	md_QueryValidatorPerformanceScoreResponse                             protoreflect.MessageDescriptor
-	fd_QueryValidatorPerformanceScoreResponse_validator_performance_score protoreflect.FieldDescriptor
+	md_QueryProducerPlannedDowntimesResponse                 protoreflect.MessageDescriptor
+	fd_QueryProducerPlannedDowntimesResponse_downtime_ranges protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_heimdallv2_bor_query_proto_init()
-	md_QueryValidatorPerformanceScoreResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceScoreResponse")
-	fd_QueryValidatorPerformanceScoreResponse_validator_performance_score = md_QueryValidatorPerformanceScoreResponse.Fields().ByName("validator_performance_score")
+	md_QueryProducerPlannedDowntimesResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QueryProducerPlannedDowntimesResponse")
+	fd_QueryProducerPlannedDowntimesResponse_downtime_ranges = md_QueryProducerPlannedDowntimesResponse.Fields().ByName("downtime_ranges")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceScoreResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProducerPlannedDowntimesResponse)(nil)
 
-type fastReflection_QueryValidatorPerformanceScoreResponse QueryValidatorPerformanceScoreResponse
+type fastReflection_QueryProducerPlannedDowntimesResponse QueryProducerPlannedDowntimesResponse
 
-func (x *QueryValidatorPerformanceScoreResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPerformanceScoreResponse)(x)
+func (x *QueryProducerPlannedDowntimesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProducerPlannedDowntimesResponse)(x)
 }
 
-func (x *QueryValidatorPerformanceScoreResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProducerPlannedDowntimesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_heimdallv2_bor_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8574,43 +8591,43 @@ func (x *QueryValidatorPerformanceScoreResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorPerformanceScoreResponse_messageType fastReflection_QueryValidatorPerformanceScoreResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceScoreResponse_messageType{}
+var _fastReflection_QueryProducerPlannedDowntimesResponse_messageType fastReflection_QueryProducerPlannedDowntimesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProducerPlannedDowntimesResponse_messageType{}
 
-type fastReflection_QueryValidatorPerformanceScoreResponse_messageType struct{}
+type fastReflection_QueryProducerPlannedDowntimesResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPerformanceScoreResponse)(nil)
+func (x fastReflection_QueryProducerPlannedDowntimesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProducerPlannedDowntimesResponse)(nil)
 }
-func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPerformanceScoreResponse)
+func (x fastReflection_QueryProducerPlannedDowntimesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProducerPlannedDowntimesResponse)
 }
-func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPerformanceScoreResponse
+func (x fastReflection_QueryProducerPlannedDowntimesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProducerPlannedDowntimesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPerformanceScoreResponse
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProducerPlannedDowntimesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorPerformanceScoreResponse_messageType
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProducerPlannedDowntimesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPerformanceScoreResponse)
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProducerPlannedDowntimesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorPerformanceScoreResponse)(x)
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProducerPlannedDowntimesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8618,10 +8635,10 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorPerformanceScore) != 0 {
-		value := protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore})
-		if !f(fd_QueryValidatorPerformanceScoreResponse_validator_performance_score, value) {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DowntimeRanges) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProducerPlannedDowntimesResponse_1_list{list: &x.DowntimeRanges})
+		if !f(fd_QueryProducerPlannedDowntimesResponse_downtime_ranges, value) {
 			return
 		}
 	}
@@ -8638,15 +8655,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		return len(x.ValidatorPerformanceScore) != 0
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		return len(x.DowntimeRanges) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8656,15 +8673,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		x.ValidatorPerformanceScore = nil
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		x.DowntimeRanges = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8674,19 +8691,19 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		if len(x.ValidatorPerformanceScore) == 0 {
-			return protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{})
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		if len(x.DowntimeRanges) == 0 {
+			return protoreflect.ValueOfList(&_QueryProducerPlannedDowntimesResponse_1_list{})
 		}
-		mapValue := &_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore}
-		return protoreflect.ValueOfMap(mapValue)
+		listValue := &_QueryProducerPlannedDowntimesResponse_1_list{list: &x.DowntimeRanges}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8700,17 +8717,17 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		mv := value.Map()
-		cmv := mv.(*_QueryValidatorPerformanceScoreResponse_1_map)
-		x.ValidatorPerformanceScore = *cmv.m
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		lv := value.List()
+		clv := lv.(*_QueryProducerPlannedDowntimesResponse_1_list)
+		x.DowntimeRanges = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8724,45 +8741,45 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		if x.ValidatorPerformanceScore == nil {
-			x.ValidatorPerformanceScore = make(map[uint64]uint64)
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		if x.DowntimeRanges == nil {
+			x.DowntimeRanges = []*BlockRange{}
 		}
-		value := &_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore}
-		return protoreflect.ValueOfMap(value)
+		value := &_QueryProducerPlannedDowntimesResponse_1_list{list: &x.DowntimeRanges}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
-		m := make(map[uint64]uint64)
-		return protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{m: &m})
+	case "heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges":
+		list := []*BlockRange{}
+		return protoreflect.ValueOfList(&_QueryProducerPlannedDowntimesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryProducerPlannedDowntimesResponse"))
 		}
-		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message heimdallv2.bor.QueryProducerPlannedDowntimesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceScoreResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryProducerPlannedDowntimesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8770,7 +8787,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8781,7 +8798,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8793,7 +8810,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) IsValid() bool {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8803,9 +8820,9 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProducerPlannedDowntimesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8817,27 +8834,10 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorPerformanceScore) > 0 {
-			SiZeMaP := func(k uint64, v uint64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.ValidatorPerformanceScore))
-				for k := range x.ValidatorPerformanceScore {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.ValidatorPerformanceScore[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.ValidatorPerformanceScore {
-					SiZeMaP(k, v)
-				}
+		if len(x.DowntimeRanges) > 0 {
+			for _, e := range x.DowntimeRanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
 		if x.unknownFields != nil {
@@ -8850,7 +8850,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8869,43 +8869,20 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorPerformanceScore) > 0 {
-			MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+		if len(x.DowntimeRanges) > 0 {
+			for iNdEx := len(x.DowntimeRanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DowntimeRanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0xa
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForValidatorPerformanceScore := make([]uint64, 0, len(x.ValidatorPerformanceScore))
-				for k := range x.ValidatorPerformanceScore {
-					keysForValidatorPerformanceScore = append(keysForValidatorPerformanceScore, uint64(k))
-				}
-				sort.Slice(keysForValidatorPerformanceScore, func(i, j int) bool {
-					return keysForValidatorPerformanceScore[i] < keysForValidatorPerformanceScore[j]
-				})
-				for iNdEx := len(keysForValidatorPerformanceScore) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.ValidatorPerformanceScore[uint64(keysForValidatorPerformanceScore[iNdEx])]
-					out, err := MaRsHaLmAp(keysForValidatorPerformanceScore[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.ValidatorPerformanceScore {
-					v := x.ValidatorPerformanceScore[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
 			}
 		}
 		if input.Buf != nil {
@@ -8919,7 +8896,7 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		x := input.Message.Interface().(*QueryProducerPlannedDowntimesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8951,15 +8928,15 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProducerPlannedDowntimesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProducerPlannedDowntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformanceScore", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeRanges", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8986,75 +8963,10 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ValidatorPerformanceScore == nil {
-					x.ValidatorPerformanceScore = make(map[uint64]uint64)
-				}
-				var mapkey uint64
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+				x.DowntimeRanges = append(x.DowntimeRanges, &BlockRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeRanges[len(x.DowntimeRanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.ValidatorPerformanceScore[mapkey] = mapvalue
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9091,760 +9003,7145 @@ func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: heimdallv2/bor/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryValidatorPerformanceScoreRequest protoreflect.MessageDescriptor
 )
 
-// QuerySpanByIdRequest is the request type for the GetSpanById query.
-type QuerySpanByIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QueryValidatorPerformanceScoreRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceScoreRequest")
+}
 
-	// ID of the span to retrieve.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceScoreRequest)(nil)
+
+type fastReflection_QueryValidatorPerformanceScoreRequest QueryValidatorPerformanceScoreRequest
+
+func (x *QueryValidatorPerformanceScoreRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceScoreRequest)(x)
 }
 
-func (x *QuerySpanByIdRequest) Reset() {
-	*x = QuerySpanByIdRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[0]
+func (x *QueryValidatorPerformanceScoreRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QuerySpanByIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryValidatorPerformanceScoreRequest_messageType fastReflection_QueryValidatorPerformanceScoreRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceScoreRequest_messageType{}
 
-func (*QuerySpanByIdRequest) ProtoMessage() {}
+type fastReflection_QueryValidatorPerformanceScoreRequest_messageType struct{}
 
-// Deprecated: Use QuerySpanByIdRequest.ProtoReflect.Descriptor instead.
-func (*QuerySpanByIdRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceScoreRequest)(nil)
 }
-
-func (x *QuerySpanByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceScoreRequest)
 }
-
-// QuerySpanByIdResponse is the response type for the GetSpanById query.
-type QuerySpanByIdResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The requested span.
-	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+func (x fastReflection_QueryValidatorPerformanceScoreRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceScoreRequest
 }
 
-func (x *QuerySpanByIdResponse) Reset() {
-	*x = QuerySpanByIdResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceScoreRequest
 }
 
-func (x *QuerySpanByIdResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPerformanceScoreRequest_messageType
 }
 
-func (*QuerySpanByIdResponse) ProtoMessage() {}
-
-// Deprecated: Use QuerySpanByIdResponse.ProtoReflect.Descriptor instead.
-func (*QuerySpanByIdResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{1}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceScoreRequest)
 }
 
-func (x *QuerySpanByIdResponse) GetSpan() *Span {
-	if x != nil {
-		return x.Span
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPerformanceScoreRequest)(x)
 }
 
-// QuerySpanListRequest is the request type for the GetSpanList query.
-type QuerySpanListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Pagination parameters for the query.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
-func (x *QuerySpanListRequest) Reset() {
-	*x = QuerySpanListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[2]
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceScoreRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPerformanceScoreRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.Map = (*_QueryValidatorPerformanceScoreResponse_1_map)(nil)
+
+type _QueryValidatorPerformanceScoreResponse_1_map struct {
+	m *map[uint64]uint64
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
+		mapValue := protoreflect.ValueOfUint64(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) NewValue() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_QueryValidatorPerformanceScoreResponse_1_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_QueryValidatorPerformanceScoreResponse                             protoreflect.MessageDescriptor
+	fd_QueryValidatorPerformanceScoreResponse_validator_performance_score protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QueryValidatorPerformanceScoreResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceScoreResponse")
+	fd_QueryValidatorPerformanceScoreResponse_validator_performance_score = md_QueryValidatorPerformanceScoreResponse.Fields().ByName("validator_performance_score")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceScoreResponse)(nil)
+
+type fastReflection_QueryValidatorPerformanceScoreResponse QueryValidatorPerformanceScoreResponse
+
+func (x *QueryValidatorPerformanceScoreResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceScoreResponse)(x)
+}
+
+func (x *QueryValidatorPerformanceScoreResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorPerformanceScoreResponse_messageType fastReflection_QueryValidatorPerformanceScoreResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceScoreResponse_messageType{}
+
+type fastReflection_QueryValidatorPerformanceScoreResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceScoreResponse)(nil)
+}
+func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceScoreResponse)
+}
+func (x fastReflection_QueryValidatorPerformanceScoreResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceScoreResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceScoreResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPerformanceScoreResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceScoreResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPerformanceScoreResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorPerformanceScore) != 0 {
+		value := protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore})
+		if !f(fd_QueryValidatorPerformanceScoreResponse_validator_performance_score, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		return len(x.ValidatorPerformanceScore) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		x.ValidatorPerformanceScore = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		if len(x.ValidatorPerformanceScore) == 0 {
+			return protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{})
+		}
+		mapValue := &_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore}
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		mv := value.Map()
+		cmv := mv.(*_QueryValidatorPerformanceScoreResponse_1_map)
+		x.ValidatorPerformanceScore = *cmv.m
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		if x.ValidatorPerformanceScore == nil {
+			x.ValidatorPerformanceScore = make(map[uint64]uint64)
+		}
+		value := &_QueryValidatorPerformanceScoreResponse_1_map{m: &x.ValidatorPerformanceScore}
+		return protoreflect.ValueOfMap(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score":
+		m := make(map[uint64]uint64)
+		return protoreflect.ValueOfMap(&_QueryValidatorPerformanceScoreResponse_1_map{m: &m})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceScoreResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceScoreResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceScoreResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPerformanceScoreResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ValidatorPerformanceScore) > 0 {
+			SiZeMaP := func(k uint64, v uint64) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.ValidatorPerformanceScore))
+				for k := range x.ValidatorPerformanceScore {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.ValidatorPerformanceScore[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.ValidatorPerformanceScore {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorPerformanceScore) > 0 {
+			MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0xa
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForValidatorPerformanceScore := make([]uint64, 0, len(x.ValidatorPerformanceScore))
+				for k := range x.ValidatorPerformanceScore {
+					keysForValidatorPerformanceScore = append(keysForValidatorPerformanceScore, uint64(k))
+				}
+				sort.Slice(keysForValidatorPerformanceScore, func(i, j int) bool {
+					return keysForValidatorPerformanceScore[i] < keysForValidatorPerformanceScore[j]
+				})
+				for iNdEx := len(keysForValidatorPerformanceScore) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.ValidatorPerformanceScore[uint64(keysForValidatorPerformanceScore[iNdEx])]
+					out, err := MaRsHaLmAp(keysForValidatorPerformanceScore[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.ValidatorPerformanceScore {
+					v := x.ValidatorPerformanceScore[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceScoreResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformanceScore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValidatorPerformanceScore == nil {
+					x.ValidatorPerformanceScore = make(map[uint64]uint64)
+				}
+				var mapkey uint64
+				var mapvalue uint64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.ValidatorPerformanceScore[mapkey] = mapvalue
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorPerformanceHistoryRequest              protoreflect.MessageDescriptor
+	fd_QueryValidatorPerformanceHistoryRequest_validator_id protoreflect.FieldDescriptor
+	fd_QueryValidatorPerformanceHistoryRequest_from_span_id protoreflect.FieldDescriptor
+	fd_QueryValidatorPerformanceHistoryRequest_to_span_id   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QueryValidatorPerformanceHistoryRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceHistoryRequest")
+	fd_QueryValidatorPerformanceHistoryRequest_validator_id = md_QueryValidatorPerformanceHistoryRequest.Fields().ByName("validator_id")
+	fd_QueryValidatorPerformanceHistoryRequest_from_span_id = md_QueryValidatorPerformanceHistoryRequest.Fields().ByName("from_span_id")
+	fd_QueryValidatorPerformanceHistoryRequest_to_span_id = md_QueryValidatorPerformanceHistoryRequest.Fields().ByName("to_span_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceHistoryRequest)(nil)
+
+type fastReflection_QueryValidatorPerformanceHistoryRequest QueryValidatorPerformanceHistoryRequest
+
+func (x *QueryValidatorPerformanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceHistoryRequest)(x)
+}
+
+func (x *QueryValidatorPerformanceHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorPerformanceHistoryRequest_messageType fastReflection_QueryValidatorPerformanceHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceHistoryRequest_messageType{}
+
+type fastReflection_QueryValidatorPerformanceHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorPerformanceHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceHistoryRequest)(nil)
+}
+func (x fastReflection_QueryValidatorPerformanceHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceHistoryRequest)
+}
+func (x fastReflection_QueryValidatorPerformanceHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPerformanceHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPerformanceHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorId)
+		if !f(fd_QueryValidatorPerformanceHistoryRequest_validator_id, value) {
+			return
+		}
+	}
+	if x.FromSpanId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromSpanId)
+		if !f(fd_QueryValidatorPerformanceHistoryRequest_from_span_id, value) {
+			return
+		}
+	}
+	if x.ToSpanId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ToSpanId)
+		if !f(fd_QueryValidatorPerformanceHistoryRequest_to_span_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		return x.ValidatorId != uint64(0)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		return x.FromSpanId != uint64(0)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		return x.ToSpanId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		x.ValidatorId = uint64(0)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		x.FromSpanId = uint64(0)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		x.ToSpanId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		value := x.ValidatorId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		value := x.FromSpanId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		value := x.ToSpanId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		x.ValidatorId = value.Uint()
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		x.FromSpanId = value.Uint()
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		x.ToSpanId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		panic(fmt.Errorf("field validator_id of message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest is not mutable"))
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		panic(fmt.Errorf("field from_span_id of message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest is not mutable"))
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		panic(fmt.Errorf("field to_span_id of message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.validator_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.from_span_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest.to_span_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPerformanceHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorId))
+		}
+		if x.FromSpanId != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromSpanId))
+		}
+		if x.ToSpanId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToSpanId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ToSpanId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToSpanId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.FromSpanId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromSpanId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+				}
+				x.ValidatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromSpanId", wireType)
+				}
+				x.FromSpanId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromSpanId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToSpanId", wireType)
+				}
+				x.ToSpanId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToSpanId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorPerformanceHistoryResponse_1_list)(nil)
+
+type _QueryValidatorPerformanceHistoryResponse_1_list struct {
+	list *[]*ValidatorPerformance
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPerformance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPerformance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPerformance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPerformance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorPerformanceHistoryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorPerformanceHistoryResponse                        protoreflect.MessageDescriptor
+	fd_QueryValidatorPerformanceHistoryResponse_validator_performances protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QueryValidatorPerformanceHistoryResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QueryValidatorPerformanceHistoryResponse")
+	fd_QueryValidatorPerformanceHistoryResponse_validator_performances = md_QueryValidatorPerformanceHistoryResponse.Fields().ByName("validator_performances")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPerformanceHistoryResponse)(nil)
+
+type fastReflection_QueryValidatorPerformanceHistoryResponse QueryValidatorPerformanceHistoryResponse
+
+func (x *QueryValidatorPerformanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceHistoryResponse)(x)
+}
+
+func (x *QueryValidatorPerformanceHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorPerformanceHistoryResponse_messageType fastReflection_QueryValidatorPerformanceHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPerformanceHistoryResponse_messageType{}
+
+type fastReflection_QueryValidatorPerformanceHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorPerformanceHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPerformanceHistoryResponse)(nil)
+}
+func (x fastReflection_QueryValidatorPerformanceHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceHistoryResponse)
+}
+func (x fastReflection_QueryValidatorPerformanceHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPerformanceHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPerformanceHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPerformanceHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPerformanceHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorPerformances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorPerformanceHistoryResponse_1_list{list: &x.ValidatorPerformances})
+		if !f(fd_QueryValidatorPerformanceHistoryResponse_validator_performances, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		return len(x.ValidatorPerformances) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		x.ValidatorPerformances = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		if len(x.ValidatorPerformances) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorPerformanceHistoryResponse_1_list{})
+		}
+		listValue := &_QueryValidatorPerformanceHistoryResponse_1_list{list: &x.ValidatorPerformances}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorPerformanceHistoryResponse_1_list)
+		x.ValidatorPerformances = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		if x.ValidatorPerformances == nil {
+			x.ValidatorPerformances = []*ValidatorPerformance{}
+		}
+		value := &_QueryValidatorPerformanceHistoryResponse_1_list{list: &x.ValidatorPerformances}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances":
+		list := []*ValidatorPerformance{}
+		return protoreflect.ValueOfList(&_QueryValidatorPerformanceHistoryResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QueryValidatorPerformanceHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QueryValidatorPerformanceHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPerformanceHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ValidatorPerformances) > 0 {
+			for _, e := range x.ValidatorPerformances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorPerformances) > 0 {
+			for iNdEx := len(x.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorPerformances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPerformanceHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPerformanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorPerformances = append(x.ValidatorPerformances, &ValidatorPerformance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPerformances[len(x.ValidatorPerformances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SimulatedProducerVotes_2_list)(nil)
+
+type _SimulatedProducerVotes_2_list struct {
+	list *[]uint64
+}
+
+func (x *_SimulatedProducerVotes_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SimulatedProducerVotes_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_SimulatedProducerVotes_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SimulatedProducerVotes_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SimulatedProducerVotes_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SimulatedProducerVotes at list field Votes as it is not of Message kind"))
+}
+
+func (x *_SimulatedProducerVotes_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SimulatedProducerVotes_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_SimulatedProducerVotes_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SimulatedProducerVotes              protoreflect.MessageDescriptor
+	fd_SimulatedProducerVotes_validator_id protoreflect.FieldDescriptor
+	fd_SimulatedProducerVotes_votes        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_SimulatedProducerVotes = File_heimdallv2_bor_query_proto.Messages().ByName("SimulatedProducerVotes")
+	fd_SimulatedProducerVotes_validator_id = md_SimulatedProducerVotes.Fields().ByName("validator_id")
+	fd_SimulatedProducerVotes_votes = md_SimulatedProducerVotes.Fields().ByName("votes")
+}
+
+var _ protoreflect.Message = (*fastReflection_SimulatedProducerVotes)(nil)
+
+type fastReflection_SimulatedProducerVotes SimulatedProducerVotes
+
+func (x *SimulatedProducerVotes) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulatedProducerVotes)(x)
+}
+
+func (x *SimulatedProducerVotes) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SimulatedProducerVotes_messageType fastReflection_SimulatedProducerVotes_messageType
+var _ protoreflect.MessageType = fastReflection_SimulatedProducerVotes_messageType{}
+
+type fastReflection_SimulatedProducerVotes_messageType struct{}
+
+func (x fastReflection_SimulatedProducerVotes_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulatedProducerVotes)(nil)
+}
+func (x fastReflection_SimulatedProducerVotes_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulatedProducerVotes)
+}
+func (x fastReflection_SimulatedProducerVotes_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulatedProducerVotes
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SimulatedProducerVotes) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulatedProducerVotes
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SimulatedProducerVotes) Type() protoreflect.MessageType {
+	return _fastReflection_SimulatedProducerVotes_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SimulatedProducerVotes) New() protoreflect.Message {
+	return new(fastReflection_SimulatedProducerVotes)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SimulatedProducerVotes) Interface() protoreflect.ProtoMessage {
+	return (*SimulatedProducerVotes)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SimulatedProducerVotes) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorId)
+		if !f(fd_SimulatedProducerVotes_validator_id, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_SimulatedProducerVotes_2_list{list: &x.Votes})
+		if !f(fd_SimulatedProducerVotes_votes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SimulatedProducerVotes) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		return x.ValidatorId != uint64(0)
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		return len(x.Votes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerVotes) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		x.ValidatorId = uint64(0)
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		x.Votes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SimulatedProducerVotes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		value := x.ValidatorId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_SimulatedProducerVotes_2_list{})
+		}
+		listValue := &_SimulatedProducerVotes_2_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerVotes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		x.ValidatorId = value.Uint()
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		lv := value.List()
+		clv := lv.(*_SimulatedProducerVotes_2_list)
+		x.Votes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerVotes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		if x.Votes == nil {
+			x.Votes = []uint64{}
+		}
+		value := &_SimulatedProducerVotes_2_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		panic(fmt.Errorf("field validator_id of message heimdallv2.bor.SimulatedProducerVotes is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SimulatedProducerVotes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerVotes.validator_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SimulatedProducerVotes.votes":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_SimulatedProducerVotes_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerVotes"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SimulatedProducerVotes) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.SimulatedProducerVotes", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SimulatedProducerVotes) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerVotes) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SimulatedProducerVotes) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SimulatedProducerVotes) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SimulatedProducerVotes)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorId))
+		}
+		if len(x.Votes) > 0 {
+			l = 0
+			for _, e := range x.Votes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SimulatedProducerVotes)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Votes) > 0 {
+			var pksize2 int
+			for _, num := range x.Votes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Votes {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ValidatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SimulatedProducerVotes)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulatedProducerVotes: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulatedProducerVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+				}
+				x.ValidatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Votes = append(x.Votes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Votes) == 0 {
+						x.Votes = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Votes = append(x.Votes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SimulatedProducerDowntime                protoreflect.MessageDescriptor
+	fd_SimulatedProducerDowntime_producer_id    protoreflect.FieldDescriptor
+	fd_SimulatedProducerDowntime_downtime_range protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_SimulatedProducerDowntime = File_heimdallv2_bor_query_proto.Messages().ByName("SimulatedProducerDowntime")
+	fd_SimulatedProducerDowntime_producer_id = md_SimulatedProducerDowntime.Fields().ByName("producer_id")
+	fd_SimulatedProducerDowntime_downtime_range = md_SimulatedProducerDowntime.Fields().ByName("downtime_range")
+}
+
+var _ protoreflect.Message = (*fastReflection_SimulatedProducerDowntime)(nil)
+
+type fastReflection_SimulatedProducerDowntime SimulatedProducerDowntime
+
+func (x *SimulatedProducerDowntime) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulatedProducerDowntime)(x)
+}
+
+func (x *SimulatedProducerDowntime) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SimulatedProducerDowntime_messageType fastReflection_SimulatedProducerDowntime_messageType
+var _ protoreflect.MessageType = fastReflection_SimulatedProducerDowntime_messageType{}
+
+type fastReflection_SimulatedProducerDowntime_messageType struct{}
+
+func (x fastReflection_SimulatedProducerDowntime_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulatedProducerDowntime)(nil)
+}
+func (x fastReflection_SimulatedProducerDowntime_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulatedProducerDowntime)
+}
+func (x fastReflection_SimulatedProducerDowntime_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulatedProducerDowntime
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SimulatedProducerDowntime) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulatedProducerDowntime
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SimulatedProducerDowntime) Type() protoreflect.MessageType {
+	return _fastReflection_SimulatedProducerDowntime_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SimulatedProducerDowntime) New() protoreflect.Message {
+	return new(fastReflection_SimulatedProducerDowntime)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SimulatedProducerDowntime) Interface() protoreflect.ProtoMessage {
+	return (*SimulatedProducerDowntime)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SimulatedProducerDowntime) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProducerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProducerId)
+		if !f(fd_SimulatedProducerDowntime_producer_id, value) {
+			return
+		}
+	}
+	if x.DowntimeRange != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeRange.ProtoReflect())
+		if !f(fd_SimulatedProducerDowntime_downtime_range, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SimulatedProducerDowntime) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		return x.ProducerId != uint64(0)
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		return x.DowntimeRange != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerDowntime) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		x.ProducerId = uint64(0)
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		x.DowntimeRange = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SimulatedProducerDowntime) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		value := x.ProducerId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		value := x.DowntimeRange
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerDowntime) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		x.ProducerId = value.Uint()
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		x.DowntimeRange = value.Message().Interface().(*BlockRange)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerDowntime) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		if x.DowntimeRange == nil {
+			x.DowntimeRange = new(BlockRange)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeRange.ProtoReflect())
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		panic(fmt.Errorf("field producer_id of message heimdallv2.bor.SimulatedProducerDowntime is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SimulatedProducerDowntime) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.SimulatedProducerDowntime.producer_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.SimulatedProducerDowntime.downtime_range":
+		m := new(BlockRange)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.SimulatedProducerDowntime"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.SimulatedProducerDowntime does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SimulatedProducerDowntime) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.SimulatedProducerDowntime", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SimulatedProducerDowntime) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulatedProducerDowntime) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SimulatedProducerDowntime) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SimulatedProducerDowntime) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SimulatedProducerDowntime)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProducerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProducerId))
+		}
+		if x.DowntimeRange != nil {
+			l = options.Size(x.DowntimeRange)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SimulatedProducerDowntime)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DowntimeRange != nil {
+			encoded, err := options.Marshal(x.DowntimeRange)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProducerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProducerId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SimulatedProducerDowntime)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulatedProducerDowntime: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulatedProducerDowntime: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+				}
+				x.ProducerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProducerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeRange", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeRange == nil {
+					x.DowntimeRange = &BlockRange{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeRange); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProducerScore                protoreflect.MessageDescriptor
+	fd_ProducerScore_validator_id   protoreflect.FieldDescriptor
+	fd_ProducerScore_score          protoreflect.FieldDescriptor
+	fd_ProducerScore_required_score protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_ProducerScore = File_heimdallv2_bor_query_proto.Messages().ByName("ProducerScore")
+	fd_ProducerScore_validator_id = md_ProducerScore.Fields().ByName("validator_id")
+	fd_ProducerScore_score = md_ProducerScore.Fields().ByName("score")
+	fd_ProducerScore_required_score = md_ProducerScore.Fields().ByName("required_score")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProducerScore)(nil)
+
+type fastReflection_ProducerScore ProducerScore
+
+func (x *ProducerScore) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProducerScore)(x)
+}
+
+func (x *ProducerScore) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProducerScore_messageType fastReflection_ProducerScore_messageType
+var _ protoreflect.MessageType = fastReflection_ProducerScore_messageType{}
+
+type fastReflection_ProducerScore_messageType struct{}
+
+func (x fastReflection_ProducerScore_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProducerScore)(nil)
+}
+func (x fastReflection_ProducerScore_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProducerScore)
+}
+func (x fastReflection_ProducerScore_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProducerScore
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProducerScore) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProducerScore
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProducerScore) Type() protoreflect.MessageType {
+	return _fastReflection_ProducerScore_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProducerScore) New() protoreflect.Message {
+	return new(fastReflection_ProducerScore)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProducerScore) Interface() protoreflect.ProtoMessage {
+	return (*ProducerScore)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProducerScore) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorId)
+		if !f(fd_ProducerScore_validator_id, value) {
+			return
+		}
+	}
+	if x.Score != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Score)
+		if !f(fd_ProducerScore_score, value) {
+			return
+		}
+	}
+	if x.RequiredScore != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequiredScore)
+		if !f(fd_ProducerScore_required_score, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProducerScore) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		return x.ValidatorId != uint64(0)
+	case "heimdallv2.bor.ProducerScore.score":
+		return x.Score != int64(0)
+	case "heimdallv2.bor.ProducerScore.required_score":
+		return x.RequiredScore != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProducerScore) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		x.ValidatorId = uint64(0)
+	case "heimdallv2.bor.ProducerScore.score":
+		x.Score = int64(0)
+	case "heimdallv2.bor.ProducerScore.required_score":
+		x.RequiredScore = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProducerScore) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		value := x.ValidatorId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.ProducerScore.score":
+		value := x.Score
+		return protoreflect.ValueOfInt64(value)
+	case "heimdallv2.bor.ProducerScore.required_score":
+		value := x.RequiredScore
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProducerScore) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		x.ValidatorId = value.Uint()
+	case "heimdallv2.bor.ProducerScore.score":
+		x.Score = value.Int()
+	case "heimdallv2.bor.ProducerScore.required_score":
+		x.RequiredScore = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProducerScore) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		panic(fmt.Errorf("field validator_id of message heimdallv2.bor.ProducerScore is not mutable"))
+	case "heimdallv2.bor.ProducerScore.score":
+		panic(fmt.Errorf("field score of message heimdallv2.bor.ProducerScore is not mutable"))
+	case "heimdallv2.bor.ProducerScore.required_score":
+		panic(fmt.Errorf("field required_score of message heimdallv2.bor.ProducerScore is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProducerScore) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.ProducerScore.validator_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.ProducerScore.score":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "heimdallv2.bor.ProducerScore.required_score":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.ProducerScore"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.ProducerScore does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProducerScore) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.ProducerScore", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProducerScore) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProducerScore) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProducerScore) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProducerScore) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProducerScore)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorId))
+		}
+		if x.Score != 0 {
+			n += 1 + runtime.Sov(uint64(x.Score))
+		}
+		if x.RequiredScore != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredScore))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProducerScore)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequiredScore != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredScore))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Score != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Score))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProducerScore)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProducerScore: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProducerScore: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+				}
+				x.ValidatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				x.Score = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Score |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredScore", wireType)
+				}
+				x.RequiredScore = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredScore |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateProducerSetRequest_1_list)(nil)
+
+type _QuerySimulateProducerSetRequest_1_list struct {
+	list *[]*SimulatedProducerVotes
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SimulatedProducerVotes)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SimulatedProducerVotes)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(SimulatedProducerVotes)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) NewElement() protoreflect.Value {
+	v := new(SimulatedProducerVotes)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QuerySimulateProducerSetRequest_2_list)(nil)
+
+type _QuerySimulateProducerSetRequest_2_list struct {
+	list *[]*SimulatedProducerDowntime
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SimulatedProducerDowntime)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SimulatedProducerDowntime)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) AppendMutable() protoreflect.Value {
+	v := new(SimulatedProducerDowntime)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) NewElement() protoreflect.Value {
+	v := new(SimulatedProducerDowntime)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateProducerSetRequest                    protoreflect.MessageDescriptor
+	fd_QuerySimulateProducerSetRequest_producer_votes     protoreflect.FieldDescriptor
+	fd_QuerySimulateProducerSetRequest_producer_downtimes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QuerySimulateProducerSetRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QuerySimulateProducerSetRequest")
+	fd_QuerySimulateProducerSetRequest_producer_votes = md_QuerySimulateProducerSetRequest.Fields().ByName("producer_votes")
+	fd_QuerySimulateProducerSetRequest_producer_downtimes = md_QuerySimulateProducerSetRequest.Fields().ByName("producer_downtimes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProducerSetRequest)(nil)
+
+type fastReflection_QuerySimulateProducerSetRequest QuerySimulateProducerSetRequest
+
+func (x *QuerySimulateProducerSetRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProducerSetRequest)(x)
+}
+
+func (x *QuerySimulateProducerSetRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProducerSetRequest_messageType fastReflection_QuerySimulateProducerSetRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProducerSetRequest_messageType{}
+
+type fastReflection_QuerySimulateProducerSetRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateProducerSetRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProducerSetRequest)(nil)
+}
+func (x fastReflection_QuerySimulateProducerSetRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProducerSetRequest)
+}
+func (x fastReflection_QuerySimulateProducerSetRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProducerSetRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProducerSetRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProducerSetRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProducerSetRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProducerSetRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProducerSetRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ProducerVotes) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_1_list{list: &x.ProducerVotes})
+		if !f(fd_QuerySimulateProducerSetRequest_producer_votes, value) {
+			return
+		}
+	}
+	if len(x.ProducerDowntimes) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_2_list{list: &x.ProducerDowntimes})
+		if !f(fd_QuerySimulateProducerSetRequest_producer_downtimes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		return len(x.ProducerVotes) != 0
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		return len(x.ProducerDowntimes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		x.ProducerVotes = nil
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		x.ProducerDowntimes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		if len(x.ProducerVotes) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_1_list{})
+		}
+		listValue := &_QuerySimulateProducerSetRequest_1_list{list: &x.ProducerVotes}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		if len(x.ProducerDowntimes) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_2_list{})
+		}
+		listValue := &_QuerySimulateProducerSetRequest_2_list{list: &x.ProducerDowntimes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProducerSetRequest_1_list)
+		x.ProducerVotes = *clv.list
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProducerSetRequest_2_list)
+		x.ProducerDowntimes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		if x.ProducerVotes == nil {
+			x.ProducerVotes = []*SimulatedProducerVotes{}
+		}
+		value := &_QuerySimulateProducerSetRequest_1_list{list: &x.ProducerVotes}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		if x.ProducerDowntimes == nil {
+			x.ProducerDowntimes = []*SimulatedProducerDowntime{}
+		}
+		value := &_QuerySimulateProducerSetRequest_2_list{list: &x.ProducerDowntimes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProducerSetRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes":
+		list := []*SimulatedProducerVotes{}
+		return protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_1_list{list: &list})
+	case "heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes":
+		list := []*SimulatedProducerDowntime{}
+		return protoreflect.ValueOfList(&_QuerySimulateProducerSetRequest_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProducerSetRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QuerySimulateProducerSetRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProducerSetRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProducerSetRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProducerSetRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProducerSetRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ProducerVotes) > 0 {
+			for _, e := range x.ProducerVotes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProducerDowntimes) > 0 {
+			for _, e := range x.ProducerDowntimes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProducerSetRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProducerDowntimes) > 0 {
+			for iNdEx := len(x.ProducerDowntimes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProducerDowntimes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ProducerVotes) > 0 {
+			for iNdEx := len(x.ProducerVotes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProducerVotes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProducerSetRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProducerSetRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProducerSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerVotes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProducerVotes = append(x.ProducerVotes, &SimulatedProducerVotes{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProducerVotes[len(x.ProducerVotes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerDowntimes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProducerDowntimes = append(x.ProducerDowntimes, &SimulatedProducerDowntime{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProducerDowntimes[len(x.ProducerDowntimes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateProducerSetResponse_1_list)(nil)
+
+type _QuerySimulateProducerSetResponse_1_list struct {
+	list *[]*ProducerScore
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProducerScore)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProducerScore)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ProducerScore)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ProducerScore)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProducerSetResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QuerySimulateProducerSetResponse_2_list)(nil)
+
+type _QuerySimulateProducerSetResponse_2_list struct {
+	list *[]uint64
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QuerySimulateProducerSetResponse at list field ProducerSet as it is not of Message kind"))
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_QuerySimulateProducerSetResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateProducerSetResponse                  protoreflect.MessageDescriptor
+	fd_QuerySimulateProducerSetResponse_producer_scores  protoreflect.FieldDescriptor
+	fd_QuerySimulateProducerSetResponse_producer_set     protoreflect.FieldDescriptor
+	fd_QuerySimulateProducerSetResponse_current_producer protoreflect.FieldDescriptor
+	fd_QuerySimulateProducerSetResponse_span_range       protoreflect.FieldDescriptor
+	fd_QuerySimulateProducerSetResponse_next_producer    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QuerySimulateProducerSetResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QuerySimulateProducerSetResponse")
+	fd_QuerySimulateProducerSetResponse_producer_scores = md_QuerySimulateProducerSetResponse.Fields().ByName("producer_scores")
+	fd_QuerySimulateProducerSetResponse_producer_set = md_QuerySimulateProducerSetResponse.Fields().ByName("producer_set")
+	fd_QuerySimulateProducerSetResponse_current_producer = md_QuerySimulateProducerSetResponse.Fields().ByName("current_producer")
+	fd_QuerySimulateProducerSetResponse_span_range = md_QuerySimulateProducerSetResponse.Fields().ByName("span_range")
+	fd_QuerySimulateProducerSetResponse_next_producer = md_QuerySimulateProducerSetResponse.Fields().ByName("next_producer")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProducerSetResponse)(nil)
+
+type fastReflection_QuerySimulateProducerSetResponse QuerySimulateProducerSetResponse
+
+func (x *QuerySimulateProducerSetResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProducerSetResponse)(x)
+}
+
+func (x *QuerySimulateProducerSetResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProducerSetResponse_messageType fastReflection_QuerySimulateProducerSetResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProducerSetResponse_messageType{}
+
+type fastReflection_QuerySimulateProducerSetResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateProducerSetResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProducerSetResponse)(nil)
+}
+func (x fastReflection_QuerySimulateProducerSetResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProducerSetResponse)
+}
+func (x fastReflection_QuerySimulateProducerSetResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProducerSetResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProducerSetResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProducerSetResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProducerSetResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProducerSetResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProducerSetResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ProducerScores) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_1_list{list: &x.ProducerScores})
+		if !f(fd_QuerySimulateProducerSetResponse_producer_scores, value) {
+			return
+		}
+	}
+	if len(x.ProducerSet) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_2_list{list: &x.ProducerSet})
+		if !f(fd_QuerySimulateProducerSetResponse_producer_set, value) {
+			return
+		}
+	}
+	if x.CurrentProducer != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CurrentProducer)
+		if !f(fd_QuerySimulateProducerSetResponse_current_producer, value) {
+			return
+		}
+	}
+	if x.SpanRange != nil {
+		value := protoreflect.ValueOfMessage(x.SpanRange.ProtoReflect())
+		if !f(fd_QuerySimulateProducerSetResponse_span_range, value) {
+			return
+		}
+	}
+	if x.NextProducer != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextProducer)
+		if !f(fd_QuerySimulateProducerSetResponse_next_producer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		return len(x.ProducerScores) != 0
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		return len(x.ProducerSet) != 0
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		return x.CurrentProducer != uint64(0)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		return x.SpanRange != nil
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		return x.NextProducer != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		x.ProducerScores = nil
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		x.ProducerSet = nil
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		x.CurrentProducer = uint64(0)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		x.SpanRange = nil
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		x.NextProducer = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		if len(x.ProducerScores) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_1_list{})
+		}
+		listValue := &_QuerySimulateProducerSetResponse_1_list{list: &x.ProducerScores}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		if len(x.ProducerSet) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_2_list{})
+		}
+		listValue := &_QuerySimulateProducerSetResponse_2_list{list: &x.ProducerSet}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		value := x.CurrentProducer
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		value := x.SpanRange
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		value := x.NextProducer
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProducerSetResponse_1_list)
+		x.ProducerScores = *clv.list
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProducerSetResponse_2_list)
+		x.ProducerSet = *clv.list
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		x.CurrentProducer = value.Uint()
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		x.SpanRange = value.Message().Interface().(*BlockRange)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		x.NextProducer = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		if x.ProducerScores == nil {
+			x.ProducerScores = []*ProducerScore{}
+		}
+		value := &_QuerySimulateProducerSetResponse_1_list{list: &x.ProducerScores}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		if x.ProducerSet == nil {
+			x.ProducerSet = []uint64{}
+		}
+		value := &_QuerySimulateProducerSetResponse_2_list{list: &x.ProducerSet}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		if x.SpanRange == nil {
+			x.SpanRange = new(BlockRange)
+		}
+		return protoreflect.ValueOfMessage(x.SpanRange.ProtoReflect())
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		panic(fmt.Errorf("field current_producer of message heimdallv2.bor.QuerySimulateProducerSetResponse is not mutable"))
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		panic(fmt.Errorf("field next_producer of message heimdallv2.bor.QuerySimulateProducerSetResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProducerSetResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores":
+		list := []*ProducerScore{}
+		return protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_1_list{list: &list})
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.producer_set":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_QuerySimulateProducerSetResponse_2_list{list: &list})
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.current_producer":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.span_range":
+		m := new(BlockRange)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "heimdallv2.bor.QuerySimulateProducerSetResponse.next_producer":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySimulateProducerSetResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySimulateProducerSetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProducerSetResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QuerySimulateProducerSetResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProducerSetResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProducerSetResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProducerSetResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProducerSetResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProducerSetResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ProducerScores) > 0 {
+			for _, e := range x.ProducerScores {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProducerSet) > 0 {
+			l = 0
+			for _, e := range x.ProducerSet {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.CurrentProducer != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentProducer))
+		}
+		if x.SpanRange != nil {
+			l = options.Size(x.SpanRange)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextProducer != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextProducer))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProducerSetResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextProducer != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextProducer))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SpanRange != nil {
+			encoded, err := options.Marshal(x.SpanRange)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.CurrentProducer != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentProducer))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ProducerSet) > 0 {
+			var pksize2 int
+			for _, num := range x.ProducerSet {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ProducerSet {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ProducerScores) > 0 {
+			for iNdEx := len(x.ProducerScores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProducerScores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProducerSetResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProducerSetResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProducerSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProducerScores = append(x.ProducerScores, &ProducerScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProducerScores[len(x.ProducerScores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ProducerSet = append(x.ProducerSet, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ProducerSet) == 0 {
+						x.ProducerSet = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ProducerSet = append(x.ProducerSet, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProducerSet", wireType)
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentProducer", wireType)
+				}
+				x.CurrentProducer = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentProducer |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpanRange", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpanRange == nil {
+					x.SpanRange = &BlockRange{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpanRange); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextProducer", wireType)
+				}
+				x.NextProducer = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextProducer |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySpanSummaryByIdRequest    protoreflect.MessageDescriptor
+	fd_QuerySpanSummaryByIdRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QuerySpanSummaryByIdRequest = File_heimdallv2_bor_query_proto.Messages().ByName("QuerySpanSummaryByIdRequest")
+	fd_QuerySpanSummaryByIdRequest_id = md_QuerySpanSummaryByIdRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySpanSummaryByIdRequest)(nil)
+
+type fastReflection_QuerySpanSummaryByIdRequest QuerySpanSummaryByIdRequest
+
+func (x *QuerySpanSummaryByIdRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySpanSummaryByIdRequest)(x)
+}
+
+func (x *QuerySpanSummaryByIdRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySpanSummaryByIdRequest_messageType fastReflection_QuerySpanSummaryByIdRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySpanSummaryByIdRequest_messageType{}
+
+type fastReflection_QuerySpanSummaryByIdRequest_messageType struct{}
+
+func (x fastReflection_QuerySpanSummaryByIdRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySpanSummaryByIdRequest)(nil)
+}
+func (x fastReflection_QuerySpanSummaryByIdRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySpanSummaryByIdRequest)
+}
+func (x fastReflection_QuerySpanSummaryByIdRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpanSummaryByIdRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpanSummaryByIdRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySpanSummaryByIdRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySpanSummaryByIdRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySpanSummaryByIdRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QuerySpanSummaryByIdRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		panic(fmt.Errorf("field id of message heimdallv2.bor.QuerySpanSummaryByIdRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QuerySpanSummaryByIdRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySpanSummaryByIdRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpanSummaryByIdRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpanSummaryByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySpanSummaryByIdResponse              protoreflect.MessageDescriptor
+	fd_QuerySpanSummaryByIdResponse_span_summary protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_bor_query_proto_init()
+	md_QuerySpanSummaryByIdResponse = File_heimdallv2_bor_query_proto.Messages().ByName("QuerySpanSummaryByIdResponse")
+	fd_QuerySpanSummaryByIdResponse_span_summary = md_QuerySpanSummaryByIdResponse.Fields().ByName("span_summary")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySpanSummaryByIdResponse)(nil)
+
+type fastReflection_QuerySpanSummaryByIdResponse QuerySpanSummaryByIdResponse
+
+func (x *QuerySpanSummaryByIdResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySpanSummaryByIdResponse)(x)
+}
+
+func (x *QuerySpanSummaryByIdResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_bor_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySpanSummaryByIdResponse_messageType fastReflection_QuerySpanSummaryByIdResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySpanSummaryByIdResponse_messageType{}
+
+type fastReflection_QuerySpanSummaryByIdResponse_messageType struct{}
+
+func (x fastReflection_QuerySpanSummaryByIdResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySpanSummaryByIdResponse)(nil)
+}
+func (x fastReflection_QuerySpanSummaryByIdResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySpanSummaryByIdResponse)
+}
+func (x fastReflection_QuerySpanSummaryByIdResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpanSummaryByIdResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpanSummaryByIdResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySpanSummaryByIdResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySpanSummaryByIdResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySpanSummaryByIdResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SpanSummary != nil {
+		value := protoreflect.ValueOfMessage(x.SpanSummary.ProtoReflect())
+		if !f(fd_QuerySpanSummaryByIdResponse_span_summary, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		return x.SpanSummary != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		x.SpanSummary = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		value := x.SpanSummary
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		x.SpanSummary = value.Message().Interface().(*SpanSummary)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		if x.SpanSummary == nil {
+			x.SpanSummary = new(SpanSummary)
+		}
+		return protoreflect.ValueOfMessage(x.SpanSummary.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary":
+		m := new(SpanSummary)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.bor.QuerySpanSummaryByIdResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.bor.QuerySpanSummaryByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.bor.QuerySpanSummaryByIdResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySpanSummaryByIdResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SpanSummary != nil {
+			l = options.Size(x.SpanSummary)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SpanSummary != nil {
+			encoded, err := options.Marshal(x.SpanSummary)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpanSummaryByIdResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpanSummaryByIdResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpanSummaryByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpanSummary", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpanSummary == nil {
+					x.SpanSummary = &SpanSummary{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpanSummary); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: heimdallv2/bor/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuerySpanByIdRequest is the request type for the GetSpanById query.
+type QuerySpanByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the span to retrieve.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QuerySpanByIdRequest) Reset() {
+	*x = QuerySpanByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpanByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpanByIdRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySpanByIdRequest.ProtoReflect.Descriptor instead.
+func (*QuerySpanByIdRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuerySpanByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// QuerySpanByIdResponse is the response type for the GetSpanById query.
+type QuerySpanByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested span.
+	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *QuerySpanByIdResponse) Reset() {
+	*x = QuerySpanByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpanByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpanByIdResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySpanByIdResponse.ProtoReflect.Descriptor instead.
+func (*QuerySpanByIdResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuerySpanByIdResponse) GetSpan() *Span {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+// QuerySpanListRequest is the request type for the GetSpanList query.
+type QuerySpanListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination parameters for the query.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySpanListRequest) Reset() {
+	*x = QuerySpanListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpanListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpanListRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySpanListRequest.ProtoReflect.Descriptor instead.
+func (*QuerySpanListRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuerySpanListRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySpanListResponse is the response type for the GetSpanList query.
+type QuerySpanListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of spans matching the query.
+	SpanList []*Span `protobuf:"bytes,1,rep,name=span_list,json=spanList,proto3" json:"span_list,omitempty"`
+	// Pagination response with next page token.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySpanListResponse) Reset() {
+	*x = QuerySpanListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpanListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpanListResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySpanListResponse.ProtoReflect.Descriptor instead.
+func (*QuerySpanListResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuerySpanListResponse) GetSpanList() []*Span {
+	if x != nil {
+		return x.SpanList
+	}
+	return nil
+}
+
+func (x *QuerySpanListResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryLatestSpanRequest is the request type for the GetLatestSpan query.
+type QueryLatestSpanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryLatestSpanRequest) Reset() {
+	*x = QueryLatestSpanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLatestSpanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLatestSpanRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLatestSpanRequest.ProtoReflect.Descriptor instead.
+func (*QueryLatestSpanRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QueryLatestSpanResponse is the response type for the GetLatestSpan query.
+type QueryLatestSpanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recently created span.
+	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *QueryLatestSpanResponse) Reset() {
+	*x = QueryLatestSpanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLatestSpanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLatestSpanResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLatestSpanResponse.ProtoReflect.Descriptor instead.
+func (*QueryLatestSpanResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryLatestSpanResponse) GetSpan() *Span {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+// QueryNextSpanSeedRequest is the request type for the GetNextSpanSeed query.
+type QueryNextSpanSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the span for which to get the seed.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryNextSpanSeedRequest) Reset() {
+	*x = QueryNextSpanSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNextSpanSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNextSpanSeedRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryNextSpanSeedRequest.ProtoReflect.Descriptor instead.
+func (*QueryNextSpanSeedRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryNextSpanSeedRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// QueryNextSpanSeedResponse is the response type for the GetNextSpanSeed query.
+type QueryNextSpanSeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seed value used for producer selection randomization.
+	Seed string `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Address of the validator who authored the seed.
+	SeedAuthor string `protobuf:"bytes,2,opt,name=seed_author,json=seedAuthor,proto3" json:"seed_author,omitempty"`
+}
+
+func (x *QueryNextSpanSeedResponse) Reset() {
+	*x = QueryNextSpanSeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNextSpanSeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNextSpanSeedResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryNextSpanSeedResponse.ProtoReflect.Descriptor instead.
+func (*QueryNextSpanSeedResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryNextSpanSeedResponse) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *QueryNextSpanSeedResponse) GetSeedAuthor() string {
+	if x != nil {
+		return x.SeedAuthor
+	}
+	return ""
+}
+
+// QueryNextSpanRequest is the request type for the GetNextSpan query.
+type QueryNextSpanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID for the next span to be created.
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	// Starting block number for the next span.
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// Chain ID of the Bor chain.
+	BorChainId string `protobuf:"bytes,3,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
+}
+
+func (x *QueryNextSpanRequest) Reset() {
+	*x = QueryNextSpanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNextSpanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNextSpanRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryNextSpanRequest.ProtoReflect.Descriptor instead.
+func (*QueryNextSpanRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryNextSpanRequest) GetSpanId() uint64 {
+	if x != nil {
+		return x.SpanId
+	}
+	return 0
+}
+
+func (x *QueryNextSpanRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *QueryNextSpanRequest) GetBorChainId() string {
+	if x != nil {
+		return x.BorChainId
+	}
+	return ""
+}
+
+// QueryNextSpanResponse is the response type for the GetNextSpan query.
+type QueryNextSpanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The prepared next span with selected producers.
+	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *QueryNextSpanResponse) Reset() {
+	*x = QueryNextSpanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpanListRequest) String() string {
+func (x *QueryNextSpanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpanListRequest) ProtoMessage() {}
+func (*QueryNextSpanResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySpanListRequest.ProtoReflect.Descriptor instead.
-func (*QuerySpanListRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryNextSpanResponse.ProtoReflect.Descriptor instead.
+func (*QueryNextSpanResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QuerySpanListRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryNextSpanResponse) GetSpan() *Span {
 	if x != nil {
-		return x.Pagination
+		return x.Span
 	}
 	return nil
 }
 
-// QuerySpanListResponse is the response type for the GetSpanList query.
-type QuerySpanListResponse struct {
+// QueryParamsRequest is the request type for the GetBorParams query.
+type QueryParamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// List of spans matching the query.
-	SpanList []*Span `protobuf:"bytes,1,rep,name=span_list,json=spanList,proto3" json:"span_list,omitempty"`
-	// Pagination response with next page token.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *QuerySpanListResponse) Reset() {
-	*x = QuerySpanListResponse{}
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{10}
+}
+
+// QueryParamsResponse is the response type for the GetBorParams query.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current parameters of the bor module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[3]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpanListResponse) String() string {
+func (x *QueryParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpanListResponse) ProtoMessage() {}
+func (*QueryParamsResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySpanListResponse.ProtoReflect.Descriptor instead.
-func (*QuerySpanListResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QuerySpanListResponse) GetSpanList() []*Span {
+func (x *QueryParamsResponse) GetParams() *Params {
 	if x != nil {
-		return x.SpanList
+		return x.Params
 	}
 	return nil
 }
 
-func (x *QuerySpanListResponse) GetPagination() *v1beta1.PageResponse {
+// QueryProducerVotesRequest is the request type for the GetProducerVotes query.
+type QueryProducerVotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryProducerVotesRequest) Reset() {
+	*x = QueryProducerVotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProducerVotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProducerVotesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProducerVotesRequest.ProtoReflect.Descriptor instead.
+func (*QueryProducerVotesRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryProducerVotesResponse is the response type for the GetProducerVotes
+// query.
+type QueryProducerVotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of validator ID to their producer votes.
+	// Key: validator ID, Value: list of validator IDs they voted for.
+	AllVotes map[uint64]*ProducerVotes `protobuf:"bytes,1,rep,name=all_votes,json=allVotes,proto3" json:"all_votes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *QueryProducerVotesResponse) Reset() {
+	*x = QueryProducerVotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProducerVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProducerVotesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProducerVotesResponse.ProtoReflect.Descriptor instead.
+func (*QueryProducerVotesResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryProducerVotesResponse) GetAllVotes() map[uint64]*ProducerVotes {
 	if x != nil {
-		return x.Pagination
+		return x.AllVotes
 	}
 	return nil
 }
 
-// QueryLatestSpanRequest is the request type for the GetLatestSpan query.
-type QueryLatestSpanRequest struct {
+// QueryProducerVotesByValidatorIdRequest is the request type for the
+// GetProducerVotesByValidatorId query.
+type QueryProducerVotesByValidatorIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the validator whose votes to retrieve.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
 }
 
-func (x *QueryLatestSpanRequest) Reset() {
-	*x = QueryLatestSpanRequest{}
+func (x *QueryProducerVotesByValidatorIdRequest) Reset() {
+	*x = QueryProducerVotesByValidatorIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[4]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLatestSpanRequest) String() string {
+func (x *QueryProducerVotesByValidatorIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLatestSpanRequest) ProtoMessage() {}
+func (*QueryProducerVotesByValidatorIdRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryLatestSpanRequest.ProtoReflect.Descriptor instead.
-func (*QueryLatestSpanRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryProducerVotesByValidatorIdRequest.ProtoReflect.Descriptor instead.
+func (*QueryProducerVotesByValidatorIdRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{14}
 }
 
-// QueryLatestSpanResponse is the response type for the GetLatestSpan query.
-type QueryLatestSpanResponse struct {
+func (x *QueryProducerVotesByValidatorIdRequest) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+// QueryProducerVotesByValidatorIdResponse is the response type for the
+// GetProducerVotesByValidatorId query.
+type QueryProducerVotesByValidatorIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The most recently created span.
-	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+	// List of validator IDs that the queried validator voted for.
+	Votes []uint64 `protobuf:"varint,1,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *QueryProducerVotesByValidatorIdResponse) Reset() {
+	*x = QueryProducerVotesByValidatorIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProducerVotesByValidatorIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProducerVotesByValidatorIdResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProducerVotesByValidatorIdResponse.ProtoReflect.Descriptor instead.
+func (*QueryProducerVotesByValidatorIdResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryProducerVotesByValidatorIdResponse) GetVotes() []uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+// QueryProducerPlannedDowntimeRequest is the request type for the
+// GetProducerPlannedDowntime query.
+type QueryProducerPlannedDowntimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the producer whose planned downtime to retrieve.
+	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+}
+
+func (x *QueryProducerPlannedDowntimeRequest) Reset() {
+	*x = QueryProducerPlannedDowntimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProducerPlannedDowntimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProducerPlannedDowntimeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProducerPlannedDowntimeRequest.ProtoReflect.Descriptor instead.
+func (*QueryProducerPlannedDowntimeRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryProducerPlannedDowntimeRequest) GetProducerId() uint64 {
+	if x != nil {
+		return x.ProducerId
+	}
+	return 0
+}
+
+// QueryProducerPlannedDowntimeResponse is the response type for the
+// GetProducerPlannedDowntime query.
+type QueryProducerPlannedDowntimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Block range during which the producer has planned downtime.
+	DowntimeRange *BlockRange `protobuf:"bytes,1,opt,name=downtime_range,json=downtimeRange,proto3" json:"downtime_range,omitempty"`
 }
 
-func (x *QueryLatestSpanResponse) Reset() {
-	*x = QueryLatestSpanResponse{}
+func (x *QueryProducerPlannedDowntimeResponse) Reset() {
+	*x = QueryProducerPlannedDowntimeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[5]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLatestSpanResponse) String() string {
+func (x *QueryProducerPlannedDowntimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLatestSpanResponse) ProtoMessage() {}
+func (*QueryProducerPlannedDowntimeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLatestSpanResponse.ProtoReflect.Descriptor instead.
-func (*QueryLatestSpanResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryProducerPlannedDowntimeResponse.ProtoReflect.Descriptor instead.
+func (*QueryProducerPlannedDowntimeResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryLatestSpanResponse) GetSpan() *Span {
+func (x *QueryProducerPlannedDowntimeResponse) GetDowntimeRange() *BlockRange {
 	if x != nil {
-		return x.Span
+		return x.DowntimeRange
 	}
 	return nil
 }
 
-// QueryNextSpanSeedRequest is the request type for the GetNextSpanSeed query.
-type QueryNextSpanSeedRequest struct {
+// QueryProducerPlannedDowntimesRequest is the request type for the
+// GetProducerPlannedDowntimes query.
+type QueryProducerPlannedDowntimesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the span for which to get the seed.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the producer whose planned downtime windows to retrieve.
+	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
 }
 
-func (x *QueryNextSpanSeedRequest) Reset() {
-	*x = QueryNextSpanSeedRequest{}
+func (x *QueryProducerPlannedDowntimesRequest) Reset() {
+	*x = QueryProducerPlannedDowntimesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[6]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryNextSpanSeedRequest) String() string {
+func (x *QueryProducerPlannedDowntimesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryNextSpanSeedRequest) ProtoMessage() {}
+func (*QueryProducerPlannedDowntimesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryNextSpanSeedRequest.ProtoReflect.Descriptor instead.
-func (*QueryNextSpanSeedRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryProducerPlannedDowntimesRequest.ProtoReflect.Descriptor instead.
+func (*QueryProducerPlannedDowntimesRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryNextSpanSeedRequest) GetId() uint64 {
+func (x *QueryProducerPlannedDowntimesRequest) GetProducerId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ProducerId
 	}
 	return 0
 }
 
-// QueryNextSpanSeedResponse is the response type for the GetNextSpanSeed query.
-type QueryNextSpanSeedResponse struct {
+// QueryProducerPlannedDowntimesResponse is the response type for the
+// GetProducerPlannedDowntimes query.
+type QueryProducerPlannedDowntimesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Seed value used for producer selection randomization.
-	Seed string `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
-	// Address of the validator who authored the seed.
-	SeedAuthor string `protobuf:"bytes,2,opt,name=seed_author,json=seedAuthor,proto3" json:"seed_author,omitempty"`
+	// Block ranges during which the producer has planned downtime.
+	DowntimeRanges []*BlockRange `protobuf:"bytes,1,rep,name=downtime_ranges,json=downtimeRanges,proto3" json:"downtime_ranges,omitempty"`
 }
 
-func (x *QueryNextSpanSeedResponse) Reset() {
-	*x = QueryNextSpanSeedResponse{}
+func (x *QueryProducerPlannedDowntimesResponse) Reset() {
+	*x = QueryProducerPlannedDowntimesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[7]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryNextSpanSeedResponse) String() string {
+func (x *QueryProducerPlannedDowntimesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryNextSpanSeedResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryNextSpanSeedResponse.ProtoReflect.Descriptor instead.
-func (*QueryNextSpanSeedResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{7}
-}
+func (*QueryProducerPlannedDowntimesResponse) ProtoMessage() {}
 
-func (x *QueryNextSpanSeedResponse) GetSeed() string {
-	if x != nil {
-		return x.Seed
-	}
-	return ""
+// Deprecated: Use QueryProducerPlannedDowntimesResponse.ProtoReflect.Descriptor instead.
+func (*QueryProducerPlannedDowntimesResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryNextSpanSeedResponse) GetSeedAuthor() string {
+func (x *QueryProducerPlannedDowntimesResponse) GetDowntimeRanges() []*BlockRange {
 	if x != nil {
-		return x.SeedAuthor
+		return x.DowntimeRanges
 	}
-	return ""
+	return nil
 }
 
-// QueryNextSpanRequest is the request type for the GetNextSpan query.
-type QueryNextSpanRequest struct {
+// QueryValidatorPerformanceScoreRequest is the request type for the
+// GetValidatorPerformanceScore query.
+type QueryValidatorPerformanceScoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// ID for the next span to be created.
-	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
-	// Starting block number for the next span.
-	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
-	// Chain ID of the Bor chain.
-	BorChainId string `protobuf:"bytes,3,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
 }
 
-func (x *QueryNextSpanRequest) Reset() {
-	*x = QueryNextSpanRequest{}
+func (x *QueryValidatorPerformanceScoreRequest) Reset() {
+	*x = QueryValidatorPerformanceScoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[8]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryNextSpanRequest) String() string {
+func (x *QueryValidatorPerformanceScoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryNextSpanRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryNextSpanRequest.ProtoReflect.Descriptor instead.
-func (*QueryNextSpanRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *QueryNextSpanRequest) GetSpanId() uint64 {
-	if x != nil {
-		return x.SpanId
-	}
-	return 0
-}
-
-func (x *QueryNextSpanRequest) GetStartBlock() uint64 {
-	if x != nil {
-		return x.StartBlock
-	}
-	return 0
-}
+func (*QueryValidatorPerformanceScoreRequest) ProtoMessage() {}
 
-func (x *QueryNextSpanRequest) GetBorChainId() string {
-	if x != nil {
-		return x.BorChainId
-	}
-	return ""
+// Deprecated: Use QueryValidatorPerformanceScoreRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorPerformanceScoreRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{20}
 }
 
-// QueryNextSpanResponse is the response type for the GetNextSpan query.
-type QueryNextSpanResponse struct {
+// QueryValidatorPerformanceScoreResponse is the response type for the
+// GetValidatorPerformanceScore query.
+type QueryValidatorPerformanceScoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The prepared next span with selected producers.
-	Span *Span `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+	// Map of validator ID to their performance score.
+	// Higher scores indicate better block production reliability.
+	ValidatorPerformanceScore map[uint64]uint64 `protobuf:"bytes,1,rep,name=validator_performance_score,json=validatorPerformanceScore,proto3" json:"validator_performance_score,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *QueryNextSpanResponse) Reset() {
-	*x = QueryNextSpanResponse{}
+func (x *QueryValidatorPerformanceScoreResponse) Reset() {
+	*x = QueryValidatorPerformanceScoreResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[9]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryNextSpanResponse) String() string {
+func (x *QueryValidatorPerformanceScoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryNextSpanResponse) ProtoMessage() {}
+func (*QueryValidatorPerformanceScoreResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryNextSpanResponse.ProtoReflect.Descriptor instead.
-func (*QueryNextSpanResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryValidatorPerformanceScoreResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorPerformanceScoreResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryNextSpanResponse) GetSpan() *Span {
+func (x *QueryValidatorPerformanceScoreResponse) GetValidatorPerformanceScore() map[uint64]uint64 {
 	if x != nil {
-		return x.Span
+		return x.ValidatorPerformanceScore
 	}
 	return nil
 }
 
-// QueryParamsRequest is the request type for the GetBorParams query.
-type QueryParamsRequest struct {
+// QueryValidatorPerformanceHistoryRequest is the request type for the
+// GetValidatorPerformanceHistory query.
+type QueryValidatorPerformanceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the validator whose performance history to retrieve.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// First span ID of the range (inclusive).
+	FromSpanId uint64 `protobuf:"varint,2,opt,name=from_span_id,json=fromSpanId,proto3" json:"from_span_id,omitempty"`
+	// Last span ID of the range (inclusive).
+	ToSpanId uint64 `protobuf:"varint,3,opt,name=to_span_id,json=toSpanId,proto3" json:"to_span_id,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryValidatorPerformanceHistoryRequest) Reset() {
+	*x = QueryValidatorPerformanceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[10]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryValidatorPerformanceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryValidatorPerformanceHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryValidatorPerformanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorPerformanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{22}
 }
 
-// QueryParamsResponse is the response type for the GetBorParams query.
-type QueryParamsResponse struct {
+func (x *QueryValidatorPerformanceHistoryRequest) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *QueryValidatorPerformanceHistoryRequest) GetFromSpanId() uint64 {
+	if x != nil {
+		return x.FromSpanId
+	}
+	return 0
+}
+
+func (x *QueryValidatorPerformanceHistoryRequest) GetToSpanId() uint64 {
+	if x != nil {
+		return x.ToSpanId
+	}
+	return 0
+}
+
+// QueryValidatorPerformanceHistoryResponse is the response type for the
+// GetValidatorPerformanceHistory query.
+type QueryValidatorPerformanceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Current parameters of the bor module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Performance of the validator in each span of the range it was recorded
+	// for, ordered by span ID.
+	ValidatorPerformances []*ValidatorPerformance `protobuf:"bytes,1,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryValidatorPerformanceHistoryResponse) Reset() {
+	*x = QueryValidatorPerformanceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[11]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryValidatorPerformanceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryValidatorPerformanceHistoryResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryValidatorPerformanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorPerformanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryValidatorPerformanceHistoryResponse) GetValidatorPerformances() []*ValidatorPerformance {
 	if x != nil {
-		return x.Params
+		return x.ValidatorPerformances
 	}
 	return nil
 }
 
-// QueryProducerVotesRequest is the request type for the GetProducerVotes query.
-type QueryProducerVotesRequest struct {
+// SimulatedProducerVotes are the hypothetical producer votes of a validator.
+type SimulatedProducerVotes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the voting validator.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Validator IDs voted for, replacing the current votes of the validator.
+	// No votes removes the current votes of the validator.
+	Votes []uint64 `protobuf:"varint,2,rep,packed,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (x *QueryProducerVotesRequest) Reset() {
-	*x = QueryProducerVotesRequest{}
+func (x *SimulatedProducerVotes) Reset() {
+	*x = SimulatedProducerVotes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[12]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProducerVotesRequest) String() string {
+func (x *SimulatedProducerVotes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProducerVotesRequest) ProtoMessage() {}
+func (*SimulatedProducerVotes) ProtoMessage() {}
 
-// Deprecated: Use QueryProducerVotesRequest.ProtoReflect.Descriptor instead.
-func (*QueryProducerVotesRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SimulatedProducerVotes.ProtoReflect.Descriptor instead.
+func (*SimulatedProducerVotes) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{24}
 }
 
-// QueryProducerVotesResponse is the response type for the GetProducerVotes
-// query.
-type QueryProducerVotesResponse struct {
+func (x *SimulatedProducerVotes) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *SimulatedProducerVotes) GetVotes() []uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+// SimulatedProducerDowntime is a hypothetical planned downtime of a producer.
+type SimulatedProducerDowntime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Map of validator ID to their producer votes.
-	// Key: validator ID, Value: list of validator IDs they voted for.
-	AllVotes map[uint64]*ProducerVotes `protobuf:"bytes,1,rep,name=all_votes,json=allVotes,proto3" json:"all_votes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ID of the producer.
+	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Block range of the downtime, planned as with MsgSetProducerDowntime.
+	DowntimeRange *BlockRange `protobuf:"bytes,2,opt,name=downtime_range,json=downtimeRange,proto3" json:"downtime_range,omitempty"`
 }
 
-func (x *QueryProducerVotesResponse) Reset() {
-	*x = QueryProducerVotesResponse{}
+func (x *SimulatedProducerDowntime) Reset() {
+	*x = SimulatedProducerDowntime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[13]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProducerVotesResponse) String() string {
+func (x *SimulatedProducerDowntime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProducerVotesResponse) ProtoMessage() {}
+func (*SimulatedProducerDowntime) ProtoMessage() {}
 
-// Deprecated: Use QueryProducerVotesResponse.ProtoReflect.Descriptor instead.
-func (*QueryProducerVotesResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SimulatedProducerDowntime.ProtoReflect.Descriptor instead.
+func (*SimulatedProducerDowntime) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryProducerVotesResponse) GetAllVotes() map[uint64]*ProducerVotes {
+func (x *SimulatedProducerDowntime) GetProducerId() uint64 {
 	if x != nil {
-		return x.AllVotes
+		return x.ProducerId
+	}
+	return 0
+}
+
+func (x *SimulatedProducerDowntime) GetDowntimeRange() *BlockRange {
+	if x != nil {
+		return x.DowntimeRange
 	}
 	return nil
 }
 
-// QueryProducerVotesByValidatorIdRequest is the request type for the
-// GetProducerVotesByValidatorId query.
-type QueryProducerVotesByValidatorIdRequest struct {
+// ProducerScore is the weighted score of a producer candidate in the
+// election of the producer set.
+type ProducerScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the validator whose votes to retrieve.
+	// ID of the candidate.
 	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Sum of the stake of the validators voting for the candidate, weighted by
+	// the position of the candidate in their votes.
+	Score int64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Score required for the candidate to enter the producer set at its rank.
+	RequiredScore int64 `protobuf:"varint,3,opt,name=required_score,json=requiredScore,proto3" json:"required_score,omitempty"`
 }
 
-func (x *QueryProducerVotesByValidatorIdRequest) Reset() {
-	*x = QueryProducerVotesByValidatorIdRequest{}
+func (x *ProducerScore) Reset() {
+	*x = ProducerScore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[14]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProducerVotesByValidatorIdRequest) String() string {
+func (x *ProducerScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProducerVotesByValidatorIdRequest) ProtoMessage() {}
+func (*ProducerScore) ProtoMessage() {}
 
-// Deprecated: Use QueryProducerVotesByValidatorIdRequest.ProtoReflect.Descriptor instead.
-func (*QueryProducerVotesByValidatorIdRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ProducerScore.ProtoReflect.Descriptor instead.
+func (*ProducerScore) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProducerScore) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *ProducerScore) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
 }
 
-func (x *QueryProducerVotesByValidatorIdRequest) GetValidatorId() uint64 {
+func (x *ProducerScore) GetRequiredScore() int64 {
 	if x != nil {
-		return x.ValidatorId
+		return x.RequiredScore
 	}
 	return 0
 }
 
-// QueryProducerVotesByValidatorIdResponse is the response type for the
-// GetProducerVotesByValidatorId query.
-type QueryProducerVotesByValidatorIdResponse struct {
+// QuerySimulateProducerSetRequest is the request type for the
+// SimulateProducerSet query.
+type QuerySimulateProducerSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of validator IDs that the queried validator voted for.
-	Votes []uint64 `protobuf:"varint,1,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+	// Hypothetical producer votes.
+	ProducerVotes []*SimulatedProducerVotes `protobuf:"bytes,1,rep,name=producer_votes,json=producerVotes,proto3" json:"producer_votes,omitempty"`
+	// Hypothetical planned downtimes.
+	ProducerDowntimes []*SimulatedProducerDowntime `protobuf:"bytes,2,rep,name=producer_downtimes,json=producerDowntimes,proto3" json:"producer_downtimes,omitempty"`
 }
 
-func (x *QueryProducerVotesByValidatorIdResponse) Reset() {
-	*x = QueryProducerVotesByValidatorIdResponse{}
+func (x *QuerySimulateProducerSetRequest) Reset() {
+	*x = QuerySimulateProducerSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[15]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProducerVotesByValidatorIdResponse) String() string {
+func (x *QuerySimulateProducerSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProducerVotesByValidatorIdResponse) ProtoMessage() {}
+func (*QuerySimulateProducerSetRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryProducerVotesByValidatorIdResponse.ProtoReflect.Descriptor instead.
-func (*QueryProducerVotesByValidatorIdResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QuerySimulateProducerSetRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateProducerSetRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryProducerVotesByValidatorIdResponse) GetVotes() []uint64 {
+func (x *QuerySimulateProducerSetRequest) GetProducerVotes() []*SimulatedProducerVotes {
 	if x != nil {
-		return x.Votes
+		return x.ProducerVotes
 	}
 	return nil
 }
 
-// QueryProducerPlannedDowntimeRequest is the request type for the
-// GetProducerPlannedDowntime query.
-type QueryProducerPlannedDowntimeRequest struct {
+func (x *QuerySimulateProducerSetRequest) GetProducerDowntimes() []*SimulatedProducerDowntime {
+	if x != nil {
+		return x.ProducerDowntimes
+	}
+	return nil
+}
+
+// QuerySimulateProducerSetResponse is the response type for the
+// SimulateProducerSet query.
+type QuerySimulateProducerSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the producer whose planned downtime to retrieve.
-	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Weighted scores of the candidates, ranked.
+	ProducerScores []*ProducerScore `protobuf:"bytes,1,rep,name=producer_scores,json=producerScores,proto3" json:"producer_scores,omitempty"`
+	// Producer set computed from the scores.
+	ProducerSet []uint64 `protobuf:"varint,2,rep,packed,name=producer_set,json=producerSet,proto3" json:"producer_set,omitempty"`
+	// Producer of the current span, replaced by the next producer.
+	CurrentProducer uint64 `protobuf:"varint,3,opt,name=current_producer,json=currentProducer,proto3" json:"current_producer,omitempty"`
+	// Block range of the next span.
+	SpanRange *BlockRange `protobuf:"bytes,4,opt,name=span_range,json=spanRange,proto3" json:"span_range,omitempty"`
+	// Producer selected for the next span.
+	NextProducer uint64 `protobuf:"varint,5,opt,name=next_producer,json=nextProducer,proto3" json:"next_producer,omitempty"`
 }
 
-func (x *QueryProducerPlannedDowntimeRequest) Reset() {
-	*x = QueryProducerPlannedDowntimeRequest{}
+func (x *QuerySimulateProducerSetResponse) Reset() {
+	*x = QuerySimulateProducerSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[16]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProducerPlannedDowntimeRequest) String() string {
+func (x *QuerySimulateProducerSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProducerPlannedDowntimeRequest) ProtoMessage() {}
+func (*QuerySimulateProducerSetResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryProducerPlannedDowntimeRequest.ProtoReflect.Descriptor instead.
-func (*QueryProducerPlannedDowntimeRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QuerySimulateProducerSetResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateProducerSetResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryProducerPlannedDowntimeRequest) GetProducerId() uint64 {
+func (x *QuerySimulateProducerSetResponse) GetProducerScores() []*ProducerScore {
 	if x != nil {
-		return x.ProducerId
+		return x.ProducerScores
 	}
-	return 0
-}
-
-// QueryProducerPlannedDowntimeResponse is the response type for the
-// GetProducerPlannedDowntime query.
-type QueryProducerPlannedDowntimeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Block range during which the producer has planned downtime.
-	DowntimeRange *BlockRange `protobuf:"bytes,1,opt,name=downtime_range,json=downtimeRange,proto3" json:"downtime_range,omitempty"`
+	return nil
 }
 
-func (x *QueryProducerPlannedDowntimeResponse) Reset() {
-	*x = QueryProducerPlannedDowntimeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulateProducerSetResponse) GetProducerSet() []uint64 {
+	if x != nil {
+		return x.ProducerSet
 	}
+	return nil
 }
 
-func (x *QueryProducerPlannedDowntimeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulateProducerSetResponse) GetCurrentProducer() uint64 {
+	if x != nil {
+		return x.CurrentProducer
+	}
+	return 0
 }
 
-func (*QueryProducerPlannedDowntimeResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryProducerPlannedDowntimeResponse.ProtoReflect.Descriptor instead.
-func (*QueryProducerPlannedDowntimeResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{17}
+func (x *QuerySimulateProducerSetResponse) GetSpanRange() *BlockRange {
+	if x != nil {
+		return x.SpanRange
+	}
+	return nil
 }
 
-func (x *QueryProducerPlannedDowntimeResponse) GetDowntimeRange() *BlockRange {
+func (x *QuerySimulateProducerSetResponse) GetNextProducer() uint64 {
 	if x != nil {
-		return x.DowntimeRange
+		return x.NextProducer
 	}
-	return nil
+	return 0
 }
 
-// QueryValidatorPerformanceScoreRequest is the request type for the
-// GetValidatorPerformanceScore query.
-type QueryValidatorPerformanceScoreRequest struct {
+// QuerySpanSummaryByIdRequest is the request type for the GetSpanSummaryById
+// query.
+type QuerySpanSummaryByIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the pruned span.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryValidatorPerformanceScoreRequest) Reset() {
-	*x = QueryValidatorPerformanceScoreRequest{}
+func (x *QuerySpanSummaryByIdRequest) Reset() {
+	*x = QuerySpanSummaryByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[18]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorPerformanceScoreRequest) String() string {
+func (x *QuerySpanSummaryByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorPerformanceScoreRequest) ProtoMessage() {}
+func (*QuerySpanSummaryByIdRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorPerformanceScoreRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorPerformanceScoreRequest) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QuerySpanSummaryByIdRequest.ProtoReflect.Descriptor instead.
+func (*QuerySpanSummaryByIdRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{29}
 }
 
-// QueryValidatorPerformanceScoreResponse is the response type for the
-// GetValidatorPerformanceScore query.
-type QueryValidatorPerformanceScoreResponse struct {
+func (x *QuerySpanSummaryByIdRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// QuerySpanSummaryByIdResponse is the response type for the
+// GetSpanSummaryById query.
+type QuerySpanSummaryByIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Map of validator ID to their performance score.
-	// Higher scores indicate better block production reliability.
-	ValidatorPerformanceScore map[uint64]uint64 `protobuf:"bytes,1,rep,name=validator_performance_score,json=validatorPerformanceScore,proto3" json:"validator_performance_score,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Summary of the pruned span.
+	SpanSummary *SpanSummary `protobuf:"bytes,1,opt,name=span_summary,json=spanSummary,proto3" json:"span_summary,omitempty"`
 }
 
-func (x *QueryValidatorPerformanceScoreResponse) Reset() {
-	*x = QueryValidatorPerformanceScoreResponse{}
+func (x *QuerySpanSummaryByIdResponse) Reset() {
+	*x = QuerySpanSummaryByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_bor_query_proto_msgTypes[19]
+		mi := &file_heimdallv2_bor_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorPerformanceScoreResponse) String() string {
+func (x *QuerySpanSummaryByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorPerformanceScoreResponse) ProtoMessage() {}
+func (*QuerySpanSummaryByIdResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorPerformanceScoreResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorPerformanceScoreResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QuerySpanSummaryByIdResponse.ProtoReflect.Descriptor instead.
+func (*QuerySpanSummaryByIdResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_bor_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryValidatorPerformanceScoreResponse) GetValidatorPerformanceScore() map[uint64]uint64 {
+func (x *QuerySpanSummaryByIdResponse) GetSpanSummary() *SpanSummary {
 	if x != nil {
-		return x.ValidatorPerformanceScore
+		return x.SpanSummary
 	}
 	return nil
 }
@@ -9963,132 +16260,279 @@ var file_heimdallv2_bor_query_proto_rawDesc = []byte{
 	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
 	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
 	0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x27,
-	0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x1b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x4e,
+	0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x64, 0x22, 0x77,
+	0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
+	0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x99, 0x02, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x1b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x55, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62,
+	0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x19, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x4c,
+	0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x01, 0x0a,
+	0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x74,
+	0x6f, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x92, 0x01, 0x0a, 0x28, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x56,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x4c, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x32, 0xd1, 0x0b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x78, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x68,
-	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
-	0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x88, 0xe7, 0xb0, 0x2a, 0x01,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61,
-	0x6e, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x68, 0x65, 0x69, 0x6d,
-	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62,
+	0x6e, 0x63, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x16, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0e,
+	0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x64, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0xe0, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x63, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x44, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x62, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x6e,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x70, 0x61, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x1b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x69, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b,
+	0x73, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x32, 0xab, 0x11, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x78, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x70, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
+	0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x80, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61,
+	0x6e, 0x12, 0x26, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62,
 	0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x88, 0xe7, 0xb0, 0x2a,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70,
-	0x61, 0x6e, 0x73, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x12, 0x28,
-	0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x65, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e,
-	0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x21, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
-	0x12, 0x14, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x73, 0x65, 0x65,
-	0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78,
-	0x74, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
-	0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74,
-	0x53, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65,
-	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
-	0x12, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x12, 0x78, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79,
-	0x49, 0x64, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
-	0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x70, 0x61, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1c, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x62,
-	0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
+	0x11, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70,
+	0x61, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78,
+	0x74, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x53,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x88, 0xe7, 0xb0,
+	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73,
+	0x70, 0x61, 0x6e, 0x73, 0x2f, 0x73, 0x65, 0x65, 0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7b,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x24, 0x2e,
 	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62,
-	0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
-	0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
-	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x88, 0xe7,
-	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x62, 0x6f, 0x72, 0x2f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0xc1,
-	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f,
-	0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x36, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
-	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32,
+	0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x70,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x88, 0xe7, 0xb0, 0x2a,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70,
+	0x61, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x78, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x62, 0x6f,
+	0x72, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x2d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
 	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
 	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2f, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
-	0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x2d, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0xc3, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x33, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62,
-	0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x88, 0xe7, 0xb0, 0x2a, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x2d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc3, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x2e, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44,
+	0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x12, 0x2d, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x2d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0xc7, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x12, 0x34, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
 	0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
 	0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x88, 0xe7,
-	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x62, 0x6f, 0x72, 0x2f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x64, 0x2d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x2e, 0x68, 0x65, 0x69, 0x6d,
-	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x36, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f,
-	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x2d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0xae, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x42, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67,
-	0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x62, 0x6f,
-	0x72, 0xa2, 0x02, 0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
-	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x72, 0xca, 0x02, 0x0e, 0x48, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0xe2, 0x02, 0x1a, 0x48, 0x65, 0x69, 0x6d,
-	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
-	0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x42, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x88,
+	0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x62, 0x6f, 0x72,
+	0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x2d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x88, 0xe7, 0xb0, 0x2a,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x2d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0xd3, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x62,
+	0x6f, 0x72, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x70, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x9f, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x2f, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61,
+	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x95, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x70, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x6e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x24, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x12, 0x17, 0x2f, 0x62, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x2f, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xae, 0x01, 0x0a, 0x12, 0x63, 0x6f,
+	0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x62, 0x6f, 0x72,
+	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c,
+	0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f,
+	0x62, 0x6f, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x42, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x72, 0xca, 0x02, 0x0e, 0x48, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0xe2, 0x02, 0x1a, 0x48, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x42, 0x6f, 0x72, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x42, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -10103,74 +16547,103 @@ func file_heimdallv2_bor_query_proto_rawDescGZIP() []byte {
 	return file_heimdallv2_bor_query_proto_rawDescData
 }
 
-var file_heimdallv2_bor_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_heimdallv2_bor_query_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_heimdallv2_bor_query_proto_goTypes = []interface{}{
-	(*QuerySpanByIdRequest)(nil),                    // 0: heimdallv2.bor.QuerySpanByIdRequest
-	(*QuerySpanByIdResponse)(nil),                   // 1: heimdallv2.bor.QuerySpanByIdResponse
-	(*QuerySpanListRequest)(nil),                    // 2: heimdallv2.bor.QuerySpanListRequest
-	(*QuerySpanListResponse)(nil),                   // 3: heimdallv2.bor.QuerySpanListResponse
-	(*QueryLatestSpanRequest)(nil),                  // 4: heimdallv2.bor.QueryLatestSpanRequest
-	(*QueryLatestSpanResponse)(nil),                 // 5: heimdallv2.bor.QueryLatestSpanResponse
-	(*QueryNextSpanSeedRequest)(nil),                // 6: heimdallv2.bor.QueryNextSpanSeedRequest
-	(*QueryNextSpanSeedResponse)(nil),               // 7: heimdallv2.bor.QueryNextSpanSeedResponse
-	(*QueryNextSpanRequest)(nil),                    // 8: heimdallv2.bor.QueryNextSpanRequest
-	(*QueryNextSpanResponse)(nil),                   // 9: heimdallv2.bor.QueryNextSpanResponse
-	(*QueryParamsRequest)(nil),                      // 10: heimdallv2.bor.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                     // 11: heimdallv2.bor.QueryParamsResponse
-	(*QueryProducerVotesRequest)(nil),               // 12: heimdallv2.bor.QueryProducerVotesRequest
-	(*QueryProducerVotesResponse)(nil),              // 13: heimdallv2.bor.QueryProducerVotesResponse
-	(*QueryProducerVotesByValidatorIdRequest)(nil),  // 14: heimdallv2.bor.QueryProducerVotesByValidatorIdRequest
-	(*QueryProducerVotesByValidatorIdResponse)(nil), // 15: heimdallv2.bor.QueryProducerVotesByValidatorIdResponse
-	(*QueryProducerPlannedDowntimeRequest)(nil),     // 16: heimdallv2.bor.QueryProducerPlannedDowntimeRequest
-	(*QueryProducerPlannedDowntimeResponse)(nil),    // 17: heimdallv2.bor.QueryProducerPlannedDowntimeResponse
-	(*QueryValidatorPerformanceScoreRequest)(nil),   // 18: heimdallv2.bor.QueryValidatorPerformanceScoreRequest
-	(*QueryValidatorPerformanceScoreResponse)(nil),  // 19: heimdallv2.bor.QueryValidatorPerformanceScoreResponse
-	nil,                          // 20: heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry
-	nil,                          // 21: heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry
-	(*Span)(nil),                 // 22: heimdallv2.bor.Span
-	(*v1beta1.PageRequest)(nil),  // 23: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil), // 24: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),               // 25: heimdallv2.bor.Params
-	(*BlockRange)(nil),           // 26: heimdallv2.bor.BlockRange
-	(*ProducerVotes)(nil),        // 27: heimdallv2.bor.ProducerVotes
+	(*QuerySpanByIdRequest)(nil),                     // 0: heimdallv2.bor.QuerySpanByIdRequest
+	(*QuerySpanByIdResponse)(nil),                    // 1: heimdallv2.bor.QuerySpanByIdResponse
+	(*QuerySpanListRequest)(nil),                     // 2: heimdallv2.bor.QuerySpanListRequest
+	(*QuerySpanListResponse)(nil),                    // 3: heimdallv2.bor.QuerySpanListResponse
+	(*QueryLatestSpanRequest)(nil),                   // 4: heimdallv2.bor.QueryLatestSpanRequest
+	(*QueryLatestSpanResponse)(nil),                  // 5: heimdallv2.bor.QueryLatestSpanResponse
+	(*QueryNextSpanSeedRequest)(nil),                 // 6: heimdallv2.bor.QueryNextSpanSeedRequest
+	(*QueryNextSpanSeedResponse)(nil),                // 7: heimdallv2.bor.QueryNextSpanSeedResponse
+	(*QueryNextSpanRequest)(nil),                     // 8: heimdallv2.bor.QueryNextSpanRequest
+	(*QueryNextSpanResponse)(nil),                    // 9: heimdallv2.bor.QueryNextSpanResponse
+	(*QueryParamsRequest)(nil),                       // 10: heimdallv2.bor.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                      // 11: heimdallv2.bor.QueryParamsResponse
+	(*QueryProducerVotesRequest)(nil),                // 12: heimdallv2.bor.QueryProducerVotesRequest
+	(*QueryProducerVotesResponse)(nil),               // 13: heimdallv2.bor.QueryProducerVotesResponse
+	(*QueryProducerVotesByValidatorIdRequest)(nil),   // 14: heimdallv2.bor.QueryProducerVotesByValidatorIdRequest
+	(*QueryProducerVotesByValidatorIdResponse)(nil),  // 15: heimdallv2.bor.QueryProducerVotesByValidatorIdResponse
+	(*QueryProducerPlannedDowntimeRequest)(nil),      // 16: heimdallv2.bor.QueryProducerPlannedDowntimeRequest
+	(*QueryProducerPlannedDowntimeResponse)(nil),     // 17: heimdallv2.bor.QueryProducerPlannedDowntimeResponse
+	(*QueryProducerPlannedDowntimesRequest)(nil),     // 18: heimdallv2.bor.QueryProducerPlannedDowntimesRequest
+	(*QueryProducerPlannedDowntimesResponse)(nil),    // 19: heimdallv2.bor.QueryProducerPlannedDowntimesResponse
+	(*QueryValidatorPerformanceScoreRequest)(nil),    // 20: heimdallv2.bor.QueryValidatorPerformanceScoreRequest
+	(*QueryValidatorPerformanceScoreResponse)(nil),   // 21: heimdallv2.bor.QueryValidatorPerformanceScoreResponse
+	(*QueryValidatorPerformanceHistoryRequest)(nil),  // 22: heimdallv2.bor.QueryValidatorPerformanceHistoryRequest
+	(*QueryValidatorPerformanceHistoryResponse)(nil), // 23: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse
+	(*SimulatedProducerVotes)(nil),                   // 24: heimdallv2.bor.SimulatedProducerVotes
+	(*SimulatedProducerDowntime)(nil),                // 25: heimdallv2.bor.SimulatedProducerDowntime
+	(*ProducerScore)(nil),                            // 26: heimdallv2.bor.ProducerScore
+	(*QuerySimulateProducerSetRequest)(nil),          // 27: heimdallv2.bor.QuerySimulateProducerSetRequest
+	(*QuerySimulateProducerSetResponse)(nil),         // 28: heimdallv2.bor.QuerySimulateProducerSetResponse
+	(*QuerySpanSummaryByIdRequest)(nil),              // 29: heimdallv2.bor.QuerySpanSummaryByIdRequest
+	(*QuerySpanSummaryByIdResponse)(nil),             // 30: heimdallv2.bor.QuerySpanSummaryByIdResponse
+	nil,                                              // 31: heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry
+	nil,                                              // 32: heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry
+	(*Span)(nil),                                     // 33: heimdallv2.bor.Span
+	(*v1beta1.PageRequest)(nil),                      // 34: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                     // 35: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                                   // 36: heimdallv2.bor.Params
+	(*BlockRange)(nil),                               // 37: heimdallv2.bor.BlockRange
+	(*ValidatorPerformance)(nil),                     // 38: heimdallv2.bor.ValidatorPerformance
+	(*SpanSummary)(nil),                              // 39: heimdallv2.bor.SpanSummary
+	(*ProducerVotes)(nil),                            // 40: heimdallv2.bor.ProducerVotes
 }
 var file_heimdallv2_bor_query_proto_depIdxs = []int32{
-	22, // 0: heimdallv2.bor.QuerySpanByIdResponse.span:type_name -> heimdallv2.bor.Span
-	23, // 1: heimdallv2.bor.QuerySpanListRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 2: heimdallv2.bor.QuerySpanListResponse.span_list:type_name -> heimdallv2.bor.Span
-	24, // 3: heimdallv2.bor.QuerySpanListResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	22, // 4: heimdallv2.bor.QueryLatestSpanResponse.span:type_name -> heimdallv2.bor.Span
-	22, // 5: heimdallv2.bor.QueryNextSpanResponse.span:type_name -> heimdallv2.bor.Span
-	25, // 6: heimdallv2.bor.QueryParamsResponse.params:type_name -> heimdallv2.bor.Params
-	20, // 7: heimdallv2.bor.QueryProducerVotesResponse.all_votes:type_name -> heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry
-	26, // 8: heimdallv2.bor.QueryProducerPlannedDowntimeResponse.downtime_range:type_name -> heimdallv2.bor.BlockRange
-	21, // 9: heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score:type_name -> heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry
-	27, // 10: heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry.value:type_name -> heimdallv2.bor.ProducerVotes
-	2,  // 11: heimdallv2.bor.Query.GetSpanList:input_type -> heimdallv2.bor.QuerySpanListRequest
-	4,  // 12: heimdallv2.bor.Query.GetLatestSpan:input_type -> heimdallv2.bor.QueryLatestSpanRequest
-	6,  // 13: heimdallv2.bor.Query.GetNextSpanSeed:input_type -> heimdallv2.bor.QueryNextSpanSeedRequest
-	8,  // 14: heimdallv2.bor.Query.GetNextSpan:input_type -> heimdallv2.bor.QueryNextSpanRequest
-	0,  // 15: heimdallv2.bor.Query.GetSpanById:input_type -> heimdallv2.bor.QuerySpanByIdRequest
-	10, // 16: heimdallv2.bor.Query.GetBorParams:input_type -> heimdallv2.bor.QueryParamsRequest
-	12, // 17: heimdallv2.bor.Query.GetProducerVotes:input_type -> heimdallv2.bor.QueryProducerVotesRequest
-	14, // 18: heimdallv2.bor.Query.GetProducerVotesByValidatorId:input_type -> heimdallv2.bor.QueryProducerVotesByValidatorIdRequest
-	16, // 19: heimdallv2.bor.Query.GetProducerPlannedDowntime:input_type -> heimdallv2.bor.QueryProducerPlannedDowntimeRequest
-	18, // 20: heimdallv2.bor.Query.GetValidatorPerformanceScore:input_type -> heimdallv2.bor.QueryValidatorPerformanceScoreRequest
-	3,  // 21: heimdallv2.bor.Query.GetSpanList:output_type -> heimdallv2.bor.QuerySpanListResponse
-	5,  // 22: heimdallv2.bor.Query.GetLatestSpan:output_type -> heimdallv2.bor.QueryLatestSpanResponse
-	7,  // 23: heimdallv2.bor.Query.GetNextSpanSeed:output_type -> heimdallv2.bor.QueryNextSpanSeedResponse
-	9,  // 24: heimdallv2.bor.Query.GetNextSpan:output_type -> heimdallv2.bor.QueryNextSpanResponse
-	1,  // 25: heimdallv2.bor.Query.GetSpanById:output_type -> heimdallv2.bor.QuerySpanByIdResponse
-	11, // 26: heimdallv2.bor.Query.GetBorParams:output_type -> heimdallv2.bor.QueryParamsResponse
-	13, // 27: heimdallv2.bor.Query.GetProducerVotes:output_type -> heimdallv2.bor.QueryProducerVotesResponse
-	15, // 28: heimdallv2.bor.Query.GetProducerVotesByValidatorId:output_type -> heimdallv2.bor.QueryProducerVotesByValidatorIdResponse
-	17, // 29: heimdallv2.bor.Query.GetProducerPlannedDowntime:output_type -> heimdallv2.bor.QueryProducerPlannedDowntimeResponse
-	19, // 30: heimdallv2.bor.Query.GetValidatorPerformanceScore:output_type -> heimdallv2.bor.QueryValidatorPerformanceScoreResponse
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	33, // 0: heimdallv2.bor.QuerySpanByIdResponse.span:type_name -> heimdallv2.bor.Span
+	34, // 1: heimdallv2.bor.QuerySpanListRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	33, // 2: heimdallv2.bor.QuerySpanListResponse.span_list:type_name -> heimdallv2.bor.Span
+	35, // 3: heimdallv2.bor.QuerySpanListResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	33, // 4: heimdallv2.bor.QueryLatestSpanResponse.span:type_name -> heimdallv2.bor.Span
+	33, // 5: heimdallv2.bor.QueryNextSpanResponse.span:type_name -> heimdallv2.bor.Span
+	36, // 6: heimdallv2.bor.QueryParamsResponse.params:type_name -> heimdallv2.bor.Params
+	31, // 7: heimdallv2.bor.QueryProducerVotesResponse.all_votes:type_name -> heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry
+	37, // 8: heimdallv2.bor.QueryProducerPlannedDowntimeResponse.downtime_range:type_name -> heimdallv2.bor.BlockRange
+	37, // 9: heimdallv2.bor.QueryProducerPlannedDowntimesResponse.downtime_ranges:type_name -> heimdallv2.bor.BlockRange
+	32, // 10: heimdallv2.bor.QueryValidatorPerformanceScoreResponse.validator_performance_score:type_name -> heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry
+	38, // 11: heimdallv2.bor.QueryValidatorPerformanceHistoryResponse.validator_performances:type_name -> heimdallv2.bor.ValidatorPerformance
+	37, // 12: heimdallv2.bor.SimulatedProducerDowntime.downtime_range:type_name -> heimdallv2.bor.BlockRange
+	24, // 13: heimdallv2.bor.QuerySimulateProducerSetRequest.producer_votes:type_name -> heimdallv2.bor.SimulatedProducerVotes
+	25, // 14: heimdallv2.bor.QuerySimulateProducerSetRequest.producer_downtimes:type_name -> heimdallv2.bor.SimulatedProducerDowntime
+	26, // 15: heimdallv2.bor.QuerySimulateProducerSetResponse.producer_scores:type_name -> heimdallv2.bor.ProducerScore
+	37, // 16: heimdallv2.bor.QuerySimulateProducerSetResponse.span_range:type_name -> heimdallv2.bor.BlockRange
+	39, // 17: heimdallv2.bor.QuerySpanSummaryByIdResponse.span_summary:type_name -> heimdallv2.bor.SpanSummary
+	40, // 18: heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry.value:type_name -> heimdallv2.bor.ProducerVotes
+	2,  // 19: heimdallv2.bor.Query.GetSpanList:input_type -> heimdallv2.bor.QuerySpanListRequest
+	4,  // 20: heimdallv2.bor.Query.GetLatestSpan:input_type -> heimdallv2.bor.QueryLatestSpanRequest
+	6,  // 21: heimdallv2.bor.Query.GetNextSpanSeed:input_type -> heimdallv2.bor.QueryNextSpanSeedRequest
+	8,  // 22: heimdallv2.bor.Query.GetNextSpan:input_type -> heimdallv2.bor.QueryNextSpanRequest
+	0,  // 23: heimdallv2.bor.Query.GetSpanById:input_type -> heimdallv2.bor.QuerySpanByIdRequest
+	10, // 24: heimdallv2.bor.Query.GetBorParams:input_type -> heimdallv2.bor.QueryParamsRequest
+	12, // 25: heimdallv2.bor.Query.GetProducerVotes:input_type -> heimdallv2.bor.QueryProducerVotesRequest
+	14, // 26: heimdallv2.bor.Query.GetProducerVotesByValidatorId:input_type -> heimdallv2.bor.QueryProducerVotesByValidatorIdRequest
+	16, // 27: heimdallv2.bor.Query.GetProducerPlannedDowntime:input_type -> heimdallv2.bor.QueryProducerPlannedDowntimeRequest
+	18, // 28: heimdallv2.bor.Query.GetProducerPlannedDowntimes:input_type -> heimdallv2.bor.QueryProducerPlannedDowntimesRequest
+	20, // 29: heimdallv2.bor.Query.GetValidatorPerformanceScore:input_type -> heimdallv2.bor.QueryValidatorPerformanceScoreRequest
+	22, // 30: heimdallv2.bor.Query.GetValidatorPerformanceHistory:input_type -> heimdallv2.bor.QueryValidatorPerformanceHistoryRequest
+	27, // 31: heimdallv2.bor.Query.SimulateProducerSet:input_type -> heimdallv2.bor.QuerySimulateProducerSetRequest
+	29, // 32: heimdallv2.bor.Query.GetSpanSummaryById:input_type -> heimdallv2.bor.QuerySpanSummaryByIdRequest
+	3,  // 33: heimdallv2.bor.Query.GetSpanList:output_type -> heimdallv2.bor.QuerySpanListResponse
+	5,  // 34: heimdallv2.bor.Query.GetLatestSpan:output_type -> heimdallv2.bor.QueryLatestSpanResponse
+	7,  // 35: heimdallv2.bor.Query.GetNextSpanSeed:output_type -> heimdallv2.bor.QueryNextSpanSeedResponse
+	9,  // 36: heimdallv2.bor.Query.GetNextSpan:output_type -> heimdallv2.bor.QueryNextSpanResponse
+	1,  // 37: heimdallv2.bor.Query.GetSpanById:output_type -> heimdallv2.bor.QuerySpanByIdResponse
+	11, // 38: heimdallv2.bor.Query.GetBorParams:output_type -> heimdallv2.bor.QueryParamsResponse
+	13, // 39: heimdallv2.bor.Query.GetProducerVotes:output_type -> heimdallv2.bor.QueryProducerVotesResponse
+	15, // 40: heimdallv2.bor.Query.GetProducerVotesByValidatorId:output_type -> heimdallv2.bor.QueryProducerVotesByValidatorIdResponse
+	17, // 41: heimdallv2.bor.Query.GetProducerPlannedDowntime:output_type -> heimdallv2.bor.QueryProducerPlannedDowntimeResponse
+	19, // 42: heimdallv2.bor.Query.GetProducerPlannedDowntimes:output_type -> heimdallv2.bor.QueryProducerPlannedDowntimesResponse
+	21, // 43: heimdallv2.bor.Query.GetValidatorPerformanceScore:output_type -> heimdallv2.bor.QueryValidatorPerformanceScoreResponse
+	23, // 44: heimdallv2.bor.Query.GetValidatorPerformanceHistory:output_type -> heimdallv2.bor.QueryValidatorPerformanceHistoryResponse
+	28, // 45: heimdallv2.bor.Query.SimulateProducerSet:output_type -> heimdallv2.bor.QuerySimulateProducerSetResponse
+	30, // 46: heimdallv2.bor.Query.GetSpanSummaryById:output_type -> heimdallv2.bor.QuerySpanSummaryByIdResponse
+	33, // [33:47] is the sub-list for method output_type
+	19, // [19:33] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_bor_query_proto_init() }
@@ -10397,7 +16870,7 @@ func file_heimdallv2_bor_query_proto_init() {
 			}
 		}
 		file_heimdallv2_bor_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorPerformanceScoreRequest); i {
+			switch v := v.(*QueryProducerPlannedDowntimesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10409,6 +16882,30 @@ func file_heimdallv2_bor_query_proto_init() {
 			}
 		}
 		file_heimdallv2_bor_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProducerPlannedDowntimesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorPerformanceScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryValidatorPerformanceScoreResponse); i {
 			case 0:
 				return &v.state
@@ -10420,6 +16917,114 @@ func file_heimdallv2_bor_query_proto_init() {
 				return nil
 			}
 		}
+		file_heimdallv2_bor_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorPerformanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorPerformanceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedProducerVotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedProducerDowntime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducerScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateProducerSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateProducerSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySpanSummaryByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_bor_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySpanSummaryByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10427,7 +17032,7 @@ func file_heimdallv2_bor_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_heimdallv2_bor_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
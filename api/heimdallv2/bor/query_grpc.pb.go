@@ -19,16 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GetSpanList_FullMethodName                   = "/heimdallv2.bor.Query/GetSpanList"
-	Query_GetLatestSpan_FullMethodName                 = "/heimdallv2.bor.Query/GetLatestSpan"
-	Query_GetNextSpanSeed_FullMethodName               = "/heimdallv2.bor.Query/GetNextSpanSeed"
-	Query_GetNextSpan_FullMethodName                   = "/heimdallv2.bor.Query/GetNextSpan"
-	Query_GetSpanById_FullMethodName                   = "/heimdallv2.bor.Query/GetSpanById"
-	Query_GetBorParams_FullMethodName                  = "/heimdallv2.bor.Query/GetBorParams"
-	Query_GetProducerVotes_FullMethodName              = "/heimdallv2.bor.Query/GetProducerVotes"
-	Query_GetProducerVotesByValidatorId_FullMethodName = "/heimdallv2.bor.Query/GetProducerVotesByValidatorId"
-	Query_GetProducerPlannedDowntime_FullMethodName    = "/heimdallv2.bor.Query/GetProducerPlannedDowntime"
-	Query_GetValidatorPerformanceScore_FullMethodName  = "/heimdallv2.bor.Query/GetValidatorPerformanceScore"
+	Query_GetSpanList_FullMethodName                    = "/heimdallv2.bor.Query/GetSpanList"
+	Query_GetLatestSpan_FullMethodName                  = "/heimdallv2.bor.Query/GetLatestSpan"
+	Query_GetNextSpanSeed_FullMethodName                = "/heimdallv2.bor.Query/GetNextSpanSeed"
+	Query_GetNextSpan_FullMethodName                    = "/heimdallv2.bor.Query/GetNextSpan"
+	Query_GetSpanById_FullMethodName                    = "/heimdallv2.bor.Query/GetSpanById"
+	Query_GetBorParams_FullMethodName                   = "/heimdallv2.bor.Query/GetBorParams"
+	Query_GetProducerVotes_FullMethodName               = "/heimdallv2.bor.Query/GetProducerVotes"
+	Query_GetProducerVotesByValidatorId_FullMethodName  = "/heimdallv2.bor.Query/GetProducerVotesByValidatorId"
+	Query_GetProducerPlannedDowntime_FullMethodName     = "/heimdallv2.bor.Query/GetProducerPlannedDowntime"
+	Query_GetProducerPlannedDowntimes_FullMethodName    = "/heimdallv2.bor.Query/GetProducerPlannedDowntimes"
+	Query_GetValidatorPerformanceScore_FullMethodName   = "/heimdallv2.bor.Query/GetValidatorPerformanceScore"
+	Query_GetValidatorPerformanceHistory_FullMethodName = "/heimdallv2.bor.Query/GetValidatorPerformanceHistory"
+	Query_SimulateProducerSet_FullMethodName            = "/heimdallv2.bor.Query/SimulateProducerSet"
+	Query_GetSpanSummaryById_FullMethodName             = "/heimdallv2.bor.Query/GetSpanSummaryById"
 )
 
 // QueryClient is the client API for Query service.
@@ -57,11 +61,25 @@ type QueryClient interface {
 	GetProducerVotesByValidatorId(ctx context.Context, in *QueryProducerVotesByValidatorIdRequest, opts ...grpc.CallOption) (*QueryProducerVotesByValidatorIdResponse, error)
 	// GetProducerPlannedDowntime queries the planned downtime window for a
 	// specific producer. Producers can signal planned maintenance periods during
-	// which they won't produce blocks.
+	// which they won't produce blocks. When the producer planned several
+	// windows, the first one is returned.
 	GetProducerPlannedDowntime(ctx context.Context, in *QueryProducerPlannedDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimeResponse, error)
+	// GetProducerPlannedDowntimes queries all the planned downtime windows of a
+	// specific producer, ordered by start block.
+	GetProducerPlannedDowntimes(ctx context.Context, in *QueryProducerPlannedDowntimesRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimesResponse, error)
 	// GetValidatorPerformanceScore queries the performance scores of all
 	// validators. Performance scores track block production reliability.
 	GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error)
+	// GetValidatorPerformanceHistory queries the performance of a validator
+	// during each span of a span range, as recorded from the milestones.
+	GetValidatorPerformanceHistory(ctx context.Context, in *QueryValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceHistoryResponse, error)
+	// SimulateProducerSet simulates the election of the producer of the next
+	// span, with hypothetical producer votes and planned downtimes applied on
+	// top of the current state, which is left unchanged.
+	SimulateProducerSet(ctx context.Context, in *QuerySimulateProducerSetRequest, opts ...grpc.CallOption) (*QuerySimulateProducerSetResponse, error)
+	// GetSpanSummaryById queries the summary of a span whose full body was
+	// pruned.
+	GetSpanSummaryById(ctx context.Context, in *QuerySpanSummaryByIdRequest, opts ...grpc.CallOption) (*QuerySpanSummaryByIdResponse, error)
 }
 
 type queryClient struct {
@@ -153,6 +171,15 @@ func (c *queryClient) GetProducerPlannedDowntime(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) GetProducerPlannedDowntimes(ctx context.Context, in *QueryProducerPlannedDowntimesRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimesResponse, error) {
+	out := new(QueryProducerPlannedDowntimesResponse)
+	err := c.cc.Invoke(ctx, Query_GetProducerPlannedDowntimes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error) {
 	out := new(QueryValidatorPerformanceScoreResponse)
 	err := c.cc.Invoke(ctx, Query_GetValidatorPerformanceScore_FullMethodName, in, out, opts...)
@@ -162,6 +189,33 @@ func (c *queryClient) GetValidatorPerformanceScore(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) GetValidatorPerformanceHistory(ctx context.Context, in *QueryValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceHistoryResponse, error) {
+	out := new(QueryValidatorPerformanceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorPerformanceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProducerSet(ctx context.Context, in *QuerySimulateProducerSetRequest, opts ...grpc.CallOption) (*QuerySimulateProducerSetResponse, error) {
+	out := new(QuerySimulateProducerSetResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProducerSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSpanSummaryById(ctx context.Context, in *QuerySpanSummaryByIdRequest, opts ...grpc.CallOption) (*QuerySpanSummaryByIdResponse, error) {
+	out := new(QuerySpanSummaryByIdResponse)
+	err := c.cc.Invoke(ctx, Query_GetSpanSummaryById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -188,11 +242,25 @@ type QueryServer interface {
 	GetProducerVotesByValidatorId(context.Context, *QueryProducerVotesByValidatorIdRequest) (*QueryProducerVotesByValidatorIdResponse, error)
 	// GetProducerPlannedDowntime queries the planned downtime window for a
 	// specific producer. Producers can signal planned maintenance periods during
-	// which they won't produce blocks.
+	// which they won't produce blocks. When the producer planned several
+	// windows, the first one is returned.
 	GetProducerPlannedDowntime(context.Context, *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error)
+	// GetProducerPlannedDowntimes queries all the planned downtime windows of a
+	// specific producer, ordered by start block.
+	GetProducerPlannedDowntimes(context.Context, *QueryProducerPlannedDowntimesRequest) (*QueryProducerPlannedDowntimesResponse, error)
 	// GetValidatorPerformanceScore queries the performance scores of all
 	// validators. Performance scores track block production reliability.
 	GetValidatorPerformanceScore(context.Context, *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error)
+	// GetValidatorPerformanceHistory queries the performance of a validator
+	// during each span of a span range, as recorded from the milestones.
+	GetValidatorPerformanceHistory(context.Context, *QueryValidatorPerformanceHistoryRequest) (*QueryValidatorPerformanceHistoryResponse, error)
+	// SimulateProducerSet simulates the election of the producer of the next
+	// span, with hypothetical producer votes and planned downtimes applied on
+	// top of the current state, which is left unchanged.
+	SimulateProducerSet(context.Context, *QuerySimulateProducerSetRequest) (*QuerySimulateProducerSetResponse, error)
+	// GetSpanSummaryById queries the summary of a span whose full body was
+	// pruned.
+	GetSpanSummaryById(context.Context, *QuerySpanSummaryByIdRequest) (*QuerySpanSummaryByIdResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -227,9 +295,21 @@ func (UnimplementedQueryServer) GetProducerVotesByValidatorId(context.Context, *
 func (UnimplementedQueryServer) GetProducerPlannedDowntime(context.Context, *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProducerPlannedDowntime not implemented")
 }
+func (UnimplementedQueryServer) GetProducerPlannedDowntimes(context.Context, *QueryProducerPlannedDowntimesRequest) (*QueryProducerPlannedDowntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerPlannedDowntimes not implemented")
+}
 func (UnimplementedQueryServer) GetValidatorPerformanceScore(context.Context, *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceScore not implemented")
 }
+func (UnimplementedQueryServer) GetValidatorPerformanceHistory(context.Context, *QueryValidatorPerformanceHistoryRequest) (*QueryValidatorPerformanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceHistory not implemented")
+}
+func (UnimplementedQueryServer) SimulateProducerSet(context.Context, *QuerySimulateProducerSetRequest) (*QuerySimulateProducerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProducerSet not implemented")
+}
+func (UnimplementedQueryServer) GetSpanSummaryById(context.Context, *QuerySpanSummaryByIdRequest) (*QuerySpanSummaryByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpanSummaryById not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -405,6 +485,24 @@ func _Query_GetProducerPlannedDowntime_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProducerPlannedDowntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerPlannedDowntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProducerPlannedDowntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetProducerPlannedDowntimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProducerPlannedDowntimes(ctx, req.(*QueryProducerPlannedDowntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetValidatorPerformanceScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorPerformanceScoreRequest)
 	if err := dec(in); err != nil {
@@ -423,6 +521,60 @@ func _Query_GetValidatorPerformanceScore_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetValidatorPerformanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorPerformanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformanceHistory(ctx, req.(*QueryValidatorPerformanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProducerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProducerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProducerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProducerSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProducerSet(ctx, req.(*QuerySimulateProducerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSpanSummaryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanSummaryByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSpanSummaryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSpanSummaryById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSpanSummaryById(ctx, req.(*QuerySpanSummaryByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -466,10 +618,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProducerPlannedDowntime",
 			Handler:    _Query_GetProducerPlannedDowntime_Handler,
 		},
+		{
+			MethodName: "GetProducerPlannedDowntimes",
+			Handler:    _Query_GetProducerPlannedDowntimes_Handler,
+		},
 		{
 			MethodName: "GetValidatorPerformanceScore",
 			Handler:    _Query_GetValidatorPerformanceScore_Handler,
 		},
+		{
+			MethodName: "GetValidatorPerformanceHistory",
+			Handler:    _Query_GetValidatorPerformanceHistory_Handler,
+		},
+		{
+			MethodName: "SimulateProducerSet",
+			Handler:    _Query_SimulateProducerSet_Handler,
+		},
+		{
+			MethodName: "GetSpanSummaryById",
+			Handler:    _Query_GetSpanSummaryById_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/bor/query.proto",
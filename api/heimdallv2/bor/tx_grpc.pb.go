@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ProposeSpan_FullMethodName         = "/heimdallv2.bor.Msg/ProposeSpan"
-	Msg_UpdateParams_FullMethodName        = "/heimdallv2.bor.Msg/UpdateParams"
-	Msg_BackfillSpans_FullMethodName       = "/heimdallv2.bor.Msg/BackfillSpans"
-	Msg_VoteProducers_FullMethodName       = "/heimdallv2.bor.Msg/VoteProducers"
-	Msg_SetProducerDowntime_FullMethodName = "/heimdallv2.bor.Msg/SetProducerDowntime"
+	Msg_ProposeSpan_FullMethodName            = "/heimdallv2.bor.Msg/ProposeSpan"
+	Msg_UpdateParams_FullMethodName           = "/heimdallv2.bor.Msg/UpdateParams"
+	Msg_BackfillSpans_FullMethodName          = "/heimdallv2.bor.Msg/BackfillSpans"
+	Msg_VoteProducers_FullMethodName          = "/heimdallv2.bor.Msg/VoteProducers"
+	Msg_SetProducerDowntime_FullMethodName    = "/heimdallv2.bor.Msg/SetProducerDowntime"
+	Msg_CancelProducerDowntime_FullMethodName = "/heimdallv2.bor.Msg/CancelProducerDowntime"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +46,9 @@ type MsgClient interface {
 	// producer. Producers can signal maintenance windows during which they won't
 	// produce blocks.
 	SetProducerDowntime(ctx context.Context, in *MsgSetProducerDowntime, opts ...grpc.CallOption) (*MsgSetProducerDowntimeResponse, error)
+	// CancelProducerDowntime defines a method for a producer to cancel one of
+	// its planned downtime windows.
+	CancelProducerDowntime(ctx context.Context, in *MsgCancelProducerDowntime, opts ...grpc.CallOption) (*MsgCancelProducerDowntimeResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +104,15 @@ func (c *msgClient) SetProducerDowntime(ctx context.Context, in *MsgSetProducerD
 	return out, nil
 }
 
+func (c *msgClient) CancelProducerDowntime(ctx context.Context, in *MsgCancelProducerDowntime, opts ...grpc.CallOption) (*MsgCancelProducerDowntimeResponse, error) {
+	out := new(MsgCancelProducerDowntimeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelProducerDowntime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -119,6 +132,9 @@ type MsgServer interface {
 	// producer. Producers can signal maintenance windows during which they won't
 	// produce blocks.
 	SetProducerDowntime(context.Context, *MsgSetProducerDowntime) (*MsgSetProducerDowntimeResponse, error)
+	// CancelProducerDowntime defines a method for a producer to cancel one of
+	// its planned downtime windows.
+	CancelProducerDowntime(context.Context, *MsgCancelProducerDowntime) (*MsgCancelProducerDowntimeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -141,6 +157,9 @@ func (UnimplementedMsgServer) VoteProducers(context.Context, *MsgVoteProducers)
 func (UnimplementedMsgServer) SetProducerDowntime(context.Context, *MsgSetProducerDowntime) (*MsgSetProducerDowntimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProducerDowntime not implemented")
 }
+func (UnimplementedMsgServer) CancelProducerDowntime(context.Context, *MsgCancelProducerDowntime) (*MsgCancelProducerDowntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProducerDowntime not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -244,6 +263,24 @@ func _Msg_SetProducerDowntime_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProducerDowntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProducerDowntime)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProducerDowntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelProducerDowntime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProducerDowntime(ctx, req.(*MsgCancelProducerDowntime))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +308,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProducerDowntime",
 			Handler:    _Msg_SetProducerDowntime_Handler,
 		},
+		{
+			MethodName: "CancelProducerDowntime",
+			Handler:    _Msg_CancelProducerDowntime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/bor/tx.proto",
@@ -1011,10 +1011,11 @@ func (x *fastReflection_ChainParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_chain_params                protoreflect.FieldDescriptor
-	fd_Params_main_chain_tx_confirmations protoreflect.FieldDescriptor
-	fd_Params_bor_chain_tx_confirmations  protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_chain_params                 protoreflect.FieldDescriptor
+	fd_Params_main_chain_tx_confirmations  protoreflect.FieldDescriptor
+	fd_Params_bor_chain_tx_confirmations   protoreflect.FieldDescriptor
+	fd_Params_main_chain_confirmation_mode protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1023,6 +1024,7 @@ func init() {
 	fd_Params_chain_params = md_Params.Fields().ByName("chain_params")
 	fd_Params_main_chain_tx_confirmations = md_Params.Fields().ByName("main_chain_tx_confirmations")
 	fd_Params_bor_chain_tx_confirmations = md_Params.Fields().ByName("bor_chain_tx_confirmations")
+	fd_Params_main_chain_confirmation_mode = md_Params.Fields().ByName("main_chain_confirmation_mode")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1108,6 +1110,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MainChainConfirmationMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.MainChainConfirmationMode))
+		if !f(fd_Params_main_chain_confirmation_mode, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1129,6 +1137,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MainChainTxConfirmations != uint64(0)
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		return x.BorChainTxConfirmations != uint64(0)
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		return x.MainChainConfirmationMode != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1151,6 +1161,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MainChainTxConfirmations = uint64(0)
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		x.BorChainTxConfirmations = uint64(0)
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		x.MainChainConfirmationMode = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1176,6 +1188,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		value := x.BorChainTxConfirmations
 		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		value := x.MainChainConfirmationMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1202,6 +1217,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MainChainTxConfirmations = value.Uint()
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		x.BorChainTxConfirmations = value.Uint()
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		x.MainChainConfirmationMode = (ConfirmationMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1231,6 +1248,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field main_chain_tx_confirmations of message heimdallv2.chainmanager.Params is not mutable"))
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		panic(fmt.Errorf("field bor_chain_tx_confirmations of message heimdallv2.chainmanager.Params is not mutable"))
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		panic(fmt.Errorf("field main_chain_confirmation_mode of message heimdallv2.chainmanager.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1251,6 +1270,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "heimdallv2.chainmanager.Params.bor_chain_tx_confirmations":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.chainmanager.Params.main_chain_confirmation_mode":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.Params"))
@@ -1330,6 +1351,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.BorChainTxConfirmations != 0 {
 			n += 1 + runtime.Sov(uint64(x.BorChainTxConfirmations))
 		}
+		if x.MainChainConfirmationMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.MainChainConfirmationMode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1359,6 +1383,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MainChainConfirmationMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MainChainConfirmationMode))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.BorChainTxConfirmations != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BorChainTxConfirmations))
 			i--
@@ -1506,6 +1535,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MainChainConfirmationMode", wireType)
+				}
+				x.MainChainConfirmationMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MainChainConfirmationMode |= ConfirmationMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1541,135 +1589,1032 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: heimdallv2/chainmanager/chainmanager.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_AddressMigration                         protoreflect.MessageDescriptor
+	fd_AddressMigration_height                  protoreflect.FieldDescriptor
+	fd_AddressMigration_pol_token_address       protoreflect.FieldDescriptor
+	fd_AddressMigration_root_chain_address      protoreflect.FieldDescriptor
+	fd_AddressMigration_staking_manager_address protoreflect.FieldDescriptor
+	fd_AddressMigration_slash_manager_address   protoreflect.FieldDescriptor
+	fd_AddressMigration_staking_info_address    protoreflect.FieldDescriptor
+	fd_AddressMigration_state_sender_address    protoreflect.FieldDescriptor
+	fd_AddressMigration_verified                protoreflect.FieldDescriptor
 )
 
-// ChainParams contains configuration for both chains and contract addresses.
-type ChainParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_heimdallv2_chainmanager_chainmanager_proto_init()
+	md_AddressMigration = File_heimdallv2_chainmanager_chainmanager_proto.Messages().ByName("AddressMigration")
+	fd_AddressMigration_height = md_AddressMigration.Fields().ByName("height")
+	fd_AddressMigration_pol_token_address = md_AddressMigration.Fields().ByName("pol_token_address")
+	fd_AddressMigration_root_chain_address = md_AddressMigration.Fields().ByName("root_chain_address")
+	fd_AddressMigration_staking_manager_address = md_AddressMigration.Fields().ByName("staking_manager_address")
+	fd_AddressMigration_slash_manager_address = md_AddressMigration.Fields().ByName("slash_manager_address")
+	fd_AddressMigration_staking_info_address = md_AddressMigration.Fields().ByName("staking_info_address")
+	fd_AddressMigration_state_sender_address = md_AddressMigration.Fields().ByName("state_sender_address")
+	fd_AddressMigration_verified = md_AddressMigration.Fields().ByName("verified")
+}
 
-	// Chain ID of the Bor (child) chain.
-	BorChainId string `protobuf:"bytes,1,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
-	// Chain ID of the Heimdall chain.
-	HeimdallChainId string `protobuf:"bytes,2,opt,name=heimdall_chain_id,json=heimdallChainId,proto3" json:"heimdall_chain_id,omitempty"`
-	// Address of the POL token contract on the root chain.
-	PolTokenAddress string `protobuf:"bytes,3,opt,name=pol_token_address,json=polTokenAddress,proto3" json:"pol_token_address,omitempty"`
-	// Address of the staking manager contract on the root chain.
-	StakingManagerAddress string `protobuf:"bytes,4,opt,name=staking_manager_address,json=stakingManagerAddress,proto3" json:"staking_manager_address,omitempty"`
-	// Address of the slash manager contract on the root chain.
-	SlashManagerAddress string `protobuf:"bytes,5,opt,name=slash_manager_address,json=slashManagerAddress,proto3" json:"slash_manager_address,omitempty"`
-	// Address of the root chain contract (checkpoint submission).
-	RootChainAddress string `protobuf:"bytes,6,opt,name=root_chain_address,json=rootChainAddress,proto3" json:"root_chain_address,omitempty"`
-	// Address of the staking info contract on the root chain.
-	StakingInfoAddress string `protobuf:"bytes,7,opt,name=staking_info_address,json=stakingInfoAddress,proto3" json:"staking_info_address,omitempty"`
-	// Address of the state sender contract on the root chain.
-	StateSenderAddress string `protobuf:"bytes,8,opt,name=state_sender_address,json=stateSenderAddress,proto3" json:"state_sender_address,omitempty"`
-	// Address of the state receiver contract on the Bor chain.
-	StateReceiverAddress string `protobuf:"bytes,9,opt,name=state_receiver_address,json=stateReceiverAddress,proto3" json:"state_receiver_address,omitempty"`
-	// Address of the validator set contract on the root chain.
-	ValidatorSetAddress string `protobuf:"bytes,10,opt,name=validator_set_address,json=validatorSetAddress,proto3" json:"validator_set_address,omitempty"`
+var _ protoreflect.Message = (*fastReflection_AddressMigration)(nil)
+
+type fastReflection_AddressMigration AddressMigration
+
+func (x *AddressMigration) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AddressMigration)(x)
 }
 
-func (x *ChainParams) Reset() {
-	*x = ChainParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_chainmanager_chainmanager_proto_msgTypes[0]
+func (x *AddressMigration) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_chainmanager_chainmanager_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *ChainParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_AddressMigration_messageType fastReflection_AddressMigration_messageType
+var _ protoreflect.MessageType = fastReflection_AddressMigration_messageType{}
 
-func (*ChainParams) ProtoMessage() {}
+type fastReflection_AddressMigration_messageType struct{}
 
-// Deprecated: Use ChainParams.ProtoReflect.Descriptor instead.
-func (*ChainParams) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_chainmanager_chainmanager_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_AddressMigration_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AddressMigration)(nil)
 }
-
-func (x *ChainParams) GetBorChainId() string {
-	if x != nil {
-		return x.BorChainId
-	}
-	return ""
+func (x fastReflection_AddressMigration_messageType) New() protoreflect.Message {
+	return new(fastReflection_AddressMigration)
+}
+func (x fastReflection_AddressMigration_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressMigration
 }
 
-func (x *ChainParams) GetHeimdallChainId() string {
-	if x != nil {
-		return x.HeimdallChainId
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AddressMigration) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressMigration
 }
 
-func (x *ChainParams) GetPolTokenAddress() string {
-	if x != nil {
-		return x.PolTokenAddress
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AddressMigration) Type() protoreflect.MessageType {
+	return _fastReflection_AddressMigration_messageType
 }
 
-func (x *ChainParams) GetStakingManagerAddress() string {
-	if x != nil {
-		return x.StakingManagerAddress
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AddressMigration) New() protoreflect.Message {
+	return new(fastReflection_AddressMigration)
 }
 
-func (x *ChainParams) GetSlashManagerAddress() string {
-	if x != nil {
-		return x.SlashManagerAddress
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AddressMigration) Interface() protoreflect.ProtoMessage {
+	return (*AddressMigration)(x)
 }
 
-func (x *ChainParams) GetRootChainAddress() string {
-	if x != nil {
-		return x.RootChainAddress
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AddressMigration) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_AddressMigration_height, value) {
+			return
+		}
+	}
+	if x.PolTokenAddress != "" {
+		value := protoreflect.ValueOfString(x.PolTokenAddress)
+		if !f(fd_AddressMigration_pol_token_address, value) {
+			return
+		}
+	}
+	if x.RootChainAddress != "" {
+		value := protoreflect.ValueOfString(x.RootChainAddress)
+		if !f(fd_AddressMigration_root_chain_address, value) {
+			return
+		}
+	}
+	if x.StakingManagerAddress != "" {
+		value := protoreflect.ValueOfString(x.StakingManagerAddress)
+		if !f(fd_AddressMigration_staking_manager_address, value) {
+			return
+		}
+	}
+	if x.SlashManagerAddress != "" {
+		value := protoreflect.ValueOfString(x.SlashManagerAddress)
+		if !f(fd_AddressMigration_slash_manager_address, value) {
+			return
+		}
+	}
+	if x.StakingInfoAddress != "" {
+		value := protoreflect.ValueOfString(x.StakingInfoAddress)
+		if !f(fd_AddressMigration_staking_info_address, value) {
+			return
+		}
+	}
+	if x.StateSenderAddress != "" {
+		value := protoreflect.ValueOfString(x.StateSenderAddress)
+		if !f(fd_AddressMigration_state_sender_address, value) {
+			return
+		}
+	}
+	if x.Verified != false {
+		value := protoreflect.ValueOfBool(x.Verified)
+		if !f(fd_AddressMigration_verified, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *ChainParams) GetStakingInfoAddress() string {
-	if x != nil {
-		return x.StakingInfoAddress
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AddressMigration) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		return x.Height != int64(0)
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		return x.PolTokenAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		return x.RootChainAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		return x.StakingManagerAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		return x.SlashManagerAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		return x.StakingInfoAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		return x.StateSenderAddress != ""
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		return x.Verified != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *ChainParams) GetStateSenderAddress() string {
-	if x != nil {
-		return x.StateSenderAddress
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressMigration) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		x.Height = int64(0)
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		x.PolTokenAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		x.RootChainAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		x.StakingManagerAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		x.SlashManagerAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		x.StakingInfoAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		x.StateSenderAddress = ""
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		x.Verified = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *ChainParams) GetStateReceiverAddress() string {
-	if x != nil {
-		return x.StateReceiverAddress
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AddressMigration) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		value := x.PolTokenAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		value := x.RootChainAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		value := x.StakingManagerAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		value := x.SlashManagerAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		value := x.StakingInfoAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		value := x.StateSenderAddress
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		value := x.Verified
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-func (x *ChainParams) GetValidatorSetAddress() string {
-	if x != nil {
-		return x.ValidatorSetAddress
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressMigration) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		x.Height = value.Int()
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		x.PolTokenAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		x.RootChainAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		x.StakingManagerAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		x.SlashManagerAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		x.StakingInfoAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		x.StateSenderAddress = value.Interface().(string)
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		x.Verified = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", fd.FullName()))
 	}
-	return ""
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressMigration) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		panic(fmt.Errorf("field height of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		panic(fmt.Errorf("field pol_token_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		panic(fmt.Errorf("field root_chain_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		panic(fmt.Errorf("field staking_manager_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		panic(fmt.Errorf("field slash_manager_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		panic(fmt.Errorf("field staking_info_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		panic(fmt.Errorf("field state_sender_address of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		panic(fmt.Errorf("field verified of message heimdallv2.chainmanager.AddressMigration is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AddressMigration) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.chainmanager.AddressMigration.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "heimdallv2.chainmanager.AddressMigration.pol_token_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.root_chain_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.staking_manager_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.slash_manager_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.staking_info_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.state_sender_address":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.chainmanager.AddressMigration.verified":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.chainmanager.AddressMigration"))
+		}
+		panic(fmt.Errorf("message heimdallv2.chainmanager.AddressMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AddressMigration) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.chainmanager.AddressMigration", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AddressMigration) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressMigration) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AddressMigration) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AddressMigration) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AddressMigration)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.PolTokenAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RootChainAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakingManagerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashManagerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakingInfoAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StateSenderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Verified {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AddressMigration)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Verified {
+			i--
+			if x.Verified {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.StateSenderAddress) > 0 {
+			i -= len(x.StateSenderAddress)
+			copy(dAtA[i:], x.StateSenderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateSenderAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.StakingInfoAddress) > 0 {
+			i -= len(x.StakingInfoAddress)
+			copy(dAtA[i:], x.StakingInfoAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingInfoAddress)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SlashManagerAddress) > 0 {
+			i -= len(x.SlashManagerAddress)
+			copy(dAtA[i:], x.SlashManagerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashManagerAddress)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StakingManagerAddress) > 0 {
+			i -= len(x.StakingManagerAddress)
+			copy(dAtA[i:], x.StakingManagerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingManagerAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RootChainAddress) > 0 {
+			i -= len(x.RootChainAddress)
+			copy(dAtA[i:], x.RootChainAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RootChainAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PolTokenAddress) > 0 {
+			i -= len(x.PolTokenAddress)
+			copy(dAtA[i:], x.PolTokenAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolTokenAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AddressMigration)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressMigration: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolTokenAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolTokenAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RootChainAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RootChainAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingManagerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingManagerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashManagerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashManagerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingInfoAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingInfoAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateSenderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateSenderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Verified = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: heimdallv2/chainmanager/chainmanager.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfirmationMode selects how main chain transactions are considered
+// confirmed.
+type ConfirmationMode int32
+
+const (
+	// Included in a finalized block when the main chain RPC exposes it, else
+	// main_chain_tx_confirmations blocks deep.
+	ConfirmationMode_CONFIRMATION_MODE_UNSPECIFIED ConfirmationMode = 0
+	// main_chain_tx_confirmations blocks deep.
+	ConfirmationMode_CONFIRMATION_MODE_DEPTH ConfirmationMode = 1
+	// Included in a finalized block.
+	ConfirmationMode_CONFIRMATION_MODE_FINALITY ConfirmationMode = 2
+)
+
+// Enum value maps for ConfirmationMode.
+var (
+	ConfirmationMode_name = map[int32]string{
+		0: "CONFIRMATION_MODE_UNSPECIFIED",
+		1: "CONFIRMATION_MODE_DEPTH",
+		2: "CONFIRMATION_MODE_FINALITY",
+	}
+	ConfirmationMode_value = map[string]int32{
+		"CONFIRMATION_MODE_UNSPECIFIED": 0,
+		"CONFIRMATION_MODE_DEPTH":       1,
+		"CONFIRMATION_MODE_FINALITY":    2,
+	}
+)
+
+func (x ConfirmationMode) Enum() *ConfirmationMode {
+	p := new(ConfirmationMode)
+	*p = x
+	return p
+}
+
+func (x ConfirmationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfirmationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_heimdallv2_chainmanager_chainmanager_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfirmationMode) Type() protoreflect.EnumType {
+	return &file_heimdallv2_chainmanager_chainmanager_proto_enumTypes[0]
+}
+
+func (x ConfirmationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfirmationMode.Descriptor instead.
+func (ConfirmationMode) EnumDescriptor() ([]byte, []int) {
+	return file_heimdallv2_chainmanager_chainmanager_proto_rawDescGZIP(), []int{0}
+}
+
+// ChainParams contains configuration for both chains and contract addresses.
+type ChainParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chain ID of the Bor (child) chain.
+	BorChainId string `protobuf:"bytes,1,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
+	// Chain ID of the Heimdall chain.
+	HeimdallChainId string `protobuf:"bytes,2,opt,name=heimdall_chain_id,json=heimdallChainId,proto3" json:"heimdall_chain_id,omitempty"`
+	// Address of the POL token contract on the root chain.
+	PolTokenAddress string `protobuf:"bytes,3,opt,name=pol_token_address,json=polTokenAddress,proto3" json:"pol_token_address,omitempty"`
+	// Address of the staking manager contract on the root chain.
+	StakingManagerAddress string `protobuf:"bytes,4,opt,name=staking_manager_address,json=stakingManagerAddress,proto3" json:"staking_manager_address,omitempty"`
+	// Address of the slash manager contract on the root chain.
+	SlashManagerAddress string `protobuf:"bytes,5,opt,name=slash_manager_address,json=slashManagerAddress,proto3" json:"slash_manager_address,omitempty"`
+	// Address of the root chain contract (checkpoint submission).
+	RootChainAddress string `protobuf:"bytes,6,opt,name=root_chain_address,json=rootChainAddress,proto3" json:"root_chain_address,omitempty"`
+	// Address of the staking info contract on the root chain.
+	StakingInfoAddress string `protobuf:"bytes,7,opt,name=staking_info_address,json=stakingInfoAddress,proto3" json:"staking_info_address,omitempty"`
+	// Address of the state sender contract on the root chain.
+	StateSenderAddress string `protobuf:"bytes,8,opt,name=state_sender_address,json=stateSenderAddress,proto3" json:"state_sender_address,omitempty"`
+	// Address of the state receiver contract on the Bor chain.
+	StateReceiverAddress string `protobuf:"bytes,9,opt,name=state_receiver_address,json=stateReceiverAddress,proto3" json:"state_receiver_address,omitempty"`
+	// Address of the validator set contract on the root chain.
+	ValidatorSetAddress string `protobuf:"bytes,10,opt,name=validator_set_address,json=validatorSetAddress,proto3" json:"validator_set_address,omitempty"`
+}
+
+func (x *ChainParams) Reset() {
+	*x = ChainParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_chainmanager_chainmanager_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainParams) ProtoMessage() {}
+
+// Deprecated: Use ChainParams.ProtoReflect.Descriptor instead.
+func (*ChainParams) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_chainmanager_chainmanager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChainParams) GetBorChainId() string {
+	if x != nil {
+		return x.BorChainId
+	}
+	return ""
+}
+
+func (x *ChainParams) GetHeimdallChainId() string {
+	if x != nil {
+		return x.HeimdallChainId
+	}
+	return ""
+}
+
+func (x *ChainParams) GetPolTokenAddress() string {
+	if x != nil {
+		return x.PolTokenAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetStakingManagerAddress() string {
+	if x != nil {
+		return x.StakingManagerAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetSlashManagerAddress() string {
+	if x != nil {
+		return x.SlashManagerAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetRootChainAddress() string {
+	if x != nil {
+		return x.RootChainAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetStakingInfoAddress() string {
+	if x != nil {
+		return x.StakingInfoAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetStateSenderAddress() string {
+	if x != nil {
+		return x.StateSenderAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetStateReceiverAddress() string {
+	if x != nil {
+		return x.StateReceiverAddress
+	}
+	return ""
+}
+
+func (x *ChainParams) GetValidatorSetAddress() string {
+	if x != nil {
+		return x.ValidatorSetAddress
+	}
+	return ""
 }
 
 // Params defines the parameters for the chainmanager module.
@@ -1684,6 +2629,8 @@ type Params struct {
 	MainChainTxConfirmations uint64 `protobuf:"varint,2,opt,name=main_chain_tx_confirmations,json=mainChainTxConfirmations,proto3" json:"main_chain_tx_confirmations,omitempty"`
 	// Number of confirmations required for Bor chain transactions.
 	BorChainTxConfirmations uint64 `protobuf:"varint,3,opt,name=bor_chain_tx_confirmations,json=borChainTxConfirmations,proto3" json:"bor_chain_tx_confirmations,omitempty"`
+	// How main chain transactions are considered confirmed.
+	MainChainConfirmationMode ConfirmationMode `protobuf:"varint,4,opt,name=main_chain_confirmation_mode,json=mainChainConfirmationMode,proto3,enum=heimdallv2.chainmanager.ConfirmationMode" json:"main_chain_confirmation_mode,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1727,6 +2674,117 @@ func (x *Params) GetBorChainTxConfirmations() uint64 {
 	return 0
 }
 
+func (x *Params) GetMainChainConfirmationMode() ConfirmationMode {
+	if x != nil {
+		return x.MainChainConfirmationMode
+	}
+	return ConfirmationMode_CONFIRMATION_MODE_UNSPECIFIED
+}
+
+// AddressMigration is a change of the root chain contract addresses of the
+// chain params, scheduled by governance and applied at the end of the block at
+// its height. Empty addresses are left unchanged.
+type AddressMigration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Height of the block at the end of which the addresses change.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// New address of the POL token contract.
+	PolTokenAddress string `protobuf:"bytes,2,opt,name=pol_token_address,json=polTokenAddress,proto3" json:"pol_token_address,omitempty"`
+	// New address of the root chain contract.
+	RootChainAddress string `protobuf:"bytes,3,opt,name=root_chain_address,json=rootChainAddress,proto3" json:"root_chain_address,omitempty"`
+	// New address of the staking manager contract.
+	StakingManagerAddress string `protobuf:"bytes,4,opt,name=staking_manager_address,json=stakingManagerAddress,proto3" json:"staking_manager_address,omitempty"`
+	// New address of the slash manager contract.
+	SlashManagerAddress string `protobuf:"bytes,5,opt,name=slash_manager_address,json=slashManagerAddress,proto3" json:"slash_manager_address,omitempty"`
+	// New address of the staking info contract.
+	StakingInfoAddress string `protobuf:"bytes,6,opt,name=staking_info_address,json=stakingInfoAddress,proto3" json:"staking_info_address,omitempty"`
+	// New address of the state sender contract.
+	StateSenderAddress string `protobuf:"bytes,7,opt,name=state_sender_address,json=stateSenderAddress,proto3" json:"state_sender_address,omitempty"`
+	// Whether the validators verified the new addresses against the main chain
+	// with a MsgVerifyAddressMigration side tx. An unverified migration is
+	// dropped at its height.
+	Verified bool `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *AddressMigration) Reset() {
+	*x = AddressMigration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_chainmanager_chainmanager_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressMigration) ProtoMessage() {}
+
+// Deprecated: Use AddressMigration.ProtoReflect.Descriptor instead.
+func (*AddressMigration) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_chainmanager_chainmanager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddressMigration) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AddressMigration) GetPolTokenAddress() string {
+	if x != nil {
+		return x.PolTokenAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetRootChainAddress() string {
+	if x != nil {
+		return x.RootChainAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetStakingManagerAddress() string {
+	if x != nil {
+		return x.StakingManagerAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetSlashManagerAddress() string {
+	if x != nil {
+		return x.SlashManagerAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetStakingInfoAddress() string {
+	if x != nil {
+		return x.StakingInfoAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetStateSenderAddress() string {
+	if x != nil {
+		return x.StateSenderAddress
+	}
+	return ""
+}
+
+func (x *AddressMigration) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
 var File_heimdallv2_chainmanager_chainmanager_proto protoreflect.FileDescriptor
 
 var file_heimdallv2_chainmanager_chainmanager_proto_rawDesc = []byte{
@@ -1772,7 +2830,7 @@ var file_heimdallv2_chainmanager_chainmanager_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
 	0x01, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xec, 0x01, 0x0a,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdf, 0x02, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e,
@@ -1787,23 +2845,61 @@ var file_heimdallv2_chainmanager_chainmanager_proto_rawDesc = []byte{
 	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x62, 0x6f,
 	0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xeb, 0x01, 0x0a, 0x1b,
-	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x11, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50,
-	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d,
-	0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
-	0x32, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0xa2, 0x02,
-	0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x17, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
-	0x32, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0xca, 0x02,
-	0x17, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0xe2, 0x02, 0x23, 0x48, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x18, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x71, 0x0a, 0x1c, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x6d,
+	0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xfd,
+	0x02, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x6f, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6f, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x72,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x54, 0x48,
+	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59,
+	0x10, 0x02, 0x42, 0xeb, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x42, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x17, 0x48, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0xca, 0x02, 0x17, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x5c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0xe2,
+	0x02, 0x23, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x3a, 0x3a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1818,18 +2914,22 @@ func file_heimdallv2_chainmanager_chainmanager_proto_rawDescGZIP() []byte {
 	return file_heimdallv2_chainmanager_chainmanager_proto_rawDescData
 }
 
-var file_heimdallv2_chainmanager_chainmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_heimdallv2_chainmanager_chainmanager_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_heimdallv2_chainmanager_chainmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_heimdallv2_chainmanager_chainmanager_proto_goTypes = []interface{}{
-	(*ChainParams)(nil), // 0: heimdallv2.chainmanager.ChainParams
-	(*Params)(nil),      // 1: heimdallv2.chainmanager.Params
+	(ConfirmationMode)(0),    // 0: heimdallv2.chainmanager.ConfirmationMode
+	(*ChainParams)(nil),      // 1: heimdallv2.chainmanager.ChainParams
+	(*Params)(nil),           // 2: heimdallv2.chainmanager.Params
+	(*AddressMigration)(nil), // 3: heimdallv2.chainmanager.AddressMigration
 }
 var file_heimdallv2_chainmanager_chainmanager_proto_depIdxs = []int32{
-	0, // 0: heimdallv2.chainmanager.Params.chain_params:type_name -> heimdallv2.chainmanager.ChainParams
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: heimdallv2.chainmanager.Params.chain_params:type_name -> heimdallv2.chainmanager.ChainParams
+	0, // 1: heimdallv2.chainmanager.Params.main_chain_confirmation_mode:type_name -> heimdallv2.chainmanager.ConfirmationMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_chainmanager_chainmanager_proto_init() }
@@ -1862,19 +2962,32 @@ func file_heimdallv2_chainmanager_chainmanager_proto_init() {
 				return nil
 			}
 		}
+		file_heimdallv2_chainmanager_chainmanager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressMigration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_heimdallv2_chainmanager_chainmanager_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_heimdallv2_chainmanager_chainmanager_proto_goTypes,
 		DependencyIndexes: file_heimdallv2_chainmanager_chainmanager_proto_depIdxs,
+		EnumInfos:         file_heimdallv2_chainmanager_chainmanager_proto_enumTypes,
 		MessageInfos:      file_heimdallv2_chainmanager_chainmanager_proto_msgTypes,
 	}.Build()
 	File_heimdallv2_chainmanager_chainmanager_proto = out.File
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GetChainManagerParams_FullMethodName = "/heimdallv2.chainmanager.Query/GetChainManagerParams"
+	Query_GetChainManagerParams_FullMethodName       = "/heimdallv2.chainmanager.Query/GetChainManagerParams"
+	Query_GetPendingAddressMigrations_FullMethodName = "/heimdallv2.chainmanager.Query/GetPendingAddressMigrations"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +29,9 @@ const (
 type QueryClient interface {
 	// GetChainManagerParams queries the chainmanager module parameters.
 	GetChainManagerParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetPendingAddressMigrations queries the address migrations scheduled at
+	// future heights, ordered by height.
+	GetPendingAddressMigrations(ctx context.Context, in *QueryPendingAddressMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingAddressMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +51,24 @@ func (c *queryClient) GetChainManagerParams(ctx context.Context, in *QueryParams
 	return out, nil
 }
 
+func (c *queryClient) GetPendingAddressMigrations(ctx context.Context, in *QueryPendingAddressMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingAddressMigrationsResponse, error) {
+	out := new(QueryPendingAddressMigrationsResponse)
+	err := c.cc.Invoke(ctx, Query_GetPendingAddressMigrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// GetChainManagerParams queries the chainmanager module parameters.
 	GetChainManagerParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetPendingAddressMigrations queries the address migrations scheduled at
+	// future heights, ordered by height.
+	GetPendingAddressMigrations(context.Context, *QueryPendingAddressMigrationsRequest) (*QueryPendingAddressMigrationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +79,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) GetChainManagerParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChainManagerParams not implemented")
 }
+func (UnimplementedQueryServer) GetPendingAddressMigrations(context.Context, *QueryPendingAddressMigrationsRequest) (*QueryPendingAddressMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAddressMigrations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +113,24 @@ func _Query_GetChainManagerParams_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPendingAddressMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAddressMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingAddressMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPendingAddressMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingAddressMigrations(ctx, req.(*QueryPendingAddressMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +142,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChainManagerParams",
 			Handler:    _Query_GetChainManagerParams_Handler,
 		},
+		{
+			MethodName: "GetPendingAddressMigrations",
+			Handler:    _Query_GetPendingAddressMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/chainmanager/query.proto",
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/heimdallv2.chainmanager.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName             = "/heimdallv2.chainmanager.Msg/UpdateParams"
+	Msg_ScheduleAddressMigration_FullMethodName = "/heimdallv2.chainmanager.Msg/ScheduleAddressMigration"
+	Msg_CancelAddressMigration_FullMethodName   = "/heimdallv2.chainmanager.Msg/CancelAddressMigration"
+	Msg_VerifyAddressMigration_FullMethodName   = "/heimdallv2.chainmanager.Msg/VerifyAddressMigration"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +32,17 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the chainmanager
 	// module parameters. Only the governance authority can execute this.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleAddressMigration defines a governance operation for scheduling a
+	// change of the root chain contract addresses at a future height. Only the
+	// governance authority can execute this.
+	ScheduleAddressMigration(ctx context.Context, in *MsgScheduleAddressMigration, opts ...grpc.CallOption) (*MsgScheduleAddressMigrationResponse, error)
+	// CancelAddressMigration defines a governance operation for cancelling a
+	// pending root chain contract address migration. Only the governance
+	// authority can execute this.
+	CancelAddressMigration(ctx context.Context, in *MsgCancelAddressMigration, opts ...grpc.CallOption) (*MsgCancelAddressMigrationResponse, error)
+	// VerifyAddressMigration defines a side tx for the validators to verify the
+	// new addresses of a pending address migration against the main chain.
+	VerifyAddressMigration(ctx context.Context, in *MsgVerifyAddressMigration, opts ...grpc.CallOption) (*MsgVerifyAddressMigrationResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +62,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleAddressMigration(ctx context.Context, in *MsgScheduleAddressMigration, opts ...grpc.CallOption) (*MsgScheduleAddressMigrationResponse, error) {
+	out := new(MsgScheduleAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleAddressMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAddressMigration(ctx context.Context, in *MsgCancelAddressMigration, opts ...grpc.CallOption) (*MsgCancelAddressMigrationResponse, error) {
+	out := new(MsgCancelAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAddressMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyAddressMigration(ctx context.Context, in *MsgVerifyAddressMigration, opts ...grpc.CallOption) (*MsgVerifyAddressMigrationResponse, error) {
+	out := new(MsgVerifyAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, Msg_VerifyAddressMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +96,17 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the chainmanager
 	// module parameters. Only the governance authority can execute this.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleAddressMigration defines a governance operation for scheduling a
+	// change of the root chain contract addresses at a future height. Only the
+	// governance authority can execute this.
+	ScheduleAddressMigration(context.Context, *MsgScheduleAddressMigration) (*MsgScheduleAddressMigrationResponse, error)
+	// CancelAddressMigration defines a governance operation for cancelling a
+	// pending root chain contract address migration. Only the governance
+	// authority can execute this.
+	CancelAddressMigration(context.Context, *MsgCancelAddressMigration) (*MsgCancelAddressMigrationResponse, error)
+	// VerifyAddressMigration defines a side tx for the validators to verify the
+	// new addresses of a pending address migration against the main chain.
+	VerifyAddressMigration(context.Context, *MsgVerifyAddressMigration) (*MsgVerifyAddressMigrationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +117,15 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ScheduleAddressMigration(context.Context, *MsgScheduleAddressMigration) (*MsgScheduleAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAddressMigration not implemented")
+}
+func (UnimplementedMsgServer) CancelAddressMigration(context.Context, *MsgCancelAddressMigration) (*MsgCancelAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAddressMigration not implemented")
+}
+func (UnimplementedMsgServer) VerifyAddressMigration(context.Context, *MsgVerifyAddressMigration) (*MsgVerifyAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAddressMigration not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +157,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleAddressMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleAddressMigration(ctx, req.(*MsgScheduleAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAddressMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAddressMigration(ctx, req.(*MsgCancelAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VerifyAddressMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyAddressMigration(ctx, req.(*MsgVerifyAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +222,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleAddressMigration",
+			Handler:    _Msg_ScheduleAddressMigration_Handler,
+		},
+		{
+			MethodName: "CancelAddressMigration",
+			Handler:    _Msg_CancelAddressMigration_Handler,
+		},
+		{
+			MethodName: "VerifyAddressMigration",
+			Handler:    _Msg_VerifyAddressMigration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/chainmanager/tx.proto",
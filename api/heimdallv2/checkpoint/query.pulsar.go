@@ -8477,6 +8477,1433 @@ func (x *fastReflection_QueryCheckpointOverviewResponse) ProtoMethods() *protoif
 	}
 }
 
+var (
+	md_QueryCheckpointProofRequest              protoreflect.MessageDescriptor
+	fd_QueryCheckpointProofRequest_block_number protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofRequest_tx_hash      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_checkpoint_query_proto_init()
+	md_QueryCheckpointProofRequest = File_heimdallv2_checkpoint_query_proto.Messages().ByName("QueryCheckpointProofRequest")
+	fd_QueryCheckpointProofRequest_block_number = md_QueryCheckpointProofRequest.Fields().ByName("block_number")
+	fd_QueryCheckpointProofRequest_tx_hash = md_QueryCheckpointProofRequest.Fields().ByName("tx_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCheckpointProofRequest)(nil)
+
+type fastReflection_QueryCheckpointProofRequest QueryCheckpointProofRequest
+
+func (x *QueryCheckpointProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCheckpointProofRequest)(x)
+}
+
+func (x *QueryCheckpointProofRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_checkpoint_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCheckpointProofRequest_messageType fastReflection_QueryCheckpointProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCheckpointProofRequest_messageType{}
+
+type fastReflection_QueryCheckpointProofRequest_messageType struct{}
+
+func (x fastReflection_QueryCheckpointProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCheckpointProofRequest)(nil)
+}
+func (x fastReflection_QueryCheckpointProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCheckpointProofRequest)
+}
+func (x fastReflection_QueryCheckpointProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCheckpointProofRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCheckpointProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCheckpointProofRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCheckpointProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCheckpointProofRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCheckpointProofRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCheckpointProofRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCheckpointProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCheckpointProofRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCheckpointProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_QueryCheckpointProofRequest_block_number, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_QueryCheckpointProofRequest_tx_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCheckpointProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		return x.BlockNumber != uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		return x.TxHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		x.BlockNumber = uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		x.TxHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCheckpointProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		x.BlockNumber = value.Uint()
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		x.TxHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		panic(fmt.Errorf("field block_number of message heimdallv2.checkpoint.QueryCheckpointProofRequest is not mutable"))
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message heimdallv2.checkpoint.QueryCheckpointProofRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCheckpointProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.checkpoint.QueryCheckpointProofRequest.tx_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofRequest"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCheckpointProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.checkpoint.QueryCheckpointProofRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCheckpointProofRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCheckpointProofRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCheckpointProofRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCheckpointProofRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCheckpointProofRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCheckpointProofRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCheckpointProofRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCheckpointProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryCheckpointProofResponse_5_list)(nil)
+
+type _QueryCheckpointProofResponse_5_list struct {
+	list *[][]byte
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryCheckpointProofResponse at list field Proof as it is not of Message kind"))
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_QueryCheckpointProofResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryCheckpointProofResponse_8_list)(nil)
+
+type _QueryCheckpointProofResponse_8_list struct {
+	list *[][]byte
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryCheckpointProofResponse at list field ReceiptProof as it is not of Message kind"))
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_QueryCheckpointProofResponse_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryCheckpointProofResponse                     protoreflect.MessageDescriptor
+	fd_QueryCheckpointProofResponse_checkpoint          protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_header_block_number protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_leaf                protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_leaf_index          protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_proof               protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_tx_index            protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_receipt             protoreflect.FieldDescriptor
+	fd_QueryCheckpointProofResponse_receipt_proof       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_checkpoint_query_proto_init()
+	md_QueryCheckpointProofResponse = File_heimdallv2_checkpoint_query_proto.Messages().ByName("QueryCheckpointProofResponse")
+	fd_QueryCheckpointProofResponse_checkpoint = md_QueryCheckpointProofResponse.Fields().ByName("checkpoint")
+	fd_QueryCheckpointProofResponse_header_block_number = md_QueryCheckpointProofResponse.Fields().ByName("header_block_number")
+	fd_QueryCheckpointProofResponse_leaf = md_QueryCheckpointProofResponse.Fields().ByName("leaf")
+	fd_QueryCheckpointProofResponse_leaf_index = md_QueryCheckpointProofResponse.Fields().ByName("leaf_index")
+	fd_QueryCheckpointProofResponse_proof = md_QueryCheckpointProofResponse.Fields().ByName("proof")
+	fd_QueryCheckpointProofResponse_tx_index = md_QueryCheckpointProofResponse.Fields().ByName("tx_index")
+	fd_QueryCheckpointProofResponse_receipt = md_QueryCheckpointProofResponse.Fields().ByName("receipt")
+	fd_QueryCheckpointProofResponse_receipt_proof = md_QueryCheckpointProofResponse.Fields().ByName("receipt_proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCheckpointProofResponse)(nil)
+
+type fastReflection_QueryCheckpointProofResponse QueryCheckpointProofResponse
+
+func (x *QueryCheckpointProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCheckpointProofResponse)(x)
+}
+
+func (x *QueryCheckpointProofResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_checkpoint_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCheckpointProofResponse_messageType fastReflection_QueryCheckpointProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCheckpointProofResponse_messageType{}
+
+type fastReflection_QueryCheckpointProofResponse_messageType struct{}
+
+func (x fastReflection_QueryCheckpointProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCheckpointProofResponse)(nil)
+}
+func (x fastReflection_QueryCheckpointProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCheckpointProofResponse)
+}
+func (x fastReflection_QueryCheckpointProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCheckpointProofResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCheckpointProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCheckpointProofResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCheckpointProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCheckpointProofResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCheckpointProofResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCheckpointProofResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCheckpointProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCheckpointProofResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCheckpointProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Checkpoint != nil {
+		value := protoreflect.ValueOfMessage(x.Checkpoint.ProtoReflect())
+		if !f(fd_QueryCheckpointProofResponse_checkpoint, value) {
+			return
+		}
+	}
+	if x.HeaderBlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HeaderBlockNumber)
+		if !f(fd_QueryCheckpointProofResponse_header_block_number, value) {
+			return
+		}
+	}
+	if len(x.Leaf) != 0 {
+		value := protoreflect.ValueOfBytes(x.Leaf)
+		if !f(fd_QueryCheckpointProofResponse_leaf, value) {
+			return
+		}
+	}
+	if x.LeafIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeafIndex)
+		if !f(fd_QueryCheckpointProofResponse_leaf_index, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCheckpointProofResponse_5_list{list: &x.Proof})
+		if !f(fd_QueryCheckpointProofResponse_proof, value) {
+			return
+		}
+	}
+	if x.TxIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxIndex)
+		if !f(fd_QueryCheckpointProofResponse_tx_index, value) {
+			return
+		}
+	}
+	if len(x.Receipt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Receipt)
+		if !f(fd_QueryCheckpointProofResponse_receipt, value) {
+			return
+		}
+	}
+	if len(x.ReceiptProof) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCheckpointProofResponse_8_list{list: &x.ReceiptProof})
+		if !f(fd_QueryCheckpointProofResponse_receipt_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCheckpointProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		return x.Checkpoint != nil
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		return x.HeaderBlockNumber != uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		return len(x.Leaf) != 0
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		return x.LeafIndex != uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		return len(x.Proof) != 0
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		return x.TxIndex != uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		return len(x.Receipt) != 0
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		return len(x.ReceiptProof) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		x.Checkpoint = nil
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		x.HeaderBlockNumber = uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		x.Leaf = nil
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		x.LeafIndex = uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		x.Proof = nil
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		x.TxIndex = uint64(0)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		x.Receipt = nil
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		x.ReceiptProof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCheckpointProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		value := x.Checkpoint
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		value := x.HeaderBlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		value := x.Leaf
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		value := x.LeafIndex
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_QueryCheckpointProofResponse_5_list{})
+		}
+		listValue := &_QueryCheckpointProofResponse_5_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		value := x.Receipt
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		if len(x.ReceiptProof) == 0 {
+			return protoreflect.ValueOfList(&_QueryCheckpointProofResponse_8_list{})
+		}
+		listValue := &_QueryCheckpointProofResponse_8_list{list: &x.ReceiptProof}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		x.Checkpoint = value.Message().Interface().(*Checkpoint)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		x.HeaderBlockNumber = value.Uint()
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		x.Leaf = value.Bytes()
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		x.LeafIndex = value.Uint()
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		lv := value.List()
+		clv := lv.(*_QueryCheckpointProofResponse_5_list)
+		x.Proof = *clv.list
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		x.TxIndex = value.Uint()
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		x.Receipt = value.Bytes()
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		lv := value.List()
+		clv := lv.(*_QueryCheckpointProofResponse_8_list)
+		x.ReceiptProof = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		if x.Checkpoint == nil {
+			x.Checkpoint = new(Checkpoint)
+		}
+		return protoreflect.ValueOfMessage(x.Checkpoint.ProtoReflect())
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		if x.Proof == nil {
+			x.Proof = [][]byte{}
+		}
+		value := &_QueryCheckpointProofResponse_5_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		if x.ReceiptProof == nil {
+			x.ReceiptProof = [][]byte{}
+		}
+		value := &_QueryCheckpointProofResponse_8_list{list: &x.ReceiptProof}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		panic(fmt.Errorf("field header_block_number of message heimdallv2.checkpoint.QueryCheckpointProofResponse is not mutable"))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		panic(fmt.Errorf("field leaf of message heimdallv2.checkpoint.QueryCheckpointProofResponse is not mutable"))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		panic(fmt.Errorf("field leaf_index of message heimdallv2.checkpoint.QueryCheckpointProofResponse is not mutable"))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		panic(fmt.Errorf("field tx_index of message heimdallv2.checkpoint.QueryCheckpointProofResponse is not mutable"))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		panic(fmt.Errorf("field receipt of message heimdallv2.checkpoint.QueryCheckpointProofResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCheckpointProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint":
+		m := new(Checkpoint)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.header_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.leaf_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_QueryCheckpointProofResponse_5_list{list: &list})
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.tx_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.checkpoint.QueryCheckpointProofResponse.receipt_proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_QueryCheckpointProofResponse_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.checkpoint.QueryCheckpointProofResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.checkpoint.QueryCheckpointProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCheckpointProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.checkpoint.QueryCheckpointProofResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCheckpointProofResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCheckpointProofResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCheckpointProofResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCheckpointProofResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCheckpointProofResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Checkpoint != nil {
+			l = options.Size(x.Checkpoint)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HeaderBlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.HeaderBlockNumber))
+		}
+		l = len(x.Leaf)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LeafIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafIndex))
+		}
+		if len(x.Proof) > 0 {
+			for _, b := range x.Proof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.Receipt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ReceiptProof) > 0 {
+			for _, b := range x.ReceiptProof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCheckpointProofResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReceiptProof) > 0 {
+			for iNdEx := len(x.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ReceiptProof[iNdEx])
+				copy(dAtA[i:], x.ReceiptProof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptProof[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Receipt) > 0 {
+			i -= len(x.Receipt)
+			copy(dAtA[i:], x.Receipt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receipt)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proof[iNdEx])
+				copy(dAtA[i:], x.Proof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.LeafIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Leaf) > 0 {
+			i -= len(x.Leaf)
+			copy(dAtA[i:], x.Leaf)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Leaf)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.HeaderBlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HeaderBlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Checkpoint != nil {
+			encoded, err := options.Marshal(x.Checkpoint)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCheckpointProofResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCheckpointProofResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCheckpointProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Checkpoint == nil {
+					x.Checkpoint = &Checkpoint{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Checkpoint); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeaderBlockNumber", wireType)
+				}
+				x.HeaderBlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HeaderBlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Leaf = append(x.Leaf[:0], dAtA[iNdEx:postIndex]...)
+				if x.Leaf == nil {
+					x.Leaf = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+				}
+				x.LeafIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proof = append(x.Proof, make([]byte, postIndex-iNdEx))
+				copy(x.Proof[len(x.Proof)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receipt = append(x.Receipt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Receipt == nil {
+					x.Receipt = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptProof = append(x.ReceiptProof, make([]byte, postIndex-iNdEx))
+				copy(x.ReceiptProof[len(x.ReceiptProof)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -9217,6 +10644,157 @@ func (x *QueryCheckpointOverviewResponse) GetValidatorSet() *stake.ValidatorSet
 	return nil
 }
 
+// QueryCheckpointProofRequest is the request type for the GetCheckpointProof
+// query.
+type QueryCheckpointProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bor block number to prove the inclusion of.
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Optional bor tx hash to also prove the inclusion of the receipt of, in the
+	// receipts root of its block. The block number may then be left to 0.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *QueryCheckpointProofRequest) Reset() {
+	*x = QueryCheckpointProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_checkpoint_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCheckpointProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCheckpointProofRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCheckpointProofRequest.ProtoReflect.Descriptor instead.
+func (*QueryCheckpointProofRequest) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_checkpoint_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryCheckpointProofRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *QueryCheckpointProofRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// QueryCheckpointProofResponse is the response type for the
+// GetCheckpointProof query.
+type QueryCheckpointProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Acknowledged checkpoint covering the block.
+	Checkpoint *Checkpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// Header block number of the checkpoint on the root chain contract.
+	HeaderBlockNumber uint64 `protobuf:"varint,2,opt,name=header_block_number,json=headerBlockNumber,proto3" json:"header_block_number,omitempty"`
+	// Merkle leaf of the block, keccak256 of its number, time, tx root and
+	// receipts root.
+	Leaf []byte `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// Index of the leaf in the checkpoint tree, from the checkpoint start block.
+	LeafIndex uint64 `protobuf:"varint,4,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// Sibling hashes from the leaf up to the checkpoint root hash.
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// Index of the tx in its block, set when a tx hash is given.
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Consensus encoding of the tx receipt, set when a tx hash is given.
+	Receipt []byte `protobuf:"bytes,7,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// Receipt trie nodes from the receipts root of the block down to the
+	// receipt, set when a tx hash is given.
+	ReceiptProof [][]byte `protobuf:"bytes,8,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (x *QueryCheckpointProofResponse) Reset() {
+	*x = QueryCheckpointProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_checkpoint_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCheckpointProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCheckpointProofResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCheckpointProofResponse.ProtoReflect.Descriptor instead.
+func (*QueryCheckpointProofResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_checkpoint_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryCheckpointProofResponse) GetCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *QueryCheckpointProofResponse) GetHeaderBlockNumber() uint64 {
+	if x != nil {
+		return x.HeaderBlockNumber
+	}
+	return 0
+}
+
+func (x *QueryCheckpointProofResponse) GetLeaf() []byte {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *QueryCheckpointProofResponse) GetLeafIndex() uint64 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *QueryCheckpointProofResponse) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *QueryCheckpointProofResponse) GetTxIndex() uint64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *QueryCheckpointProofResponse) GetReceipt() []byte {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *QueryCheckpointProofResponse) GetReceiptProof() [][]byte {
+	if x != nil {
+		return x.ReceiptProof
+	}
+	return nil
+}
+
 var File_heimdallv2_checkpoint_query_proto protoreflect.FileDescriptor
 
 var file_heimdallv2_checkpoint_query_proto_rawDesc = []byte{
@@ -9352,7 +10930,35 @@ var file_heimdallv2_checkpoint_query_proto_rawDesc = []byte{
 	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde,
 	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x65, 0x74, 0x32, 0xc1, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xdb, 0x02, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x24, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x05, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x32, 0xec, 0x0d, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
 	0x8e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
 	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61,
 	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
@@ -9452,21 +11058,32 @@ var file_heimdallv2_checkpoint_query_proto_rawDesc = []byte{
 	0x65, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93,
 	0x02, 0x17, 0x12, 0x15, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x42, 0xd8, 0x01, 0x0a, 0x19, 0x63, 0x6f,
-	0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d,
-	0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d,
-	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x15, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
-	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0xca,
-	0x02, 0x15, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
-	0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x48, 0x65,
-	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x32, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x12, 0x21, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x7d, 0x42, 0xd8, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d,
+	0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x48,
+	0x43, 0x58, 0xaa, 0x02, 0x15, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x48, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0xe2, 0x02, 0x21, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9481,7 +11098,7 @@ func file_heimdallv2_checkpoint_query_proto_rawDescGZIP() []byte {
 	return file_heimdallv2_checkpoint_query_proto_rawDescData
 }
 
-var file_heimdallv2_checkpoint_query_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_heimdallv2_checkpoint_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_heimdallv2_checkpoint_query_proto_goTypes = []interface{}{
 	(*QueryCheckpointSignaturesRequest)(nil),  // 0: heimdallv2.checkpoint.QueryCheckpointSignaturesRequest
 	(*QueryCheckpointSignaturesResponse)(nil), // 1: heimdallv2.checkpoint.QueryCheckpointSignaturesResponse
@@ -9503,51 +11120,56 @@ var file_heimdallv2_checkpoint_query_proto_goTypes = []interface{}{
 	(*QueryCheckpointListResponse)(nil),       // 17: heimdallv2.checkpoint.QueryCheckpointListResponse
 	(*QueryCheckpointOverviewRequest)(nil),    // 18: heimdallv2.checkpoint.QueryCheckpointOverviewRequest
 	(*QueryCheckpointOverviewResponse)(nil),   // 19: heimdallv2.checkpoint.QueryCheckpointOverviewResponse
-	(*CheckpointSignature)(nil),               // 20: heimdallv2.checkpoint.CheckpointSignature
-	(*Params)(nil),                            // 21: heimdallv2.checkpoint.Params
-	(*Checkpoint)(nil),                        // 22: heimdallv2.checkpoint.Checkpoint
-	(*MsgCheckpoint)(nil),                     // 23: heimdallv2.checkpoint.MsgCheckpoint
-	(*v1beta1.PageRequest)(nil),               // 24: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),              // 25: cosmos.base.query.v1beta1.PageResponse
-	(*stake.ValidatorSet)(nil),                // 26: heimdallv2.stake.ValidatorSet
+	(*QueryCheckpointProofRequest)(nil),       // 20: heimdallv2.checkpoint.QueryCheckpointProofRequest
+	(*QueryCheckpointProofResponse)(nil),      // 21: heimdallv2.checkpoint.QueryCheckpointProofResponse
+	(*CheckpointSignature)(nil),               // 22: heimdallv2.checkpoint.CheckpointSignature
+	(*Params)(nil),                            // 23: heimdallv2.checkpoint.Params
+	(*Checkpoint)(nil),                        // 24: heimdallv2.checkpoint.Checkpoint
+	(*MsgCheckpoint)(nil),                     // 25: heimdallv2.checkpoint.MsgCheckpoint
+	(*v1beta1.PageRequest)(nil),               // 26: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),              // 27: cosmos.base.query.v1beta1.PageResponse
+	(*stake.ValidatorSet)(nil),                // 28: heimdallv2.stake.ValidatorSet
 }
 var file_heimdallv2_checkpoint_query_proto_depIdxs = []int32{
-	20, // 0: heimdallv2.checkpoint.QueryCheckpointSignaturesResponse.signatures:type_name -> heimdallv2.checkpoint.CheckpointSignature
-	21, // 1: heimdallv2.checkpoint.QueryParamsResponse.params:type_name -> heimdallv2.checkpoint.Params
-	22, // 2: heimdallv2.checkpoint.QueryCheckpointBufferResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
-	22, // 3: heimdallv2.checkpoint.QueryCheckpointResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
-	22, // 4: heimdallv2.checkpoint.QueryCheckpointLatestResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
-	23, // 5: heimdallv2.checkpoint.QueryNextCheckpointResponse.checkpoint:type_name -> heimdallv2.checkpoint.MsgCheckpoint
-	24, // 6: heimdallv2.checkpoint.QueryCheckpointListRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 7: heimdallv2.checkpoint.QueryCheckpointListResponse.checkpoint_list:type_name -> heimdallv2.checkpoint.Checkpoint
-	25, // 8: heimdallv2.checkpoint.QueryCheckpointListResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	22, // 9: heimdallv2.checkpoint.QueryCheckpointOverviewResponse.buffer_checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
-	26, // 10: heimdallv2.checkpoint.QueryCheckpointOverviewResponse.validator_set:type_name -> heimdallv2.stake.ValidatorSet
-	2,  // 11: heimdallv2.checkpoint.Query.GetCheckpointParams:input_type -> heimdallv2.checkpoint.QueryParamsRequest
-	18, // 12: heimdallv2.checkpoint.Query.GetCheckpointOverview:input_type -> heimdallv2.checkpoint.QueryCheckpointOverviewRequest
-	4,  // 13: heimdallv2.checkpoint.Query.GetAckCount:input_type -> heimdallv2.checkpoint.QueryAckCountRequest
-	12, // 14: heimdallv2.checkpoint.Query.GetCheckpointLatest:input_type -> heimdallv2.checkpoint.QueryCheckpointLatestRequest
-	8,  // 15: heimdallv2.checkpoint.Query.GetCheckpointBuffer:input_type -> heimdallv2.checkpoint.QueryCheckpointBufferRequest
-	6,  // 16: heimdallv2.checkpoint.Query.GetLastNoAck:input_type -> heimdallv2.checkpoint.QueryLastNoAckRequest
-	14, // 17: heimdallv2.checkpoint.Query.GetNextCheckpoint:input_type -> heimdallv2.checkpoint.QueryNextCheckpointRequest
-	16, // 18: heimdallv2.checkpoint.Query.GetCheckpointList:input_type -> heimdallv2.checkpoint.QueryCheckpointListRequest
-	0,  // 19: heimdallv2.checkpoint.Query.GetCheckpointSignatures:input_type -> heimdallv2.checkpoint.QueryCheckpointSignaturesRequest
-	10, // 20: heimdallv2.checkpoint.Query.GetCheckpoint:input_type -> heimdallv2.checkpoint.QueryCheckpointRequest
-	3,  // 21: heimdallv2.checkpoint.Query.GetCheckpointParams:output_type -> heimdallv2.checkpoint.QueryParamsResponse
-	19, // 22: heimdallv2.checkpoint.Query.GetCheckpointOverview:output_type -> heimdallv2.checkpoint.QueryCheckpointOverviewResponse
-	5,  // 23: heimdallv2.checkpoint.Query.GetAckCount:output_type -> heimdallv2.checkpoint.QueryAckCountResponse
-	13, // 24: heimdallv2.checkpoint.Query.GetCheckpointLatest:output_type -> heimdallv2.checkpoint.QueryCheckpointLatestResponse
-	9,  // 25: heimdallv2.checkpoint.Query.GetCheckpointBuffer:output_type -> heimdallv2.checkpoint.QueryCheckpointBufferResponse
-	7,  // 26: heimdallv2.checkpoint.Query.GetLastNoAck:output_type -> heimdallv2.checkpoint.QueryLastNoAckResponse
-	15, // 27: heimdallv2.checkpoint.Query.GetNextCheckpoint:output_type -> heimdallv2.checkpoint.QueryNextCheckpointResponse
-	17, // 28: heimdallv2.checkpoint.Query.GetCheckpointList:output_type -> heimdallv2.checkpoint.QueryCheckpointListResponse
-	1,  // 29: heimdallv2.checkpoint.Query.GetCheckpointSignatures:output_type -> heimdallv2.checkpoint.QueryCheckpointSignaturesResponse
-	11, // 30: heimdallv2.checkpoint.Query.GetCheckpoint:output_type -> heimdallv2.checkpoint.QueryCheckpointResponse
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	22, // 0: heimdallv2.checkpoint.QueryCheckpointSignaturesResponse.signatures:type_name -> heimdallv2.checkpoint.CheckpointSignature
+	23, // 1: heimdallv2.checkpoint.QueryParamsResponse.params:type_name -> heimdallv2.checkpoint.Params
+	24, // 2: heimdallv2.checkpoint.QueryCheckpointBufferResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
+	24, // 3: heimdallv2.checkpoint.QueryCheckpointResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
+	24, // 4: heimdallv2.checkpoint.QueryCheckpointLatestResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
+	25, // 5: heimdallv2.checkpoint.QueryNextCheckpointResponse.checkpoint:type_name -> heimdallv2.checkpoint.MsgCheckpoint
+	26, // 6: heimdallv2.checkpoint.QueryCheckpointListRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	24, // 7: heimdallv2.checkpoint.QueryCheckpointListResponse.checkpoint_list:type_name -> heimdallv2.checkpoint.Checkpoint
+	27, // 8: heimdallv2.checkpoint.QueryCheckpointListResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	24, // 9: heimdallv2.checkpoint.QueryCheckpointOverviewResponse.buffer_checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
+	28, // 10: heimdallv2.checkpoint.QueryCheckpointOverviewResponse.validator_set:type_name -> heimdallv2.stake.ValidatorSet
+	24, // 11: heimdallv2.checkpoint.QueryCheckpointProofResponse.checkpoint:type_name -> heimdallv2.checkpoint.Checkpoint
+	2,  // 12: heimdallv2.checkpoint.Query.GetCheckpointParams:input_type -> heimdallv2.checkpoint.QueryParamsRequest
+	18, // 13: heimdallv2.checkpoint.Query.GetCheckpointOverview:input_type -> heimdallv2.checkpoint.QueryCheckpointOverviewRequest
+	4,  // 14: heimdallv2.checkpoint.Query.GetAckCount:input_type -> heimdallv2.checkpoint.QueryAckCountRequest
+	12, // 15: heimdallv2.checkpoint.Query.GetCheckpointLatest:input_type -> heimdallv2.checkpoint.QueryCheckpointLatestRequest
+	8,  // 16: heimdallv2.checkpoint.Query.GetCheckpointBuffer:input_type -> heimdallv2.checkpoint.QueryCheckpointBufferRequest
+	6,  // 17: heimdallv2.checkpoint.Query.GetLastNoAck:input_type -> heimdallv2.checkpoint.QueryLastNoAckRequest
+	14, // 18: heimdallv2.checkpoint.Query.GetNextCheckpoint:input_type -> heimdallv2.checkpoint.QueryNextCheckpointRequest
+	16, // 19: heimdallv2.checkpoint.Query.GetCheckpointList:input_type -> heimdallv2.checkpoint.QueryCheckpointListRequest
+	0,  // 20: heimdallv2.checkpoint.Query.GetCheckpointSignatures:input_type -> heimdallv2.checkpoint.QueryCheckpointSignaturesRequest
+	10, // 21: heimdallv2.checkpoint.Query.GetCheckpoint:input_type -> heimdallv2.checkpoint.QueryCheckpointRequest
+	20, // 22: heimdallv2.checkpoint.Query.GetCheckpointProof:input_type -> heimdallv2.checkpoint.QueryCheckpointProofRequest
+	3,  // 23: heimdallv2.checkpoint.Query.GetCheckpointParams:output_type -> heimdallv2.checkpoint.QueryParamsResponse
+	19, // 24: heimdallv2.checkpoint.Query.GetCheckpointOverview:output_type -> heimdallv2.checkpoint.QueryCheckpointOverviewResponse
+	5,  // 25: heimdallv2.checkpoint.Query.GetAckCount:output_type -> heimdallv2.checkpoint.QueryAckCountResponse
+	13, // 26: heimdallv2.checkpoint.Query.GetCheckpointLatest:output_type -> heimdallv2.checkpoint.QueryCheckpointLatestResponse
+	9,  // 27: heimdallv2.checkpoint.Query.GetCheckpointBuffer:output_type -> heimdallv2.checkpoint.QueryCheckpointBufferResponse
+	7,  // 28: heimdallv2.checkpoint.Query.GetLastNoAck:output_type -> heimdallv2.checkpoint.QueryLastNoAckResponse
+	15, // 29: heimdallv2.checkpoint.Query.GetNextCheckpoint:output_type -> heimdallv2.checkpoint.QueryNextCheckpointResponse
+	17, // 30: heimdallv2.checkpoint.Query.GetCheckpointList:output_type -> heimdallv2.checkpoint.QueryCheckpointListResponse
+	1,  // 31: heimdallv2.checkpoint.Query.GetCheckpointSignatures:output_type -> heimdallv2.checkpoint.QueryCheckpointSignaturesResponse
+	11, // 32: heimdallv2.checkpoint.Query.GetCheckpoint:output_type -> heimdallv2.checkpoint.QueryCheckpointResponse
+	21, // 33: heimdallv2.checkpoint.Query.GetCheckpointProof:output_type -> heimdallv2.checkpoint.QueryCheckpointProofResponse
+	23, // [23:34] is the sub-list for method output_type
+	12, // [12:23] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_checkpoint_query_proto_init() }
@@ -9799,6 +11421,30 @@ func file_heimdallv2_checkpoint_query_proto_init() {
 				return nil
 			}
 		}
+		file_heimdallv2_checkpoint_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCheckpointProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_checkpoint_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCheckpointProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -9806,7 +11452,7 @@ func file_heimdallv2_checkpoint_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_heimdallv2_checkpoint_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,7 @@ const (
 	Query_GetCheckpointList_FullMethodName       = "/heimdallv2.checkpoint.Query/GetCheckpointList"
 	Query_GetCheckpointSignatures_FullMethodName = "/heimdallv2.checkpoint.Query/GetCheckpointSignatures"
 	Query_GetCheckpoint_FullMethodName           = "/heimdallv2.checkpoint.Query/GetCheckpoint"
+	Query_GetCheckpointProof_FullMethodName      = "/heimdallv2.checkpoint.Query/GetCheckpointProof"
 )
 
 // QueryClient is the client API for Query service.
@@ -57,6 +58,9 @@ type QueryClient interface {
 	GetCheckpointSignatures(ctx context.Context, in *QueryCheckpointSignaturesRequest, opts ...grpc.CallOption) (*QueryCheckpointSignaturesResponse, error)
 	// GetCheckpoint queries a specific checkpoint by its ID number.
 	GetCheckpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+	// GetCheckpointProof queries the Merkle proof of inclusion of a bor block
+	// in the acknowledged checkpoint covering it.
+	GetCheckpointProof(ctx context.Context, in *QueryCheckpointProofRequest, opts ...grpc.CallOption) (*QueryCheckpointProofResponse, error)
 }
 
 type queryClient struct {
@@ -157,6 +161,15 @@ func (c *queryClient) GetCheckpoint(ctx context.Context, in *QueryCheckpointRequ
 	return out, nil
 }
 
+func (c *queryClient) GetCheckpointProof(ctx context.Context, in *QueryCheckpointProofRequest, opts ...grpc.CallOption) (*QueryCheckpointProofResponse, error) {
+	out := new(QueryCheckpointProofResponse)
+	err := c.cc.Invoke(ctx, Query_GetCheckpointProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -183,6 +196,9 @@ type QueryServer interface {
 	GetCheckpointSignatures(context.Context, *QueryCheckpointSignaturesRequest) (*QueryCheckpointSignaturesResponse, error)
 	// GetCheckpoint queries a specific checkpoint by its ID number.
 	GetCheckpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+	// GetCheckpointProof queries the Merkle proof of inclusion of a bor block
+	// in the acknowledged checkpoint covering it.
+	GetCheckpointProof(context.Context, *QueryCheckpointProofRequest) (*QueryCheckpointProofResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -220,6 +236,9 @@ func (UnimplementedQueryServer) GetCheckpointSignatures(context.Context, *QueryC
 func (UnimplementedQueryServer) GetCheckpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpoint not implemented")
 }
+func (UnimplementedQueryServer) GetCheckpointProof(context.Context, *QueryCheckpointProofRequest) (*QueryCheckpointProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpointProof not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -413,6 +432,24 @@ func _Query_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCheckpointProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCheckpointProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCheckpointProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCheckpointProof(ctx, req.(*QueryCheckpointProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -460,6 +497,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCheckpoint",
 			Handler:    _Query_GetCheckpoint_Handler,
 		},
+		{
+			MethodName: "GetCheckpointProof",
+			Handler:    _Query_GetCheckpointProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/checkpoint/query.proto",
@@ -1171,6 +1171,9 @@ var (
 	fd_GenesisState_pending_visibility_event_ids protoreflect.FieldDescriptor
 	fd_GenesisState_visibility_heights_by_id     protoreflect.FieldDescriptor
 	fd_GenesisState_block_time_entries           protoreflect.FieldDescriptor
+	fd_GenesisState_params                       protoreflect.FieldDescriptor
+	fd_GenesisState_record_accumulator           protoreflect.FieldDescriptor
+	fd_GenesisState_bor_consumed_record_id       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1182,6 +1185,9 @@ func init() {
 	fd_GenesisState_pending_visibility_event_ids = md_GenesisState.Fields().ByName("pending_visibility_event_ids")
 	fd_GenesisState_visibility_heights_by_id = md_GenesisState.Fields().ByName("visibility_heights_by_id")
 	fd_GenesisState_block_time_entries = md_GenesisState.Fields().ByName("block_time_entries")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_record_accumulator = md_GenesisState.Fields().ByName("record_accumulator")
+	fd_GenesisState_bor_consumed_record_id = md_GenesisState.Fields().ByName("bor_consumed_record_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -1285,6 +1291,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if x.RecordAccumulator != nil {
+		value := protoreflect.ValueOfMessage(x.RecordAccumulator.ProtoReflect())
+		if !f(fd_GenesisState_record_accumulator, value) {
+			return
+		}
+	}
+	if x.BorConsumedRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BorConsumedRecordId)
+		if !f(fd_GenesisState_bor_consumed_record_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1312,6 +1336,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.VisibilityHeightsById) != 0
 	case "heimdallv2.clerk.GenesisState.block_time_entries":
 		return len(x.BlockTimeEntries) != 0
+	case "heimdallv2.clerk.GenesisState.params":
+		return x.Params != nil
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		return x.RecordAccumulator != nil
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		return x.BorConsumedRecordId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1340,6 +1370,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.VisibilityHeightsById = nil
 	case "heimdallv2.clerk.GenesisState.block_time_entries":
 		x.BlockTimeEntries = nil
+	case "heimdallv2.clerk.GenesisState.params":
+		x.Params = nil
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		x.RecordAccumulator = nil
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		x.BorConsumedRecordId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1389,6 +1425,15 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_6_list{list: &x.BlockTimeEntries}
 		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.clerk.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		value := x.RecordAccumulator
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		value := x.BorConsumedRecordId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1431,6 +1476,12 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_6_list)
 		x.BlockTimeEntries = *clv.list
+	case "heimdallv2.clerk.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		x.RecordAccumulator = value.Message().Interface().(*RecordAccumulator)
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		x.BorConsumedRecordId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1481,8 +1532,20 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_6_list{list: &x.BlockTimeEntries}
 		return protoreflect.ValueOfList(value)
+	case "heimdallv2.clerk.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		if x.RecordAccumulator == nil {
+			x.RecordAccumulator = new(RecordAccumulator)
+		}
+		return protoreflect.ValueOfMessage(x.RecordAccumulator.ProtoReflect())
 	case "heimdallv2.clerk.GenesisState.visibility_time_upgrade_id":
 		panic(fmt.Errorf("field visibility_time_upgrade_id of message heimdallv2.clerk.GenesisState is not mutable"))
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		panic(fmt.Errorf("field bor_consumed_record_id of message heimdallv2.clerk.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1513,6 +1576,14 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "heimdallv2.clerk.GenesisState.block_time_entries":
 		list := []*BlockTimeEntry{}
 		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "heimdallv2.clerk.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "heimdallv2.clerk.GenesisState.record_accumulator":
+		m := new(RecordAccumulator)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "heimdallv2.clerk.GenesisState.bor_consumed_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.clerk.GenesisState"))
@@ -1616,6 +1687,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecordAccumulator != nil {
+			l = options.Size(x.RecordAccumulator)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BorConsumedRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BorConsumedRecordId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1645,6 +1727,39 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BorConsumedRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BorConsumedRecordId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RecordAccumulator != nil {
+			encoded, err := options.Marshal(x.RecordAccumulator)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.BlockTimeEntries) > 0 {
 			for iNdEx := len(x.BlockTimeEntries) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.BlockTimeEntries[iNdEx])
@@ -2005,6 +2120,97 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordAccumulator", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecordAccumulator == nil {
+					x.RecordAccumulator = &RecordAccumulator{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecordAccumulator); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorConsumedRecordId", wireType)
+				}
+				x.BorConsumedRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BorConsumedRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2164,6 +2370,12 @@ type GenesisState struct {
 	VisibilityHeightsById []*Uint64Pair `protobuf:"bytes,5,rep,name=visibility_heights_by_id,json=visibilityHeightsById,proto3" json:"visibility_heights_by_id,omitempty"`
 	// Block time reverse index: (block_time, height) → height for cutoff lookups.
 	BlockTimeEntries []*BlockTimeEntry `protobuf:"bytes,6,rep,name=block_time_entries,json=blockTimeEntries,proto3" json:"block_time_entries,omitempty"`
+	// Module parameters.
+	Params *Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`
+	// Accumulator over the pruned event records.
+	RecordAccumulator *RecordAccumulator `protobuf:"bytes,8,opt,name=record_accumulator,json=recordAccumulator,proto3" json:"record_accumulator,omitempty"`
+	// ID of the last event record consumed by bor.
+	BorConsumedRecordId uint64 `protobuf:"varint,9,opt,name=bor_consumed_record_id,json=borConsumedRecordId,proto3" json:"bor_consumed_record_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2228,6 +2440,27 @@ func (x *GenesisState) GetBlockTimeEntries() []*BlockTimeEntry {
 	return nil
 }
 
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRecordAccumulator() *RecordAccumulator {
+	if x != nil {
+		return x.RecordAccumulator
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBorConsumedRecordId() uint64 {
+	if x != nil {
+		return x.BorConsumedRecordId
+	}
+	return 0
+}
+
 var File_heimdallv2_clerk_genesis_proto protoreflect.FileDescriptor
 
 var file_heimdallv2_clerk_genesis_proto_rawDesc = []byte{
@@ -2246,7 +2479,7 @@ var file_heimdallv2_clerk_genesis_proto_rawDesc = []byte{
 	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
 	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc0, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x65, 0x76, 0x65,
 	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x6c,
@@ -2274,20 +2507,33 @@ var file_heimdallv2_clerk_genesis_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63,
 	0x6c, 0x65, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0xbc, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63,
-	0x6c, 0x65, 0x72, 0x6b, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x65, 0x72, 0x6b, 0xa2, 0x02, 0x03, 0x48, 0x43,
-	0x58, 0xaa, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x43,
-	0x6c, 0x65, 0x72, 0x6b, 0xca, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
-	0x32, 0x5c, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0xe2, 0x02, 0x1c, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
-	0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
-	0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x63, 0x6c, 0x65, 0x72, 0x6b, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x2e, 0x63, 0x6c, 0x65, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x62, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x42, 0xbc, 0x01, 0x0a,
+	0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x63, 0x6c, 0x65, 0x72, 0x6b, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x65, 0x72, 0x6b, 0xa2, 0x02, 0x03, 0x48,
+	0x43, 0x58, 0xaa, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e,
+	0x43, 0x6c, 0x65, 0x72, 0x6b, 0xca, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x76, 0x32, 0x5c, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0xe2, 0x02, 0x1c, 0x48, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
+	0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x43, 0x6c, 0x65, 0x72, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2304,20 +2550,24 @@ func file_heimdallv2_clerk_genesis_proto_rawDescGZIP() []byte {
 
 var file_heimdallv2_clerk_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_heimdallv2_clerk_genesis_proto_goTypes = []interface{}{
-	(*Uint64Pair)(nil),     // 0: heimdallv2.clerk.Uint64Pair
-	(*BlockTimeEntry)(nil), // 1: heimdallv2.clerk.BlockTimeEntry
-	(*GenesisState)(nil),   // 2: heimdallv2.clerk.GenesisState
-	(*EventRecord)(nil),    // 3: heimdallv2.clerk.EventRecord
+	(*Uint64Pair)(nil),        // 0: heimdallv2.clerk.Uint64Pair
+	(*BlockTimeEntry)(nil),    // 1: heimdallv2.clerk.BlockTimeEntry
+	(*GenesisState)(nil),      // 2: heimdallv2.clerk.GenesisState
+	(*EventRecord)(nil),       // 3: heimdallv2.clerk.EventRecord
+	(*Params)(nil),            // 4: heimdallv2.clerk.Params
+	(*RecordAccumulator)(nil), // 5: heimdallv2.clerk.RecordAccumulator
 }
 var file_heimdallv2_clerk_genesis_proto_depIdxs = []int32{
 	3, // 0: heimdallv2.clerk.GenesisState.event_records:type_name -> heimdallv2.clerk.EventRecord
 	0, // 1: heimdallv2.clerk.GenesisState.visibility_heights_by_id:type_name -> heimdallv2.clerk.Uint64Pair
 	1, // 2: heimdallv2.clerk.GenesisState.block_time_entries:type_name -> heimdallv2.clerk.BlockTimeEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: heimdallv2.clerk.GenesisState.params:type_name -> heimdallv2.clerk.Params
+	5, // 4: heimdallv2.clerk.GenesisState.record_accumulator:type_name -> heimdallv2.clerk.RecordAccumulator
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_clerk_genesis_proto_init() }
@@ -19,13 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GetRecordCount_FullMethodName        = "/heimdallv2.clerk.Query/GetRecordCount"
-	Query_GetRecordList_FullMethodName         = "/heimdallv2.clerk.Query/GetRecordList"
-	Query_GetLatestRecordId_FullMethodName     = "/heimdallv2.clerk.Query/GetLatestRecordId"
-	Query_GetRecordById_FullMethodName         = "/heimdallv2.clerk.Query/GetRecordById"
-	Query_GetRecordListWithTime_FullMethodName = "/heimdallv2.clerk.Query/GetRecordListWithTime"
-	Query_GetRecordSequence_FullMethodName     = "/heimdallv2.clerk.Query/GetRecordSequence"
-	Query_IsClerkTxOld_FullMethodName          = "/heimdallv2.clerk.Query/IsClerkTxOld"
+	Query_GetRecordCount_FullMethodName               = "/heimdallv2.clerk.Query/GetRecordCount"
+	Query_GetRecordList_FullMethodName                = "/heimdallv2.clerk.Query/GetRecordList"
+	Query_GetLatestRecordId_FullMethodName            = "/heimdallv2.clerk.Query/GetLatestRecordId"
+	Query_GetRecordById_FullMethodName                = "/heimdallv2.clerk.Query/GetRecordById"
+	Query_GetRecordListWithTime_FullMethodName        = "/heimdallv2.clerk.Query/GetRecordListWithTime"
+	Query_GetRecordSequence_FullMethodName            = "/heimdallv2.clerk.Query/GetRecordSequence"
+	Query_IsClerkTxOld_FullMethodName                 = "/heimdallv2.clerk.Query/IsClerkTxOld"
+	Query_GetRecordsByContract_FullMethodName         = "/heimdallv2.clerk.Query/GetRecordsByContract"
+	Query_GetRecordsByTxHash_FullMethodName           = "/heimdallv2.clerk.Query/GetRecordsByTxHash"
+	Query_GetRecordsByVisibilityHeight_FullMethodName = "/heimdallv2.clerk.Query/GetRecordsByVisibilityHeight"
+	Query_GetClerkParams_FullMethodName               = "/heimdallv2.clerk.Query/GetClerkParams"
+	Query_GetRecordAccumulator_FullMethodName         = "/heimdallv2.clerk.Query/GetRecordAccumulator"
+	Query_GetRecordAccumulatorProof_FullMethodName    = "/heimdallv2.clerk.Query/GetRecordAccumulatorProof"
+	Query_GetBorConsumedRecordId_FullMethodName       = "/heimdallv2.clerk.Query/GetBorConsumedRecordId"
+	Query_SubscribeRecords_FullMethodName             = "/heimdallv2.clerk.Query/SubscribeRecords"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +54,31 @@ type QueryClient interface {
 	GetRecordSequence(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*RecordSequenceResponse, error)
 	// IsClerkTxOld checks if a clerk transaction has already been submitted.
 	IsClerkTxOld(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*IsClerkTxOldResponse, error)
+	// GetRecordsByContract queries the event records targeting a receiver
+	// contract, in ID order from the given ID.
+	GetRecordsByContract(ctx context.Context, in *RecordsByContractRequest, opts ...grpc.CallOption) (*RecordsByContractResponse, error)
+	// GetRecordsByTxHash queries the event records emitted by a root chain
+	// transaction.
+	GetRecordsByTxHash(ctx context.Context, in *RecordsByTxHashRequest, opts ...grpc.CallOption) (*RecordsByTxHashResponse, error)
+	// GetRecordsByVisibilityHeight queries the event records which became
+	// visible within a range of heimdall heights.
+	GetRecordsByVisibilityHeight(ctx context.Context, in *RecordsByVisibilityHeightRequest, opts ...grpc.CallOption) (*RecordsByVisibilityHeightResponse, error)
+	// GetClerkParams queries the clerk module parameters.
+	GetClerkParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetRecordAccumulator queries the accumulator over the pruned event records.
+	GetRecordAccumulator(ctx context.Context, in *RecordAccumulatorRequest, opts ...grpc.CallOption) (*RecordAccumulatorResponse, error)
+	// GetRecordAccumulatorProof queries a pruned event record and its inclusion
+	// proof in the accumulator. It is only served by nodes keeping a record
+	// archive.
+	GetRecordAccumulatorProof(ctx context.Context, in *RecordAccumulatorProofRequest, opts ...grpc.CallOption) (*RecordAccumulatorProofResponse, error)
+	// GetBorConsumedRecordId queries the ID of the last event record consumed by
+	// bor.
+	GetBorConsumedRecordId(ctx context.Context, in *BorConsumedRecordIdRequest, opts ...grpc.CallOption) (*BorConsumedRecordIdResponse, error)
+	// SubscribeRecords streams the event records in ID order from the given ID,
+	// each one once its visibility height is assigned. It is served on gRPC
+	// only; the REST server bridges it to server-sent events on
+	// /clerk/event-records/subscribe.
+	SubscribeRecords(ctx context.Context, in *SubscribeRecordsRequest, opts ...grpc.CallOption) (Query_SubscribeRecordsClient, error)
 }
 
 type queryClient struct {
@@ -119,6 +152,101 @@ func (c *queryClient) IsClerkTxOld(ctx context.Context, in *RecordSequenceReques
 	return out, nil
 }
 
+func (c *queryClient) GetRecordsByContract(ctx context.Context, in *RecordsByContractRequest, opts ...grpc.CallOption) (*RecordsByContractResponse, error) {
+	out := new(RecordsByContractResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordsByContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordsByTxHash(ctx context.Context, in *RecordsByTxHashRequest, opts ...grpc.CallOption) (*RecordsByTxHashResponse, error) {
+	out := new(RecordsByTxHashResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordsByTxHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordsByVisibilityHeight(ctx context.Context, in *RecordsByVisibilityHeightRequest, opts ...grpc.CallOption) (*RecordsByVisibilityHeightResponse, error) {
+	out := new(RecordsByVisibilityHeightResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordsByVisibilityHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClerkParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetClerkParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordAccumulator(ctx context.Context, in *RecordAccumulatorRequest, opts ...grpc.CallOption) (*RecordAccumulatorResponse, error) {
+	out := new(RecordAccumulatorResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordAccumulator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordAccumulatorProof(ctx context.Context, in *RecordAccumulatorProofRequest, opts ...grpc.CallOption) (*RecordAccumulatorProofResponse, error) {
+	out := new(RecordAccumulatorProofResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordAccumulatorProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBorConsumedRecordId(ctx context.Context, in *BorConsumedRecordIdRequest, opts ...grpc.CallOption) (*BorConsumedRecordIdResponse, error) {
+	out := new(BorConsumedRecordIdResponse)
+	err := c.cc.Invoke(ctx, Query_GetBorConsumedRecordId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeRecords(ctx context.Context, in *SubscribeRecordsRequest, opts ...grpc.CallOption) (Query_SubscribeRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_SubscribeRecords_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeRecordsClient interface {
+	Recv() (*SubscribeRecordsResponse, error)
+	grpc.ClientStream
+}
+
+type querySubscribeRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeRecordsClient) Recv() (*SubscribeRecordsResponse, error) {
+	m := new(SubscribeRecordsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -137,6 +265,31 @@ type QueryServer interface {
 	GetRecordSequence(context.Context, *RecordSequenceRequest) (*RecordSequenceResponse, error)
 	// IsClerkTxOld checks if a clerk transaction has already been submitted.
 	IsClerkTxOld(context.Context, *RecordSequenceRequest) (*IsClerkTxOldResponse, error)
+	// GetRecordsByContract queries the event records targeting a receiver
+	// contract, in ID order from the given ID.
+	GetRecordsByContract(context.Context, *RecordsByContractRequest) (*RecordsByContractResponse, error)
+	// GetRecordsByTxHash queries the event records emitted by a root chain
+	// transaction.
+	GetRecordsByTxHash(context.Context, *RecordsByTxHashRequest) (*RecordsByTxHashResponse, error)
+	// GetRecordsByVisibilityHeight queries the event records which became
+	// visible within a range of heimdall heights.
+	GetRecordsByVisibilityHeight(context.Context, *RecordsByVisibilityHeightRequest) (*RecordsByVisibilityHeightResponse, error)
+	// GetClerkParams queries the clerk module parameters.
+	GetClerkParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetRecordAccumulator queries the accumulator over the pruned event records.
+	GetRecordAccumulator(context.Context, *RecordAccumulatorRequest) (*RecordAccumulatorResponse, error)
+	// GetRecordAccumulatorProof queries a pruned event record and its inclusion
+	// proof in the accumulator. It is only served by nodes keeping a record
+	// archive.
+	GetRecordAccumulatorProof(context.Context, *RecordAccumulatorProofRequest) (*RecordAccumulatorProofResponse, error)
+	// GetBorConsumedRecordId queries the ID of the last event record consumed by
+	// bor.
+	GetBorConsumedRecordId(context.Context, *BorConsumedRecordIdRequest) (*BorConsumedRecordIdResponse, error)
+	// SubscribeRecords streams the event records in ID order from the given ID,
+	// each one once its visibility height is assigned. It is served on gRPC
+	// only; the REST server bridges it to server-sent events on
+	// /clerk/event-records/subscribe.
+	SubscribeRecords(*SubscribeRecordsRequest, Query_SubscribeRecordsServer) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -165,6 +318,30 @@ func (UnimplementedQueryServer) GetRecordSequence(context.Context, *RecordSequen
 func (UnimplementedQueryServer) IsClerkTxOld(context.Context, *RecordSequenceRequest) (*IsClerkTxOldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsClerkTxOld not implemented")
 }
+func (UnimplementedQueryServer) GetRecordsByContract(context.Context, *RecordsByContractRequest) (*RecordsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByContract not implemented")
+}
+func (UnimplementedQueryServer) GetRecordsByTxHash(context.Context, *RecordsByTxHashRequest) (*RecordsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByTxHash not implemented")
+}
+func (UnimplementedQueryServer) GetRecordsByVisibilityHeight(context.Context, *RecordsByVisibilityHeightRequest) (*RecordsByVisibilityHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByVisibilityHeight not implemented")
+}
+func (UnimplementedQueryServer) GetClerkParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClerkParams not implemented")
+}
+func (UnimplementedQueryServer) GetRecordAccumulator(context.Context, *RecordAccumulatorRequest) (*RecordAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordAccumulator not implemented")
+}
+func (UnimplementedQueryServer) GetRecordAccumulatorProof(context.Context, *RecordAccumulatorProofRequest) (*RecordAccumulatorProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordAccumulatorProof not implemented")
+}
+func (UnimplementedQueryServer) GetBorConsumedRecordId(context.Context, *BorConsumedRecordIdRequest) (*BorConsumedRecordIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorConsumedRecordId not implemented")
+}
+func (UnimplementedQueryServer) SubscribeRecords(*SubscribeRecordsRequest, Query_SubscribeRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRecords not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +481,153 @@ func _Query_IsClerkTxOld_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRecordsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordsByContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByContract(ctx, req.(*RecordsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordsByTxHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByTxHash(ctx, req.(*RecordsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByVisibilityHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByVisibilityHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByVisibilityHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordsByVisibilityHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByVisibilityHeight(ctx, req.(*RecordsByVisibilityHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClerkParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClerkParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetClerkParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClerkParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordAccumulator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordAccumulator(ctx, req.(*RecordAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordAccumulatorProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAccumulatorProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordAccumulatorProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordAccumulatorProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordAccumulatorProof(ctx, req.(*RecordAccumulatorProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBorConsumedRecordId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BorConsumedRecordIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorConsumedRecordId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetBorConsumedRecordId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorConsumedRecordId(ctx, req.(*BorConsumedRecordIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeRecords(m, &querySubscribeRecordsServer{stream})
+}
+
+type Query_SubscribeRecordsServer interface {
+	Send(*SubscribeRecordsResponse) error
+	grpc.ServerStream
+}
+
+type querySubscribeRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeRecordsServer) Send(m *SubscribeRecordsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,7 +663,41 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsClerkTxOld",
 			Handler:    _Query_IsClerkTxOld_Handler,
 		},
+		{
+			MethodName: "GetRecordsByContract",
+			Handler:    _Query_GetRecordsByContract_Handler,
+		},
+		{
+			MethodName: "GetRecordsByTxHash",
+			Handler:    _Query_GetRecordsByTxHash_Handler,
+		},
+		{
+			MethodName: "GetRecordsByVisibilityHeight",
+			Handler:    _Query_GetRecordsByVisibilityHeight_Handler,
+		},
+		{
+			MethodName: "GetClerkParams",
+			Handler:    _Query_GetClerkParams_Handler,
+		},
+		{
+			MethodName: "GetRecordAccumulator",
+			Handler:    _Query_GetRecordAccumulator_Handler,
+		},
+		{
+			MethodName: "GetRecordAccumulatorProof",
+			Handler:    _Query_GetRecordAccumulatorProof_Handler,
+		},
+		{
+			MethodName: "GetBorConsumedRecordId",
+			Handler:    _Query_GetBorConsumedRecordId_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeRecords",
+			Handler:       _Query_SubscribeRecords_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/clerk/query.proto",
 }
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_HandleMsgEventRecord_FullMethodName = "/heimdallv2.clerk.Msg/HandleMsgEventRecord"
+	Msg_HandleMsgEventRecord_FullMethodName     = "/heimdallv2.clerk.Msg/HandleMsgEventRecord"
+	Msg_HandleMsgRecordsConsumed_FullMethodName = "/heimdallv2.clerk.Msg/HandleMsgRecordsConsumed"
+	Msg_UpdateParams_FullMethodName             = "/heimdallv2.clerk.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +31,11 @@ type MsgClient interface {
 	// HandleMsgEventRecord processes a state sync event record from the root
 	// chain.
 	HandleMsgEventRecord(ctx context.Context, in *MsgEventRecord, opts ...grpc.CallOption) (*MsgEventRecordResponse, error)
+	// HandleMsgRecordsConsumed records the last event record consumed by bor.
+	HandleMsgRecordsConsumed(ctx context.Context, in *MsgRecordsConsumed, opts ...grpc.CallOption) (*MsgRecordsConsumedResponse, error)
+	// UpdateParams defines a method for updating the clerk module parameters.
+	// Only the governance authority can execute this.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +55,24 @@ func (c *msgClient) HandleMsgEventRecord(ctx context.Context, in *MsgEventRecord
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgRecordsConsumed(ctx context.Context, in *MsgRecordsConsumed, opts ...grpc.CallOption) (*MsgRecordsConsumedResponse, error) {
+	out := new(MsgRecordsConsumedResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgRecordsConsumed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +80,11 @@ type MsgServer interface {
 	// HandleMsgEventRecord processes a state sync event record from the root
 	// chain.
 	HandleMsgEventRecord(context.Context, *MsgEventRecord) (*MsgEventRecordResponse, error)
+	// HandleMsgRecordsConsumed records the last event record consumed by bor.
+	HandleMsgRecordsConsumed(context.Context, *MsgRecordsConsumed) (*MsgRecordsConsumedResponse, error)
+	// UpdateParams defines a method for updating the clerk module parameters.
+	// Only the governance authority can execute this.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +95,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) HandleMsgEventRecord(context.Context, *MsgEventRecord) (*MsgEventRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgEventRecord not implemented")
 }
+func (UnimplementedMsgServer) HandleMsgRecordsConsumed(context.Context, *MsgRecordsConsumed) (*MsgRecordsConsumedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRecordsConsumed not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +132,42 @@ func _Msg_HandleMsgEventRecord_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgRecordsConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordsConsumed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRecordsConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgRecordsConsumed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRecordsConsumed(ctx, req.(*MsgRecordsConsumed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +179,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgEventRecord",
 			Handler:    _Msg_HandleMsgEventRecord_Handler,
 		},
+		{
+			MethodName: "HandleMsgRecordsConsumed",
+			Handler:    _Msg_HandleMsgRecordsConsumed_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/clerk/tx.proto",
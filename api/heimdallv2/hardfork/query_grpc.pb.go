@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: heimdallv2/hardfork/query.proto
+
+package hardfork
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_GetForks_FullMethodName      = "/heimdallv2.hardfork.Query/GetForks"
+	Query_GetForkByName_FullMethodName = "/heimdallv2.hardfork.Query/GetForkByName"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetForks queries the activation heights of all the hard forks.
+	GetForks(ctx context.Context, in *QueryForksRequest, opts ...grpc.CallOption) (*QueryForksResponse, error)
+	// GetForkByName queries the activation height of a hard fork.
+	GetForkByName(ctx context.Context, in *QueryForkByNameRequest, opts ...grpc.CallOption) (*QueryForkByNameResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetForks(ctx context.Context, in *QueryForksRequest, opts ...grpc.CallOption) (*QueryForksResponse, error) {
+	out := new(QueryForksResponse)
+	err := c.cc.Invoke(ctx, Query_GetForks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetForkByName(ctx context.Context, in *QueryForkByNameRequest, opts ...grpc.CallOption) (*QueryForkByNameResponse, error) {
+	out := new(QueryForkByNameResponse)
+	err := c.cc.Invoke(ctx, Query_GetForkByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// GetForks queries the activation heights of all the hard forks.
+	GetForks(context.Context, *QueryForksRequest) (*QueryForksResponse, error)
+	// GetForkByName queries the activation height of a hard fork.
+	GetForkByName(context.Context, *QueryForkByNameRequest) (*QueryForkByNameResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) GetForks(context.Context, *QueryForksRequest) (*QueryForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForks not implemented")
+}
+func (UnimplementedQueryServer) GetForkByName(context.Context, *QueryForkByNameRequest) (*QueryForkByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkByName not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_GetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetForks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForks(ctx, req.(*QueryForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetForkByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForkByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetForkByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetForkByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForkByName(ctx, req.(*QueryForkByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.hardfork.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetForks",
+			Handler:    _Query_GetForks_Handler,
+		},
+		{
+			MethodName: "GetForkByName",
+			Handler:    _Query_GetForkByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdallv2/hardfork/query.proto",
+}
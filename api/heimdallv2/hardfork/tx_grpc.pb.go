@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: heimdallv2/hardfork/tx.proto
+
+package hardfork
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ScheduleFork_FullMethodName   = "/heimdallv2.hardfork.Msg/ScheduleFork"
+	Msg_UnscheduleFork_FullMethodName = "/heimdallv2.hardfork.Msg/UnscheduleFork"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// ScheduleFork defines a governance operation for setting the activation
+	// height of a hard fork not active yet. Only the governance authority can
+	// execute this, and not on the public networks.
+	ScheduleFork(ctx context.Context, in *MsgScheduleFork, opts ...grpc.CallOption) (*MsgScheduleForkResponse, error)
+	// UnscheduleFork defines a governance operation for removing the activation
+	// height of a hard fork not active yet from the registry, restoring the
+	// height set in the code. Only the governance authority can execute this,
+	// and not on the public networks.
+	UnscheduleFork(ctx context.Context, in *MsgUnscheduleFork, opts ...grpc.CallOption) (*MsgUnscheduleForkResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleFork(ctx context.Context, in *MsgScheduleFork, opts ...grpc.CallOption) (*MsgScheduleForkResponse, error) {
+	out := new(MsgScheduleForkResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleFork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnscheduleFork(ctx context.Context, in *MsgUnscheduleFork, opts ...grpc.CallOption) (*MsgUnscheduleForkResponse, error) {
+	out := new(MsgUnscheduleForkResponse)
+	err := c.cc.Invoke(ctx, Msg_UnscheduleFork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// ScheduleFork defines a governance operation for setting the activation
+	// height of a hard fork not active yet. Only the governance authority can
+	// execute this, and not on the public networks.
+	ScheduleFork(context.Context, *MsgScheduleFork) (*MsgScheduleForkResponse, error)
+	// UnscheduleFork defines a governance operation for removing the activation
+	// height of a hard fork not active yet from the registry, restoring the
+	// height set in the code. Only the governance authority can execute this,
+	// and not on the public networks.
+	UnscheduleFork(context.Context, *MsgUnscheduleFork) (*MsgUnscheduleForkResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ScheduleFork(context.Context, *MsgScheduleFork) (*MsgScheduleForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFork not implemented")
+}
+func (UnimplementedMsgServer) UnscheduleFork(context.Context, *MsgUnscheduleFork) (*MsgUnscheduleForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnscheduleFork not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ScheduleFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleFork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleFork(ctx, req.(*MsgScheduleFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnscheduleFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnscheduleFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnscheduleFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnscheduleFork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnscheduleFork(ctx, req.(*MsgUnscheduleFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.hardfork.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleFork",
+			Handler:    _Msg_ScheduleFork_Handler,
+		},
+		{
+			MethodName: "UnscheduleFork",
+			Handler:    _Msg_UnscheduleFork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdallv2/hardfork/tx.proto",
+}
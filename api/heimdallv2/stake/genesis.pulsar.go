@@ -111,6 +111,57 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ValidatorBlsPubKey
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorBlsPubKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorBlsPubKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorBlsPubKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ValidatorBlsPubKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                                 protoreflect.MessageDescriptor
 	fd_GenesisState_validators                      protoreflect.FieldDescriptor
@@ -119,6 +170,7 @@ var (
 	fd_GenesisState_previous_block_validator_set    protoreflect.FieldDescriptor
 	fd_GenesisState_last_block_txs                  protoreflect.FieldDescriptor
 	fd_GenesisState_penultimate_block_validator_set protoreflect.FieldDescriptor
+	fd_GenesisState_validator_bls_pub_keys          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -130,6 +182,7 @@ func init() {
 	fd_GenesisState_previous_block_validator_set = md_GenesisState.Fields().ByName("previous_block_validator_set")
 	fd_GenesisState_last_block_txs = md_GenesisState.Fields().ByName("last_block_txs")
 	fd_GenesisState_penultimate_block_validator_set = md_GenesisState.Fields().ByName("penultimate_block_validator_set")
+	fd_GenesisState_validator_bls_pub_keys = md_GenesisState.Fields().ByName("validator_bls_pub_keys")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -233,6 +286,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ValidatorBlsPubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ValidatorBlsPubKeys})
+		if !f(fd_GenesisState_validator_bls_pub_keys, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -260,6 +319,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.LastBlockTxs != nil
 	case "heimdallv2.stake.GenesisState.penultimate_block_validator_set":
 		return x.PenultimateBlockValidatorSet != nil
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		return len(x.ValidatorBlsPubKeys) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -288,6 +349,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastBlockTxs = nil
 	case "heimdallv2.stake.GenesisState.penultimate_block_validator_set":
 		x.PenultimateBlockValidatorSet = nil
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		x.ValidatorBlsPubKeys = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -328,6 +391,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "heimdallv2.stake.GenesisState.penultimate_block_validator_set":
 		value := x.PenultimateBlockValidatorSet
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		if len(x.ValidatorBlsPubKeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ValidatorBlsPubKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -364,6 +433,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.LastBlockTxs = value.Message().Interface().(*LastBlockTxs)
 	case "heimdallv2.stake.GenesisState.penultimate_block_validator_set":
 		x.PenultimateBlockValidatorSet = value.Message().Interface().(*ValidatorSet)
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ValidatorBlsPubKeys = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -416,6 +489,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.PenultimateBlockValidatorSet = new(ValidatorSet)
 		}
 		return protoreflect.ValueOfMessage(x.PenultimateBlockValidatorSet.ProtoReflect())
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		if x.ValidatorBlsPubKeys == nil {
+			x.ValidatorBlsPubKeys = []*ValidatorBlsPubKey{}
+		}
+		value := &_GenesisState_7_list{list: &x.ValidatorBlsPubKeys}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -447,6 +526,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "heimdallv2.stake.GenesisState.penultimate_block_validator_set":
 		m := new(ValidatorSet)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "heimdallv2.stake.GenesisState.validator_bls_pub_keys":
+		list := []*ValidatorBlsPubKey{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.GenesisState"))
@@ -544,6 +626,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.PenultimateBlockValidatorSet)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ValidatorBlsPubKeys) > 0 {
+			for _, e := range x.ValidatorBlsPubKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -573,6 +661,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ValidatorBlsPubKeys) > 0 {
+			for iNdEx := len(x.ValidatorBlsPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorBlsPubKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if x.PenultimateBlockValidatorSet != nil {
 			encoded, err := options.Marshal(x.PenultimateBlockValidatorSet)
 			if err != nil {
@@ -913,6 +1017,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorBlsPubKeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorBlsPubKeys = append(x.ValidatorBlsPubKeys, &ValidatorBlsPubKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorBlsPubKeys[len(x.ValidatorBlsPubKeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -979,6 +1117,8 @@ type GenesisState struct {
 	LastBlockTxs *LastBlockTxs `protobuf:"bytes,5,opt,name=last_block_txs,json=lastBlockTxs,proto3" json:"last_block_txs,omitempty"`
 	// Validator set from two blocks ago.
 	PenultimateBlockValidatorSet *ValidatorSet `protobuf:"bytes,6,opt,name=penultimate_block_validator_set,json=penultimateBlockValidatorSet,proto3" json:"penultimate_block_validator_set,omitempty"`
+	// BLS keys registered by the validators.
+	ValidatorBlsPubKeys []*ValidatorBlsPubKey `protobuf:"bytes,7,rep,name=validator_bls_pub_keys,json=validatorBlsPubKeys,proto3" json:"validator_bls_pub_keys,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1043,6 +1183,13 @@ func (x *GenesisState) GetPenultimateBlockValidatorSet() *ValidatorSet {
 	return nil
 }
 
+func (x *GenesisState) GetValidatorBlsPubKeys() []*ValidatorBlsPubKey {
+	if x != nil {
+		return x.ValidatorBlsPubKeys
+	}
+	return nil
+}
+
 var File_heimdallv2_stake_genesis_proto protoreflect.FileDescriptor
 
 var file_heimdallv2_stake_genesis_proto_rawDesc = []byte{
@@ -1056,7 +1203,7 @@ var file_heimdallv2_stake_genesis_proto_rawDesc = []byte{
 	0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x20, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73,
 	0x74, 0x61, 0x6b, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65, 0x69, 0x6d,
 	0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x56, 0x61, 0x6c,
@@ -1089,20 +1236,26 @@ var file_heimdallv2_stake_genesis_proto_rawDesc = []byte{
 	0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
 	0x01, 0x52, 0x1c, 0x70, 0x65, 0x6e, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x42,
-	0xbc, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
-	0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68,
-	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68,
-	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0xa2,
-	0x02, 0x03, 0x48, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
-	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0xca, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64,
-	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0xe2, 0x02, 0x1c, 0x48, 0x65,
-	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x48, 0x65, 0x69,
-	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x64, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x73, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x73, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x73, 0x42, 0xbc, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c,
+	0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0xa2, 0x02, 0x03, 0x48, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x48, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0xca, 0x02,
+	0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0xe2, 0x02, 0x1c, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x11, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1119,10 +1272,11 @@ func file_heimdallv2_stake_genesis_proto_rawDescGZIP() []byte {
 
 var file_heimdallv2_stake_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_heimdallv2_stake_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: heimdallv2.stake.GenesisState
-	(*Validator)(nil),    // 1: heimdallv2.stake.Validator
-	(*ValidatorSet)(nil), // 2: heimdallv2.stake.ValidatorSet
-	(*LastBlockTxs)(nil), // 3: heimdallv2.stake.LastBlockTxs
+	(*GenesisState)(nil),       // 0: heimdallv2.stake.GenesisState
+	(*Validator)(nil),          // 1: heimdallv2.stake.Validator
+	(*ValidatorSet)(nil),       // 2: heimdallv2.stake.ValidatorSet
+	(*LastBlockTxs)(nil),       // 3: heimdallv2.stake.LastBlockTxs
+	(*ValidatorBlsPubKey)(nil), // 4: heimdallv2.stake.ValidatorBlsPubKey
 }
 var file_heimdallv2_stake_genesis_proto_depIdxs = []int32{
 	1, // 0: heimdallv2.stake.GenesisState.validators:type_name -> heimdallv2.stake.Validator
@@ -1130,11 +1284,12 @@ var file_heimdallv2_stake_genesis_proto_depIdxs = []int32{
 	2, // 2: heimdallv2.stake.GenesisState.previous_block_validator_set:type_name -> heimdallv2.stake.ValidatorSet
 	3, // 3: heimdallv2.stake.GenesisState.last_block_txs:type_name -> heimdallv2.stake.LastBlockTxs
 	2, // 4: heimdallv2.stake.GenesisState.penultimate_block_validator_set:type_name -> heimdallv2.stake.ValidatorSet
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	4, // 5: heimdallv2.stake.GenesisState.validator_bls_pub_keys:type_name -> heimdallv2.stake.ValidatorBlsPubKey
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_stake_genesis_proto_init() }
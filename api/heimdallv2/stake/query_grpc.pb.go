@@ -27,6 +27,7 @@ const (
 	Query_IsStakeTxOld_FullMethodName                = "/heimdallv2.stake.Query/IsStakeTxOld"
 	Query_GetCurrentProposer_FullMethodName          = "/heimdallv2.stake.Query/GetCurrentProposer"
 	Query_GetProposersByTimes_FullMethodName         = "/heimdallv2.stake.Query/GetProposersByTimes"
+	Query_GetValidatorBlsPubKey_FullMethodName       = "/heimdallv2.stake.Query/GetValidatorBlsPubKey"
 )
 
 // QueryClient is the client API for Query service.
@@ -49,6 +50,8 @@ type QueryClient interface {
 	GetCurrentProposer(ctx context.Context, in *QueryCurrentProposerRequest, opts ...grpc.CallOption) (*QueryCurrentProposerResponse, error)
 	// GetProposersByTimes returns the next N proposers in the rotation sequence.
 	GetProposersByTimes(ctx context.Context, in *QueryProposersRequest, opts ...grpc.CallOption) (*QueryProposersResponse, error)
+	// GetValidatorBlsPubKey queries the BLS key registered by a validator.
+	GetValidatorBlsPubKey(ctx context.Context, in *QueryValidatorBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorBlsPubKeyResponse, error)
 }
 
 type queryClient struct {
@@ -131,6 +134,15 @@ func (c *queryClient) GetProposersByTimes(ctx context.Context, in *QueryProposer
 	return out, nil
 }
 
+func (c *queryClient) GetValidatorBlsPubKey(ctx context.Context, in *QueryValidatorBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorBlsPubKeyResponse, error) {
+	out := new(QueryValidatorBlsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorBlsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -151,6 +163,8 @@ type QueryServer interface {
 	GetCurrentProposer(context.Context, *QueryCurrentProposerRequest) (*QueryCurrentProposerResponse, error)
 	// GetProposersByTimes returns the next N proposers in the rotation sequence.
 	GetProposersByTimes(context.Context, *QueryProposersRequest) (*QueryProposersResponse, error)
+	// GetValidatorBlsPubKey queries the BLS key registered by a validator.
+	GetValidatorBlsPubKey(context.Context, *QueryValidatorBlsPubKeyRequest) (*QueryValidatorBlsPubKeyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -182,6 +196,9 @@ func (UnimplementedQueryServer) GetCurrentProposer(context.Context, *QueryCurren
 func (UnimplementedQueryServer) GetProposersByTimes(context.Context, *QueryProposersRequest) (*QueryProposersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProposersByTimes not implemented")
 }
+func (UnimplementedQueryServer) GetValidatorBlsPubKey(context.Context, *QueryValidatorBlsPubKeyRequest) (*QueryValidatorBlsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBlsPubKey not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +356,24 @@ func _Query_GetProposersByTimes_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetValidatorBlsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBlsPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorBlsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorBlsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorBlsPubKey(ctx, req.(*QueryValidatorBlsPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -378,6 +413,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProposersByTimes",
 			Handler:    _Query_GetProposersByTimes_Handler,
 		},
+		{
+			MethodName: "GetValidatorBlsPubKey",
+			Handler:    _Query_GetValidatorBlsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/stake/query.proto",
@@ -4508,479 +4508,4408 @@ func (x *fastReflection_MsgValidatorExitResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
+var (
+	md_SlashedValidator              protoreflect.MessageDescriptor
+	fd_SlashedValidator_val_id       protoreflect.FieldDescriptor
+	fd_SlashedValidator_voting_power protoreflect.FieldDescriptor
+	fd_SlashedValidator_jailed       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_SlashedValidator = File_heimdallv2_stake_tx_proto.Messages().ByName("SlashedValidator")
+	fd_SlashedValidator_val_id = md_SlashedValidator.Fields().ByName("val_id")
+	fd_SlashedValidator_voting_power = md_SlashedValidator.Fields().ByName("voting_power")
+	fd_SlashedValidator_jailed = md_SlashedValidator.Fields().ByName("jailed")
+}
+
+var _ protoreflect.Message = (*fastReflection_SlashedValidator)(nil)
+
+type fastReflection_SlashedValidator SlashedValidator
+
+func (x *SlashedValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SlashedValidator)(x)
+}
+
+func (x *SlashedValidator) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SlashedValidator_messageType fastReflection_SlashedValidator_messageType
+var _ protoreflect.MessageType = fastReflection_SlashedValidator_messageType{}
+
+type fastReflection_SlashedValidator_messageType struct{}
+
+func (x fastReflection_SlashedValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SlashedValidator)(nil)
+}
+func (x fastReflection_SlashedValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_SlashedValidator)
+}
+func (x fastReflection_SlashedValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashedValidator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SlashedValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashedValidator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SlashedValidator) Type() protoreflect.MessageType {
+	return _fastReflection_SlashedValidator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SlashedValidator) New() protoreflect.Message {
+	return new(fastReflection_SlashedValidator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SlashedValidator) Interface() protoreflect.ProtoMessage {
+	return (*SlashedValidator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SlashedValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValId)
+		if !f(fd_SlashedValidator_val_id, value) {
+			return
+		}
+	}
+	if x.VotingPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VotingPower)
+		if !f(fd_SlashedValidator_voting_power, value) {
+			return
+		}
+	}
+	if x.Jailed != false {
+		value := protoreflect.ValueOfBool(x.Jailed)
+		if !f(fd_SlashedValidator_jailed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SlashedValidator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		return x.ValId != uint64(0)
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		return x.VotingPower != int64(0)
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		return x.Jailed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashedValidator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		x.ValId = uint64(0)
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		x.VotingPower = int64(0)
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		x.Jailed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SlashedValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		value := x.ValId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		value := x.VotingPower
+		return protoreflect.ValueOfInt64(value)
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		value := x.Jailed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashedValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		x.ValId = value.Uint()
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		x.VotingPower = value.Int()
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		x.Jailed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashedValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		panic(fmt.Errorf("field val_id of message heimdallv2.stake.SlashedValidator is not mutable"))
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		panic(fmt.Errorf("field voting_power of message heimdallv2.stake.SlashedValidator is not mutable"))
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		panic(fmt.Errorf("field jailed of message heimdallv2.stake.SlashedValidator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SlashedValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.SlashedValidator.val_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.stake.SlashedValidator.voting_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "heimdallv2.stake.SlashedValidator.jailed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.SlashedValidator"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.SlashedValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SlashedValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.SlashedValidator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SlashedValidator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashedValidator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SlashedValidator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SlashedValidator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SlashedValidator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValId))
+		}
+		if x.VotingPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotingPower))
+		}
+		if x.Jailed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SlashedValidator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Jailed {
+			i--
+			if x.Jailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.VotingPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPower))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SlashedValidator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashedValidator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+				}
+				x.ValId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				}
+				x.VotingPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotingPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Jailed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgValidatorSlashed_4_list)(nil)
+
+type _MsgValidatorSlashed_4_list struct {
+	list *[]*SlashedValidator
+}
+
+func (x *_MsgValidatorSlashed_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgValidatorSlashed_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgValidatorSlashed_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedValidator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgValidatorSlashed_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedValidator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgValidatorSlashed_4_list) AppendMutable() protoreflect.Value {
+	v := new(SlashedValidator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgValidatorSlashed_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgValidatorSlashed_4_list) NewElement() protoreflect.Value {
+	v := new(SlashedValidator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgValidatorSlashed_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgValidatorSlashed                protoreflect.MessageDescriptor
+	fd_MsgValidatorSlashed_from           protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_slashing_nonce protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_amount         protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_validators     protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_tx_hash        protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_log_index      protoreflect.FieldDescriptor
+	fd_MsgValidatorSlashed_block_number   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgValidatorSlashed = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgValidatorSlashed")
+	fd_MsgValidatorSlashed_from = md_MsgValidatorSlashed.Fields().ByName("from")
+	fd_MsgValidatorSlashed_slashing_nonce = md_MsgValidatorSlashed.Fields().ByName("slashing_nonce")
+	fd_MsgValidatorSlashed_amount = md_MsgValidatorSlashed.Fields().ByName("amount")
+	fd_MsgValidatorSlashed_validators = md_MsgValidatorSlashed.Fields().ByName("validators")
+	fd_MsgValidatorSlashed_tx_hash = md_MsgValidatorSlashed.Fields().ByName("tx_hash")
+	fd_MsgValidatorSlashed_log_index = md_MsgValidatorSlashed.Fields().ByName("log_index")
+	fd_MsgValidatorSlashed_block_number = md_MsgValidatorSlashed.Fields().ByName("block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgValidatorSlashed)(nil)
+
+type fastReflection_MsgValidatorSlashed MsgValidatorSlashed
+
+func (x *MsgValidatorSlashed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgValidatorSlashed)(x)
+}
+
+func (x *MsgValidatorSlashed) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgValidatorSlashed_messageType fastReflection_MsgValidatorSlashed_messageType
+var _ protoreflect.MessageType = fastReflection_MsgValidatorSlashed_messageType{}
+
+type fastReflection_MsgValidatorSlashed_messageType struct{}
+
+func (x fastReflection_MsgValidatorSlashed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgValidatorSlashed)(nil)
+}
+func (x fastReflection_MsgValidatorSlashed_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorSlashed)
+}
+func (x fastReflection_MsgValidatorSlashed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorSlashed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgValidatorSlashed) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorSlashed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgValidatorSlashed) Type() protoreflect.MessageType {
+	return _fastReflection_MsgValidatorSlashed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgValidatorSlashed) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorSlashed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgValidatorSlashed) Interface() protoreflect.ProtoMessage {
+	return (*MsgValidatorSlashed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgValidatorSlashed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_MsgValidatorSlashed_from, value) {
+			return
+		}
+	}
+	if x.SlashingNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashingNonce)
+		if !f(fd_MsgValidatorSlashed_slashing_nonce, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgValidatorSlashed_amount, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_MsgValidatorSlashed_4_list{list: &x.Validators})
+		if !f(fd_MsgValidatorSlashed_validators, value) {
+			return
+		}
+	}
+	if len(x.TxHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxHash)
+		if !f(fd_MsgValidatorSlashed_tx_hash, value) {
+			return
+		}
+	}
+	if x.LogIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LogIndex)
+		if !f(fd_MsgValidatorSlashed_log_index, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_MsgValidatorSlashed_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgValidatorSlashed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		return x.From != ""
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		return x.SlashingNonce != uint64(0)
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		return x.Amount != ""
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		return len(x.Validators) != 0
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		return len(x.TxHash) != 0
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		return x.LogIndex != uint64(0)
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		return x.BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		x.From = ""
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		x.SlashingNonce = uint64(0)
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		x.Amount = ""
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		x.Validators = nil
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		x.TxHash = nil
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		x.LogIndex = uint64(0)
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		x.BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgValidatorSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		value := x.SlashingNonce
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_MsgValidatorSlashed_4_list{})
+		}
+		listValue := &_MsgValidatorSlashed_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		value := x.LogIndex
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		x.From = value.Interface().(string)
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		x.SlashingNonce = value.Uint()
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		x.Amount = value.Interface().(string)
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		lv := value.List()
+		clv := lv.(*_MsgValidatorSlashed_4_list)
+		x.Validators = *clv.list
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		x.TxHash = value.Bytes()
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		x.LogIndex = value.Uint()
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		x.BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		if x.Validators == nil {
+			x.Validators = []*SlashedValidator{}
+		}
+		value := &_MsgValidatorSlashed_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		panic(fmt.Errorf("field from of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		panic(fmt.Errorf("field slashing_nonce of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		panic(fmt.Errorf("field amount of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		panic(fmt.Errorf("field log_index of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		panic(fmt.Errorf("field block_number of message heimdallv2.stake.MsgValidatorSlashed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgValidatorSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorSlashed.from":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.stake.MsgValidatorSlashed.slashing_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.stake.MsgValidatorSlashed.amount":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.stake.MsgValidatorSlashed.validators":
+		list := []*SlashedValidator{}
+		return protoreflect.ValueOfList(&_MsgValidatorSlashed_4_list{list: &list})
+	case "heimdallv2.stake.MsgValidatorSlashed.tx_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.stake.MsgValidatorSlashed.log_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.stake.MsgValidatorSlashed.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashed"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgValidatorSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgValidatorSlashed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgValidatorSlashed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgValidatorSlashed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgValidatorSlashed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgValidatorSlashed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlashingNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashingNonce))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LogIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LogIndex))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorSlashed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.LogIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LogIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SlashingNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashingNonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorSlashed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorSlashed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingNonce", wireType)
+				}
+				x.SlashingNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashingNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &SlashedValidator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = append(x.TxHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxHash == nil {
+					x.TxHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+				}
+				x.LogIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LogIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgValidatorSlashedResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgValidatorSlashedResponse = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgValidatorSlashedResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgValidatorSlashedResponse)(nil)
+
+type fastReflection_MsgValidatorSlashedResponse MsgValidatorSlashedResponse
+
+func (x *MsgValidatorSlashedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgValidatorSlashedResponse)(x)
+}
+
+func (x *MsgValidatorSlashedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgValidatorSlashedResponse_messageType fastReflection_MsgValidatorSlashedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgValidatorSlashedResponse_messageType{}
+
+type fastReflection_MsgValidatorSlashedResponse_messageType struct{}
+
+func (x fastReflection_MsgValidatorSlashedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgValidatorSlashedResponse)(nil)
+}
+func (x fastReflection_MsgValidatorSlashedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorSlashedResponse)
+}
+func (x fastReflection_MsgValidatorSlashedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorSlashedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgValidatorSlashedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorSlashedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgValidatorSlashedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgValidatorSlashedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgValidatorSlashedResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorSlashedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgValidatorSlashedResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgValidatorSlashedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgValidatorSlashedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgValidatorSlashedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgValidatorSlashedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgValidatorSlashedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorSlashedResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorSlashedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgValidatorSlashedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgValidatorSlashedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgValidatorSlashedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorSlashedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgValidatorSlashedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgValidatorSlashedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgValidatorSlashedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorSlashedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorSlashedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorSlashedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorSlashedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgValidatorUnjail              protoreflect.MessageDescriptor
+	fd_MsgValidatorUnjail_from         protoreflect.FieldDescriptor
+	fd_MsgValidatorUnjail_val_id       protoreflect.FieldDescriptor
+	fd_MsgValidatorUnjail_tx_hash      protoreflect.FieldDescriptor
+	fd_MsgValidatorUnjail_log_index    protoreflect.FieldDescriptor
+	fd_MsgValidatorUnjail_block_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgValidatorUnjail = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgValidatorUnjail")
+	fd_MsgValidatorUnjail_from = md_MsgValidatorUnjail.Fields().ByName("from")
+	fd_MsgValidatorUnjail_val_id = md_MsgValidatorUnjail.Fields().ByName("val_id")
+	fd_MsgValidatorUnjail_tx_hash = md_MsgValidatorUnjail.Fields().ByName("tx_hash")
+	fd_MsgValidatorUnjail_log_index = md_MsgValidatorUnjail.Fields().ByName("log_index")
+	fd_MsgValidatorUnjail_block_number = md_MsgValidatorUnjail.Fields().ByName("block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgValidatorUnjail)(nil)
+
+type fastReflection_MsgValidatorUnjail MsgValidatorUnjail
+
+func (x *MsgValidatorUnjail) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgValidatorUnjail)(x)
+}
+
+func (x *MsgValidatorUnjail) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgValidatorUnjail_messageType fastReflection_MsgValidatorUnjail_messageType
+var _ protoreflect.MessageType = fastReflection_MsgValidatorUnjail_messageType{}
+
+type fastReflection_MsgValidatorUnjail_messageType struct{}
+
+func (x fastReflection_MsgValidatorUnjail_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgValidatorUnjail)(nil)
+}
+func (x fastReflection_MsgValidatorUnjail_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorUnjail)
+}
+func (x fastReflection_MsgValidatorUnjail_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorUnjail
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgValidatorUnjail) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorUnjail
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgValidatorUnjail) Type() protoreflect.MessageType {
+	return _fastReflection_MsgValidatorUnjail_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgValidatorUnjail) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorUnjail)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgValidatorUnjail) Interface() protoreflect.ProtoMessage {
+	return (*MsgValidatorUnjail)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgValidatorUnjail) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_MsgValidatorUnjail_from, value) {
+			return
+		}
+	}
+	if x.ValId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValId)
+		if !f(fd_MsgValidatorUnjail_val_id, value) {
+			return
+		}
+	}
+	if len(x.TxHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxHash)
+		if !f(fd_MsgValidatorUnjail_tx_hash, value) {
+			return
+		}
+	}
+	if x.LogIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LogIndex)
+		if !f(fd_MsgValidatorUnjail_log_index, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_MsgValidatorUnjail_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgValidatorUnjail) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		return x.From != ""
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		return x.ValId != uint64(0)
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		return len(x.TxHash) != 0
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		return x.LogIndex != uint64(0)
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		return x.BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjail) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		x.From = ""
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		x.ValId = uint64(0)
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		x.TxHash = nil
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		x.LogIndex = uint64(0)
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		x.BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgValidatorUnjail) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		value := x.ValId
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		value := x.LogIndex
+		return protoreflect.ValueOfUint64(value)
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjail) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		x.From = value.Interface().(string)
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		x.ValId = value.Uint()
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		x.TxHash = value.Bytes()
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		x.LogIndex = value.Uint()
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		x.BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjail) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		panic(fmt.Errorf("field from of message heimdallv2.stake.MsgValidatorUnjail is not mutable"))
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		panic(fmt.Errorf("field val_id of message heimdallv2.stake.MsgValidatorUnjail is not mutable"))
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message heimdallv2.stake.MsgValidatorUnjail is not mutable"))
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		panic(fmt.Errorf("field log_index of message heimdallv2.stake.MsgValidatorUnjail is not mutable"))
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		panic(fmt.Errorf("field block_number of message heimdallv2.stake.MsgValidatorUnjail is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgValidatorUnjail) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgValidatorUnjail.from":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.stake.MsgValidatorUnjail.val_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.stake.MsgValidatorUnjail.tx_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.stake.MsgValidatorUnjail.log_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "heimdallv2.stake.MsgValidatorUnjail.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjail"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjail does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgValidatorUnjail) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgValidatorUnjail", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgValidatorUnjail) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjail) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgValidatorUnjail) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgValidatorUnjail) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgValidatorUnjail)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValId))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LogIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LogIndex))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorUnjail)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.LogIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LogIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ValId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorUnjail)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorUnjail: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+				}
+				x.ValId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = append(x.TxHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxHash == nil {
+					x.TxHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+				}
+				x.LogIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LogIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgValidatorUnjailResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgValidatorUnjailResponse = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgValidatorUnjailResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgValidatorUnjailResponse)(nil)
+
+type fastReflection_MsgValidatorUnjailResponse MsgValidatorUnjailResponse
+
+func (x *MsgValidatorUnjailResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgValidatorUnjailResponse)(x)
+}
+
+func (x *MsgValidatorUnjailResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgValidatorUnjailResponse_messageType fastReflection_MsgValidatorUnjailResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgValidatorUnjailResponse_messageType{}
+
+type fastReflection_MsgValidatorUnjailResponse_messageType struct{}
+
+func (x fastReflection_MsgValidatorUnjailResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgValidatorUnjailResponse)(nil)
+}
+func (x fastReflection_MsgValidatorUnjailResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorUnjailResponse)
+}
+func (x fastReflection_MsgValidatorUnjailResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorUnjailResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgValidatorUnjailResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgValidatorUnjailResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgValidatorUnjailResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgValidatorUnjailResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgValidatorUnjailResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgValidatorUnjailResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgValidatorUnjailResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgValidatorUnjailResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgValidatorUnjailResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgValidatorUnjailResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjailResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgValidatorUnjailResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjailResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjailResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgValidatorUnjailResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgValidatorUnjailResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgValidatorUnjailResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgValidatorUnjailResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgValidatorUnjailResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgValidatorUnjailResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgValidatorUnjailResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgValidatorUnjailResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgValidatorUnjailResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgValidatorUnjailResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorUnjailResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgValidatorUnjailResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorUnjailResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgValidatorUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterBlsKey                     protoreflect.MessageDescriptor
+	fd_MsgRegisterBlsKey_from                protoreflect.FieldDescriptor
+	fd_MsgRegisterBlsKey_bls_pub_key         protoreflect.FieldDescriptor
+	fd_MsgRegisterBlsKey_proof_of_possession protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgRegisterBlsKey = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgRegisterBlsKey")
+	fd_MsgRegisterBlsKey_from = md_MsgRegisterBlsKey.Fields().ByName("from")
+	fd_MsgRegisterBlsKey_bls_pub_key = md_MsgRegisterBlsKey.Fields().ByName("bls_pub_key")
+	fd_MsgRegisterBlsKey_proof_of_possession = md_MsgRegisterBlsKey.Fields().ByName("proof_of_possession")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterBlsKey)(nil)
+
+type fastReflection_MsgRegisterBlsKey MsgRegisterBlsKey
+
+func (x *MsgRegisterBlsKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterBlsKey)(x)
+}
+
+func (x *MsgRegisterBlsKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterBlsKey_messageType fastReflection_MsgRegisterBlsKey_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterBlsKey_messageType{}
+
+type fastReflection_MsgRegisterBlsKey_messageType struct{}
+
+func (x fastReflection_MsgRegisterBlsKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterBlsKey)(nil)
+}
+func (x fastReflection_MsgRegisterBlsKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterBlsKey)
+}
+func (x fastReflection_MsgRegisterBlsKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterBlsKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterBlsKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterBlsKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterBlsKey) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterBlsKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterBlsKey) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterBlsKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterBlsKey) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterBlsKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterBlsKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_MsgRegisterBlsKey_from, value) {
+			return
+		}
+	}
+	if len(x.BlsPubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.BlsPubKey)
+		if !f(fd_MsgRegisterBlsKey_bls_pub_key, value) {
+			return
+		}
+	}
+	if len(x.ProofOfPossession) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProofOfPossession)
+		if !f(fd_MsgRegisterBlsKey_proof_of_possession, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterBlsKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		return x.From != ""
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		return len(x.BlsPubKey) != 0
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		return len(x.ProofOfPossession) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		x.From = ""
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		x.BlsPubKey = nil
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		x.ProofOfPossession = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterBlsKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		value := x.BlsPubKey
+		return protoreflect.ValueOfBytes(value)
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		value := x.ProofOfPossession
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		x.From = value.Interface().(string)
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		x.BlsPubKey = value.Bytes()
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		x.ProofOfPossession = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		panic(fmt.Errorf("field from of message heimdallv2.stake.MsgRegisterBlsKey is not mutable"))
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		panic(fmt.Errorf("field bls_pub_key of message heimdallv2.stake.MsgRegisterBlsKey is not mutable"))
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		panic(fmt.Errorf("field proof_of_possession of message heimdallv2.stake.MsgRegisterBlsKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterBlsKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "heimdallv2.stake.MsgRegisterBlsKey.from":
+		return protoreflect.ValueOfString("")
+	case "heimdallv2.stake.MsgRegisterBlsKey.bls_pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "heimdallv2.stake.MsgRegisterBlsKey.proof_of_possession":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKey"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterBlsKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgRegisterBlsKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterBlsKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterBlsKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterBlsKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterBlsKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlsPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProofOfPossession)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterBlsKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProofOfPossession) > 0 {
+			i -= len(x.ProofOfPossession)
+			copy(dAtA[i:], x.ProofOfPossession)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProofOfPossession)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BlsPubKey) > 0 {
+			i -= len(x.BlsPubKey)
+			copy(dAtA[i:], x.BlsPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlsPubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterBlsKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterBlsKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlsPubKey = append(x.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.BlsPubKey == nil {
+					x.BlsPubKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProofOfPossession = append(x.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProofOfPossession == nil {
+					x.ProofOfPossession = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterBlsKeyResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_heimdallv2_stake_tx_proto_init()
+	md_MsgRegisterBlsKeyResponse = File_heimdallv2_stake_tx_proto.Messages().ByName("MsgRegisterBlsKeyResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterBlsKeyResponse)(nil)
+
+type fastReflection_MsgRegisterBlsKeyResponse MsgRegisterBlsKeyResponse
+
+func (x *MsgRegisterBlsKeyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterBlsKeyResponse)(x)
+}
+
+func (x *MsgRegisterBlsKeyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_heimdallv2_stake_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterBlsKeyResponse_messageType fastReflection_MsgRegisterBlsKeyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterBlsKeyResponse_messageType{}
+
+type fastReflection_MsgRegisterBlsKeyResponse_messageType struct{}
+
+func (x fastReflection_MsgRegisterBlsKeyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterBlsKeyResponse)(nil)
+}
+func (x fastReflection_MsgRegisterBlsKeyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterBlsKeyResponse)
+}
+func (x fastReflection_MsgRegisterBlsKeyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterBlsKeyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterBlsKeyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterBlsKeyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterBlsKeyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterBlsKeyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: heimdallv2.stake.MsgRegisterBlsKeyResponse"))
+		}
+		panic(fmt.Errorf("message heimdallv2.stake.MsgRegisterBlsKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in heimdallv2.stake.MsgRegisterBlsKeyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterBlsKeyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterBlsKeyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterBlsKeyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterBlsKeyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterBlsKeyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterBlsKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: heimdallv2/stake/tx.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgValidatorJoin defines the message for a new validator joining the network.
+// This is triggered by stake events from the root chain.
+type MsgValidatorJoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From            string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ValId           uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`                               // Validator ID
+	ActivationEpoch uint64 `protobuf:"varint,3,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"` // Epoch when validator becomes active
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                           // Staked amount
+	SignerPubKey    []byte `protobuf:"bytes,5,opt,name=signer_pub_key,json=signerPubKey,proto3" json:"signer_pub_key,omitempty"`         // Public key for block signing
+	TxHash          []byte `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                             // Root chain transaction hash
+	LogIndex        uint64 `protobuf:"varint,7,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`                      // Log index in root chain tx
+	BlockNumber     uint64 `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`             // Root chain block number
+	Nonce           uint64 `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`                                            // Nonce for replay protection
+}
+
+func (x *MsgValidatorJoin) Reset() {
+	*x = MsgValidatorJoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgValidatorJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgValidatorJoin) ProtoMessage() {}
+
+// Deprecated: Use MsgValidatorJoin.ProtoReflect.Descriptor instead.
+func (*MsgValidatorJoin) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgValidatorJoin) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *MsgValidatorJoin) GetValId() uint64 {
+	if x != nil {
+		return x.ValId
+	}
+	return 0
+}
+
+func (x *MsgValidatorJoin) GetActivationEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *MsgValidatorJoin) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *MsgValidatorJoin) GetSignerPubKey() []byte {
+	if x != nil {
+		return x.SignerPubKey
+	}
+	return nil
+}
+
+func (x *MsgValidatorJoin) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *MsgValidatorJoin) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *MsgValidatorJoin) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *MsgValidatorJoin) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// MsgValidatorJoinResponse defines the response for MsgValidatorJoin.
+type MsgValidatorJoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgValidatorJoinResponse) Reset() {
+	*x = MsgValidatorJoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgValidatorJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgValidatorJoinResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgValidatorJoinResponse.ProtoReflect.Descriptor instead.
+func (*MsgValidatorJoinResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgStakeUpdate defines the message for updating a validator's stake amount.
+type MsgStakeUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ValId       uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	NewAmount   string `protobuf:"bytes,3,opt,name=new_amount,json=newAmount,proto3" json:"new_amount,omitempty"` // New stake amount
+	TxHash      []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Nonce       uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *MsgStakeUpdate) Reset() {
+	*x = MsgStakeUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStakeUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStakeUpdate) ProtoMessage() {}
+
+// Deprecated: Use MsgStakeUpdate.ProtoReflect.Descriptor instead.
+func (*MsgStakeUpdate) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgStakeUpdate) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *MsgStakeUpdate) GetValId() uint64 {
+	if x != nil {
+		return x.ValId
+	}
+	return 0
+}
+
+func (x *MsgStakeUpdate) GetNewAmount() string {
+	if x != nil {
+		return x.NewAmount
+	}
+	return ""
+}
+
+func (x *MsgStakeUpdate) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
 
-// MsgValidatorJoin defines the message for a new validator joining the network.
-// This is triggered by stake events from the root chain.
-type MsgValidatorJoin struct {
+func (x *MsgStakeUpdate) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *MsgStakeUpdate) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *MsgStakeUpdate) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// MsgStakeUpdateResponse defines the response for MsgStakeUpdate.
+type MsgStakeUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgStakeUpdateResponse) Reset() {
+	*x = MsgStakeUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStakeUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStakeUpdateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgStakeUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MsgStakeUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// MsgSignerUpdate defines the message for updating a validator's signer public
+// key.
+type MsgSignerUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	From            string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	ValId           uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`                               // Validator ID
-	ActivationEpoch uint64 `protobuf:"varint,3,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"` // Epoch when validator becomes active
-	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                           // Staked amount
-	SignerPubKey    []byte `protobuf:"bytes,5,opt,name=signer_pub_key,json=signerPubKey,proto3" json:"signer_pub_key,omitempty"`         // Public key for block signing
-	TxHash          []byte `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                             // Root chain transaction hash
-	LogIndex        uint64 `protobuf:"varint,7,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`                      // Log index in root chain tx
-	BlockNumber     uint64 `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`             // Root chain block number
-	Nonce           uint64 `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`                                            // Nonce for replay protection
+	ValId           uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	NewSignerPubKey []byte `protobuf:"bytes,3,opt,name=new_signer_pub_key,json=newSignerPubKey,proto3" json:"new_signer_pub_key,omitempty"` // New public key for block signing
+	TxHash          []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex        uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber     uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Nonce           uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgValidatorJoin) Reset() {
-	*x = MsgValidatorJoin{}
+func (x *MsgSignerUpdate) Reset() {
+	*x = MsgSignerUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[0]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgValidatorJoin) String() string {
+func (x *MsgSignerUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgValidatorJoin) ProtoMessage() {}
+func (*MsgSignerUpdate) ProtoMessage() {}
 
-// Deprecated: Use MsgValidatorJoin.ProtoReflect.Descriptor instead.
-func (*MsgValidatorJoin) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgSignerUpdate.ProtoReflect.Descriptor instead.
+func (*MsgSignerUpdate) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *MsgValidatorJoin) GetFrom() string {
+func (x *MsgSignerUpdate) GetFrom() string {
 	if x != nil {
 		return x.From
 	}
 	return ""
 }
 
-func (x *MsgValidatorJoin) GetValId() uint64 {
+func (x *MsgSignerUpdate) GetValId() uint64 {
 	if x != nil {
 		return x.ValId
 	}
 	return 0
 }
 
-func (x *MsgValidatorJoin) GetActivationEpoch() uint64 {
+func (x *MsgSignerUpdate) GetNewSignerPubKey() []byte {
 	if x != nil {
-		return x.ActivationEpoch
+		return x.NewSignerPubKey
+	}
+	return nil
+}
+
+func (x *MsgSignerUpdate) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *MsgSignerUpdate) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
 	}
 	return 0
 }
 
-func (x *MsgValidatorJoin) GetAmount() string {
+func (x *MsgSignerUpdate) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Amount
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *MsgSignerUpdate) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// MsgSignerUpdateResponse defines the response for MsgSignerUpdate.
+type MsgSignerUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSignerUpdateResponse) Reset() {
+	*x = MsgSignerUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSignerUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSignerUpdateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSignerUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MsgSignerUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgValidatorExit defines the message for a validator exiting the network.
+type MsgValidatorExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ValId             uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	DeactivationEpoch uint64 `protobuf:"varint,3,opt,name=deactivation_epoch,json=deactivationEpoch,proto3" json:"deactivation_epoch,omitempty"` // Epoch when validator becomes inactive
+	TxHash            []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex          uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber       uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Nonce             uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *MsgValidatorExit) Reset() {
+	*x = MsgValidatorExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgValidatorExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgValidatorExit) ProtoMessage() {}
+
+// Deprecated: Use MsgValidatorExit.ProtoReflect.Descriptor instead.
+func (*MsgValidatorExit) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgValidatorExit) GetFrom() string {
+	if x != nil {
+		return x.From
 	}
 	return ""
 }
 
-func (x *MsgValidatorJoin) GetSignerPubKey() []byte {
+func (x *MsgValidatorExit) GetValId() uint64 {
 	if x != nil {
-		return x.SignerPubKey
+		return x.ValId
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgValidatorJoin) GetTxHash() []byte {
+func (x *MsgValidatorExit) GetDeactivationEpoch() uint64 {
+	if x != nil {
+		return x.DeactivationEpoch
+	}
+	return 0
+}
+
+func (x *MsgValidatorExit) GetTxHash() []byte {
 	if x != nil {
 		return x.TxHash
 	}
 	return nil
 }
 
-func (x *MsgValidatorJoin) GetLogIndex() uint64 {
+func (x *MsgValidatorExit) GetLogIndex() uint64 {
 	if x != nil {
 		return x.LogIndex
 	}
 	return 0
 }
 
-func (x *MsgValidatorJoin) GetBlockNumber() uint64 {
+func (x *MsgValidatorExit) GetBlockNumber() uint64 {
 	if x != nil {
 		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *MsgValidatorJoin) GetNonce() uint64 {
+func (x *MsgValidatorExit) GetNonce() uint64 {
 	if x != nil {
 		return x.Nonce
 	}
 	return 0
 }
 
-// MsgValidatorJoinResponse defines the response for MsgValidatorJoin.
-type MsgValidatorJoinResponse struct {
+// MsgValidatorExitResponse defines the response for MsgValidatorExit.
+type MsgValidatorExitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgValidatorJoinResponse) Reset() {
-	*x = MsgValidatorJoinResponse{}
+func (x *MsgValidatorExitResponse) Reset() {
+	*x = MsgValidatorExitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgValidatorExitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgValidatorExitResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgValidatorExitResponse.ProtoReflect.Descriptor instead.
+func (*MsgValidatorExitResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// SlashedValidator describes the effect of a root chain slashing on a single
+// validator.
+type SlashedValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValId       uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	VotingPower int64  `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"` // Voting power left after slashing
+	Jailed      bool   `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`                              // Whether the validator was jailed
+}
+
+func (x *SlashedValidator) Reset() {
+	*x = SlashedValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[1]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgValidatorJoinResponse) String() string {
+func (x *SlashedValidator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgValidatorJoinResponse) ProtoMessage() {}
+func (*SlashedValidator) ProtoMessage() {}
 
-// Deprecated: Use MsgValidatorJoinResponse.ProtoReflect.Descriptor instead.
-func (*MsgValidatorJoinResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SlashedValidator.ProtoReflect.Descriptor instead.
+func (*SlashedValidator) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{8}
 }
 
-// MsgStakeUpdate defines the message for updating a validator's stake amount.
-type MsgStakeUpdate struct {
+func (x *SlashedValidator) GetValId() uint64 {
+	if x != nil {
+		return x.ValId
+	}
+	return 0
+}
+
+func (x *SlashedValidator) GetVotingPower() int64 {
+	if x != nil {
+		return x.VotingPower
+	}
+	return 0
+}
+
+func (x *SlashedValidator) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+// MsgValidatorSlashed defines the message for applying a slashing, reported by
+// the Slashed event of the root chain, to the validators it affected.
+type MsgValidatorSlashed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	ValId       uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
-	NewAmount   string `protobuf:"bytes,3,opt,name=new_amount,json=newAmount,proto3" json:"new_amount,omitempty"` // New stake amount
-	TxHash      []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	LogIndex    uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Nonce       uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	From          string              `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SlashingNonce uint64              `protobuf:"varint,2,opt,name=slashing_nonce,json=slashingNonce,proto3" json:"slashing_nonce,omitempty"` // Nonce of the slashing on root chain
+	Amount        string              `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                     // Total slashed amount
+	Validators    []*SlashedValidator `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`                             // Validators affected by the slashing
+	TxHash        []byte              `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex      uint64              `protobuf:"varint,6,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber   uint64              `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 }
 
-func (x *MsgStakeUpdate) Reset() {
-	*x = MsgStakeUpdate{}
+func (x *MsgValidatorSlashed) Reset() {
+	*x = MsgValidatorSlashed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[2]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgStakeUpdate) String() string {
+func (x *MsgValidatorSlashed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgStakeUpdate) ProtoMessage() {}
+func (*MsgValidatorSlashed) ProtoMessage() {}
 
-// Deprecated: Use MsgStakeUpdate.ProtoReflect.Descriptor instead.
-func (*MsgStakeUpdate) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgValidatorSlashed.ProtoReflect.Descriptor instead.
+func (*MsgValidatorSlashed) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MsgStakeUpdate) GetFrom() string {
+func (x *MsgValidatorSlashed) GetFrom() string {
 	if x != nil {
 		return x.From
 	}
 	return ""
 }
 
-func (x *MsgStakeUpdate) GetValId() uint64 {
+func (x *MsgValidatorSlashed) GetSlashingNonce() uint64 {
 	if x != nil {
-		return x.ValId
+		return x.SlashingNonce
 	}
 	return 0
 }
 
-func (x *MsgStakeUpdate) GetNewAmount() string {
+func (x *MsgValidatorSlashed) GetAmount() string {
 	if x != nil {
-		return x.NewAmount
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *MsgStakeUpdate) GetTxHash() []byte {
+func (x *MsgValidatorSlashed) GetValidators() []*SlashedValidator {
 	if x != nil {
-		return x.TxHash
+		return x.Validators
 	}
 	return nil
 }
 
-func (x *MsgStakeUpdate) GetLogIndex() uint64 {
+func (x *MsgValidatorSlashed) GetTxHash() []byte {
 	if x != nil {
-		return x.LogIndex
+		return x.TxHash
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgStakeUpdate) GetBlockNumber() uint64 {
+func (x *MsgValidatorSlashed) GetLogIndex() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.LogIndex
 	}
 	return 0
 }
 
-func (x *MsgStakeUpdate) GetNonce() uint64 {
+func (x *MsgValidatorSlashed) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Nonce
+		return x.BlockNumber
 	}
 	return 0
 }
 
-// MsgStakeUpdateResponse defines the response for MsgStakeUpdate.
-type MsgStakeUpdateResponse struct {
+// MsgValidatorSlashedResponse defines the response for MsgValidatorSlashed.
+type MsgValidatorSlashedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgStakeUpdateResponse) Reset() {
-	*x = MsgStakeUpdateResponse{}
+func (x *MsgValidatorSlashedResponse) Reset() {
+	*x = MsgValidatorSlashedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[3]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgStakeUpdateResponse) String() string {
+func (x *MsgValidatorSlashedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgStakeUpdateResponse) ProtoMessage() {}
+func (*MsgValidatorSlashedResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgStakeUpdateResponse.ProtoReflect.Descriptor instead.
-func (*MsgStakeUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgValidatorSlashedResponse.ProtoReflect.Descriptor instead.
+func (*MsgValidatorSlashedResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{10}
 }
 
-// MsgSignerUpdate defines the message for updating a validator's signer public
-// key.
-type MsgSignerUpdate struct {
+// MsgValidatorUnjail defines the message for a validator being unjailed on the
+// root chain.
+type MsgValidatorUnjail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From            string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	ValId           uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
-	NewSignerPubKey []byte `protobuf:"bytes,3,opt,name=new_signer_pub_key,json=newSignerPubKey,proto3" json:"new_signer_pub_key,omitempty"` // New public key for block signing
-	TxHash          []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	LogIndex        uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
-	BlockNumber     uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Nonce           uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ValId       uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	TxHash      []byte `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,4,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 }
 
-func (x *MsgSignerUpdate) Reset() {
-	*x = MsgSignerUpdate{}
+func (x *MsgValidatorUnjail) Reset() {
+	*x = MsgValidatorUnjail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[4]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSignerUpdate) String() string {
+func (x *MsgValidatorUnjail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSignerUpdate) ProtoMessage() {}
+func (*MsgValidatorUnjail) ProtoMessage() {}
 
-// Deprecated: Use MsgSignerUpdate.ProtoReflect.Descriptor instead.
-func (*MsgSignerUpdate) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgValidatorUnjail.ProtoReflect.Descriptor instead.
+func (*MsgValidatorUnjail) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MsgSignerUpdate) GetFrom() string {
+func (x *MsgValidatorUnjail) GetFrom() string {
 	if x != nil {
 		return x.From
 	}
 	return ""
 }
 
-func (x *MsgSignerUpdate) GetValId() uint64 {
+func (x *MsgValidatorUnjail) GetValId() uint64 {
 	if x != nil {
 		return x.ValId
 	}
 	return 0
 }
 
-func (x *MsgSignerUpdate) GetNewSignerPubKey() []byte {
-	if x != nil {
-		return x.NewSignerPubKey
-	}
-	return nil
-}
-
-func (x *MsgSignerUpdate) GetTxHash() []byte {
+func (x *MsgValidatorUnjail) GetTxHash() []byte {
 	if x != nil {
 		return x.TxHash
 	}
 	return nil
 }
 
-func (x *MsgSignerUpdate) GetLogIndex() uint64 {
+func (x *MsgValidatorUnjail) GetLogIndex() uint64 {
 	if x != nil {
 		return x.LogIndex
 	}
 	return 0
 }
 
-func (x *MsgSignerUpdate) GetBlockNumber() uint64 {
+func (x *MsgValidatorUnjail) GetBlockNumber() uint64 {
 	if x != nil {
 		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *MsgSignerUpdate) GetNonce() uint64 {
-	if x != nil {
-		return x.Nonce
-	}
-	return 0
-}
-
-// MsgSignerUpdateResponse defines the response for MsgSignerUpdate.
-type MsgSignerUpdateResponse struct {
+// MsgValidatorUnjailResponse defines the response for MsgValidatorUnjail.
+type MsgValidatorUnjailResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSignerUpdateResponse) Reset() {
-	*x = MsgSignerUpdateResponse{}
+func (x *MsgValidatorUnjailResponse) Reset() {
+	*x = MsgValidatorUnjailResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[5]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSignerUpdateResponse) String() string {
+func (x *MsgValidatorUnjailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSignerUpdateResponse) ProtoMessage() {}
+func (*MsgValidatorUnjailResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSignerUpdateResponse.ProtoReflect.Descriptor instead.
-func (*MsgSignerUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgValidatorUnjailResponse.ProtoReflect.Descriptor instead.
+func (*MsgValidatorUnjailResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{12}
 }
 
-// MsgValidatorExit defines the message for a validator exiting the network.
-type MsgValidatorExit struct {
+// MsgRegisterBlsKey defines the message for a validator registering the BLS
+// key signing its checkpoints, replacing the one registered before.
+type MsgRegisterBlsKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	ValId             uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
-	DeactivationEpoch uint64 `protobuf:"varint,3,opt,name=deactivation_epoch,json=deactivationEpoch,proto3" json:"deactivation_epoch,omitempty"` // Epoch when validator becomes inactive
-	TxHash            []byte `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	LogIndex          uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
-	BlockNumber       uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Nonce             uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`                                                      // Signer address of the validator
+	BlsPubKey         []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`                         // Compressed BLS12-381 public key
+	ProofOfPossession []byte `protobuf:"bytes,3,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"` // Signature of the public key
 }
 
-func (x *MsgValidatorExit) Reset() {
-	*x = MsgValidatorExit{}
+func (x *MsgRegisterBlsKey) Reset() {
+	*x = MsgRegisterBlsKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[6]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgValidatorExit) String() string {
+func (x *MsgRegisterBlsKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgValidatorExit) ProtoMessage() {}
+func (*MsgRegisterBlsKey) ProtoMessage() {}
 
-// Deprecated: Use MsgValidatorExit.ProtoReflect.Descriptor instead.
-func (*MsgValidatorExit) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgRegisterBlsKey.ProtoReflect.Descriptor instead.
+func (*MsgRegisterBlsKey) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MsgValidatorExit) GetFrom() string {
+func (x *MsgRegisterBlsKey) GetFrom() string {
 	if x != nil {
 		return x.From
 	}
 	return ""
 }
 
-func (x *MsgValidatorExit) GetValId() uint64 {
-	if x != nil {
-		return x.ValId
-	}
-	return 0
-}
-
-func (x *MsgValidatorExit) GetDeactivationEpoch() uint64 {
-	if x != nil {
-		return x.DeactivationEpoch
-	}
-	return 0
-}
-
-func (x *MsgValidatorExit) GetTxHash() []byte {
+func (x *MsgRegisterBlsKey) GetBlsPubKey() []byte {
 	if x != nil {
-		return x.TxHash
+		return x.BlsPubKey
 	}
 	return nil
 }
 
-func (x *MsgValidatorExit) GetLogIndex() uint64 {
-	if x != nil {
-		return x.LogIndex
-	}
-	return 0
-}
-
-func (x *MsgValidatorExit) GetBlockNumber() uint64 {
-	if x != nil {
-		return x.BlockNumber
-	}
-	return 0
-}
-
-func (x *MsgValidatorExit) GetNonce() uint64 {
+func (x *MsgRegisterBlsKey) GetProofOfPossession() []byte {
 	if x != nil {
-		return x.Nonce
+		return x.ProofOfPossession
 	}
-	return 0
+	return nil
 }
 
-// MsgValidatorExitResponse defines the response for MsgValidatorExit.
-type MsgValidatorExitResponse struct {
+// MsgRegisterBlsKeyResponse defines the response for MsgRegisterBlsKey.
+type MsgRegisterBlsKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgValidatorExitResponse) Reset() {
-	*x = MsgValidatorExitResponse{}
+func (x *MsgRegisterBlsKeyResponse) Reset() {
+	*x = MsgRegisterBlsKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_heimdallv2_stake_tx_proto_msgTypes[7]
+		mi := &file_heimdallv2_stake_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgValidatorExitResponse) String() string {
+func (x *MsgRegisterBlsKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgValidatorExitResponse) ProtoMessage() {}
+func (*MsgRegisterBlsKeyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgValidatorExitResponse.ProtoReflect.Descriptor instead.
-func (*MsgValidatorExitResponse) Descriptor() ([]byte, []int) {
-	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgRegisterBlsKeyResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterBlsKeyResponse) Descriptor() ([]byte, []int) {
+	return file_heimdallv2_stake_tx_proto_rawDescGZIP(), []int{14}
 }
 
 var File_heimdallv2_stake_tx_proto protoreflect.FileDescriptor
@@ -5096,7 +9025,79 @@ var file_heimdallv2_stake_tx_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6b, 0x65, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x45, 0x78, 0x69, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0x87, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5f, 0x0a, 0x0d, 0x56, 0x61, 0x6c,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x08, 0x88,
+	0xa0, 0x1f, 0x01, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xab, 0x03, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x07, 0x74,
+	0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6c,
+	0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x28, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x01, 0xe8,
+	0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x24, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x12, 0x31, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1c,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x09,
+	0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x28, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x39, 0x88, 0xa0, 0x1f, 0x01,
+	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x23, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0b,
+	0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f,
+	0x70, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66,
+	0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x01,
+	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x22, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6c,
+	0x73, 0x4b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xbc, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5f, 0x0a, 0x0d, 0x56, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x68, 0x65, 0x69,
 	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73,
 	0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x1a, 0x2a,
@@ -5120,19 +9121,39 @@ var file_heimdallv2_stake_tx_proto_rawDesc = []byte{
 	0x61, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x69, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
 	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb7, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73,
-	0x74, 0x61, 0x6b, 0x65, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f,
-	0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2d, 0x76,
-	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32,
-	0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0xa2, 0x02, 0x03, 0x48, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x48,
-	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0xca,
-	0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53, 0x74, 0x61,
-	0x6b, 0x65, 0xe2, 0x02, 0x1c, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x5c,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x11, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x3a, 0x3a,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x25, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x1a,
+	0x2d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69,
+	0x6c, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61,
+	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61,
+	0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x1a, 0x2b, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
+	0x42, 0xb7, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x76, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x2d, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0xa2, 0x02, 0x03, 0x48, 0x53,
+	0x58, 0xaa, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0xca, 0x02, 0x10, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x76,
+	0x32, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0xe2, 0x02, 0x1c, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61,
+	0x6c, 0x6c, 0x76, 0x32, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x76, 0x32, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -5147,31 +9168,45 @@ func file_heimdallv2_stake_tx_proto_rawDescGZIP() []byte {
 	return file_heimdallv2_stake_tx_proto_rawDescData
 }
 
-var file_heimdallv2_stake_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_heimdallv2_stake_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_heimdallv2_stake_tx_proto_goTypes = []interface{}{
-	(*MsgValidatorJoin)(nil),         // 0: heimdallv2.stake.MsgValidatorJoin
-	(*MsgValidatorJoinResponse)(nil), // 1: heimdallv2.stake.MsgValidatorJoinResponse
-	(*MsgStakeUpdate)(nil),           // 2: heimdallv2.stake.MsgStakeUpdate
-	(*MsgStakeUpdateResponse)(nil),   // 3: heimdallv2.stake.MsgStakeUpdateResponse
-	(*MsgSignerUpdate)(nil),          // 4: heimdallv2.stake.MsgSignerUpdate
-	(*MsgSignerUpdateResponse)(nil),  // 5: heimdallv2.stake.MsgSignerUpdateResponse
-	(*MsgValidatorExit)(nil),         // 6: heimdallv2.stake.MsgValidatorExit
-	(*MsgValidatorExitResponse)(nil), // 7: heimdallv2.stake.MsgValidatorExitResponse
+	(*MsgValidatorJoin)(nil),            // 0: heimdallv2.stake.MsgValidatorJoin
+	(*MsgValidatorJoinResponse)(nil),    // 1: heimdallv2.stake.MsgValidatorJoinResponse
+	(*MsgStakeUpdate)(nil),              // 2: heimdallv2.stake.MsgStakeUpdate
+	(*MsgStakeUpdateResponse)(nil),      // 3: heimdallv2.stake.MsgStakeUpdateResponse
+	(*MsgSignerUpdate)(nil),             // 4: heimdallv2.stake.MsgSignerUpdate
+	(*MsgSignerUpdateResponse)(nil),     // 5: heimdallv2.stake.MsgSignerUpdateResponse
+	(*MsgValidatorExit)(nil),            // 6: heimdallv2.stake.MsgValidatorExit
+	(*MsgValidatorExitResponse)(nil),    // 7: heimdallv2.stake.MsgValidatorExitResponse
+	(*SlashedValidator)(nil),            // 8: heimdallv2.stake.SlashedValidator
+	(*MsgValidatorSlashed)(nil),         // 9: heimdallv2.stake.MsgValidatorSlashed
+	(*MsgValidatorSlashedResponse)(nil), // 10: heimdallv2.stake.MsgValidatorSlashedResponse
+	(*MsgValidatorUnjail)(nil),          // 11: heimdallv2.stake.MsgValidatorUnjail
+	(*MsgValidatorUnjailResponse)(nil),  // 12: heimdallv2.stake.MsgValidatorUnjailResponse
+	(*MsgRegisterBlsKey)(nil),           // 13: heimdallv2.stake.MsgRegisterBlsKey
+	(*MsgRegisterBlsKeyResponse)(nil),   // 14: heimdallv2.stake.MsgRegisterBlsKeyResponse
 }
 var file_heimdallv2_stake_tx_proto_depIdxs = []int32{
-	0, // 0: heimdallv2.stake.Msg.ValidatorJoin:input_type -> heimdallv2.stake.MsgValidatorJoin
-	2, // 1: heimdallv2.stake.Msg.StakeUpdate:input_type -> heimdallv2.stake.MsgStakeUpdate
-	4, // 2: heimdallv2.stake.Msg.SignerUpdate:input_type -> heimdallv2.stake.MsgSignerUpdate
-	6, // 3: heimdallv2.stake.Msg.ValidatorExit:input_type -> heimdallv2.stake.MsgValidatorExit
-	1, // 4: heimdallv2.stake.Msg.ValidatorJoin:output_type -> heimdallv2.stake.MsgValidatorJoinResponse
-	3, // 5: heimdallv2.stake.Msg.StakeUpdate:output_type -> heimdallv2.stake.MsgStakeUpdateResponse
-	5, // 6: heimdallv2.stake.Msg.SignerUpdate:output_type -> heimdallv2.stake.MsgSignerUpdateResponse
-	7, // 7: heimdallv2.stake.Msg.ValidatorExit:output_type -> heimdallv2.stake.MsgValidatorExitResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: heimdallv2.stake.MsgValidatorSlashed.validators:type_name -> heimdallv2.stake.SlashedValidator
+	0,  // 1: heimdallv2.stake.Msg.ValidatorJoin:input_type -> heimdallv2.stake.MsgValidatorJoin
+	2,  // 2: heimdallv2.stake.Msg.StakeUpdate:input_type -> heimdallv2.stake.MsgStakeUpdate
+	4,  // 3: heimdallv2.stake.Msg.SignerUpdate:input_type -> heimdallv2.stake.MsgSignerUpdate
+	6,  // 4: heimdallv2.stake.Msg.ValidatorExit:input_type -> heimdallv2.stake.MsgValidatorExit
+	9,  // 5: heimdallv2.stake.Msg.ValidatorSlashed:input_type -> heimdallv2.stake.MsgValidatorSlashed
+	11, // 6: heimdallv2.stake.Msg.ValidatorUnjail:input_type -> heimdallv2.stake.MsgValidatorUnjail
+	13, // 7: heimdallv2.stake.Msg.RegisterBlsKey:input_type -> heimdallv2.stake.MsgRegisterBlsKey
+	1,  // 8: heimdallv2.stake.Msg.ValidatorJoin:output_type -> heimdallv2.stake.MsgValidatorJoinResponse
+	3,  // 9: heimdallv2.stake.Msg.StakeUpdate:output_type -> heimdallv2.stake.MsgStakeUpdateResponse
+	5,  // 10: heimdallv2.stake.Msg.SignerUpdate:output_type -> heimdallv2.stake.MsgSignerUpdateResponse
+	7,  // 11: heimdallv2.stake.Msg.ValidatorExit:output_type -> heimdallv2.stake.MsgValidatorExitResponse
+	10, // 12: heimdallv2.stake.Msg.ValidatorSlashed:output_type -> heimdallv2.stake.MsgValidatorSlashedResponse
+	12, // 13: heimdallv2.stake.Msg.ValidatorUnjail:output_type -> heimdallv2.stake.MsgValidatorUnjailResponse
+	14, // 14: heimdallv2.stake.Msg.RegisterBlsKey:output_type -> heimdallv2.stake.MsgRegisterBlsKeyResponse
+	8,  // [8:15] is the sub-list for method output_type
+	1,  // [1:8] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_heimdallv2_stake_tx_proto_init() }
@@ -5276,6 +9311,90 @@ func file_heimdallv2_stake_tx_proto_init() {
 				return nil
 			}
 		}
+		file_heimdallv2_stake_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashedValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgValidatorSlashed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgValidatorSlashedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgValidatorUnjail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgValidatorUnjailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterBlsKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heimdallv2_stake_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterBlsKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5283,7 +9402,7 @@ func file_heimdallv2_stake_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_heimdallv2_stake_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
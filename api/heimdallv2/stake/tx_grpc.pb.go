@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ValidatorJoin_FullMethodName = "/heimdallv2.stake.Msg/ValidatorJoin"
-	Msg_StakeUpdate_FullMethodName   = "/heimdallv2.stake.Msg/StakeUpdate"
-	Msg_SignerUpdate_FullMethodName  = "/heimdallv2.stake.Msg/SignerUpdate"
-	Msg_ValidatorExit_FullMethodName = "/heimdallv2.stake.Msg/ValidatorExit"
+	Msg_ValidatorJoin_FullMethodName    = "/heimdallv2.stake.Msg/ValidatorJoin"
+	Msg_StakeUpdate_FullMethodName      = "/heimdallv2.stake.Msg/StakeUpdate"
+	Msg_SignerUpdate_FullMethodName     = "/heimdallv2.stake.Msg/SignerUpdate"
+	Msg_ValidatorExit_FullMethodName    = "/heimdallv2.stake.Msg/ValidatorExit"
+	Msg_ValidatorSlashed_FullMethodName = "/heimdallv2.stake.Msg/ValidatorSlashed"
+	Msg_ValidatorUnjail_FullMethodName  = "/heimdallv2.stake.Msg/ValidatorUnjail"
+	Msg_RegisterBlsKey_FullMethodName   = "/heimdallv2.stake.Msg/RegisterBlsKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +40,13 @@ type MsgClient interface {
 	SignerUpdate(ctx context.Context, in *MsgSignerUpdate, opts ...grpc.CallOption) (*MsgSignerUpdateResponse, error)
 	// ValidatorExit processes a validator exiting the network.
 	ValidatorExit(ctx context.Context, in *MsgValidatorExit, opts ...grpc.CallOption) (*MsgValidatorExitResponse, error)
+	// ValidatorSlashed applies a root chain slashing to the affected validators.
+	ValidatorSlashed(ctx context.Context, in *MsgValidatorSlashed, opts ...grpc.CallOption) (*MsgValidatorSlashedResponse, error)
+	// ValidatorUnjail processes a validator being unjailed on the root chain.
+	ValidatorUnjail(ctx context.Context, in *MsgValidatorUnjail, opts ...grpc.CallOption) (*MsgValidatorUnjailResponse, error)
+	// RegisterBlsKey registers the BLS key signing the checkpoints of a
+	// validator.
+	RegisterBlsKey(ctx context.Context, in *MsgRegisterBlsKey, opts ...grpc.CallOption) (*MsgRegisterBlsKeyResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +93,33 @@ func (c *msgClient) ValidatorExit(ctx context.Context, in *MsgValidatorExit, opt
 	return out, nil
 }
 
+func (c *msgClient) ValidatorSlashed(ctx context.Context, in *MsgValidatorSlashed, opts ...grpc.CallOption) (*MsgValidatorSlashedResponse, error) {
+	out := new(MsgValidatorSlashedResponse)
+	err := c.cc.Invoke(ctx, Msg_ValidatorSlashed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ValidatorUnjail(ctx context.Context, in *MsgValidatorUnjail, opts ...grpc.CallOption) (*MsgValidatorUnjailResponse, error) {
+	out := new(MsgValidatorUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_ValidatorUnjail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBlsKey(ctx context.Context, in *MsgRegisterBlsKey, opts ...grpc.CallOption) (*MsgRegisterBlsKeyResponse, error) {
+	out := new(MsgRegisterBlsKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterBlsKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +132,13 @@ type MsgServer interface {
 	SignerUpdate(context.Context, *MsgSignerUpdate) (*MsgSignerUpdateResponse, error)
 	// ValidatorExit processes a validator exiting the network.
 	ValidatorExit(context.Context, *MsgValidatorExit) (*MsgValidatorExitResponse, error)
+	// ValidatorSlashed applies a root chain slashing to the affected validators.
+	ValidatorSlashed(context.Context, *MsgValidatorSlashed) (*MsgValidatorSlashedResponse, error)
+	// ValidatorUnjail processes a validator being unjailed on the root chain.
+	ValidatorUnjail(context.Context, *MsgValidatorUnjail) (*MsgValidatorUnjailResponse, error)
+	// RegisterBlsKey registers the BLS key signing the checkpoints of a
+	// validator.
+	RegisterBlsKey(context.Context, *MsgRegisterBlsKey) (*MsgRegisterBlsKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -114,6 +158,15 @@ func (UnimplementedMsgServer) SignerUpdate(context.Context, *MsgSignerUpdate) (*
 func (UnimplementedMsgServer) ValidatorExit(context.Context, *MsgValidatorExit) (*MsgValidatorExitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExit not implemented")
 }
+func (UnimplementedMsgServer) ValidatorSlashed(context.Context, *MsgValidatorSlashed) (*MsgValidatorSlashedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashed not implemented")
+}
+func (UnimplementedMsgServer) ValidatorUnjail(context.Context, *MsgValidatorUnjail) (*MsgValidatorUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnjail not implemented")
+}
+func (UnimplementedMsgServer) RegisterBlsKey(context.Context, *MsgRegisterBlsKey) (*MsgRegisterBlsKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBlsKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +252,60 @@ func _Msg_ValidatorExit_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ValidatorSlashed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidatorSlashed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidatorSlashed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ValidatorSlashed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidatorSlashed(ctx, req.(*MsgValidatorSlashed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ValidatorUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidatorUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidatorUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ValidatorUnjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidatorUnjail(ctx, req.(*MsgValidatorUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBlsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBlsKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBlsKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterBlsKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBlsKey(ctx, req.(*MsgRegisterBlsKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +329,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorExit",
 			Handler:    _Msg_ValidatorExit_Handler,
 		},
+		{
+			MethodName: "ValidatorSlashed",
+			Handler:    _Msg_ValidatorSlashed_Handler,
+		},
+		{
+			MethodName: "ValidatorUnjail",
+			Handler:    _Msg_ValidatorUnjail_Handler,
+		},
+		{
+			MethodName: "RegisterBlsKey",
+			Handler:    _Msg_RegisterBlsKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/stake/tx.proto",
@@ -26,7 +26,9 @@ import (
 	heimdallTypes "github.com/0xPolygon/heimdall-v2/types"
 	borTypes "github.com/0xPolygon/heimdall-v2/x/bor/types"
 	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+	clerkKeeper "github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
 	clerkTypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
+	hardforktypes "github.com/0xPolygon/heimdall-v2/x/hardfork/types"
 	milestoneAbci "github.com/0xPolygon/heimdall-v2/x/milestone/abci"
 	milestoneTypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
 	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
@@ -582,6 +584,31 @@ func (app *HeimdallApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 
 	logger := app.Logger()
 
+	// Halt at the upgrade height of the module stores not added yet, for the node to restart adding them.
+	if err := app.checkModuleStoresUpgrade(req.Height); err != nil {
+		logger.Error("Halting at the upgrade height of a module store", "error", err, "height", req.Height)
+		return nil, err
+	}
+
+	// Apply the upgrade plan due at this height first, halting the node when it has no handler for it.
+	// The upgrade handlers may change the consensus params and schedule hard forks, read below.
+	preBlockRsp, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		logger.Error("Error running the module pre-blockers", "error", err, "height", req.Height)
+		return nil, err
+	}
+	if preBlockRsp.ConsensusParamsChanged {
+		ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	}
+
+	// Apply the hard fork heights scheduled by governance in the previous blocks, before any fork gate is read.
+	if app.isModuleStoreMounted(hardforktypes.StoreKey) {
+		if err := app.HardforkKeeper.ApplyForkHeights(ctx); err != nil {
+			logger.Error("Error applying the hard fork heights", "error", err, "height", req.Height)
+			return nil, err
+		}
+	}
+
 	// handle the case when the VEs are disabled starting from the next block
 	if err := checkIfVoteExtensionsDisabled(ctx, req.Height+1); err != nil {
 		return nil, err
@@ -624,6 +651,39 @@ func (app *HeimdallApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 		}
 	}
 
+	// Backfill the clerk record indexes with the records committed before the index height, one batch per block.
+	if helper.IsClerkRecordIndex(req.Height) {
+		if err := app.ClerkKeeper.BackfillRecordIndexes(ctx, clerkKeeper.RecordIndexBackfillBatchSize); err != nil {
+			logger.Error("Error backfilling clerk record indexes", "error", err, "height", req.Height)
+			return nil, err
+		}
+	}
+
+	// Prune the clerk records past their retention, or consumed by bor, into the record accumulator.
+	if helper.IsClerkRecordPruning(req.Height) {
+		if err := app.ClerkKeeper.PruneRecords(ctx); err != nil {
+			logger.Error("Error pruning clerk records", "error", err, "height", req.Height)
+			return nil, err
+		}
+	}
+
+	// Prune the bor span bodies past the span retention, keeping their summaries.
+	if helper.IsBorSpanPruning(req.Height) {
+		if err := app.BorKeeper.PruneSpans(ctx); err != nil {
+			logger.Error("Error pruning bor spans", "error", err, "height", req.Height)
+			return nil, err
+		}
+	}
+
+	// Move the single planned downtimes of the producers left to their downtime windows, once the windows are enabled.
+	// It's keyed on the state instead of the fork height, which can be rescheduled.
+	if helper.IsProducerDowntimeWindows(req.Height) {
+		if err := app.BorKeeper.MigrateProducerPlannedDowntimes(ctx); err != nil {
+			logger.Error("Error migrating producer planned downtimes", "error", err, "height", req.Height)
+			return nil, err
+		}
+	}
+
 	// Extract ExtendedVoteInfo encoded at the beginning of txs bytes
 	extCommitInfo := new(abci.ExtendedCommitInfo)
 
@@ -647,7 +707,7 @@ func (app *HeimdallApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 			logger.Error("Unexpected behavior, non-empty VEs found in the initial height's pre-blocker", "height", req.Height)
 			return nil, errors.New("non-empty VEs found in the initial height's pre-blocker")
 		}
-		return app.ModuleManager.PreBlock(ctx)
+		return preBlockRsp, nil
 	}
 
 	// Fetch txs from block n-1 so that we can match them with the approved txs in block n to execute sideTxs
@@ -771,6 +831,15 @@ func (app *HeimdallApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 				return nil, err
 			}
 
+			if helper.IsValidatorPerformanceHistory(ctx.BlockHeight()) {
+				err = app.BorKeeper.RecordValidatorPerformance(addMilestoneCtx, supportingValidatorIDs,
+					majorityMilestone.StartBlockNumber, majorityMilestone.StartBlockNumber+uint64(len(majorityMilestone.BlockHashes)-1))
+				if err != nil {
+					logger.Error("Error occurred while recording validator performance", "error", err)
+					return nil, err
+				}
+			}
+
 			if err := app.updateBlockProducerStatus(addMilestoneCtx, supportingValidatorIDs); err != nil {
 				logger.Error("Error occurred while updating block producer status", "error", err)
 				return nil, err
@@ -918,7 +987,7 @@ func (app *HeimdallApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 		return nil, err
 	}
 
-	return app.ModuleManager.PreBlock(ctx)
+	return preBlockRsp, nil
 }
 
 func (app *HeimdallApp) updateBlockProducerStatus(ctx sdk.Context, supportingProducerIDs map[uint64]struct{}) error {
@@ -1226,6 +1295,16 @@ func extractTxHash(msg sdk.Msg) (common.Hash, bool) {
 			return common.Hash{}, false
 		}
 		return common.BytesToHash(m.TxHash), true
+	case *stakeTypes.MsgValidatorSlashed:
+		if !verifyTxHash(m.TxHash) {
+			return common.Hash{}, false
+		}
+		return common.BytesToHash(m.TxHash), true
+	case *stakeTypes.MsgValidatorUnjail:
+		if !verifyTxHash(m.TxHash) {
+			return common.Hash{}, false
+		}
+		return common.BytesToHash(m.TxHash), true
 	case *topupTypes.MsgTopupTx:
 		if !verifyTxHash(m.TxHash) {
 			return common.Hash{}, false
@@ -1048,7 +1048,7 @@ func TestSideTxsHappyPath(t *testing.T) {
 		On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
 		Return([]*ethTypes.Header{}, []uint64{}, []common.Address{}, nil)
 
-	mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.AnythingOfType("int64")).Return(txReceipt, nil)
+	mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.AnythingOfType("int64"), mock.Anything).Return(txReceipt, nil)
 	mockCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, mock.Anything, mock.Anything).Return(event, nil)
 	app.TopupKeeper = topupKeeper.NewKeeper(
 		app.AppCodec(),
@@ -1091,7 +1091,9 @@ func TestSideTxsHappyPath(t *testing.T) {
 	app.ModuleManager.Modules[clerkTypes.ModuleName] = clerk.NewAppModule(new(clerkKeeper.NewKeeper(
 		app.AppCodec(),
 		runtime.NewKVStoreService(app.GetKey(borTypes.StoreKey)),
+		authTypes.NewModuleAddress(govtypes.ModuleName).String(),
 		app.ChainManagerKeeper,
+		&app.MilestoneKeeper,
 		mockCaller,
 	)))
 	app.sideTxCfg = sidetxs.NewSideTxConfigurator()
@@ -1140,7 +1142,7 @@ func TestSideTxsHappyPath(t *testing.T) {
 		},
 	}
 
-	mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
+	mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
 	mockCaller.On("DecodeStateSyncedEvent", mock.Anything, mock.Anything, mock.Anything).Return(stateSyncEvent, nil)
 
 	for _, tc := range testCases {
@@ -1214,6 +1216,7 @@ func TestAllUnhappyPathBorSideTxs(t *testing.T) {
 		app.AppCodec(),
 		runtime.NewKVStoreService(app.GetKey(borTypes.StoreKey)),
 		authTypes.NewModuleAddress(govtypes.ModuleName).String(),
+		mockCaller,
 	)
 	helper.SetTestInitialHeight(3)
 	app.TopupKeeper = topupKeeper.NewKeeper(
@@ -1489,6 +1492,7 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 		app.AppCodec(),
 		runtime.NewKVStoreService(app.GetKey(borTypes.StoreKey)),
 		authTypes.NewModuleAddress(govtypes.ModuleName).String(),
+		mockCaller,
 	)
 	helper.SetTestInitialHeight(3)
 	app.TopupKeeper = topupKeeper.NewKeeper(
@@ -1535,7 +1539,9 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 	app.ModuleManager.Modules[clerkTypes.ModuleName] = clerk.NewAppModule(new(clerkKeeper.NewKeeper(
 		app.AppCodec(),
 		runtime.NewKVStoreService(app.GetKey(borTypes.StoreKey)),
+		authTypes.NewModuleAddress(govtypes.ModuleName).String(),
 		mockChainKeeper,
+		&app.MilestoneKeeper,
 		mockCaller,
 	)))
 	app.BorKeeper.SetContractCaller(mockCaller)
@@ -1567,7 +1573,7 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 		Address2 := "0xb316fa9fa91700d7084d377bfdc81eb9f232f5ff"
 
 		addrBz2, err := ac.StringToBytes(Address2)
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.
 			On("GetBorChainBlock", mock.Anything, mock.Anything).
 			Return(&ethTypes.Header{
@@ -1643,7 +1649,7 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 
 		addrBz2, err := ac.StringToBytes(Address2)
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
 		mockCaller.On("DecodeStateSyncedEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.
 			On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
@@ -1733,7 +1739,7 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 			"0",
 		)
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
 		event := &statesender.StatesenderStateSynced{
 			Id:              new(big.Int).SetUint64(msg.Id),
 			ContractAddress: common.BytesToAddress([]byte(msg.ContractAddress)),
@@ -1809,7 +1815,7 @@ func TestAllUnhappyPathClerkSideTxs(t *testing.T) {
 
 		addrBz2, err := ac.StringToBytes(Address2)
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
 		mockCaller.On("DecodeStateSyncedEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.
 			On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
@@ -1894,6 +1900,7 @@ func TestAllUnhappyPathTopupSideTxs(t *testing.T) {
 		app.AppCodec(),
 		runtime.NewKVStoreService(app.GetKey(borTypes.StoreKey)),
 		authTypes.NewModuleAddress(govtypes.ModuleName).String(),
+		mockCaller,
 	)
 	helper.SetTestInitialHeight(3)
 	app.TopupKeeper = topupKeeper.NewKeeper(
@@ -1969,7 +1976,7 @@ func TestAllUnhappyPathTopupSideTxs(t *testing.T) {
 
 		coins, err := simulation.RandomFees(rand.New(rand.NewSource(time.Now().UnixNano())), ctx, sdk.Coins{sdk.NewCoin(authTypes.FeeToken, math.NewInt(1000000000000000000))})
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.On("DecodeStateSyncedEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.
 			On("GetBorChainBlock", mock.Anything, mock.Anything).
@@ -2043,7 +2050,7 @@ func TestAllUnhappyPathTopupSideTxs(t *testing.T) {
 
 		coins, err := simulation.RandomFees(rand.New(rand.NewSource(time.Now().UnixNano())), ctx, sdk.Coins{sdk.NewCoin(authTypes.FeeToken, math.NewInt(1000000000000000000))})
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
 		mockCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 		mockCaller.
 			On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
@@ -2116,7 +2123,7 @@ func TestAllUnhappyPathTopupSideTxs(t *testing.T) {
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil).Once()
 		mockCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, mock.Anything, mock.Anything).Return(event, nil).Once()
 		mockCaller.
 			On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
@@ -2190,7 +2197,7 @@ func TestAllUnhappyPathTopupSideTxs(t *testing.T) {
 		}
 		fmt.Println("txReceipt: ", txReceipt)
 
-		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
+		mockCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
 		mockCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, mock.Anything, mock.Anything).Return(event, nil)
 		mockCaller.
 			On("GetBorChainBlockInfoInBatch", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).
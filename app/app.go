@@ -3,12 +3,14 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,11 +21,14 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/tx/signing"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -67,12 +72,14 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/gorilla/mux"
 	"github.com/hellofresh/health-go/v5"
+	"github.com/spf13/cast"
 
 	"github.com/0xPolygon/heimdall-v2/client/docs"
 	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/metrics"
 	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	hmTypes "github.com/0xPolygon/heimdall-v2/types"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
 	hversion "github.com/0xPolygon/heimdall-v2/version"
 	"github.com/0xPolygon/heimdall-v2/x/bor"
 	borKeeper "github.com/0xPolygon/heimdall-v2/x/bor/keeper"
@@ -84,8 +91,12 @@ import (
 	checkpointKeeper "github.com/0xPolygon/heimdall-v2/x/checkpoint/keeper"
 	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 	"github.com/0xPolygon/heimdall-v2/x/clerk"
+	clerkrest "github.com/0xPolygon/heimdall-v2/x/clerk/client/rest"
 	clerkkeeper "github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
 	clerktypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork"
+	hardforkkeeper "github.com/0xPolygon/heimdall-v2/x/hardfork/keeper"
+	hardforktypes "github.com/0xPolygon/heimdall-v2/x/hardfork/types"
 	"github.com/0xPolygon/heimdall-v2/x/milestone"
 	milestoneKeeper "github.com/0xPolygon/heimdall-v2/x/milestone/keeper"
 	milestoneTypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
@@ -95,6 +106,7 @@ import (
 	"github.com/0xPolygon/heimdall-v2/x/topup"
 	topupKeeper "github.com/0xPolygon/heimdall-v2/x/topup/keeper"
 	topupTypes "github.com/0xPolygon/heimdall-v2/x/topup/types"
+	"github.com/0xPolygon/heimdall-v2/x/upgrade"
 )
 
 const (
@@ -140,12 +152,17 @@ type HeimdallApp struct {
 	tKeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
+	// stores of the modules introduced after the chain started, mounted on the node and added at startup
+	mountedModuleStores map[string]bool
+	addedModuleStores   []string
+
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
 	BankKeeper            bankkeeper.Keeper
 	GovKeeper             govkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
 
 	// Custom Keepers
 	ClerkKeeper        clerkkeeper.Keeper
@@ -155,10 +172,14 @@ type HeimdallApp struct {
 	CheckpointKeeper   checkpointKeeper.Keeper
 	MilestoneKeeper    milestoneKeeper.Keeper
 	BorKeeper          borKeeper.Keeper
+	HardforkKeeper     hardforkkeeper.Keeper
 
 	// utility for invoking contracts in the Ethereum and Bor chains
 	caller helper.IContractCaller
 
+	// database of the pruned clerk records, on the nodes keeping a record archive
+	clerkArchiveDB dbm.DB
+
 	ModuleManager *module.Manager
 	BasicManager  module.BasicManager
 
@@ -222,6 +243,8 @@ func NewHeimdallApp(
 		chainmanagertypes.StoreKey,
 		milestoneTypes.StoreKey,
 		borTypes.StoreKey,
+		hardforktypes.StoreKey,
+		upgradetypes.StoreKey,
 	)
 
 	// register streaming services
@@ -231,6 +254,11 @@ func NewHeimdallApp(
 
 	tKeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 
+	mountedModuleStores, addedModuleStores, err := loadModuleStores(db)
+	if err != nil {
+		panic(err)
+	}
+
 	app := &HeimdallApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -239,6 +267,9 @@ func NewHeimdallApp(
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
 		tKeys:             tKeys,
+
+		mountedModuleStores: mountedModuleStores,
+		addedModuleStores:   addedModuleStores,
 	}
 
 	// Contract caller
@@ -261,6 +292,21 @@ func NewHeimdallApp(
 	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]), authtypes.NewModuleAddress(govtypes.ModuleName).String(), runtime.EventService{})
 	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
 
+	// the nodes halt at the height of the upgrade plans they have no handler for, unless skipped
+	skipUpgradeHeights := map[int64]bool{}
+	for _, height := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
+		skipUpgradeHeights[int64(height)] = true
+	}
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(
+		skipUpgradeHeights,
+		runtime.NewKVStoreService(keys[upgradetypes.StoreKey]),
+		appCodec,
+		homePath,
+		app.BaseApp,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
@@ -270,18 +316,34 @@ func NewHeimdallApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.HardforkKeeper = hardforkkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[hardforktypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.ChainManagerKeeper = chainmanagerkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[chainmanagertypes.StoreKey]),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.caller,
 	)
 
 	app.ClerkKeeper = clerkkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[clerktypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		app.ChainManagerKeeper,
+		&app.MilestoneKeeper,
 		app.caller,
 	)
+	app.ClerkKeeper.SetQueryContextFn(app.CreateQueryContext)
+
+	// keep the pruned clerk records in a local archive, outside the consensus state
+	if helper.GetConfig().ClerkRecordArchive {
+		app.clerkArchiveDB = openClerkRecordArchive(appOpts)
+		app.ClerkKeeper.SetRecordArchive(clerkkeeper.NewRecordArchive(app.clerkArchiveDB, appCodec))
+	}
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec,
@@ -360,7 +422,7 @@ func NewHeimdallApp(
 	// HV2: stake and checkpoint keepers are circularly dependent. This workaround solves it
 	app.StakeKeeper.SetCheckpointKeeper(app.CheckpointKeeper)
 
-	app.ModuleManager = module.NewManager(
+	appModules := []module.AppModule{
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil, app.GetSubspace(authtypes.ModuleName)),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
@@ -373,7 +435,17 @@ func NewHeimdallApp(
 		bor.NewAppModule(&app.BorKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
-	)
+	}
+
+	// the modules introduced after the chain started are disabled until their store is added
+	if app.isModuleStoreMounted(hardforktypes.StoreKey) {
+		appModules = append(appModules, hardfork.NewAppModule(app.HardforkKeeper))
+	}
+	if app.isModuleStoreMounted(upgradetypes.StoreKey) {
+		appModules = append(appModules, upgrade.NewAppModule(app.UpgradeKeeper, authcodec.NewHexCodec()))
+	}
+
+	app.ModuleManager = module.NewManager(appModules...)
 
 	// Basic manager
 	app.BasicManager = module.NewBasicManagerFromManager(
@@ -396,6 +468,11 @@ func NewHeimdallApp(
 	bApp.SetExtendVoteHandler(app.ExtendVoteHandler())
 	bApp.SetVerifyVoteExtensionHandler(app.VerifyVoteExtensionHandler())
 
+	// the upgrade plans are applied before anything else in the block
+	app.ModuleManager.SetOrderPreBlockers(
+		upgradetypes.ModuleName,
+	)
+
 	app.ModuleManager.SetOrderBeginBlockers(
 		staketypes.ModuleName,
 	)
@@ -405,7 +482,9 @@ func NewHeimdallApp(
 		staketypes.ModuleName,
 	)
 
+	// the hard fork heights are applied first, as the other modules read them
 	genesisModuleOrder := []string{
+		hardforktypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -418,7 +497,12 @@ func NewHeimdallApp(
 		topupTypes.ModuleName,
 		paramstypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		upgradetypes.ModuleName,
 	}
+	genesisModuleOrder = slices.DeleteFunc(genesisModuleOrder, func(moduleName string) bool {
+		_, ok := app.ModuleManager.Modules[moduleName]
+		return !ok
+	})
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -433,6 +517,15 @@ func NewHeimdallApp(
 		panic(err)
 	}
 
+	app.setUpgradeHandlers()
+
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	overrideModules := map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, hmSimulation.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+	}
+	app.simulationManager = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
+	app.simulationManager.RegisterStoreDecoders()
+
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
 	reflectionSvc, err := runtimeservices.NewReflectionService()
@@ -442,7 +535,7 @@ func NewHeimdallApp(
 	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 
 	// initialize stores
-	app.MountKVStores(keys)
+	app.MountKVStores(app.kvStoreKeysToMount(keys))
 	app.MountTransientStores(tKeys)
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -468,10 +561,19 @@ func NewHeimdallApp(
 		}
 	}
 
+	app.setStoreLoader()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			panic(fmt.Errorf("error loading last version: %w", err))
 		}
+
+		// apply the hard fork heights of the registry, before the first block and the queries use them
+		if app.isModuleStoreMounted(hardforktypes.StoreKey) {
+			if err := app.HardforkKeeper.ApplyForkHeights(app.NewUncachedContext(false, cmtproto.Header{})); err != nil {
+				panic(fmt.Errorf("error applying the hard fork heights: %w", err))
+			}
+		}
 	}
 
 	metrics.InitMetrics()
@@ -581,19 +683,43 @@ func (app *HeimdallApp) setPostHandler() {
 	app.SetPostHandler(postHandler)
 }
 
+// openClerkRecordArchive opens the database of the pruned clerk records, in the data directory of the node.
+func openClerkRecordArchive(appOpts servertypes.AppOptions) dbm.DB {
+	homePath, _ := appOpts.Get(flags.FlagHome).(string)
+
+	db, err := dbm.NewDB("clerk_archive", dbm.GoLevelDBBackend, filepath.Join(homePath, "data"))
+	if err != nil {
+		panic(fmt.Errorf("failed to open the clerk record archive: %w", err))
+	}
+
+	return db
+}
+
+// Close closes the app, along with the clerk record archive when kept.
+func (app *HeimdallApp) Close() error {
+	err := app.BaseApp.Close()
+
+	if app.clerkArchiveDB != nil {
+		err = errors.Join(err, app.clerkArchiveDB.Close())
+	}
+
+	return err
+}
+
 func (app *HeimdallApp) Name() string { return app.BaseApp.Name() }
 
 // InitChainer application update at chain initialization
 func (app *HeimdallApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
-	// newApp started the Bor failover prober and per-endpoint probe clients via
-	// InitHeimdallConfig, but the start command only registers their shutdown
-	// cleanup in PostSetup, which runs after InitChain. Any abort here (error
-	// return or panic) exits before that, so close them on every non-success
-	// exit. CloseBorChainClients is idempotent and mutex-guarded.
+	// newApp started the Bor and main chain failover probers and per-endpoint
+	// probe clients via InitHeimdallConfig, but the start command only registers
+	// their shutdown cleanup in PostSetup, which runs after InitChain. Any abort
+	// here (error return or panic) exits before that, so close them on every
+	// non-success exit. Both close functions are idempotent and mutex-guarded.
 	succeeded := false
 	defer func() {
 		if !succeeded {
 			helper.CloseBorChainClients()
+			helper.CloseMainChainClients()
 		}
 	}()
 
@@ -624,6 +750,9 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain)
 		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
 	}
 
+	// record the module versions of the chain, from which the upgrades migrate
+	app.UpgradeKeeper.SetInitVersionMap(app.ModuleManager.GetVersionMap())
+
 	// init genesis
 	if _, err := app.ModuleManager.InitGenesis(ctx, app.AppCodec(), genesisState); err != nil {
 		return &abci.ResponseInitChain{}, err
@@ -750,6 +879,11 @@ func (app *HeimdallApp) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetSideTxConfigurator returns the side msg handlers registered by the modules.
+func (app *HeimdallApp) GetSideTxConfigurator() sidetxs.SideTxConfigurator {
+	return app.sideTxCfg
+}
+
 // AutoCliOpts returns the autocli options for the app.
 func (app *HeimdallApp) AutoCliOpts() autocli.AppOptions {
 	modules := make(map[string]appmodule.AppModule)
@@ -828,6 +962,9 @@ func (app *HeimdallApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.A
 
 	apiSvr.Router.HandleFunc("/version", getHeimdallV2Version()).Methods("GET")
 
+	// Bridge the clerk record subscriptions to server-sent events.
+	apiSvr.Router.HandleFunc(clerkrest.SubscribeRecordsPath, clerkrest.SubscribeRecordsHandler(clientCtx)).Methods("GET")
+
 	// Register the health service endpoint.
 	apiSvr.Router.Handle("/health", app.customHealthServiceHandler(clientCtx)).Methods("GET")
 }
@@ -25,10 +25,12 @@ import (
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager"
 	"github.com/0xPolygon/heimdall-v2/x/checkpoint"
 	"github.com/0xPolygon/heimdall-v2/x/clerk"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork"
 	"github.com/0xPolygon/heimdall-v2/x/milestone"
 	"github.com/0xPolygon/heimdall-v2/x/stake"
 	"github.com/0xPolygon/heimdall-v2/x/topup"
 	topupTypes "github.com/0xPolygon/heimdall-v2/x/topup/types"
+	"github.com/0xPolygon/heimdall-v2/x/upgrade"
 )
 
 func TestHeimdallAppExport(t *testing.T) {
@@ -127,6 +129,8 @@ func TestRunMigrations(t *testing.T) {
 					"milestone":    milestone.AppModule{}.ConsensusVersion(),
 					"topup":        topup.AppModule{}.ConsensusVersion(),
 					"bor":          bor.AppModule{}.ConsensusVersion(),
+					"hardfork":     hardfork.AppModule{}.ConsensusVersion(),
+					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 				},
 			)
 
@@ -172,6 +176,8 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"milestone":    milestone.AppModule{}.ConsensusVersion(),
 			"topup":        topup.AppModule{}.ConsensusVersion(),
 			"bor":          bor.AppModule{}.ConsensusVersion(),
+			"hardfork":     hardfork.AppModule{}.ConsensusVersion(),
+			"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
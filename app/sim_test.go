@@ -0,0 +1,739 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	_ "unsafe" // for the go:linkname of the gov simulation proposal ID
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	sdksimulation "github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	helpermocks "github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	borSimulation "github.com/0xPolygon/heimdall-v2/x/bor/simulation"
+	borTypes "github.com/0xPolygon/heimdall-v2/x/bor/types"
+	checkpointSimulation "github.com/0xPolygon/heimdall-v2/x/checkpoint/simulation"
+	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+	clerkSimulation "github.com/0xPolygon/heimdall-v2/x/clerk/simulation"
+	clerkTypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
+	stakeSimulation "github.com/0xPolygon/heimdall-v2/x/stake/simulation"
+	stakeTypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+	topupSimulation "github.com/0xPolygon/heimdall-v2/x/topup/simulation"
+)
+
+const (
+	simChainID = "heimdall-simulation"
+	// simInitialHeight is the initial height of the simulated chains, from which the vote extensions are enabled
+	simInitialHeight = 1
+	// maxSimBlockTimeStep bounds the time between two simulated blocks
+	maxSimBlockTimeStep = 20 * time.Second
+	// simMaxTxBytes is the max size of the txs of a simulated block proposal
+	simMaxTxBytes = 1_000_000
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// govSimInitialProposalID is the lowest proposal ID picked by the gov operations, which they lower to the first
+// next proposal ID they read, for the whole process. It's reset for each simulation, so that they're reproducible.
+//
+//go:linkname govSimInitialProposalID github.com/cosmos/cosmos-sdk/x/gov/simulation.initialProposalID
+var govSimInitialProposalID uint64
+
+// defaultGovSimInitialProposalID is the initial value of govSimInitialProposalID
+const defaultGovSimInitialProposalID = uint64(100000000000000)
+
+// TestFullAppSimulation runs a randomized simulation of the app, through the whole ABCI flow of each block
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger := setupSimulation(t, "leveldb-app-sim", "Simulation")
+	defer closeSimulation(t, db, dir)
+
+	app := newSimApp(t, logger, db, dir)
+	runSimulation(t, app, config)
+}
+
+// TestAppImportExport runs a simulation, then imports its exported genesis in a new app,
+// and checks that the stores of both apps match
+func TestAppImportExport(t *testing.T) {
+	config, db, dir, logger := setupSimulation(t, "leveldb-app-sim", "Simulation")
+	defer closeSimulation(t, db, dir)
+
+	app := newSimApp(t, logger, db, dir)
+	runSimulation(t, app, config)
+
+	t.Log("exporting genesis...")
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	t.Log("importing genesis...")
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err)
+	defer closeSimulation(t, newDB, newDir)
+
+	newApp := newSimApp(t, logger, newDB, newDir)
+
+	_, err = newApp.InitChain(&abci.RequestInitChain{
+		ChainId:         simChainID,
+		InitialHeight:   exported.Height,
+		ConsensusParams: &exported.ConsensusParams,
+		AppStateBytes:   importableAppState(t, newApp, exported.AppState),
+	})
+	require.NoError(t, err)
+
+	ctxA := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContextLegacy(false, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	for _, key := range app.GetStoreKeys() {
+		kvKey, ok := key.(*storetypes.KVStoreKey)
+		require.True(t, ok)
+
+		storeA := ctxA.KVStore(kvKey)
+		storeB := ctxB.KVStore(newApp.GetKey(kvKey.Name()))
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, simStorePrefixesToSkip[kvKey.Name()])
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare in %s", kvKey.Name())
+
+		t.Logf("compared %d different key/value pairs between %s and %s", len(failedKVAs), kvKey, newApp.GetKey(kvKey.Name()))
+		require.Empty(t, failedKVAs, simtestutil.GetSimulationLog(kvKey.Name(), app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+// TestAppStateDeterminism runs the same simulation several times, and checks that it always ends with the same app hash
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = simChainID
+
+	const (
+		numSeeds             = 3
+		numTimesToRunPerSeed = 3
+	)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed += int64(i)
+		appHashes := make([]string, numTimesToRunPerSeed)
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			db := dbm.NewMemDB()
+			app := newSimApp(t, log.NewNopLogger(), db, t.TempDir())
+
+			t.Logf("running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed)
+
+			runSimulation(t, app, config)
+			appHashes[j] = fmt.Sprintf("%X", app.LastCommitID().Hash)
+
+			require.NoError(t, app.Close())
+		}
+
+		for j := 1; j < numTimesToRunPerSeed; j++ {
+			require.Equal(t, appHashes[0], appHashes[j], "non-determinism in seed %d: %d/%d, attempt: %d/%d", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed)
+		}
+	}
+}
+
+// simStorePrefixesToSkip are the store prefixes not restored by the genesis import, per store
+var simStorePrefixesToSkip = map[string][][]byte{
+	// the stake genesis only imports the validators of the current set, with the proposer priorities reset by the set
+	stakeTypes.StoreKey: {stakeTypes.ValidatorsKey, stakeTypes.ValidatorSetKey, stakeTypes.SignerKey},
+	// the bor genesis only holds the spans and their seeds, while the producers' state is rebuilt from the next blocks
+	borTypes.StoreKey: {
+		borTypes.ProducerVotesKey.Bytes(),
+		borTypes.PerformanceScoreKey.Bytes(),
+		borTypes.LatestActiveProducerKey.Bytes(),
+		borTypes.LatestFailedProducerKey.Bytes(),
+		borTypes.LastSpanBlockKey.Bytes(),
+		borTypes.ProducerPlannedDowntimeKey.Bytes(),
+		borTypes.ProducerDowntimeWindowsKey.Bytes(),
+	},
+	// the imported records are only indexed by visibility height by the indexes backfill, from the first block
+	clerkTypes.StoreKey: {clerkTypes.RecordsByVisibilityKeyPrefix.Bytes(), clerkTypes.RecordIndexBackfillKeyPrefix.Bytes()},
+	// the tx hash of the checkpoint signatures isn't part of an importable genesis
+	checkpointTypes.StoreKey: {checkpointTypes.CheckpointSignaturesTxHashPrefixKey.Bytes()},
+}
+
+// importableAppState returns the exported app state with the checkpoint signatures tx hash cleared,
+// as the checkpoint genesis validation rejects it
+func importableAppState(t *testing.T, app *HeimdallApp, appState json.RawMessage) json.RawMessage {
+	t.Helper()
+
+	var rawState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appState, &rawState))
+
+	checkpointState := checkpointTypes.GetGenesisStateFromAppState(app.AppCodec(), rawState)
+	checkpointState.CheckpointSignaturesTxhash = ""
+	rawState[checkpointTypes.ModuleName] = app.AppCodec().MustMarshalJSON(checkpointState)
+
+	bz, err := json.Marshal(rawState)
+	require.NoError(t, err)
+
+	return bz
+}
+
+// setupSimulation returns the simulation config from the flags, along with its db, skipping the test when the
+// simulations aren't enabled
+func setupSimulation(t *testing.T, dirPrefix, dbName string) (simtypes.Config, dbm.DB, string, log.Logger) {
+	t.Helper()
+
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = simChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, dirPrefix, dbName, simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	return config, db, dir, logger
+}
+
+// closeSimulation closes the db of a simulation, and removes its directory
+func closeSimulation(t *testing.T, db dbm.DB, dir string) {
+	t.Helper()
+
+	require.NoError(t, db.Close())
+	require.NoError(t, os.RemoveAll(dir))
+}
+
+// newSimApp returns a HeimdallApp for the simulations, which restores the global heimdall config they change once the test ends
+func newSimApp(t *testing.T, logger log.Logger, db dbm.DB, dir string) *HeimdallApp {
+	t.Helper()
+
+	restoreSimConfig(t)
+
+	appOptions := make(simtestutil.AppOptionsMap)
+	appOptions[flags.FlagHome] = dir
+
+	return NewHeimdallApp(logger, db, nil, true, appOptions, baseapp.SetChainID(simChainID))
+}
+
+// restoreSimConfig restores the initial height and the hard fork heights once the test ends,
+// as the simulations change them for the whole process
+func restoreSimConfig(t *testing.T) {
+	t.Helper()
+
+	initialHeight := helper.GetInitialHeight()
+	forkHeights := make(map[string]int64)
+	for _, name := range helper.ForkNames() {
+		forkHeights[name], _ = helper.GetForkHeight(name)
+	}
+
+	t.Cleanup(func() {
+		helper.SetTestInitialHeight(initialHeight)
+		for name, height := range forkHeights {
+			require.NoError(t, helper.SetForkHeight(name, height))
+		}
+	})
+}
+
+// simOperation is an operation picked by the simulation: either a heimdall operation, whose msg is included
+// in the next block proposal, or a cosmos-sdk one, delivered once the block is finalized
+type simOperation struct {
+	weight       int
+	heimdallOp   hmSimulation.Operation
+	cosmosSdkOp  simtypes.Operation
+	isCosmosSdk  bool
+	operationKey int
+}
+
+// appSimulation is the state of an app simulation across its blocks
+type appSimulation struct {
+	t        *testing.T
+	app      *HeimdallApp
+	config   simtypes.Config
+	r        *rand.Rand
+	accounts []simtypes.Account
+	caller   *helpermocks.IContractCaller
+
+	operations  []simOperation
+	totalWeight int
+	futureOps   []simtypes.FutureOperation
+	stats       sdksimulation.EventStats
+
+	blockTime time.Time
+	// voteExtension and nonRpExtension are the vote extensions of the last block,
+	// signed by all the validators in the commit of the next block
+	voteExtension  []byte
+	nonRpExtension []byte
+}
+
+// runSimulation initializes the chain of app with a random genesis, then simulates config.NumBlocks blocks
+func runSimulation(t *testing.T, app *HeimdallApp, config simtypes.Config) {
+	t.Helper()
+
+	govSimInitialProposalID = defaultGovSimInitialProposalID
+
+	r := rand.New(rand.NewSource(config.Seed))
+	accounts := simtypes.RandomAccounts(r, r.Intn(50)+50)
+
+	appState, accounts, genesisTime := simAppState(t, app, r, accounts)
+
+	helper.SetTestInitialHeight(simInitialHeight)
+
+	consensusParams := *simtestutil.DefaultConsensusParams
+	consensusParams.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: simInitialHeight}
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         config.ChainID,
+		InitialHeight:   simInitialHeight,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appState,
+		Time:            genesisTime,
+	})
+	require.NoError(t, err)
+
+	caller := baseMockCaller()
+	hmSimulation.MockChains(caller)
+	setMockCallerOnAllKeepers(app, caller)
+
+	s := &appSimulation{
+		t:         t,
+		app:       app,
+		config:    config,
+		r:         r,
+		accounts:  accounts,
+		caller:    caller,
+		stats:     sdksimulation.NewEventStats(),
+		blockTime: genesisTime,
+	}
+	s.setOperations()
+
+	for i := 0; i < config.NumBlocks; i++ {
+		s.simulateBlock()
+	}
+
+	if simcli.FlagVerboseValue {
+		s.stats.Print(os.Stdout)
+	}
+
+	if config.ExportStatsPath != "" {
+		s.stats.ExportJSON(config.ExportStatsPath)
+	}
+}
+
+// simAppState returns a random genesis of the app, built by the simulation manager like the testnet command builds its genesis:
+// the first span of bor is generated from the validators of the stake genesis
+func simAppState(t *testing.T, app *HeimdallApp, r *rand.Rand, accounts []simtypes.Account) (json.RawMessage, []simtypes.Account, time.Time) {
+	t.Helper()
+
+	genesisTime := simtypes.RandTimestamp(r)
+
+	// heimdall txs carry a single signature, and pay the tx fees param whatever their fee,
+	// so it's kept low enough for the accounts to send several txs.
+	// The validators are the first bonded accounts, leaving some of the others to join the set.
+	appParams := simtypes.AppParams{
+		authsims.TxSigLimit:                   json.RawMessage("1"),
+		authsims.TxFees:                       json.RawMessage(strconv.Quote(authtypes.DefaultTxFees)),
+		simtestutil.InitiallyBondedValidators: json.RawMessage(strconv.Itoa(r.Intn(len(accounts)/2) + 1)),
+	}
+
+	appState, accounts := simtestutil.AppStateRandomizedFn(
+		app.SimulationManager(),
+		r,
+		app.AppCodec(),
+		accounts,
+		genesisTime,
+		appParams,
+		app.DefaultGenesis(),
+	)
+
+	rawState := make(map[string]json.RawMessage)
+	require.NoError(t, json.Unmarshal(appState, &rawState))
+
+	stakeState := stakeTypes.GetGenesisStateFromAppState(app.AppCodec(), rawState)
+	rawState, err := borTypes.SetGenesisStateToAppState(app.AppCodec(), rawState, stakeState.CurrentValidatorSet)
+	require.NoError(t, err)
+
+	// the bank randomized genesis accounts for the bonded pool of the cosmos-sdk staking module,
+	// which heimdall doesn't have, so the supply is the sum of the balances.
+	// The sends are kept enabled, as the topups and fee withdrawals rely on them.
+	var bankState banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(rawState[banktypes.ModuleName], &bankState)
+
+	bankState.Params.DefaultSendEnabled = true
+	bankState.SendEnabled = nil
+
+	supply := sdk.NewCoins()
+	for _, balance := range bankState.Balances {
+		supply = supply.Add(balance.Coins...)
+	}
+	bankState.Supply = supply
+	rawState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankState)
+
+	appState, err = json.Marshal(rawState)
+	require.NoError(t, err)
+
+	return appState, accounts, genesisTime
+}
+
+// setOperations sets the weighted operations of the simulation: the heimdall ones, composed with the app keepers,
+// and the cosmos-sdk ones of the simulation manager, proposing the heimdall gov msgs too
+func (s *appSimulation) setOperations() {
+	appParams := make(simtypes.AppParams)
+
+	heimdallOps := make([]hmSimulation.WeightedOperation, 0)
+	heimdallOps = append(heimdallOps, stakeSimulation.WeightedOperations(appParams, &s.app.StakeKeeper)...)
+	heimdallOps = append(heimdallOps, topupSimulation.WeightedOperations(appParams, &s.app.TopupKeeper)...)
+	heimdallOps = append(heimdallOps, clerkSimulation.WeightedOperations(appParams, &s.app.ClerkKeeper)...)
+	heimdallOps = append(heimdallOps, checkpointSimulation.WeightedOperations(appParams, &s.app.CheckpointKeeper, &s.app.StakeKeeper, &s.app.TopupKeeper, s.app.ChainManagerKeeper)...)
+	heimdallOps = append(heimdallOps, borSimulation.WeightedOperations(appParams, &s.app.BorKeeper, &s.app.StakeKeeper, s.app.ChainManagerKeeper, &s.app.MilestoneKeeper)...)
+
+	for _, op := range heimdallOps {
+		s.operations = append(s.operations, simOperation{weight: op.Weight, heimdallOp: op.Op})
+	}
+
+	simState := module.SimulationState{
+		AppParams: appParams,
+		Cdc:       s.app.AppCodec(),
+		TxConfig:  s.app.GetTxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+	for _, proposalMsg := range s.app.SimulationManager().GetProposalMsgs(simState) {
+		simState.ProposalMsgs = append(simState.ProposalMsgs, govProposalMsg(proposalMsg))
+	}
+
+	for _, op := range s.app.SimulationManager().WeightedOperations(simState) {
+		s.operations = append(s.operations, simOperation{weight: op.Weight(), cosmosSdkOp: op.Op(), isCosmosSdk: true})
+	}
+
+	for i := range s.operations {
+		s.operations[i].operationKey = i
+		s.totalWeight += s.operations[i].weight
+	}
+}
+
+// govProposalMsg wraps proposalMsg to only propose the msgs accepted by the gov module,
+// which restricts the proposals to the params updates of the heimdall modules it enables
+func govProposalMsg(proposalMsg simtypes.WeightedProposalMsg) simtypes.WeightedProposalMsg {
+	return sdksimulation.NewWeightedProposalMsg(proposalMsg.AppParamsKey(), proposalMsg.DefaultWeight(),
+		func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+			msg := proposalMsg.MsgSimulatorFn()(r, ctx, accs)
+			if msg == nil || govkeeper.ValidateGovMsgType(msg) != nil {
+				return nil
+			}
+
+			return msg
+		})
+}
+
+// selectOperation picks a random operation, according to their weights
+func (s *appSimulation) selectOperation() simOperation {
+	x := s.r.Intn(s.totalWeight)
+	for _, op := range s.operations {
+		if x < op.weight {
+			return op
+		}
+		x -= op.weight
+	}
+
+	return s.operations[len(s.operations)-1]
+}
+
+// simulateBlock simulates the next block: the picked heimdall operations are proposed in it, with the vote extensions
+// of the last block signed by all the validators, and the cosmos-sdk ones are delivered once it's finalized
+func (s *appSimulation) simulateBlock() {
+	t := s.t
+	app := s.app
+
+	height := app.LastBlockHeight() + 1
+	if height < simInitialHeight {
+		height = simInitialHeight
+	}
+
+	s.blockTime = s.blockTime.Add(time.Duration(s.r.Int63n(int64(maxSimBlockTimeStep))) + time.Second)
+
+	// the genesis state is only committed along with the first block, so its operations read the finalize block state
+	ctx := app.NewContextLegacy(height > simInitialHeight, cmtproto.Header{Height: height - 1, Time: s.blockTime, ChainID: s.config.ChainID})
+
+	blockSize := s.r.Intn(s.config.BlockSize + 1)
+	txs := make([][]byte, 0, blockSize)
+	cosmosSdkOps := make([]simOperation, 0)
+	sequences := make(map[string]uint64)
+
+	for i := 0; i < blockSize; i++ {
+		op := s.selectOperation()
+		if op.isCosmosSdk {
+			cosmosSdkOps = append(cosmosSdkOps, op)
+			continue
+		}
+
+		opMsg, err := op.heimdallOp(s.r, ctx, s.accounts, s.caller)
+		require.NoError(t, err, "operation %s failed at height %d: %s", opMsg.Name, height, opMsg.Comment)
+
+		if !opMsg.OK {
+			s.stats.Tally(simMsgRoute(opMsg.Name), opMsg.Name, "no-operation")
+			continue
+		}
+
+		txBytes, ok := s.signTx(ctx, opMsg, sequences)
+		if !ok {
+			s.stats.Tally(simMsgRoute(opMsg.Name), opMsg.Name, "no-operation")
+			continue
+		}
+		txs = append(txs, txBytes)
+	}
+
+	validatorSet, err := app.getValidatorSetForHeight(ctx, height)
+	require.NoError(t, err)
+
+	proposer := common.FromHex(validatorSet.GetProposer().Signer)
+	extCommit, lastCommit := s.extendedCommitInfo(height, validatorSet)
+
+	respPrepare, err := app.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:             txs,
+		MaxTxBytes:      simMaxTxBytes,
+		LocalLastCommit: extCommit,
+		Height:          height,
+		Time:            s.blockTime,
+		ProposerAddress: proposer,
+	})
+	require.NoError(t, err)
+
+	hash := simBlockHash(height, respPrepare.Txs)
+
+	respProcess, err := app.ProcessProposal(&abci.RequestProcessProposal{
+		Txs:                respPrepare.Txs,
+		ProposedLastCommit: lastCommit,
+		Hash:               hash,
+		Height:             height,
+		Time:               s.blockTime,
+		ProposerAddress:    proposer,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcess.Status, "proposal rejected at height %d", height)
+
+	respExtend, err := app.ExtendVote(ctx, &abci.RequestExtendVote{
+		Hash:            hash,
+		Height:          height,
+		Time:            s.blockTime,
+		Txs:             respPrepare.Txs,
+		ProposerAddress: proposer,
+	})
+	require.NoError(t, err)
+
+	respVerify, err := app.VerifyVoteExtension(&abci.RequestVerifyVoteExtension{
+		Hash:               hash,
+		ValidatorAddress:   proposer,
+		Height:             height,
+		VoteExtension:      respExtend.VoteExtension,
+		NonRpVoteExtension: respExtend.NonRpExtension,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, respVerify.Status, "vote extension rejected at height %d", height)
+
+	respFinalize, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:               respPrepare.Txs,
+		DecidedLastCommit: lastCommit,
+		Hash:              hash,
+		Height:            height,
+		Time:              s.blockTime,
+		ProposerAddress:   proposer,
+	})
+	require.NoError(t, err)
+
+	// the first tx of the block is the extended commit info
+	for i, result := range respFinalize.TxResults[1:] {
+		tx, err := app.TxDecode(respPrepare.Txs[i+1])
+		require.NoError(t, err)
+
+		for _, msg := range tx.GetMsgs() {
+			msgType := sdk.MsgTypeURL(msg)
+			if result.IsOK() {
+				s.stats.Tally(simMsgRoute(msgType), msgType, "ok")
+			} else {
+				s.stats.Tally(simMsgRoute(msgType), msgType, "failure")
+			}
+		}
+	}
+
+	s.deliverCosmosSdkOperations(height, cosmosSdkOps)
+
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	s.voteExtension = respExtend.VoteExtension
+	s.nonRpExtension = respExtend.NonRpExtension
+}
+
+// deliverCosmosSdkOperations delivers the picked cosmos-sdk operations, and the future ones due, in the finalized block
+func (s *appSimulation) deliverCosmosSdkOperations(height int64, ops []simOperation) {
+	t := s.t
+	app := s.app
+
+	ctx := app.NewContextLegacy(false, cmtproto.Header{Height: height, Time: s.blockTime, ChainID: s.config.ChainID})
+
+	sort.SliceStable(s.futureOps, func(i, j int) bool {
+		return s.futureOps[i].BlockTime.Before(s.futureOps[j].BlockTime)
+	})
+
+	dueOps := make([]simtypes.Operation, 0)
+	for len(s.futureOps) > 0 && !s.futureOps[0].BlockTime.After(s.blockTime) {
+		dueOps = append(dueOps, s.futureOps[0].Op)
+		s.futureOps = s.futureOps[1:]
+	}
+	for _, op := range ops {
+		dueOps = append(dueOps, op.cosmosSdkOp)
+	}
+
+	for _, op := range dueOps {
+		opMsg, futureOps, err := op(s.r, app.BaseApp, ctx, s.accounts, s.config.ChainID)
+		if err != nil {
+			// the cosmos-sdk operations don't account for the heimdall restrictions on their modules,
+			// so their rejected txs are tallied as failures, like the heimdall txs rejected in the block
+			t.Logf("operation %s failed at height %d: %s: %v", opMsg.Name, height, opMsg.Comment, err)
+			s.stats.Tally(opMsg.Route, opMsg.Name, "failure")
+
+			continue
+		}
+
+		s.stats.Tally(opMsg.Route, opMsg.Name, simResult(opMsg.OK))
+
+		for _, futureOp := range futureOps {
+			// the gov proposals return an empty future operation after their votes
+			if futureOp.Op == nil {
+				continue
+			}
+
+			if futureOp.BlockHeight != 0 {
+				// the future operations by height are run at the time of the corresponding block
+				futureOp.BlockTime = s.blockTime.Add(time.Duration(int64(futureOp.BlockHeight)-height) * maxSimBlockTimeStep / 2)
+			}
+			s.futureOps = append(s.futureOps, futureOp)
+		}
+	}
+}
+
+// signTx returns the tx of opMsg, signed by its signer with the next sequence of its account in the block
+func (s *appSimulation) signTx(ctx sdk.Context, opMsg hmSimulation.OperationMsg, sequences map[string]uint64) ([]byte, bool) {
+	account := s.app.AccountKeeper.GetAccount(ctx, opMsg.Signer.Address)
+	if account == nil {
+		return nil, false
+	}
+
+	key := opMsg.Signer.Address.String()
+	sequence, ok := sequences[key]
+	if !ok {
+		sequence = account.GetSequence()
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		s.r,
+		s.app.GetTxConfig(),
+		[]sdk.Msg{opMsg.Msg},
+		ante.DefaultFeeWantedPerTx,
+		simtestutil.DefaultGenTxGas,
+		s.config.ChainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{sequence},
+		opMsg.Signer.PrivKey,
+	)
+	require.NoError(s.t, err)
+
+	txBytes, err := s.app.GetTxConfig().TxEncoder()(tx)
+	require.NoError(s.t, err)
+
+	sequences[key] = sequence + 1
+
+	return txBytes, true
+}
+
+// extendedCommitInfo returns the commit of the last block, with the vote extensions of its block signed by every validator
+func (s *appSimulation) extendedCommitInfo(height int64, validatorSet *stakeTypes.ValidatorSet) (abci.ExtendedCommitInfo, abci.CommitInfo) {
+	extCommit := abci.ExtendedCommitInfo{}
+	lastCommit := abci.CommitInfo{}
+
+	// no vote extension is expected at the initial height
+	if height <= simInitialHeight {
+		return extCommit, lastCommit
+	}
+
+	for _, validator := range validatorSet.Validators {
+		account, found := simtypes.FindAccount(s.accounts, sdk.MustAccAddressFromHex(validator.Signer))
+		require.True(s.t, found, "validator %s is not a simulation account", validator.Signer)
+
+		cmtValidator := abci.Validator{
+			Address: common.FromHex(validator.Signer),
+			Power:   validator.VotingPower,
+		}
+
+		voteInfo := abci.ExtendedVoteInfo{
+			Validator:          cmtValidator,
+			VoteExtension:      s.voteExtension,
+			NonRpVoteExtension: s.nonRpExtension,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}
+		createSignatureForVoteExtensionWithChainID(s.t, height-1, s.config.ChainID, cmtsecp256k1.PrivKey(account.PrivKey.Bytes()), s.voteExtension, s.nonRpExtension, &voteInfo)
+
+		extCommit.Votes = append(extCommit.Votes, voteInfo)
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{
+			Validator:   cmtValidator,
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	return extCommit, lastCommit
+}
+
+// simBlockHash returns a deterministic hash for the simulated block
+func simBlockHash(height int64, txs [][]byte) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, height)
+	for _, tx := range txs {
+		h.Write(tx)
+	}
+
+	return h.Sum(nil)
+}
+
+// simMsgRoute returns the module of the msg type URL, as the route of its simulation stats
+func simMsgRoute(msgType string) string {
+	parts := strings.Split(strings.TrimPrefix(msgType, "/"), ".")
+	if len(parts) < 2 {
+		return msgType
+	}
+
+	return parts[1]
+}
+
+// simResult returns the simulation stats result of an operation
+func simResult(ok bool) string {
+	if ok {
+		return "ok"
+	}
+
+	return "failure"
+}
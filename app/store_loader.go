@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	hardforktypes "github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+// moduleStore is the store of a module introduced after the chain started, named after the module.
+type moduleStore struct {
+	// Name of the module and of its store.
+	Name string
+	// UpgradeHeight returns the height the chains started before the module add its store at, 0 if not scheduled.
+	UpgradeHeight func() int64
+}
+
+// moduleStores are the stores of the modules introduced after the chain started,
+// which the chains started with them already have from genesis.
+var moduleStores = []moduleStore{
+	{Name: hardforktypes.StoreKey, UpgradeHeight: helper.GetHardforkStoreHeight},
+	{Name: upgradetypes.StoreKey, UpgradeHeight: helper.GetUpgradeStoreHeight},
+}
+
+// loadModuleStores returns the module stores to mount on the node, and the ones among them to add to the last commit.
+// The stores of the last commit are mounted, and all of them on a new chain, which has them from genesis.
+// The others are added when the node restarts at their upgrade height: the added stores change the app hash,
+// so all the nodes must add them at the same height. Until then, the store isn't mounted, and its module is disabled.
+func loadModuleStores(db dbm.DB) (map[string]bool, []string, error) {
+	mounted := make(map[string]bool, len(moduleStores))
+
+	version := rootmulti.GetLatestVersion(db)
+	if version == 0 {
+		for _, s := range moduleStores {
+			mounted[s.Name] = true
+		}
+
+		return mounted, nil, nil
+	}
+
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the stores of the last commit: %w", err)
+	}
+
+	committed := make(map[string]bool, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committed[storeInfo.Name] = true
+	}
+
+	var added []string
+	for _, s := range moduleStores {
+		switch {
+		case committed[s.Name]:
+			mounted[s.Name] = true
+		case s.UpgradeHeight() == version+1:
+			mounted[s.Name] = true
+			added = append(added, s.Name)
+		}
+	}
+
+	return mounted, added, nil
+}
+
+// moduleStoresLoader returns the store loader adding the module stores due at the next height, along with the
+// store upgrades of the upgrade plan due at the next height, if any.
+func moduleStoresLoader(added []string, planHeight int64, planUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms storetypes.CommitMultiStore) error {
+		storeUpgrades := &storetypes.StoreUpgrades{Added: added}
+		if planUpgrades != nil && planHeight == ms.LastCommitID().Version+1 {
+			storeUpgrades = &storetypes.StoreUpgrades{
+				Added:   append(append([]string{}, planUpgrades.Added...), added...),
+				Renamed: planUpgrades.Renamed,
+				Deleted: planUpgrades.Deleted,
+			}
+		}
+
+		if len(storeUpgrades.Added) == 0 && len(storeUpgrades.Renamed) == 0 && len(storeUpgrades.Deleted) == 0 {
+			return baseapp.DefaultStoreLoader(ms)
+		}
+
+		return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+	}
+}
+
+// isModuleStoreMounted reports whether the store of a module introduced after the chain started is mounted.
+func (app *HeimdallApp) isModuleStoreMounted(name string) bool {
+	return app.mountedModuleStores[name]
+}
+
+// kvStoreKeysToMount returns the keys of the stores to mount, without the module stores not added yet.
+func (app *HeimdallApp) kvStoreKeysToMount(keys map[string]*storetypes.KVStoreKey) map[string]*storetypes.KVStoreKey {
+	mounted := maps.Clone(keys)
+	for _, s := range moduleStores {
+		if !app.isModuleStoreMounted(s.Name) {
+			delete(mounted, s.Name)
+		}
+	}
+
+	return mounted
+}
+
+// checkModuleStoresUpgrade halts the node at the upgrade height of the module stores it hasn't mounted,
+// for it to add them when it restarts at that height, like the other nodes.
+func (app *HeimdallApp) checkModuleStoresUpgrade(height int64) error {
+	for _, s := range moduleStores {
+		if !app.isModuleStoreMounted(s.Name) && s.UpgradeHeight() == height {
+			return fmt.Errorf("the %s module store is added at height %d: restart the node to add it", s.Name, height)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"slices"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	hardforktypes "github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func newTestRootMultiStore(db dbm.DB, storeNames ...string) *rootmulti.Store {
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, name := range storeNames {
+		ms.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+
+	return ms
+}
+
+func moduleStoreNames() []string {
+	names := make([]string, 0, len(moduleStores))
+	for _, s := range moduleStores {
+		names = append(names, s.Name)
+	}
+
+	return names
+}
+
+func setModuleStoresUpgradeHeight(height int64) {
+	helper.SetHardforkStoreHeight(height)
+	helper.SetUpgradeStoreHeight(height)
+}
+
+func setModuleStoresUpgradeHeightForTest(height int64) func() {
+	hardforkStoreHeight, upgradeStoreHeight := helper.GetHardforkStoreHeight(), helper.GetUpgradeStoreHeight()
+	setModuleStoresUpgradeHeight(height)
+	return func() {
+		helper.SetHardforkStoreHeight(hardforkStoreHeight)
+		helper.SetUpgradeStoreHeight(upgradeStoreHeight)
+	}
+}
+
+func TestModuleStoresLoader(t *testing.T) {
+	defer setModuleStoresUpgradeHeightForTest(0)()
+
+	db := dbm.NewMemDB()
+	existing := []string{"acc", "bank"}
+	all := append(slices.Clone(existing), moduleStoreNames()...)
+
+	// a new chain has the module stores from genesis
+	mounted, added, err := loadModuleStores(db)
+	require.NoError(t, err)
+	require.Len(t, mounted, len(moduleStores))
+	require.Empty(t, added)
+
+	// a chain started without the module stores
+	ms := newTestRootMultiStore(db, existing...)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms.Commit()
+
+	// restarts without them while their upgrade height isn't scheduled, or not reached
+	for _, height := range []int64{0, 2, 4} {
+		setModuleStoresUpgradeHeight(height)
+		mounted, added, err = loadModuleStores(db)
+		require.NoError(t, err)
+		require.Empty(t, mounted)
+		require.Empty(t, added)
+
+		ms = newTestRootMultiStore(db, existing...)
+		require.NoError(t, moduleStoresLoader(added, 0, nil)(ms))
+		require.Equal(t, int64(2), ms.LastCommitID().Version)
+	}
+
+	// and adds them on the restart at the upgrade height
+	setModuleStoresUpgradeHeight(3)
+	mounted, added, err = loadModuleStores(db)
+	require.NoError(t, err)
+	require.Len(t, mounted, len(moduleStores))
+	require.ElementsMatch(t, moduleStoreNames(), added)
+
+	ms = newTestRootMultiStore(db, all...)
+	require.NoError(t, moduleStoresLoader(added, 0, nil)(ms))
+	require.Equal(t, int64(2), ms.LastCommitID().Version)
+	ms.Commit()
+
+	commitInfo, err := ms.GetCommitInfo(3)
+	require.NoError(t, err)
+	names := make([]string, 0, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		names = append(names, storeInfo.Name)
+	}
+	require.ElementsMatch(t, all, names)
+
+	// which are loaded as any other store on the next restarts
+	mounted, added, err = loadModuleStores(db)
+	require.NoError(t, err)
+	require.Len(t, mounted, len(moduleStores))
+	require.Empty(t, added)
+
+	ms = newTestRootMultiStore(db, all...)
+	require.NoError(t, moduleStoresLoader(added, 0, nil)(ms))
+	require.Equal(t, int64(3), ms.LastCommitID().Version)
+}
+
+// newPreModuleStoresDB returns the db of a chain started before the hardfork and upgrade modules, at height 2.
+func newPreModuleStoresDB(t *testing.T, appOptions simtestutil.AppOptionsMap) dbm.DB {
+	t.Helper()
+
+	var existing []string
+	for _, key := range NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, appOptions).GetStoreKeys() {
+		if !slices.Contains(moduleStoreNames(), key.Name()) {
+			existing = append(existing, key.Name())
+		}
+	}
+
+	db := dbm.NewMemDB()
+	ms := newTestRootMultiStore(db, existing...)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms.Commit()
+
+	return db
+}
+
+func TestRestartWithoutModuleStores(t *testing.T) {
+	defer setModuleStoresUpgradeHeightForTest(0)()
+
+	appOptions := make(simtestutil.AppOptionsMap)
+	appOptions[flags.FlagHome] = t.TempDir()
+	db := newPreModuleStoresDB(t, appOptions)
+
+	// the node restarts without their stores and modules while the upgrade height isn't scheduled
+	app := NewHeimdallApp(log.NewNopLogger(), db, nil, true, appOptions)
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	require.NotContains(t, app.ModuleManager.Modules, hardforktypes.ModuleName)
+	require.NotContains(t, app.ModuleManager.Modules, upgradetypes.ModuleName)
+	require.NoError(t, app.checkModuleStoresUpgrade(3))
+
+	// it halts at the upgrade height
+	setModuleStoresUpgradeHeight(3)
+	require.ErrorContains(t, app.checkModuleStoresUpgrade(3), "restart the node to add it")
+
+	// and adds the stores when it restarts at it
+	app = NewHeimdallApp(log.NewNopLogger(), db, nil, true, appOptions)
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	require.Contains(t, app.ModuleManager.Modules, hardforktypes.ModuleName)
+	require.Contains(t, app.ModuleManager.Modules, upgradetypes.ModuleName)
+	require.NoError(t, app.checkModuleStoresUpgrade(3))
+	require.NotNil(t, app.CommitMultiStore().GetCommitKVStore(app.GetKey(hardforktypes.StoreKey)))
+	require.NotNil(t, app.CommitMultiStore().GetCommitKVStore(app.GetKey(upgradetypes.StoreKey)))
+}
+
+func TestUpgradeStoreHeight(t *testing.T) {
+	defer setModuleStoresUpgradeHeightForTest(0)()
+
+	appOptions := make(simtestutil.AppOptionsMap)
+	appOptions[flags.FlagHome] = t.TempDir()
+	db := newPreModuleStoresDB(t, appOptions)
+
+	// the upgrade store is added at its own height, before the hardfork one
+	helper.SetUpgradeStoreHeight(3)
+	helper.SetHardforkStoreHeight(4)
+
+	app := NewHeimdallApp(log.NewNopLogger(), db, nil, true, appOptions)
+	require.Contains(t, app.ModuleManager.Modules, upgradetypes.ModuleName)
+	require.NotContains(t, app.ModuleManager.Modules, hardforktypes.ModuleName)
+	require.Equal(t, []string{upgradetypes.StoreKey}, app.addedModuleStores)
+
+	// the upgrade plans can be read from the added store
+	_, err := app.UpgradeKeeper.GetUpgradePlan(app.NewUncachedContext(false, cmtproto.Header{Height: 3}))
+	require.ErrorIs(t, err, upgradetypes.ErrNoUpgradePlanFound)
+
+	app.CommitMultiStore().Commit()
+	require.Equal(t, int64(3), app.LastBlockHeight())
+
+	// the node halts at the height of the hardfork store, and adds it on the restart
+	require.ErrorContains(t, app.checkModuleStoresUpgrade(4), hardforktypes.StoreKey)
+
+	app = NewHeimdallApp(log.NewNopLogger(), db, nil, true, appOptions)
+	require.Contains(t, app.ModuleManager.Modules, upgradetypes.ModuleName)
+	require.Contains(t, app.ModuleManager.Modules, hardforktypes.ModuleName)
+	require.Equal(t, []string{hardforktypes.StoreKey}, app.addedModuleStores)
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade is a software upgrade of the chain, applied at the height of the governance plan of the same name.
+// Nodes running the previous binary halt at that height, and resume with the binary registering the upgrade.
+type Upgrade struct {
+	// Name of the upgrade plan.
+	Name string
+	// StoreUpgrades are the stores added, renamed and deleted at the upgrade height.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// upgrades are the software upgrades handled by this binary. Their handlers run the migrations registered
+// by the modules with RegisterMigrations, from the module versions of the previous binary to the current ones.
+var upgrades []Upgrade
+
+// preUpgradeModuleVersions are the consensus versions of the modules in the binary introducing the upgrade module.
+// The chains started before it have no module versions recorded, so their first upgrade migrates from these.
+var preUpgradeModuleVersions = module.VersionMap{
+	"auth":         5,
+	"bank":         4,
+	"bor":          1,
+	"chainmanager": 1,
+	"checkpoint":   1,
+	"clerk":        1,
+	"consensus":    1,
+	"gov":          5,
+	"hardfork":     1,
+	"milestone":    1,
+	"params":       1,
+	"stake":        1,
+	"topup":        1,
+	"upgrade":      2,
+}
+
+// setUpgradeHandlers registers the handlers of the software upgrades.
+func (app *HeimdallApp) setUpgradeHandlers() {
+	for _, u := range upgrades {
+		if app.UpgradeKeeper.HasHandler(u.Name) {
+			panic(fmt.Sprintf("duplicate handler of upgrade %s", u.Name))
+		}
+
+		app.UpgradeKeeper.SetUpgradeHandler(u.Name, app.runMigrationsHandler(u.Name))
+	}
+}
+
+// runMigrationsHandler returns the handler of an upgrade, running the migrations of the modules
+// whose consensus version changed, and initializing the modules added by the upgrade.
+func (app *HeimdallApp) runMigrationsHandler(name string) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if len(fromVM) == 0 {
+			fromVM = make(module.VersionMap, len(preUpgradeModuleVersions))
+			for moduleName, version := range preUpgradeModuleVersions {
+				fromVM[moduleName] = version
+			}
+		}
+
+		app.Logger().Info("Running module migrations", "upgrade", name, "height", plan.Height)
+
+		return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+	}
+}
+
+// setStoreLoader sets the store loader, adding the module stores due at the next height, and applying the store
+// upgrades of the upgrade plan due at the next height, as written to disk by the node halted at it.
+func (app *HeimdallApp) setStoreLoader() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read the upgrade info from disk: %w", err))
+	}
+
+	if upgradeInfo.Name != "" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		for _, u := range upgrades {
+			if u.Name == upgradeInfo.Name {
+				app.SetStoreLoader(moduleStoresLoader(app.addedModuleStores, upgradeInfo.Height, &u.StoreUpgrades))
+				return
+			}
+		}
+	}
+
+	app.SetStoreLoader(moduleStoresLoader(app.addedModuleStores, 0, nil))
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetUpgradeHandlers(t *testing.T) {
+	original := upgrades
+	t.Cleanup(func() { upgrades = original })
+
+	upgrades = []Upgrade{{Name: "v2"}}
+	app := SetupApp(t, 1).App
+	require.True(t, app.UpgradeKeeper.HasHandler("v2"))
+	require.False(t, app.UpgradeKeeper.HasHandler("v3"))
+
+	require.Panics(t, func() { app.setUpgradeHandlers() })
+}
+
+func TestRunMigrationsHandler(t *testing.T) {
+	app := SetupApp(t, 1).App
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	handler := app.runMigrationsHandler("v2")
+
+	versionMap, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), versionMap)
+
+	updated, err := handler(ctx, upgradetypes.Plan{Name: "v2", Height: 10}, versionMap)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), updated)
+
+	// the chains started before the upgrade module have no module versions recorded
+	updated, err = handler(ctx, upgradetypes.Plan{Name: "v2", Height: 10}, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), updated)
+}
+
+func TestPreUpgradeModuleVersions(t *testing.T) {
+	app := SetupApp(t, 1).App
+
+	// every module of the binary introducing the upgrade module is migrated from its version, not initialized
+	for name := range app.ModuleManager.GetVersionMap() {
+		require.Contains(t, preUpgradeModuleVersions, name)
+	}
+}
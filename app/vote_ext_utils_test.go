@@ -2356,11 +2356,26 @@ func createSignatureForVoteExtension(
 	nonRpVoteExtensionBytes []byte,
 	voteInfo *abci.ExtendedVoteInfo,
 ) {
+	createSignatureForVoteExtensionWithChainID(t, height, "", privKey, voteExtensionBytes, nonRpVoteExtensionBytes, voteInfo)
+}
+
+// createSignatureForVoteExtensionWithChainID signs the vote extensions of voteInfo for the given chain
+func createSignatureForVoteExtensionWithChainID(
+	t *testing.T,
+	height int64,
+	chainID string,
+	privKey cmtcrypto.PrivKey,
+	voteExtensionBytes,
+	nonRpVoteExtensionBytes []byte,
+	voteInfo *abci.ExtendedVoteInfo,
+) {
+	t.Helper()
+
 	cve := cmtTypes.CanonicalVoteExtension{
 		Extension: voteExtensionBytes,
 		Height:    height,
 		Round:     int64(0),
-		ChainId:   "",
+		ChainId:   chainID,
 	}
 
 	marshalDelimitedFn := func(msg proto.Message) ([]byte, error) {
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/listener"
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+)
+
+const (
+	clientTimeout = 30 * time.Second
+	// replayTimeout is longer, as replays query the root chain and heimdall for every event
+	replayTimeout = 10 * time.Minute
+)
+
+// Client queries the bridge API of a running bridge
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the bridge API listening on the given address
+func NewClient(addr string) *Client {
+	baseURL := strings.TrimSuffix(addr, "/")
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "http://" + baseURL
+	}
+
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// Status returns the state of the bridge
+func (c *Client) Status() (*Status, error) {
+	status := new(Status)
+	if err := c.do(http.MethodGet, "/status", status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Replay replays the root chain events of the requested block range and returns them
+func (c *Client) Replay(req ReplayRequest) ([]listener.ReplayedEvent, error) {
+	var events []listener.ReplayedEvent
+	if err := c.doWithTimeout(replayTimeout, http.MethodPost, "/replay", req, &events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ListDeadLetters returns all the dead letters
+func (c *Client) ListDeadLetters() ([]queue.DeadLetter, error) {
+	var deadLetters []queue.DeadLetter
+	if err := c.do(http.MethodGet, "/dlq", &deadLetters); err != nil {
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
+
+// GetDeadLetter returns the dead letter with the given id
+func (c *Client) GetDeadLetter(id string) (*queue.DeadLetter, error) {
+	deadLetter := new(queue.DeadLetter)
+	if err := c.do(http.MethodGet, "/dlq/"+url.PathEscape(id), deadLetter); err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+// ReplayDeadLetter re-enqueues the dead letter with the given id
+func (c *Client) ReplayDeadLetter(id string) error {
+	return c.do(http.MethodPost, "/dlq/"+url.PathEscape(id)+"/replay", new(CountResponse))
+}
+
+// ReplayDeadLetters re-enqueues all the dead letters and returns how many were replayed
+func (c *Client) ReplayDeadLetters() (int, error) {
+	var res CountResponse
+	if err := c.do(http.MethodPost, "/dlq/replay", &res); err != nil {
+		return 0, err
+	}
+
+	return res.Count, nil
+}
+
+// DeleteDeadLetter removes the dead letter with the given id
+func (c *Client) DeleteDeadLetter(id string) error {
+	return c.do(http.MethodDelete, "/dlq/"+url.PathEscape(id), new(CountResponse))
+}
+
+// PurgeDeadLetters removes all the dead letters and returns how many were removed
+func (c *Client) PurgeDeadLetters() (int, error) {
+	var res CountResponse
+	if err := c.do(http.MethodDelete, "/dlq", &res); err != nil {
+		return 0, err
+	}
+
+	return res.Count, nil
+}
+
+// do sends the request and decodes the response into out
+func (c *Client) do(method string, path string, out interface{}) error {
+	return c.doWithTimeout(clientTimeout, method, path, nil, out)
+}
+
+// doWithTimeout sends the request with the given JSON body, if any, and decodes the response into out
+func (c *Client) doWithTimeout(timeout time.Duration, method string, path string, in interface{}, out interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error while querying the bridge API, is the bridge running? %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errRes ErrorResponse
+		if err := json.NewDecoder(res.Body).Decode(&errRes); err != nil || errRes.Error == "" {
+			return fmt.Errorf("bridge API returned status %d", res.StatusCode)
+		}
+
+		return fmt.Errorf("bridge API returned status %d: %s", res.StatusCode, errRes.Error)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
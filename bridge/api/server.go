@@ -0,0 +1,413 @@
+// Package api exposes the state of the running bridge over HTTP, for the heimdalld bridge commands.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	logger "github.com/cometbft/cometbft/libs/log"
+	common "github.com/cometbft/cometbft/libs/service"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/listener"
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+	"github.com/0xPolygon/heimdall-v2/bridge/txmanager"
+	"github.com/0xPolygon/heimdall-v2/bridge/util"
+)
+
+const (
+	apiServiceStr = "bridge-api"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// CountResponse is the response of the endpoints acting on dead letters
+type CountResponse struct {
+	Count int `json:"count"`
+}
+
+// ErrorResponse is the response of a failed request
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Status is the state of the running bridge
+type Status struct {
+	RootChain  RootChainStatus         `json:"root_chain"`
+	Queue      QueueStatus             `json:"queue"`
+	Processors []queue.ProcessorStatus `json:"processors"`
+	Proposer   ProposerStatus          `json:"proposer"`
+	MainChain  MainChainStatus         `json:"main_chain"`
+}
+
+// RootChainStatus is the progress of the root chain listener
+type RootChainStatus struct {
+	listener.RootChainStatus
+	Error string `json:"error,omitempty"`
+}
+
+// QueueStatus is the number of tasks in the queue and in the dead-letter queue
+type QueueStatus struct {
+	Pending     *int   `json:"pending,omitempty"`
+	Delayed     *int   `json:"delayed,omitempty"`
+	DeadLetters *int   `json:"dead_letters,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ProposerStatus tells whether the validator of the node is the proposer
+type ProposerStatus struct {
+	// CheckpointProposer tells whether the validator is the next checkpoint proposer
+	CheckpointProposer *bool `json:"checkpoint_proposer,omitempty"`
+	// CurrentProposer tells whether the validator is the current block proposer
+	CurrentProposer *bool  `json:"current_proposer,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// MainChainStatus is the state of the main chain txs sent by the bridge, pending and recently finished
+type MainChainStatus struct {
+	Txs   []MainChainTx `json:"txs"`
+	Error string        `json:"error,omitempty"`
+}
+
+// MainChainTx is a main chain tx sent by the bridge, with the hash of its last or mined attempt
+type MainChainTx struct {
+	Label       string           `json:"label"`
+	Nonce       uint64           `json:"nonce"`
+	Status      txmanager.Status `json:"status"`
+	Hash        string           `json:"hash"`
+	Attempts    int              `json:"attempts"`
+	Drops       int              `json:"drops"`
+	GasFeeCap   string           `json:"gas_fee_cap"`
+	BlockNumber uint64           `json:"block_number,omitempty"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// ReplayRequest is the request of a replay of root chain events
+type ReplayRequest struct {
+	FromBlock uint64   `json:"from_block"`
+	ToBlock   uint64   `json:"to_block"`
+	Events    []string `json:"events,omitempty"`
+	DryRun    bool     `json:"dry_run"`
+}
+
+// Replayer replays the root chain events of a block range, implemented by the root chain listener
+type Replayer interface {
+	Replay(ctx context.Context, fromBlock uint64, toBlock uint64, eventNames []string, dryRun bool) ([]listener.ReplayedEvent, error)
+}
+
+// Server serves the bridge API
+type Server struct {
+	// Base service
+	common.BaseService
+
+	addr           string
+	queueConnector *queue.Connector
+	storageClient  *leveldb.DB
+	cdc            codec.Codec
+	replayer       Replayer
+	httpServer     *http.Server
+}
+
+// NewServer returns the bridge API server listening on the given address
+func NewServer(addr string, queueConnector *queue.Connector, storageClient *leveldb.DB, cdc codec.Codec, replayer Replayer) *Server {
+	server := &Server{
+		addr:           addr,
+		queueConnector: queueConnector,
+		storageClient:  storageClient,
+		cdc:            cdc,
+		replayer:       replayer,
+	}
+
+	server.BaseService = *common.NewBaseService(logger.NewTMLogger(logger.NewSyncWriter(os.Stdout)).With("service", apiServiceStr), apiServiceStr, server)
+	server.httpServer = &http.Server{
+		Handler:           server.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return server
+}
+
+// Handler returns the handler serving the bridge API routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /status", s.getStatus)
+	mux.HandleFunc("POST /replay", s.replay)
+
+	mux.HandleFunc("GET /dlq", s.listDeadLetters)
+	mux.HandleFunc("DELETE /dlq", s.purgeDeadLetters)
+	mux.HandleFunc("POST /dlq/replay", s.replayDeadLetters)
+	mux.HandleFunc("GET /dlq/{id}", s.getDeadLetter)
+	mux.HandleFunc("DELETE /dlq/{id}", s.deleteDeadLetter)
+	mux.HandleFunc("POST /dlq/{id}/replay", s.replayDeadLetter)
+
+	return mux
+}
+
+// OnStart starts serving the bridge API
+func (s *Server) OnStart() error {
+	if err := s.BaseService.OnStart(); err != nil {
+		s.Logger.Error("BridgeAPI: OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Error("BridgeAPI: error while serving", "Error", err)
+		}
+	}()
+
+	s.Logger.Info("BridgeAPI: started", "addr", listener.Addr().String())
+
+	return nil
+}
+
+// OnStop stops serving the bridge API
+func (s *Server) OnStop() {
+	s.BaseService.OnStop() // Always call the overridden method.
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.Logger.Error("BridgeAPI: error while stopping", "Error", err)
+	}
+
+	s.Logger.Info("BridgeAPI: stopped")
+}
+
+func (s *Server) getStatus(w http.ResponseWriter, _ *http.Request) {
+	var status Status
+
+	// root chain listener
+	if rootChainStatus, err := listener.GetRootChainStatus(s.storageClient); err != nil {
+		status.RootChain.Error = err.Error()
+	} else {
+		status.RootChain.RootChainStatus = *rootChainStatus
+	}
+
+	// queue
+	if pending, delayed, err := s.queueConnector.TaskCounts(); err != nil {
+		status.Queue.Error = err.Error()
+	} else {
+		status.Queue.Pending, status.Queue.Delayed = &pending, &delayed
+	}
+
+	if store := s.queueConnector.DeadLetters(); store != nil {
+		if deadLetters, err := store.List(); err != nil {
+			status.Queue.Error = err.Error()
+		} else {
+			count := len(deadLetters)
+			status.Queue.DeadLetters = &count
+		}
+	}
+
+	// processors
+	status.Processors = s.queueConnector.ProcessorStatuses()
+
+	// proposer
+	if isProposer, err := util.IsProposer(s.cdc); err != nil {
+		status.Proposer.Error = err.Error()
+	} else {
+		status.Proposer.CheckpointProposer = &isProposer
+	}
+
+	if isCurrentProposer, err := util.IsCurrentProposer(s.cdc); err != nil {
+		status.Proposer.Error = err.Error()
+	} else {
+		status.Proposer.CurrentProposer = &isCurrentProposer
+	}
+
+	// main chain txs
+	if txs, err := txmanager.GetTxs(s.storageClient); err != nil {
+		status.MainChain.Error = err.Error()
+	} else {
+		status.MainChain.Txs = make([]MainChainTx, 0, len(txs))
+		for _, tx := range txs {
+			status.MainChain.Txs = append(status.MainChain.Txs, newMainChainTx(tx))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+func newMainChainTx(tx txmanager.Tx) MainChainTx {
+	mainChainTx := MainChainTx{
+		Label:       tx.Label,
+		Nonce:       tx.Nonce,
+		Status:      tx.Status,
+		Attempts:    len(tx.Attempts),
+		Drops:       tx.Drops,
+		BlockNumber: tx.BlockNumber,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+
+	if len(tx.Attempts) > 0 {
+		last := tx.LastAttempt()
+		mainChainTx.Hash = last.Hash.Hex()
+		mainChainTx.GasFeeCap = last.GasFeeCap.String()
+	}
+
+	if tx.MinedHash != nil {
+		mainChainTx.Hash = tx.MinedHash.Hex()
+	}
+
+	return mainChainTx
+}
+
+func (s *Server) replay(w http.ResponseWriter, r *http.Request) {
+	if s.replayer == nil {
+		writeJSON(w, http.StatusServiceUnavailable, ErrorResponse{Error: "replay is not available"})
+		return
+	}
+
+	var req ReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "invalid replay request: " + err.Error()})
+		return
+	}
+
+	events, err := s.replayer.Replay(r.Context(), req.FromBlock, req.ToBlock, req.Events, req.DryRun)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, events)
+}
+
+func (s *Server) listDeadLetters(w http.ResponseWriter, _ *http.Request) {
+	store, ok := s.deadLetterStore(w)
+	if !ok {
+		return
+	}
+
+	deadLetters, err := store.List()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deadLetters)
+}
+
+func (s *Server) getDeadLetter(w http.ResponseWriter, r *http.Request) {
+	store, ok := s.deadLetterStore(w)
+	if !ok {
+		return
+	}
+
+	deadLetter, err := store.Get(r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deadLetter)
+}
+
+func (s *Server) replayDeadLetter(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.deadLetterStore(w); !ok {
+		return
+	}
+
+	if err := s.queueConnector.ReplayDeadLetter(r.PathValue("id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, CountResponse{Count: 1})
+}
+
+func (s *Server) replayDeadLetters(w http.ResponseWriter, _ *http.Request) {
+	store, ok := s.deadLetterStore(w)
+	if !ok {
+		return
+	}
+
+	deadLetters, err := store.List()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	replayed := 0
+	for _, deadLetter := range deadLetters {
+		if err := s.queueConnector.ReplayDeadLetter(deadLetter.ID); err != nil {
+			s.Logger.Error("BridgeAPI: error while replaying dead letter", "id", deadLetter.ID, "Error", err)
+			continue
+		}
+		replayed++
+	}
+
+	writeJSON(w, http.StatusOK, CountResponse{Count: replayed})
+}
+
+func (s *Server) deleteDeadLetter(w http.ResponseWriter, r *http.Request) {
+	store, ok := s.deadLetterStore(w)
+	if !ok {
+		return
+	}
+
+	if err := store.Delete(r.PathValue("id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, CountResponse{Count: 1})
+}
+
+func (s *Server) purgeDeadLetters(w http.ResponseWriter, _ *http.Request) {
+	store, ok := s.deadLetterStore(w)
+	if !ok {
+		return
+	}
+
+	purged, err := store.Purge()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, CountResponse{Count: purged})
+}
+
+// deadLetterStore returns the dead letter store, writing an error response if dead letters are not enabled
+func (s *Server) deadLetterStore(w http.ResponseWriter) (*queue.DeadLetterStore, bool) {
+	store := s.queueConnector.DeadLetters()
+	if store == nil {
+		writeJSON(w, http.StatusServiceUnavailable, ErrorResponse{Error: "dead letters are not enabled"})
+		return nil, false
+	}
+
+	return store, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, queue.ErrDeadLetterNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, listener.ErrInvalidReplay):
+		status = http.StatusBadRequest
+	}
+
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
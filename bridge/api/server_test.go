@@ -0,0 +1,172 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/api"
+	"github.com/0xPolygon/heimdall-v2/bridge/listener"
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+)
+
+func newTestClient(t *testing.T) (*api.Client, *queue.DeadLetterStore) {
+	t.Helper()
+
+	client, store, _ := newTestClientWithDB(t)
+
+	return client, store
+}
+
+func newTestClientWithDB(t *testing.T) (*api.Client, *queue.DeadLetterStore, *leveldb.DB) {
+	t.Helper()
+
+	return newTestClientWithReplayer(t, nil)
+}
+
+func newTestClientWithReplayer(t *testing.T, replayer api.Replayer) (*api.Client, *queue.DeadLetterStore, *leveldb.DB) {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	qc := queue.NewLevelDBQueueConnector(db)
+	store := queue.NewDeadLetterStore(db)
+	qc.EnableDeadLetters(store)
+
+	server := httptest.NewServer(api.NewServer("", qc, db, nil, replayer).Handler())
+	t.Cleanup(server.Close)
+
+	return api.NewClient(server.URL), store, db
+}
+
+func addDeadLetter(t *testing.T, store *queue.DeadLetterStore, id string) {
+	t.Helper()
+
+	require.NoError(t, store.Add(&tasks.Signature{
+		UUID: id,
+		Name: "sendTask",
+		Args: []tasks.Arg{{Type: "string", Value: id}},
+	}, "failure"))
+	// keep the listing order deterministic
+	time.Sleep(time.Millisecond)
+}
+
+func TestServer_DeadLetters(t *testing.T) {
+	client, store := newTestClient(t)
+
+	deadLetters, err := client.ListDeadLetters()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+
+	addDeadLetter(t, store, "task_1")
+	addDeadLetter(t, store, "task_2")
+	addDeadLetter(t, store, "task_3")
+
+	deadLetters, err = client.ListDeadLetters()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 3)
+	require.Equal(t, "task_1", deadLetters[0].ID)
+
+	deadLetter, err := client.GetDeadLetter("task_2")
+	require.NoError(t, err)
+	require.Equal(t, "sendTask", deadLetter.TaskName)
+	require.Equal(t, "failure", deadLetter.Error)
+
+	_, err = client.GetDeadLetter("unknown")
+	require.ErrorContains(t, err, "status 404")
+
+	require.NoError(t, client.ReplayDeadLetter("task_1"))
+	require.ErrorContains(t, client.ReplayDeadLetter("task_1"), "status 404")
+
+	require.NoError(t, client.DeleteDeadLetter("task_2"))
+	require.ErrorContains(t, client.DeleteDeadLetter("task_2"), "status 404")
+
+	addDeadLetter(t, store, "task_4")
+
+	replayed, err := client.ReplayDeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, 2, replayed)
+
+	addDeadLetter(t, store, "task_5")
+
+	purged, err := client.PurgeDeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+
+	deadLetters, err = client.ListDeadLetters()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestServer_Status(t *testing.T) {
+	client, store, db := newTestClientWithDB(t)
+
+	require.NoError(t, db.Put([]byte("rootchain-last-block"), []byte("1000"), nil))
+	addDeadLetter(t, store, "task_1")
+
+	status, err := client.Status()
+	require.NoError(t, err)
+
+	require.Empty(t, status.RootChain.Error)
+	require.NotNil(t, status.RootChain.LastBlock)
+	require.Equal(t, uint64(1000), *status.RootChain.LastBlock)
+
+	require.Empty(t, status.Queue.Error)
+	require.Equal(t, 0, *status.Queue.Pending)
+	require.Equal(t, 0, *status.Queue.Delayed)
+	require.Equal(t, 1, *status.Queue.DeadLetters)
+
+	require.Empty(t, status.Processors)
+}
+
+// fakeReplayer records the replay requests and returns a single event
+type fakeReplayer struct {
+	requests []api.ReplayRequest
+}
+
+func (f *fakeReplayer) Replay(_ context.Context, fromBlock uint64, toBlock uint64, eventNames []string, dryRun bool) ([]listener.ReplayedEvent, error) {
+	if toBlock < fromBlock {
+		return nil, fmt.Errorf("%w: from block is after to block", listener.ErrInvalidReplay)
+	}
+
+	f.requests = append(f.requests, api.ReplayRequest{FromBlock: fromBlock, ToBlock: toBlock, Events: eventNames, DryRun: dryRun})
+
+	known := true
+
+	return []listener.ReplayedEvent{{Name: "StateSynced", BlockNumber: fromBlock, TxHash: "0x01", LogIndex: 2, Known: &known}}, nil
+}
+
+func TestServer_Replay(t *testing.T) {
+	replayer := &fakeReplayer{}
+	client, _, _ := newTestClientWithReplayer(t, replayer)
+
+	req := api.ReplayRequest{FromBlock: 100, ToBlock: 200, Events: []string{"StateSynced"}, DryRun: true}
+
+	events, err := client.Replay(req)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(100), events[0].BlockNumber)
+	require.True(t, *events[0].Known)
+	require.Equal(t, []api.ReplayRequest{req}, replayer.requests)
+
+	_, err = client.Replay(api.ReplayRequest{FromBlock: 200, ToBlock: 100})
+	require.ErrorContains(t, err, "status 400")
+
+	client, _ = newTestClient(t)
+	_, err = client.Replay(req)
+	require.ErrorContains(t, err, "status 503")
+}
+
+func TestClient_BridgeNotRunning(t *testing.T) {
+	_, err := api.NewClient("127.0.0.1:1").ListDeadLetters()
+	require.ErrorContains(t, err, "is the bridge running?")
+}
@@ -0,0 +1,389 @@
+package broadcaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
+)
+
+const (
+	// maxMsgsPerBatchTx bounds the msgs packed into a tx, as heimdall refuses txs carrying more than 16 msgs in CheckTx
+	maxMsgsPerBatchTx = 16
+
+	// batchGasAdjustment is the margin over the simulated gas of a multi-msg tx, which must fit in the max tx gas
+	batchGasAdjustment = 1.5
+
+	// maxDeliveryWaitBlocks bounds the blocks searched for a multi-msg tx, from the latest one when it was broadcast
+	maxDeliveryWaitBlocks = 10
+
+	// deliveryPollInterval is the interval at which new blocks are polled while waiting for a multi-msg tx
+	deliveryPollInterval = 500 * time.Millisecond
+)
+
+// errBatcherStopped is returned for the msgs submitted after the batcher stopped
+var errBatcherStopped = errors.New("tx batcher stopped")
+
+// failedMsgIndexRegex matches the index of the failed msg in the log of a tx, as reported by the baseapp
+var failedMsgIndexRegex = regexp.MustCompile(`message index: (\d+)`)
+
+// batchResult is the result of the tx carrying a msg
+type batchResult struct {
+	txRes *sdk.TxResponse
+	err   error
+}
+
+// batchRequest is a msg waiting for its tx
+type batchRequest struct {
+	msg    sdk.Msg
+	result chan batchResult
+}
+
+// batcher coalesces the msgs submitted concurrently to the broadcaster, to broadcast them together:
+// the account and the auth params are fetched once per batch, and its txs are broadcast back to back.
+// Only the consecutive non-side msgs are packed into multi-msg txs. The side msgs, i.e. the state syncs,
+// checkpoints, stake and topup msgs, still take a tx each, as sidetxs.CountSideHandlers allows a single one
+// per tx: for them, batching only saves the account and auth params fetches. So batching does not drain
+// a state sync backlog faster, its records still need a tx each, in as many blocks as without batching.
+// When a tx carrying several msgs fails in CheckTx, its msgs are broadcast again one by one, so that each msg
+// gets the result of its own tx. A multi-msg tx is also awaited in a block: when it fails there, the msg that
+// failed gets the error, and the others, reverted along with it, are broadcast again one by one.
+type batcher struct {
+	logger log.Logger
+
+	requests chan *batchRequest
+	stopped  chan struct{}
+
+	size      int
+	window    time.Duration
+	isSideMsg func(msg sdk.Msg) bool
+
+	// broadcast broadcasts each group of msgs in a tx, and returns the result of each tx
+	broadcast func(ctx context.Context, txs [][]sdk.Msg) []batchResult
+
+	// awaitDelivery waits for the broadcast tx to be included in a block, and returns its result there
+	awaitDelivery func(ctx context.Context, txRes *sdk.TxResponse) (*sdk.TxResponse, error)
+}
+
+// EnableBatching makes BroadcastToHeimdall batch the msgs submitted within the given window, up to the given size.
+// It must be called before the broadcaster is used, and batches until the context is done.
+func (tb *TxBroadcaster) EnableBatching(ctx context.Context, sideTxCfg sidetxs.SideTxConfigurator, size int, window time.Duration) {
+	if size <= 1 {
+		return
+	}
+
+	tb.batcher = newBatcher(tb.logger, size, window, func(msg sdk.Msg) bool {
+		return sideTxCfg.GetSideHandler(msg) != nil
+	}, tb.broadcastBatch, tb.awaitDelivery)
+
+	tb.logger.Info("Batching the heimdall txs", "size", size, "window", window)
+
+	go tb.batcher.run(ctx)
+}
+
+// broadcastBatch broadcasts the txs of a batch back to back, with the account fetched once
+func (tb *TxBroadcaster) broadcastBatch(ctx context.Context, txs [][]sdk.Msg) []batchResult {
+	tb.heimdallMutex.Lock()
+	defer tb.heimdallMutex.Unlock()
+
+	results := make([]batchResult, len(txs))
+
+	txf, err := tb.newTxFactory(ctx)
+	if err != nil {
+		for i := range results {
+			results[i] = batchResult{txRes: &sdk.TxResponse{}, err: err}
+		}
+		return results
+	}
+
+	for i, msgs := range txs {
+		txRes, err := tb.broadcastTx(ctx, txf, msgs...)
+		results[i] = batchResult{txRes: txRes, err: err}
+	}
+
+	return results
+}
+
+// awaitDelivery searches the blocks from the latest one for the given tx, as the tx indexer is disabled by default,
+// and returns its result in the block
+func (tb *TxBroadcaster) awaitDelivery(ctx context.Context, txRes *sdk.TxResponse) (*sdk.TxResponse, error) {
+	node, err := tb.CliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	lastHeight := height + maxDeliveryWaitBlocks
+
+	for height <= lastHeight {
+		status, err = node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for ; height <= status.SyncInfo.LatestBlockHeight && height <= lastHeight; height++ {
+			block, err := node.Block(ctx, &height)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, tx := range block.Block.Txs {
+				if !strings.EqualFold(fmt.Sprintf("%X", tx.Hash()), txRes.TxHash) {
+					continue
+				}
+
+				blockResults, err := node.BlockResults(ctx, &height)
+				if err != nil {
+					return nil, err
+				}
+				if i >= len(blockResults.TxsResults) {
+					return nil, fmt.Errorf("no result for tx %d of block %d", i, height)
+				}
+
+				result := blockResults.TxsResults[i]
+
+				return &sdk.TxResponse{
+					Height:    height,
+					TxHash:    txRes.TxHash,
+					Codespace: result.Codespace,
+					Code:      result.Code,
+					RawLog:    result.Log,
+					GasWanted: result.GasWanted,
+					GasUsed:   result.GasUsed,
+				}, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(deliveryPollInterval):
+		}
+	}
+
+	return nil, fmt.Errorf("tx %s not found in the %d blocks after its broadcast", txRes.TxHash, maxDeliveryWaitBlocks)
+}
+
+func newBatcher(
+	logger log.Logger,
+	size int,
+	window time.Duration,
+	isSideMsg func(msg sdk.Msg) bool,
+	broadcast func(ctx context.Context, txs [][]sdk.Msg) []batchResult,
+	awaitDelivery func(ctx context.Context, txRes *sdk.TxResponse) (*sdk.TxResponse, error),
+) *batcher {
+	return &batcher{
+		logger:        logger,
+		requests:      make(chan *batchRequest),
+		stopped:       make(chan struct{}),
+		size:          size,
+		window:        window,
+		isSideMsg:     isSideMsg,
+		broadcast:     broadcast,
+		awaitDelivery: awaitDelivery,
+	}
+}
+
+// submit queues the msg for the next batch, and waits for the result of its tx
+func (b *batcher) submit(ctx context.Context, msg sdk.Msg) (*sdk.TxResponse, error) {
+	req := &batchRequest{msg: msg, result: make(chan batchResult, 1)}
+
+	select {
+	case b.requests <- req:
+	case <-b.stopped:
+		return &sdk.TxResponse{}, errBatcherStopped
+	case <-ctx.Done():
+		return &sdk.TxResponse{}, ctx.Err()
+	}
+
+	// once taken in a batch, the msg may be broadcast anyway: wait for its result
+	res := <-req.result
+
+	return res.txRes, res.err
+}
+
+// run collects and broadcasts the batches until the context is done
+func (b *batcher) run(ctx context.Context) {
+	defer close(b.stopped)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-b.requests:
+			b.flush(ctx, b.collect(req))
+		}
+	}
+}
+
+// collect returns the batch starting with the given request, completed with the ones submitted within the window
+func (b *batcher) collect(first *batchRequest) []*batchRequest {
+	batch := []*batchRequest{first}
+
+	timer := time.NewTimer(b.window)
+	defer timer.Stop()
+
+	for len(batch) < b.size {
+		select {
+		case req := <-b.requests:
+			batch = append(batch, req)
+		case <-timer.C:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// flush broadcasts the batch, and returns the result of its tx to each request
+func (b *batcher) flush(ctx context.Context, batch []*batchRequest) {
+	txs := b.pack(batch)
+	results := b.broadcast(ctx, msgsOf(txs))
+
+	var retries []*batchRequest
+	for i, tx := range txs {
+		if len(tx) > 1 {
+			// the failure can't be attributed to a msg of the tx
+			if results[i].err != nil {
+				b.logger.Info("Broadcasting the msgs of a failed tx one by one", "msgs", len(tx), "error", results[i].err)
+				retries = append(retries, tx...)
+				continue
+			}
+
+			if failed := b.deliveryFailures(ctx, tx, results[i].txRes); failed != nil {
+				retries = append(retries, failed...)
+				continue
+			}
+		}
+
+		for _, req := range tx {
+			req.result <- results[i]
+		}
+	}
+
+	if len(retries) == 0 {
+		return
+	}
+
+	txs = make([][]*batchRequest, len(retries))
+	for i, req := range retries {
+		txs[i] = []*batchRequest{req}
+	}
+
+	results = b.broadcast(ctx, msgsOf(txs))
+	for i, req := range retries {
+		req.result <- results[i]
+	}
+}
+
+// deliveryFailures waits for the multi-msg tx in a block. When it failed there, the msg reported by the tx log
+// gets the error, and the other msgs of the tx, which were reverted along with it, are returned to be broadcast
+// again. All of them are returned when the failed msg is unknown. When the tx can't be awaited, its msgs keep
+// the CheckTx result.
+func (b *batcher) deliveryFailures(ctx context.Context, tx []*batchRequest, txRes *sdk.TxResponse) []*batchRequest {
+	delivered, err := b.awaitDelivery(ctx, txRes)
+	if err != nil {
+		b.logger.Error("Could not check the result of a multi-msg tx in the block", "txHash", txRes.TxHash, "msgs", len(tx), "error", err)
+		return nil
+	}
+
+	if delivered.Code == abci.CodeTypeOK {
+		return nil
+	}
+
+	index, ok := failedMsgIndex(delivered.RawLog)
+	if !ok || index >= len(tx) {
+		b.logger.Error("Multi-msg tx failed in the block, broadcasting its msgs one by one", "txHash", txRes.TxHash,
+			"msgs", len(tx), "code", delivered.Code, "log", delivered.RawLog)
+		return tx
+	}
+
+	failed := tx[index]
+	b.logger.Error("Msg of a multi-msg tx failed in the block", "txHash", txRes.TxHash, "msgIndex", index,
+		"msgType", sdk.MsgTypeURL(failed.msg), "code", delivered.Code, "log", delivered.RawLog)
+
+	failed.result <- batchResult{
+		txRes: delivered,
+		err:   fmt.Errorf("msg %d of tx %s failed in the block with code %d: %s", index, txRes.TxHash, delivered.Code, delivered.RawLog),
+	}
+
+	retries := make([]*batchRequest, 0, len(tx)-1)
+	retries = append(retries, tx[:index]...)
+
+	return append(retries, tx[index+1:]...)
+}
+
+// failedMsgIndex returns the index of the failed msg reported in the log of a tx
+func failedMsgIndex(rawLog string) (int, bool) {
+	match := failedMsgIndexRegex.FindStringSubmatch(rawLog)
+	if match == nil {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return index, true
+}
+
+// pack splits the batch in txs of consecutive msgs. A side msg takes a tx of its own, as a tx carries at most
+// one side msg, and its other msgs would be executed whatever the side msg votes.
+func (b *batcher) pack(batch []*batchRequest) [][]*batchRequest {
+	var (
+		txs [][]*batchRequest
+		tx  []*batchRequest
+	)
+
+	for _, req := range batch {
+		if b.isSideMsg(req.msg) {
+			if len(tx) > 0 {
+				txs = append(txs, tx)
+				tx = nil
+			}
+			txs = append(txs, []*batchRequest{req})
+			continue
+		}
+
+		if len(tx) == maxMsgsPerBatchTx {
+			txs = append(txs, tx)
+			tx = nil
+		}
+
+		tx = append(tx, req)
+	}
+
+	if len(tx) > 0 {
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+// msgsOf returns the msgs of each tx
+func msgsOf(txs [][]*batchRequest) [][]sdk.Msg {
+	msgs := make([][]sdk.Msg, len(txs))
+	for i, tx := range txs {
+		msgs[i] = make([]sdk.Msg, len(tx))
+		for j, req := range tx {
+			msgs[i][j] = req.msg
+		}
+	}
+
+	return msgs
+}
@@ -0,0 +1,250 @@
+package broadcaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	borTypes "github.com/0xPolygon/heimdall-v2/x/bor/types"
+	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+	clerkTypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// fakeBatchBroadcaster records the broadcast txs, and fails the ones carrying a failing msg, in CheckTx or in the block
+type fakeBatchBroadcaster struct {
+	mu               sync.Mutex
+	txs              [][]sdk.Msg
+	failing          map[sdk.Msg]bool
+	failingInBlock   map[sdk.Msg]bool
+	unknownFailIndex bool
+}
+
+func (f *fakeBatchBroadcaster) broadcast(_ context.Context, txs [][]sdk.Msg) []batchResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	results := make([]batchResult, len(txs))
+	for i, msgs := range txs {
+		f.txs = append(f.txs, msgs)
+
+		results[i] = batchResult{txRes: &sdk.TxResponse{TxHash: string(rune('A' + len(f.txs) - 1))}}
+		for _, msg := range msgs {
+			if f.failing[msg] {
+				results[i] = batchResult{txRes: &sdk.TxResponse{Code: 1}, err: errors.New("tx failed")}
+			}
+		}
+	}
+
+	return results
+}
+
+func (f *fakeBatchBroadcaster) awaitDelivery(_ context.Context, txRes *sdk.TxResponse) (*sdk.TxResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	msgs := f.txs[int(txRes.TxHash[0]-'A')]
+	for i, msg := range msgs {
+		if !f.failingInBlock[msg] {
+			continue
+		}
+
+		rawLog := fmt.Sprintf("failed to execute message; message index: %d: msg failed", i)
+		if f.unknownFailIndex {
+			rawLog = "out of gas"
+		}
+
+		return &sdk.TxResponse{TxHash: txRes.TxHash, Code: 5, RawLog: rawLog}, nil
+	}
+
+	return &sdk.TxResponse{TxHash: txRes.TxHash}, nil
+}
+
+func (f *fakeBatchBroadcaster) broadcastTxs() [][]sdk.Msg {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.txs
+}
+
+func isTestSideMsg(msg sdk.Msg) bool {
+	_, ok := msg.(*clerkTypes.MsgEventRecord)
+	return ok
+}
+
+func newTestBatcher(size int, window time.Duration, fake *fakeBatchBroadcaster) *batcher {
+	return newBatcher(log.NewNopLogger(), size, window, isTestSideMsg, fake.broadcast, fake.awaitDelivery)
+}
+
+func newTestBatch(msgs ...sdk.Msg) []*batchRequest {
+	batch := make([]*batchRequest, len(msgs))
+	for i, msg := range msgs {
+		batch[i] = &batchRequest{msg: msg, result: make(chan batchResult, 1)}
+	}
+
+	return batch
+}
+
+func TestBatcher_Pack(t *testing.T) {
+	t.Parallel()
+
+	b := newTestBatcher(100, 0, &fakeBatchBroadcaster{})
+
+	record1 := &clerkTypes.MsgEventRecord{Id: 1}
+	record2 := &clerkTypes.MsgEventRecord{Id: 2}
+	noAck1 := &checkpointTypes.MsgCpNoAck{}
+	noAck2 := &checkpointTypes.MsgCpNoAck{}
+	vote := &borTypes.MsgVoteProducers{}
+
+	txs := msgsOf(b.pack(newTestBatch(record1, record2, noAck1, vote, record1, noAck2)))
+	require.Equal(t, [][]sdk.Msg{{record1}, {record2}, {noAck1, vote}, {record1}, {noAck2}}, txs)
+
+	// a tx carries up to maxMsgsPerBatchTx msgs
+	msgs := make([]sdk.Msg, maxMsgsPerBatchTx+1)
+	for i := range msgs {
+		msgs[i] = &checkpointTypes.MsgCpNoAck{}
+	}
+	txs = msgsOf(b.pack(newTestBatch(msgs...)))
+	require.Len(t, txs, 2)
+	require.Len(t, txs[0], maxMsgsPerBatchTx)
+	require.Len(t, txs[1], 1)
+}
+
+func TestBatcher_SideMsgsUnpacked(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeBatchBroadcaster{}
+	b := newTestBatcher(100, 0, fake)
+
+	records := make([]sdk.Msg, 5)
+	for i := range records {
+		records[i] = &clerkTypes.MsgEventRecord{Id: uint64(i + 1)}
+	}
+
+	// a run of side msgs takes a tx per msg, in the order they were submitted
+	batch := newTestBatch(records...)
+	b.flush(context.Background(), batch)
+	require.Equal(t, [][]sdk.Msg{{records[0]}, {records[1]}, {records[2]}, {records[3]}, {records[4]}}, fake.broadcastTxs())
+
+	for i, req := range batch {
+		res := <-req.result
+		require.NoError(t, res.err)
+		require.Equal(t, string(rune('A'+i)), res.txRes.TxHash)
+	}
+}
+
+func TestBatcher_FailureAttribution(t *testing.T) {
+	t.Parallel()
+
+	good := &checkpointTypes.MsgCpNoAck{From: "good"}
+	bad := &checkpointTypes.MsgCpNoAck{From: "bad"}
+	record := &clerkTypes.MsgEventRecord{Id: 1}
+
+	fake := &fakeBatchBroadcaster{failing: map[sdk.Msg]bool{bad: true}}
+	b := newTestBatcher(100, 0, fake)
+
+	batch := newTestBatch(good, bad, record)
+	b.flush(context.Background(), batch)
+
+	// the failed tx is broadcast again one msg at a time
+	require.Equal(t, [][]sdk.Msg{{good, bad}, {record}, {good}, {bad}}, fake.broadcastTxs())
+
+	res := <-batch[0].result
+	require.NoError(t, res.err)
+	require.Equal(t, "C", res.txRes.TxHash)
+
+	res = <-batch[1].result
+	require.ErrorContains(t, res.err, "tx failed")
+
+	res = <-batch[2].result
+	require.NoError(t, res.err)
+	require.Equal(t, "B", res.txRes.TxHash)
+}
+
+func TestBatcher_DeliveryFailureAttribution(t *testing.T) {
+	t.Parallel()
+
+	first := &checkpointTypes.MsgCpNoAck{From: "first"}
+	bad := &checkpointTypes.MsgCpNoAck{From: "bad"}
+	last := &checkpointTypes.MsgCpNoAck{From: "last"}
+
+	fake := &fakeBatchBroadcaster{failingInBlock: map[sdk.Msg]bool{bad: true}}
+	b := newTestBatcher(100, 0, fake)
+
+	batch := newTestBatch(first, bad, last)
+	b.flush(context.Background(), batch)
+
+	// the failed msg gets the error of the block, and the reverted ones are broadcast again one by one
+	require.Equal(t, [][]sdk.Msg{{first, bad, last}, {first}, {last}}, fake.broadcastTxs())
+
+	res := <-batch[0].result
+	require.NoError(t, res.err)
+	require.Equal(t, "B", res.txRes.TxHash)
+
+	res = <-batch[1].result
+	require.ErrorContains(t, res.err, "msg 1 of tx A failed in the block with code 5")
+	require.Equal(t, uint32(5), res.txRes.Code)
+
+	res = <-batch[2].result
+	require.NoError(t, res.err)
+	require.Equal(t, "C", res.txRes.TxHash)
+
+	// when the failed msg is unknown, all the msgs are broadcast again
+	fake = &fakeBatchBroadcaster{failingInBlock: map[sdk.Msg]bool{bad: true}, unknownFailIndex: true}
+	b = newTestBatcher(100, 0, fake)
+
+	batch = newTestBatch(first, bad)
+	b.flush(context.Background(), batch)
+	require.Equal(t, [][]sdk.Msg{{first, bad}, {first}, {bad}}, fake.broadcastTxs())
+
+	res = <-batch[0].result
+	require.Equal(t, "B", res.txRes.TxHash)
+	res = <-batch[1].result
+	require.Equal(t, "C", res.txRes.TxHash)
+}
+
+func TestBatcher_Submit(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	fake := &fakeBatchBroadcaster{}
+	b := newTestBatcher(10, time.Second, fake)
+	go b.run(ctx)
+
+	// the msgs submitted concurrently are broadcast in a single tx once the batch is full
+	var wg sync.WaitGroup
+	hashes := make([]string, 10)
+	errs := make([]error, 10)
+	for i := range hashes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var res *sdk.TxResponse
+			res, errs[i] = b.submit(context.Background(), &checkpointTypes.MsgCpNoAck{})
+			hashes[i] = res.TxHash
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, fake.broadcastTxs(), 1)
+	require.Len(t, fake.broadcastTxs()[0], 10)
+	for i, hash := range hashes {
+		require.NoError(t, errs[i])
+		require.Equal(t, "A", hash)
+	}
+
+	// no more msgs are taken once stopped
+	cancel()
+	<-b.stopped
+
+	_, err := b.submit(context.Background(), &checkpointTypes.MsgCpNoAck{})
+	require.ErrorIs(t, err, errBatcherStopped)
+}
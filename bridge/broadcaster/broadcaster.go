@@ -34,6 +34,9 @@ type TxBroadcaster struct {
 
 	accNum    uint64
 	lastSeqNo uint64
+
+	// batcher coalesces the msgs broadcast to heimdall, when batching is enabled
+	batcher *batcher
 }
 
 // NewTxBroadcaster creates a new instance of TxBroadcaster and waits until the account is visible locally,
@@ -101,30 +104,46 @@ func NewTxBroadcaster(
 
 // BroadcastToHeimdall broadcast to heimdall
 func (tb *TxBroadcaster) BroadcastToHeimdall(ctx context.Context, msg sdk.Msg, event interface{}) (*sdk.TxResponse, error) {
+	if tb.batcher != nil {
+		defer util.LogElapsedTimeForStateSyncedEvent(event, "BroadcastToHeimdall", time.Now())
+		return tb.batcher.submit(ctx, msg)
+	}
+
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "BroadcastToHeimdall", time.Now())
 
+	txf, err := tb.newTxFactory(ctx)
+	if err != nil {
+		return &sdk.TxResponse{}, err
+	}
+
+	return tb.broadcastTx(ctx, txf, msg)
+}
+
+// newTxFactory fetches the account and the auth params, and returns the factory of the next txs.
+// It must be called with the heimdall mutex held.
+func (tb *TxBroadcaster) newTxFactory(ctx context.Context) (clienttx.Factory, error) {
 	txCfg := tb.CliCtx.TxConfig
 	signMode, err := authsign.APISignModeToInternal(txCfg.SignModeHandler().DefaultMode())
 	if err != nil {
-		return &sdk.TxResponse{}, err
+		return clienttx.Factory{}, err
 	}
 
 	authParams, err := util.GetAccountParamsURL(tb.CliCtx.Codec)
 	if err != nil {
-		return &sdk.TxResponse{}, err
+		return clienttx.Factory{}, err
 	}
 
 	address, err := helper.GetAddressString()
 	if err != nil {
 		tb.logger.Error("Error getting address string", "error", err)
-		return &sdk.TxResponse{}, err
+		return clienttx.Factory{}, err
 	}
 	account, err := util.GetAccount(ctx, tb.CliCtx, address)
 	if err != nil {
 		tb.logger.Error("Error fetching account", "error", err)
-		return &sdk.TxResponse{}, err
+		return clienttx.Factory{}, err
 	}
 	// Note: This is a special case where the sequence of an account is updated if any cli commands are executed
 	// in between two bridge broadcast tx calls, but the lastSeqNo in the TxBroadcaster struct is not updated.
@@ -146,11 +165,33 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(ctx context.Context, msg sdk.Msg, e
 		WithFees(ante.DefaultFeeWantedPerTx.String()).
 		WithGas(authParams.MaxTxGas)
 
+	return txf, nil
+}
+
+// broadcastTx signs and broadcasts the msgs in a single tx, with the next sequence.
+// A tx carrying several msgs is simulated first, and refused when it may exceed the gas limit of the factory.
+// It must be called with the heimdall mutex held.
+func (tb *TxBroadcaster) broadcastTx(ctx context.Context, txf clienttx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf = txf.WithSequence(tb.lastSeqNo)
+
 	// setting this to true to as the if block in BroadcastTx
 	// might cause a canceled transaction.
 	tb.CliCtx.SkipConfirm = true
 
-	txResponse, err := helper.BroadcastTx(tb.CliCtx, txf, msg)
+	// the msgs are only executed in the block, and would all fail there when running out of gas
+	if len(msgs) > 1 {
+		_, gas, err := clienttx.CalculateGas(tb.CliCtx, txf.WithGasAdjustment(batchGasAdjustment), msgs...)
+		if err != nil {
+			tb.logger.Error("Error while simulating the heimdall transaction", "msgs", len(msgs), "error", err)
+			return &sdk.TxResponse{}, err
+		}
+
+		if gas > txf.Gas() {
+			return &sdk.TxResponse{}, fmt.Errorf("%d msgs need %d gas, exceeding the max tx gas %d", len(msgs), gas, txf.Gas())
+		}
+	}
+
+	txResponse, err := helper.BroadcastTx(tb.CliCtx, txf, msgs...)
 	// Check for an error from broadcasting the transaction
 	if err != nil {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
@@ -177,7 +218,7 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(ctx context.Context, msg sdk.Msg, e
 
 	txHash := txResponse.TxHash
 
-	tb.logger.Info("Tx broadcasted successfully", "txHash", txHash, "txResponseCode", txResponse.Code)
+	tb.logger.Info("Tx broadcasted successfully", "txHash", txHash, "txResponseCode", txResponse.Code, "msgs", len(msgs))
 
 	// increment account sequence
 	tb.lastSeqNo += 1
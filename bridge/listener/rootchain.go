@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/0xPolygon/heimdall-v2/bridge/util"
@@ -27,13 +28,14 @@ type RootChainListenerContext struct {
 type RootChainListener struct {
 	BaseListener
 
+	rootChainAbi   *abi.ABI
 	stakingInfoAbi *abi.ABI
 	stateSenderAbi *abi.ABI
 
 	// Pre-built topic→event lookup (avoids per-log linear scan across ABIs)
 	eventMap map[ethCommon.Hash]*abi.Event
 
-	// For self-healing, it will be only initialized if sub_graph_url is provided
+	// For self-healing from the subgraph, it will be only initialized if sub_graph_url is provided
 	subGraphClient *subGraphClient
 }
 
@@ -81,6 +83,7 @@ func NewRootChainListener() *RootChainListener {
 	}
 
 	return &RootChainListener{
+		rootChainAbi:   &contractCaller.RootChainABI,
 		stakingInfoAbi: &contractCaller.StakingInfoABI,
 		stateSenderAbi: &contractCaller.StateSenderABI,
 		eventMap:       eventMap,
@@ -132,8 +135,13 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 	// the upper cap (i.e., the `to` value)
 	//
 	// If the incoming header is a `latest` header, rely on `requiredConfirmations` to get
-	// finalized block range.
+	// finalized block range, unless finality is required.
 	if !newHeader.isFinalized {
+		if rootChainContext.ChainmanagerParams.MainChainConfirmationMode == chainmanagerTypes.ConfirmationMode_CONFIRMATION_MODE_FINALITY {
+			rl.Logger.Debug("RootChainListener: skipping non-finalized block as finality is required", "blockNumber", headerNumber.Uint64())
+			return
+		}
+
 		// This check is only useful when the L1 blocks received are < requiredConfirmations
 		// just for the below headerNumber -= requiredConfirmations math operation
 		confirmationBlocks := big.NewInt(0).SetUint64(requiredConfirmations)
@@ -246,11 +254,26 @@ func (rl *RootChainListener) persistLastRootBlock(block *big.Int) error {
 
 // queryAndBroadcastEvents fetches supported events from the rootChain and handles all of them
 func (rl *RootChainListener) queryAndBroadcastEvents(rootChainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) error {
+	logs, err := rl.queryEvents(rootChainContext, fromBlock, toBlock, rootChainEventTopics(rl.eventMap))
+	if err != nil {
+		return err
+	}
+
+	for _, vLog := range logs {
+		rl.handleLog(vLog, rl.eventMap[vLog.Topics[0]])
+	}
+
+	return nil
+}
+
+// queryEvents fetches the events with the given topics from the rootChain contracts.
+// Only the logs of known events are returned.
+func (rl *RootChainListener) queryEvents(rootChainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int, eventTopics []ethCommon.Hash) ([]types.Log, error) {
 	rl.Logger.Debug("RootChainListener: querying rootChain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	if rl.contractCaller.MainChainClient == nil {
 		// don't advance the cursor if the client isn't ready.
-		return errMainChainClientUnavailable
+		return nil, errMainChainClientUnavailable
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), rl.contractCaller.MainChainTimeout)
@@ -269,12 +292,11 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootChainContext *RootChain
 		},
 	}
 
-	eventTopics := rootChainEventTopics(rl.eventMap)
 	if len(eventTopics) == 0 {
 		// Fail closed. Querying without topics can fetch every log from the
 		// bridge contracts and then advance the cursor without handling them.
 		rl.Logger.Error("RootChainListener: no supported rootChain event topics configured")
-		return errNoSupportedRootChainTopics
+		return nil, errNoSupportedRootChainTopics
 	}
 	query.Topics = [][]ethCommon.Hash{eventTopics}
 
@@ -282,37 +304,42 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootChainContext *RootChain
 	logs, err := rl.contractCaller.MainChainClient.FilterLogs(ctx, query)
 	if err != nil {
 		rl.Logger.Error("RootChainListener: error while filtering logs", "error", err)
-		return err
+		return nil, err
 	}
 
 	if len(logs) > 0 {
 		rl.Logger.Debug("RootChainListener: new logs found", "numberOfLogs", len(logs))
 	}
 
+	known := logs[:0]
 	for _, vLog := range logs {
 		if len(vLog.Topics) == 0 {
 			continue
 		}
 
-		selectedEvent, ok := rl.eventMap[vLog.Topics[0]]
-		if !ok {
+		if rl.eventMap[vLog.Topics[0]] == nil {
 			continue
 		}
 
-		rl.handleLog(vLog, selectedEvent)
+		known = append(known, vLog)
 	}
 
-	return nil
+	return known, nil
 }
 
 func rootChainEventTopics(eventMap map[ethCommon.Hash]*abi.Event) []ethCommon.Hash {
-	topics := make([]ethCommon.Hash, 0, len(rootChainEvents))
+	return filterEventTopics(eventMap, rootChainEvents)
+}
+
+// filterEventTopics returns the topics of the given events
+func filterEventTopics(eventMap map[ethCommon.Hash]*abi.Event, events map[string]struct{}) []ethCommon.Hash {
+	topics := make([]ethCommon.Hash, 0, len(events))
 
 	for topic, event := range eventMap {
 		if event == nil {
 			continue
 		}
-		if _, ok := rootChainEvents[event.Name]; !ok {
+		if _, ok := events[event.Name]; !ok {
 			continue
 		}
 		topics = append(topics, topic)
@@ -0,0 +1,215 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/util"
+	"github.com/0xPolygon/heimdall-v2/contracts/rootchain"
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+// maxReplayBlockRange is the maximum number of root chain blocks replayed by a single request
+const maxReplayBlockRange = 100000
+
+// ErrInvalidReplay is returned when the requested replay is invalid
+var ErrInvalidReplay = errors.New("invalid replay")
+
+// ReplayedEvent is a root chain event found in a replayed block range
+type ReplayedEvent struct {
+	Name        string `json:"name"`
+	BlockNumber uint64 `json:"block_number"`
+	TxHash      string `json:"tx_hash"`
+	LogIndex    uint   `json:"log_index"`
+	// Known tells whether the event was already processed by heimdall, only checked on dry runs
+	Known *bool `json:"known,omitempty"`
+	// Error is the failure to check whether the event is known
+	Error string `json:"error,omitempty"`
+}
+
+// Replay re-ingests the root chain events emitted in the given block range, in order,
+// through the same handling as the newly finalized blocks. Events already processed by heimdall
+// are skipped by the processors. The given event names restrict the replayed events, all by default.
+// On dry runs, the events are only checked against heimdall, and no task is sent.
+// The persisted last root block is left untouched.
+func (rl *RootChainListener) Replay(ctx context.Context, fromBlock uint64, toBlock uint64, eventNames []string, dryRun bool) ([]ReplayedEvent, error) {
+	if toBlock < fromBlock {
+		return nil, fmt.Errorf("%w: from block %d is after to block %d", ErrInvalidReplay, fromBlock, toBlock)
+	}
+
+	if toBlock-fromBlock >= maxReplayBlockRange {
+		return nil, fmt.Errorf("%w: at most %d blocks can be replayed at once", ErrInvalidReplay, maxReplayBlockRange)
+	}
+
+	events, err := parseReplayEvents(eventNames)
+	if err != nil {
+		return nil, err
+	}
+
+	if rl.contractCaller.MainChainClient == nil {
+		return nil, errMainChainClientUnavailable
+	}
+
+	// only finalized blocks are replayed, as the listener would
+	finalized, err := rl.contractCaller.GetMainChainFinalizedBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the finalized block: %w", err)
+	}
+
+	if finalized == nil || toBlock > finalized.Number.Uint64() {
+		return nil, fmt.Errorf("%w: to block %d is not finalized", ErrInvalidReplay, toBlock)
+	}
+
+	rootChainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	topics := filterEventTopics(rl.eventMap, events)
+
+	rl.Logger.Info("RootChainListener: replaying rootChain events", "fromBlock", fromBlock, "toBlock", toBlock, "events", eventNames, "dryRun", dryRun)
+
+	replayed := make([]ReplayedEvent, 0)
+	for _, blockRange := range splitReplayRange(fromBlock, toBlock, maxRootChainBlockRange) {
+		logs, err := rl.queryEvents(rootChainContext, new(big.Int).SetUint64(blockRange[0]), new(big.Int).SetUint64(blockRange[1]), topics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query blocks %d-%d: %w", blockRange[0], blockRange[1], err)
+		}
+
+		for _, vLog := range logs {
+			if vLog.Removed {
+				continue
+			}
+
+			selectedEvent := rl.eventMap[vLog.Topics[0]]
+
+			event := ReplayedEvent{
+				Name:        selectedEvent.Name,
+				BlockNumber: vLog.BlockNumber,
+				TxHash:      vLog.TxHash.Hex(),
+				LogIndex:    vLog.Index,
+			}
+
+			if dryRun {
+				known, err := rl.isKnownEvent(&vLog, selectedEvent)
+				if err != nil {
+					event.Error = err.Error()
+				} else {
+					event.Known = &known
+				}
+			} else {
+				rl.handleLog(vLog, selectedEvent)
+			}
+
+			replayed = append(replayed, event)
+		}
+	}
+
+	rl.Logger.Info("RootChainListener: replayed rootChain events", "fromBlock", fromBlock, "toBlock", toBlock, "numberOfEvents", len(replayed), "dryRun", dryRun)
+
+	return replayed, nil
+}
+
+// isKnownEvent checks whether the event was already processed by heimdall
+func (rl *RootChainListener) isKnownEvent(vLog *types.Log, selectedEvent *abi.Event) (bool, error) {
+	if selectedEvent.Name == helper.NewHeaderBlockEvent {
+		// checkpoints are known once acknowledged
+		event := new(rootchain.RootchainNewHeaderBlock)
+		if err := helper.UnpackLog(rl.rootChainAbi, event, selectedEvent.Name, vLog); err != nil {
+			return false, err
+		}
+
+		checkpointParams, err := util.GetCheckpointParams(rl.cliCtx.Codec)
+		if err != nil {
+			return false, err
+		}
+
+		ackCount, err := util.GetCheckpointAckCount(rl.cliCtx.Codec)
+		if err != nil {
+			return false, err
+		}
+
+		return event.HeaderBlockId.Uint64()/checkpointParams.ChildChainBlockInterval <= ackCount, nil
+	}
+
+	eventType, ok := bridgeEventType(selectedEvent.Name)
+	if !ok {
+		return false, fmt.Errorf("unsupported event %s", selectedEvent.Name)
+	}
+
+	return util.IsOldTx(rl.cliCtx.Codec, vLog.TxHash.Hex(), uint64(vLog.Index), eventType)
+}
+
+// bridgeEventType returns the type of the given root chain event, as checked by isOldTx in the processors
+func bridgeEventType(eventName string) (util.BridgeEvent, bool) {
+	switch eventName {
+	case helper.StakedEvent, helper.StakeUpdateEvent, helper.SignerChangeEvent, helper.UnstakeInitEvent,
+		helper.SlashedEvent, helper.UnJailedEvent:
+		return util.StakingEvent, true
+	case helper.TopUpFeeEvent:
+		return util.TopupEvent, true
+	case helper.StateSyncedEvent:
+		return util.ClerkEvent, true
+	default:
+		return "", false
+	}
+}
+
+// parseReplayEvents returns the set of the given root chain event names, all of them if none is given
+func parseReplayEvents(eventNames []string) (map[string]struct{}, error) {
+	if len(eventNames) == 0 {
+		return rootChainEvents, nil
+	}
+
+	events := make(map[string]struct{}, len(eventNames))
+	for _, name := range eventNames {
+		if _, ok := rootChainEvents[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown event %q, expected one of %s", ErrInvalidReplay, name, strings.Join(RootChainEventNames(), ","))
+		}
+
+		events[name] = struct{}{}
+	}
+
+	return events, nil
+}
+
+// RootChainEventNames returns the names of the root chain events handled by the listener, sorted
+func RootChainEventNames() []string {
+	names := make([]string, 0, len(rootChainEvents))
+	for name := range rootChainEvents {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// splitReplayRange splits the given block range into consecutive ranges of at most size blocks
+func splitReplayRange(fromBlock uint64, toBlock uint64, size uint64) [][2]uint64 {
+	var ranges [][2]uint64
+
+	for start := fromBlock; start <= toBlock; {
+		end := toBlock
+		if toBlock-start >= size {
+			end = start + size - 1
+		}
+
+		ranges = append(ranges, [2]uint64{start, end})
+
+		if end == toBlock {
+			break
+		}
+
+		start = end + 1
+	}
+
+	return ranges
+}
@@ -0,0 +1,77 @@
+package listener
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/util"
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+func TestSplitReplayRange(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, [][2]uint64{{100, 100}}, splitReplayRange(100, 100, 50))
+	require.Equal(t, [][2]uint64{{100, 149}}, splitReplayRange(100, 149, 50))
+	require.Equal(t, [][2]uint64{{100, 149}, {150, 150}}, splitReplayRange(100, 150, 50))
+	require.Equal(t, [][2]uint64{{0, 4999}, {5000, 9999}, {10000, 12000}}, splitReplayRange(0, 12000, maxRootChainBlockRange))
+}
+
+func TestParseReplayEvents(t *testing.T) {
+	t.Parallel()
+
+	events, err := parseReplayEvents(nil)
+	require.NoError(t, err)
+	require.Equal(t, rootChainEvents, events)
+
+	events, err = parseReplayEvents([]string{helper.StateSyncedEvent, helper.StakeUpdateEvent})
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{helper.StateSyncedEvent: {}, helper.StakeUpdateEvent: {}}, events)
+
+	_, err = parseReplayEvents([]string{helper.StateSyncedEvent, "Transfer"})
+	require.ErrorIs(t, err, ErrInvalidReplay)
+	require.ErrorContains(t, err, `unknown event "Transfer"`)
+}
+
+func TestBridgeEventType(t *testing.T) {
+	t.Parallel()
+
+	// every root chain event but checkpoints is checked through isOldTx
+	for name := range rootChainEvents {
+		eventType, ok := bridgeEventType(name)
+		if name == helper.NewHeaderBlockEvent {
+			require.False(t, ok)
+			continue
+		}
+
+		require.True(t, ok, name)
+		require.NotEmpty(t, eventType)
+	}
+
+	eventType, _ := bridgeEventType(helper.StateSyncedEvent)
+	require.Equal(t, util.ClerkEvent, eventType)
+
+	eventType, _ = bridgeEventType(helper.TopUpFeeEvent)
+	require.Equal(t, util.TopupEvent, eventType)
+
+	eventType, _ = bridgeEventType(helper.SlashedEvent)
+	require.Equal(t, util.StakingEvent, eventType)
+}
+
+func TestRootChainListener_ReplayValidation(t *testing.T) {
+	t.Parallel()
+
+	rl := &RootChainListener{BaseListener: BaseListener{Logger: log.NewNopLogger()}}
+
+	_, err := rl.Replay(context.Background(), 200, 100, nil, true)
+	require.ErrorIs(t, err, ErrInvalidReplay)
+
+	_, err = rl.Replay(context.Background(), 0, maxReplayBlockRange, nil, true)
+	require.ErrorIs(t, err, ErrInvalidReplay)
+
+	_, err = rl.Replay(context.Background(), 100, 200, []string{"Unknown"}, true)
+	require.ErrorIs(t, err, ErrInvalidReplay)
+}
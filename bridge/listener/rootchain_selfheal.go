@@ -28,41 +28,88 @@ type subGraphClient struct {
 
 // startSelfHealing starts self-healing processes for all required events
 func (rl *RootChainListener) startSelfHealing(ctx context.Context) {
-	if !helper.GetConfig().EnableSH || helper.GetConfig().SubGraphUrl == "" {
+	if !helper.GetConfig().EnableSH {
 		rl.Logger.Info("Self-healing is disabled")
 		return
 	}
 
-	rl.subGraphClient = &subGraphClient{
-		graphUrl:   helper.GetConfig().SubGraphUrl,
-		httpClient: &http.Client{Timeout: 5 * time.Second},
+	if helper.GetConfig().SubGraphUrl != "" {
+		rl.subGraphClient = &subGraphClient{
+			graphUrl:   helper.GetConfig().SubGraphUrl,
+			httpClient: &http.Client{Timeout: 5 * time.Second},
+		}
+	}
+
+	processStakeEvents := rl.selfHealProcess(helper.StakeUpdateEvent, helper.GetConfig().SHStakeUpdateSource, rl.processStakeEvents, rl.processStakeEventsFromLogs)
+	processStateSynced := rl.selfHealProcess(helper.StateSyncedEvent, helper.GetConfig().SHStateSyncedSource, rl.processStateSynced, rl.processStateSyncedFromLogs)
+	processCheckpointAck := rl.selfHealProcess(helper.NewHeaderBlockEvent, helper.GetConfig().SHCheckpointAckSource, rl.processCheckpointAck, rl.processCheckpointAckFromLogs)
+
+	if processStakeEvents == nil && processStateSynced == nil && processCheckpointAck == nil {
+		rl.Logger.Info("Self-healing is disabled")
+		return
+	}
+
+	// a nil ticker channel never fires, for the event types with self-healing disabled
+	var stakeEventsTick, stateSyncedTick, checkpointAckTick <-chan time.Time
+
+	if processStakeEvents != nil {
+		stakeEventsTicker := time.NewTicker(helper.GetConfig().SHStakeUpdateInterval)
+		defer stakeEventsTicker.Stop()
+		stakeEventsTick = stakeEventsTicker.C
 	}
 
-	stakeEventsTicker := time.NewTicker(helper.GetConfig().SHStakeUpdateInterval)
-	stateSyncedTicker := time.NewTicker(helper.GetConfig().SHStateSyncedInterval)
-	checkpointAckTicker := time.NewTicker(helper.GetConfig().SHCheckpointAckInterval)
+	if processStateSynced != nil {
+		stateSyncedTicker := time.NewTicker(helper.GetConfig().SHStateSyncedInterval)
+		defer stateSyncedTicker.Stop()
+		stateSyncedTick = stateSyncedTicker.C
+	}
+
+	if processCheckpointAck != nil {
+		checkpointAckTicker := time.NewTicker(helper.GetConfig().SHCheckpointAckInterval)
+		defer checkpointAckTicker.Stop()
+		checkpointAckTick = checkpointAckTicker.C
+	}
 
 	rl.Logger.Info("Self-healing: started")
 
 	for {
 		select {
-		case <-stakeEventsTicker.C:
-			rl.processStakeEvents(ctx)
-		case <-stateSyncedTicker.C:
-			rl.processStateSynced(ctx)
-		case <-checkpointAckTicker.C:
-			rl.processCheckpointAck(ctx)
+		case <-stakeEventsTick:
+			processStakeEvents(ctx)
+		case <-stateSyncedTick:
+			processStateSynced(ctx)
+		case <-checkpointAckTick:
+			processCheckpointAck(ctx)
 		case <-ctx.Done():
 			rl.Logger.Info("Self-healing: stopping")
-			stakeEventsTicker.Stop()
-			stateSyncedTicker.Stop()
-			checkpointAckTicker.Stop()
-
 			return
 		}
 	}
 }
 
+// selfHealProcess returns the self-healing process of the event type for the configured source,
+// or nil if the event type can't be self-healed from it
+func (rl *RootChainListener) selfHealProcess(eventType string, source string, fromSubgraph func(context.Context), fromLogs func(context.Context)) func(context.Context) {
+	switch source {
+	case helper.SHSourceSubgraph:
+		if rl.subGraphClient == nil {
+			rl.Logger.Info("Self-healing: disabled for event type, no sub_graph_url configured", "eventType", eventType)
+			return nil
+		}
+
+		rl.Logger.Info("Self-healing: enabled for event type", "eventType", eventType, "source", source)
+
+		return fromSubgraph
+	case helper.SHSourceLogs:
+		rl.Logger.Info("Self-healing: enabled for event type", "eventType", eventType, "source", source)
+
+		return fromLogs
+	default:
+		rl.Logger.Error("Self-healing: disabled for event type, invalid source", "eventType", eventType, "source", source, "expected", []string{helper.SHSourceSubgraph, helper.SHSourceLogs})
+		return nil
+	}
+}
+
 func (rl *RootChainListener) processCheckpointAck(ctx context.Context) {
 	rl.Logger.Info("Self-healing: processing checkpoint")
 
@@ -79,14 +126,38 @@ func (rl *RootChainListener) processCheckpointAck(ctx context.Context) {
 		return
 	}
 
+	checkpointId, acked, buffered := rl.matchBufferedCheckpoint(l1HeaderBlockId)
+	if acked || !buffered {
+		return
+	}
+
+	// Get the transaction receipt to construct the ACK.
+	receipt, err := rl.contractCaller.MainChainClient.TransactionReceipt(ctx, common.HexToHash(latestL1Checkpoint.TransactionHash))
+	if err != nil {
+		rl.Logger.Error("Self-healing: failed to get transaction receipt for L1 checkpoint", "txHash", latestL1Checkpoint.TransactionHash, "error", err)
+		return
+	}
+	targetLog := findLogByIndex(receipt.Logs, latestL1Checkpoint.LogIndex)
+	if targetLog == nil {
+		rl.Logger.Error("Self-healing: failed to find matching log in transaction receipt", "txHash", latestL1Checkpoint.TransactionHash, "expectedLogIndex", latestL1Checkpoint.LogIndex)
+		return
+	}
+	rl.Logger.Info("Self-healing: retrieved log for NewHeaderBlock event", "headerBlockId", checkpointId, "logIndex", latestL1Checkpoint.LogIndex, "txHash", latestL1Checkpoint.TransactionHash)
+
+	rl.queueCheckpointAck(checkpointId, targetLog)
+}
+
+// matchBufferedCheckpoint returns the checkpoint id of the given L1 header block id, whether it is already
+// acknowledged on heimdall, and whether it is the buffered checkpoint waiting for its ACK
+func (rl *RootChainListener) matchBufferedCheckpoint(l1HeaderBlockId uint64) (uint64, bool, bool) {
 	// Get checkpoint parameters to get ChildChainBlockInterval.
 	checkpointParams, err := util.GetCheckpointParams(rl.cliCtx.Codec)
 	if err != nil {
 		rl.Logger.Error("Self-healing: failed to get checkpoint params", "error", err)
-		return
+		return 0, false, false
 	}
 
-	l1HeaderBlockId = l1HeaderBlockId / checkpointParams.ChildChainBlockInterval
+	checkpointId := l1HeaderBlockId / checkpointParams.ChildChainBlockInterval
 
 	// Get the latest checkpoint id from Heimdall using the checkpoint ack count.
 	// Using GetLatestCheckpoint returns an error if there is no checkpoint.
@@ -94,49 +165,41 @@ func (rl *RootChainListener) processCheckpointAck(ctx context.Context) {
 	ackCount, err := util.GetCheckpointAckCount(rl.cliCtx.Codec)
 	if err != nil {
 		rl.Logger.Error("Self-healing: failed to get checkpoint ack count", "error", err)
-		return
+		return checkpointId, false, false
 	}
 
-	if l1HeaderBlockId == ackCount {
-		rl.Logger.Info("Self-healing: latest checkpoint is already synced on heimdall; skipping", "l1HeaderBlockId", l1HeaderBlockId, "heimdallAckCount", ackCount)
-		return
+	if checkpointId <= ackCount {
+		rl.Logger.Info("Self-healing: checkpoint is already synced on heimdall; skipping", "l1HeaderBlockId", checkpointId, "heimdallAckCount", ackCount)
+		return checkpointId, true, false
 	}
 
 	// Check if we have a checkpoint in the buffer.
 	bufferedCheckpoint, err := util.GetBufferedCheckpoint(rl.cliCtx.Codec)
 	if err != nil {
 		rl.Logger.Error("Self-healing: failed to get buffered checkpoint", "error", err)
-		return
+		return checkpointId, false, false
 	}
 
 	if bufferedCheckpoint == nil || bufferedCheckpoint.Id == 0 {
 		rl.Logger.Warn("Self-healing: empty buffered checkpoint")
-		return
+		return checkpointId, false, false
 	}
 
 	// Check if the buffered checkpoint matches the L1 checkpoint.
-	if l1HeaderBlockId != bufferedCheckpoint.Id {
-		rl.Logger.Info("Self-healing: no matching buffered checkpoint found", "l1HeaderBlockId", l1HeaderBlockId, "bufferedCheckpointId", bufferedCheckpoint.Id)
-		return
+	if checkpointId != bufferedCheckpoint.Id {
+		rl.Logger.Info("Self-healing: no matching buffered checkpoint found", "l1HeaderBlockId", checkpointId, "bufferedCheckpointId", bufferedCheckpoint.Id)
+		return checkpointId, false, false
 	}
 
-	rl.Logger.Info("Self-healing: found matching buffered checkpoint, preparing to send ACK", "checkpointId", l1HeaderBlockId)
+	rl.Logger.Info("Self-healing: found matching buffered checkpoint, preparing to send ACK", "checkpointId", checkpointId)
 
-	// Get the transaction receipt to construct the ACK.
-	receipt, err := rl.contractCaller.MainChainClient.TransactionReceipt(ctx, common.HexToHash(latestL1Checkpoint.TransactionHash))
-	if err != nil {
-		rl.Logger.Error("Self-healing: failed to get transaction receipt for L1 checkpoint", "txHash", latestL1Checkpoint.TransactionHash, "error", err)
-		return
-	}
-	targetLog := findLogByIndex(receipt.Logs, latestL1Checkpoint.LogIndex)
-	if targetLog == nil {
-		rl.Logger.Error("Self-healing: failed to find matching log in transaction receipt", "txHash", latestL1Checkpoint.TransactionHash, "expectedLogIndex", latestL1Checkpoint.LogIndex)
-		return
-	}
-	rl.Logger.Info("Self-healing: retrieved log for NewHeaderBlock event", "headerBlockId", l1HeaderBlockId, "logIndex", latestL1Checkpoint.LogIndex, "txHash", latestL1Checkpoint.TransactionHash)
+	return checkpointId, false, true
+}
 
+// queueCheckpointAck sends the checkpoint ACK task for the given NewHeaderBlock log
+func (rl *RootChainListener) queueCheckpointAck(checkpointId uint64, headerBlockLog *types.Log) {
 	// Marshal the log to JSON for the task queue.
-	logBytes, err := json.Marshal(*targetLog)
+	logBytes, err := json.Marshal(*headerBlockLog)
 	if err != nil {
 		rl.Logger.Error("Self-healing: failed to marshal log to JSON", "error", err)
 		return
@@ -146,7 +209,7 @@ func (rl *RootChainListener) processCheckpointAck(ctx context.Context) {
 
 	// Send the checkpoint ACK task.
 	rl.SendTaskWithDelay("sendCheckpointAckToHeimdall", helper.NewHeaderBlockEvent, logBytes, 0, nil)
-	rl.Logger.Info("Self-healing: successfully queued checkpoint ACK task", "headerBlockId", l1HeaderBlockId, "logIndex", latestL1Checkpoint.LogIndex, "txHash", targetLog.TxHash.Hex())
+	rl.Logger.Info("Self-healing: successfully queued checkpoint ACK task", "headerBlockId", checkpointId, "logIndex", headerBlockLog.Index, "txHash", headerBlockLog.TxHash.Hex())
 }
 
 // processStakeEvents recovers any missing nonce-gated stake event for each validator.
@@ -0,0 +1,380 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/util"
+	"github.com/0xPolygon/heimdall-v2/contracts/rootchain"
+	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/contracts/statesender"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/metrics"
+)
+
+const (
+	// selfHealCursorKeyPrefix prefixes the storage keys of the self-healing scan cursors, suffixed by the event type.
+	// A cursor holds the last L1 block up to which all the logs of the event type are known to be on heimdall.
+	selfHealCursorKeyPrefix = "rootchain-selfheal-cursor-"
+
+	// maxSelfHealLogRangesPerCycle bounds the eth_getLogs calls per event type per self-heal tick,
+	// so that a lagging cursor catches up over several cycles.
+	maxSelfHealLogRangesPerCycle = 50
+)
+
+// selfHealStakeEvents are the nonce-gated stake events, sharing a single per-validator nonce counter
+var selfHealStakeEvents = []string{
+	helper.StakeUpdateEvent,
+	helper.SignerChangeEvent,
+	helper.UnstakeInitEvent,
+}
+
+// filterLogsFunc returns the logs in the block range [fromBlock, toBlock]
+type filterLogsFunc func(ctx context.Context, fromBlock uint64, toBlock uint64) ([]types.Log, error)
+
+// processStateSyncedFromLogs scans the StateSender logs for StateSynced events missing on heimdall and broadcasts them
+func (rl *RootChainListener) processStateSyncedFromLogs(ctx context.Context) {
+	rootChainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return
+	}
+
+	latestPolygonStateId, err := rl.getCurrentStateID(ctx)
+	if err != nil {
+		rl.Logger.Error("Self-healing: failed to fetch current Polygon stateId from StateReceiver contract", "error", err)
+		return
+	}
+
+	address := common.HexToAddress(rootChainContext.ChainmanagerParams.ChainParams.StateSenderAddress)
+
+	rl.scanSelfHealLogs(ctx, helper.StateSyncedEvent, address, eventTopics(rl.stateSenderAbi, helper.StateSyncedEvent), func(vLog *types.Log) bool {
+		event := new(statesender.StatesenderStateSynced)
+		if err := helper.UnpackLog(rl.stateSenderAbi, event, helper.StateSyncedEvent, vLog); err != nil {
+			rl.Logger.Error("Self-healing: failed to decode StateSynced log", "txHash", vLog.TxHash.Hex(), "logIndex", vLog.Index, "error", err)
+			return false
+		}
+
+		if event.Id.Cmp(latestPolygonStateId) <= 0 {
+			return true
+		}
+
+		if _, err := util.GetClerkEventRecord(event.Id.Int64(), rl.cliCtx.Codec); err == nil {
+			return true
+		}
+
+		rl.Logger.Info("Self-healing: missing state detected in L1 logs; processing StateSynced event", "stateId", event.Id, "txHash", vLog.TxHash.Hex())
+
+		skipped, err := rl.processEvent(ctx, vLog)
+		if err != nil {
+			rl.Logger.Error("Self-healing: failed to process StateSynced event", "stateId", event.Id, "error", err)
+			return false
+		}
+
+		if !skipped {
+			metrics.SelfHealStateSyncsProcessed.Inc()
+		}
+
+		// pending until the record is found on heimdall
+		return false
+	})
+}
+
+// processStakeEventsFromLogs scans the StakingInfo logs for nonce-gated stake events missing on heimdall and broadcasts them
+func (rl *RootChainListener) processStakeEventsFromLogs(ctx context.Context) {
+	rootChainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return
+	}
+
+	address := common.HexToAddress(rootChainContext.ChainmanagerParams.ChainParams.StakingInfoAddress)
+
+	// heimdall nonces and replays queued in this cycle, by validator
+	heimdallNonces := make(map[uint64]uint64)
+	queued := make(map[uint64]uint64)
+	replays := 0
+
+	rl.scanSelfHealLogs(ctx, helper.StakeUpdateEvent, address, eventTopics(rl.stakingInfoAbi, selfHealStakeEvents...), func(vLog *types.Log) bool {
+		id, nonce, err := decodeStakeEventNonce(rl.stakingInfoAbi, vLog)
+		if err != nil {
+			rl.Logger.Error("Self-healing: failed to decode stake event log", "txHash", vLog.TxHash.Hex(), "logIndex", vLog.Index, "error", err)
+			return false
+		}
+
+		heimdallNonce, ok := heimdallNonces[id]
+		if !ok {
+			heimdallNonce, err = util.GetValidatorNonce(id, rl.cliCtx.Codec)
+			if err != nil {
+				rl.Logger.Error("Self-healing: failed to fetch nonce for validator from Heimdall", "validatorId", id, "error", err)
+				return false
+			}
+			heimdallNonces[id] = heimdallNonce
+		}
+
+		if nonce <= heimdallNonce {
+			return true
+		}
+
+		if queued[id] >= maxStakeNoncesPerCycle {
+			rl.Logger.Warn("Self-healing: stake event backlog exceeds per-cycle limit; will continue next cycle", "validatorId", id, "nonce", nonce, "perCycleLimit", maxStakeNoncesPerCycle)
+			return false
+		}
+
+		if replays > 0 && !pauseBetweenReplays(ctx) {
+			return false
+		}
+
+		rl.Logger.Info("Self-healing: validator is behind; processing missing stake event from L1 logs", "validatorId", id, "heimdallNonce", heimdallNonce, "nonce", nonce)
+
+		skipped, err := rl.processEvent(ctx, vLog)
+		if err != nil {
+			rl.Logger.Error("Self-healing: failed to process stake event", "validatorId", id, "nonce", nonce, "error", err)
+			return false
+		}
+
+		if !skipped {
+			queued[id]++
+			replays++
+			metrics.SelfHealStakeEventsProcessed.Inc()
+		}
+
+		// pending until the nonce is updated on heimdall
+		return false
+	})
+}
+
+// processCheckpointAckFromLogs scans the RootChain logs for checkpoints not acknowledged on heimdall and sends their ACK
+func (rl *RootChainListener) processCheckpointAckFromLogs(ctx context.Context) {
+	rootChainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return
+	}
+
+	address := common.HexToAddress(rootChainContext.ChainmanagerParams.ChainParams.RootChainAddress)
+
+	rl.scanSelfHealLogs(ctx, helper.NewHeaderBlockEvent, address, eventTopics(rl.rootChainAbi, helper.NewHeaderBlockEvent), func(vLog *types.Log) bool {
+		event := new(rootchain.RootchainNewHeaderBlock)
+		if err := helper.UnpackLog(rl.rootChainAbi, event, helper.NewHeaderBlockEvent, vLog); err != nil {
+			rl.Logger.Error("Self-healing: failed to decode NewHeaderBlock log", "txHash", vLog.TxHash.Hex(), "logIndex", vLog.Index, "error", err)
+			return false
+		}
+
+		checkpointId, acked, buffered := rl.matchBufferedCheckpoint(event.HeaderBlockId.Uint64())
+		if acked {
+			return true
+		}
+
+		if buffered {
+			rl.queueCheckpointAck(checkpointId, vLog)
+		}
+
+		// pending until the checkpoint is acknowledged on heimdall
+		return false
+	})
+}
+
+// scanSelfHealLogs scans the logs of the given contract and topics from the persisted cursor of the event type
+// up to the finalized L1 block, calling handle on each of them in order, and advances the cursor
+// over the blocks whose logs are all known to heimdall
+func (rl *RootChainListener) scanSelfHealLogs(ctx context.Context, eventType string, address common.Address, topics []common.Hash, handle func(vLog *types.Log) bool) {
+	if rl.contractCaller.MainChainClient == nil {
+		rl.Logger.Error("Self-healing: main chain client unavailable", "eventType", eventType)
+		return
+	}
+
+	finalized, err := rl.contractCaller.GetMainChainFinalizedBlock(ctx)
+	if err != nil || finalized == nil {
+		rl.Logger.Error("Self-healing: failed to fetch finalized block from L1", "eventType", eventType, "error", err)
+		return
+	}
+
+	toBlock := finalized.Number.Uint64()
+
+	cursor, hasCursor, err := rl.getSelfHealCursor(eventType)
+	if err != nil {
+		rl.Logger.Error("Self-healing: failed to read scan cursor", "eventType", eventType, "error", err)
+		return
+	}
+
+	var fromBlock uint64
+	switch {
+	case hasCursor:
+		fromBlock = cursor + 1
+	case toBlock > helper.GetConfig().SHLogsLookbackBlocks:
+		fromBlock = toBlock - helper.GetConfig().SHLogsLookbackBlocks
+	}
+
+	if fromBlock > toBlock {
+		return
+	}
+
+	rl.Logger.Info("Self-healing: scanning L1 logs", "eventType", eventType, "fromBlock", fromBlock, "toBlock", toBlock)
+
+	filterLogs := func(ctx context.Context, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+		ctx, cancel := context.WithTimeout(ctx, rl.contractCaller.MainChainTimeout)
+		defer cancel()
+
+		return rl.contractCaller.MainChainClient.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(fromBlock),
+			ToBlock:   new(big.Int).SetUint64(toBlock),
+			Addresses: []common.Address{address},
+			Topics:    [][]common.Hash{topics},
+		})
+	}
+
+	synced, advanced, err := scanLogRanges(ctx, filterLogs, fromBlock, toBlock, helper.GetConfig().SHLogsBlockRange, maxSelfHealLogRangesPerCycle, handle)
+	if err != nil {
+		rl.Logger.Error("Self-healing: failed to scan L1 logs", "eventType", eventType, "error", err)
+	}
+
+	if !advanced {
+		return
+	}
+
+	if err := rl.storageClient.Put(selfHealCursorKey(eventType), []byte(strconv.FormatUint(synced, 10)), nil); err != nil {
+		rl.Logger.Error("Self-healing: failed to persist scan cursor", "eventType", eventType, "cursor", synced, "error", err)
+		return
+	}
+
+	rl.Logger.Info("Self-healing: advanced scan cursor", "eventType", eventType, "cursor", synced)
+}
+
+// getSelfHealCursor returns the persisted scan cursor of the event type, if any
+func (rl *RootChainListener) getSelfHealCursor(eventType string) (uint64, bool, error) {
+	has, err := rl.storageClient.Has(selfHealCursorKey(eventType), nil)
+	if err != nil || !has {
+		return 0, false, err
+	}
+
+	value, err := rl.storageClient.Get(selfHealCursorKey(eventType), nil)
+	if err != nil {
+		return 0, false, err
+	}
+
+	cursor, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("malformed scan cursor %q: %w", value, err)
+	}
+
+	return cursor, true, nil
+}
+
+// scanLogRanges handles the logs of [fromBlock, toBlock] in ranges of at most blockRange blocks, up to maxRanges ranges.
+// It returns the last block up to which all the logs were reported as synced by handle,
+// and whether it's past fromBlock. The logs of the ranges after a pending log are still handled,
+// so that one stuck event doesn't prevent the later ones from being healed.
+func scanLogRanges(ctx context.Context, filterLogs filterLogsFunc, fromBlock uint64, toBlock uint64, blockRange uint64, maxRanges int, handle func(vLog *types.Log) bool) (uint64, bool, error) {
+	if blockRange == 0 {
+		return 0, false, errors.New("block range must be positive")
+	}
+
+	var (
+		synced   uint64
+		advanced bool
+		pending  bool
+	)
+
+	rangeFrom := fromBlock
+	for i := 0; i < maxRanges && rangeFrom <= toBlock; i++ {
+		if ctx.Err() != nil {
+			return synced, advanced, ctx.Err()
+		}
+
+		rangeTo := rangeFrom + blockRange - 1
+		if rangeTo > toBlock || rangeTo < rangeFrom {
+			rangeTo = toBlock
+		}
+
+		logs, err := filterLogs(ctx, rangeFrom, rangeTo)
+		if err != nil {
+			return synced, advanced, fmt.Errorf("error while filtering logs in blocks [%d, %d]: %w", rangeFrom, rangeTo, err)
+		}
+
+		for j := range logs {
+			if logs[j].Removed {
+				continue
+			}
+
+			if !handle(&logs[j]) && !pending {
+				pending = true
+				// the blocks before the first pending log are synced
+				if logs[j].BlockNumber > fromBlock {
+					synced, advanced = logs[j].BlockNumber-1, true
+				}
+			}
+		}
+
+		if !pending {
+			synced, advanced = rangeTo, true
+		}
+
+		if rangeTo == toBlock {
+			break
+		}
+
+		rangeFrom = rangeTo + 1
+	}
+
+	return synced, advanced, nil
+}
+
+// decodeStakeEventNonce returns the validator id and the nonce of a nonce-gated stake event log
+func decodeStakeEventNonce(stakingInfoAbi *abi.ABI, vLog *types.Log) (uint64, uint64, error) {
+	if len(vLog.Topics) == 0 {
+		return 0, 0, errors.New("log without topics")
+	}
+
+	event := helper.EventByID(stakingInfoAbi, vLog.Topics[0].Bytes())
+	if event == nil {
+		return 0, 0, errors.New("unknown event")
+	}
+
+	switch event.Name {
+	case helper.StakeUpdateEvent:
+		e := new(stakinginfo.StakinginfoStakeUpdate)
+		if err := helper.UnpackLog(stakingInfoAbi, e, event.Name, vLog); err != nil {
+			return 0, 0, err
+		}
+
+		return e.ValidatorId.Uint64(), e.Nonce.Uint64(), nil
+	case helper.SignerChangeEvent:
+		e := new(stakinginfo.StakinginfoSignerChange)
+		if err := helper.UnpackLog(stakingInfoAbi, e, event.Name, vLog); err != nil {
+			return 0, 0, err
+		}
+
+		return e.ValidatorId.Uint64(), e.Nonce.Uint64(), nil
+	case helper.UnstakeInitEvent:
+		e := new(stakinginfo.StakinginfoUnstakeInit)
+		if err := helper.UnpackLog(stakingInfoAbi, e, event.Name, vLog); err != nil {
+			return 0, 0, err
+		}
+
+		return e.ValidatorId.Uint64(), e.Nonce.Uint64(), nil
+	default:
+		return 0, 0, fmt.Errorf("unexpected event %s", event.Name)
+	}
+}
+
+// eventTopics returns the topics of the given events of the ABI
+func eventTopics(abiObject *abi.ABI, events ...string) []common.Hash {
+	topics := make([]common.Hash, 0, len(events))
+	for _, name := range events {
+		if event, ok := abiObject.Events[name]; ok {
+			topics = append(topics, event.ID)
+		}
+	}
+
+	return topics
+}
+
+func selfHealCursorKey(eventType string) []byte {
+	return []byte(selfHealCursorKeyPrefix + eventType)
+}
@@ -0,0 +1,278 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+// fakeLogs serves the logs at the given blocks and records the queried ranges
+type fakeLogs struct {
+	blocks  []uint64
+	failAt  uint64
+	queried [][2]uint64
+}
+
+func (f *fakeLogs) filterLogs(_ context.Context, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+	f.queried = append(f.queried, [2]uint64{fromBlock, toBlock})
+
+	if f.failAt != 0 && fromBlock <= f.failAt && f.failAt <= toBlock {
+		return nil, errors.New("rpc error")
+	}
+
+	var logs []types.Log
+	for _, block := range f.blocks {
+		if fromBlock <= block && block <= toBlock {
+			logs = append(logs, types.Log{BlockNumber: block})
+		}
+	}
+
+	return logs, nil
+}
+
+// pendingAt reports the logs at the given blocks as pending, and records the handled blocks
+func pendingAt(handled *[]uint64, blocks ...uint64) func(vLog *types.Log) bool {
+	return func(vLog *types.Log) bool {
+		*handled = append(*handled, vLog.BlockNumber)
+		for _, block := range blocks {
+			if vLog.BlockNumber == block {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func TestScanLogRanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("advances over synced ranges", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{blocks: []uint64{105, 150, 230}}
+		var handled []uint64
+
+		synced, advanced, err := scanLogRanges(context.Background(), f.filterLogs, 100, 250, 50, 10, pendingAt(&handled))
+		require.NoError(t, err)
+		require.True(t, advanced)
+		require.Equal(t, uint64(250), synced)
+		require.Equal(t, [][2]uint64{{100, 149}, {150, 199}, {200, 249}, {250, 250}}, f.queried)
+		require.Equal(t, []uint64{105, 150, 230}, handled)
+	})
+
+	t.Run("stops the cursor before the first pending log", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{blocks: []uint64{105, 150, 230}}
+		var handled []uint64
+
+		synced, advanced, err := scanLogRanges(context.Background(), f.filterLogs, 100, 250, 50, 10, pendingAt(&handled, 150))
+		require.NoError(t, err)
+		require.True(t, advanced)
+		require.Equal(t, uint64(149), synced)
+		// the logs after the pending one are still handled
+		require.Equal(t, []uint64{105, 150, 230}, handled)
+	})
+
+	t.Run("does not advance when the first block is pending", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{blocks: []uint64{100}}
+		var handled []uint64
+
+		_, advanced, err := scanLogRanges(context.Background(), f.filterLogs, 100, 250, 50, 10, pendingAt(&handled, 100))
+		require.NoError(t, err)
+		require.False(t, advanced)
+	})
+
+	t.Run("bounds the ranges per cycle", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{}
+		var handled []uint64
+
+		synced, advanced, err := scanLogRanges(context.Background(), f.filterLogs, 0, 999, 100, 3, pendingAt(&handled))
+		require.NoError(t, err)
+		require.True(t, advanced)
+		require.Equal(t, uint64(299), synced)
+		require.Len(t, f.queried, 3)
+	})
+
+	t.Run("keeps the progress made before an RPC failure", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{failAt: 160}
+		var handled []uint64
+
+		synced, advanced, err := scanLogRanges(context.Background(), f.filterLogs, 100, 250, 50, 10, pendingAt(&handled))
+		require.ErrorContains(t, err, "rpc error")
+		require.True(t, advanced)
+		require.Equal(t, uint64(149), synced)
+	})
+
+	t.Run("skips removed logs", func(t *testing.T) {
+		t.Parallel()
+
+		var handled []uint64
+		filterLogs := func(context.Context, uint64, uint64) ([]types.Log, error) {
+			return []types.Log{{BlockNumber: 120, Removed: true}}, nil
+		}
+
+		synced, advanced, err := scanLogRanges(context.Background(), filterLogs, 100, 149, 50, 10, pendingAt(&handled, 120))
+		require.NoError(t, err)
+		require.True(t, advanced)
+		require.Equal(t, uint64(149), synced)
+		require.Empty(t, handled)
+	})
+
+	t.Run("rejects an empty block range", func(t *testing.T) {
+		t.Parallel()
+
+		f := &fakeLogs{}
+		_, _, err := scanLogRanges(context.Background(), f.filterLogs, 100, 250, 0, 10, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestSelfHealCursor(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	rl := &RootChainListener{BaseListener: BaseListener{Logger: log.NewNopLogger(), storageClient: db}}
+
+	_, ok, err := rl.getSelfHealCursor(helper.StateSyncedEvent)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, db.Put(selfHealCursorKey(helper.StateSyncedEvent), []byte("12345"), nil))
+
+	cursor, ok, err := rl.getSelfHealCursor(helper.StateSyncedEvent)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(12345), cursor)
+
+	// cursors are kept per event type
+	_, ok, err = rl.getSelfHealCursor(helper.StakeUpdateEvent)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, db.Put(selfHealCursorKey(helper.StakeUpdateEvent), []byte("invalid"), nil))
+	_, _, err = rl.getSelfHealCursor(helper.StakeUpdateEvent)
+	require.ErrorContains(t, err, "malformed scan cursor")
+}
+
+func TestDecodeStakeEventNonce(t *testing.T) {
+	t.Parallel()
+
+	stakingInfoAbi, err := stakinginfo.StakinginfoMetaData.GetAbi()
+	require.NoError(t, err)
+
+	topic := func(v int64) common.Hash { return common.BigToHash(big.NewInt(v)) }
+
+	t.Run("StakeUpdate", func(t *testing.T) {
+		t.Parallel()
+
+		vLog := &types.Log{Topics: []common.Hash{stakingInfoAbi.Events[helper.StakeUpdateEvent].ID, topic(7), topic(42), topic(1000)}}
+
+		id, nonce, err := decodeStakeEventNonce(stakingInfoAbi, vLog)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), id)
+		require.Equal(t, uint64(42), nonce)
+	})
+
+	t.Run("SignerChange", func(t *testing.T) {
+		t.Parallel()
+
+		event := stakingInfoAbi.Events[helper.SignerChangeEvent]
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(43), []byte{0x01})
+		require.NoError(t, err)
+
+		vLog := &types.Log{
+			Topics: []common.Hash{event.ID, topic(7), common.HexToHash("0x01"), common.HexToHash("0x02")},
+			Data:   data,
+		}
+
+		id, nonce, err := decodeStakeEventNonce(stakingInfoAbi, vLog)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), id)
+		require.Equal(t, uint64(43), nonce)
+	})
+
+	t.Run("UnstakeInit", func(t *testing.T) {
+		t.Parallel()
+
+		event := stakingInfoAbi.Events[helper.UnstakeInitEvent]
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(44), big.NewInt(10))
+		require.NoError(t, err)
+
+		vLog := &types.Log{
+			Topics: []common.Hash{event.ID, common.HexToHash("0x01"), topic(7), topic(1000)},
+			Data:   data,
+		}
+
+		id, nonce, err := decodeStakeEventNonce(stakingInfoAbi, vLog)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), id)
+		require.Equal(t, uint64(44), nonce)
+	})
+
+	t.Run("rejects other events", func(t *testing.T) {
+		t.Parallel()
+
+		vLog := &types.Log{Topics: []common.Hash{stakingInfoAbi.Events[helper.StakedEvent].ID}}
+
+		_, _, err := decodeStakeEventNonce(stakingInfoAbi, vLog)
+		require.Error(t, err)
+
+		_, _, err = decodeStakeEventNonce(stakingInfoAbi, &types.Log{})
+		require.Error(t, err)
+	})
+}
+
+func TestEventTopics(t *testing.T) {
+	t.Parallel()
+
+	abiObj := &abi.ABI{Events: map[string]abi.Event{
+		"Transfer": {Name: "Transfer", ID: common.HexToHash("0x1111")},
+		"Approval": {Name: "Approval", ID: common.HexToHash("0x2222")},
+	}}
+
+	require.Equal(t, []common.Hash{common.HexToHash("0x2222"), common.HexToHash("0x1111")}, eventTopics(abiObj, "Approval", "Transfer", "Unknown"))
+	require.Empty(t, eventTopics(abiObj))
+}
+
+func TestSelfHealProcess(t *testing.T) {
+	t.Parallel()
+
+	var called string
+	fromSubgraph := func(context.Context) { called = helper.SHSourceSubgraph }
+	fromLogs := func(context.Context) { called = helper.SHSourceLogs }
+
+	rl := &RootChainListener{BaseListener: BaseListener{Logger: log.NewNopLogger()}}
+
+	require.Nil(t, rl.selfHealProcess(helper.StateSyncedEvent, helper.SHSourceSubgraph, fromSubgraph, fromLogs), "subgraph source requires a sub_graph_url")
+	require.Nil(t, rl.selfHealProcess(helper.StateSyncedEvent, "invalid", fromSubgraph, fromLogs))
+
+	rl.selfHealProcess(helper.StateSyncedEvent, helper.SHSourceLogs, fromSubgraph, fromLogs)(context.Background())
+	require.Equal(t, helper.SHSourceLogs, called)
+
+	rl.subGraphClient = &subGraphClient{graphUrl: "http://localhost"}
+	rl.selfHealProcess(helper.StateSyncedEvent, helper.SHSourceSubgraph, fromSubgraph, fromLogs)(context.Background())
+	require.Equal(t, helper.SHSourceSubgraph, called)
+}
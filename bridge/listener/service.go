@@ -25,6 +25,8 @@ type Service struct {
 	// Base service
 	common.BaseService
 	listeners []Listener
+
+	rootChainListener *RootChainListener
 }
 
 // NewListenerService returns the new service object for listening to events
@@ -37,6 +39,7 @@ func NewListenerService(cdc codec.Codec, queueConnector *queue.Connector, httpCl
 	rootChainListener := NewRootChainListener()
 	rootChainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMainClient(), rootChainListenerStr, rootChainListener)
 	listenerService.listeners = append(listenerService.listeners, rootChainListener)
+	listenerService.rootChainListener = rootChainListener
 
 	borChainListener := &BorChainListener{}
 	borChainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetBorClient(), borChainListenerStr, borChainListener)
@@ -49,6 +52,11 @@ func NewListenerService(cdc codec.Codec, queueConnector *queue.Connector, httpCl
 	return listenerService
 }
 
+// RootChainListener returns the root chain listener of the service
+func (listenerService *Service) RootChainListener() *RootChainListener {
+	return listenerService.rootChainListener
+}
+
 // OnStart starts the new block subscription
 func (listenerService *Service) OnStart() error {
 	if err := listenerService.BaseService.OnStart(); err != nil {
@@ -0,0 +1,57 @@
+package listener
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// RootChainStatus is the progress of the root chain listener, as persisted in the bridge storage
+type RootChainStatus struct {
+	// LastBlock is the last root chain block whose logs were processed, nil if none yet
+	LastBlock *uint64 `json:"last_block,omitempty"`
+	// SelfHealCursors are the last root chain blocks scanned by the self-healing from logs, by event type
+	SelfHealCursors map[string]uint64 `json:"self_heal_cursors"`
+}
+
+// GetRootChainStatus returns the progress of the root chain listener from the given bridge storage
+func GetRootChainStatus(storageClient *leveldb.DB) (*RootChainStatus, error) {
+	status := &RootChainStatus{SelfHealCursors: make(map[string]uint64)}
+
+	value, err := storageClient.Get([]byte(lastRootBlockKey), nil)
+	switch {
+	case err == nil:
+		lastBlock, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed last root block %q: %w", value, err)
+		}
+
+		status.LastBlock = &lastBlock
+	case !errors.Is(err, leveldb.ErrNotFound):
+		return nil, err
+	}
+
+	iter := storageClient.NewIterator(util.BytesPrefix([]byte(selfHealCursorKeyPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		eventType := strings.TrimPrefix(string(iter.Key()), selfHealCursorKeyPrefix)
+
+		cursor, err := strconv.ParseUint(string(iter.Value()), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed scan cursor %q for %s: %w", iter.Value(), eventType, err)
+		}
+
+		status.SelfHealCursors[eventType] = cursor
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
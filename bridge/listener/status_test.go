@@ -0,0 +1,41 @@
+package listener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+func TestGetRootChainStatus(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	status, err := GetRootChainStatus(db)
+	require.NoError(t, err)
+	require.Nil(t, status.LastBlock)
+	require.Empty(t, status.SelfHealCursors)
+
+	require.NoError(t, db.Put([]byte(lastRootBlockKey), []byte("1000"), nil))
+	require.NoError(t, db.Put(selfHealCursorKey(helper.StateSyncedEvent), []byte("900"), nil))
+	require.NoError(t, db.Put(selfHealCursorKey(helper.NewHeaderBlockEvent), []byte("950"), nil))
+
+	status, err = GetRootChainStatus(db)
+	require.NoError(t, err)
+	require.NotNil(t, status.LastBlock)
+	require.Equal(t, uint64(1000), *status.LastBlock)
+	require.Equal(t, map[string]uint64{
+		helper.StateSyncedEvent:    900,
+		helper.NewHeaderBlockEvent: 950,
+	}, status.SelfHealCursors)
+
+	require.NoError(t, db.Put([]byte(lastRootBlockKey), []byte("invalid"), nil))
+	_, err = GetRootChainStatus(db)
+	require.ErrorContains(t, err, "malformed last root block")
+}
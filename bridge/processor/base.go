@@ -19,12 +19,8 @@ import (
 	"github.com/0xPolygon/heimdall-v2/bridge/util"
 	"github.com/0xPolygon/heimdall-v2/helper"
 	clerkTypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
-	staketypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
-	topupTypes "github.com/0xPolygon/heimdall-v2/x/topup/types"
 )
 
-const errorUnmarshallingTxStatus = "BaseProcessor: error unmarshalling tx status received from heimdall server"
-
 // Processor defines a block header listener for RootChain, BorChain, Heimdall
 type Processor interface {
 	Start() error
@@ -123,64 +119,7 @@ func (bp *BaseProcessor) Stop() {
 func (bp *BaseProcessor) isOldTx(_ client.Context, txHash string, logIndex uint64, eventType util.BridgeEvent, event interface{}) (bool, error) {
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "isOldTx", time.Now())
 
-	queryParam := map[string]interface{}{
-		"tx_hash":   txHash,
-		"log_index": logIndex,
-	}
-
-	// define the endpoint based on the type of event
-	var endpoint string
-
-	switch eventType {
-	case util.StakingEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.StakingTxStatusURL)
-	case util.TopupEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.TopupTxStatusURL)
-	case util.ClerkEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.ClerkTxStatusURL)
-	default:
-		bp.Logger.Error("BaseProcessor: invalid event type", "event", eventType)
-	}
-
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
-	if err != nil {
-		bp.Logger.Error("BaseProcessor: error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
-	}
-
-	res, err := helper.FetchFromAPI(url)
-	if err != nil {
-		bp.Logger.Error("BaseProcessor: error fetching tx status", "url", url, "error", err)
-		return false, err
-	}
-
-	switch eventType {
-	case util.StakingEvent:
-		var response staketypes.QueryStakeIsOldTxResponse
-		if err := bp.cliCtx.Codec.UnmarshalJSON(res, &response); err != nil {
-			bp.Logger.Error(errorUnmarshallingTxStatus, "error", err)
-			return false, err
-		}
-		return response.IsOld, nil
-	case util.TopupEvent:
-		var response topupTypes.QueryIsTopupTxOldResponse
-		if err := bp.cliCtx.Codec.UnmarshalJSON(res, &response); err != nil {
-			bp.Logger.Error(errorUnmarshallingTxStatus, "error", err)
-			return false, err
-		}
-		return response.IsOld, nil
-	case util.ClerkEvent:
-		var response clerkTypes.IsClerkTxOldResponse
-		if err := bp.cliCtx.Codec.UnmarshalJSON(res, &response); err != nil {
-			bp.Logger.Error(errorUnmarshallingTxStatus, "error", err)
-			return false, err
-		}
-		return response.IsOld, nil
-	default:
-		bp.Logger.Error("BaseProcessor: invalid event type", "event", eventType)
-	}
-
-	return false, nil
+	return util.IsOldTx(bp.cliCtx.Codec, txHash, logIndex, eventType)
 }
 
 // checkTxAgainstMempool checks if the transaction is already in the mempool or not.
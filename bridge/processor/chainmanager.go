@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/util"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	chainmanagerkeeper "github.com/0xPolygon/heimdall-v2/x/chainmanager/keeper"
+	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+const (
+	// Error messages
+	errMsgChainManagerCheckingProposer           = "ChainManagerProcessor: error while checking if proposer"
+	errMsgChainManagerFetchingMigrations         = "ChainManagerProcessor: error while fetching pending address migrations"
+	errMsgChainManagerConvertingAddress          = "ChainManagerProcessor: error converting address to string"
+	errMsgChainManagerBroadcastingToHeimdall     = "ChainManagerProcessor: error while broadcasting address migration verification to heimdall. height: %d, error: %w"
+	errMsgChainManagerVerifyAddressMigrationFail = "ChainManagerProcessor: verify address migration tx failed on heimdall, txHash: %s, code: %d, height: %d"
+	errMsgChainManagerVerifyAddressMigration     = "ChainManagerProcessor: error while verifying address migration"
+
+	// Info messages
+	infoMsgChainManagerStarting       = "ChainManagerProcessor: starting process"
+	infoMsgChainManagerStartPolling   = "ChainManagerProcessor: start polling for address migrations"
+	infoMsgChainManagerPollingStopped = "ChainManagerProcessor: polling stopped"
+	infoMsgChainManagerVerifying      = "ChainManagerProcessor: sending address migration verification"
+
+	// Debug messages
+	debugMsgChainManagerNotProposer          = "ChainManagerProcessor: not the proposer, skipping address migration verification"
+	debugMsgChainManagerContractsNotReported = "ChainManagerProcessor: root chain contracts not reported at the new addresses yet"
+)
+
+// ChainManagerProcessor sends the verification of the pending root chain contract address migrations
+type ChainManagerProcessor struct {
+	BaseProcessor
+
+	// address migrations polling cancel function
+	cancelChainManagerService context.CancelFunc
+}
+
+// Start starts polling for the pending address migrations
+func (cp *ChainManagerProcessor) Start() error {
+	cp.Logger.Info(infoMsgChainManagerStarting)
+
+	// create cancellable context
+	chainManagerCtx, cancelChainManagerService := context.WithCancel(context.Background())
+
+	cp.cancelChainManagerService = cancelChainManagerService
+
+	cp.Logger.Info(infoMsgChainManagerStartPolling, "pollInterval", helper.GetConfig().SyncerPollInterval)
+
+	go cp.startPolling(chainManagerCtx, helper.GetConfig().SyncerPollInterval)
+
+	return nil
+}
+
+// RegisterTasks - nil
+func (cp *ChainManagerProcessor) RegisterTasks() {
+}
+
+// startPolling polls heimdall for the address migrations to verify
+func (cp *ChainManagerProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			cp.checkAndVerifyAddressMigrations(ctx)
+		case <-ctx.Done():
+			cp.Logger.Info(infoMsgChainManagerPollingStopped)
+
+			return
+		}
+	}
+}
+
+// checkAndVerifyAddressMigrations sends, when proposer, the verification of the pending address migrations
+// whose new addresses are reported by the root chain contracts
+func (cp *ChainManagerProcessor) checkAndVerifyAddressMigrations(ctx context.Context) {
+	isProposer, err := util.IsProposer(cp.cliCtx.Codec)
+	if err != nil {
+		cp.Logger.Error(errMsgChainManagerCheckingProposer, "error", err)
+		return
+	}
+
+	if !isProposer {
+		cp.Logger.Debug(debugMsgChainManagerNotProposer)
+		return
+	}
+
+	migrations, err := util.GetPendingAddressMigrations(cp.cliCtx.Codec)
+	if err != nil {
+		cp.Logger.Error(errMsgChainManagerFetchingMigrations, "error", err)
+		return
+	}
+
+	for _, height := range addressMigrationsToVerify(ctx, cp.Logger, &cp.contractCaller, migrations) {
+		if err = cp.sendVerifyAddressMigration(ctx, height); err != nil {
+			cp.Logger.Error(errMsgChainManagerVerifyAddressMigration, "height", height, "error", err)
+		}
+	}
+}
+
+// addressMigrationsToVerify returns the heights of the unverified address migrations
+// whose new addresses hold the contracts they replace on the root chain
+func addressMigrationsToVerify(ctx context.Context, logger log.Logger, contractCaller helper.IContractCaller, migrations []chainmanagertypes.AddressMigration) []int64 {
+	var heights []int64
+
+	for _, migration := range migrations {
+		if migration.Verified {
+			continue
+		}
+
+		// the validators would vote no on the verification, so wait for the contracts to be deployed
+		if err := chainmanagerkeeper.VerifyAddressMigration(ctx, contractCaller, migration); err != nil {
+			logger.Debug(debugMsgChainManagerContractsNotReported, "height", migration.Height, "error", err)
+			continue
+		}
+
+		heights = append(heights, migration.Height)
+	}
+
+	return heights
+}
+
+// sendVerifyAddressMigration broadcasts the verification of the address migration at the height to heimdall
+func (cp *ChainManagerProcessor) sendVerifyAddressMigration(ctx context.Context, height int64) error {
+	cp.Logger.Info(infoMsgChainManagerVerifying, "height", height)
+
+	addrString, err := helper.GetAddressString()
+	if err != nil {
+		return fmt.Errorf(errMsgChainManagerConvertingAddress+": %w", err)
+	}
+
+	msg := chainmanagertypes.MsgVerifyAddressMigration{
+		From:   addrString,
+		Height: height,
+	}
+
+	txRes, err := cp.txBroadcaster.BroadcastToHeimdall(ctx, &msg, nil)
+	if err != nil {
+		return fmt.Errorf(errMsgChainManagerBroadcastingToHeimdall, height, err)
+	}
+
+	if txRes.Code != abci.CodeTypeOK {
+		return fmt.Errorf(errMsgChainManagerVerifyAddressMigrationFail, txRes.TxHash, txRes.Code, height)
+	}
+
+	return nil
+}
+
+// Stop stops all necessary go routines
+func (cp *ChainManagerProcessor) Stop() {
+	// cancel address migrations polling
+	cp.cancelChainManagerService()
+}
@@ -0,0 +1,98 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/contracts/statesender"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+// counterBackend answers the calls of the bound contracts with a counter, or fails them when there is no contract
+type counterBackend struct {
+	bind.ContractBackend
+	hasCode bool
+}
+
+func (b counterBackend) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	if !b.hasCode {
+		return nil, errors.New("execution reverted")
+	}
+
+	return common.LeftPadBytes(big.NewInt(1).Bytes(), 32), nil
+}
+
+func TestChainManagerProcessor_Stop(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cancels chainmanager service context", func(t *testing.T) {
+		t.Parallel()
+
+		cp := &ChainManagerProcessor{}
+		cp.BaseProcessor.Logger = log.NewNopLogger()
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		cp.cancelChainManagerService = cancelFunc
+
+		cp.Stop()
+
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
+
+func TestAddressMigrationsToVerify(t *testing.T) {
+	t.Parallel()
+
+	deployed := "0x1111111111111111111111111111111111111111"
+	notDeployed := "0x2222222222222222222222222222222222222222"
+
+	contractCaller := &mocks.IContractCaller{}
+	for address, hasCode := range map[string]bool{deployed: true, notDeployed: false} {
+		instance, err := statesender.NewStatesender(common.HexToAddress(address), counterBackend{hasCode: hasCode})
+		require.NoError(t, err)
+		contractCaller.On("GetStateSenderInstance", address).Return(instance, nil)
+	}
+
+	migrations := []chainmanagertypes.AddressMigration{
+		{Height: 100, StateSenderAddress: deployed, Verified: true},
+		{Height: 200, StateSenderAddress: notDeployed},
+		{Height: 300, StateSenderAddress: deployed},
+	}
+
+	// only the unverified migration whose contract is reported at the new address is verified
+	heights := addressMigrationsToVerify(context.Background(), log.NewNopLogger(), contractCaller, migrations)
+	require.Equal(t, []int64{300}, heights)
+}
+
+func TestChainManagerProcessor_Constants(t *testing.T) {
+	t.Parallel()
+
+	messages := []string{
+		errMsgChainManagerCheckingProposer,
+		errMsgChainManagerFetchingMigrations,
+		errMsgChainManagerConvertingAddress,
+		errMsgChainManagerBroadcastingToHeimdall,
+		errMsgChainManagerVerifyAddressMigrationFail,
+		errMsgChainManagerVerifyAddressMigration,
+		infoMsgChainManagerStarting,
+		infoMsgChainManagerStartPolling,
+		infoMsgChainManagerPollingStopped,
+		infoMsgChainManagerVerifying,
+		debugMsgChainManagerNotProposer,
+		debugMsgChainManagerContractsNotReported,
+	}
+
+	for _, msg := range messages {
+		require.NotEmpty(t, msg)
+		require.Contains(t, msg, "ChainManagerProcessor")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/0xPolygon/heimdall-v2/bridge/txmanager"
 	"github.com/0xPolygon/heimdall-v2/bridge/util"
 	"github.com/0xPolygon/heimdall-v2/contracts/rootchain"
 	"github.com/0xPolygon/heimdall-v2/helper"
@@ -60,6 +61,7 @@ const (
 	errMsgCpParsingCheckpointSignatures      = "CheckpointProcessor: error parsing checkpoint signatures"
 	errMsgCpCreatingRootChainInstance        = "CheckpointProcessor: error while creating rootChain instance"
 	errMsgCpSubmittingCheckpointToRootChain  = "CheckpointProcessor: error submitting checkpoint to rootChain"
+	errMsgCpPackingCheckpointSubmission      = "CheckpointProcessor: error packing checkpoint submission"
 	errMsgCpFetchingAccountRootHash          = "CheckpointProcessor: error fetching account root hash from HeimdallServer"
 	errMsgCpUnmarshallingAccountRootHash     = "CheckpointProcessor: error unmarshalling account root hash received from Heimdall Server"
 	errMsgCpFetchingCurrentHeaderBlockNumber = "CheckpointProcessor: error while fetching current header block number"
@@ -93,6 +95,7 @@ const (
 	infoMsgCpStartBlockDoesNotMatch                = "CheckpointProcessor: start block does not match, checkpoint already sent"
 	infoMsgCpCheckpointAlreadySent                 = "CheckpointProcessor: checkpoint already sent"
 	infoMsgCpNoNeedToSendCheckpoint                = "CheckpointProcessor: no need to send checkpoint"
+	infoMsgCpCheckpointSubmittedToRootChain        = "CheckpointProcessor: checkpoint submitted to rootChain"
 
 	// Debug messages
 	debugMsgCpProcessingNewHeaderBlock           = "CheckpointProcessor: processing new header block"
@@ -114,6 +117,12 @@ type CheckpointProcessor struct {
 	// header listener subscription
 	cancelNoACKPolling context.CancelFunc
 
+	// main chain tx monitoring
+	cancelTxMonitoring context.CancelFunc
+
+	// txManager sends the checkpoints to the rootChain, and replaces them when stuck
+	txManager *txmanager.Manager
+
 	// noAckInProgress prevents overlapping handleCheckpointNoAck goroutines
 	noAckInProgress atomic.Bool
 
@@ -153,6 +162,12 @@ func (cp *CheckpointProcessor) Start() error {
 
 	go cp.startPollingForNoAck(ackCtx, helper.GetConfig().NoACKPollInterval)
 
+	// pending checkpoint txs
+	txCtx, cancelTxMonitoring := context.WithCancel(context.Background())
+	cp.cancelTxMonitoring = cancelTxMonitoring
+
+	go cp.txManager.Start(txCtx)
+
 	return nil
 }
 
@@ -160,15 +175,15 @@ func (cp *CheckpointProcessor) Start() error {
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info(infoMsgCpRegisteringTasks)
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask(cp.name, "sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("CheckpointProcessor | RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootChain); err != nil {
+	if err := cp.queueConnector.RegisterTask(cp.name, "sendCheckpointToRootchain", cp.sendCheckpointToRootChain); err != nil {
 		cp.Logger.Error("CheckpointProcessor | RegisterTasks | sendCheckpointToRootChain", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask(cp.name, "sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("CheckpointProcessor | RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
 }
@@ -615,19 +630,22 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootChain(checkpointCont
 		return err
 	}
 
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	rootChainAddress := chainParams.RootChainAddress
-	rootChainInstance, err := cp.contractCaller.GetRootChainInstance(rootChainAddress)
+	data, err := cp.rootChainAbi.Pack("submitCheckpoint", sideTxData, sigs)
 	if err != nil {
-		cp.Logger.Info(errMsgCpCreatingRootChainInstance, "error", err)
+		cp.Logger.Error(errMsgCpPackingCheckpointSubmission, "error", err)
 		return err
 	}
 
-	if err := cp.contractCaller.SendCheckpoint(sideTxData, sigs, common.HexToAddress(rootChainAddress), rootChainInstance); err != nil {
+	// the tx manager replaces the tx until it's mined, so a checkpoint stuck under a rising base fee doesn't delay the next ones
+	rootChainAddress := checkpointContext.ChainmanagerParams.ChainParams.RootChainAddress
+	tx, err := cp.txManager.Send(context.Background(), fmt.Sprintf("checkpoint %d-%d", start, end), common.HexToAddress(rootChainAddress), data)
+	if err != nil {
 		cp.Logger.Info(errMsgCpSubmittingCheckpointToRootChain, "error", err)
 		return err
 	}
 
+	cp.Logger.Info(infoMsgCpCheckpointSubmittedToRootChain, "start", start, "end", end, "nonce", tx.Nonce, "txHash", tx.LastAttempt().Hash)
+
 	return nil
 }
 
@@ -876,6 +894,11 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *Checkpoin
 func (cp *CheckpointProcessor) Stop() {
 	// cancel No-Ack polling
 	cp.cancelNoACKPolling()
+
+	// cancel tx monitoring
+	if cp.cancelTxMonitoring != nil {
+		cp.cancelTxMonitoring()
+	}
 }
 
 func (cp *CheckpointProcessor) getCheckpointContext() (*CheckpointContext, error) {
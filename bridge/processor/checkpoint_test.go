@@ -189,6 +189,7 @@ func TestCheckpointProcessor_Constants(t *testing.T) {
 			errMsgCpParsingCheckpointSignatures,
 			errMsgCpCreatingRootChainInstance,
 			errMsgCpSubmittingCheckpointToRootChain,
+			errMsgCpPackingCheckpointSubmission,
 			errMsgCpFetchingAccountRootHash,
 			errMsgCpUnmarshallingAccountRootHash,
 			errMsgCpFetchingCurrentHeaderBlockNumber,
@@ -232,6 +233,7 @@ func TestCheckpointProcessor_Constants(t *testing.T) {
 			infoMsgCpStartBlockDoesNotMatch,
 			infoMsgCpCheckpointAlreadySent,
 			infoMsgCpNoNeedToSendCheckpoint,
+			infoMsgCpCheckpointSubmittedToRootChain,
 		}
 
 		for _, msg := range infoMessages {
@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -29,6 +31,12 @@ const (
 	errMsgClerkConvertingAddress          = "ClerkProcessor: error converting address to string"
 	errMsgClerkBroadcasting               = "ClerkProcessor: error while broadcasting clerk Record to heimdall"
 	errMsgClerkFetchingChainManagerParams = "ClerkProcessor: error while fetching chain manager params"
+	errMsgClerkFetchingNodeStatus         = "ClerkProcessor: error while fetching heimdall node status"
+	errMsgClerkCheckingProposer           = "ClerkProcessor: error while checking if proposer"
+	errMsgClerkFetchingConsumedRecordID   = "ClerkProcessor: error while fetching bor consumed record id"
+	errMsgClerkFetchingLatestMilestone    = "ClerkProcessor: error while fetching latest milestone"
+	errMsgClerkFetchingBorLastStateID     = "ClerkProcessor: error while fetching last state id from bor"
+	errMsgClerkRecordsConsumedTxFailed    = "ClerkProcessor: records consumed tx failed on heimdall"
 
 	// Info messages
 	infoMsgClerkStarting                 = "ClerkProcessor: starting"
@@ -36,9 +44,13 @@ const (
 	infoMsgClerkIgnoringAlreadyProcessed = "ClerkProcessor: ignoring task to send deposit to heimdall as already processed"
 	infoMsgClerkDataTooLarge             = `ClerkProcessor: data is too large to process, Resetting to ""`
 	infoMsgClerkTxInMempool              = "ClerkProcessor: similar transaction already in mempool, retrying in sometime"
+	infoMsgClerkStartPolling             = "ClerkProcessor: start polling for records consumed by bor"
+	infoMsgClerkPollingStopped           = "ClerkProcessor: polling stopped"
+	infoMsgClerkSendingRecordsConsumed   = "ClerkProcessor: sending records consumed by bor to heimdall"
 
 	// Debug messages
 	debugMsgClerkNewEventFound = "ClerkProcessor: new event found"
+	debugMsgClerkNotProposer   = "ClerkProcessor: not the proposer, skipping records consumed"
 )
 
 // ClerkContext for bridge
@@ -50,6 +62,9 @@ type ClerkContext struct {
 type ClerkProcessor struct {
 	BaseProcessor
 	stateSenderAbi *abi.ABI
+
+	// cancelClerkService stops the polling for the records consumed by bor
+	cancelClerkService context.CancelFunc
 }
 
 // NewClerkProcessor - add state sender abi to clerk processor
@@ -59,17 +74,120 @@ func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 	}
 }
 
-// Start starts new block subscription
+// Start starts new block subscription, and the polling for the records consumed by bor
 func (cp *ClerkProcessor) Start() error {
 	cp.Logger.Info(infoMsgClerkStarting)
+
+	clerkCtx, cancelClerkService := context.WithCancel(context.Background())
+	cp.cancelClerkService = cancelClerkService
+
+	cp.Logger.Info(infoMsgClerkStartPolling, "pollInterval", helper.GetConfig().ClerkPollInterval)
+
+	go cp.startPolling(clerkCtx, helper.GetConfig().ClerkPollInterval)
+
 	return nil
 }
 
+// Stop stops all necessary go routines
+func (cp *ClerkProcessor) Stop() {
+	if cp.cancelClerkService != nil {
+		cp.cancelClerkService()
+	}
+}
+
+// startPolling polls bor and heimdall, and checks if the records consumed by bor need to be sent to heimdall
+func (cp *ClerkProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			cp.checkAndSendRecordsConsumed(ctx)
+		case <-ctx.Done():
+			cp.Logger.Info(infoMsgClerkPollingStopped)
+			return
+		}
+	}
+}
+
+// checkAndSendRecordsConsumed sends to heimdall the last record committed by the bor state receiver as of the
+// latest milestone, when it is past the one known by heimdall. Only the proposer sends it.
+func (cp *ClerkProcessor) checkAndSendRecordsConsumed(ctx context.Context) {
+	nodeStatus, err := helper.GetNodeStatus(ctx, cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error(errMsgClerkFetchingNodeStatus, "error", err)
+		return
+	}
+
+	if !helper.IsClerkRecordPruning(nodeStatus.SyncInfo.LatestBlockHeight) {
+		return
+	}
+
+	isProposer, err := util.IsProposer(cp.cliCtx.Codec)
+	if err != nil {
+		cp.Logger.Error(errMsgClerkCheckingProposer, "error", err)
+		return
+	}
+
+	if !isProposer {
+		cp.Logger.Debug(debugMsgClerkNotProposer)
+		return
+	}
+
+	consumedRecordID, err := util.GetBorConsumedRecordID(cp.cliCtx.Codec)
+	if err != nil {
+		cp.Logger.Error(errMsgClerkFetchingConsumedRecordID, "error", err)
+		return
+	}
+
+	milestone, err := util.GetLatestMilestone(cp.cliCtx.Codec)
+	if err != nil {
+		cp.Logger.Error(errMsgClerkFetchingLatestMilestone, "error", err)
+		return
+	}
+
+	clerkContext, err := cp.getClerkContext()
+	if err != nil {
+		return
+	}
+
+	lastStateID, err := cp.contractCaller.GetBorChainLastStateID(ctx, clerkContext.ChainmanagerParams.ChainParams.StateReceiverAddress, new(big.Int).SetUint64(milestone.EndBlock))
+	if err != nil {
+		cp.Logger.Error(errMsgClerkFetchingBorLastStateID, "error", err)
+		return
+	}
+
+	if lastStateID <= consumedRecordID {
+		return
+	}
+
+	addrString, err := helper.GetAddressString()
+	if err != nil {
+		cp.Logger.Error(errMsgClerkConvertingAddress, "error", err)
+		return
+	}
+
+	cp.Logger.Info(infoMsgClerkSendingRecordsConsumed, "lastRecordID", lastStateID, "borBlockNumber", milestone.EndBlock)
+
+	msg := clerkTypes.NewMsgRecordsConsumed(addrString, lastStateID, milestone.EndBlock)
+
+	txRes, err := cp.txBroadcaster.BroadcastToHeimdall(ctx, &msg, nil)
+	if err != nil {
+		cp.Logger.Error(errMsgClerkBroadcasting, "error", err)
+		return
+	}
+
+	if txRes.Code != abci.CodeTypeOK {
+		cp.Logger.Error(errMsgClerkRecordsConsumedTxFailed, "txHash", txRes.TxHash, "code", txRes.Code)
+	}
+}
+
 // RegisterTasks registers the clerk-related tasks with machinery
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info(infoMsgClerkRegisteringTasks)
 
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask(cp.name, "sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("ClerkProcessor | RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
 }
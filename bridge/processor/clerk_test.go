@@ -262,6 +262,12 @@ func TestClerkProcessor_Constants(t *testing.T) {
 			errMsgClerkConvertingAddress,
 			errMsgClerkBroadcasting,
 			errMsgClerkFetchingChainManagerParams,
+			errMsgClerkFetchingNodeStatus,
+			errMsgClerkCheckingProposer,
+			errMsgClerkFetchingConsumedRecordID,
+			errMsgClerkFetchingLatestMilestone,
+			errMsgClerkFetchingBorLastStateID,
+			errMsgClerkRecordsConsumedTxFailed,
 		}
 
 		for _, msg := range errorMessages {
@@ -279,6 +285,9 @@ func TestClerkProcessor_Constants(t *testing.T) {
 			infoMsgClerkIgnoringAlreadyProcessed,
 			infoMsgClerkDataTooLarge,
 			infoMsgClerkTxInMempool,
+			infoMsgClerkStartPolling,
+			infoMsgClerkPollingStopped,
+			infoMsgClerkSendingRecordsConsumed,
 		}
 
 		for _, msg := range infoMessages {
@@ -292,6 +301,7 @@ func TestClerkProcessor_Constants(t *testing.T) {
 
 		debugMessages := []string{
 			debugMsgClerkNewEventFound,
+			debugMsgClerkNotProposer,
 		}
 
 		for _, msg := range debugMessages {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/0xPolygon/heimdall-v2/bridge/broadcaster"
 	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+	"github.com/0xPolygon/heimdall-v2/bridge/txmanager"
 	"github.com/0xPolygon/heimdall-v2/helper"
 )
 
@@ -56,6 +57,10 @@ func NewProcessorService(
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
 	checkpointProcessor.cliCtx = txBroadcaster.CliCtx
+	checkpointProcessor.txManager, err = txmanager.NewKeyedManager(helper.GetMainClient(), checkpointProcessor.storageClient)
+	if err != nil {
+		panic(err)
+	}
 
 	// initialize fee processor
 	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI)
@@ -77,6 +82,11 @@ func NewProcessorService(
 	spanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "span", spanProcessor)
 	spanProcessor.cliCtx = txBroadcaster.CliCtx
 
+	// initialize chainmanager processor
+	chainManagerProcessor := &ChainManagerProcessor{}
+	chainManagerProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "chainmanager", chainManagerProcessor)
+	chainManagerProcessor.cliCtx = txBroadcaster.CliCtx
+
 	//
 	// Select processors
 	//
@@ -92,6 +102,7 @@ func NewProcessorService(
 			clerkProcessor,
 			feeProcessor,
 			spanProcessor,
+			chainManagerProcessor,
 		)
 	} else {
 		for _, service := range onlyServices {
@@ -106,6 +117,8 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, feeProcessor)
 			case "span":
 				processorService.processors = append(processorService.processors, spanProcessor)
+			case "chainmanager":
+				processorService.processors = append(processorService.processors, chainManagerProcessor)
 			}
 		}
 	}
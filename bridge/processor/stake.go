@@ -40,6 +40,9 @@ const (
 	errMsgNonceNotInOrder     = "StakingProcessor: nonce for the given event not in order"
 	errMsgQueryStakeTxs       = "StakingProcessor: failed to query stake txs by txQuery for the given validator"
 	errMsgSearchTxs           = "StakingProcessor: failed to search for txs"
+	errMsgFetchingReceipt     = "StakingProcessor: error while fetching the main chain tx receipt"
+	errMsgFetchingParams      = "StakingProcessor: error while fetching chainmanager params"
+	errMsgFetchingValidators  = "StakingProcessor: error while fetching validators"
 
 	// Info messages
 	infoMsgIgnoringAlreadyProcessed = "StakingProcessor: ignoring task to send %s to heimdall as already processed"
@@ -75,21 +78,29 @@ func (sp *StakingProcessor) Start() error {
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("StakingProcessor: registering staking related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
 		sp.Logger.Error("StakingProcessor | RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
 		sp.Logger.Error("StakingProcessor | RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
 		sp.Logger.Error("StakingProcessor | RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
 		sp.Logger.Error("StakingProcessor | RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
+
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendTickAckToHeimdall", sp.sendTickAckToHeimdall); err != nil {
+		sp.Logger.Error("StakingProcessor | RegisterTasks | sendTickAckToHeimdall", "error", err)
+	}
+
+	if err := sp.queueConnector.RegisterTask(sp.name, "sendUnjailToHeimdall", sp.sendUnjailToHeimdall); err != nil {
+		sp.Logger.Error("StakingProcessor | RegisterTasks | sendUnjailToHeimdall", "error", err)
+	}
 }
 
 func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logBytes string) error {
@@ -457,6 +468,233 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 	return nil
 }
 
+// sendTickAckToHeimdall sends the slashing of the validators to heimdall.
+// The Slashed event only carries the slashing nonce and the total amount,
+// so the slashed validators are derived from the Jailed events emitted in the same
+// tx and from the voting power left for each current validator on the root chain.
+func (sp *StakingProcessor) sendTickAckToHeimdall(eventName string, logBytes string) error {
+	vLog := types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error(errMsgUnmarshallingEvent, "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoSlashed)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error(errMsgParsingEvent, "name", eventName, "error", err)
+		return nil
+	}
+
+	if isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent, event); isOld {
+		sp.Logger.Info(fmt.Sprintf(infoMsgIgnoringAlreadyProcessed, "validatorSlashed"),
+			"event", eventName,
+			"nonce", event.Nonce,
+			"amount", event.Amount,
+			"txHash", vLog.TxHash.String(),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+		return nil
+	} else if err != nil {
+		sp.Logger.Error(errMsgCheckingOldTx, "error", err)
+		return err
+	}
+
+	slashedValidators, err := sp.getSlashedValidators(vLog.TxHash)
+	if err != nil {
+		return err
+	}
+
+	sp.Logger.Info(
+		helper.LogReceivedTaskToSend("validator-slashed"),
+		"event", eventName,
+		"nonce", event.Nonce,
+		"amount", event.Amount,
+		"validators", len(slashedValidators),
+		"txHash", vLog.TxHash.String(),
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	address, err := helper.GetAddressString()
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMsgConvertingAddress, err)
+	}
+
+	// msg validator slashed
+	msg, err := stakingTypes.NewMsgValidatorSlashed(
+		address,
+		event.Nonce.Uint64(),
+		math.NewIntFromBigInt(event.Amount),
+		slashedValidators,
+		vLog.TxHash.Bytes(),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	if err != nil {
+		sp.Logger.Error(errMsgCreatingMsg, "error", err)
+		return err
+	}
+
+	// return broadcast to heimdall
+	txRes, err := sp.txBroadcaster.BroadcastToHeimdall(context.Background(), msg, event)
+	if err != nil {
+		sp.Logger.Error(errMsgBroadcasting, "slashingNonce", event.Nonce.Uint64(), "error", err)
+		return err
+	}
+
+	if txRes.Code != abci.CodeTypeOK {
+		sp.Logger.Error(errMsgTxFailed, "txHash", txRes.TxHash, "code", txRes.Code)
+		return fmt.Errorf("validatorSlashed %s, tx response code: %v", errMsgTxFailed, txRes.Code)
+	}
+
+	return nil
+}
+
+// getSlashedValidators returns the validators affected by the slashing tx, with their voting power left on the root chain
+// as of the block of the tx, which the heimdall validators check the same way
+func (sp *StakingProcessor) getSlashedValidators(txHash common.Hash) ([]stakingTypes.SlashedValidator, error) {
+	chainmanagerParams, err := util.GetChainmanagerParams(sp.cliCtx.Codec)
+	if err != nil {
+		sp.Logger.Error(errMsgFetchingParams, "error", err)
+		return nil, err
+	}
+
+	stakingInfoAddress := chainmanagerParams.ChainParams.StakingInfoAddress
+
+	receipt, err := sp.contractCaller.GetMainTxReceipt(context.Background(), txHash)
+	if err != nil || receipt == nil {
+		sp.Logger.Error(errMsgFetchingReceipt, "txHash", txHash.String(), "error", err)
+		return nil, errors.Join(errors.New(errMsgFetchingReceipt), err)
+	}
+
+	jailedLogs, err := sp.contractCaller.DecodeJailedEvents(stakingInfoAddress, receipt)
+	if err != nil {
+		sp.Logger.Error(errMsgParsingEvent, "name", helper.JailedEvent, "error", err)
+		return nil, err
+	}
+
+	jailed := make(map[uint64]bool, len(jailedLogs))
+	for _, jailedLog := range jailedLogs {
+		jailed[jailedLog.ValidatorId.Uint64()] = true
+	}
+
+	validatorSet, err := util.GetValidatorSet(sp.cliCtx.Codec)
+	if err != nil {
+		sp.Logger.Error(errMsgFetchingValidators, "error", err)
+		return nil, err
+	}
+
+	slashedValidators := make([]stakingTypes.SlashedValidator, 0, len(validatorSet.Validators))
+	for _, validator := range validatorSet.Validators {
+		mainChainValidator, err := sp.contractCaller.GetValidatorInfoAtBlock(validator.ValId, receipt.BlockNumber, stakingInfoAddress)
+		if err != nil {
+			sp.Logger.Error(errMsgFetchingValidators, "validatorId", validator.ValId, "error", err)
+			return nil, err
+		}
+
+		isJailed := jailed[validator.ValId]
+		delete(jailed, validator.ValId)
+
+		if !isJailed && mainChainValidator.VotingPower >= validator.VotingPower {
+			continue
+		}
+
+		slashedValidators = append(slashedValidators, stakingTypes.SlashedValidator{
+			ValId:       validator.ValId,
+			VotingPower: mainChainValidator.VotingPower,
+			Jailed:      isJailed,
+		})
+	}
+
+	// jailed validators which are not part of the current validator set
+	for valID := range jailed {
+		mainChainValidator, err := sp.contractCaller.GetValidatorInfoAtBlock(valID, receipt.BlockNumber, stakingInfoAddress)
+		if err != nil {
+			sp.Logger.Error(errMsgFetchingValidators, "validatorId", valID, "error", err)
+			return nil, err
+		}
+
+		slashedValidators = append(slashedValidators, stakingTypes.SlashedValidator{
+			ValId:       valID,
+			VotingPower: mainChainValidator.VotingPower,
+			Jailed:      true,
+		})
+	}
+
+	return slashedValidators, nil
+}
+
+func (sp *StakingProcessor) sendUnjailToHeimdall(eventName string, logBytes string) error {
+	vLog := types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error(errMsgUnmarshallingEvent, "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoUnJailed)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error(errMsgParsingEvent, "name", eventName, "error", err)
+	} else {
+		if isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent, event); isOld {
+			sp.Logger.Info(fmt.Sprintf(infoMsgIgnoringAlreadyProcessed, "unjail"),
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"signer", event.Signer.Hex(),
+				"txHash", vLog.TxHash.String(),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		} else if err != nil {
+			sp.Logger.Error(errMsgCheckingOldTx, "error", err)
+			return err
+		}
+
+		sp.Logger.Info(
+			helper.LogReceivedTaskToSend("unjail"),
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"signer", event.Signer.Hex(),
+			"txHash", vLog.TxHash.String(),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		address, err := helper.GetAddressString()
+		if err != nil {
+			return fmt.Errorf("%s: %w", errMsgConvertingAddress, err)
+		}
+
+		// msg validator unjail
+		msg, err := stakingTypes.NewMsgValidatorUnjail(
+			address,
+			event.ValidatorId.Uint64(),
+			vLog.TxHash.Bytes(),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+		if err != nil {
+			sp.Logger.Error(errMsgCreatingMsg, "error", err)
+			return err
+		}
+
+		// return broadcast to heimdall
+		txRes, err := sp.txBroadcaster.BroadcastToHeimdall(context.Background(), msg, event)
+		if err != nil {
+			sp.Logger.Error(errMsgBroadcasting, "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+
+		if txRes.Code != abci.CodeTypeOK {
+			sp.Logger.Error(errMsgTxFailed, "txHash", txRes.TxHash, "code", txRes.Code)
+			return fmt.Errorf("unjail %s, tx response code: %v", errMsgTxFailed, txRes.Code)
+		}
+	}
+
+	return nil
+}
+
 func (sp *StakingProcessor) checkValidNonce(validatorId uint64, txNonce uint64) (bool, uint64, error) {
 	currentNonce, err := util.GetValidatorNonce(validatorId, sp.cliCtx.Codec)
 	if err != nil {
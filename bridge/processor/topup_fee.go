@@ -56,7 +56,7 @@ func (fp *FeeProcessor) Start() error {
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info(infoMsgTopupRegisteringTasks)
 
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.queueConnector.RegisterTask(fp.name, "sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
 		fp.Logger.Error(errMsgTopupRegisteringTask, "error", err)
 	}
 }
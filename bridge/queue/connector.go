@@ -1,12 +1,18 @@
 package queue
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 
 	"cosmossdk.io/log"
 	"github.com/RichardKnop/machinery/v1"
+	"github.com/RichardKnop/machinery/v1/backends/null"
 	"github.com/RichardKnop/machinery/v1/config"
+	eagerlock "github.com/RichardKnop/machinery/v1/locks/eager"
+	"github.com/RichardKnop/machinery/v1/tasks"
 	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/syndtr/goleveldb/leveldb"
 
 	"github.com/0xPolygon/heimdall-v2/helper"
 )
@@ -16,6 +22,9 @@ type Connector struct {
 	logger log.Logger
 	Server *machinery.Server
 
+	deadLetters *DeadLetterStore
+	statuses    *taskStatuses
+
 	mu     sync.Mutex
 	worker *machinery.Worker
 }
@@ -25,7 +34,26 @@ const (
 	QName = "machinery_tasks"
 )
 
-// NewQueueConnector creates a new queue connector
+// NewConnector creates a new queue connector for the given backend,
+// storing the tasks which exhausted their retries in the dead letter store of the given LevelDB
+func NewConnector(backend string, dialer string, db *leveldb.DB) (*Connector, error) {
+	var connector *Connector
+
+	switch backend {
+	case helper.QueueBackendAMQP:
+		connector = NewQueueConnector(dialer)
+	case helper.QueueBackendLevelDB:
+		connector = NewLevelDBQueueConnector(db)
+	default:
+		return nil, fmt.Errorf("invalid queue backend %q, expected %q or %q", backend, helper.QueueBackendLevelDB, helper.QueueBackendAMQP)
+	}
+
+	connector.EnableDeadLetters(NewDeadLetterStore(db))
+
+	return connector, nil
+}
+
+// NewQueueConnector creates a new queue connector backed by AMQP
 func NewQueueConnector(dialer string) *Connector {
 	// amqp dialer
 	c, err := amqp.Dial(dialer)
@@ -59,10 +87,73 @@ func NewQueueConnector(dialer string) *Connector {
 		logger: helper.Logger.With("module", "bridge/queue"),
 		Server: server,
 	}
+	connector.trackTaskStatuses()
 
 	return &connector
 }
 
+// NewLevelDBQueueConnector creates a new queue connector backed by the given LevelDB
+func NewLevelDBQueueConnector(db *leveldb.DB) *Connector {
+	logger := helper.Logger.With("module", "bridge/queue")
+
+	cnf := &config.Config{
+		Broker:        "leveldb",
+		DefaultQueue:  QName,
+		ResultBackend: "null",
+	}
+
+	// task results are never read, so the null backend is enough
+	server := machinery.NewServerWithBrokerBackendLock(cnf, NewLevelDBBroker(cnf, db, logger), null.New(), eagerlock.New())
+
+	connector := Connector{
+		logger: logger,
+		Server: server,
+	}
+	connector.trackTaskStatuses()
+
+	return &connector
+}
+
+// EnableDeadLetters stores the tasks which exhausted their retries in the given store
+func (qc *Connector) EnableDeadLetters(store *DeadLetterStore) {
+	qc.deadLetters = store
+	qc.Server.SetBackend(&deadLetterBackend{
+		Backend: qc.Server.GetBackend(),
+		store:   store,
+	})
+}
+
+// DeadLetters returns the dead letter store, nil if dead letters are not enabled
+func (qc *Connector) DeadLetters() *DeadLetterStore {
+	return qc.deadLetters
+}
+
+// ReplayDeadLetter re-enqueues the dead letter with the given id and removes it from the store
+func (qc *Connector) ReplayDeadLetter(id string) error {
+	if qc.deadLetters == nil {
+		return errors.New("dead letters are not enabled")
+	}
+
+	deadLetter, err := qc.deadLetters.Get(id)
+	if err != nil {
+		return err
+	}
+
+	signature := &tasks.Signature{
+		Name:       deadLetter.TaskName,
+		Args:       deadLetter.Args,
+		RetryCount: DefaultReplayRetryCount,
+	}
+
+	if _, err := qc.Server.SendTask(signature); err != nil {
+		return fmt.Errorf("error while replaying dead letter %s: %w", id, err)
+	}
+
+	qc.logger.Info("Replayed dead letter", "id", id, "taskName", deadLetter.TaskName, "taskUUID", signature.UUID)
+
+	return qc.deadLetters.Delete(id)
+}
+
 // StartWorker - starts worker to process registered tasks
 func (qc *Connector) StartWorker() {
 	qc.mu.Lock()
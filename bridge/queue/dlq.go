@@ -0,0 +1,193 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// deadLetterKeyPrefix prefixes the dead letters, keyed by task UUID
+	deadLetterKeyPrefix = "dlq-"
+
+	// attemptsHeader is the task header counting the attempts made to process it
+	attemptsHeader = "attempts"
+
+	// DefaultReplayRetryCount is the retry count of a replayed dead letter
+	DefaultReplayRetryCount = 3
+)
+
+// ErrDeadLetterNotFound is returned when a dead letter doesn't exist
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is a task which failed after exhausting its retries
+type DeadLetter struct {
+	ID       string      `json:"id"`
+	TaskName string      `json:"task_name"`
+	Args     []tasks.Arg `json:"args"`
+	Error    string      `json:"error"`
+	Attempts int         `json:"attempts"`
+	FailedAt time.Time   `json:"failed_at"`
+}
+
+// DeadLetterStore persists the failed tasks in the bridge LevelDB
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore creates a new dead letter store
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+// Add stores a failed task
+func (s *DeadLetterStore) Add(signature *tasks.Signature, errMsg string) error {
+	deadLetter := DeadLetter{
+		ID:       signature.UUID,
+		TaskName: signature.Name,
+		Args:     signature.Args,
+		Error:    errMsg,
+		Attempts: attempts(signature),
+		FailedAt: time.Now().UTC(),
+	}
+
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %w", err)
+	}
+
+	return s.db.Put(deadLetterKey(deadLetter.ID), value, nil)
+}
+
+// List returns all the dead letters, oldest first
+func (s *DeadLetterStore) List() ([]DeadLetter, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(deadLetterKeyPrefix)), nil)
+	defer iter.Release()
+
+	deadLetters := make([]DeadLetter, 0)
+	for iter.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(iter.Value(), &deadLetter); err != nil {
+			return nil, fmt.Errorf("JSON unmarshal error: %w", err)
+		}
+
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(deadLetters, func(i, j int) bool {
+		return deadLetters[i].FailedAt.Before(deadLetters[j].FailedAt)
+	})
+
+	return deadLetters, nil
+}
+
+// Get returns the dead letter with the given id
+func (s *DeadLetterStore) Get(id string) (*DeadLetter, error) {
+	value, err := s.db.Get(deadLetterKey(id), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	deadLetter := new(DeadLetter)
+	if err := json.Unmarshal(value, deadLetter); err != nil {
+		return nil, fmt.Errorf("JSON unmarshal error: %w", err)
+	}
+
+	return deadLetter, nil
+}
+
+// Delete removes the dead letter with the given id
+func (s *DeadLetterStore) Delete(id string) error {
+	exists, err := s.db.Has(deadLetterKey(id), nil)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrDeadLetterNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(id), nil)
+}
+
+// Purge removes all the dead letters and returns how many were removed
+func (s *DeadLetterStore) Purge() (int, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(deadLetterKeyPrefix)), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(iter.Key())
+	}
+
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	if err := s.db.Write(batch, nil); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), nil
+}
+
+// deadLetterBackend wraps the result backend, to count the attempts made on each task
+// and to store the tasks which failed after exhausting their retries
+type deadLetterBackend struct {
+	backendsiface.Backend
+	store *DeadLetterStore
+}
+
+// SetStateStarted counts the attempt, before the task is processed
+func (b *deadLetterBackend) SetStateStarted(signature *tasks.Signature) error {
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+	signature.Headers[attemptsHeader] = attempts(signature) + 1
+
+	return b.Backend.SetStateStarted(signature)
+}
+
+// SetStateFailure stores the task in the dead letter store, as machinery only marks a task
+// as failed once it has no retries left
+func (b *deadLetterBackend) SetStateFailure(signature *tasks.Signature, err string) error {
+	if storeErr := b.store.Add(signature, err); storeErr != nil {
+		return fmt.Errorf("error while storing dead letter for task %s: %w", signature.UUID, storeErr)
+	}
+
+	return b.Backend.SetStateFailure(signature, err)
+}
+
+// attempts returns the number of attempts made on a task, as counted in its headers
+func attempts(signature *tasks.Signature) int {
+	// the header is a json.Number or a float64 once the task went through a broker
+	switch v := signature.Headers[attemptsHeader].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case json.Number:
+		n, _ := strconv.Atoi(v.String())
+		return n
+	default:
+		return 0
+	}
+}
+
+func deadLetterKey(id string) []byte {
+	return []byte(deadLetterKeyPrefix + id)
+}
@@ -0,0 +1,101 @@
+package queue_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	store := queue.NewDeadLetterStore(newTestDB(t))
+
+	require.NoError(t, store.Add(newSignature("task_1", "a", time.Now()), "error a"))
+	require.NoError(t, store.Add(newSignature("task_2", "b", time.Now()), "error b"))
+
+	deadLetters, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, "task_1", deadLetters[0].ID)
+	require.Equal(t, "task_2", deadLetters[1].ID)
+
+	deadLetter, err := store.Get("task_2")
+	require.NoError(t, err)
+	require.Equal(t, "sendTask", deadLetter.TaskName)
+	require.Equal(t, "error b", deadLetter.Error)
+	require.Equal(t, "b", deadLetter.Args[0].Value)
+
+	_, err = store.Get("task_3")
+	require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+
+	require.NoError(t, store.Delete("task_1"))
+	require.ErrorIs(t, store.Delete("task_1"), queue.ErrDeadLetterNotFound)
+
+	require.NoError(t, store.Add(newSignature("task_3", "c", time.Now()), "error c"))
+
+	purged, err := store.Purge()
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+
+	deadLetters, err = store.List()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestConnector_DeadLetters(t *testing.T) {
+	db := newTestDB(t)
+	qc := queue.NewLevelDBQueueConnector(db)
+	qc.EnableDeadLetters(queue.NewDeadLetterStore(db))
+
+	// the task fails on its first attempt and on its retry, then succeeds once replayed
+	var calls atomic.Int32
+	replayed := make(chan string, 1)
+	require.NoError(t, qc.Server.RegisterTask("sendTask", func(value string) error {
+		if calls.Add(1) <= 2 {
+			return errors.New("failure")
+		}
+
+		replayed <- value
+
+		return nil
+	}))
+
+	qc.StartWorker()
+	t.Cleanup(qc.StopWorker)
+
+	_, err := qc.Server.SendTask(&tasks.Signature{
+		UUID:       "task_1",
+		Name:       "sendTask",
+		Args:       []tasks.Arg{{Type: "string", Value: "a"}},
+		RetryCount: 1,
+	})
+	require.NoError(t, err)
+
+	var deadLetter *queue.DeadLetter
+	require.Eventually(t, func() bool {
+		deadLetter, err = qc.DeadLetters().Get("task_1")
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond, "failed task should be dead lettered")
+
+	require.Equal(t, "sendTask", deadLetter.TaskName)
+	require.Equal(t, "failure", deadLetter.Error)
+	require.Equal(t, 2, deadLetter.Attempts)
+
+	require.NoError(t, qc.ReplayDeadLetter("task_1"))
+
+	select {
+	case value := <-replayed:
+		require.Equal(t, "a", value)
+	case <-time.After(10 * time.Second):
+		t.Fatal("dead letter not replayed")
+	}
+
+	_, err = qc.DeadLetters().Get("task_1")
+	require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+	require.ErrorIs(t, qc.ReplayDeadLetter("task_1"), queue.ErrDeadLetterNotFound)
+}
@@ -0,0 +1,350 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/RichardKnop/machinery/v1/brokers/iface"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// taskKeyPrefix prefixes the pending tasks, ordered by their ETA
+	taskKeyPrefix = "queue-task-"
+	// dedupKeyPrefix prefixes the content hash of the pending tasks
+	dedupKeyPrefix = "queue-dedup-"
+
+	// DefaultPollInterval is the interval at which the LevelDB broker looks for due tasks
+	DefaultPollInterval = time.Second
+)
+
+// LevelDBBroker is a machinery broker persisting the tasks in the bridge LevelDB.
+// Tasks are stored under a key ordered by their ETA, so that delayed tasks and retries
+// are delivered once due, and are removed only after being processed, so that they survive a restart.
+// A task with the same name and arguments as a pending one is dropped as a duplicate.
+type LevelDBBroker struct {
+	common.Broker
+
+	logger       log.Logger
+	db           *leveldb.DB
+	pollInterval time.Duration
+
+	// mu serializes the writes, so that the dedup check and the insertion are atomic
+	mu sync.Mutex
+	// inflight holds the keys of the tasks being processed
+	inflight sync.Map
+
+	stopMu sync.Mutex
+	stopCh chan struct{}
+	wakeCh chan struct{}
+}
+
+var _ iface.Broker = (*LevelDBBroker)(nil)
+
+// NewLevelDBBroker creates a new LevelDB broker
+func NewLevelDBBroker(cnf *config.Config, db *leveldb.DB, logger log.Logger) *LevelDBBroker {
+	return &LevelDBBroker{
+		Broker:       common.NewBroker(cnf),
+		logger:       logger,
+		db:           db,
+		pollInterval: DefaultPollInterval,
+		wakeCh:       make(chan struct{}, 1),
+	}
+}
+
+// StartConsuming delivers the due tasks to the processor until StopConsuming is called
+func (b *LevelDBBroker) StartConsuming(consumerTag string, concurrency int, p iface.TaskProcessor) (bool, error) {
+	b.Broker.StartConsuming(consumerTag, concurrency, p)
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	b.stopMu.Lock()
+	b.stopCh = make(chan struct{})
+	stopCh := b.stopCh
+	b.stopMu.Unlock()
+
+	pool := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	b.logger.Info("LevelDB broker: waiting for tasks", "consumerTag", consumerTag, "concurrency", concurrency)
+
+	for {
+		select {
+		case <-stopCh:
+			// wait for the tasks being processed, they are removed only once done
+			wg.Wait()
+			return false, nil
+		case <-ticker.C:
+		case <-b.wakeCh:
+		}
+
+		if !p.PreConsumeHandler() {
+			continue
+		}
+
+		if err := b.dispatchDueTasks(stopCh, pool, &wg, p); err != nil {
+			b.logger.Error("LevelDB broker: error while dispatching tasks", "error", err)
+		}
+	}
+}
+
+// StopConsuming stops the delivery of the tasks
+func (b *LevelDBBroker) StopConsuming() {
+	b.stopMu.Lock()
+	defer b.stopMu.Unlock()
+
+	if b.stopCh == nil {
+		return
+	}
+
+	close(b.stopCh)
+	b.stopCh = nil
+}
+
+// Publish persists the task, to be delivered once its ETA is reached
+func (b *LevelDBBroker) Publish(_ context.Context, signature *tasks.Signature) error {
+	b.AdjustRoutingKey(signature)
+
+	message, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %w", err)
+	}
+
+	eta := time.Now().UTC()
+	if signature.ETA != nil {
+		eta = signature.ETA.UTC()
+	}
+
+	key := taskKey(eta, signature.UUID)
+
+	dedupKey, err := taskDedupKey(signature)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// a task is a duplicate when another task (i.e. not a retry of the same one) with the same content is pending
+	pendingKey, err := b.db.Get(dedupKey, nil)
+	if err != nil && !errors.Is(err, leveldb.ErrNotFound) {
+		return err
+	}
+
+	if err == nil && !strings.HasSuffix(string(pendingKey), signature.UUID) {
+		if exists, err := b.db.Has(pendingKey, nil); err != nil {
+			return err
+		} else if exists {
+			b.logger.Info("LevelDB broker: dropping duplicate task", "taskName", signature.Name, "taskUUID", signature.UUID)
+			return nil
+		}
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put(key, message)
+	batch.Put(dedupKey, key)
+
+	if err := b.db.Write(batch, nil); err != nil {
+		return err
+	}
+
+	b.wake()
+
+	return nil
+}
+
+// GetPendingTasks returns the tasks which are due
+func (b *LevelDBBroker) GetPendingTasks(_ string) ([]*tasks.Signature, error) {
+	now := time.Now()
+
+	return b.getTasks(func(eta time.Time) bool { return !eta.After(now) })
+}
+
+// GetDelayedTasks returns the tasks which are scheduled, but not due yet
+func (b *LevelDBBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	now := time.Now()
+
+	return b.getTasks(func(eta time.Time) bool { return eta.After(now) })
+}
+
+func (b *LevelDBBroker) getTasks(filter func(eta time.Time) bool) ([]*tasks.Signature, error) {
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	signatures := make([]*tasks.Signature, 0)
+	for iter.Next() {
+		eta, err := parseTaskKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		if !filter(eta) {
+			continue
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, iter.Error()
+}
+
+// dispatchDueTasks hands the due tasks to the processor, as long as there is capacity in the pool
+func (b *LevelDBBroker) dispatchDueTasks(stopCh chan struct{}, pool chan struct{}, wg *sync.WaitGroup, p iface.TaskProcessor) error {
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	now := time.Now()
+	for iter.Next() {
+		eta, err := parseTaskKey(iter.Key())
+		if err != nil {
+			return err
+		}
+
+		// keys are ordered by ETA, so no more tasks are due
+		if eta.After(now) {
+			break
+		}
+
+		key := bytes.Clone(iter.Key())
+		if _, ok := b.inflight.Load(string(key)); ok {
+			continue
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			b.logger.Error("LevelDB broker: dropping malformed task", "key", string(key), "error", err)
+			b.remove(key)
+			continue
+		}
+
+		select {
+		case <-stopCh:
+			return nil
+		case pool <- struct{}{}:
+		default:
+			// the pool is full, remaining tasks are dispatched once a slot is released
+			return iter.Error()
+		}
+
+		b.inflight.Store(string(key), struct{}{})
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				b.remove(key)
+				b.inflight.Delete(string(key))
+				<-pool
+				wg.Done()
+				b.wake()
+			}()
+
+			if err := p.Process(signature); err != nil {
+				b.logger.Error("LevelDB broker: error while processing task", "taskName", signature.Name, "taskUUID", signature.UUID, "error", err)
+			}
+		}()
+	}
+
+	return iter.Error()
+}
+
+// remove deletes a processed task, along with its dedup entry if it wasn't replaced by a retry
+func (b *LevelDBBroker) remove(key []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch := new(leveldb.Batch)
+	batch.Delete(key)
+
+	value, err := b.db.Get(key, nil)
+	if err == nil {
+		if signature, err := decodeSignature(value); err == nil {
+			if dedupKey, err := taskDedupKey(signature); err == nil {
+				if pendingKey, err := b.db.Get(dedupKey, nil); err == nil && bytes.Equal(pendingKey, key) {
+					batch.Delete(dedupKey)
+				}
+			}
+		}
+	}
+
+	if err := b.db.Write(batch, nil); err != nil {
+		b.logger.Error("LevelDB broker: error while removing task", "key", string(key), "error", err)
+	}
+}
+
+func (b *LevelDBBroker) wake() {
+	select {
+	case b.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// taskKey returns the key of a task, made of its zero-padded ETA and UUID to keep the tasks ordered
+func taskKey(eta time.Time, uuid string) []byte {
+	return fmt.Appendf(nil, "%s%020d-%s", taskKeyPrefix, eta.UnixNano(), uuid)
+}
+
+// parseTaskKey returns the ETA of the task from its key
+func parseTaskKey(key []byte) (time.Time, error) {
+	rest := strings.TrimPrefix(string(key), taskKeyPrefix)
+
+	etaStr, _, found := strings.Cut(rest, "-")
+	if !found {
+		return time.Time{}, fmt.Errorf("invalid task key %s", string(key))
+	}
+
+	eta, err := strconv.ParseInt(etaStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid task key %s: %w", string(key), err)
+	}
+
+	return time.Unix(0, eta), nil
+}
+
+// taskDedupKey returns the dedup key of a task, based on its name and arguments
+func taskDedupKey(signature *tasks.Signature) ([]byte, error) {
+	args, err := json.Marshal(signature.Args)
+	if err != nil {
+		return nil, fmt.Errorf("JSON marshal error: %w", err)
+	}
+
+	hash := sha256.Sum256(append([]byte(signature.Name+"/"), args...))
+
+	return []byte(dedupKeyPrefix + hex.EncodeToString(hash[:])), nil
+}
+
+// decodeSignature decodes a task the same way as the machinery brokers, keeping the numbers as json.Number
+func decodeSignature(message []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return nil, fmt.Errorf("JSON unmarshal error: %w", err)
+	}
+
+	return signature, nil
+}
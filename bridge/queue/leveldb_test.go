@@ -0,0 +1,217 @@
+package queue_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+// recordingProcessor records the tasks delivered by the broker
+type recordingProcessor struct {
+	delivered chan *tasks.Signature
+}
+
+func newRecordingProcessor() *recordingProcessor {
+	return &recordingProcessor{delivered: make(chan *tasks.Signature, 16)}
+}
+
+func (p *recordingProcessor) Process(signature *tasks.Signature) error {
+	p.delivered <- signature
+
+	return nil
+}
+
+func (p *recordingProcessor) CustomQueue() string     { return "" }
+func (p *recordingProcessor) PreConsumeHandler() bool { return true }
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func newTestBroker(t *testing.T, db *leveldb.DB) *queue.LevelDBBroker {
+	t.Helper()
+
+	return queue.NewLevelDBBroker(&config.Config{DefaultQueue: queue.QName}, db, log.NewNopLogger())
+}
+
+func newSignature(uuid string, value string, eta time.Time) *tasks.Signature {
+	return &tasks.Signature{
+		UUID: uuid,
+		Name: "sendTask",
+		Args: []tasks.Arg{{Type: "string", Value: value}},
+		ETA:  &eta,
+	}
+}
+
+func startConsuming(t *testing.T, broker *queue.LevelDBBroker, p *recordingProcessor) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = broker.StartConsuming("test", 2, p)
+	}()
+
+	t.Cleanup(func() {
+		broker.StopConsuming()
+		<-done
+	})
+}
+
+func waitForTask(t *testing.T, p *recordingProcessor) *tasks.Signature {
+	t.Helper()
+
+	select {
+	case signature := <-p.delivered:
+		return signature
+	case <-time.After(5 * time.Second):
+		t.Fatal("task not delivered")
+		return nil
+	}
+}
+
+func TestLevelDBBroker_DeliversDueTasks(t *testing.T) {
+	broker := newTestBroker(t, newTestDB(t))
+	p := newRecordingProcessor()
+	startConsuming(t, broker, p)
+
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_1", "a", time.Now())))
+
+	signature := waitForTask(t, p)
+	require.Equal(t, "task_1", signature.UUID)
+	require.Equal(t, "a", signature.Args[0].Value)
+
+	require.Eventually(t, func() bool {
+		pending, err := broker.GetPendingTasks(queue.QName)
+		return err == nil && len(pending) == 0
+	}, 5*time.Second, 10*time.Millisecond, "processed task should be removed")
+}
+
+func TestLevelDBBroker_DelayedTasks(t *testing.T) {
+	broker := newTestBroker(t, newTestDB(t))
+
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_1", "a", time.Now().Add(time.Hour))))
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_2", "b", time.Now().Add(-time.Second))))
+
+	delayed, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayed, 1)
+	require.Equal(t, "task_1", delayed[0].UUID)
+
+	pending, err := broker.GetPendingTasks(queue.QName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "task_2", pending[0].UUID)
+
+	p := newRecordingProcessor()
+	startConsuming(t, broker, p)
+
+	require.Equal(t, "task_2", waitForTask(t, p).UUID)
+
+	select {
+	case signature := <-p.delivered:
+		t.Fatalf("delayed task %s delivered before its ETA", signature.UUID)
+	case <-time.After(1500 * time.Millisecond):
+	}
+}
+
+func TestLevelDBBroker_Dedup(t *testing.T) {
+	broker := newTestBroker(t, newTestDB(t))
+	eta := time.Now().Add(time.Hour)
+
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_1", "a", eta)))
+	// same content, different task
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_2", "a", eta)))
+	// different content
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_3", "b", eta)))
+	// retry of the same task
+	require.NoError(t, broker.Publish(context.Background(), newSignature("task_1", "a", eta.Add(time.Minute))))
+
+	delayed, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+
+	uuids := make([]string, 0, len(delayed))
+	for _, signature := range delayed {
+		uuids = append(uuids, signature.UUID)
+	}
+
+	require.ElementsMatch(t, []string{"task_1", "task_3", "task_1"}, uuids)
+}
+
+func TestLevelDBBroker_PersistsAcrossRestart(t *testing.T) {
+	db := newTestDB(t)
+
+	require.NoError(t, newTestBroker(t, db).Publish(context.Background(), newSignature("task_1", "a", time.Now())))
+
+	// a new broker on the same db delivers the task published before the restart
+	broker := newTestBroker(t, db)
+	p := newRecordingProcessor()
+	startConsuming(t, broker, p)
+
+	require.Equal(t, "task_1", waitForTask(t, p).UUID)
+}
+
+func TestLevelDBQueueConnector_RetriesTasks(t *testing.T) {
+	qc := queue.NewLevelDBQueueConnector(newTestDB(t))
+
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	done := make(chan struct{})
+
+	require.NoError(t, qc.Server.RegisterTask("sendTask", func(value string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+		if attempts == 1 {
+			return tasks.NewErrRetryTaskLater("retry", time.Millisecond)
+		}
+
+		close(done)
+
+		return nil
+	}))
+
+	qc.StartWorker()
+	t.Cleanup(qc.StopWorker)
+
+	_, err := qc.Server.SendTask(&tasks.Signature{
+		Name: "sendTask",
+		Args: []tasks.Arg{{Type: "string", Value: "a"}},
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("task not retried")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 2, attempts)
+}
+
+func TestNewConnector_InvalidBackend(t *testing.T) {
+	_, err := queue.NewConnector("invalid", helper.DefaultAmqpURL, nil)
+	require.ErrorContains(t, err, "invalid queue backend")
+}
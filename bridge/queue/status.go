@@ -0,0 +1,197 @@
+package queue
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// TaskStatus is the outcome of the processing of a task since the bridge started
+type TaskStatus struct {
+	Name        string     `json:"name"`
+	Successes   uint64     `json:"successes"`
+	Retries     uint64     `json:"retries"`
+	Failures    uint64     `json:"failures"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastRetry   *time.Time `json:"last_retry,omitempty"`
+	LastFailure *time.Time `json:"last_failure,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+}
+
+// ProcessorStatus is the outcome of the processing of the tasks of a processor since the bridge started
+type ProcessorStatus struct {
+	Name        string       `json:"name"`
+	LastSuccess *time.Time   `json:"last_success,omitempty"`
+	LastFailure *time.Time   `json:"last_failure,omitempty"`
+	LastError   string       `json:"last_error,omitempty"`
+	Tasks       []TaskStatus `json:"tasks"`
+}
+
+// taskStatuses tracks the outcome of the registered tasks
+type taskStatuses struct {
+	mu         sync.RWMutex
+	processors map[string]string // processor of each task
+	tasks      map[string]*TaskStatus
+}
+
+func newTaskStatuses() *taskStatuses {
+	return &taskStatuses{
+		processors: make(map[string]string),
+		tasks:      make(map[string]*TaskStatus),
+	}
+}
+
+func (s *taskStatuses) register(processor string, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processors[name] = processor
+	if _, ok := s.tasks[name]; !ok {
+		s.tasks[name] = &TaskStatus{Name: name}
+	}
+}
+
+// update applies the given change to the status of the task, if it is registered
+func (s *taskStatuses) update(name string, change func(status *TaskStatus, now time.Time)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.tasks[name]; ok {
+		change(status, time.Now().UTC())
+	}
+}
+
+// byProcessor returns the statuses of the tasks grouped by processor, sorted by name
+func (s *taskStatuses) byProcessor() []ProcessorStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make(map[string]*ProcessorStatus)
+	for name, task := range s.tasks {
+		processor := s.processors[name]
+
+		status, ok := statuses[processor]
+		if !ok {
+			status = &ProcessorStatus{Name: processor}
+			statuses[processor] = status
+		}
+
+		status.Tasks = append(status.Tasks, *task)
+
+		if task.LastSuccess != nil && (status.LastSuccess == nil || task.LastSuccess.After(*status.LastSuccess)) {
+			status.LastSuccess = task.LastSuccess
+		}
+
+		if task.LastFailure != nil && (status.LastFailure == nil || task.LastFailure.After(*status.LastFailure)) {
+			status.LastFailure = task.LastFailure
+			status.LastError = task.LastError
+		}
+	}
+
+	result := make([]ProcessorStatus, 0, len(statuses))
+	for _, status := range statuses {
+		sort.Slice(status.Tasks, func(i, j int) bool {
+			return status.Tasks[i].Name < status.Tasks[j].Name
+		})
+		result = append(result, *status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// taskStatusBackend wraps the result backend, to track the outcome of the registered tasks
+type taskStatusBackend struct {
+	backendsiface.Backend
+	statuses *taskStatuses
+}
+
+// SetStateSuccess records the success of the task
+func (b *taskStatusBackend) SetStateSuccess(signature *tasks.Signature, results []*tasks.TaskResult) error {
+	b.statuses.update(signature.Name, func(status *TaskStatus, now time.Time) {
+		status.Successes++
+		status.LastSuccess = &now
+	})
+
+	return b.Backend.SetStateSuccess(signature, results)
+}
+
+// SetStateRetry records a failed attempt of the task, which is going to be retried
+func (b *taskStatusBackend) SetStateRetry(signature *tasks.Signature) error {
+	b.statuses.update(signature.Name, func(status *TaskStatus, now time.Time) {
+		status.Retries++
+		status.LastRetry = &now
+	})
+
+	return b.Backend.SetStateRetry(signature)
+}
+
+// SetStateFailure records the failure of the task, which has no retries left
+func (b *taskStatusBackend) SetStateFailure(signature *tasks.Signature, err string) error {
+	b.statuses.update(signature.Name, func(status *TaskStatus, now time.Time) {
+		status.Failures++
+		status.LastFailure = &now
+		status.LastError = err
+	})
+
+	return b.Backend.SetStateFailure(signature, err)
+}
+
+// RegisterTask registers the task of the given processor with machinery, and tracks its outcome
+func (qc *Connector) RegisterTask(processor string, name string, taskFunc interface{}) error {
+	if err := qc.Server.RegisterTask(name, taskFunc); err != nil {
+		return err
+	}
+
+	if qc.statuses != nil {
+		qc.statuses.register(processor, name)
+	}
+
+	return nil
+}
+
+// ProcessorStatuses returns the outcome of the tasks registered through RegisterTask, grouped by processor
+func (qc *Connector) ProcessorStatuses() []ProcessorStatus {
+	if qc.statuses == nil {
+		return []ProcessorStatus{}
+	}
+
+	return qc.statuses.byProcessor()
+}
+
+// TaskCounts returns the number of tasks waiting in the queue, due and delayed ones.
+// It is only supported by the leveldb queue backend.
+func (qc *Connector) TaskCounts() (int, int, error) {
+	broker, ok := qc.Server.GetBroker().(*LevelDBBroker)
+	if !ok {
+		return 0, 0, errors.New("task counts are only supported by the leveldb queue backend")
+	}
+
+	pending, err := broker.GetPendingTasks(QName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	delayed, err := broker.GetDelayedTasks()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return len(pending), len(delayed), nil
+}
+
+// trackTaskStatuses wraps the result backend to track the outcome of the tasks
+func (qc *Connector) trackTaskStatuses() {
+	qc.statuses = newTaskStatuses()
+	qc.Server.SetBackend(&taskStatusBackend{
+		Backend:  qc.Server.GetBackend(),
+		statuses: qc.statuses,
+	})
+}
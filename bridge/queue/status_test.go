@@ -0,0 +1,79 @@
+package queue_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/queue"
+)
+
+func TestConnector_ProcessorStatuses(t *testing.T) {
+	qc := queue.NewLevelDBQueueConnector(newTestDB(t))
+
+	require.NoError(t, qc.RegisterTask("stake", "sendStakeUpdateToHeimdall", func(string) error { return nil }))
+	require.NoError(t, qc.RegisterTask("stake", "sendSignerChangeToHeimdall", func(string) error { return errors.New("failure") }))
+	require.NoError(t, qc.RegisterTask("clerk", "sendStateSyncedToHeimdall", func(string) error { return nil }))
+
+	statuses := qc.ProcessorStatuses()
+	require.Len(t, statuses, 2)
+	require.Equal(t, "clerk", statuses[0].Name)
+	require.Equal(t, "stake", statuses[1].Name)
+	require.Nil(t, statuses[1].LastSuccess)
+
+	qc.StartWorker()
+	t.Cleanup(qc.StopWorker)
+
+	for _, signature := range []*tasks.Signature{
+		{Name: "sendStakeUpdateToHeimdall", Args: []tasks.Arg{{Type: "string", Value: "a"}}},
+		{Name: "sendSignerChangeToHeimdall", Args: []tasks.Arg{{Type: "string", Value: "b"}}, RetryCount: 1},
+	} {
+		_, err := qc.Server.SendTask(signature)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		statuses = qc.ProcessorStatuses()
+		return statuses[1].LastSuccess != nil && statuses[1].LastFailure != nil
+	}, 10*time.Second, 10*time.Millisecond, "task outcomes should be tracked")
+
+	stake := statuses[1]
+	require.Equal(t, "failure", stake.LastError)
+	require.Len(t, stake.Tasks, 2)
+
+	signerChange, stakeUpdate := stake.Tasks[0], stake.Tasks[1]
+	require.Equal(t, "sendSignerChangeToHeimdall", signerChange.Name)
+	require.Equal(t, uint64(0), signerChange.Successes)
+	require.Equal(t, uint64(1), signerChange.Retries)
+	require.Equal(t, uint64(1), signerChange.Failures)
+	require.NotNil(t, signerChange.LastRetry)
+	require.Equal(t, "failure", signerChange.LastError)
+
+	require.Equal(t, "sendStakeUpdateToHeimdall", stakeUpdate.Name)
+	require.Equal(t, uint64(1), stakeUpdate.Successes)
+	require.Nil(t, stakeUpdate.LastFailure)
+
+	require.Nil(t, statuses[0].LastSuccess)
+}
+
+func TestConnector_TaskCounts(t *testing.T) {
+	qc := queue.NewLevelDBQueueConnector(newTestDB(t))
+
+	eta := time.Now().Add(time.Hour)
+	for _, signature := range []*tasks.Signature{
+		{Name: "sendTask", Args: []tasks.Arg{{Type: "string", Value: "a"}}},
+		{Name: "sendTask", Args: []tasks.Arg{{Type: "string", Value: "b"}}},
+		{Name: "sendTask", Args: []tasks.Arg{{Type: "string", Value: "c"}}, ETA: &eta},
+	} {
+		_, err := qc.Server.SendTask(signature)
+		require.NoError(t, err)
+	}
+
+	pending, delayed, err := qc.TaskCounts()
+	require.NoError(t, err)
+	require.Equal(t, 2, pending)
+	require.Equal(t, 1, delayed)
+}
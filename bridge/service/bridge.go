@@ -24,12 +24,14 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/0xPolygon/heimdall-v2/app"
+	"github.com/0xPolygon/heimdall-v2/bridge/api"
 	"github.com/0xPolygon/heimdall-v2/bridge/broadcaster"
 	"github.com/0xPolygon/heimdall-v2/bridge/listener"
 	"github.com/0xPolygon/heimdall-v2/bridge/processor"
 	"github.com/0xPolygon/heimdall-v2/bridge/queue"
 	"github.com/0xPolygon/heimdall-v2/bridge/util"
 	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 	clerkTypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
 	milestoneTypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
@@ -75,7 +77,8 @@ func AdjustDBValue(cmd *cobra.Command) {
 }
 
 // StartWithCtx starts the bridge runtime as a side service of heimdalld and shuts down gracefully.
-func StartWithCtx(ctx context.Context, clientCtx client.Context) error {
+// The side tx configurator tells the side msgs apart when the bridge batches its txs.
+func StartWithCtx(ctx context.Context, clientCtx client.Context, sideTxCfg sidetxs.SideTxConfigurator) error {
 	// set up the codec and registry
 	cdc, err := makeCodec()
 	if err != nil {
@@ -83,8 +86,14 @@ func StartWithCtx(ctx context.Context, clientCtx client.Context) error {
 	}
 	clientCtx = attachCodecIfMissing(clientCtx, cdc)
 
+	bridgeDB := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
 	// setup queue and CometBFT RPC
-	qc := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	qc, err := queue.NewConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL, bridgeDB)
+	if err != nil {
+		logger().Error("Bridge: error creating the queue connector", "err", err)
+		return err
+	}
 
 	httpClient, err := createAndStartRPC(helper.GetConfig().CometBFTRPCUrl)
 	if err != nil {
@@ -121,6 +130,7 @@ func StartWithCtx(ctx context.Context, clientCtx client.Context) error {
 
 	// wire bridge services: register tasks before starting worker to avoid race
 	txBroadcaster := broadcaster.NewTxBroadcaster(cdc, ctx, clientCtx, nil)
+	txBroadcaster.EnableBatching(ctx, sideTxCfg, helper.GetConfig().BridgeBatchSize, helper.GetConfig().BridgeBatchWindow)
 	listenerService := listener.NewListenerService(cdc, qc, httpClient)
 	processorService := processor.NewProcessorService(cdc, qc, httpClient, txBroadcaster)
 	processorService.RegisterTasks()
@@ -131,6 +141,10 @@ func StartWithCtx(ctx context.Context, clientCtx client.Context) error {
 		processorService,
 	}
 
+	if addr := helper.GetConfig().BridgeAPIAddr; addr != "" {
+		services = append(services, api.NewServer(addr, qc, bridgeDB, cdc, listenerService.RootChainListener()))
+	}
+
 	// run services and handle a graceful shutdown
 	earlyReturn = false
 	return runServices(ctx, services, httpClient, qc)
@@ -270,10 +284,12 @@ func closeBridgeResources() {
 	util.CloseBridgeDBInstance()
 	// mutator-disable-next-line statement-deletion shutdown cleanup
 	helper.CloseBorChainClients()
+	// mutator-disable-next-line statement-deletion shutdown cleanup
+	helper.CloseMainChainClients()
 }
 
 // stopBridge tears down the bridge services, the comet client, the DB, and the
-// Bor failover clients. Called by runServices's shutdown controller once the
+// Bor and main chain failover clients. Called by runServices's shutdown controller once the
 // group context is cancelled.
 func stopBridge(services []common.Service, httpClient stopper, qc workerStopper, cleanup func()) error {
 	defer cleanup()
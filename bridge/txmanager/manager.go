@@ -0,0 +1,471 @@
+// Package txmanager sends the bridge txs to the main chain, and keeps them moving: the pending txs are tracked
+// in the bridge storage, replaced with higher fees when stuck, and broadcast again when dropped by the node.
+package txmanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+const (
+	// monitorInterval is the interval at which the pending txs are checked, about one main chain block
+	monitorInterval = 12 * time.Second
+
+	// feeBumpPercent is the fee increase of a replacement attempt, above the 10% required by the tx pools
+	feeBumpPercent = 20
+
+	// errAlreadyKnown is the error of the nodes receiving a tx already in their pool
+	errAlreadyKnown = "already known"
+)
+
+// Client is the main chain client used by the manager, implemented by ethclient.Client
+type Client interface {
+	helper.EthClient
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// SignerFn signs a tx for the given chain
+type SignerFn func(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+// Manager sends the txs of an account to the main chain, and follows them until they are mined
+type Manager struct {
+	client       Client
+	store        *Store
+	from         common.Address
+	signer       SignerFn
+	bumpInterval time.Duration
+	rpcTimeout   time.Duration
+	logger       log.Logger
+
+	// now returns the current time, overridden in tests
+	now func() time.Time
+
+	// mu serializes the sends and the monitoring, which share the account nonces
+	mu      sync.Mutex
+	chainID *big.Int
+}
+
+// NewManager creates a manager sending the txs of the given account, signed with the given signer
+func NewManager(client Client, db *leveldb.DB, from common.Address, signer SignerFn, bumpInterval time.Duration) *Manager {
+	return &Manager{
+		client:       client,
+		store:        NewStore(db),
+		from:         from,
+		signer:       signer,
+		bumpInterval: bumpInterval,
+		rpcTimeout:   helper.GetConfig().EthRPCTimeout,
+		logger:       helper.Logger.With("module", "bridge/txmanager"),
+		now:          time.Now,
+	}
+}
+
+// NewKeyedManager creates a manager sending the txs of the validator account, signed with the validator key,
+// locally or by the remote signer
+func NewKeyedManager(client Client, db *leveldb.DB) (*Manager, error) {
+	validatorSigner := helper.GetSigner()
+	if validatorSigner == nil {
+		return nil, errors.New("validator signer not initialized")
+	}
+
+	signer := func(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+		return helper.SignEthTx(validatorSigner, tx, chainID)
+	}
+
+	return NewManager(client, db, helper.SignerAddress(validatorSigner), signer, helper.GetConfig().MainChainTxBumpInterval), nil
+}
+
+// Start monitors the pending txs until the context is done
+func (m *Manager) Start(ctx context.Context) {
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Monitor(ctx); err != nil {
+			m.logger.Error("Error while monitoring main chain txs", "error", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			m.logger.Info("Main chain tx monitoring stopped")
+			return
+		}
+	}
+}
+
+// Send sends a tx calling the given contract with the given data. A pending tx with the same destination and data
+// is returned instead of being sent again, so that retried submissions don't spend several nonces.
+func (m *Manager) Send(ctx context.Context, label string, to common.Address, data []byte) (*Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending, err := m.store.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pending {
+		if pending[i].To == to && bytes.Equal(pending[i].Data, data) {
+			m.logger.Info("Main chain tx already pending", "label", label, "nonce", pending[i].Nonce, "txHash", pending[i].LastAttempt().Hash)
+			return &pending[i], nil
+		}
+	}
+
+	nonce, err := m.nextNonce(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (uint64, error) {
+		return m.client.EstimateGas(callCtx, ethereum.CallMsg{From: m.from, To: &to, Data: data})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate gas: %w", err)
+	}
+
+	gasFeeCap, gasTipCap, err := m.marketFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.now().UTC()
+	tx := &Tx{
+		Label:     label,
+		Nonce:     nonce,
+		To:        to,
+		Data:      data,
+		GasLimit:  gasLimit,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := m.sendAttempt(ctx, tx, gasFeeCap, gasTipCap); err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Sent main chain tx", "label", label, "nonce", nonce, "txHash", tx.LastAttempt().Hash,
+		"gasFeeCap", gasFeeCap, "gasTipCap", gasTipCap)
+
+	return tx, nil
+}
+
+// Monitor checks the pending txs once: the mined ones are finished with their receipt, the ones whose nonce
+// was used by another tx are finished as replaced, the stuck ones are replaced with higher fees, and the
+// dropped ones are broadcast again.
+func (m *Manager) Monitor(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending, err := m.store.Pending()
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	// fetched before the receipts, so that a nonce used by one of our attempts always comes with its receipt
+	minedNonce, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (uint64, error) {
+		return m.client.NonceAt(callCtx, m.from, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch the mined nonce: %w", err)
+	}
+
+	for i := range pending {
+		if err := m.monitorTx(ctx, &pending[i], minedNonce); err != nil {
+			m.logger.Error("Error while monitoring main chain tx", "label", pending[i].Label, "nonce", pending[i].Nonce, "error", err)
+		}
+	}
+
+	return m.store.Prune()
+}
+
+func (m *Manager) monitorTx(ctx context.Context, tx *Tx, minedNonce uint64) error {
+	receipt, err := m.findReceipt(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case receipt != nil:
+		return m.finish(tx, receipt)
+	case minedNonce > tx.Nonce:
+		tx.Status = StatusReplaced
+		tx.UpdatedAt = m.now().UTC()
+		m.logger.Warn("Main chain tx nonce used by another tx", "label", tx.Label, "nonce", tx.Nonce)
+
+		return m.store.Put(*tx)
+	case m.now().Sub(tx.BroadcastAt) >= m.bumpInterval:
+		return m.bump(ctx, tx)
+	}
+
+	_, err = callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (*types.Transaction, error) {
+		knownTx, _, err := m.client.TransactionByHash(callCtx, tx.LastAttempt().Hash)
+		return knownTx, err
+	})
+	if errors.Is(err, ethereum.NotFound) {
+		tx.Drops++
+		m.logger.Warn("Main chain tx dropped, broadcasting it again", "label", tx.Label, "nonce", tx.Nonce, "txHash", tx.LastAttempt().Hash)
+
+		return m.rebroadcast(ctx, tx)
+	}
+
+	return err
+}
+
+// findReceipt returns the receipt of the mined attempt of the tx, nil when none is mined
+func (m *Manager) findReceipt(ctx context.Context, tx *Tx) (*types.Receipt, error) {
+	for i := len(tx.Attempts) - 1; i >= 0; i-- {
+		receipt, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (*types.Receipt, error) {
+			return m.client.TransactionReceipt(callCtx, tx.Attempts[i].Hash)
+		})
+		switch {
+		case err == nil:
+			return receipt, nil
+		case !errors.Is(err, ethereum.NotFound):
+			return nil, fmt.Errorf("unable to fetch the receipt of %s: %w", tx.Attempts[i].Hash, err)
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *Manager) finish(tx *Tx, receipt *types.Receipt) error {
+	tx.Status = StatusConfirmed
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tx.Status = StatusReverted
+	}
+
+	tx.MinedHash = &receipt.TxHash
+	tx.BlockNumber = receipt.BlockNumber.Uint64()
+	tx.GasUsed = receipt.GasUsed
+	tx.UpdatedAt = m.now().UTC()
+
+	m.logger.Info("Main chain tx mined", "label", tx.Label, "nonce", tx.Nonce, "status", tx.Status,
+		"txHash", receipt.TxHash, "blockNumber", tx.BlockNumber, "attempts", len(tx.Attempts))
+
+	return m.store.Put(*tx)
+}
+
+// bump replaces the tx with higher fees, following the market but at least feeBumpPercent above the last
+// attempt, bounded by the configured gas caps. Once they are reached, the last attempt is broadcast again.
+func (m *Manager) bump(ctx context.Context, tx *Tx) error {
+	gasFeeCap, gasTipCap, err := m.marketFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	last := tx.LastAttempt()
+	minGasFeeCap, minGasTipCap := bumpFee(last.GasFeeCap), bumpFee(last.GasTipCap)
+	gasFeeCap, gasTipCap = bigMax(gasFeeCap, minGasFeeCap), bigMax(gasTipCap, minGasTipCap)
+
+	maxGasFeeCap, maxGasTipCap := helper.ConfiguredGasCaps()
+	gasFeeCap = bigMin(gasFeeCap, big.NewInt(maxGasFeeCap))
+	gasTipCap = bigMin(gasTipCap, big.NewInt(maxGasTipCap), gasFeeCap)
+
+	if gasFeeCap.Cmp(minGasFeeCap) < 0 || gasTipCap.Cmp(minGasTipCap) < 0 {
+		m.logger.Warn("Main chain tx stuck at the configured gas caps, broadcasting it again", "label", tx.Label, "nonce", tx.Nonce,
+			"gasFeeCap", last.GasFeeCap, "gasTipCap", last.GasTipCap)
+
+		return m.rebroadcast(ctx, tx)
+	}
+
+	if err := m.sendAttempt(ctx, tx, gasFeeCap, gasTipCap); err != nil {
+		return err
+	}
+
+	m.logger.Info("Replaced stuck main chain tx", "label", tx.Label, "nonce", tx.Nonce, "txHash", tx.LastAttempt().Hash,
+		"replacedTxHash", last.Hash, "gasFeeCap", gasFeeCap, "gasTipCap", gasTipCap)
+
+	return nil
+}
+
+// sendAttempt signs and broadcasts a new attempt of the tx. The attempt is stored before being broadcast, so that
+// it is tracked even if the bridge stops in between, and removed if the node refuses it.
+func (m *Manager) sendAttempt(ctx context.Context, tx *Tx, gasFeeCap, gasTipCap *big.Int) error {
+	chainID, err := m.getChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	to := tx.To
+	signedTx, err := m.signer(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     tx.Nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       tx.GasLimit,
+		To:        &to,
+		Data:      tx.Data,
+	}), chainID)
+	if err != nil {
+		return fmt.Errorf("unable to sign tx: %w", err)
+	}
+
+	rawTx, err := signedTx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	now := m.now().UTC()
+	updated := *tx
+	updated.Attempts = append(slices.Clone(tx.Attempts), Attempt{
+		Hash:      signedTx.Hash(),
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		SentAt:    now,
+	})
+	updated.RawTx = rawTx
+	updated.BroadcastAt = now
+	updated.UpdatedAt = now
+
+	if err := m.store.Put(updated); err != nil {
+		return err
+	}
+
+	if err := m.broadcast(ctx, signedTx); err != nil {
+		if len(tx.Attempts) == 0 {
+			err = errors.Join(err, m.store.Delete(tx.Nonce))
+		} else {
+			err = errors.Join(err, m.store.Put(*tx))
+		}
+
+		return err
+	}
+
+	*tx = updated
+
+	return nil
+}
+
+// rebroadcast broadcasts the last attempt of the tx again
+func (m *Manager) rebroadcast(ctx context.Context, tx *Tx) error {
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(tx.RawTx); err != nil {
+		return fmt.Errorf("malformed raw tx: %w", err)
+	}
+
+	tx.BroadcastAt = m.now().UTC()
+	tx.UpdatedAt = tx.BroadcastAt
+	if err := m.store.Put(*tx); err != nil {
+		return err
+	}
+
+	return m.broadcast(ctx, signedTx)
+}
+
+func (m *Manager) broadcast(ctx context.Context, signedTx *types.Transaction) error {
+	_, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (struct{}, error) {
+		return struct{}{}, m.client.SendTransaction(callCtx, signedTx)
+	})
+	if err != nil && !strings.Contains(err.Error(), errAlreadyKnown) {
+		return fmt.Errorf("unable to broadcast tx %s: %w", signedTx.Hash(), err)
+	}
+
+	return nil
+}
+
+// nextNonce returns the nonce of a new tx, after the pending ones of the node and of the manager
+func (m *Manager) nextNonce(ctx context.Context, pending []Tx) (uint64, error) {
+	nonce, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (uint64, error) {
+		return m.client.PendingNonceAt(callCtx, m.from)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch the pending nonce: %w", err)
+	}
+
+	for _, tx := range pending {
+		if tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+
+	return nonce, nil
+}
+
+// marketFees returns the gas caps of a new tx at the current base fee and suggested tip
+func (m *Manager) marketFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	latestBlock, err := callWithTimeout(ctx, m.rpcTimeout, func(callCtx context.Context) (*types.Block, error) {
+		return m.client.BlockByNumber(callCtx, nil)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch the latest block: %w", err)
+	}
+
+	suggestedTipCap, err := callWithTimeout(ctx, m.rpcTimeout, m.client.SuggestGasTipCap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch the suggested gas tip cap: %w", err)
+	}
+
+	return helper.CalculateEIP1559Caps(latestBlock.BaseFee(), suggestedTipCap)
+}
+
+func (m *Manager) getChainID(ctx context.Context) (*big.Int, error) {
+	if m.chainID != nil {
+		return m.chainID, nil
+	}
+
+	chainID, err := callWithTimeout(ctx, m.rpcTimeout, m.client.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the chain id: %w", err)
+	}
+
+	m.chainID = chainID
+
+	return chainID, nil
+}
+
+// bumpFee returns the fee increased by feeBumpPercent, rounded up
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+feeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+
+	return b
+}
+
+func bigMin(values ...*big.Int) *big.Int {
+	minValue := values[0]
+	for _, value := range values[1:] {
+		if value.Cmp(minValue) < 0 {
+			minValue = value
+		}
+	}
+
+	return minValue
+}
+
+func callWithTimeout[T any](parent context.Context, timeout time.Duration, fn func(context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		timeout = helper.DefaultEthRPCTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	return fn(ctx)
+}
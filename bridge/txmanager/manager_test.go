@@ -0,0 +1,334 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+const gwei = int64(1_000_000_000)
+
+var testContract = common.HexToAddress("0x86e4dc95c7fbdbf52e33d563bbdb00823894c287")
+
+// fakeClient is a main chain client keeping the sent txs in memory
+type fakeClient struct {
+	mu sync.Mutex
+
+	baseFee      *big.Int
+	tipCap       *big.Int
+	pendingNonce uint64
+	minedNonce   uint64
+	sendErr      error
+
+	sent     []*types.Transaction
+	known    map[common.Hash]bool
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		baseFee:  big.NewInt(10 * gwei),
+		tipCap:   big.NewInt(gwei),
+		known:    make(map[common.Hash]bool),
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+}
+
+func (c *fakeClient) BlockByNumber(_ context.Context, _ *big.Int) (*types.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(100), BaseFee: c.baseFee}), nil
+}
+
+func (c *fakeClient) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	return c.tipCap, nil
+}
+
+func (c *fakeClient) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *fakeClient) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return 300000, nil
+}
+
+func (c *fakeClient) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (c *fakeClient) NonceAt(_ context.Context, _ common.Address, _ *big.Int) (uint64, error) {
+	return c.minedNonce, nil
+}
+
+func (c *fakeClient) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sendErr != nil {
+		return c.sendErr
+	}
+
+	c.sent = append(c.sent, tx)
+	c.known[tx.Hash()] = true
+
+	return nil
+}
+
+func (c *fakeClient) TransactionByHash(_ context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.known[hash] {
+		return nil, false, ethereum.NotFound
+	}
+
+	return nil, true, nil
+}
+
+func (c *fakeClient) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	receipt, ok := c.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return receipt, nil
+}
+
+func (c *fakeClient) mine(hash common.Hash, status uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.receipts[hash] = &types.Receipt{TxHash: hash, Status: status, BlockNumber: big.NewInt(101), GasUsed: 250000}
+}
+
+func newTestManager(t *testing.T) (*Manager, *fakeClient, *time.Time) {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signer := func(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+		return types.SignTx(tx, types.LatestSignerForChainID(chainID), privateKey)
+	}
+
+	client := newFakeClient()
+	manager := NewManager(client, db, crypto.PubkeyToAddress(privateKey.PublicKey), signer, time.Minute)
+
+	now := time.Unix(1700000000, 0)
+	manager.now = func() time.Time { return now }
+
+	return manager, client, &now
+}
+
+func TestManager_Send(t *testing.T) {
+	manager, client, _ := newTestManager(t)
+	client.pendingNonce = 7
+
+	tx, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), tx.Nonce)
+	require.Equal(t, StatusPending, tx.Status)
+	require.Len(t, tx.Attempts, 1)
+	require.Len(t, client.sent, 1)
+
+	// (base fee * 2) + tip
+	sent := client.sent[0]
+	require.Equal(t, tx.LastAttempt().Hash, sent.Hash())
+	require.Equal(t, big.NewInt(21*gwei), sent.GasFeeCap())
+	require.Equal(t, big.NewInt(gwei), sent.GasTipCap())
+	require.Equal(t, uint64(300000), sent.Gas())
+
+	// a retried submission returns the pending tx
+	again, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+	require.Equal(t, tx.LastAttempt().Hash, again.LastAttempt().Hash)
+	require.Len(t, client.sent, 1)
+
+	// a new tx gets the next nonce, even if the node doesn't report the pending one
+	next, err := manager.Send(context.Background(), "checkpoint 257-512", testContract, []byte{0x02})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), next.Nonce)
+
+	txs, err := manager.store.Pending()
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+}
+
+func TestManager_SendRefused(t *testing.T) {
+	manager, client, _ := newTestManager(t)
+	client.sendErr = errors.New("insufficient funds for gas * price + value")
+
+	_, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.ErrorContains(t, err, "insufficient funds")
+
+	txs, err := manager.store.List()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// a tx already in the node pool is tracked
+	client.sendErr = errors.New("already known")
+	_, err = manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+}
+
+func TestManager_MonitorMined(t *testing.T) {
+	manager, client, _ := newTestManager(t)
+
+	confirmed, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+	reverted, err := manager.Send(context.Background(), "checkpoint 257-512", testContract, []byte{0x02})
+	require.NoError(t, err)
+
+	client.mine(confirmed.LastAttempt().Hash, types.ReceiptStatusSuccessful)
+	client.mine(reverted.LastAttempt().Hash, types.ReceiptStatusFailed)
+	client.minedNonce = 2
+
+	require.NoError(t, manager.Monitor(context.Background()))
+
+	tx, err := manager.store.Get(confirmed.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, StatusConfirmed, tx.Status)
+	require.Equal(t, confirmed.LastAttempt().Hash, *tx.MinedHash)
+	require.Equal(t, uint64(101), tx.BlockNumber)
+	require.Equal(t, uint64(250000), tx.GasUsed)
+
+	tx, err = manager.store.Get(reverted.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, StatusReverted, tx.Status)
+}
+
+func TestManager_MonitorBump(t *testing.T) {
+	manager, client, now := newTestManager(t)
+
+	sent, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+
+	// not stuck yet
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, manager.Monitor(context.Background()))
+	require.Len(t, client.sent, 1)
+
+	// stuck: replaced with at least 20% higher fees, following the base fee
+	*now = now.Add(30 * time.Second)
+	client.baseFee = big.NewInt(20 * gwei)
+	require.NoError(t, manager.Monitor(context.Background()))
+	require.Len(t, client.sent, 2)
+
+	tx, err := manager.store.Get(sent.Nonce)
+	require.NoError(t, err)
+	require.Len(t, tx.Attempts, 2)
+	require.Equal(t, big.NewInt(41*gwei), tx.LastAttempt().GasFeeCap)
+	require.Equal(t, big.NewInt(1200000000), tx.LastAttempt().GasTipCap)
+	require.Equal(t, sent.Nonce, client.sent[1].Nonce())
+
+	// the first attempt is mined after all
+	client.mine(sent.LastAttempt().Hash, types.ReceiptStatusSuccessful)
+	client.minedNonce = 1
+	require.NoError(t, manager.Monitor(context.Background()))
+
+	tx, err = manager.store.Get(sent.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, StatusConfirmed, tx.Status)
+	require.Equal(t, sent.LastAttempt().Hash, *tx.MinedHash)
+}
+
+func TestManager_MonitorBumpAtCap(t *testing.T) {
+	manager, client, now := newTestManager(t)
+
+	// (base fee * 2) + tip is above the configured gas fee cap
+	client.baseFee = big.NewInt(helper.DefaultMainChainGasFeeCap)
+
+	sent, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(helper.DefaultMainChainGasFeeCap), sent.LastAttempt().GasFeeCap)
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, manager.Monitor(context.Background()))
+
+	// broadcast again, without a new attempt
+	require.Len(t, client.sent, 2)
+	require.Equal(t, client.sent[0].Hash(), client.sent[1].Hash())
+
+	tx, err := manager.store.Get(sent.Nonce)
+	require.NoError(t, err)
+	require.Len(t, tx.Attempts, 1)
+	require.Equal(t, now.UTC(), tx.BroadcastAt)
+}
+
+func TestManager_MonitorDropped(t *testing.T) {
+	manager, client, _ := newTestManager(t)
+
+	sent, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+
+	// still in the node pool
+	require.NoError(t, manager.Monitor(context.Background()))
+	require.Len(t, client.sent, 1)
+
+	client.known = make(map[common.Hash]bool)
+	require.NoError(t, manager.Monitor(context.Background()))
+	require.Len(t, client.sent, 2)
+	require.Equal(t, sent.LastAttempt().Hash, client.sent[1].Hash())
+
+	tx, err := manager.store.Get(sent.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, tx.Status)
+	require.Equal(t, 1, tx.Drops)
+}
+
+func TestManager_MonitorReplaced(t *testing.T) {
+	manager, client, _ := newTestManager(t)
+
+	sent, err := manager.Send(context.Background(), "checkpoint 1-256", testContract, []byte{0x01})
+	require.NoError(t, err)
+
+	// the nonce is used by a tx sent by someone else with the same key
+	client.minedNonce = 1
+	require.NoError(t, manager.Monitor(context.Background()))
+
+	tx, err := manager.store.Get(sent.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, StatusReplaced, tx.Status)
+	require.Nil(t, tx.MinedHash)
+}
+
+func TestStore_Prune(t *testing.T) {
+	manager, _, _ := newTestManager(t)
+	store := manager.store
+
+	for nonce := uint64(0); nonce < maxFinishedTxs+5; nonce++ {
+		require.NoError(t, store.Put(Tx{Nonce: nonce, Status: StatusConfirmed}))
+	}
+	require.NoError(t, store.Put(Tx{Nonce: maxFinishedTxs + 5, Status: StatusPending}))
+
+	require.NoError(t, store.Prune())
+
+	txs, err := GetTxs(store.db)
+	require.NoError(t, err)
+	require.Len(t, txs, maxFinishedTxs+1)
+	require.Equal(t, uint64(5), txs[0].Nonce)
+
+	_, err = store.Get(0)
+	require.ErrorIs(t, err, ErrTxNotFound)
+}
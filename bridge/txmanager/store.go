@@ -0,0 +1,190 @@
+package txmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// txKeyPrefix prefixes the managed txs, keyed by zero-padded nonce so that they are iterated in nonce order
+	txKeyPrefix = "l1tx-"
+
+	// maxFinishedTxs is the number of finished txs kept in the store, to report their outcome
+	maxFinishedTxs = 100
+)
+
+// ErrTxNotFound is returned when a managed tx doesn't exist
+var ErrTxNotFound = errors.New("managed tx not found")
+
+// Status is the state of a managed tx
+type Status string
+
+const (
+	// StatusPending is the status of a tx not mined yet
+	StatusPending Status = "pending"
+	// StatusConfirmed is the status of a tx mined successfully, in any of its attempts
+	StatusConfirmed Status = "confirmed"
+	// StatusReverted is the status of a tx mined, in any of its attempts, but reverted
+	StatusReverted Status = "reverted"
+	// StatusReplaced is the status of a tx whose nonce was used by a tx not sent by the manager
+	StatusReplaced Status = "replaced"
+)
+
+// Attempt is a signed version of a managed tx, each one replacing the previous with higher fees
+type Attempt struct {
+	Hash      common.Hash `json:"hash"`
+	GasFeeCap *big.Int    `json:"gas_fee_cap"`
+	GasTipCap *big.Int    `json:"gas_tip_cap"`
+	SentAt    time.Time   `json:"sent_at"`
+}
+
+// Tx is a main chain tx sent by the manager, along with its attempts and its outcome
+type Tx struct {
+	Label    string         `json:"label"`
+	Nonce    uint64         `json:"nonce"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	GasLimit uint64         `json:"gas_limit"`
+	Status   Status         `json:"status"`
+	Attempts []Attempt      `json:"attempts"`
+	// RawTx is the last signed attempt, broadcast again when dropped by the node
+	RawTx hexutil.Bytes `json:"raw_tx"`
+	// BroadcastAt is the last time the tx was broadcast, either as a new attempt or again
+	BroadcastAt time.Time `json:"broadcast_at"`
+	// Drops counts the times the tx was dropped by the node and broadcast again
+	Drops int `json:"drops"`
+
+	MinedHash   *common.Hash `json:"mined_hash,omitempty"`
+	BlockNumber uint64       `json:"block_number,omitempty"`
+	GasUsed     uint64       `json:"gas_used,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LastAttempt returns the last attempt of the tx
+func (tx *Tx) LastAttempt() Attempt {
+	return tx.Attempts[len(tx.Attempts)-1]
+}
+
+// Store persists the managed txs in the bridge LevelDB
+type Store struct {
+	db *leveldb.DB
+}
+
+// NewStore creates a new managed tx store
+func NewStore(db *leveldb.DB) *Store {
+	return &Store{db: db}
+}
+
+// Put stores a managed tx, replacing the one with the same nonce
+func (s *Store) Put(tx Tx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %w", err)
+	}
+
+	return s.db.Put(txKey(tx.Nonce), value, nil)
+}
+
+// Get returns the managed tx with the given nonce
+func (s *Store) Get(nonce uint64) (*Tx, error) {
+	value, err := s.db.Get(txKey(nonce), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrTxNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	tx := new(Tx)
+	if err := json.Unmarshal(value, tx); err != nil {
+		return nil, fmt.Errorf("JSON unmarshal error: %w", err)
+	}
+
+	return tx, nil
+}
+
+// Delete removes the managed tx with the given nonce
+func (s *Store) Delete(nonce uint64) error {
+	return s.db.Delete(txKey(nonce), nil)
+}
+
+// List returns all the managed txs, in nonce order
+func (s *Store) List() ([]Tx, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(txKeyPrefix)), nil)
+	defer iter.Release()
+
+	txs := make([]Tx, 0)
+	for iter.Next() {
+		var tx Tx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, fmt.Errorf("JSON unmarshal error: %w", err)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// Pending returns the pending managed txs, in nonce order
+func (s *Store) Pending() ([]Tx, error) {
+	txs, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]Tx, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Status == StatusPending {
+			pending = append(pending, tx)
+		}
+	}
+
+	return pending, nil
+}
+
+// Prune removes the finished txs with the lowest nonces, keeping the last maxFinishedTxs of them
+func (s *Store) Prune() error {
+	txs, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	finished := make([]uint64, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Status != StatusPending {
+			finished = append(finished, tx.Nonce)
+		}
+	}
+
+	for len(finished) > maxFinishedTxs {
+		if err := s.Delete(finished[0]); err != nil {
+			return err
+		}
+		finished = finished[1:]
+	}
+
+	return nil
+}
+
+// GetTxs returns the txs managed with the given bridge storage, in nonce order
+func GetTxs(db *leveldb.DB) ([]Tx, error) {
+	return NewStore(db).List()
+}
+
+func txKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", txKeyPrefix, nonce))
+}
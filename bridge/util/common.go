@@ -23,7 +23,9 @@ import (
 	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 	clerktypes "github.com/0xPolygon/heimdall-v2/x/clerk/types"
+	milestonetypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
 	staketypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+	topuptypes "github.com/0xPolygon/heimdall-v2/x/topup/types"
 )
 
 type BridgeEvent string
@@ -35,6 +37,7 @@ const (
 	CheckpointParamsURL     = "/checkpoints/params"
 	CheckpointSignaturesURL = "/checkpoints/signatures/%v"
 	ChainManagerParamsURL   = "/chainmanager/params"
+	AddressMigrationsURL    = "/chainmanager/address-migrations"
 	ProposersURL            = "/stake/proposers/%v"
 	BufferedCheckpointURL   = "/checkpoints/buffer"
 	LatestCheckpointURL     = "/checkpoints/latest"
@@ -52,6 +55,8 @@ const (
 	TopupTxStatusURL        = "/topup/is-old-tx"
 	ClerkTxStatusURL        = "/clerk/is-old-tx"
 	ClerkEventRecordURL     = "/clerk/event-records/%d"
+	ClerkBorConsumedIDURL   = "/clerk/bor-consumed-record-id"
+	LatestMilestoneURL      = "/milestones/latest"
 
 	CometBFTUnconfirmedTxsURL      = "/unconfirmed_txs"
 	CometBFTUnconfirmedTxsCountURL = "/num_unconfirmed_txs"
@@ -351,6 +356,25 @@ func GetChainmanagerParams(cdc codec.Codec) (*chainmanagertypes.Params, error) {
 	return &params.Params, nil
 }
 
+// GetPendingAddressMigrations returns the root chain contract address migrations scheduled at future heights
+func GetPendingAddressMigrations(cdc codec.Codec) ([]chainmanagertypes.AddressMigration, error) {
+	logger := Logger()
+
+	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(AddressMigrationsURL))
+	if err != nil {
+		logger.Error("Error fetching pending address migrations", "err", err)
+		return nil, err
+	}
+
+	var migrations chainmanagertypes.QueryPendingAddressMigrationsResponse
+	if err = cdc.UnmarshalJSON(response, &migrations); err != nil {
+		logger.Error("Error unmarshalling pending address migrations", "url", AddressMigrationsURL, "err", err)
+		return nil, err
+	}
+
+	return migrations.Migrations, nil
+}
+
 // GetCheckpointParams return checkpoint params
 func GetCheckpointParams(cdc codec.Codec) (*checkpointTypes.Params, error) {
 	logger := Logger()
@@ -497,6 +521,105 @@ func GetClerkEventRecord(stateId int64, cdc codec.Codec) (*clerktypes.EventRecor
 	return &eventRecordResponse.Record, nil
 }
 
+// GetBorConsumedRecordID returns the ID of the last event record consumed by bor, as known by heimdall
+func GetBorConsumedRecordID(cdc codec.Codec) (uint64, error) {
+	logger := Logger()
+
+	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(ClerkBorConsumedIDURL))
+	if err != nil {
+		logger.Error("Error fetching bor consumed record id", "error", err)
+		return 0, err
+	}
+
+	var consumedResponse clerktypes.BorConsumedRecordIdResponse
+	if err = cdc.UnmarshalJSON(response, &consumedResponse); err != nil {
+		logger.Error("Error unmarshalling bor consumed record id", "error", err)
+		return 0, err
+	}
+
+	return consumedResponse.RecordId, nil
+}
+
+// GetLatestMilestone returns the latest milestone
+func GetLatestMilestone(cdc codec.Codec) (*milestonetypes.Milestone, error) {
+	logger := Logger()
+
+	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(LatestMilestoneURL))
+	if err != nil {
+		logger.Error("Error fetching latest milestone", "error", err)
+		return nil, err
+	}
+
+	var milestoneResponse milestonetypes.QueryLatestMilestoneResponse
+	if err = cdc.UnmarshalJSON(response, &milestoneResponse); err != nil {
+		logger.Error("Error unmarshalling latest milestone", "error", err)
+		return nil, err
+	}
+
+	return &milestoneResponse.Milestone, nil
+}
+
+// IsOldTx checks if the transaction of the given event type was already processed by heimdall
+func IsOldTx(cdc codec.Codec, txHash string, logIndex uint64, eventType BridgeEvent) (bool, error) {
+	logger := Logger()
+
+	// define the endpoint based on the type of event
+	var endpoint string
+
+	switch eventType {
+	case StakingEvent:
+		endpoint = helper.GetHeimdallServerEndpoint(StakingTxStatusURL)
+	case TopupEvent:
+		endpoint = helper.GetHeimdallServerEndpoint(TopupTxStatusURL)
+	case ClerkEvent:
+		endpoint = helper.GetHeimdallServerEndpoint(ClerkTxStatusURL)
+	default:
+		return false, fmt.Errorf("invalid event type %q", eventType)
+	}
+
+	url, err := CreateURLWithQuery(endpoint, map[string]interface{}{
+		"tx_hash":   txHash,
+		"log_index": logIndex,
+	})
+	if err != nil {
+		logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
+		return false, err
+	}
+
+	res, err := helper.FetchFromAPI(url)
+	if err != nil {
+		logger.Error("Error fetching tx status", "url", url, "error", err)
+		return false, err
+	}
+
+	switch eventType {
+	case StakingEvent:
+		var response staketypes.QueryStakeIsOldTxResponse
+		if err = cdc.UnmarshalJSON(res, &response); err != nil {
+			logger.Error("Error unmarshalling tx status", "url", url, "error", err)
+			return false, err
+		}
+
+		return response.IsOld, nil
+	case TopupEvent:
+		var response topuptypes.QueryIsTopupTxOldResponse
+		if err = cdc.UnmarshalJSON(res, &response); err != nil {
+			logger.Error("Error unmarshalling tx status", "url", url, "error", err)
+			return false, err
+		}
+
+		return response.IsOld, nil
+	default:
+		var response clerktypes.IsClerkTxOldResponse
+		if err = cdc.UnmarshalJSON(res, &response); err != nil {
+			logger.Error("Error unmarshalling tx status", "url", url, "error", err)
+			return false, err
+		}
+
+		return response.IsOld, nil
+	}
+}
+
 func GetUnconfirmedTxnCount(event interface{}) int {
 	logger := Logger()
 
@@ -40,6 +40,7 @@ func TestURLConstants_NotEmpty(t *testing.T) {
 		"CheckpointParamsURL":     util.CheckpointParamsURL,
 		"CheckpointSignaturesURL": util.CheckpointSignaturesURL,
 		"ChainManagerParamsURL":   util.ChainManagerParamsURL,
+		"AddressMigrationsURL":    util.AddressMigrationsURL,
 		"ProposersURL":            util.ProposersURL,
 		"BufferedCheckpointURL":   util.BufferedCheckpointURL,
 		"LatestCheckpointURL":     util.LatestCheckpointURL,
@@ -191,6 +192,7 @@ func TestURLConstants_UniquePaths(t *testing.T) {
 		util.LastNoAckURL,
 		util.CheckpointParamsURL,
 		util.ChainManagerParamsURL,
+		util.AddressMigrationsURL,
 		util.BufferedCheckpointURL,
 		util.LatestCheckpointURL,
 		util.CountCheckpointURL,
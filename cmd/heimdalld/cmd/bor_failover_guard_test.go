@@ -96,12 +96,12 @@ func TestMustApplyBorFailoverBPGuard(t *testing.T) {
 	})
 }
 
-func TestRegisterBorChainClientCleanup(t *testing.T) {
+func TestRegisterChainClientCleanup(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	var g errgroup.Group
 	var closed atomic.Bool
 
-	registerBorChainClientCleanup(ctx, &g, func() {
+	registerChainClientCleanup(ctx, &g, func() {
 		closed.Store(true)
 	})
 
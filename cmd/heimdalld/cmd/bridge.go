@@ -0,0 +1,458 @@
+package heimdalld
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/heimdall-v2/bridge/api"
+	"github.com/0xPolygon/heimdall-v2/bridge/listener"
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+const (
+	flagAll       = "all"
+	flagOutput    = "output"
+	flagFromBlock = "from-block"
+	flagToBlock   = "to-block"
+	flagEvents    = "events"
+	flagDryRun    = "dry-run"
+)
+
+// BridgeCmd returns the bridge command, to inspect and operate the bridge of a running node
+func BridgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge",
+		Short: "Inspect and operate the bridge of a running node",
+		Long: `Inspect and operate the bridge of a running node through its API,
+listening on the bridge_api_addr of app.toml (override with --bridge_api_addr).`,
+	}
+
+	cmd.AddCommand(
+		bridgeStatusCmd(),
+		bridgeReplayCmd(),
+		bridgeDLQCmd(),
+	)
+
+	return cmd
+}
+
+func bridgeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the state of the bridge: root chain progress, queue, processors and proposer status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			status, err := bridgeAPIClient().Status()
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case "json":
+				return printJSON(cmd.OutOrStdout(), status)
+			case "text":
+				return printBridgeStatus(cmd.OutOrStdout(), status)
+			default:
+				return fmt.Errorf("invalid output %q, expected text or json", output)
+			}
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// printBridgeStatus renders the bridge status as text
+func printBridgeStatus(out io.Writer, status *api.Status) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "ROOT CHAIN")
+	_, _ = fmt.Fprintf(w, "  Last processed block:\t%s\n", formatOptional(status.RootChain.LastBlock))
+	eventTypes := make([]string, 0, len(status.RootChain.SelfHealCursors))
+	for eventType := range status.RootChain.SelfHealCursors {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	for _, eventType := range eventTypes {
+		_, _ = fmt.Fprintf(w, "  Self-heal cursor (%s):\t%d\n", eventType, status.RootChain.SelfHealCursors[eventType])
+	}
+	printStatusError(w, status.RootChain.Error)
+
+	_, _ = fmt.Fprintln(w, "\nQUEUE")
+	_, _ = fmt.Fprintf(w, "  Pending tasks:\t%s\n", formatOptional(status.Queue.Pending))
+	_, _ = fmt.Fprintf(w, "  Delayed tasks:\t%s\n", formatOptional(status.Queue.Delayed))
+	_, _ = fmt.Fprintf(w, "  Dead letters:\t%s\n", formatOptional(status.Queue.DeadLetters))
+	printStatusError(w, status.Queue.Error)
+
+	_, _ = fmt.Fprintln(w, "\nPROPOSER")
+	_, _ = fmt.Fprintf(w, "  Checkpoint proposer:\t%s\n", formatOptional(status.Proposer.CheckpointProposer))
+	_, _ = fmt.Fprintf(w, "  Current proposer:\t%s\n", formatOptional(status.Proposer.CurrentProposer))
+	printStatusError(w, status.Proposer.Error)
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(out, "\nPROCESSORS")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "  PROCESSOR\tTASK\tSUCCESSES\tRETRIES\tFAILURES\tLAST SUCCESS\tLAST FAILURE\tLAST ERROR")
+	for _, processor := range status.Processors {
+		for _, task := range processor.Tasks {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n",
+				processor.Name,
+				task.Name,
+				task.Successes,
+				task.Retries,
+				task.Failures,
+				formatTime(task.LastSuccess),
+				formatTime(task.LastFailure),
+				task.LastError,
+			)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(out, "\nMAIN CHAIN TXS")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "  NONCE\tLABEL\tSTATUS\tATTEMPTS\tDROPS\tGAS FEE CAP\tBLOCK\tHASH")
+	for _, tx := range status.MainChain.Txs {
+		block := "-"
+		if tx.BlockNumber != 0 {
+			block = fmt.Sprint(tx.BlockNumber)
+		}
+
+		_, _ = fmt.Fprintf(w, "  %d\t%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+			tx.Nonce,
+			tx.Label,
+			tx.Status,
+			tx.Attempts,
+			tx.Drops,
+			tx.GasFeeCap,
+			block,
+			tx.Hash,
+		)
+	}
+	printStatusError(w, status.MainChain.Error)
+
+	return w.Flush()
+}
+
+func printStatusError(w io.Writer, err string) {
+	if err != "" {
+		_, _ = fmt.Fprintf(w, "  Error:\t%s\n", err)
+	}
+}
+
+// formatOptional formats a value which may be unknown
+func formatOptional[T any](v *T) string {
+	if v == nil {
+		return "-"
+	}
+
+	return fmt.Sprint(*v)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func bridgeReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-ingest the root chain events of a finalized block range",
+		Long: `Re-ingest the root chain events of a finalized block range through the bridge listener,
+e.g. to backfill the events missed during an L1 RPC outage. Events already known to heimdall
+are skipped by the processors, and the last processed root chain block is left untouched.
+With --dry-run, the events are only listed along with whether heimdall already knows them.`,
+		Example: fmt.Sprintf("heimdalld bridge replay --%s 20000000 --%s 20001000 --%s StateSynced,StakeUpdate --%s",
+			flagFromBlock, flagToBlock, flagEvents, flagDryRun),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var (
+				req api.ReplayRequest
+				err error
+			)
+
+			if req.FromBlock, err = cmd.Flags().GetUint64(flagFromBlock); err != nil {
+				return err
+			}
+
+			if req.ToBlock, err = cmd.Flags().GetUint64(flagToBlock); err != nil {
+				return err
+			}
+
+			if req.Events, err = cmd.Flags().GetStringSlice(flagEvents); err != nil {
+				return err
+			}
+
+			if req.DryRun, err = cmd.Flags().GetBool(flagDryRun); err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output %q, expected text or json", output)
+			}
+
+			events, err := bridgeAPIClient().Replay(req)
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(cmd.OutOrStdout(), events)
+			}
+
+			return printReplayedEvents(cmd.OutOrStdout(), events, req.DryRun)
+		},
+	}
+
+	cmd.Flags().Uint64(flagFromBlock, 0, "First root chain block to replay")
+	cmd.Flags().Uint64(flagToBlock, 0, "Last root chain block to replay, which must be finalized")
+	cmd.Flags().StringSlice(flagEvents, nil, fmt.Sprintf("Events to replay, all by default (%s)", strings.Join(listener.RootChainEventNames(), ",")))
+	cmd.Flags().Bool(flagDryRun, false, "Only report which events are already known to heimdall, without replaying them")
+	cmd.Flags().StringP(flagOutput, "o", "text", "Output format (text|json)")
+
+	_ = cmd.MarkFlagRequired(flagFromBlock)
+	_ = cmd.MarkFlagRequired(flagToBlock)
+
+	return cmd
+}
+
+// printReplayedEvents renders the replayed events as text
+func printReplayedEvents(out io.Writer, events []listener.ReplayedEvent, dryRun bool) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	if dryRun {
+		_, _ = fmt.Fprintln(w, "BLOCK\tTX HASH\tLOG INDEX\tEVENT\tKNOWN")
+	} else {
+		_, _ = fmt.Fprintln(w, "BLOCK\tTX HASH\tLOG INDEX\tEVENT")
+	}
+
+	known := 0
+	for _, event := range events {
+		if !dryRun {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", event.BlockNumber, event.TxHash, event.LogIndex, event.Name)
+			continue
+		}
+
+		status := formatOptional(event.Known)
+		if event.Error != "" {
+			status = "error: " + event.Error
+		}
+
+		if event.Known != nil && *event.Known {
+			known++
+		}
+
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\n", event.BlockNumber, event.TxHash, event.LogIndex, event.Name, status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintf(out, "\nFound %d event(s), %d already known to heimdall\n", len(events), known)
+	} else {
+		_, _ = fmt.Fprintf(out, "\nReplayed %d event(s)\n", len(events))
+	}
+
+	return nil
+}
+
+func bridgeDLQCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dlq",
+		Short: "Inspect and replay the bridge tasks which failed after exhausting their retries",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "list",
+			Short: "List the failed tasks",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				deadLetters, err := bridgeAPIClient().ListDeadLetters()
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "ID\tTASK\tATTEMPTS\tFAILED AT\tERROR")
+				for _, deadLetter := range deadLetters {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+						deadLetter.ID,
+						deadLetter.TaskName,
+						deadLetter.Attempts,
+						deadLetter.FailedAt.Format(time.RFC3339),
+						deadLetter.Error,
+					)
+				}
+
+				return w.Flush()
+			},
+		},
+		&cobra.Command{
+			Use:   "show [id]",
+			Short: "Show a failed task along with its payload",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				deadLetter, err := bridgeAPIClient().GetDeadLetter(args[0])
+				if err != nil {
+					return err
+				}
+
+				return printJSON(cmd.OutOrStdout(), deadLetter)
+			},
+		},
+		bridgeDLQReplayCmd(),
+		bridgeDLQPurgeCmd(),
+	)
+
+	return cmd
+}
+
+func bridgeDLQReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [id]",
+		Short: "Re-enqueue a failed task, or all of them with --all",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+
+			id, err := idOrAll(args, all)
+			if err != nil {
+				return err
+			}
+
+			client := bridgeAPIClient()
+
+			if all {
+				count, err := client.ReplayDeadLetters()
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Replayed %d task(s)\n", count)
+
+				return nil
+			}
+
+			if err := client.ReplayDeadLetter(id); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Replayed task %s\n", id)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Replay all the failed tasks")
+
+	return cmd
+}
+
+func bridgeDLQPurgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge [id]",
+		Short: "Remove a failed task, or all of them with --all",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+
+			id, err := idOrAll(args, all)
+			if err != nil {
+				return err
+			}
+
+			client := bridgeAPIClient()
+
+			if all {
+				count, err := client.PurgeDeadLetters()
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Purged %d task(s)\n", count)
+
+				return nil
+			}
+
+			if err := client.DeleteDeadLetter(id); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Purged task %s\n", id)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Purge all the failed tasks")
+
+	return cmd
+}
+
+// idOrAll returns the id given as argument, ensuring that exactly one of the id and --all is set
+func idOrAll(args []string, all bool) (string, error) {
+	if all == (len(args) == 1) {
+		return "", errors.New("either a task id or --all is required")
+	}
+
+	if all {
+		return "", nil
+	}
+
+	return args[0], nil
+}
+
+// bridgeAPIClient returns the client of the bridge API configured in app.toml
+func bridgeAPIClient() *api.Client {
+	helper.InitHeimdallConfig("")
+
+	return api.NewClient(helper.GetConfig().BridgeAPIAddr)
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(out))
+
+	return err
+}
@@ -42,6 +42,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/console/prompt"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
@@ -185,7 +186,8 @@ func initRootCmd(
 		PostSetup: func(svrCtx *server.Context, clientCtx client.Context, ctx context.Context, g *errgroup.Group) error {
 			helper.InitHeimdallConfig("")
 			bridgeEnabled := viper.GetBool(helper.BridgeFlag)
-			registerBorChainClientCleanup(ctx, g, helper.CloseBorChainClients)
+			registerChainClientCleanup(ctx, g, helper.CloseBorChainClients)
+			registerChainClientCleanup(ctx, g, helper.CloseMainChainClients)
 
 			// wait for the rest server to start.
 			resultChan := make(chan string, 1)
@@ -247,7 +249,7 @@ func initRootCmd(
 			if bridgeEnabled {
 				bridge.AdjustDBValue(rootCmd)
 				g.Go(func() error {
-					return bridge.StartWithCtx(ctx, clientCtx)
+					return bridge.StartWithCtx(ctx, clientCtx, hApp.GetSideTxConfigurator())
 				})
 			}
 
@@ -270,9 +272,11 @@ func initRootCmd(
 		importKeyStore(),
 		generateValidatorKey(),
 		importValidatorKey(),
+		generateBlsKey(),
 		StakeCmd(),
 		ApproveCmd(),
 		PruneCmd(),
+		BridgeCmd(),
 		version.Cmd,
 	)
 
@@ -368,6 +372,16 @@ func AddCommandsWithStartCmdOptions(
 
 	startCmd := server.StartCmdWithOptions(startAppCreator, defaultNodeHome, opts)
 
+	// the remote signer must be serving before cometbft waits for it on priv_validator_laddr
+	startPreRunE := startCmd.PreRunE
+	startCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := startPreRunE(cmd, args); err != nil {
+			return err
+		}
+
+		return startSignerPV(cmd)
+	}
+
 	rootCmd.AddCommand(
 		startCmd,
 		cometCmd,
@@ -376,10 +390,10 @@ func AddCommandsWithStartCmdOptions(
 	)
 }
 
-func registerBorChainClientCleanup(ctx context.Context, g *errgroup.Group, closeBorClients func()) {
+func registerChainClientCleanup(ctx context.Context, g *errgroup.Group, closeClients func()) {
 	g.Go(func() error {
 		<-ctx.Done()
-		closeBorClients()
+		closeClients()
 		return nil
 	})
 }
@@ -635,6 +649,37 @@ func generateValidatorKey() *cobra.Command {
 	return cmd
 }
 
+// generateBlsKey generates the BLS key of a validator, to be registered with the register-bls-key stake tx
+func generateBlsKey() *cobra.Command {
+	return &cobra.Command{
+		Use:   "generate-bls-key <bls-key-file>",
+		Short: "Generate the BLS key of a validator in the given file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := os.Stat(args[0]); err == nil {
+				return fmt.Errorf("the BLS key file %s already exists", args[0])
+			}
+
+			secretKey, err := helper.GenerateBlsSecretKey()
+			if err != nil {
+				return err
+			}
+
+			if err := helper.SaveBlsKeyFile(args[0], secretKey); err != nil {
+				return err
+			}
+
+			pubKey, err := helper.BlsPubKeyFromSecretKey(secretKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("BLS key saved to %s, public key %s\n", args[0], hexutil.Encode(pubKey))
+			return nil
+		},
+	}
+}
+
 // importValidatorKey imports the validator private key from the given file path
 func importValidatorKey() *cobra.Command {
 	cdc := codec.NewLegacyAmino()
@@ -686,6 +731,11 @@ func showPrivateKeyCmd() *cobra.Command {
 			// init heimdall config
 			helper.InitHeimdallConfig("")
 
+			if helper.IsRemoteSigner() {
+				fmt.Println("The private key is held by the remote signer")
+				return
+			}
+
 			// get private and public keys
 			privKeyObject := helper.GetPrivKey()
 
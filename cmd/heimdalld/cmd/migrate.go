@@ -283,6 +283,13 @@ func performMigrations(genesisFileV1, chainId, genesisTime string, initialHeight
 	}
 	logger.Info(fmt.Sprintf("migrateMilestoneModule took %.2f minutes", time.Since(start).Minutes()))
 
+	// migrateHardforkModule
+	start = time.Now()
+	if err := migrateHardforkModule(genesisData); err != nil {
+		return nil, err
+	}
+	logger.Info(fmt.Sprintf("migrateHardforkModule took %.2f minutes", time.Since(start).Minutes()))
+
 	// migrateChainManagerModule
 	start = time.Now()
 	if err := migrateChainManagerModule(genesisData, chainId); err != nil {
@@ -488,6 +495,22 @@ func migrateMilestoneModule(genesisData map[string]interface{}) error {
 	return nil
 }
 
+// migrateHardforkModule adds genesis state for the hardfork module because it's not exported from heimdall-v1.
+// No hard fork height is stored, so the ones set in the code for the chain apply.
+func migrateHardforkModule(genesisData map[string]interface{}) error {
+	logger.Info("Migrating hardfork module...")
+
+	genesisData["app_state"].(map[string]interface{})["hardfork"] = map[string]interface{}{}
+
+	if err := utils.AddProperty(genesisData, "app_state.hardfork", "forks", []interface{}{}); err != nil {
+		return fmt.Errorf("failed to add forks to hardfork module: %w", err)
+	}
+
+	logger.Info("Hardfork module migration completed successfully")
+
+	return nil
+}
+
 // migrateTopupModule renames the tx_sequences field to topup_sequences.
 func migrateTopupModule(genesisData map[string]interface{}) error {
 	logger.Info("Migrating topup module...")
@@ -0,0 +1,77 @@
+package heimdalld
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/server"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+const (
+	// signerPVStateFile is the sign state of the consensus votes signed with the remote signer, in the data dir.
+	// It is kept apart from priv_validator_state.json, which is reset when cometbft generates a new file key.
+	signerPVStateFile = "remote_signer_state.json"
+
+	// signerPVReadWriteTimeout is the deadline of the connection dialed to cometbft, as set by cometbft itself
+	signerPVReadWriteTimeout = 5 * time.Second
+)
+
+// startSignerPV serves the consensus signatures of cometbft with the remote signer, when configured.
+// Cometbft listens on priv_validator_laddr for an external signer: the node dials it in-process, and signs
+// the proposals, votes and vote extensions with the remote signer, guarded by a local sign state.
+func startSignerPV(cmd *cobra.Command) error {
+	if !helper.IsRemoteSigner() {
+		return nil
+	}
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	cfg := serverCtx.Config
+
+	if cfg.PrivValidatorListenAddr == "" {
+		return errors.New("priv_validator_laddr must be set in config.toml when using a remote signer, for cometbft to sign the votes with it")
+	}
+
+	genesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id from the genesis file: %w", err)
+	}
+
+	pv, err := helper.NewSignerPV(helper.GetSigner(), filepath.Join(cfg.DBDir(), signerPVStateFile), cfg.PrivValidatorStateFile())
+	if err != nil {
+		return err
+	}
+
+	var dialer privval.SocketDialer
+	protocol, address := cmtnet.ProtocolAndAddress(cfg.PrivValidatorListenAddr)
+	switch protocol {
+	case "unix":
+		dialer = privval.DialUnixFn(address)
+	case "tcp":
+		// cometbft doesn't authenticate the signer, the connection key is ephemeral
+		dialer = privval.DialTCPFn(address, signerPVReadWriteTimeout, ed25519.GenPrivKey())
+	default:
+		return fmt.Errorf("unsupported priv_validator_laddr protocol %s", protocol)
+	}
+
+	logger := servercmtlog.CometLoggerWrapper{Logger: serverCtx.Logger.With("module", "signer")}
+
+	// keep dialing: the signer server stops for good when it runs out of retries
+	endpoint := privval.NewSignerDialerEndpoint(logger, dialer, privval.SignerDialerEndpointConnRetries(math.MaxInt))
+
+	state := pv.State()
+	serverCtx.Logger.Info("Signing consensus votes with the remote signer", "laddr", cfg.PrivValidatorListenAddr,
+		"address", helper.SignerAddress(helper.GetSigner()), "lastHeight", state.Height, "lastRound", state.Round, "lastStep", state.Step)
+
+	return privval.NewSignerServer(endpoint, genesis.ChainID, pv).Start()
+}
@@ -0,0 +1,263 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// BlsPubKeyLength is the length of a compressed BLS public key, a point of G1
+	BlsPubKeyLength = bls12381.SizeOfG1AffineCompressed
+
+	// BlsSignatureLength is the length of a compressed BLS signature, a point of G2
+	BlsSignatureLength = bls12381.SizeOfG2AffineCompressed
+
+	// BlsSecretKeyLength is the length of a BLS secret key, a scalar of the BLS12-381 curve
+	BlsSecretKeyLength = fr.Bytes
+)
+
+var (
+	// blsSignatureDST is the domain separation tag of the signatures, from the proof of possession scheme
+	// of the IETF BLS signature draft, which makes the aggregation over a same message safe
+	blsSignatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// blsPopDST is the domain separation tag of the proofs of possession
+	blsPopDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	ErrInvalidBlsSecretKey = errors.New("invalid BLS secret key")
+	ErrInvalidBlsPubKey    = errors.New("invalid BLS public key")
+	ErrInvalidBlsSignature = errors.New("invalid BLS signature")
+)
+
+// BlsKeyFile is the content of the file holding the BLS key of a validator
+type BlsKeyFile struct {
+	PubKey    hexutil.Bytes `json:"pub_key"`
+	SecretKey hexutil.Bytes `json:"secret_key"`
+}
+
+// GenerateBlsSecretKey generates a random BLS secret key
+func GenerateBlsSecretKey() ([]byte, error) {
+	var scalar fr.Element
+	for scalar.IsZero() {
+		if _, err := scalar.SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+
+	secretKey := scalar.Bytes()
+
+	return secretKey[:], nil
+}
+
+// BlsPubKeyFromSecretKey returns the compressed public key of a BLS secret key
+func BlsPubKeyFromSecretKey(secretKey []byte) ([]byte, error) {
+	scalar, err := parseBlsSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey bls12381.G1Affine
+	pubKey.ScalarMultiplicationBase(scalar)
+
+	compressed := pubKey.Bytes()
+
+	return compressed[:], nil
+}
+
+// BlsSign signs the message with a BLS secret key
+func BlsSign(secretKey []byte, msg []byte) ([]byte, error) {
+	return blsSign(secretKey, msg, blsSignatureDST)
+}
+
+// BlsProofOfPossession returns the proof of possession of a BLS secret key, the signature of its public key,
+// which protects the aggregated signatures from the rogue key attacks
+func BlsProofOfPossession(secretKey []byte) ([]byte, error) {
+	pubKey, err := BlsPubKeyFromSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return blsSign(secretKey, pubKey, blsPopDST)
+}
+
+// VerifyBlsSignature verifies the BLS signature of the message with the public key
+func VerifyBlsSignature(pubKey []byte, msg []byte, signature []byte) error {
+	return blsVerify(pubKey, msg, signature, blsSignatureDST)
+}
+
+// VerifyBlsProofOfPossession verifies the proof of possession of the secret key of a BLS public key
+func VerifyBlsProofOfPossession(pubKey []byte, proof []byte) error {
+	return blsVerify(pubKey, pubKey, proof, blsPopDST)
+}
+
+// AggregateBlsSignatures aggregates the BLS signatures of a same message
+func AggregateBlsSignatures(signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, ErrInvalidBlsSignature
+	}
+
+	var aggregate bls12381.G2Affine
+	for _, signature := range signatures {
+		var point bls12381.G2Affine
+		if err := parseBlsPoint(&point, signature, BlsSignatureLength); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBlsSignature, err)
+		}
+
+		aggregate.Add(&aggregate, &point)
+	}
+
+	compressed := aggregate.Bytes()
+
+	return compressed[:], nil
+}
+
+// AggregateBlsPubKeys aggregates the BLS public keys, which verifies the aggregated signature of a same message
+func AggregateBlsPubKeys(pubKeys [][]byte) ([]byte, error) {
+	if len(pubKeys) == 0 {
+		return nil, ErrInvalidBlsPubKey
+	}
+
+	var aggregate bls12381.G1Affine
+	for _, pubKey := range pubKeys {
+		var point bls12381.G1Affine
+		if err := parseBlsPoint(&point, pubKey, BlsPubKeyLength); err != nil || point.IsInfinity() {
+			return nil, ErrInvalidBlsPubKey
+		}
+
+		aggregate.Add(&aggregate, &point)
+	}
+
+	compressed := aggregate.Bytes()
+
+	return compressed[:], nil
+}
+
+// LoadBlsKeyFile loads the BLS secret key from a key file, and checks it matches the public key of the file
+func LoadBlsKeyFile(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyFile BlsKeyFile
+	if err := json.Unmarshal(bz, &keyFile); err != nil {
+		return nil, fmt.Errorf("failed to decode the BLS key file %s: %w", path, err)
+	}
+
+	pubKey, err := BlsPubKeyFromSecretKey(keyFile.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(pubKey, keyFile.PubKey) {
+		return nil, fmt.Errorf("the public key of the BLS key file %s doesn't match its secret key", path)
+	}
+
+	return keyFile.SecretKey, nil
+}
+
+// SaveBlsKeyFile saves the BLS secret key, with its public key, in a key file readable by the owner only
+func SaveBlsKeyFile(path string, secretKey []byte) error {
+	pubKey, err := BlsPubKeyFromSecretKey(secretKey)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(BlsKeyFile{PubKey: pubKey, SecretKey: secretKey}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, secretFilePerm)
+}
+
+// blsSign signs the message hashed to G2 with the domain separation tag
+func blsSign(secretKey []byte, msg []byte, dst []byte) ([]byte, error) {
+	scalar, err := parseBlsSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature bls12381.G2Affine
+	signature.ScalarMultiplication(&hash, scalar)
+
+	compressed := signature.Bytes()
+
+	return compressed[:], nil
+}
+
+// blsVerify checks e(pubKey, H(msg)) == e(g1, signature)
+func blsVerify(pubKey []byte, msg []byte, signature []byte, dst []byte) error {
+	var pubKeyPoint bls12381.G1Affine
+	if err := parseBlsPoint(&pubKeyPoint, pubKey, BlsPubKeyLength); err != nil || pubKeyPoint.IsInfinity() {
+		return ErrInvalidBlsPubKey
+	}
+
+	var signaturePoint bls12381.G2Affine
+	if err := parseBlsPoint(&signaturePoint, signature, BlsSignatureLength); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBlsSignature, err)
+	}
+
+	hash, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return err
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{pubKeyPoint, negG1}, []bls12381.G2Affine{hash, signaturePoint})
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrInvalidBlsSignature
+	}
+
+	return nil
+}
+
+// parseBlsSecretKey parses a non-zero scalar of the curve
+func parseBlsSecretKey(secretKey []byte) (*big.Int, error) {
+	if len(secretKey) != BlsSecretKeyLength {
+		return nil, ErrInvalidBlsSecretKey
+	}
+
+	var scalar fr.Element
+	if err := scalar.SetBytesCanonical(secretKey); err != nil || scalar.IsZero() {
+		return nil, ErrInvalidBlsSecretKey
+	}
+
+	return scalar.BigInt(new(big.Int)), nil
+}
+
+// blsPoint is a point of G1 or G2, decoded with the subgroup check
+type blsPoint interface {
+	SetBytes(buf []byte) (int, error)
+}
+
+// parseBlsPoint decodes a compressed point of the given length
+func parseBlsPoint(point blsPoint, bz []byte, length int) error {
+	if len(bz) != length {
+		return fmt.Errorf("invalid length %d, expected %d", len(bz), length)
+	}
+
+	_, err := point.SetBytes(bz)
+
+	return err
+}
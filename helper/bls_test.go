@@ -0,0 +1,121 @@
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func generateBlsKeys(t *testing.T, count int) ([][]byte, [][]byte) {
+	t.Helper()
+
+	secretKeys := make([][]byte, 0, count)
+	pubKeys := make([][]byte, 0, count)
+
+	for range count {
+		secretKey, err := GenerateBlsSecretKey()
+		require.NoError(t, err)
+
+		pubKey, err := BlsPubKeyFromSecretKey(secretKey)
+		require.NoError(t, err)
+
+		secretKeys = append(secretKeys, secretKey)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return secretKeys, pubKeys
+}
+
+func TestBlsSignature(t *testing.T) {
+	t.Parallel()
+
+	secretKeys, pubKeys := generateBlsKeys(t, 2)
+	msg := []byte("checkpoint")
+
+	signature, err := BlsSign(secretKeys[0], msg)
+	require.NoError(t, err)
+	require.Len(t, signature, BlsSignatureLength)
+	require.Len(t, pubKeys[0], BlsPubKeyLength)
+
+	require.NoError(t, VerifyBlsSignature(pubKeys[0], msg, signature))
+	require.ErrorIs(t, VerifyBlsSignature(pubKeys[1], msg, signature), ErrInvalidBlsSignature)
+	require.ErrorIs(t, VerifyBlsSignature(pubKeys[0], []byte("other"), signature), ErrInvalidBlsSignature)
+	require.ErrorIs(t, VerifyBlsSignature(pubKeys[0][1:], msg, signature), ErrInvalidBlsPubKey)
+	require.ErrorIs(t, VerifyBlsSignature(pubKeys[0], msg, signature[1:]), ErrInvalidBlsSignature)
+
+	_, err = BlsSign(make([]byte, BlsSecretKeyLength), msg)
+	require.ErrorIs(t, err, ErrInvalidBlsSecretKey)
+}
+
+func TestBlsProofOfPossession(t *testing.T) {
+	t.Parallel()
+
+	secretKeys, pubKeys := generateBlsKeys(t, 2)
+
+	proof, err := BlsProofOfPossession(secretKeys[0])
+	require.NoError(t, err)
+	require.NoError(t, VerifyBlsProofOfPossession(pubKeys[0], proof))
+	require.Error(t, VerifyBlsProofOfPossession(pubKeys[1], proof))
+
+	// a signature of the public key isn't a proof of possession, as they use different domains
+	signature, err := BlsSign(secretKeys[0], pubKeys[0])
+	require.NoError(t, err)
+	require.Error(t, VerifyBlsProofOfPossession(pubKeys[0], signature))
+}
+
+func TestAggregateBlsSignatures(t *testing.T) {
+	t.Parallel()
+
+	secretKeys, pubKeys := generateBlsKeys(t, 4)
+	msg := []byte("checkpoint")
+
+	signatures := make([][]byte, 0, len(secretKeys))
+	for _, secretKey := range secretKeys {
+		signature, err := BlsSign(secretKey, msg)
+		require.NoError(t, err)
+		signatures = append(signatures, signature)
+	}
+
+	aggregateSignature, err := AggregateBlsSignatures(signatures)
+	require.NoError(t, err)
+
+	aggregatePubKey, err := AggregateBlsPubKeys(pubKeys)
+	require.NoError(t, err)
+	require.NoError(t, VerifyBlsSignature(aggregatePubKey, msg, aggregateSignature))
+
+	// the aggregate of a subset of the signers doesn't verify against all the keys
+	partialSignature, err := AggregateBlsSignatures(signatures[:3])
+	require.NoError(t, err)
+	require.Error(t, VerifyBlsSignature(aggregatePubKey, msg, partialSignature))
+
+	_, err = AggregateBlsSignatures(nil)
+	require.Error(t, err)
+
+	_, err = AggregateBlsPubKeys([][]byte{pubKeys[0], pubKeys[1][2:]})
+	require.ErrorIs(t, err, ErrInvalidBlsPubKey)
+}
+
+func TestBlsKeyFile(t *testing.T) {
+	t.Parallel()
+
+	secretKeys, _ := generateBlsKeys(t, 1)
+	path := filepath.Join(t.TempDir(), "bls_key.json")
+
+	require.NoError(t, SaveBlsKeyFile(path, secretKeys[0]))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(secretFilePerm), info.Mode().Perm())
+
+	secretKey, err := LoadBlsKeyFile(path)
+	require.NoError(t, err)
+	require.Equal(t, secretKeys[0], secretKey)
+
+	// a key file with a mismatching public key is refused
+	require.NoError(t, os.WriteFile(path, []byte(`{"pub_key":"0x01","secret_key":"`+hexutil.Encode(secretKeys[0])+`"}`), secretFilePerm))
+	_, err = LoadBlsKeyFile(path)
+	require.ErrorContains(t, err, "doesn't match")
+}
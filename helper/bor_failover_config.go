@@ -122,7 +122,7 @@ func buildBorGRPCClient(urls []string, token string, attemptTimeout time.Duratio
 	}
 
 	return clients[0], borgrpc.NewMultiBorGRPCClient(
-		clients, Logger, metrics.BorFailover("grpc"), attemptTimeout,
+		clients, Logger.With("chain", "bor"), metrics.BorFailover("grpc"), attemptTimeout,
 		borGRPCParityValidators(httpClient, attemptTimeout)...,
 	), nil
 }
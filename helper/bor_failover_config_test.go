@@ -343,7 +343,7 @@ func TestSucceeded(t *testing.T) {
 }
 
 func TestCheckChainID(t *testing.T) {
-	tr := &borHTTPFailoverTransport{}
+	tr := &httpFailoverTransport{}
 	require.Error(t, tr.checkChainID(1, big.NewInt(5)))   // expected unknown + fallback → rejected
 	require.NoError(t, tr.checkChainID(0, big.NewInt(5))) // primary establishes the expectation
 	require.Equal(t, int64(5), tr.expectedChainID.Load().Int64())
@@ -352,7 +352,7 @@ func TestCheckChainID(t *testing.T) {
 }
 
 func TestCanAnchor(t *testing.T) {
-	tr := &borHTTPFailoverTransport{}
+	tr := &httpFailoverTransport{}
 	require.True(t, tr.canAnchor(primaryEndpoint))               // primary always anchors
 	require.False(t, tr.canAnchor(1))                            // fallback blocked while primary reachable
 	tr.primaryProbeFailures.Store(primaryAnchorFailureThreshold) // primary unreachable through startup
@@ -360,7 +360,7 @@ func TestCanAnchor(t *testing.T) {
 }
 
 func TestCheckChainID_FallbackAnchorsWhenPrimaryUnreachable(t *testing.T) {
-	tr := &borHTTPFailoverTransport{}
+	tr := &httpFailoverTransport{}
 	tr.primaryProbeFailures.Store(primaryAnchorFailureThreshold)
 
 	require.NoError(t, tr.checkChainID(1, big.NewInt(7))) // fallback provisionally establishes the expectation
@@ -370,7 +370,7 @@ func TestCheckChainID_FallbackAnchorsWhenPrimaryUnreachable(t *testing.T) {
 }
 
 func TestCheckChainID_PrimaryReclaimsProvisionalAnchor(t *testing.T) {
-	tr := &borHTTPFailoverTransport{}
+	tr := &httpFailoverTransport{}
 	tr.primaryProbeFailures.Store(primaryAnchorFailureThreshold)
 
 	require.NoError(t, tr.checkChainID(1, big.NewInt(7)))  // fallback provisionally anchors 7
@@ -398,7 +398,7 @@ func TestCloseBorChainClients(t *testing.T) {
 	h := failover.New(2, func(int) error { return nil }, failover.Metrics{}, log.NewNopLogger())
 	h.SetTuning(5*time.Millisecond, 1, 0, 50*time.Millisecond)
 	h.Start()
-	borRPCFailoverTransport = &borHTTPFailoverTransport{
+	borRPCFailoverTransport = &httpFailoverTransport{
 		endpoints: []httpEndpoint{{probe: p0}, {probe: p1}},
 		health:    h,
 	}
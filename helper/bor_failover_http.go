@@ -51,7 +51,7 @@ const (
 // borRPCFailoverTransport holds the running HTTP failover transport so
 // CloseBorChainClients can stop its prober and close its per-endpoint probe
 // clients on shutdown; nil when HTTP failover is not configured.
-var borRPCFailoverTransport *borHTTPFailoverTransport
+var borRPCFailoverTransport *httpFailoverTransport
 
 // borChainClientsMu serializes CloseBorChainClients. On a --bridge=true SIGTERM
 // both the bridge shutdown path and the start-command cleanup goroutine reach
@@ -64,7 +64,7 @@ type chainIDProbe interface {
 	Close()
 }
 
-// httpEndpoint pairs a Bor HTTP JSON-RPC URL with a single-endpoint client used
+// httpEndpoint pairs an HTTP JSON-RPC URL with a single-endpoint client used
 // only for health/identity probes (the actual traffic flows through the shared
 // rpc.Client whose transport is the failover one).
 type httpEndpoint struct {
@@ -72,12 +72,13 @@ type httpEndpoint struct {
 	probe chainIDProbe
 }
 
-// borHTTPFailoverTransport is an http.RoundTripper that sends each Bor JSON-RPC
+// httpFailoverTransport is an http.RoundTripper that sends each JSON-RPC
 // request to the active endpoint and, on a transport failure, a per-attempt
 // timeout, or a 5xx response, cascades to the next validated endpoint in
 // priority order. A background prober reverts to a higher-priority endpoint
-// once it recovers.
-type borHTTPFailoverTransport struct {
+// once it recovers. It serves both the Bor and the main chain (L1) clients.
+type httpFailoverTransport struct {
+	chain                string // "bor" or "eth", for errors and logs
 	endpoints            []httpEndpoint
 	base                 http.RoundTripper
 	health               *failover.Health
@@ -85,9 +86,12 @@ type borHTTPFailoverTransport struct {
 	expectedChainID      atomic.Pointer[big.Int]
 	expectedByPrimary    atomic.Bool
 	primaryProbeFailures atomic.Int32
+	// pinnedChainID, when set, is the chain ID every endpoint (primary included)
+	// must report; it replaces the primary-anchored expectation.
+	pinnedChainID *big.Int
 }
 
-func (t *borHTTPFailoverTransport) Close() {
+func (t *httpFailoverTransport) Close() {
 	if t == nil {
 		return
 	}
@@ -101,7 +105,7 @@ func (t *borHTTPFailoverTransport) Close() {
 	}
 }
 
-func (t *borHTTPFailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+func (t *httpFailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	body, err := drainRequestBody(req)
 	if err != nil {
 		return nil, err
@@ -121,7 +125,7 @@ func (t *borHTTPFailoverTransport) RoundTrip(req *http.Request) (*http.Response,
 	return t.cascade(req, body, active, resp, err)
 }
 
-func (t *borHTTPFailoverTransport) cascade(req *http.Request, body []byte, active int, resp *http.Response, err error) (*http.Response, error) {
+func (t *httpFailoverTransport) cascade(req *http.Request, body []byte, active int, resp *http.Response, err error) (*http.Response, error) {
 	for _, i := range t.health.Candidates(active) {
 		drainAndClose(resp)
 		resp, err = t.attempt(req, body, i)
@@ -130,7 +134,7 @@ func (t *borHTTPFailoverTransport) cascade(req *http.Request, body []byte, activ
 				return resp, err
 			}
 			drainAndClose(resp)
-			resp, err = nil, fmt.Errorf("bor endpoint %d no longer healthy", i)
+			resp, err = nil, fmt.Errorf("%s endpoint %d no longer healthy", t.chain, i)
 			continue
 		}
 		if req.Context().Err() != nil {
@@ -149,9 +153,9 @@ func succeeded(resp *http.Response, err error) bool {
 	return err == nil && resp != nil && resp.StatusCode < http.StatusInternalServerError
 }
 
-func (t *borHTTPFailoverTransport) markFailed(i int, resp *http.Response, err error) {
+func (t *httpFailoverTransport) markFailed(i int, resp *http.Response, err error) {
 	if err == nil && resp != nil {
-		err = fmt.Errorf("bor endpoint returned http %d", resp.StatusCode)
+		err = fmt.Errorf("%s endpoint returned http %d", t.chain, resp.StatusCode)
 	}
 	t.health.MarkUnhealthy(i, err)
 }
@@ -159,7 +163,7 @@ func (t *borHTTPFailoverTransport) markFailed(i int, resp *http.Response, err er
 // attempt sends the request to endpoint i with its own attempt timeout. On
 // success the timeout context is tied to the response body's Close so it stays
 // alive while the caller reads the body.
-func (t *borHTTPFailoverTransport) attempt(req *http.Request, body []byte, i int) (*http.Response, error) {
+func (t *httpFailoverTransport) attempt(req *http.Request, body []byte, i int) (*http.Response, error) {
 	ctx, cancel := failover.WithAttemptTimeout(req.Context(), t.attemptTimeout)
 	resp, err := t.send(req.WithContext(ctx), body, i)
 	if err != nil {
@@ -174,7 +178,7 @@ func (t *borHTTPFailoverTransport) attempt(req *http.Request, body []byte, i int
 	return resp, nil
 }
 
-func (t *borHTTPFailoverTransport) send(req *http.Request, body []byte, i int) (*http.Response, error) {
+func (t *httpFailoverTransport) send(req *http.Request, body []byte, i int) (*http.Response, error) {
 	clone := req.Clone(req.Context())
 	ep := *t.endpoints[i].url
 	clone.URL = &ep
@@ -229,10 +233,10 @@ func drainRequestBody(req *http.Request) ([]byte, error) {
 }
 
 // probe validates endpoint i for the background prober: it must answer and
-// report the expected Bor chain ID before it is treated as a healthy fallback.
+// report the expected chain ID before it is treated as a healthy fallback.
 // It tracks consecutive primary failures so a fallback can anchor identity when
 // the primary is never reachable (see canAnchor).
-func (t *borHTTPFailoverTransport) probe(i int) error {
+func (t *httpFailoverTransport) probe(i int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), t.health.ProbeTimeout())
 	defer cancel()
 
@@ -240,7 +244,7 @@ func (t *borHTTPFailoverTransport) probe(i int) error {
 	if err == nil && id == nil {
 		// A nil chain id with no error would poison the expected id (primary) or
 		// panic in checkChainID's Cmp; treat it as a probe failure.
-		err = fmt.Errorf("bor endpoint %d returned nil chain id", i)
+		err = fmt.Errorf("%s endpoint %d returned nil chain id", t.chain, i)
 	}
 	if err != nil {
 		if i == primaryEndpoint {
@@ -276,7 +280,17 @@ func (t *borHTTPFailoverTransport) probe(i int) error {
 // provisionally anchor only after the primary has been unreachable for
 // primaryAnchorFailureThreshold probes (see canAnchor); every endpoint that does
 // not anchor must match the current expectation.
-func (t *borHTTPFailoverTransport) checkChainID(i int, id *big.Int) error {
+//
+// A pinned chain ID is checked against every endpoint instead, the primary
+// included, as it comes from the configured network rather than an endpoint.
+func (t *httpFailoverTransport) checkChainID(i int, id *big.Int) error {
+	if t.pinnedChainID != nil {
+		if t.pinnedChainID.Cmp(id) != 0 {
+			return fmt.Errorf("%s endpoint %d chain id %s != configured %s", t.chain, i, id, t.pinnedChainID)
+		}
+		return nil
+	}
+
 	if i == primaryEndpoint && !t.expectedByPrimary.Load() {
 		t.expectedChainID.Store(id)
 		t.expectedByPrimary.Store(true)
@@ -286,7 +300,7 @@ func (t *borHTTPFailoverTransport) checkChainID(i int, id *big.Int) error {
 	expected := t.expectedChainID.Load()
 	if expected == nil {
 		if !t.canAnchor(i) {
-			return fmt.Errorf("bor endpoint %d: expected chain id not yet known", i)
+			return fmt.Errorf("%s endpoint %d: expected chain id not yet known", t.chain, i)
 		}
 		if t.expectedChainID.CompareAndSwap(nil, id) {
 			return nil
@@ -295,7 +309,7 @@ func (t *borHTTPFailoverTransport) checkChainID(i int, id *big.Int) error {
 	}
 
 	if expected.Cmp(id) != 0 {
-		return fmt.Errorf("bor endpoint %d chain id %s != expected %s", i, id, expected)
+		return fmt.Errorf("%s endpoint %d chain id %s != expected %s", t.chain, i, id, expected)
 	}
 
 	return nil
@@ -305,7 +319,7 @@ func (t *borHTTPFailoverTransport) checkChainID(i int, id *big.Int) error {
 // chain identity: only after the primary has failed primaryAnchorFailureThreshold
 // consecutive probes, so failover still engages when the primary is never
 // reachable at boot. The primary itself anchors via checkChainID's reclaim path.
-func (t *borHTTPFailoverTransport) canAnchor(i int) bool {
+func (t *httpFailoverTransport) canAnchor(i int) bool {
 	return i == primaryEndpoint || t.primaryProbeFailures.Load() >= primaryAnchorFailureThreshold
 }
 
@@ -314,35 +328,53 @@ func (t *borHTTPFailoverTransport) canAnchor(i int) bool {
 // *rpc.Client / *ethclient.Client are the same concrete types as a plain
 // rpc.Dial, so every existing Bor HTTP caller gets failover transparently.
 func newBorHTTPFailoverClient(rawURLs []string, attemptTimeout time.Duration) (*rpc.Client, *ethclient.Client, error) {
-	endpoints, err := dialHTTPEndpoints(rawURLs)
+	tr, rpcClient, err := newHTTPFailoverClient("bor", rawURLs, attemptTimeout, nil, metrics.BorFailover("http"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	borRPCFailoverTransport = tr
+
+	return rpcClient, ethclient.NewClient(rpcClient), nil
+}
+
+// newHTTPFailoverClient builds the failover transport of the given chain over
+// the priority-ordered endpoints and starts its prober. A non-nil
+// pinnedChainID is required from every endpoint; otherwise the primary's chain
+// ID is the expectation (see checkChainID).
+func newHTTPFailoverClient(chain string, rawURLs []string, attemptTimeout time.Duration, pinnedChainID *big.Int, m failover.Metrics) (*httpFailoverTransport, *rpc.Client, error) {
+	endpoints, err := dialHTTPEndpoints(chain, rawURLs)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	tr := &borHTTPFailoverTransport{
+	tr := &httpFailoverTransport{
+		chain:          chain,
 		endpoints:      endpoints,
 		base:           http.DefaultTransport,
 		attemptTimeout: attemptTimeout,
+		pinnedChainID:  pinnedChainID,
 	}
-	tr.captureExpectedChainID()
-	tr.health = failover.New(len(endpoints), tr.probe, metrics.BorFailover("http"), Logger)
+	if pinnedChainID == nil {
+		tr.captureExpectedChainID()
+	}
+	tr.health = failover.New(len(endpoints), tr.probe, m, Logger.With("chain", chain))
 
 	rpcClient, err := rpc.DialOptions(context.Background(), endpoints[primaryEndpoint].url.String(),
 		rpc.WithHTTPClient(&http.Client{Transport: tr}))
 	if err != nil {
-		return nil, nil, fmt.Errorf("constructing bor failover rpc client: %w", err)
+		return nil, nil, fmt.Errorf("constructing %s failover rpc client: %w", chain, err)
 	}
 
 	tr.health.Start()
-	borRPCFailoverTransport = tr
 
-	return rpcClient, ethclient.NewClient(rpcClient), nil
+	return tr, rpcClient, nil
 }
 
 // captureExpectedChainID best-effort records the primary's chain ID at startup
 // so fallbacks can be validated before the first request. If the primary is
 // unreachable, the expectation is set later by the primary's first probe.
-func (t *borHTTPFailoverTransport) captureExpectedChainID() {
+func (t *httpFailoverTransport) captureExpectedChainID() {
 	if id, ok := fetchChainID(t.endpoints[primaryEndpoint].probe, t.attemptTimeout); ok {
 		if t.expectedChainID.CompareAndSwap(nil, id) {
 			t.expectedByPrimary.Store(true)
@@ -350,15 +382,15 @@ func (t *borHTTPFailoverTransport) captureExpectedChainID() {
 	}
 }
 
-func dialHTTPEndpoints(rawURLs []string) ([]httpEndpoint, error) {
+func dialHTTPEndpoints(chain string, rawURLs []string) ([]httpEndpoint, error) {
 	out := make([]httpEndpoint, 0, len(rawURLs))
 	for i, raw := range rawURLs {
 		ep, err := dialHTTPEndpoint(raw)
 		if err != nil {
 			if i == primaryEndpoint {
-				return nil, fmt.Errorf("invalid primary bor RPC URL %s: %w", redactURL(raw), err)
+				return nil, fmt.Errorf("invalid primary %s RPC URL %s: %w", chain, redactURL(raw), err)
 			}
-			Logger.Warn("bor failover: skipping invalid bor RPC URL", "url", redactURL(raw), "error", err)
+			Logger.Warn("failover: skipping invalid RPC URL", "chain", chain, "url", redactURL(raw), "error", err)
 			continue
 		}
 
@@ -366,7 +398,7 @@ func dialHTTPEndpoints(rawURLs []string) ([]httpEndpoint, error) {
 	}
 
 	if len(out) == 0 {
-		return nil, fmt.Errorf("no valid http(s) bor RPC endpoints among %d configured", len(rawURLs))
+		return nil, fmt.Errorf("no valid http(s) %s RPC endpoints among %d configured", chain, len(rawURLs))
 	}
 
 	return out, nil
@@ -476,9 +508,9 @@ func redactURLs(csv string) string {
 }
 
 // CloseBorChainClients stops the Bor failover background probers, closes the
-// HTTP probe clients and the HTTP JSON-RPC client, and closes the gRPC
-// connections. It is the termination path for those goroutines; wire it into
-// Heimdall's shutdown. Safe to call when neither failover is configured.
+// HTTP probe clients, the quorum reader clients and the HTTP JSON-RPC client,
+// and closes the gRPC connections. It is the termination path for those
+// goroutines; wire it into Heimdall's shutdown. Safe to call when neither failover is configured.
 func CloseBorChainClients() {
 	borChainClientsMu.Lock()
 	defer borChainClientsMu.Unlock()
@@ -486,6 +518,10 @@ func CloseBorChainClients() {
 		borRPCFailoverTransport.Close()
 		borRPCFailoverTransport = nil
 	}
+	if borChainQuorum != nil {
+		borChainQuorum.Close()
+		borChainQuorum = nil
+	}
 	if borRPCClient != nil {
 		// borClient wraps the same underlying rpc.Client, so this closes both.
 		borRPCClient.Close()
@@ -50,7 +50,7 @@ func TestCloseBorChainClients_ConcurrentIsRaceFree(t *testing.T) {
 }
 
 func TestBorHTTPFailover_SkipsCandidateDemotedBeforePromotion(t *testing.T) {
-	tr := &borHTTPFailoverTransport{
+	tr := &httpFailoverTransport{
 		endpoints: []httpEndpoint{
 			mustEndpointURL(t, "https://primary.example"),
 			mustEndpointURL(t, "https://fallback.example"),
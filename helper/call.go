@@ -42,6 +42,7 @@ const (
 	StateSyncedEvent    = "StateSynced"
 	SlashedEvent        = "Slashed"
 	UnJailedEvent       = "UnJailed"
+	JailedEvent         = "Jailed"
 
 	// error messages
 	errUnableToConnect = "unable to connect to bor chain"
@@ -57,6 +58,7 @@ type IContractCaller interface {
 	GetRootHash(ctx context.Context, start, end, checkpointLength uint64) ([]byte, error)
 	GetVoteOnHash(start, end uint64, hash, milestoneID string) (bool, error)
 	GetValidatorInfo(valID uint64, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
+	GetValidatorInfoAtBlock(valID uint64, blockNumber *big.Int, stakingInfoAddress string) (validator types.Validator, err error)
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
@@ -68,8 +70,8 @@ type IContractCaller interface {
 	GetBorChainBlockInfoInBatch(ctx context.Context, start, end int64) ([]*ethTypes.Header, []uint64, []common.Address, error)
 	GetBorChainBlockTd(ctx context.Context, blockHash common.Hash) (uint64, error)
 	GetBorChainBlockAuthor(ctx context.Context, blockNum *big.Int) (*common.Address, error)
-	IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64) bool
-	GetConfirmedTxReceipt(ctx context.Context, txHash common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error)
+	IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64, mode ConfirmationMode) bool
+	GetConfirmedTxReceipt(ctx context.Context, txHash common.Hash, requiredConfirmations uint64, mode ConfirmationMode) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 
 	DecodeNewHeaderBlockEvent(string, *ethTypes.Receipt, uint64) (*rootchain.RootchainNewHeaderBlock, error)
@@ -84,9 +86,11 @@ type IContractCaller interface {
 
 	DecodeSlashedEvent(string, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSlashed, error)
 	DecodeUnJailedEvent(string, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnJailed, error)
+	DecodeJailedEvents(string, *ethTypes.Receipt) ([]*stakinginfo.StakinginfoJailed, error)
 
 	GetMainTxReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error)
 	GetBorTxReceipt(common.Hash) (*ethTypes.Receipt, error)
+	GetBorBlockReceipts(ctx context.Context, blockNumber uint64) ([]*ethTypes.Receipt, error)
 	ApproveTokens(*big.Int, common.Address, common.Address, *erc20.Erc20) error
 	StakeFor(common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error
 	CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error)
@@ -94,6 +98,7 @@ type IContractCaller interface {
 	GetSpanDetails(id *big.Int, validatorSet *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
 	CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int)
 	CheckIfBlocksExist(ctx context.Context, end uint64) (bool, error)
+	GetBorChainLastStateID(ctx context.Context, stateReceiverAddress string, blockNum *big.Int) (uint64, error)
 	GetRootChainInstance(rootChainAddress string) (*rootchain.Rootchain, error)
 	GetStakingInfoInstance(stakingInfoAddress string) (*stakinginfo.Stakinginfo, error)
 	GetValidatorSetInstance(validatorSetAddress string) (*validatorset.Validatorset, error)
@@ -127,6 +132,9 @@ type ContractCaller struct {
 	MainChainRPCClient *rpc.Client
 	MainChainTimeout   time.Duration
 
+	// MainChainArchiveClient, when set, reads the main chain contract state of past blocks
+	MainChainArchiveClient *ethclient.Client
+
 	BorChainClient    *ethclient.Client
 	BorChainRPCClient *rpc.Client
 	BorChainTimeout   time.Duration
@@ -134,6 +142,11 @@ type ContractCaller struct {
 	BorChainGrpcFlag   bool
 	BorChainGrpcClient BorGRPCClienter
 
+	// MainChainQuorum and BorChainQuorum, when set, read the data used to verify
+	// side txs from every configured endpoint and require them to agree.
+	MainChainQuorum *QuorumReader
+	BorChainQuorum  *QuorumReader
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -170,15 +183,18 @@ type rpcTransaction struct {
 func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	config := GetConfig()
 	contractCallerObj.MainChainClient = GetMainClient()
-	contractCallerObj.MainChainTimeout = config.EthRPCTimeout
+	contractCallerObj.MainChainTimeout = GetMainChainCallTimeout()
 	contractCallerObj.BorChainClient = GetBorClient()
 	contractCallerObj.BorChainTimeout = GetBorChainCallTimeout()
 	contractCallerObj.MainChainRPCClient = GetMainChainRPCClient()
+	contractCallerObj.MainChainArchiveClient = GetMainChainArchiveClient()
 	contractCallerObj.BorChainRPCClient = GetBorRPCClient()
 	contractCallerObj.BorChainGrpcFlag = config.BorGRPCFlag
 	if client := GetBorGRPCClient(); client != nil {
 		contractCallerObj.BorChainGrpcClient = client
 	}
+	contractCallerObj.MainChainQuorum = GetMainChainQuorumReader()
+	contractCallerObj.BorChainQuorum = GetBorChainQuorumReader()
 
 	// listeners and processors instance cache (address->ABI)
 	contractCallerObj.ContractInstanceCache = make(map[common.Address]interface{})
@@ -393,7 +409,9 @@ func (c *ContractCaller) GetRootHash(ctx context.Context, start, end, checkpoint
 	var rootHash string
 	var err error
 
-	if c.BorChainGrpcFlag {
+	if c.BorChainQuorum != nil {
+		rootHash, err = c.BorChainQuorum.RootHash(callCtx, start, end)
+	} else if c.BorChainGrpcFlag {
 		grpcClient, grpcErr := c.getRequiredBorGRPCClient()
 		if grpcErr != nil {
 			return nil, grpcErr
@@ -496,7 +514,32 @@ func (c *ContractCaller) GetBalance(address common.Address) (*big.Int, error) {
 
 // GetValidatorInfo get validator info
 func (c *ContractCaller) GetValidatorInfo(valID uint64, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error) {
-	stakerDetails, err := stakingInfoInstance.GetStakerDetails(nil, big.NewInt(int64(valID)))
+	return getValidatorInfo(nil, valID, stakingInfoInstance)
+}
+
+// GetValidatorInfoAtBlock returns the validator info as of the given main chain block,
+// so that all the validators read the same state whenever they query it.
+// Only archive nodes serve the state of the blocks past their state retention,
+// so the state is read from eth_archive_rpc_url when it is set.
+func (c *ContractCaller) GetValidatorInfoAtBlock(valID uint64, blockNumber *big.Int, stakingInfoAddress string) (validator types.Validator, err error) {
+	var stakingInfoInstance *stakinginfo.Stakinginfo
+	if c.MainChainArchiveClient != nil {
+		stakingInfoInstance, err = stakinginfo.NewStakinginfo(common.HexToAddress(stakingInfoAddress), c.MainChainArchiveClient)
+	} else {
+		stakingInfoInstance, err = c.GetStakingInfoInstance(stakingInfoAddress)
+	}
+
+	if err != nil {
+		Logger.Error("Error in fetching the stakingInfo instance", "error", err)
+		return
+	}
+
+	return getValidatorInfo(&bind.CallOpts{BlockNumber: blockNumber}, valID, stakingInfoInstance)
+}
+
+// getValidatorInfo returns the validator info read from the staking info contract with the given call options
+func getValidatorInfo(opts *bind.CallOpts, valID uint64, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error) {
+	stakerDetails, err := stakingInfoInstance.GetStakerDetails(opts, big.NewInt(int64(valID)))
 	if err != nil {
 		Logger.Error("Error fetching validator information from stake manager", "validatorId", valID, "error", err)
 		return
@@ -548,7 +591,21 @@ func (c *ContractCaller) GetMainChainFinalizedBlock(ctx context.Context) (header
 }
 
 // getOrFetchReceipt returns a receipt from prefetched receipts or fetches from L1.
+// With quorum reads, the receipt is always read from the main chain endpoints.
 func (c *ContractCaller) getOrFetchReceipt(ctx context.Context, tx common.Hash) (*ethTypes.Receipt, error) {
+	if c.MainChainQuorum != nil {
+		callCtx, cancel := context.WithTimeout(ctx, c.MainChainTimeout)
+		defer cancel()
+
+		receipt, err := c.MainChainQuorum.TransactionReceipt(callCtx, tx)
+		if err != nil {
+			Logger.Error("Error while fetching receipt from ethereum with quorum", "txHash", tx.Hex(), "error", err)
+			return nil, err
+		}
+
+		return receipt, nil
+	}
+
 	c.prefetchMu.RLock()
 	var cachedReceipt *ethTypes.Receipt
 	if c.prefetchedReceipts != nil {
@@ -870,9 +927,9 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 }
 
 // IsTxConfirmed checks whether the tx corresponding to the given hash is confirmed with given
-// requiredConfirmations numbers
-func (c *ContractCaller) IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64) bool {
-	receipt, err := c.GetConfirmedTxReceipt(ctx, txHash, requiredConfirmations)
+// requiredConfirmations numbers, or finalized, according to the given mode
+func (c *ContractCaller) IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64, mode ConfirmationMode) bool {
+	receipt, err := c.GetConfirmedTxReceipt(ctx, txHash, requiredConfirmations, mode)
 	if err != nil {
 		Logger.Error("Error while fetching the tx receipt", "error", err)
 		return false
@@ -881,8 +938,10 @@ func (c *ContractCaller) IsTxConfirmed(ctx context.Context, txHash common.Hash,
 	return receipt != nil
 }
 
-// GetConfirmedTxReceipt returns a tx receipt only if it is finalized (or has the required confirmations).
-func (c *ContractCaller) GetConfirmedTxReceipt(ctx context.Context, tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+// GetConfirmedTxReceipt returns a tx receipt only if it is confirmed according to the given mode:
+// finalized, has the required confirmations, or by default finalized when the main chain
+// exposes its finalized block and has the required confirmations otherwise.
+func (c *ContractCaller) GetConfirmedTxReceipt(ctx context.Context, tx common.Hash, requiredConfirmations uint64, mode ConfirmationMode) (*ethTypes.Receipt, error) {
 	receipt, err := c.getOrFetchReceipt(ctx, tx)
 	if err != nil {
 		return nil, err
@@ -894,37 +953,35 @@ func (c *ContractCaller) GetConfirmedTxReceipt(ctx context.Context, tx common.Ha
 
 	receiptBlockNumber := receipt.BlockNumber.Uint64()
 
-	c.prefetchMu.RLock()
-	cachedFinalizedHeader := c.finalizedHeaderCache
-	c.prefetchMu.RUnlock()
+	if mode != ConfirmationModeDepth {
+		finalized, ok := c.isMainChainBlockFinalized(ctx, receiptBlockNumber)
+		if ok {
+			if !finalized {
+				return nil, errors.New("receipt block number is ahead of latest finalized main chain block")
+			}
 
-	if cachedFinalizedHeader != nil && cachedFinalizedHeader.Number != nil {
-		if receiptBlockNumber <= cachedFinalizedHeader.Number.Uint64() {
 			return receipt, nil
 		}
-	}
 
-	latestFinalizedBlock, err := c.GetMainChainFinalizedBlock(ctx)
-	if err != nil {
-		Logger.Error("Error getting latest finalized main chain block", "error", err)
+		if mode == ConfirmationModeFinality {
+			return nil, errors.New("latest finalized main chain block is not available")
+		}
 	}
 
-	if latestFinalizedBlock != nil && latestFinalizedBlock.Number != nil {
-		Logger.Debug("Fetched latest finalized main chain block",
-			"blockNumber", latestFinalizedBlock.Number.Uint64(),
-		)
-		c.prefetchMu.Lock()
-		c.finalizedHeaderCache = latestFinalizedBlock
-		c.prefetchMu.Unlock()
+	// No finalized API, or depth-based confirmation: check N confirmations.
+	if c.MainChainQuorum != nil {
+		confirmed, err := c.MainChainQuorum.IsBlockConfirmed(ctx, receiptBlockNumber, requiredConfirmations)
+		if err != nil {
+			return nil, err
+		}
 
-		if receiptBlockNumber > latestFinalizedBlock.Number.Uint64() {
-			return nil, errors.New("receipt block number is ahead of latest finalized main chain block")
+		if !confirmed {
+			return nil, errors.New("not enough confirmations")
 		}
 
 		return receipt, nil
 	}
 
-	// No finalized API: fall back to N confirmations.
 	latestBlock, err := c.GetMainChainBlock(ctx, nil)
 	if err != nil {
 		Logger.Error("Error getting latest main chain block", "error", err)
@@ -950,6 +1007,49 @@ func (c *ContractCaller) GetConfirmedTxReceipt(ctx context.Context, tx common.Ha
 	return receipt, nil
 }
 
+// isMainChainBlockFinalized checks whether the given main chain block is finalized, against
+// the cached finalized header first, or against the quorum endpoints when configured. The
+// second value is false when the latest finalized block could not be fetched.
+func (c *ContractCaller) isMainChainBlockFinalized(ctx context.Context, blockNumber uint64) (bool, bool) {
+	if c.MainChainQuorum != nil {
+		finalized, err := c.MainChainQuorum.IsBlockFinalized(ctx, blockNumber)
+		if err != nil {
+			Logger.Error("Error getting latest finalized main chain block from the quorum", "error", err)
+			return false, false
+		}
+
+		return finalized, true
+	}
+
+	c.prefetchMu.RLock()
+	cachedFinalizedHeader := c.finalizedHeaderCache
+	c.prefetchMu.RUnlock()
+
+	if cachedFinalizedHeader != nil && cachedFinalizedHeader.Number != nil {
+		if blockNumber <= cachedFinalizedHeader.Number.Uint64() {
+			return true, true
+		}
+	}
+
+	latestFinalizedBlock, err := c.GetMainChainFinalizedBlock(ctx)
+	if err != nil {
+		Logger.Error("Error getting latest finalized main chain block", "error", err)
+	}
+
+	if latestFinalizedBlock == nil || latestFinalizedBlock.Number == nil {
+		return false, false
+	}
+
+	Logger.Debug("Fetched latest finalized main chain block",
+		"blockNumber", latestFinalizedBlock.Number.Uint64(),
+	)
+	c.prefetchMu.Lock()
+	c.finalizedHeaderCache = latestFinalizedBlock
+	c.prefetchMu.Unlock()
+
+	return blockNumber <= latestFinalizedBlock.Number.Uint64(), true
+}
+
 // DecodeNewHeaderBlockEvent represents the new header block event
 func (c *ContractCaller) DecodeNewHeaderBlockEvent(contractAddressString string, receipt *ethTypes.Receipt, logIndex uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	event := new(rootchain.RootchainNewHeaderBlock)
@@ -1123,6 +1223,29 @@ func (c *ContractCaller) DecodeUnJailedEvent(contractAddressString string, recei
 	return nil, errors.New(errEventNotFound)
 }
 
+// DecodeJailedEvents returns all the validator jailing events emitted by the contract in the receipt
+func (c *ContractCaller) DecodeJailedEvents(contractAddressString string, receipt *ethTypes.Receipt) ([]*stakinginfo.StakinginfoJailed, error) {
+	contractAddress := common.HexToAddress(contractAddressString)
+	jailedEventID := c.StakingInfoABI.Events[JailedEvent].ID
+
+	var events []*stakinginfo.StakinginfoJailed
+
+	for _, vLog := range receipt.Logs {
+		if !bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) || len(vLog.Topics) == 0 || vLog.Topics[0] != jailedEventID {
+			continue
+		}
+
+		event := new(stakinginfo.StakinginfoJailed)
+		if err := UnpackLog(&c.StakingInfoABI, event, JailedEvent, vLog); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
 //
 // Account root functions
 //
@@ -1214,6 +1337,26 @@ func (c *ContractCaller) CheckIfBlocksExist(ctx context.Context, number uint64)
 	return number == header.Number.Uint64(), nil
 }
 
+// GetBorChainLastStateID returns the ID of the last state committed by the bor state receiver contract
+// as of the given bor block.
+func (c *ContractCaller) GetBorChainLastStateID(ctx context.Context, stateReceiverAddress string, blockNum *big.Int) (uint64, error) {
+	stateReceiverInstance, err := c.GetStateReceiverInstance(stateReceiverAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.BorChainTimeout)
+	defer cancel()
+
+	stateID, err := stateReceiverInstance.LastStateId(&bind.CallOpts{Context: callCtx, BlockNumber: blockNum})
+	if err != nil {
+		Logger.Error("Could not fetch the last state id from bor chain", "blockNumber", blockNum, "error", err)
+		return 0, err
+	}
+
+	return stateID.Uint64(), nil
+}
+
 // GetBlockByNumber returns blocks by number from the child chain (bor)
 func (c *ContractCaller) GetBlockByNumber(ctx context.Context, blockNumber uint64) (*ethTypes.Block, error) {
 	var block *ethTypes.Block
@@ -1298,6 +1441,15 @@ func (c *ContractCaller) GetBorTxReceipt(txHash common.Hash) (*ethTypes.Receipt,
 	return c.getTxReceipt(ctx, c.BorChainClient, txHash)
 }
 
+// GetBorBlockReceipts returns the receipts of all the txs of the given bor block,
+// always read over JSON-RPC
+func (c *ContractCaller) GetBorBlockReceipts(ctx context.Context, blockNumber uint64) ([]*ethTypes.Receipt, error) {
+	callCtx, cancel := context.WithTimeout(ctx, c.BorChainTimeout)
+	defer cancel()
+
+	return c.BorChainClient.BlockReceipts(callCtx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNumber)))
+}
+
 func (c *ContractCaller) getTxReceipt(
 	ctx context.Context,
 	client interface {
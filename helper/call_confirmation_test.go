@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMainChainHeads serves the latest block and, unless finalized is 0, the finalized block
+func fakeMainChainHeads(t *testing.T, latest uint64, finalized uint64) *ethclient.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Params []interface{}   `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+
+		number := latest
+		if req.Params[0] == "finalized" {
+			number = finalized
+		}
+
+		if number == 0 {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "finalized block not found"}
+		} else {
+			resp["result"] = &ethTypes.Header{Number: new(big.Int).SetUint64(number), Difficulty: new(big.Int)}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ethclient.Dial(server.URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+func TestGetConfirmedTxReceipt_ConfirmationModes(t *testing.T) {
+	txHash := common.HexToHash("0x01")
+
+	tests := []struct {
+		name      string
+		mode      ConfirmationMode
+		latest    uint64
+		finalized uint64
+		wantErr   string
+	}{
+		{name: "default, finalized", mode: ConfirmationModeDefault, latest: 110, finalized: 100},
+		{name: "default, not finalized", mode: ConfirmationModeDefault, latest: 200, finalized: 99, wantErr: "ahead of latest finalized"},
+		{name: "default, no finalized block, deep enough", mode: ConfirmationModeDefault, latest: 106},
+		{name: "depth, deep enough but not finalized", mode: ConfirmationModeDepth, latest: 106, finalized: 99},
+		{name: "depth, not deep enough but finalized", mode: ConfirmationModeDepth, latest: 105, finalized: 100, wantErr: "not enough confirmations"},
+		{name: "finality, finalized", mode: ConfirmationModeFinality, latest: 101, finalized: 100},
+		{name: "finality, not finalized", mode: ConfirmationModeFinality, latest: 200, finalized: 99, wantErr: "ahead of latest finalized"},
+		{name: "finality, no finalized block", mode: ConfirmationModeFinality, latest: 200, wantErr: "finalized main chain block is not available"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &ContractCaller{
+				MainChainClient:  fakeMainChainHeads(t, tc.latest, tc.finalized),
+				MainChainTimeout: time.Second,
+				prefetchMu:       &sync.RWMutex{},
+				prefetchedReceipts: map[common.Hash]*ethTypes.Receipt{
+					txHash: {TxHash: txHash, BlockNumber: big.NewInt(100)},
+				},
+			}
+
+			receipt, err := c.GetConfirmedTxReceipt(context.Background(), txHash, 6, tc.mode)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				require.False(t, c.IsTxConfirmed(context.Background(), txHash, 6, tc.mode))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, txHash, receipt.TxHash)
+			require.True(t, c.IsTxConfirmed(context.Background(), txHash, 6, tc.mode))
+		})
+	}
+}
@@ -25,6 +25,7 @@ func InitTestHeimdallConfig(chain string) {
 
 	privKeyObject = secp256k1.GenPrivKey()
 	pubKeyObject = privKeyObject.PubKey().(secp256k1.PubKey)
+	signerObject = NewLocalSigner(privKeyObject)
 }
 
 // SetTestConfig sets test configuration
@@ -41,6 +42,14 @@ func SetTestPrivPubKey(privKey secp256k1.PrivKey) {
 		panic("pub key is not of type secp256k1.PrivKey")
 	}
 	pubKeyObject = pubKey
+	signerObject = NewLocalSigner(privKey)
+}
+
+// SetTestSigner sets the signer of the validator key for testing, e.g. a mock remote signer
+func SetTestSigner(signer Signer) {
+	privKeyObject = nil
+	pubKeyObject = signer.PubKey()
+	signerObject = signer
 }
 
 // SetTestInitialHeight sets test the initial height for testing
@@ -0,0 +1,145 @@
+package helper
+
+import (
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/0xPolygon/heimdall-v2/metrics"
+)
+
+// maxMainChainCallBudget bounds the worst-case time a single main chain call
+// may consume across a failover cascade. Side handlers read L1 (e.g. checkpoint
+// acks) within CometBFT's ~10s ABCI budget, like the Bor ones.
+const maxMainChainCallBudget = 9 * time.Second
+
+// mainChainIDs are the chain IDs of the main chain (L1) of each network. With
+// failover, every main chain endpoint must report the one of the configured
+// network; local and custom networks have none, so the primary anchors it.
+var mainChainIDs = map[string]*big.Int{
+	MainChain:   big.NewInt(1),        // Ethereum mainnet
+	AmoyChain:   big.NewInt(11155111), // Sepolia
+	MumbaiChain: big.NewInt(5),        // Goerli
+}
+
+// mainRPCFailoverTransport holds the running main chain HTTP failover transport
+// so CloseMainChainClients can stop its prober; nil when failover is not
+// configured.
+var mainRPCFailoverTransport *httpFailoverTransport
+
+// mainChainClientsMu serializes CloseMainChainClients, reached from both the
+// bridge and the start command shutdown paths.
+var mainChainClientsMu sync.Mutex
+
+// initMainChainClient sets the mainRPCClient/mainChainClient globals. A
+// comma-separated eth_rpc_url enables HTTP failover across the priority-ordered
+// endpoints (index 0 = primary), pinned to the chain ID of the configured
+// network when known; a single URL keeps the plain dial path unchanged.
+func initMainChainClient() {
+	var err error
+
+	mainRPCUrls := parseURLs(conf.Custom.EthRPCUrl)
+	switch {
+	case len(mainRPCUrls) >= 2:
+		if mainRPCFailoverTransport, mainRPCClient, err = newHTTPFailoverClient(
+			"eth", mainRPCUrls, conf.Custom.EthRPCTimeout, mainChainIDs[conf.Custom.Chain], metrics.EthFailover(),
+		); err != nil {
+			log.Fatal("unable to set up main chain RPC failover client", "URLs", redactURLs(conf.Custom.EthRPCUrl), "error", err)
+		}
+	case len(mainRPCUrls) == 1:
+		if mainRPCClient, err = rpc.Dial(mainRPCUrls[0]); err != nil {
+			log.Fatal("unable to dial main chain RPC client", "URL", redactURL(mainRPCUrls[0]), "error", err)
+		}
+	default:
+		log.Fatal("eth_rpc_url is empty")
+	}
+
+	mainChainClient = ethclient.NewClient(mainRPCClient)
+
+	if len(mainRPCUrls) == 1 {
+		warnIfMainChainIDMismatch(mainChainClient, mainChainIDs[conf.Custom.Chain], conf.Custom.EthRPCTimeout)
+	}
+}
+
+// initMainChainArchiveClient sets the mainChainArchiveClient global when
+// eth_archive_rpc_url is set, pinned to the chain ID of the configured network
+// like a single eth_rpc_url.
+func initMainChainArchiveClient() {
+	mainChainArchiveClient = nil
+	if conf.Custom.EthArchiveRPCUrl == "" {
+		return
+	}
+
+	rpcClient, err := rpc.Dial(conf.Custom.EthArchiveRPCUrl)
+	if err != nil {
+		log.Fatal("unable to dial main chain archive RPC client", "URL", redactURL(conf.Custom.EthArchiveRPCUrl), "error", err)
+	}
+
+	mainChainArchiveClient = ethclient.NewClient(rpcClient)
+
+	warnIfMainChainIDMismatch(mainChainArchiveClient, mainChainIDs[conf.Custom.Chain], conf.Custom.EthRPCTimeout)
+}
+
+// warnIfMainChainIDMismatch logs an error when the single main chain endpoint
+// serves another network than the configured one. Unreachable endpoints and
+// networks without a known chain ID are not checked.
+func warnIfMainChainIDMismatch(client chainIDProbe, expected *big.Int, timeout time.Duration) bool {
+	if expected == nil {
+		return false
+	}
+
+	id, ok := fetchChainID(client, timeout)
+	if !ok || id.Cmp(expected) == 0 {
+		return false
+	}
+
+	Logger.Error("main chain RPC endpoint serves another network than the configured chain",
+		"chain", conf.Custom.Chain, "chainId", id, "expectedChainId", expected)
+
+	return true
+}
+
+// GetMainChainCallTimeout returns the time budget a caller should allow for a
+// single main chain call. A single endpoint yields eth_rpc_timeout unchanged;
+// with failover the per-endpoint timeout is multiplied by the budgeted endpoint
+// count so a cascade can reach a fallback within the same call, capped at
+// maxMainChainCallBudget (but never below one attempt).
+func GetMainChainCallTimeout() time.Duration {
+	timeout := conf.Custom.EthRPCTimeout
+	if timeout <= 0 {
+		timeout = DefaultEthRPCTimeout
+	}
+
+	n := min(len(parseURLs(conf.Custom.EthRPCUrl)), maxBudgetedEndpoints)
+	if n <= 1 {
+		return timeout
+	}
+
+	return max(timeout, min(timeout*time.Duration(n), maxMainChainCallBudget))
+}
+
+// CloseMainChainClients stops the main chain failover prober, closes its probe
+// clients, the quorum reader clients and the main chain JSON-RPC client. Safe to
+// call when failover is not configured, and more than once.
+func CloseMainChainClients() {
+	mainChainClientsMu.Lock()
+	defer mainChainClientsMu.Unlock()
+	if mainRPCFailoverTransport != nil {
+		mainRPCFailoverTransport.Close()
+		mainRPCFailoverTransport = nil
+	}
+	if mainChainQuorum != nil {
+		mainChainQuorum.Close()
+		mainChainQuorum = nil
+	}
+	if mainRPCClient != nil {
+		// mainChainClient wraps the same underlying rpc.Client, so this closes both.
+		mainRPCClient.Close()
+		mainRPCClient = nil
+		mainChainClient = nil
+	}
+}
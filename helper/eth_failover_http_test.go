@@ -0,0 +1,107 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/bor/failover"
+)
+
+func TestHTTPTransport_PinnedChainIDAppliesToPrimary(t *testing.T) {
+	primary := fakeBorRPC(t, "0x5", new(int32)) // another network than the configured one
+	defer primary.Close()
+	fallback := fakeBorRPC(t, "0x1", new(int32))
+	defer fallback.Close()
+
+	tr := newTestTransport(mustEndpoint(t, primary.URL), mustEndpoint(t, fallback.URL))
+	tr.pinnedChainID = big.NewInt(1)
+
+	// the primary does not anchor the expectation when the chain id is pinned
+	require.ErrorContains(t, tr.probe(0), "!= configured 1")
+	require.NoError(t, tr.probe(1))
+	require.Nil(t, tr.expectedChainID.Load())
+}
+
+func TestNewHTTPFailoverClient_MainChain(t *testing.T) {
+	var h1, h2 int32
+	s1 := fakeBorRPC(t, "0x1", &h1)
+	s2 := fakeBorRPC(t, "0x1", &h2)
+	defer s2.Close()
+
+	tr, rc, err := newHTTPFailoverClient("eth", []string{s1.URL, s2.URL}, time.Second, big.NewInt(1), failover.Metrics{})
+	require.NoError(t, err)
+	defer tr.Close()
+	defer rc.Close()
+
+	// stop the background prober so it does not race the steps below
+	tr.health.Stop()
+
+	require.Equal(t, "eth", tr.chain)
+	require.Nil(t, tr.expectedChainID.Load(), "the pinned chain id is not captured from the primary")
+
+	// the fallback becomes a candidate once validated against the pinned chain id
+	require.NoError(t, tr.probe(1))
+	for range failover.DefaultConsecutiveThreshold {
+		tr.health.MarkSuccess(1)
+	}
+
+	s1.Close() // primary down → the call cascades to the fallback
+	id, err := ethclient.NewClient(rc).ChainID(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id.Int64())
+	require.Equal(t, 1, tr.health.Active())
+	require.Positive(t, atomic.LoadInt32(&h2))
+}
+
+func TestWarnIfMainChainIDMismatch(t *testing.T) {
+	s := fakeBorRPC(t, "0xaa36a7", new(int32)) // Sepolia
+	defer s.Close()
+	probe := mustEndpoint(t, s.URL).probe
+
+	require.False(t, warnIfMainChainIDMismatch(probe, nil, time.Second))
+	require.False(t, warnIfMainChainIDMismatch(probe, mainChainIDs[AmoyChain], time.Second))
+	require.True(t, warnIfMainChainIDMismatch(probe, mainChainIDs[MainChain], time.Second))
+
+	down := fakeBorRPC(t, "0x1", new(int32))
+	downURL := down.URL
+	down.Close()
+	require.False(t, warnIfMainChainIDMismatch(mustEndpoint(t, downURL).probe, mainChainIDs[MainChain], 200*time.Millisecond))
+}
+
+func TestGetMainChainCallTimeout(t *testing.T) {
+	cfg := CustomAppConfig{Custom: GetDefaultHeimdallConfig()}
+	cfg.Custom.EthRPCTimeout = 2 * time.Second
+
+	// Single endpoint unchanged.
+	cfg.Custom.EthRPCUrl = "http://a"
+	SetTestConfig(cfg)
+	require.Equal(t, 2*time.Second, GetMainChainCallTimeout())
+
+	// One attempt per endpoint.
+	cfg.Custom.EthRPCUrl = "http://a,http://b"
+	SetTestConfig(cfg)
+	require.Equal(t, 4*time.Second, GetMainChainCallTimeout())
+
+	// Capped at the budget.
+	cfg.Custom.EthRPCTimeout = 4 * time.Second
+	cfg.Custom.EthRPCUrl = "http://a,http://b,http://c,http://d"
+	SetTestConfig(cfg)
+	require.Equal(t, maxMainChainCallBudget, GetMainChainCallTimeout())
+
+	// Never below a single attempt.
+	cfg.Custom.EthRPCTimeout = 10 * time.Second
+	SetTestConfig(cfg)
+	require.Equal(t, 10*time.Second, GetMainChainCallTimeout())
+
+	// Unset timeout falls back to the default.
+	cfg.Custom.EthRPCTimeout = 0
+	cfg.Custom.EthRPCUrl = "http://a"
+	SetTestConfig(cfg)
+	require.Equal(t, DefaultEthRPCTimeout, GetMainChainCallTimeout())
+}
@@ -0,0 +1,128 @@
+package helper
+
+import (
+	"fmt"
+	"sort"
+	"sync/atomic"
+)
+
+// Names of the hard forks, as stored in the hardfork registry.
+const (
+	TallyFixFork                    = "tally_fix"
+	ProducerDowntimeFork            = "producer_downtime"
+	PhuketFork                      = "phuket"
+	FeeWithdrawValidatorGateFork    = "fee_withdraw_validator_gate"
+	ZurichFork                      = "zurich"
+	IthacaFork                      = "ithaca"
+	ClerkRecordIndexFork            = "clerk_record_index"
+	ClerkRecordPruningFork          = "clerk_record_pruning"
+	ProducerDowntimeWindowsFork     = "producer_downtime_windows"
+	ValidatorPerformanceHistoryFork = "validator_performance_history"
+	BlsCheckpointFork               = "bls_checkpoint"
+	BorSpanPruningFork              = "bor_span_pruning"
+	ValidatorJailFork               = "validator_jail"
+)
+
+// fork is the activation height of a hard fork, set per chain in InitHeimdallConfig,
+// and overridden by the heights stored in the hardfork registry. The height is atomic,
+// as the registry sets it while the queries and the bridge read it.
+type fork struct {
+	height *atomic.Int64
+	// activeAtZero is set for the forks active from genesis when their height is zero,
+	// instead of never active.
+	activeAtZero bool
+}
+
+// forks is the registry of the hard forks. The rio hard fork isn't part of it, as its height
+// is a bor block number.
+var forks = map[string]fork{
+	TallyFixFork:                    {height: &tallyFixHeight, activeAtZero: true},
+	ProducerDowntimeFork:            {height: &producerDowntimeHeight, activeAtZero: true},
+	PhuketFork:                      {height: &phuketHardforkHeight},
+	FeeWithdrawValidatorGateFork:    {height: &feeWithdrawValidatorGateHeight},
+	ZurichFork:                      {height: &zurichHardforkHeight},
+	IthacaFork:                      {height: &ithacaHeight},
+	ClerkRecordIndexFork:            {height: &clerkRecordIndexHeight},
+	ClerkRecordPruningFork:          {height: &clerkRecordPruningHeight},
+	ProducerDowntimeWindowsFork:     {height: &producerDowntimeWindowsHeight},
+	ValidatorPerformanceHistoryFork: {height: &validatorPerformanceHistoryHeight},
+	BlsCheckpointFork:               {height: &blsCheckpointHeight},
+	BorSpanPruningFork:              {height: &borSpanPruningHeight},
+	ValidatorJailFork:               {height: &validatorJailHeight},
+}
+
+// ForkNames returns the names of the hard forks, sorted.
+func ForkNames() []string {
+	names := make([]string, 0, len(forks))
+	for name := range forks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// IsKnownFork reports whether the hard fork with the given name exists.
+func IsKnownFork(name string) bool {
+	_, ok := forks[name]
+	return ok
+}
+
+// GetForkHeight returns the activation height of the hard fork, and false when it doesn't exist.
+func GetForkHeight(name string) (int64, bool) {
+	f, ok := forks[name]
+	if !ok {
+		return 0, false
+	}
+
+	return f.height.Load(), true
+}
+
+// SetForkHeight sets the activation height of the hard fork.
+func SetForkHeight(name string, height int64) error {
+	f, ok := forks[name]
+	if !ok {
+		return fmt.Errorf("unknown hard fork %q", name)
+	}
+
+	f.height.Store(height)
+
+	return nil
+}
+
+// IsForkActive reports whether the hard fork is active at the given height.
+func IsForkActive(name string, height int64) bool {
+	forkHeight, ok := GetForkHeight(name)
+	if !ok {
+		return false
+	}
+
+	return IsForkHeightActive(name, forkHeight, height)
+}
+
+// IsForkHeightActive reports whether the hard fork would be active at the given height,
+// were its activation height the given fork height.
+func IsForkHeightActive(name string, forkHeight, height int64) bool {
+	f, ok := forks[name]
+	if !ok {
+		return false
+	}
+
+	if forkHeight == 0 {
+		return f.activeAtZero
+	}
+
+	return height >= forkHeight
+}
+
+// IsForkScheduleLocked reports whether the hard fork heights are locked to the ones set in the code,
+// i.e. whether they can't be scheduled by governance. This is the case on the public networks.
+func IsForkScheduleLocked() bool {
+	switch conf.Custom.Chain {
+	case MainChain, MumbaiChain, AmoyChain:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,121 @@
+package helper
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkHeights(t *testing.T) {
+	origZurich := GetZurichHardforkHeight()
+	origTallyFix := GetTallyFixHeight()
+	t.Cleanup(func() {
+		SetZurichHardforkHeight(origZurich)
+		require.NoError(t, SetForkHeight(TallyFixFork, origTallyFix))
+	})
+
+	names := ForkNames()
+	require.True(t, sort.StringsAreSorted(names))
+	require.Contains(t, names, ZurichFork)
+	require.True(t, IsKnownFork(ZurichFork))
+	require.False(t, IsKnownFork("unknown"))
+
+	// the fork heights are the ones read by the existing helpers
+	require.NoError(t, SetForkHeight(ZurichFork, 100))
+	require.Equal(t, int64(100), GetZurichHardforkHeight())
+	require.False(t, IsZurichHardfork(99))
+	require.True(t, IsZurichHardfork(100))
+
+	height, ok := GetForkHeight(ZurichFork)
+	require.True(t, ok)
+	require.Equal(t, int64(100), height)
+	require.False(t, IsForkActive(ZurichFork, 99))
+	require.True(t, IsForkActive(ZurichFork, 100))
+
+	// a zero height disables the forks, except the ones active from genesis
+	require.NoError(t, SetForkHeight(ZurichFork, 0))
+	require.False(t, IsForkActive(ZurichFork, 100))
+	require.NoError(t, SetForkHeight(TallyFixFork, 0))
+	require.True(t, IsForkActive(TallyFixFork, 0))
+	require.True(t, IsForkHeightActive(TallyFixFork, 0, 1))
+	require.False(t, IsForkHeightActive(ZurichFork, 0, 1))
+	require.True(t, IsForkHeightActive(ZurichFork, 1, 1))
+
+	_, ok = GetForkHeight("unknown")
+	require.False(t, ok)
+	require.Error(t, SetForkHeight("unknown", 1))
+	require.False(t, IsForkActive("unknown", 1))
+}
+
+func TestForkHeightsMatchHelpers(t *testing.T) {
+	// the gate of each hard fork, as read by the modules
+	helpers := map[string]func(height int64) bool{
+		TallyFixFork:                    func(height int64) bool { return height >= GetTallyFixHeight() },
+		ProducerDowntimeFork:            func(height int64) bool { return height >= GetSetProducerDowntimeHeight() },
+		PhuketFork:                      IsPhuketHardfork,
+		FeeWithdrawValidatorGateFork:    IsFeeWithdrawValidatorGate,
+		ZurichFork:                      IsZurichHardfork,
+		IthacaFork:                      IsIthaca,
+		ClerkRecordIndexFork:            IsClerkRecordIndex,
+		ClerkRecordPruningFork:          IsClerkRecordPruning,
+		ProducerDowntimeWindowsFork:     IsProducerDowntimeWindows,
+		ValidatorPerformanceHistoryFork: IsValidatorPerformanceHistory,
+		BlsCheckpointFork:               IsBlsCheckpoint,
+		BorSpanPruningFork:              IsBorSpanPruning,
+		ValidatorJailFork:               IsValidatorJail,
+	}
+	require.ElementsMatch(t, ForkNames(), slices.Collect(maps.Keys(helpers)))
+
+	for _, name := range ForkNames() {
+		origHeight, _ := GetForkHeight(name)
+		t.Cleanup(func() { require.NoError(t, SetForkHeight(name, origHeight)) })
+
+		for _, forkHeight := range []int64{0, 1, 100} {
+			require.NoError(t, SetForkHeight(name, forkHeight))
+
+			for _, height := range []int64{0, 1, 99, 100, 101} {
+				require.Equal(t, helpers[name](height), IsForkActive(name, height),
+					"fork %s at height %d with fork height %d", name, height, forkHeight)
+				require.Equal(t, helpers[name](height), IsForkHeightActive(name, forkHeight, height),
+					"fork %s at height %d with fork height %d", name, height, forkHeight)
+			}
+		}
+	}
+}
+
+func TestSetForkHeightConcurrently(t *testing.T) {
+	origHeight := GetZurichHardforkHeight()
+	t.Cleanup(func() { SetZurichHardforkHeight(origHeight) })
+
+	// the registry sets the heights while the queries read them, which the race detector checks
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, SetForkHeight(ZurichFork, int64(i)))
+		}()
+		go func() {
+			defer wg.Done()
+			_ = IsZurichHardfork(int64(i))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestIsForkScheduleLocked(t *testing.T) {
+	origChain := conf.Custom.Chain
+	t.Cleanup(func() { conf.Custom.Chain = origChain })
+
+	for _, chain := range []string{MainChain, MumbaiChain, AmoyChain} {
+		conf.Custom.Chain = chain
+		require.True(t, IsForkScheduleLocked(), chain)
+	}
+
+	conf.Custom.Chain = "local"
+	require.False(t, IsForkScheduleLocked())
+}
@@ -27,15 +27,15 @@ func TestInitHeimdallConfigWithSetsIthacaHeightPerChain(t *testing.T) {
 	origPubKey := pubKeyObject
 	origProducerVotes := producerVotes
 	origRio := rioHeight
-	origTally := tallyFixHeight
+	origTally := tallyFixHeight.Load()
 	origDisableVP := disableVPCheckHeight
 	origDisableVal := disableValSetCheckHeight
 	origInitial := initialHeight
-	origProducerDown := producerDowntimeHeight
-	origPhuket := phuketHardforkHeight
-	origFeeGate := feeWithdrawValidatorGateHeight
-	origZurich := zurichHardforkHeight
-	origSpan := ithacaHeight
+	origProducerDown := producerDowntimeHeight.Load()
+	origPhuket := phuketHardforkHeight.Load()
+	origFeeGate := feeWithdrawValidatorGateHeight.Load()
+	origZurich := zurichHardforkHeight.Load()
+	origSpan := ithacaHeight.Load()
 	t.Cleanup(func() {
 		conf = origConf
 		mainRPCClient = origMainRPCClient
@@ -46,15 +46,15 @@ func TestInitHeimdallConfigWithSetsIthacaHeightPerChain(t *testing.T) {
 		pubKeyObject = origPubKey
 		producerVotes = origProducerVotes
 		rioHeight = origRio
-		tallyFixHeight = origTally
+		tallyFixHeight.Store(origTally)
 		disableVPCheckHeight = origDisableVP
 		disableValSetCheckHeight = origDisableVal
 		initialHeight = origInitial
-		producerDowntimeHeight = origProducerDown
-		phuketHardforkHeight = origPhuket
-		feeWithdrawValidatorGateHeight = origFeeGate
-		zurichHardforkHeight = origZurich
-		ithacaHeight = origSpan
+		producerDowntimeHeight.Store(origProducerDown)
+		phuketHardforkHeight.Store(origPhuket)
+		feeWithdrawValidatorGateHeight.Store(origFeeGate)
+		zurichHardforkHeight.Store(origZurich)
+		ithacaHeight.Store(origSpan)
 	})
 
 	rpcStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
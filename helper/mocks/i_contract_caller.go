@@ -10,6 +10,8 @@ import (
 
 	erc20 "github.com/0xPolygon/heimdall-v2/contracts/erc20"
 
+	helper "github.com/0xPolygon/heimdall-v2/helper"
+
 	mock "github.com/stretchr/testify/mock"
 
 	rootchain "github.com/0xPolygon/heimdall-v2/contracts/rootchain"
@@ -180,6 +182,36 @@ func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.
 	return r0
 }
 
+// DecodeJailedEvents provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeJailedEvents(_a0 string, _a1 *types.Receipt) ([]*stakinginfo.StakinginfoJailed, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecodeJailedEvents")
+	}
+
+	var r0 []*stakinginfo.StakinginfoJailed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *types.Receipt) ([]*stakinginfo.StakinginfoJailed, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, *types.Receipt) []*stakinginfo.StakinginfoJailed); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*stakinginfo.StakinginfoJailed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *types.Receipt) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 string, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -510,6 +542,36 @@ func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int,
 	return r0, r1
 }
 
+// GetBorBlockReceipts provides a mock function with given fields: ctx, blockNumber
+func (_m *IContractCaller) GetBorBlockReceipts(ctx context.Context, blockNumber uint64) ([]*types.Receipt, error) {
+	ret := _m.Called(ctx, blockNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBorBlockReceipts")
+	}
+
+	var r0 []*types.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]*types.Receipt, error)); ok {
+		return rf(ctx, blockNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*types.Receipt); ok {
+		r0 = rf(ctx, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBorChainBlock provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) GetBorChainBlock(_a0 context.Context, _a1 *big.Int) (*types.Header, error) {
 	ret := _m.Called(_a0, _a1)
@@ -646,6 +708,34 @@ func (_m *IContractCaller) GetBorChainBlockTd(ctx context.Context, blockHash com
 	return r0, r1
 }
 
+// GetBorChainLastStateID provides a mock function with given fields: ctx, stateReceiverAddress, blockNum
+func (_m *IContractCaller) GetBorChainLastStateID(ctx context.Context, stateReceiverAddress string, blockNum *big.Int) (uint64, error) {
+	ret := _m.Called(ctx, stateReceiverAddress, blockNum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBorChainLastStateID")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *big.Int) (uint64, error)); ok {
+		return rf(ctx, stateReceiverAddress, blockNum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *big.Int) uint64); ok {
+		r0 = rf(ctx, stateReceiverAddress, blockNum)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *big.Int) error); ok {
+		r1 = rf(ctx, stateReceiverAddress, blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBorTxReceipt provides a mock function with given fields: _a0
 func (_m *IContractCaller) GetBorTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
 	ret := _m.Called(_a0)
@@ -724,9 +814,9 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
-// GetConfirmedTxReceipt provides a mock function with given fields: ctx, txHash, requiredConfirmations
-func (_m *IContractCaller) GetConfirmedTxReceipt(ctx context.Context, txHash common.Hash, requiredConfirmations uint64) (*types.Receipt, error) {
-	ret := _m.Called(ctx, txHash, requiredConfirmations)
+// GetConfirmedTxReceipt provides a mock function with given fields: ctx, txHash, requiredConfirmations, mode
+func (_m *IContractCaller) GetConfirmedTxReceipt(ctx context.Context, txHash common.Hash, requiredConfirmations uint64, mode helper.ConfirmationMode) (*types.Receipt, error) {
+	ret := _m.Called(ctx, txHash, requiredConfirmations, mode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConfirmedTxReceipt")
@@ -734,19 +824,19 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(ctx context.Context, txHash com
 
 	var r0 *types.Receipt
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64) (*types.Receipt, error)); ok {
-		return rf(ctx, txHash, requiredConfirmations)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64, helper.ConfirmationMode) (*types.Receipt, error)); ok {
+		return rf(ctx, txHash, requiredConfirmations, mode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64) *types.Receipt); ok {
-		r0 = rf(ctx, txHash, requiredConfirmations)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64, helper.ConfirmationMode) *types.Receipt); ok {
+		r0 = rf(ctx, txHash, requiredConfirmations, mode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, uint64) error); ok {
-		r1 = rf(ctx, txHash, requiredConfirmations)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, uint64, helper.ConfirmationMode) error); ok {
+		r1 = rf(ctx, txHash, requiredConfirmations, mode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1246,6 +1336,34 @@ func (_m *IContractCaller) GetValidatorInfo(valID uint64, stakingInfoInstance *s
 	return r0, r1
 }
 
+// GetValidatorInfoAtBlock provides a mock function with given fields: valID, blockNumber, stakingInfoAddress
+func (_m *IContractCaller) GetValidatorInfoAtBlock(valID uint64, blockNumber *big.Int, stakingInfoAddress string) (staketypes.Validator, error) {
+	ret := _m.Called(valID, blockNumber, stakingInfoAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValidatorInfoAtBlock")
+	}
+
+	var r0 staketypes.Validator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *big.Int, string) (staketypes.Validator, error)); ok {
+		return rf(valID, blockNumber, stakingInfoAddress)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *big.Int, string) staketypes.Validator); ok {
+		r0 = rf(valID, blockNumber, stakingInfoAddress)
+	} else {
+		r0 = ret.Get(0).(staketypes.Validator)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *big.Int, string) error); ok {
+		r1 = rf(valID, blockNumber, stakingInfoAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetValidatorSetInstance provides a mock function with given fields: validatorSetAddress
 func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress string) (*validatorset.Validatorset, error) {
 	ret := _m.Called(validatorSetAddress)
@@ -1304,17 +1422,17 @@ func (_m *IContractCaller) GetVoteOnHash(start uint64, end uint64, hash string,
 	return r0, r1
 }
 
-// IsTxConfirmed provides a mock function with given fields: ctx, txHash, requiredConfirmations
-func (_m *IContractCaller) IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64) bool {
-	ret := _m.Called(ctx, txHash, requiredConfirmations)
+// IsTxConfirmed provides a mock function with given fields: ctx, txHash, requiredConfirmations, mode
+func (_m *IContractCaller) IsTxConfirmed(ctx context.Context, txHash common.Hash, requiredConfirmations uint64, mode helper.ConfirmationMode) bool {
+	ret := _m.Called(ctx, txHash, requiredConfirmations, mode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for IsTxConfirmed")
 	}
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64) bool); ok {
-		r0 = rf(ctx, txHash, requiredConfirmations)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64, helper.ConfirmationMode) bool); ok {
+		r0 = rf(ctx, txHash, requiredConfirmations, mode)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -0,0 +1,295 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrQuorumNotReached is returned by a quorum read when too few endpoints answered
+// or when the answers of the endpoints disagree.
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+// mainChainQuorum and borChainQuorum hold the quorum readers of the main chain and
+// bor endpoints; nil when quorum reads are not configured.
+var (
+	mainChainQuorum *QuorumReader
+	borChainQuorum  *QuorumReader
+)
+
+// QuorumReader reads the data used to verify side txs from every configured
+// endpoint of a chain, and only returns it when at least quorum endpoints
+// answered and all the answers are identical, so that a single compromised or
+// buggy RPC provider cannot make the validator vote YES on its own.
+type QuorumReader struct {
+	chain     string
+	urls      []string
+	clients   []*ethclient.Client
+	quorum    int
+	timeout   time.Duration
+	closeOnce sync.Once
+}
+
+// newQuorumReader dials every given endpoint, each one being queried on quorum
+// reads within the given timeout. The quorum must be between 1 and the number
+// of endpoints.
+func newQuorumReader(chain string, rawURLs []string, quorum int, timeout time.Duration) (*QuorumReader, error) {
+	if quorum < 1 || quorum > len(rawURLs) {
+		return nil, fmt.Errorf("%s RPC quorum %d must be between 1 and the number of configured endpoints (%d)", chain, quorum, len(rawURLs))
+	}
+
+	q := &QuorumReader{chain: chain, quorum: quorum, timeout: timeout}
+	for _, raw := range rawURLs {
+		rc, err := rpc.Dial(raw)
+		if err != nil {
+			q.Close()
+			return nil, fmt.Errorf("dialing %s RPC endpoint %s: %w", chain, redactURL(raw), err)
+		}
+
+		q.urls = append(q.urls, redactURL(raw))
+		q.clients = append(q.clients, ethclient.NewClient(rc))
+	}
+
+	return q, nil
+}
+
+// initQuorumReader returns the quorum reader of the given comma-separated
+// endpoints, nil when the quorum is 0 (quorum reads disabled).
+func initQuorumReader(chain string, rawURLs string, quorum int, timeout time.Duration) *QuorumReader {
+	if quorum == 0 {
+		return nil
+	}
+
+	q, err := newQuorumReader(chain, parseURLs(rawURLs), quorum, timeout)
+	if err != nil {
+		log.Fatal("unable to set up the RPC quorum reader", "chain", chain, "error", err)
+	}
+
+	Logger.Info("RPC quorum reads enabled", "chain", chain, "quorum", quorum, "endpoints", len(q.clients))
+
+	return q
+}
+
+// Close closes the clients of the quorum reader. Safe to call more than once.
+func (q *QuorumReader) Close() {
+	q.closeOnce.Do(func() {
+		for _, client := range q.clients {
+			client.Close()
+		}
+	})
+}
+
+// TransactionReceipt returns the receipt of the given tx once at least quorum
+// endpoints returned it, with identical status, logs and inclusion, and no
+// endpoint returned a different one.
+func (q *QuorumReader) TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error) {
+	return quorumRead(ctx, q, "eth_getTransactionReceipt",
+		func(ctx context.Context, client *ethclient.Client) (*ethTypes.Receipt, error) {
+			return client.TransactionReceipt(ctx, txHash)
+		},
+		receiptQuorumKey,
+	)
+}
+
+// RootHash returns the bor root hash of the given block range once at least
+// quorum endpoints returned it, and no endpoint returned a different one.
+func (q *QuorumReader) RootHash(ctx context.Context, start uint64, end uint64) (string, error) {
+	return quorumRead(ctx, q, "bor_getRootHash",
+		func(ctx context.Context, client *ethclient.Client) (string, error) {
+			return client.GetRootHash(ctx, start, end)
+		},
+		func(rootHash string) (string, error) {
+			return strings.ToLower(rootHash), nil
+		},
+	)
+}
+
+// IsBlockConfirmed reports whether the given block has at least the given number
+// of confirmations, once at least quorum endpoints agreed on it from their latest
+// header, and no endpoint answered otherwise.
+func (q *QuorumReader) IsBlockConfirmed(ctx context.Context, blockNumber uint64, confirmations uint64) (bool, error) {
+	return quorumRead(ctx, q, "eth_getBlockByNumber(latest)",
+		func(ctx context.Context, client *ethclient.Client) (bool, error) {
+			latest, err := client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return false, err
+			}
+
+			if latest == nil || latest.Number == nil {
+				return false, errors.New("latest header or number is nil")
+			}
+
+			latestNum := latest.Number.Uint64()
+
+			return latestNum >= blockNumber && latestNum-blockNumber >= confirmations, nil
+		},
+		boolQuorumKey,
+	)
+}
+
+// IsBlockFinalized reports whether the given block is finalized, once at least
+// quorum endpoints agreed on it from their latest finalized header, and no
+// endpoint answered otherwise.
+func (q *QuorumReader) IsBlockFinalized(ctx context.Context, blockNumber uint64) (bool, error) {
+	return quorumRead(ctx, q, "eth_getBlockByNumber(finalized)",
+		func(ctx context.Context, client *ethclient.Client) (bool, error) {
+			finalized, err := client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+			if err != nil {
+				return false, err
+			}
+
+			if finalized == nil || finalized.Number == nil {
+				return false, errors.New("finalized header or number is nil")
+			}
+
+			return blockNumber <= finalized.Number.Uint64(), nil
+		},
+		boolQuorumKey,
+	)
+}
+
+// quorumRead queries every endpoint of the reader concurrently, and returns the
+// answer when at least quorum of them returned it and none returned another
+// one. Endpoints failing to answer are not counted.
+func quorumRead[T any](
+	ctx context.Context,
+	q *QuorumReader,
+	method string,
+	read func(context.Context, *ethclient.Client) (T, error),
+	key func(T) (string, error),
+) (T, error) {
+	type answer struct {
+		value T
+		key   string
+		err   error
+	}
+
+	answers := make([]answer, len(q.clients))
+
+	var wg sync.WaitGroup
+	for i, client := range q.clients {
+		wg.Add(1)
+		go func(i int, client *ethclient.Client) {
+			defer wg.Done()
+
+			callCtx, cancel := context.WithTimeout(ctx, q.timeout)
+			defer cancel()
+
+			value, err := read(callCtx, client)
+			if err == nil {
+				answers[i].key, err = key(value)
+			}
+
+			answers[i].value = value
+			answers[i].err = err
+		}(i, client)
+	}
+	wg.Wait()
+
+	var (
+		result  T
+		agreed  int
+		firstOK = -1
+	)
+
+	for i, a := range answers {
+		if a.err != nil {
+			Logger.Debug("Quorum read failed on endpoint", "chain", q.chain, "method", method, "url", q.urls[i], "error", a.err)
+			continue
+		}
+
+		if firstOK == -1 {
+			firstOK = i
+			result = a.value
+		}
+
+		if a.key != answers[firstOK].key {
+			Logger.Error("RPC endpoints disagree on quorum read", "chain", q.chain, "method", method,
+				"url", q.urls[firstOK], "otherUrl", q.urls[i])
+
+			var zero T
+			return zero, fmt.Errorf("%w: %s endpoints disagree on %s", ErrQuorumNotReached, q.chain, method)
+		}
+
+		agreed++
+	}
+
+	if agreed < q.quorum {
+		Logger.Error("Not enough RPC endpoints answered the quorum read", "chain", q.chain, "method", method,
+			"answered", agreed, "quorum", q.quorum)
+
+		var zero T
+		return zero, fmt.Errorf("%w: %d of %d %s endpoints answered %s, %d required", ErrQuorumNotReached, agreed, len(q.clients), q.chain, method, q.quorum)
+	}
+
+	return result, nil
+}
+
+// boolQuorumKey identifies a yes/no answer, so that endpoints at different heads
+// still agree as long as they give the same answer.
+func boolQuorumKey(ok bool) (string, error) {
+	return strconv.FormatBool(ok), nil
+}
+
+// receiptQuorumKey identifies a receipt by its consensus encoding (status,
+// cumulative gas, bloom and logs) along with its inclusion and the indexes of its
+// logs, which are used to look the events up.
+func receiptQuorumKey(receipt *ethTypes.Receipt) (string, error) {
+	if receipt == nil {
+		return "", errors.New("ethereum tx receipt not found")
+	}
+
+	encoded, err := receipt.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	blockNumber := receipt.BlockNumber
+	if blockNumber == nil {
+		blockNumber = new(big.Int)
+	}
+
+	logIndexes := make([]uint64, 0, len(receipt.Logs))
+	for _, vLog := range receipt.Logs {
+		logIndexes = append(logIndexes, uint64(vLog.Index))
+	}
+
+	key, err := rlp.EncodeToBytes([]interface{}{
+		encoded,
+		receipt.TxHash,
+		receipt.BlockHash,
+		blockNumber,
+		uint64(receipt.TransactionIndex),
+		logIndexes,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.Keccak256Hash(key).Hex(), nil
+}
+
+// GetMainChainQuorumReader returns the main chain quorum reader, nil when
+// eth_rpc_quorum is not set
+func GetMainChainQuorumReader() *QuorumReader {
+	return mainChainQuorum
+}
+
+// GetBorChainQuorumReader returns the bor chain quorum reader, nil when
+// bor_rpc_quorum is not set
+func GetBorChainQuorumReader() *QuorumReader {
+	return borChainQuorum
+}
@@ -0,0 +1,209 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuorumRPC serves the given result to every JSON-RPC call, or fails them when result is nil
+func fakeQuorumRPC(t *testing.T, result interface{}) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if result == nil {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "unavailable"}
+		} else {
+			resp["result"] = result
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestQuorumReader(t *testing.T, quorum int, results ...interface{}) *QuorumReader {
+	t.Helper()
+
+	urls := make([]string, 0, len(results))
+	for _, result := range results {
+		urls = append(urls, fakeQuorumRPC(t, result).URL)
+	}
+
+	q, err := newQuorumReader("eth", urls, quorum, time.Second)
+	require.NoError(t, err)
+	t.Cleanup(q.Close)
+
+	return q
+}
+
+func testQuorumReceipt(logIndex uint) *ethTypes.Receipt {
+	txHash := common.HexToHash("0x01")
+	blockHash := common.HexToHash("0x02")
+
+	return &ethTypes.Receipt{
+		Status:            ethTypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs: []*ethTypes.Log{{
+			Address:     common.HexToAddress("0x03"),
+			Topics:      []common.Hash{common.HexToHash("0x04")},
+			Data:        []byte{0x05},
+			BlockNumber: 100,
+			TxHash:      txHash,
+			BlockHash:   blockHash,
+			Index:       logIndex,
+		}},
+		TxHash:      txHash,
+		GasUsed:     21000,
+		BlockHash:   blockHash,
+		BlockNumber: big.NewInt(100),
+	}
+}
+
+func TestNewQuorumReader_InvalidQuorum(t *testing.T) {
+	_, err := newQuorumReader("eth", []string{"http://a", "http://b"}, 3, time.Second)
+	require.ErrorContains(t, err, "must be between 1 and the number of configured endpoints (2)")
+
+	_, err = newQuorumReader("eth", []string{"http://a"}, 0, time.Second)
+	require.Error(t, err)
+}
+
+func TestQuorumReader_TransactionReceipt(t *testing.T) {
+	receipt := testQuorumReceipt(1)
+
+	tests := []struct {
+		name    string
+		quorum  int
+		results []interface{}
+		wantErr bool
+	}{
+		{
+			name:    "all endpoints agree",
+			quorum:  3,
+			results: []interface{}{receipt, receipt, receipt},
+		},
+		{
+			name:    "quorum reached with an endpoint down",
+			quorum:  2,
+			results: []interface{}{receipt, nil, receipt},
+		},
+		{
+			name:    "too few endpoints answered",
+			quorum:  2,
+			results: []interface{}{receipt, nil, nil},
+			wantErr: true,
+		},
+		{
+			name:    "an endpoint returns another log index",
+			quorum:  2,
+			results: []interface{}{receipt, receipt, testQuorumReceipt(2)},
+			wantErr: true,
+		},
+		{
+			name:    "an endpoint does not know the tx",
+			quorum:  3,
+			results: []interface{}{receipt, receipt, json.RawMessage("null")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q := newTestQuorumReader(t, tc.quorum, tc.results...)
+
+			got, err := q.TransactionReceipt(context.Background(), receipt.TxHash)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrQuorumNotReached)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, receipt.TxHash, got.TxHash)
+			require.Equal(t, uint(1), got.Logs[0].Index)
+		})
+	}
+}
+
+func TestQuorumReader_RootHash(t *testing.T) {
+	rootHash := common.HexToHash("0xabcdef").Hex()
+
+	q := newTestQuorumReader(t, 2, rootHash, rootHash)
+	got, err := q.RootHash(context.Background(), 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, rootHash, got)
+
+	q = newTestQuorumReader(t, 1, rootHash, common.HexToHash("0x01").Hex())
+	_, err = q.RootHash(context.Background(), 1, 10)
+	require.ErrorIs(t, err, ErrQuorumNotReached)
+}
+
+func TestGetConfirmedTxReceipt_Quorum(t *testing.T) {
+	receipt := testQuorumReceipt(1)
+
+	c := &ContractCaller{
+		MainChainTimeout: time.Second,
+		MainChainQuorum:  newTestQuorumReader(t, 2, receipt, testQuorumReceipt(2)),
+		prefetchedReceipts: map[common.Hash]*ethTypes.Receipt{
+			receipt.TxHash: receipt,
+		},
+	}
+
+	// the receipt prefetched from a single endpoint is ignored
+	_, err := c.getOrFetchReceipt(context.Background(), receipt.TxHash)
+	require.ErrorIs(t, err, ErrQuorumNotReached)
+}
+
+func TestQuorumReader_IsBlockConfirmed(t *testing.T) {
+	header := func(number int64) map[string]interface{} {
+		h := &ethTypes.Header{Number: big.NewInt(number), Difficulty: big.NewInt(0)}
+		encoded, err := json.Marshal(h)
+		require.NoError(t, err)
+
+		var result map[string]interface{}
+		require.NoError(t, json.Unmarshal(encoded, &result))
+
+		return result
+	}
+
+	// endpoints at different heads agree as long as the block is confirmed on both
+	q := newTestQuorumReader(t, 2, header(110), header(112))
+	confirmed, err := q.IsBlockConfirmed(context.Background(), 100, 10)
+	require.NoError(t, err)
+	require.True(t, confirmed)
+
+	confirmed, err = q.IsBlockConfirmed(context.Background(), 100, 20)
+	require.NoError(t, err)
+	require.False(t, confirmed)
+
+	// a lagging endpoint blocks the confirmation
+	q = newTestQuorumReader(t, 1, header(110), header(105))
+	_, err = q.IsBlockConfirmed(context.Background(), 100, 10)
+	require.ErrorIs(t, err, ErrQuorumNotReached)
+
+	// the finalized header is checked the same way
+	q = newTestQuorumReader(t, 2, header(100), header(101))
+	finalized, err := q.IsBlockFinalized(context.Background(), 100)
+	require.NoError(t, err)
+	require.True(t, finalized)
+
+	q = newTestQuorumReader(t, 2, header(100), nil)
+	_, err = q.IsBlockFinalized(context.Background(), 100)
+	require.ErrorIs(t, err, ErrQuorumNotReached)
+}
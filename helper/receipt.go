@@ -9,13 +9,28 @@ import (
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// ConfirmationMode selects how main chain txs are considered confirmed.
+// It mirrors the chainmanager ConfirmationMode param.
+type ConfirmationMode int32
+
+const (
+	// ConfirmationModeDefault requires the finalized block when the main chain exposes it,
+	// the required confirmations otherwise.
+	ConfirmationModeDefault ConfirmationMode = 0
+	// ConfirmationModeDepth requires the required confirmations.
+	ConfirmationModeDepth ConfirmationMode = 1
+	// ConfirmationModeFinality requires the finalized block.
+	ConfirmationModeFinality ConfirmationMode = 2
+)
+
 // ReceiptValidationParams holds parameters for receipt validation.
 // ModuleName is used for logging to identify which module is performing the validation.
 type ReceiptValidationParams struct {
-	TxHash         []byte
-	MsgBlockNumber uint64
-	Confirmations  uint64
-	ModuleName     string
+	TxHash           []byte
+	MsgBlockNumber   uint64
+	Confirmations    uint64
+	ConfirmationMode ConfirmationMode
+	ModuleName       string
 }
 
 // FetchAndValidateReceipt fetches and validates the confirmed tx receipt;
@@ -31,6 +46,7 @@ func FetchAndValidateReceipt(
 		ctx,
 		common.BytesToHash(params.TxHash),
 		params.Confirmations,
+		params.ConfirmationMode,
 	)
 
 	if receipt == nil || err != nil {
@@ -68,6 +84,12 @@ func PrefetchReceipts(ctx context.Context, contractCaller IContractCaller, txHas
 		return
 	}
 
+	// receipts read from a single endpoint are not used with quorum reads
+	if caller.MainChainQuorum != nil {
+		logger.Debug("Prefetch skipped: main chain quorum reads are enabled")
+		return
+	}
+
 	receipts := caller.BatchGetMainChainTxReceipts(ctx, txHashes)
 	if len(receipts) == 0 {
 		logger.Debug("Batch RPC returned no receipts", "requested", len(txHashes))
@@ -1,4 +1,4 @@
-package helper
+package helper_test
 
 import (
 	"context"
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/helper/mocks"
 )
 
@@ -22,7 +23,7 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 		name           string
 		receipt        *ethTypes.Receipt
 		err            error
-		params         ReceiptValidationParams
+		params         helper.ReceiptValidationParams
 		expectedResult bool // true if the receipt should be returned (not nil)
 	}{
 		{
@@ -31,7 +32,7 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 				BlockNumber: big.NewInt(100),
 			},
 			err: nil,
-			params: ReceiptValidationParams{
+			params: helper.ReceiptValidationParams{
 				TxHash:         common.Hex2Bytes("0x1234"),
 				MsgBlockNumber: 100,
 				Confirmations:  6,
@@ -39,11 +40,26 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 			},
 			expectedResult: true,
 		},
+		{
+			name: "valid receipt with finality-based confirmation",
+			receipt: &ethTypes.Receipt{
+				BlockNumber: big.NewInt(100),
+			},
+			err: nil,
+			params: helper.ReceiptValidationParams{
+				TxHash:           common.Hex2Bytes("0x1234"),
+				MsgBlockNumber:   100,
+				Confirmations:    6,
+				ConfirmationMode: helper.ConfirmationModeFinality,
+				ModuleName:       "test",
+			},
+			expectedResult: true,
+		},
 		{
 			name:    "nil receipt",
 			receipt: nil,
 			err:     nil,
-			params: ReceiptValidationParams{
+			params: helper.ReceiptValidationParams{
 				TxHash:         common.Hex2Bytes("0x1234"),
 				MsgBlockNumber: 100,
 				Confirmations:  6,
@@ -55,7 +71,7 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 			name:    "error fetching receipt",
 			receipt: nil,
 			err:     errors.New("network error"),
-			params: ReceiptValidationParams{
+			params: helper.ReceiptValidationParams{
 				TxHash:         common.Hex2Bytes("0x1234"),
 				MsgBlockNumber: 100,
 				Confirmations:  6,
@@ -69,7 +85,7 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 				BlockNumber: big.NewInt(99),
 			},
 			err: nil,
-			params: ReceiptValidationParams{
+			params: helper.ReceiptValidationParams{
 				TxHash:         common.Hex2Bytes("0x1234"),
 				MsgBlockNumber: 100,
 				Confirmations:  6,
@@ -85,10 +101,10 @@ func TestFetchAndValidateReceipt(t *testing.T) {
 
 			// Set up the mock expectation for GetConfirmedTxReceipt
 			txHash := common.BytesToHash(tt.params.TxHash)
-			mockCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash, tt.params.Confirmations).
+			mockCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash, tt.params.Confirmations, tt.params.ConfirmationMode).
 				Return(tt.receipt, tt.err)
 
-			result := FetchAndValidateReceipt(context.Background(), mockCaller, tt.params, logger)
+			result := helper.FetchAndValidateReceipt(context.Background(), mockCaller, tt.params, logger)
 
 			if tt.expectedResult {
 				require.NotNil(t, result, "expected receipt to be returned")
@@ -0,0 +1,198 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// remoteSignerPublicKeysPath lists the public keys of the remote signer
+	remoteSignerPublicKeysPath = "/api/v1/eth1/publicKeys"
+	// remoteSignerSignPath signs with the key whose public key follows
+	remoteSignerSignPath = "/api/v1/eth1/sign/"
+
+	// maxRemoteSignerResponseSize bounds the responses read from the remote signer
+	maxRemoteSignerResponseSize = 1 << 20
+)
+
+// RemoteSigner signs with a key held by a remote signing service, e.g. backed by an HSM or a cloud KMS.
+// It speaks the eth1 signing API of Web3Signer, which is also implemented by other KMS-backed signers:
+// GET /api/v1/eth1/publicKeys lists the available keys, and POST /api/v1/eth1/sign/{public key}
+// with {"data": "0x..."} returns the hex encoded signature of the keccak256 hash of the data.
+type RemoteSigner struct {
+	url        string
+	identifier string
+	pubKey     secp256k1.PubKey
+	client     *http.Client
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects to the remote signer at the given url, and selects the key with the given public key.
+// When no public key is given, the signer must hold a single key.
+func NewRemoteSigner(url string, pubKey string, timeout time.Duration) (*RemoteSigner, error) {
+	if timeout <= 0 {
+		timeout = DefaultRemoteSignerTimeout
+	}
+
+	s := &RemoteSigner{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+
+	keys, err := s.publicKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the remote signer keys: %w", err)
+	}
+
+	var want secp256k1.PubKey
+	if pubKey != "" {
+		if want, err = parseRemoteSignerPubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid remote signer public key: %w", err)
+		}
+	}
+
+	for identifier, key := range keys {
+		if want != nil && !bytes.Equal(key, want) {
+			continue
+		}
+
+		if s.pubKey != nil {
+			return nil, errors.New("remote signer holds several keys, the public key of the validator must be configured")
+		}
+
+		s.identifier, s.pubKey = identifier, key
+	}
+
+	if s.pubKey == nil {
+		return nil, errors.New("validator key not found in the remote signer")
+	}
+
+	return s, nil
+}
+
+// PubKey implements Signer
+func (s *RemoteSigner) PubKey() secp256k1.PubKey {
+	return s.pubKey
+}
+
+// Sign implements Signer. The signature is checked against the public key, so that a misbehaving
+// signer can't make the node send invalid signatures.
+func (s *RemoteSigner) Sign(msg []byte) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"data": hexutil.Encode(msg)})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.do(http.MethodPost, remoteSignerSignPath+s.identifier, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signature is returned as plain text, or as a JSON string by some implementations
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(res)), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from the remote signer: %w", err)
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length from the remote signer: %d", len(sig))
+	}
+
+	// signers may return the recovery id in the 27/28 form
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	recovered, err := crypto.Ecrecover(crypto.Keccak256(msg), sig)
+	if err != nil || !bytes.Equal(recovered, s.pubKey) {
+		return nil, errors.New("signature from the remote signer doesn't match the validator key")
+	}
+
+	return sig, nil
+}
+
+// publicKeys returns the keys of the remote signer, by their identifier
+func (s *RemoteSigner) publicKeys() (map[string]secp256k1.PubKey, error) {
+	res, err := s.do(http.MethodGet, remoteSignerPublicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers []string
+	if err := json.Unmarshal(res, &identifiers); err != nil {
+		return nil, fmt.Errorf("invalid public keys response: %w", err)
+	}
+
+	keys := make(map[string]secp256k1.PubKey, len(identifiers))
+	for _, identifier := range identifiers {
+		key, err := parseRemoteSignerPubKey(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", identifier, err)
+		}
+		keys[identifier] = key
+	}
+
+	return keys, nil
+}
+
+// do sends a request to the remote signer, and returns the body of its successful response
+func (s *RemoteSigner) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, s.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			Logger.Error("Error while closing the remote signer response body", "error", err)
+		}
+	}()
+
+	res, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteSignerResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(res)))
+	}
+
+	return res, nil
+}
+
+// parseRemoteSignerPubKey parses a hex encoded public key, with or without the 0x04 prefix of the uncompressed keys
+func parseRemoteSignerPubKey(key string) (secp256k1.PubKey, error) {
+	bz, err := hexutil.Decode(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) == secp256k1.PubKeySize-1 {
+		bz = append([]byte{0x04}, bz...)
+	}
+
+	if _, err := crypto.UnmarshalPubkey(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,138 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Signer signs with the validator key, which may live outside the node process.
+// Its signatures are the ones of secp256k1.PrivKey.Sign: 65 bytes long, in the R || S || V form with V being 0 or 1,
+// over the keccak256 hash of the message. This makes it fit for Heimdall txs, CometBFT votes and main chain txs alike.
+type Signer interface {
+	// PubKey returns the uncompressed public key of the validator
+	PubKey() secp256k1.PubKey
+	// Sign signs the keccak256 hash of the message
+	Sign(msg []byte) ([]byte, error)
+}
+
+// LocalSigner signs with a private key held in memory
+type LocalSigner struct {
+	privKey secp256k1.PrivKey
+	pubKey  secp256k1.PubKey
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// NewLocalSigner creates a signer from the given private key
+func NewLocalSigner(privKey secp256k1.PrivKey) *LocalSigner {
+	pubKey, ok := privKey.PubKey().(secp256k1.PubKey)
+	if !ok {
+		panic("pub key is not of type secp256k1.PubKey")
+	}
+
+	return &LocalSigner{privKey: privKey, pubKey: pubKey}
+}
+
+// PubKey implements Signer
+func (s *LocalSigner) PubKey() secp256k1.PubKey {
+	return s.pubKey
+}
+
+// Sign implements Signer
+func (s *LocalSigner) Sign(msg []byte) ([]byte, error) {
+	return s.privKey.Sign(msg)
+}
+
+// SignerAddress returns the main chain address of the signer
+func SignerAddress(signer Signer) common.Address {
+	return common.BytesToAddress(signer.PubKey().Address().Bytes())
+}
+
+// SignEthTx signs a main chain tx with the signer. Only the EIP-1559 and the legacy txs are supported,
+// as they are the only ones sent by heimdall.
+func SignEthTx(signer Signer, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ethSigner := types.LatestSignerForChainID(chainID)
+
+	preimage, err := ethTxSigningPreimage(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signer hashes the preimage itself: make sure it is the one of the tx hash
+	if hash := ethSigner.Hash(tx); !bytes.Equal(crypto.Keccak256(preimage), hash.Bytes()) {
+		return nil, fmt.Errorf("unexpected signing preimage for tx type %d", tx.Type())
+	}
+
+	sig, err := signer.Sign(preimage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+
+	return tx.WithSignature(ethSigner, sig)
+}
+
+// ethTxSigningPreimage returns the data whose keccak256 hash is signed for the tx
+func ethTxSigningPreimage(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes([]any{
+			chainID,
+			tx.Nonce(),
+			tx.GasTipCap(),
+			tx.GasFeeCap(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte{types.DynamicFeeTxType}, payload...), nil
+	case types.LegacyTxType:
+		return rlp.EncodeToBytes([]any{
+			tx.Nonce(),
+			tx.GasPrice(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			chainID, uint(0), uint(0),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
+	}
+}
+
+// NewSignerTransactor creates a transaction auth object signing with the given signer
+func NewSignerTransactor(signer Signer, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, errors.New("no chain id specified")
+	}
+
+	from := SignerAddress(signer)
+
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			return SignEthTx(signer, tx, chainID)
+		},
+		Context: context.Background(),
+	}, nil
+}
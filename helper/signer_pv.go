@@ -0,0 +1,308 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// steps of a round, as in the sign state of the CometBFT file private validator
+const (
+	stepPropose   int8 = 1
+	stepPrevote   int8 = 2
+	stepPrecommit int8 = 3
+)
+
+// SignerPVState is the last height, round and step signed by a SignerPV, along with what was signed.
+// Its JSON form extends the one of the CometBFT file private validator state with the signed vote extensions.
+type SignerPVState struct {
+	Height    int64             `json:"height"`
+	Round     int32             `json:"round"`
+	Step      int8              `json:"step"`
+	Signature []byte            `json:"signature,omitempty"`
+	SignBytes cmtbytes.HexBytes `json:"signbytes,omitempty"`
+
+	ExtensionSignature      []byte            `json:"extension_signature,omitempty"`
+	ExtensionSignBytes      cmtbytes.HexBytes `json:"extension_signbytes,omitempty"`
+	NonRpExtensionSignature []byte            `json:"non_rp_extension_signature,omitempty"`
+	NonRpExtensionSignBytes cmtbytes.HexBytes `json:"non_rp_extension_signbytes,omitempty"`
+}
+
+// checkHRS checks the given height, round and step against the last signed ones.
+// It returns an error on a regression, and whether they are the last signed ones.
+func (s *SignerPVState) checkHRS(height int64, round int32, step int8) (bool, error) {
+	switch {
+	case s.Height > height:
+		return false, fmt.Errorf("height regression. Got %v, last height %v", height, s.Height)
+	case s.Height < height:
+		return false, nil
+	case s.Round > round:
+		return false, fmt.Errorf("round regression at height %v. Got %v, last round %v", height, round, s.Round)
+	case s.Round < round:
+		return false, nil
+	case s.Step > step:
+		return false, fmt.Errorf("step regression at height %v round %v. Got %v, last step %v", height, round, step, s.Step)
+	case s.Step < step:
+		return false, nil
+	case s.SignBytes == nil || s.Signature == nil:
+		return false, errors.New("no SignBytes found")
+	default:
+		return true, nil
+	}
+}
+
+// SignerPV is a CometBFT private validator signing the proposals and votes with a Signer.
+// Like the file private validator, it never signs conflicting proposals or votes for the same height,
+// round and step, and persists what it signed before returning the signature. Unlike it, it also never
+// signs conflicting vote extensions: when a precommit is signed again, e.g. after a restart, its
+// extensions must be the ones signed the first time.
+type SignerPV struct {
+	mu        sync.Mutex
+	signer    Signer
+	state     SignerPVState
+	stateFile string
+}
+
+var _ cmttypes.PrivValidator = (*SignerPV)(nil)
+
+// NewSignerPV creates a private validator signing with the given signer, and persisting its sign state in
+// the given file. When the file doesn't exist yet, the state is seeded from the given CometBFT file private
+// validator state, if any, so that the double-sign protection carries over when the key is moved to the signer.
+func NewSignerPV(signer Signer, stateFile string, filePVStateFile string) (*SignerPV, error) {
+	pv := &SignerPV{signer: signer, stateFile: stateFile}
+
+	for _, file := range []string{stateFile, filePVStateFile} {
+		if file == "" {
+			continue
+		}
+
+		bz, err := os.ReadFile(file)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cmtjson.Unmarshal(bz, &pv.state); err != nil {
+			return nil, fmt.Errorf("failed to read the sign state from %s: %w", file, err)
+		}
+
+		break
+	}
+
+	return pv, nil
+}
+
+// State returns the last signed height, round and step
+func (pv *SignerPV) State() SignerPVState {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	return pv.state
+}
+
+// GetPubKey implements PrivValidator
+func (pv *SignerPV) GetPubKey() (crypto.PubKey, error) {
+	return pv.signer.PubKey(), nil
+}
+
+// SignVote implements PrivValidator
+func (pv *SignerPV) SignVote(chainID string, vote *cmtproto.Vote) error {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	if err := pv.signVote(chainID, vote); err != nil {
+		return fmt.Errorf("error signing vote: %w", err)
+	}
+
+	return nil
+}
+
+// SignProposal implements PrivValidator
+func (pv *SignerPV) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	if err := pv.signProposal(chainID, proposal); err != nil {
+		return fmt.Errorf("error signing proposal: %w", err)
+	}
+
+	return nil
+}
+
+func (pv *SignerPV) signVote(chainID string, vote *cmtproto.Vote) error {
+	var step int8
+	switch vote.Type {
+	case cmtproto.PrevoteType:
+		step = stepPrevote
+	case cmtproto.PrecommitType:
+		step = stepPrecommit
+	default:
+		return fmt.Errorf("unknown vote type: %v", vote.Type)
+	}
+
+	sameHRS, err := pv.state.checkHRS(vote.Height, vote.Round, step)
+	if err != nil {
+		return err
+	}
+
+	signBytes := cmttypes.VoteSignBytes(chainID, vote)
+
+	// extensions are signed for the non-nil precommits only, even when empty
+	var extSignBytes, nonRpExtSignBytes []byte
+	withExtensions := vote.Type == cmtproto.PrecommitType && !cmttypes.ProtoBlockIDIsNil(&vote.BlockID)
+	if withExtensions {
+		extSignBytes, nonRpExtSignBytes = cmttypes.VoteExtensionSignBytes(chainID, vote)
+	} else if len(vote.Extension) > 0 || len(vote.NonRpExtension) > 0 {
+		return errors.New("unexpected vote extension - extensions are only allowed in non-nil precommits")
+	}
+
+	// the vote may be signed again after a crash, before it hit the WAL: reuse the signatures
+	// when it only differs by its timestamp, and its extensions are the same
+	if sameHRS {
+		lastSignBytes := pv.state.SignBytes
+		if !bytes.Equal(signBytes, lastSignBytes) {
+			timestamp, ok := votesOnlyDifferByTimestamp(lastSignBytes, signBytes)
+			if !ok {
+				return errors.New("conflicting data")
+			}
+			vote.Timestamp = timestamp
+		}
+
+		if withExtensions && (!bytes.Equal(extSignBytes, pv.state.ExtensionSignBytes) ||
+			!bytes.Equal(nonRpExtSignBytes, pv.state.NonRpExtensionSignBytes)) {
+			return errors.New("conflicting vote extension")
+		}
+
+		vote.Signature = pv.state.Signature
+		vote.ExtensionSignature = pv.state.ExtensionSignature
+		vote.NonRpExtensionSignature = pv.state.NonRpExtensionSignature
+
+		return nil
+	}
+
+	state := SignerPVState{Height: vote.Height, Round: vote.Round, Step: step, SignBytes: signBytes}
+	if state.Signature, err = pv.signer.Sign(signBytes); err != nil {
+		return err
+	}
+
+	if withExtensions {
+		state.ExtensionSignBytes, state.NonRpExtensionSignBytes = extSignBytes, nonRpExtSignBytes
+		if state.ExtensionSignature, err = pv.signer.Sign(extSignBytes); err != nil {
+			return err
+		}
+		if state.NonRpExtensionSignature, err = pv.signer.Sign(nonRpExtSignBytes); err != nil {
+			return err
+		}
+	}
+
+	if err := pv.saveState(state); err != nil {
+		return err
+	}
+
+	vote.Signature = state.Signature
+	vote.ExtensionSignature = state.ExtensionSignature
+	vote.NonRpExtensionSignature = state.NonRpExtensionSignature
+
+	return nil
+}
+
+func (pv *SignerPV) signProposal(chainID string, proposal *cmtproto.Proposal) error {
+	sameHRS, err := pv.state.checkHRS(proposal.Height, proposal.Round, stepPropose)
+	if err != nil {
+		return err
+	}
+
+	signBytes := cmttypes.ProposalSignBytes(chainID, proposal)
+
+	if sameHRS {
+		lastSignBytes := pv.state.SignBytes
+		if !bytes.Equal(signBytes, lastSignBytes) {
+			timestamp, ok := proposalsOnlyDifferByTimestamp(lastSignBytes, signBytes)
+			if !ok {
+				return errors.New("conflicting data")
+			}
+			proposal.Timestamp = timestamp
+		}
+
+		proposal.Signature = pv.state.Signature
+
+		return nil
+	}
+
+	state := SignerPVState{Height: proposal.Height, Round: proposal.Round, Step: stepPropose, SignBytes: signBytes}
+	if state.Signature, err = pv.signer.Sign(signBytes); err != nil {
+		return err
+	}
+
+	if err := pv.saveState(state); err != nil {
+		return err
+	}
+
+	proposal.Signature = state.Signature
+
+	return nil
+}
+
+// saveState persists the sign state, before the signature is released
+func (pv *SignerPV) saveState(state SignerPVState) error {
+	if pv.stateFile != "" {
+		bz, err := cmtjson.MarshalIndent(state, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := tempfile.WriteFileAtomic(pv.stateFile, bz, 0o600); err != nil {
+			return fmt.Errorf("failed to save the sign state: %w", err)
+		}
+	}
+
+	pv.state = state
+
+	return nil
+}
+
+// votesOnlyDifferByTimestamp returns the timestamp of the last signed vote, and whether the votes only differ by it
+func votesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
+	var lastVote, newVote cmtproto.CanonicalVote
+	if err := protoio.UnmarshalDelimited(lastSignBytes, &lastVote); err != nil {
+		return time.Time{}, false
+	}
+	if err := protoio.UnmarshalDelimited(newSignBytes, &newVote); err != nil {
+		return time.Time{}, false
+	}
+
+	lastTime := lastVote.Timestamp
+	newVote.Timestamp = lastTime
+
+	return lastTime, proto.Equal(&newVote, &lastVote)
+}
+
+// proposalsOnlyDifferByTimestamp returns the timestamp of the last signed proposal, and whether the proposals only differ by it
+func proposalsOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
+	var lastProposal, newProposal cmtproto.CanonicalProposal
+	if err := protoio.UnmarshalDelimited(lastSignBytes, &lastProposal); err != nil {
+		return time.Time{}, false
+	}
+	if err := protoio.UnmarshalDelimited(newSignBytes, &newProposal); err != nil {
+		return time.Time{}, false
+	}
+
+	lastTime := lastProposal.Timestamp
+	newProposal.Timestamp = lastTime
+
+	return lastTime, proto.Equal(&newProposal, &lastProposal)
+}
@@ -0,0 +1,285 @@
+package helper
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRemoteSigner serves the eth1 signing API with the given local signers
+type mockRemoteSigner struct {
+	signers []*LocalSigner
+	// tamper alters the returned signatures
+	tamper func(sig []byte) []byte
+}
+
+func (m *mockRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == remoteSignerPublicKeysPath:
+		keys := make([]string, 0, len(m.signers))
+		for _, signer := range m.signers {
+			// listed without the 0x04 prefix, as Web3Signer does
+			keys = append(keys, hexutil.Encode(signer.PubKey()[1:]))
+		}
+		_ = json.NewEncoder(w).Encode(keys)
+
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, remoteSignerSignPath):
+		var req struct {
+			Data string `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, signer := range m.signers {
+			if hexutil.Encode(signer.PubKey()[1:]) != strings.TrimPrefix(r.URL.Path, remoteSignerSignPath) {
+				continue
+			}
+
+			sig, err := signer.Sign(hexutil.MustDecode(req.Data))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			// returned with the recovery id in the 27/28 form
+			sig[64] += 27
+			if m.tamper != nil {
+				sig = m.tamper(sig)
+			}
+			_, _ = w.Write([]byte(hexutil.Encode(sig)))
+
+			return
+		}
+
+		http.Error(w, "key not found", http.StatusNotFound)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestLocalSigner() *LocalSigner {
+	return NewLocalSigner(secp256k1.GenPrivKey())
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	local := newTestLocalSigner()
+	other := newTestLocalSigner()
+	mock := &mockRemoteSigner{signers: []*LocalSigner{local, other}}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	// the key must be selected when the signer holds several keys
+	_, err := NewRemoteSigner(server.URL, "", time.Second)
+	require.ErrorContains(t, err, "several keys")
+
+	_, err = NewRemoteSigner(server.URL, hexutil.Encode(newTestLocalSigner().PubKey()), time.Second)
+	require.ErrorContains(t, err, "not found")
+
+	signer, err := NewRemoteSigner(server.URL, hexutil.Encode(local.PubKey()), time.Second)
+	require.NoError(t, err)
+	require.Equal(t, local.PubKey(), signer.PubKey())
+
+	msg := []byte("heimdall")
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	require.True(t, local.PubKey().VerifySignature(msg, sig))
+
+	expected, err := local.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, sig)
+
+	// a signature by another key is refused
+	mock.tamper = func(sig []byte) []byte {
+		sig[10] ^= 0xff
+		return sig
+	}
+	_, err = signer.Sign(msg)
+	require.ErrorContains(t, err, "doesn't match")
+}
+
+func TestRemoteSigner_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "locked", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewRemoteSigner(server.URL, "", time.Second)
+	require.ErrorContains(t, err, "status 503")
+}
+
+func TestSignEthTx(t *testing.T) {
+	t.Parallel()
+
+	local := newTestLocalSigner()
+	chainID := big.NewInt(11155111)
+	to := common.HexToAddress("0x86e4dc95c7fbdbf52e33d563bbdb00823894c287")
+
+	txs := []*types.Transaction{
+		types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     7,
+			GasTipCap: big.NewInt(1_000_000_000),
+			GasFeeCap: big.NewInt(21_000_000_000),
+			Gas:       300000,
+			To:        &to,
+			Data:      []byte{0x01, 0x02},
+		}),
+		types.NewTx(&types.LegacyTx{
+			Nonce:    8,
+			GasPrice: big.NewInt(30_000_000_000),
+			Gas:      21000,
+			To:       &to,
+			Value:    big.NewInt(1),
+		}),
+	}
+
+	for _, tx := range txs {
+		signed, err := SignEthTx(local, tx, chainID)
+		require.NoError(t, err)
+
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, SignerAddress(local), sender)
+	}
+
+	_, err := SignEthTx(local, types.NewTx(&types.AccessListTx{ChainID: chainID, To: &to}), chainID)
+	require.ErrorContains(t, err, "unsupported tx type")
+}
+
+func newTestVote(height int64, round int32, voteType cmtproto.SignedMsgType, extension []byte) *cmtproto.Vote {
+	vote := &cmtproto.Vote{
+		Type:   voteType,
+		Height: height,
+		Round:  round,
+		BlockID: cmtproto.BlockID{
+			Hash:          make([]byte, 32),
+			PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+		},
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+	}
+
+	if voteType == cmtproto.PrecommitType {
+		vote.Extension = extension
+		vote.NonRpExtension = []byte("non-rp")
+	}
+
+	return vote
+}
+
+func TestSignerPV_Votes(t *testing.T) {
+	t.Parallel()
+
+	const chainID = "heimdall-80002"
+	local := newTestLocalSigner()
+	stateFile := filepath.Join(t.TempDir(), "remote_signer_state.json")
+
+	pv, err := NewSignerPV(local, stateFile, "")
+	require.NoError(t, err)
+
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, local.PubKey(), pubKey)
+
+	prevote := newTestVote(10, 0, cmtproto.PrevoteType, nil)
+	require.NoError(t, pv.SignVote(chainID, prevote))
+
+	precommit := newTestVote(10, 0, cmtproto.PrecommitType, []byte("side txs"))
+	require.NoError(t, pv.SignVote(chainID, precommit))
+	require.NotEmpty(t, precommit.ExtensionSignature)
+	require.NotEmpty(t, precommit.NonRpExtensionSignature)
+
+	// signed again after a restart, with a new timestamp: the signatures are reused
+	resigned := newTestVote(10, 0, cmtproto.PrecommitType, []byte("side txs"))
+	resigned.Timestamp = resigned.Timestamp.Add(time.Second)
+
+	restarted, err := NewSignerPV(local, stateFile, "")
+	require.NoError(t, err)
+	require.NoError(t, restarted.SignVote(chainID, resigned))
+	require.Equal(t, precommit.Signature, resigned.Signature)
+	require.Equal(t, precommit.ExtensionSignature, resigned.ExtensionSignature)
+	require.Equal(t, precommit.Timestamp, resigned.Timestamp)
+
+	// a different extension for the same precommit is refused
+	require.ErrorContains(t, restarted.SignVote(chainID, newTestVote(10, 0, cmtproto.PrecommitType, []byte("other side txs"))), "conflicting vote extension")
+
+	// as well as a vote for another block
+	conflicting := newTestVote(10, 0, cmtproto.PrecommitType, []byte("side txs"))
+	conflicting.BlockID.Hash[0] = 1
+	require.ErrorContains(t, restarted.SignVote(chainID, conflicting), "conflicting data")
+
+	// and going back
+	require.ErrorContains(t, restarted.SignVote(chainID, newTestVote(10, 0, cmtproto.PrevoteType, nil)), "step regression")
+	require.ErrorContains(t, restarted.SignVote(chainID, newTestVote(9, 3, cmtproto.PrecommitType, nil)), "height regression")
+
+	// the next round is signed
+	next := newTestVote(10, 1, cmtproto.PrecommitType, []byte("other side txs"))
+	require.NoError(t, restarted.SignVote(chainID, next))
+	extSignBytes, _ := cmttypes.VoteExtensionSignBytes(chainID, next)
+	require.True(t, local.PubKey().VerifySignature(extSignBytes, next.ExtensionSignature))
+	require.Equal(t, int32(1), restarted.State().Round)
+}
+
+func TestSignerPV_Proposal(t *testing.T) {
+	t.Parallel()
+
+	const chainID = "heimdall-80002"
+	pv, err := NewSignerPV(newTestLocalSigner(), "", "")
+	require.NoError(t, err)
+
+	proposal := &cmtproto.Proposal{Type: cmtproto.ProposalType, Height: 5, Round: 0, PolRound: -1, Timestamp: time.Unix(1700000000, 0).UTC()}
+	require.NoError(t, pv.SignProposal(chainID, proposal))
+
+	again := *proposal
+	again.Signature = nil
+	again.Timestamp = again.Timestamp.Add(time.Second)
+	require.NoError(t, pv.SignProposal(chainID, &again))
+	require.Equal(t, proposal.Signature, again.Signature)
+	require.Equal(t, proposal.Timestamp, again.Timestamp)
+
+	other := *proposal
+	other.PolRound = 0
+	require.ErrorContains(t, pv.SignProposal(chainID, &other), "conflicting data")
+}
+
+func TestSignerPV_SeededFromFilePVState(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filePVState := filepath.Join(dir, "priv_validator_state.json")
+
+	// state left by the file private validator, before the key was moved to the remote signer
+	bz, err := cmtjson.MarshalIndent(privval.FilePVLastSignState{Height: 100, Round: 2, Step: 3}, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePVState, bz, 0o600))
+
+	pv, err := NewSignerPV(newTestLocalSigner(), filepath.Join(dir, "remote_signer_state.json"), filePVState)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), pv.State().Height)
+
+	require.ErrorContains(t, pv.SignVote("heimdall-80002", newTestVote(100, 1, cmtproto.PrecommitType, nil)), "round regression")
+	require.NoError(t, pv.SignVote("heimdall-80002", newTestVote(101, 0, cmtproto.PrevoteType, nil)))
+}
@@ -14,7 +14,9 @@ const DefaultConfigTemplate = `
 
 ##### RPC and REST configs #####
 
-# RPC endpoint for ethereum chain
+# RPC endpoint(s) for ethereum chain. Accepts a comma-separated, priority-ordered
+# list for failover (first = primary; heimdall reverts to it once it recovers).
+# A single URL keeps the previous single-endpoint behavior.
 eth_rpc_url = "{{ .Custom.EthRPCUrl }}"
 
 # RPC endpoint(s) for bor chain. Accepts a comma-separated, priority-ordered
@@ -32,6 +34,20 @@ bor_grpc_url = "{{ .Custom.BorGRPCUrl }}"
 # Bearer token for bor gRPC authentication (empty disables auth)
 bor_grpc_token = "{{ .Custom.BorGRPCToken }}"
 
+# Quorum reads for side tx verification (0 disables them). When set, the L1 tx
+# receipts (eth_rpc_quorum) and the bor checkpoint root hashes (bor_rpc_quorum)
+# are read from every endpoint of eth_rpc_url / bor_rpc_url, and the validator
+# only votes YES when at least that many endpoints return identical data and
+# none returns a different one.
+eth_rpc_quorum = "{{ .Custom.EthRPCQuorum }}"
+bor_rpc_quorum = "{{ .Custom.BorRPCQuorum }}"
+
+# Archive RPC endpoint for the main chain (optional). The validators read the
+# staking state of past L1 blocks to vote on the slashing txs, which only archive
+# nodes serve past their state retention: without it, the validator votes NO on
+# the slashing txs older than the state retention of eth_rpc_url.
+eth_archive_rpc_url = "{{ .Custom.EthArchiveRPCUrl }}"
+
 # RPC endpoint for cometBFT
 comet_bft_rpc_url = "{{ .Custom.CometBFTRPCUrl }}"
 
@@ -40,9 +56,22 @@ sub_graph_url = "{{ .Custom.SubGraphUrl }}"
 
 #### Bridge configs ####
 
-# AMQP endpoint
+# Bridge task queue backend: "amqp" or "leveldb" (embedded, persisted in the bridge db)
+queue_backend = "{{ .Custom.QueueBackend }}"
+
+# AMQP endpoint, used when queue_backend is "amqp"
 amqp_url = "{{ .Custom.AmqpURL }}"
 
+# Listen address of the bridge API, used by the "heimdalld bridge" commands (empty disables it)
+bridge_api_addr = "{{ .Custom.BridgeAPIAddr }}"
+
+# Max number of msgs the bridge broadcasts together (0 or 1 broadcasts them one by one).
+# The msgs submitted within bridge_batch_window are broadcast back to back, and packed
+# into multi-msg txs when they aren't side msgs: side msgs, i.e. state syncs, checkpoints,
+# stake and topup msgs, always take a tx of their own.
+bridge_batch_size = "{{ .Custom.BridgeBatchSize }}"
+bridge_batch_window = "{{ .Custom.BridgeBatchWindow }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .Custom.CheckpointPollInterval }}"
 syncer_poll_interval = "{{ .Custom.SyncerPollInterval }}"
@@ -55,16 +84,39 @@ sh_state_synced_interval = "{{ .Custom.SHStateSyncedInterval }}"
 sh_stake_update_interval = "{{ .Custom.SHStakeUpdateInterval }}"
 sh_checkpoint_ack_interval = "{{ .Custom.SHCheckpointAckInterval }}"
 sh_max_depth_duration = "{{ .Custom.SHMaxDepthDuration }}"
+# Source to self-heal each event type from: "subgraph" (sub_graph_url) or "logs" (eth_getLogs on eth_rpc_url)
+sh_state_synced_source = "{{ .Custom.SHStateSyncedSource }}"
+sh_stake_update_source = "{{ .Custom.SHStakeUpdateSource }}"
+sh_checkpoint_ack_source = "{{ .Custom.SHCheckpointAckSource }}"
+# Max blocks per eth_getLogs call, and blocks scanned back from the finalized block on the first run, for the "logs" source
+sh_logs_block_range = "{{ .Custom.SHLogsBlockRange }}"
+sh_logs_lookback_blocks = "{{ .Custom.SHLogsLookbackBlocks }}"
 
 #### gas price configs (EIP-1559) ####
 main_chain_gas_fee_cap = "{{ .Custom.MainChainGasFeeCap }}"
 main_chain_gas_tip_cap = "{{ .Custom.MainChainGasTipCap }}"
+# Time after which a pending checkpoint tx is replaced with higher fees, up to main_chain_gas_fee_cap
+main_chain_tx_bump_interval = "{{ .Custom.MainChainTxBumpInterval }}"
+
+#### Remote signer configs ####
+# Url of the remote signer holding the validator key (Web3Signer eth1 API), instead of priv_validator_key.json.
+# The consensus votes are then signed through priv_validator_laddr, in config.toml
+remote_signer_url = "{{ .Custom.RemoteSignerURL }}"
+# Public key of the validator in the remote signer, only needed when it holds several keys
+remote_signer_pub_key = "{{ .Custom.RemoteSignerPubKey }}"
+# Timeout of the remote signer requests. A precommit takes up to three signatures, which must fit in the 5s allowed by CometBFT
+remote_signer_timeout = "{{ .Custom.RemoteSignerTimeout }}"
 
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .Custom.NoACKWaitTime }}"
 
 chain = "{{ .Custom.Chain }}"
 
+#### Clerk configs ####
+# Keep the event records pruned from the clerk state in a local archive
+# (data/clerk_archive.db), to keep serving them and their accumulator proofs
+clerk_record_archive = "{{ .Custom.ClerkRecordArchive }}"
+
 #### Health check configs ####
 # Maximum number of goroutines before heimdall health check fails (0 = disabled)
 max_goroutine_threshold = "{{ .Custom.MaxGoRoutineThreshold }}"
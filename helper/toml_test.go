@@ -22,9 +22,15 @@ func TestDefaultConfigTemplate_ContainsRequiredSections(t *testing.T) {
 		"bor_grpc_flag",
 		"bor_grpc_url",
 		"bor_grpc_token",
+		"eth_rpc_quorum",
+		"bor_rpc_quorum",
 		"comet_bft_rpc_url",
 		"sub_graph_url",
+		"queue_backend",
 		"amqp_url",
+		"bridge_api_addr",
+		"bridge_batch_size",
+		"bridge_batch_window",
 		"checkpoint_poll_interval",
 		"syncer_poll_interval",
 		"noack_poll_interval",
@@ -36,10 +42,20 @@ func TestDefaultConfigTemplate_ContainsRequiredSections(t *testing.T) {
 		"sh_stake_update_interval",
 		"sh_checkpoint_ack_interval",
 		"sh_max_depth_duration",
+		"sh_state_synced_source",
+		"sh_stake_update_source",
+		"sh_checkpoint_ack_source",
+		"sh_logs_block_range",
+		"sh_logs_lookback_blocks",
 		"main_chain_gas_fee_cap",
 		"main_chain_gas_tip_cap",
+		"main_chain_tx_bump_interval",
+		"remote_signer_url",
+		"remote_signer_pub_key",
+		"remote_signer_timeout",
 		"no_ack_wait_time",
 		"chain",
+		"clerk_record_archive",
 		"max_goroutine_threshold",
 		"warn_goroutine_threshold",
 		"min_peer_threshold",
@@ -60,9 +76,15 @@ func TestDefaultConfigTemplate_ContainsTemplateVariables(t *testing.T) {
 		"{{ .Custom.BorGRPCFlag }}",
 		"{{ .Custom.BorGRPCUrl }}",
 		"{{ .Custom.BorGRPCToken }}",
+		"{{ .Custom.EthRPCQuorum }}",
+		"{{ .Custom.BorRPCQuorum }}",
 		"{{ .Custom.CometBFTRPCUrl }}",
 		"{{ .Custom.SubGraphUrl }}",
+		"{{ .Custom.QueueBackend }}",
 		"{{ .Custom.AmqpURL }}",
+		"{{ .Custom.BridgeAPIAddr }}",
+		"{{ .Custom.BridgeBatchSize }}",
+		"{{ .Custom.BridgeBatchWindow }}",
 		"{{ .Custom.CheckpointPollInterval }}",
 		"{{ .Custom.SyncerPollInterval }}",
 		"{{ .Custom.NoACKPollInterval }}",
@@ -74,10 +96,20 @@ func TestDefaultConfigTemplate_ContainsTemplateVariables(t *testing.T) {
 		"{{ .Custom.SHStakeUpdateInterval }}",
 		"{{ .Custom.SHCheckpointAckInterval }}",
 		"{{ .Custom.SHMaxDepthDuration }}",
+		"{{ .Custom.SHStateSyncedSource }}",
+		"{{ .Custom.SHStakeUpdateSource }}",
+		"{{ .Custom.SHCheckpointAckSource }}",
+		"{{ .Custom.SHLogsBlockRange }}",
+		"{{ .Custom.SHLogsLookbackBlocks }}",
 		"{{ .Custom.MainChainGasFeeCap }}",
 		"{{ .Custom.MainChainGasTipCap }}",
+		"{{ .Custom.MainChainTxBumpInterval }}",
+		"{{ .Custom.RemoteSignerURL }}",
+		"{{ .Custom.RemoteSignerPubKey }}",
+		"{{ .Custom.RemoteSignerTimeout }}",
 		"{{ .Custom.NoACKWaitTime }}",
 		"{{ .Custom.Chain }}",
+		"{{ .Custom.ClerkRecordArchive }}",
 		"{{ .Custom.MaxGoRoutineThreshold }}",
 		"{{ .Custom.WarnGoRoutineThreshold }}",
 		"{{ .Custom.MinPeerThreshold }}",
@@ -107,6 +139,7 @@ func TestDefaultConfigTemplate_ContainsRPCConfigs(t *testing.T) {
 func TestDefaultConfigTemplate_ContainsBridgeConfigs(t *testing.T) {
 	// Test Bridge configuration section
 	require.Contains(t, helper.DefaultConfigTemplate, "#### Bridge configs ####")
+	require.Contains(t, helper.DefaultConfigTemplate, "queue_backend")
 	require.Contains(t, helper.DefaultConfigTemplate, "amqp_url")
 	require.Contains(t, helper.DefaultConfigTemplate, "checkpoint_poll_interval")
 	require.Contains(t, helper.DefaultConfigTemplate, "syncer_poll_interval")
@@ -117,6 +150,7 @@ func TestDefaultConfigTemplate_ContainsGasConfigs(t *testing.T) {
 	require.Contains(t, helper.DefaultConfigTemplate, "#### gas price configs (EIP-1559) ####")
 	require.Contains(t, helper.DefaultConfigTemplate, "main_chain_gas_fee_cap")
 	require.Contains(t, helper.DefaultConfigTemplate, "main_chain_gas_tip_cap")
+	require.Contains(t, helper.DefaultConfigTemplate, "main_chain_tx_bump_interval")
 }
 
 func TestDefaultConfigTemplate_ContainsHealthCheckConfigs(t *testing.T) {
@@ -14,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/0xPolygon/heimdall-v2/contracts/erc20"
@@ -60,14 +59,10 @@ func generateAuthObjWithClient(ctx context.Context, timeout time.Duration, clien
 		Data: data,
 	}
 
-	pkObject := GetPrivKey()
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
+	signer := GetSigner()
 
 	// Get the from address.
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := SignerAddress(signer)
 	callMsg.From = fromAddress
 
 	rpcData, err := fetchAuthRPCData(ctx, timeout, client, fromAddress, callMsg)
@@ -76,12 +71,12 @@ func generateAuthObjWithClient(ctx context.Context, timeout time.Duration, clien
 	}
 
 	baseFee := rpcData.latestBlock.BaseFee()
-	gasFeeCap, gasTipCap, err := calculateEIP1559Caps(baseFee, rpcData.suggestedTipCap)
+	gasFeeCap, gasTipCap, err := CalculateEIP1559Caps(baseFee, rpcData.suggestedTipCap)
 	if err != nil {
 		return
 	}
 
-	auth, err = bind.NewKeyedTransactorWithChainID(ecdsaPrivateKey, rpcData.chainID)
+	auth, err = NewSignerTransactor(signer, rpcData.chainID)
 	if err != nil {
 		Logger.Error(errUnableToCreateAuthObj, "error", err)
 		return
@@ -103,7 +98,9 @@ func generateAuthObjWithClient(ctx context.Context, timeout time.Duration, clien
 	return
 }
 
-func calculateEIP1559Caps(baseFee, suggestedTipCap *big.Int) (*big.Int, *big.Int, error) {
+// CalculateEIP1559Caps returns the gas fee cap and tip cap of a new tx, from the base fee and the suggested tip cap,
+// bounded by the configured maximums.
+func CalculateEIP1559Caps(baseFee, suggestedTipCap *big.Int) (*big.Int, *big.Int, error) {
 	if baseFee == nil {
 		err := errors.New("baseFee is nil, EIP-1559 not supported")
 		Logger.Error("EIP-1559 not supported on this chain", "error", err)
@@ -115,7 +112,7 @@ func calculateEIP1559Caps(baseFee, suggestedTipCap *big.Int) (*big.Int, *big.Int
 		return nil, nil, err
 	}
 
-	configGasFeeCap, configGasTipCap := configuredGasCaps()
+	configGasFeeCap, configGasTipCap := ConfiguredGasCaps()
 
 	gasTipCap := suggestedTipCap
 	if gasTipCap.Cmp(big.NewInt(configGasTipCap)) > 0 {
@@ -148,7 +145,8 @@ func calculateEIP1559Caps(baseFee, suggestedTipCap *big.Int) (*big.Int, *big.Int
 	return gasFeeCap, gasTipCap, nil
 }
 
-func configuredGasCaps() (int64, int64) {
+// ConfiguredGasCaps returns the configured maximum gas fee cap and tip cap of the main chain txs.
+func ConfiguredGasCaps() (int64, int64) {
 	configGasFeeCap := GetConfig().MainChainGasFeeCap
 	if configGasFeeCap <= 0 {
 		configGasFeeCap = DefaultMainChainGasFeeCap
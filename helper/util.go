@@ -238,13 +238,14 @@ func BroadcastTx(clientCtx client.Context, txf clienttx.Factory, msgs ...sdk.Msg
 		panic("this should not happen as SkipConfirm is set to true")
 	}
 
-	cosmosPrivKey := &cosmossecp256k1.PrivKey{Key: GetPrivKey()}
+	signer := GetSigner()
+	cosmosPubKey := &cosmossecp256k1.PubKey{Key: signer.PubKey()}
 
 	// First round: we gather all the signer infos. We use the "set empty
 	// signature" hack to do that.
 	sigsV2 := make([]signing.SignatureV2, 0, 1)
 	sigV2 := signing.SignatureV2{
-		PubKey: cosmosPrivKey.PubKey(),
+		PubKey: cosmosPubKey,
 		Data: &signing.SingleSignatureData{
 			SignMode:  txf.SignMode(),
 			Signature: nil,
@@ -258,7 +259,7 @@ func BroadcastTx(clientCtx client.Context, txf clienttx.Factory, msgs ...sdk.Msg
 		return nil, err
 	}
 
-	addrStr := sdk.MustHexifyAddressBytes(cosmosPrivKey.PubKey().Address())
+	addrStr := sdk.MustHexifyAddressBytes(cosmosPubKey.Address())
 
 	// Second round: all signer infos are set, so each signer can sign.
 	sigsV2 = make([]signing.SignatureV2, 0, 1)
@@ -267,14 +268,30 @@ func BroadcastTx(clientCtx client.Context, txf clienttx.Factory, msgs ...sdk.Msg
 		ChainID:       txf.ChainID(),
 		AccountNumber: txf.AccountNumber(),
 		Sequence:      txf.Sequence(),
-		PubKey:        cosmosPrivKey.PubKey(),
+		PubKey:        cosmosPubKey,
 	}
 
-	sigV2, err = clienttx.SignWithPrivKey(clientCtx.CmdContext, txf.SignMode(), signerData, tx, cosmosPrivKey, clientCtx.TxConfig, txf.Sequence())
+	// the signer may be remote, so sign the bytes rather than handing over a private key
+	signBytes, err := authsigning.GetSignBytesAdapter(clientCtx.CmdContext, clientCtx.TxConfig.SignModeHandler(), txf.SignMode(), signerData, tx.GetTx())
 	if err != nil {
 		return nil, err
 	}
 
+	signature, err := signer.Sign(signBytes)
+	if err != nil {
+		Logger.Error("Error while signing tx", "error", err)
+		return nil, err
+	}
+
+	sigV2 = signing.SignatureV2{
+		PubKey: cosmosPubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  txf.SignMode(),
+			Signature: signature,
+		},
+		Sequence: txf.Sequence(),
+	}
+
 	sigsV2 = append(sigsV2, sigV2)
 
 	err = tx.SetSignatures(sigsV2...)
@@ -3,24 +3,29 @@ package api
 const (
 	// Query API methods.
 
-	GetSpanListMethod                   = "GetSpanList"
-	GetLatestSpanMethod                 = "GetLatestSpan"
-	GetNextSpanSeedMethod               = "GetNextSpanSeed"
-	GetNextSpanMethod                   = "GetNextSpan"
-	GetSpanByIdMethod                   = "GetSpanById"
-	GetBorParamsMethod                  = "GetBorParams"
-	GetProducerVotesMethod              = "GetProducerVotes"
-	GetProducerVotesByValidatorIdMethod = "GetProducerVotesByValidatorId"
-	GetProducerPlannedDowntimeMethod    = "GetProducerPlannedDowntime"
-	GetValidatorPerformanceScoreMethod  = "GetValidatorPerformanceScore"
+	GetSpanListMethod                    = "GetSpanList"
+	GetLatestSpanMethod                  = "GetLatestSpan"
+	GetNextSpanSeedMethod                = "GetNextSpanSeed"
+	GetNextSpanMethod                    = "GetNextSpan"
+	GetSpanByIdMethod                    = "GetSpanById"
+	GetSpanSummaryByIdMethod             = "GetSpanSummaryById"
+	GetBorParamsMethod                   = "GetBorParams"
+	GetProducerVotesMethod               = "GetProducerVotes"
+	GetProducerVotesByValidatorIdMethod  = "GetProducerVotesByValidatorId"
+	GetProducerPlannedDowntimeMethod     = "GetProducerPlannedDowntime"
+	GetProducerPlannedDowntimesMethod    = "GetProducerPlannedDowntimes"
+	GetValidatorPerformanceScoreMethod   = "GetValidatorPerformanceScore"
+	GetValidatorPerformanceHistoryMethod = "GetValidatorPerformanceHistory"
+	SimulateProducerSetMethod            = "SimulateProducerSet"
 
 	// Transaction API methods.
 
-	ProposeSpanMethod      = "ProposeSpan"
-	BorUpdateParamsMethod  = "UpdateParams"
-	BackfillSpansMethod    = "BackfillSpans"
-	VoteProducersMethod    = "VoteProducers"
-	ProducerDowntimeMethod = "ProducerDowntime"
+	ProposeSpanMethod            = "ProposeSpan"
+	BorUpdateParamsMethod        = "UpdateParams"
+	BackfillSpansMethod          = "BackfillSpans"
+	VoteProducersMethod          = "VoteProducers"
+	ProducerDowntimeMethod       = "ProducerDowntime"
+	CancelProducerDowntimeMethod = "CancelProducerDowntime"
 
 	// Side message handler methods.
 
@@ -13,6 +13,7 @@ const (
 	GetCheckpointListMethod       = "GetCheckpointList"
 	GetCheckpointSignaturesMethod = "GetCheckpointSignatures"
 	GetCheckpointMethod           = "GetCheckpoint"
+	GetCheckpointProofMethod      = "GetCheckpointProof"
 
 	// Transaction API methods.
 
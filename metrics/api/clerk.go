@@ -3,23 +3,35 @@ package api
 const (
 	// Query API methods.
 
-	GetRecordCountMethod        = "GetRecordCount"
-	GetRecordListMethod         = "GetRecordList"
-	GetLatestRecordIdMethod     = "GetLatestRecordId"
-	GetRecordByIdMethod         = "GetRecordById"
-	GetRecordListWithTimeMethod = "GetRecordListWithTime"
-	GetRecordSequenceMethod     = "GetRecordSequence"
-	IsClerkTxOldMethod          = "IsClerkTxOld"
+	GetRecordCountMethod               = "GetRecordCount"
+	GetRecordListMethod                = "GetRecordList"
+	GetLatestRecordIdMethod            = "GetLatestRecordId"
+	GetRecordByIdMethod                = "GetRecordById"
+	GetRecordListWithTimeMethod        = "GetRecordListWithTime"
+	GetRecordSequenceMethod            = "GetRecordSequence"
+	IsClerkTxOldMethod                 = "IsClerkTxOld"
+	SubscribeRecordsMethod             = "SubscribeRecords"
+	GetRecordsByContractMethod         = "GetRecordsByContract"
+	GetRecordsByTxHashMethod           = "GetRecordsByTxHash"
+	GetRecordsByVisibilityHeightMethod = "GetRecordsByVisibilityHeight"
+	GetClerkParamsMethod               = "GetClerkParams"
+	GetRecordAccumulatorMethod         = "GetRecordAccumulator"
+	GetRecordAccumulatorProofMethod    = "GetRecordAccumulatorProof"
+	GetBorConsumedRecordIdMethod       = "GetBorConsumedRecordId"
 
 	// Transaction API methods.
 
-	HandleMsgEventRecordMethod = "HandleMsgEventRecord"
+	HandleMsgEventRecordMethod     = "HandleMsgEventRecord"
+	HandleMsgRecordsConsumedMethod = "HandleMsgRecordsConsumed"
+	ClerkUpdateParamsMethod        = "UpdateParams"
 
 	// Side message handler methods.
 
-	SideHandleMsgEventRecordMethod = "SideHandleMsgEventRecord"
+	SideHandleMsgEventRecordMethod     = "SideHandleMsgEventRecord"
+	SideHandleMsgRecordsConsumedMethod = "SideHandleMsgRecordsConsumed"
 
 	// Post message handler methods.
 
-	PostHandleMsgEventRecordMethod = "PostHandleMsgEventRecord"
+	PostHandleMsgEventRecordMethod     = "PostHandleMsgEventRecord"
+	PostHandleMsgRecordsConsumedMethod = "PostHandleMsgRecordsConsumed"
 )
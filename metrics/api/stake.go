@@ -10,25 +10,33 @@ const (
 	IsStakeTxOldMethod           = "IsStakeTxOld"
 	GetCurrentProposerMethod     = "GetCurrentProposer"
 	GetProposersByTimesMethod    = "GetProposersByTimes"
+	GetValidatorBlsPubKeyMethod  = "GetValidatorBlsPubKey"
 
 	// Transaction API methods.
 
-	ValidatorJoinMethod = "ValidatorJoin"
-	StakeUpdateMethod   = "StakeUpdate"
-	SignerUpdateMethod  = "SignerUpdate"
-	ValidatorExitMethod = "ValidatorExit"
+	ValidatorJoinMethod    = "ValidatorJoin"
+	StakeUpdateMethod      = "StakeUpdate"
+	SignerUpdateMethod     = "SignerUpdate"
+	ValidatorExitMethod    = "ValidatorExit"
+	ValidatorSlashedMethod = "ValidatorSlashed"
+	ValidatorUnjailMethod  = "ValidatorUnjail"
+	RegisterBlsKeyMethod   = "RegisterBlsKey"
 
 	// Side message handler methods.
 
-	SideHandleMsgValidatorJoinMethod = "SideHandleMsgValidatorJoin"
-	SideHandleMsgStakeUpdateMethod   = "SideHandleMsgStakeUpdate"
-	SideHandleMsgSignerUpdateMethod  = "SideHandleMsgSignerUpdate"
-	SideHandleMsgValidatorExitMethod = "SideHandleMsgValidatorExit"
+	SideHandleMsgValidatorJoinMethod    = "SideHandleMsgValidatorJoin"
+	SideHandleMsgStakeUpdateMethod      = "SideHandleMsgStakeUpdate"
+	SideHandleMsgSignerUpdateMethod     = "SideHandleMsgSignerUpdate"
+	SideHandleMsgValidatorExitMethod    = "SideHandleMsgValidatorExit"
+	SideHandleMsgValidatorSlashedMethod = "SideHandleMsgValidatorSlashed"
+	SideHandleMsgValidatorUnjailMethod  = "SideHandleMsgValidatorUnjail"
 
 	// Post message handler methods.
 
-	PostHandleMsgValidatorJoinMethod = "PostHandleMsgValidatorJoin"
-	PostHandleMsgStakeUpdateMethod   = "PostHandleMsgStakeUpdate"
-	PostHandleMsgSignerUpdateMethod  = "PostHandleMsgSignerUpdate"
-	PostHandleMsgValidatorExitMethod = "PostHandleMsgValidatorExit"
+	PostHandleMsgValidatorJoinMethod    = "PostHandleMsgValidatorJoin"
+	PostHandleMsgStakeUpdateMethod      = "PostHandleMsgStakeUpdate"
+	PostHandleMsgSignerUpdateMethod     = "PostHandleMsgSignerUpdate"
+	PostHandleMsgValidatorExitMethod    = "PostHandleMsgValidatorExit"
+	PostHandleMsgValidatorSlashedMethod = "PostHandleMsgValidatorSlashed"
+	PostHandleMsgValidatorUnjailMethod  = "PostHandleMsgValidatorUnjail"
 )
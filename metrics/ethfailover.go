@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/0xPolygon/heimdall-v2/x/bor/failover"
+)
+
+// Main chain (L1) endpoint-failover metrics, the counterpart of the Bor ones.
+// Counter names use the _total suffix per Prometheus convention.
+var (
+	EthFailoverSwitches = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "eth_failover",
+		Name:      "switches_total",
+		Help:      "Total in-call failover switches of the active main chain endpoint",
+	})
+
+	EthFailoverProactiveSwitches = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "eth_failover",
+		Name:      "proactive_switches_total",
+		Help:      "Total background-prober switches of the active main chain endpoint (including revert-to-primary)",
+	})
+
+	EthFailoverActiveIndex = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "eth_failover",
+		Name:      "active_index",
+		Help:      "Index of the currently active main chain endpoint (0 = primary)",
+	})
+
+	EthFailoverHealthyEndpoints = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "eth_failover",
+		Name:      "healthy_endpoints",
+		Help:      "Number of main chain endpoints currently considered healthy",
+	})
+)
+
+// EthFailover wires the failover state machine's metric hooks to the main
+// chain Prometheus metrics.
+func EthFailover() failover.Metrics {
+	return failover.Metrics{
+		Switch:          func() { EthFailoverSwitches.Inc() },
+		ProactiveSwitch: func() { EthFailoverProactiveSwitches.Inc() },
+		ActiveIndex:     func(i int) { EthFailoverActiveIndex.Set(float64(i)) },
+		HealthyCount:    func(c int) { EthFailoverHealthyEndpoints.Set(float64(c)) },
+	}
+}
@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthFailover_AllHooksWired(t *testing.T) {
+	m := EthFailover()
+
+	require.NotNil(t, m.Switch)
+	require.NotNil(t, m.ProactiveSwitch)
+	require.NotNil(t, m.ActiveIndex)
+	require.NotNil(t, m.HealthyCount)
+
+	require.NotPanics(t, func() {
+		m.Switch()
+		m.ProactiveSwitch()
+		m.ActiveIndex(1)
+		m.HealthyCount(2)
+	})
+}
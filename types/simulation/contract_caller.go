@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/0xPolygon/heimdall-v2/contracts/rootchain"
+	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+)
+
+// maxLogIndex bounds the log index of the simulated events, below the log index unit of their sequences
+const maxLogIndex = 100
+
+// RandomMainChainEvent returns the tx hash, block number and log index of a random root chain event.
+// The block numbers are drawn from a range wide enough for the sequences of the events not to collide.
+func RandomMainChainEvent(r *rand.Rand) (common.Hash, uint64, uint64) {
+	var txHash common.Hash
+	r.Read(txHash[:])
+
+	return txHash, uint64(r.Int63n(math.MaxInt32) + 1), uint64(r.Intn(maxLogIndex))
+}
+
+// MockTxReceipt registers on contractCaller the confirmed receipt of txHash, mined at blockNumber, and returns it.
+// The receipts carry their tx hash, so that the decoding of their events can be mocked per receipt.
+func MockTxReceipt(contractCaller *mocks.IContractCaller, txHash common.Hash, blockNumber uint64) *ethTypes.Receipt {
+	receipt := &ethTypes.Receipt{
+		Status:      ethTypes.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+	}
+
+	contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash, mock.Anything, mock.Anything).Return(receipt, nil)
+
+	return receipt
+}
+
+// BorChainRootHash returns the root hash of the simulated bor blocks from start to end.
+// It only depends on the range, as the validity of the checkpoints is cached by range.
+func BorChainRootHash(start, end uint64) []byte {
+	return crypto.Keccak256(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, start), end))
+}
+
+// MockChains registers on contractCaller the calls whose results don't depend on the simulated msgs:
+// every bor block exists, with the root hashes of BorChainRootHash, and the contract instances are empty bindings.
+func MockChains(contractCaller *mocks.IContractCaller) {
+	contractCaller.On("CheckIfBlocksExist", mock.Anything, mock.Anything).Return(true, nil)
+	contractCaller.On("GetRootHash", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, start, end, _ uint64) ([]byte, error) {
+			return BorChainRootHash(start, end), nil
+		})
+	contractCaller.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+	contractCaller.On("GetStakingInfoInstance", mock.Anything).Return(&stakinginfo.Stakinginfo{}, nil)
+}
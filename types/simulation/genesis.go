@@ -0,0 +1,17 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RandomGenesisAccounts returns the base accounts of the simulation accounts, for the auth genesis.
+// Their account numbers start from 1, as the fee collector is the first initialized (module) account (AccountNumber = 0).
+func RandomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		genesisAccs[i] = authtypes.NewBaseAccount(acc.Address, acc.PubKey, uint64(i+1), 0)
+	}
+
+	return genesisAccs
+}
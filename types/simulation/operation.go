@@ -0,0 +1,62 @@
+// Package simulation provides the types shared by the heimdall modules to take part in the
+// randomized simulation of the app.
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+)
+
+// Operation generates a random msg of a heimdall module from the committed state of ctx.
+// Unlike the cosmos-sdk operations, it doesn't deliver the msg: the side msgs are only executed once the
+// vote extensions of the next block approve them, so the app simulation includes the msg in the block it proposes.
+// The operations of the side msgs register on contractCaller the root chain and bor data their side handlers fetch,
+// so that the validators vote on them as they would on a live chain.
+type Operation func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (OperationMsg, error)
+
+// OperationMsg is the msg generated by an Operation, along with the account signing it
+type OperationMsg struct {
+	Name    string
+	Msg     sdk.Msg
+	Signer  simtypes.Account
+	OK      bool
+	Comment string
+}
+
+// NewOperationMsg returns the OperationMsg of msg, signed by signer
+func NewOperationMsg(msg sdk.Msg, signer simtypes.Account, comment string) OperationMsg {
+	return OperationMsg{
+		Name:    sdk.MsgTypeURL(msg),
+		Msg:     msg,
+		Signer:  signer,
+		OK:      true,
+		Comment: comment,
+	}
+}
+
+// NoOpMsg returns the OperationMsg of an operation that could not generate its msg from the current state
+func NoOpMsg(msgType, comment string) OperationMsg {
+	return OperationMsg{
+		Name:    msgType,
+		OK:      false,
+		Comment: comment,
+	}
+}
+
+// WeightedOperation is an Operation with the weight it is picked with by the app simulation
+type WeightedOperation struct {
+	Weight int
+	Op     Operation
+}
+
+// NewWeightedOperation creates a new WeightedOperation
+func NewWeightedOperation(weight int, op Operation) WeightedOperation {
+	return WeightedOperation{
+		Weight: weight,
+		Op:     op,
+	}
+}
@@ -19,6 +19,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "GetSpanSummaryById",
+					Use:       "span-summary [id]",
+					Short:     "Query the summary of a pruned bor span by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
 				{
 					RpcMethod: "GetSpanList",
 					Use:       "span-list",
@@ -60,6 +68,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "producer_id"},
 					},
 				},
+				{
+					RpcMethod: "GetProducerPlannedDowntimes",
+					Use:       "producer-planned-downtimes [producer_id]",
+					Short:     "Query all the planned downtimes of a producer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "producer_id"},
+					},
+				},
+				{
+					RpcMethod: "GetValidatorPerformanceHistory",
+					Use:       "validator-performance-history [validator_id] [from_span_id] [to_span_id]",
+					Short:     "Query the performance of a validator in each span of a span range",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_id"},
+						{ProtoField: "from_span_id"},
+						{ProtoField: "to_span_id"},
+					},
+				},
+				{
+					RpcMethod: "SimulateProducerSet",
+					Use:       "simulate-producer-set",
+					Short:     "Simulate the election of the next span producer with hypothetical producer votes and downtimes",
+					Example: `heimdalld query bor simulate-producer-set --producer-votes '{"validator_id":1,"votes":[2,3]}' ` +
+						`--producer-downtimes '{"producer_id":2,"downtime_range":{"start_block":1000,"end_block":2000}}'`,
+				},
 			},
 		},
 	}
@@ -33,6 +33,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSpanProposalCmd(),
 		NewProducerDowntimeCmd(),
+		NewCancelProducerDowntimeCmd(),
 	)
 
 	return txCmd
@@ -227,6 +228,52 @@ func NewProducerDowntimeCmd() *cobra.Command {
 	return cmd
 }
 
+func NewCancelProducerDowntimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-producer-downtime",
+		Short: "Cancel a planned producer downtime",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			producerAddress := viper.GetString(FlagProducerAddress)
+			if producerAddress == "" {
+				producerAddress = clientCtx.GetFromAddress().String()
+			}
+
+			addressCodec := addresscodec.NewHexCodec()
+			_, err = addressCodec.StringToBytes(producerAddress)
+			if err != nil {
+				return fmt.Errorf("producer address is invalid: %w", err)
+			}
+
+			startBlock := viper.GetUint64(FlagStartBlock)
+			if startBlock == 0 {
+				return fmt.Errorf("start block of the planned downtime is required")
+			}
+
+			msg := types.NewMsgCancelProducerDowntime(producerAddress, startBlock)
+
+			return cli.BroadcastMsg(clientCtx, producerAddress, msg, logger)
+		},
+	}
+
+	cmd.Flags().String(FlagProducerAddress, "", "--producer-address=<producer-address>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<start-block-of-the-planned-downtime>")
+
+	if err := cmd.MarkFlagRequired(FlagProducerAddress); err != nil {
+		fmt.Printf("NewCancelProducerDowntimeCmd | MarkFlagRequired | FlagProducerAddress Error: %v", err)
+	}
+
+	if err := cmd.MarkFlagRequired(FlagStartBlock); err != nil {
+		fmt.Printf("NewCancelProducerDowntimeCmd | MarkFlagRequired | FlagStartBlock Error: %v", err)
+	}
+
+	return cmd
+}
+
 func calculateAverageBlocktime(clientCtx client.Context) (uint64, error) {
 	borClient := helper.GetBorClient()
 	currentBlock, err := borClient.BlockNumber(clientCtx.CmdContext)
@@ -1,8 +1,8 @@
 // Package failover provides a transport-agnostic endpoint failover state
-// machine shared by Heimdall's Bor HTTP and gRPC clients. It tracks N
-// priority-ordered endpoints (index 0 is the primary), cascades to the next
-// reachable endpoint when the active one fails, and a background prober reverts
-// to a higher-priority endpoint once it recovers.
+// machine shared by Heimdall's Bor HTTP and gRPC clients and its main chain (L1)
+// HTTP client. It tracks N priority-ordered endpoints (index 0 is the primary),
+// cascades to the next reachable endpoint when the active one fails, and a
+// background prober reverts to a higher-priority endpoint once it recovers.
 //
 // Fallback endpoints are only used after a probe has confirmed their identity
 // against the expected chain. The primary owns that expectation whenever it is
@@ -12,7 +12,9 @@
 // identity so failover still engages, which means a misconfigured wrong-network
 // fallback could serve during that window. Once the primary becomes reachable it
 // reclaims the expectation and demotes every other endpoint (see Reclaim),
-// ending the window.
+// ending the window. Callers which know the expected identity up front (the
+// main chain client, from the configured network) check it on every endpoint
+// instead, the primary included.
 package failover
 
 import (
@@ -251,7 +253,7 @@ func (h *Health) Promote(from, to int) bool {
 	h.markSuccessLocked(to)
 	if prev != to {
 		h.metric.onSwitch()
-		h.logger.Info("bor failover: switched active endpoint", "from", from, "to", to)
+		h.logger.Info("failover: switched active endpoint", "from", from, "to", to)
 	}
 	return true
 }
@@ -107,7 +107,7 @@ func (h *Health) maybePromote() {
 	}
 	for i := 0; i < h.active; i++ {
 		if h.health[i].healthy && time.Since(h.health[i].healthySince) >= h.promotionCooldown {
-			h.promoteLocked(i, "revert to higher-priority bor endpoint")
+			h.promoteLocked(i, "revert to higher-priority endpoint")
 			return
 		}
 	}
@@ -122,7 +122,7 @@ func (h *Health) maybeProactiveSwitch() {
 		return
 	}
 	if i, ok := h.bestHealthyLocked(); ok {
-		h.promoteLocked(i, "switch away from unhealthy active bor endpoint")
+		h.promoteLocked(i, "switch away from unhealthy active endpoint")
 	}
 }
 
@@ -153,5 +153,5 @@ func (h *Health) promoteLocked(i int, msg string) {
 	h.active = i
 	h.metric.onActiveIndex(i)
 	h.metric.onProactiveSwitch()
-	h.logger.Info("bor failover: "+msg, "from", prev, "to", i)
+	h.logger.Info("failover: "+msg, "from", prev, "to", i)
 }
@@ -29,6 +29,23 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
 			panic(fmt.Sprintf("error while updating last span during InitGenesis: %v", err))
 		}
 	}
+
+	for _, summary := range data.SpanSummaries {
+		if err := k.spanSummaries.Set(ctx, summary.Id, summary); err != nil {
+			panic(fmt.Sprintf("error while adding span summary during InitGenesis: %v", err))
+		}
+	}
+
+	for _, seed := range data.SpanSeeds {
+		// the spans not selected from a seed keep an empty one, which the store doesn't take as nil
+		if seed.Seed == nil {
+			seed.Seed = []byte{}
+		}
+
+		if err := k.spanSeeds.Set(ctx, seed.SpanId, seed.Seed); err != nil {
+			panic(fmt.Sprintf("error while adding span seed during InitGenesis: %v", err))
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for bor.
@@ -50,8 +67,22 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 
 	types.SortSpansById(spans)
 
-	return types.NewGenesisState(
+	summaries, err := k.GetAllSpanSummaries(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	seeds, err := k.GetAllSpanSeeds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(
 		params,
 		spans,
 	)
+	genesis.SpanSummaries = summaries
+	genesis.SpanSeeds = seeds
+
+	return genesis
 }
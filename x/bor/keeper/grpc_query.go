@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -136,7 +138,7 @@ func (q queryServer) GetNextSpanSeed(ctx context.Context, req *types.QueryNextSp
 	// fetch the next span seed
 	nextSpanSeed, nextSpanSeedAuthor, err := q.k.FetchNextSpanSeed(ctx, spanId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, spanStatusError(err)
 	}
 
 	return &types.QueryNextSpanSeedResponse{
@@ -176,12 +178,30 @@ func (q queryServer) GetSpanById(ctx context.Context, req *types.QuerySpanByIdRe
 
 	span, err := q.k.GetSpan(ctx, uint64(spanId))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, spanStatusError(err)
 	}
 
 	return &types.QuerySpanByIdResponse{Span: &span}, nil
 }
 
+// GetSpanSummaryById returns the summary of a pruned span by id
+func (q queryServer) GetSpanSummaryById(ctx context.Context, req *types.QuerySpanSummaryByIdRequest) (*types.QuerySpanSummaryByIdResponse, error) {
+	var err error
+	start := time.Now()
+	defer recordBorQueryMetric(api.GetSpanSummaryByIdMethod, start, &err)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	summary, err := q.k.GetSpanSummary(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySpanSummaryByIdResponse{SpanSummary: summary}, nil
+}
+
 // GetSpanList returns the list of spans
 func (q queryServer) GetSpanList(ctx context.Context, req *types.QuerySpanListRequest) (*types.QuerySpanListResponse, error) {
 	var err error
@@ -260,21 +280,35 @@ func (q queryServer) GetProducerPlannedDowntime(ctx context.Context, req *types.
 		return nil, status.Errorf(codes.InvalidArgument, errEmptyRequest)
 	}
 
-	found, err := q.k.ProducerPlannedDowntime.Has(ctx, req.ProducerId)
+	downtimes, err := q.k.GetProducerPlannedDowntimes(ctx, req.ProducerId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if !found {
+	if len(downtimes) == 0 {
 		return nil, status.Errorf(codes.NotFound, "no planned downtime found for producer id %d", req.ProducerId)
 	}
 
-	downtime, err := q.k.ProducerPlannedDowntime.Get(ctx, req.ProducerId)
+	return &types.QueryProducerPlannedDowntimeResponse{
+		DowntimeRange: downtimes[0],
+	}, nil
+}
+
+func (q queryServer) GetProducerPlannedDowntimes(ctx context.Context, req *types.QueryProducerPlannedDowntimesRequest) (*types.QueryProducerPlannedDowntimesResponse, error) {
+	var err error
+	start := time.Now()
+	defer recordBorQueryMetric(api.GetProducerPlannedDowntimesMethod, start, &err)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	downtimes, err := q.k.GetProducerPlannedDowntimes(ctx, req.ProducerId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryProducerPlannedDowntimeResponse{
-		DowntimeRange: downtime,
+	return &types.QueryProducerPlannedDowntimesResponse{
+		DowntimeRanges: downtimes,
 	}, nil
 }
 
@@ -295,3 +329,76 @@ func (q queryServer) GetValidatorPerformanceScore(ctx context.Context, _ *types.
 
 	return &types.QueryValidatorPerformanceScoreResponse{ValidatorPerformanceScore: validatorPerformanceScore}, nil
 }
+
+func (q queryServer) GetValidatorPerformanceHistory(ctx context.Context, req *types.QueryValidatorPerformanceHistoryRequest) (*types.QueryValidatorPerformanceHistoryResponse, error) {
+	var err error
+	start := time.Now()
+	defer recordBorQueryMetric(api.GetValidatorPerformanceHistoryMethod, start, &err)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	if req.ToSpanId < req.FromSpanId {
+		err = status.Errorf(codes.InvalidArgument, "toSpanId cannot be less than fromSpanId")
+		return nil, err
+	}
+	if req.ToSpanId-req.FromSpanId >= types.MaxPerformanceHistoryQuerySpans {
+		err = status.Errorf(codes.InvalidArgument, "span range cannot be greater than %d spans", types.MaxPerformanceHistoryQuerySpans)
+		return nil, err
+	}
+
+	performances, err := q.k.GetValidatorPerformanceHistory(ctx, req.ValidatorId, req.FromSpanId, req.ToSpanId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPerformanceHistoryResponse{ValidatorPerformances: performances}, nil
+}
+
+func (q queryServer) SimulateProducerSet(ctx context.Context, req *types.QuerySimulateProducerSetRequest) (*types.QuerySimulateProducerSetResponse, error) {
+	var err error
+	start := time.Now()
+	defer recordBorQueryMetric(api.SimulateProducerSetMethod, start, &err)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	for _, vote := range req.ProducerVotes {
+		seen := make(map[uint64]bool)
+		for _, candidate := range vote.Votes {
+			if seen[candidate] {
+				err = status.Errorf(codes.InvalidArgument, "duplicate vote for validator id %d in the votes of validator id %d", candidate, vote.ValidatorId)
+				return nil, err
+			}
+			seen[candidate] = true
+		}
+	}
+
+	for _, downtime := range req.ProducerDowntimes {
+		if downtime.DowntimeRange.StartBlock >= downtime.DowntimeRange.EndBlock {
+			err = status.Errorf(codes.InvalidArgument, "downtime of producer id %d must start before it ends", downtime.ProducerId)
+			return nil, err
+		}
+	}
+
+	res, err := q.k.SimulateProducerSet(sdk.UnwrapSDKContext(ctx), req.ProducerVotes, req.ProducerDowntimes)
+	if errors.Is(err, types.ErrOverlappingDowntime) || errors.Is(err, types.ErrTooManyDowntimeWindows) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// spanStatusError returns the status of an error reading a span, FailedPrecondition when the span was pruned
+func spanStatusError(err error) error {
+	if errors.Is(err, types.ErrSpanPruned) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
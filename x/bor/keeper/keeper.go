@@ -48,6 +48,10 @@ type Keeper struct {
 	LatestFailedProducer    collections.KeySet[uint64]
 	LastSpanBlock           collections.Item[uint64]
 	ProducerPlannedDowntime collections.Map[uint64, types.BlockRange]
+	ProducerDowntimeWindows collections.Map[collections.Pair[uint64, uint64], types.BlockRange]
+	ValidatorPerformances   collections.Map[collections.Pair[uint64, uint64], types.ValidatorPerformance]
+	spanSummaries           collections.Map[uint64, types.SpanSummary]
+	spanSeeds               collections.Map[uint64, []byte]
 }
 
 // NewKeeper creates a new instance of the bor Keeper
@@ -89,6 +93,10 @@ func NewKeeper(
 		LatestFailedProducer:    collections.NewKeySet(sb, types.LatestFailedProducerKey, "latestFailedProducer", collections.Uint64Key),
 		LastSpanBlock:           collections.NewItem(sb, types.LastSpanBlockKey, "lastSpanBlock", collections.Uint64Value),
 		ProducerPlannedDowntime: collections.NewMap(sb, types.ProducerPlannedDowntimeKey, "producerPlannedDowntime", collections.Uint64Key, codec.CollValue[types.BlockRange](cdc)),
+		ProducerDowntimeWindows: collections.NewMap(sb, types.ProducerDowntimeWindowsKey, "producerDowntimeWindows", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BlockRange](cdc)),
+		ValidatorPerformances:   collections.NewMap(sb, types.ValidatorPerformancesKey, "validatorPerformances", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ValidatorPerformance](cdc)),
+		spanSummaries:           collections.NewMap(sb, types.SpanSummaryKey, "spanSummary", collections.Uint64Key, codec.CollValue[types.SpanSummary](cdc)),
+		spanSeeds:               collections.NewMap(sb, types.SpanSeedKey, "spanSeed", collections.Uint64Key, collections.BytesValue),
 	}
 
 	schema, err := sb.Build()
@@ -140,6 +148,15 @@ func (k *Keeper) GetSpan(ctx context.Context, id uint64) (types.Span, error) {
 
 	// If we are starting from zero, there will be no span key present
 	if !ok {
+		pruned, err := k.spanSummaries.Has(ctx, id)
+		if err != nil {
+			return types.Span{}, err
+		}
+
+		if pruned {
+			return types.Span{}, fmt.Errorf("%w: only the summary of span %d is kept", types.ErrSpanPruned, id)
+		}
+
 		return types.Span{}, fmt.Errorf("span not found for id: %v", id)
 	}
 
@@ -253,7 +270,11 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 
 	logger.Info("Freezing new span", "span", newSpan.LogSpan())
 
-	return k.AddNewSpan(ctx, newSpan)
+	if err := k.AddNewSpan(ctx, newSpan); err != nil {
+		return err
+	}
+
+	return k.setSpanSeed(ctx, id, seed.Bytes())
 }
 
 // SelectNextProducers selects producers for the next span
@@ -647,7 +668,7 @@ func (k *Keeper) SpanByBlockNumber(ctx context.Context, blockNumber uint64) (typ
 	}
 
 	for id := lastSpan.Id; ; id-- {
-		span, err := k.GetSpan(ctx, id)
+		span, err := k.getSpanOrSummary(ctx, id)
 		if err != nil {
 			// Not finding a span that should exist is a critical error.
 			return types.Span{}, fmt.Errorf("failed to get span with id %d: %w", id, err)
@@ -337,6 +337,10 @@ func (srv msgServer) SetProducerDowntime(ctx context.Context, msg *types.MsgSetP
 		return nil, fmt.Errorf("producer with address %s and id %d is not in the current producer set", msg.Producer, producerId)
 	}
 
+	if err := srv.ValidateProducerPlannedDowntime(ctx, producerId, msg.DowntimeRange); err != nil {
+		return nil, err
+	}
+
 	// Reject non-default target before the fork height.
 	if msg.TargetProducerId != types.RoundRobinDefault && sdkCtx.BlockHeight() < helper.GetZurichHardforkHeight() {
 		return nil, fmt.Errorf("target producer override is not enabled until height %d", helper.GetZurichHardforkHeight())
@@ -362,6 +366,32 @@ func (srv msgServer) SetProducerDowntime(ctx context.Context, msg *types.MsgSetP
 	return &types.MsgSetProducerDowntimeResponse{}, nil
 }
 
+func (srv msgServer) CancelProducerDowntime(ctx context.Context, msg *types.MsgCancelProducerDowntime) (*types.MsgCancelProducerDowntimeResponse, error) {
+	var err error
+	start := time.Now()
+	defer recordBorTransactionMetric(api.CancelProducerDowntimeMethod, start, &err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsProducerDowntimeWindows(sdkCtx.BlockHeight()) {
+		err = fmt.Errorf("MsgCancelProducerDowntime not allowed: block %d is before the producerDowntimeWindowsHeight %d",
+			sdkCtx.BlockHeight(), helper.GetProducerDowntimeWindowsHeight())
+		return nil, err
+	}
+
+	producerId, err := srv.sk.GetValIdFromAddress(ctx, msg.Producer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "producer with address %s not found", msg.Producer)
+	}
+
+	if err = srv.CancelProducerPlannedDowntime(ctx, producerId, msg.StartBlock); err != nil {
+		return nil, err
+	}
+
+	srv.Logger(ctx).Info("Cancelled producer planned downtime", "producerId", producerId, "startBlock", msg.StartBlock)
+
+	return &types.MsgCancelProducerDowntimeResponse{}, nil
+}
+
 func recordBorTransactionMetric(method string, start time.Time, err *error) {
 	success := *err == nil
 	api.RecordAPICallWithStart(api.BorSubsystem, method, api.TxType, success, start)
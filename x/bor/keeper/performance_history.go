@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+// RecordValidatorPerformance records the blocks finalized by a milestone in the performance of the validators,
+// for each span they belong to: the producer of the span is credited with the blocks produced,
+// and the validators supporting the milestone with their score.
+func (k *Keeper) RecordValidatorPerformance(ctx context.Context, supportingValidatorIDs map[uint64]struct{}, startBlock, endBlock uint64) error {
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return err
+	}
+
+	// a block belongs to the last span covering it, as a span takes over the blocks of the previous ones from its start
+	takenOver := lastSpan.EndBlock + 1
+	for id := lastSpan.Id; ; id-- {
+		span, err := k.getSpanOrSummary(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		from := max(span.StartBlock, startBlock)
+		to := min(span.EndBlock, takenOver-1, endBlock)
+		if from <= to && len(span.SelectedProducers) > 0 {
+			blocks := to - from + 1
+
+			if err := k.updateValidatorPerformance(ctx, span.Id, span.SelectedProducers[0].ValId, func(performance *types.ValidatorPerformance) {
+				performance.BlocksProduced += blocks
+			}); err != nil {
+				return err
+			}
+
+			for validatorID := range supportingValidatorIDs {
+				if err := k.updateValidatorPerformance(ctx, span.Id, validatorID, func(performance *types.ValidatorPerformance) {
+					performance.Score += blocks
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if span.StartBlock <= startBlock || id == 0 {
+			break
+		}
+
+		takenOver = min(takenOver, span.StartBlock)
+	}
+
+	return nil
+}
+
+// RecordSpanAssignment records the blocks of a new span assigned to its producer, and takes the blocks it takes over
+// off the producers of the previous spans. The performance of the span leaving the history is pruned.
+func (k *Keeper) RecordSpanAssignment(ctx context.Context, newSpan *types.Span) error {
+	for id := newSpan.Id - 1; newSpan.Id > 0; id-- {
+		span, err := k.getSpanOrSummary(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if span.EndBlock < newSpan.StartBlock {
+			break
+		}
+
+		if len(span.SelectedProducers) > 0 {
+			key := collections.Join(span.Id, span.SelectedProducers[0].ValId)
+
+			performance, err := k.ValidatorPerformances.Get(ctx, key)
+			switch {
+			case errors.Is(err, collections.ErrNotFound):
+				// span recorded before the performance history
+			case err != nil:
+				return err
+			default:
+				var kept uint64
+				if newSpan.StartBlock > span.StartBlock {
+					kept = newSpan.StartBlock - span.StartBlock
+				}
+
+				performance.BlocksAssigned = min(performance.BlocksAssigned, kept)
+				if err := k.ValidatorPerformances.Set(ctx, key, performance); err != nil {
+					return err
+				}
+			}
+		}
+
+		if id == 0 {
+			break
+		}
+	}
+
+	if len(newSpan.SelectedProducers) > 0 {
+		if err := k.updateValidatorPerformance(ctx, newSpan.Id, newSpan.SelectedProducers[0].ValId, func(performance *types.ValidatorPerformance) {
+			performance.BlocksAssigned = newSpan.EndBlock - newSpan.StartBlock + 1
+		}); err != nil {
+			return err
+		}
+	}
+
+	if newSpan.Id < types.PerformanceHistorySpans {
+		return nil
+	}
+
+	return k.ValidatorPerformances.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](newSpan.Id-types.PerformanceHistorySpans))
+}
+
+// GetValidatorPerformanceHistory returns the performance of a validator in each span of the given range
+// it was recorded for, ordered by span ID.
+func (k *Keeper) GetValidatorPerformanceHistory(ctx context.Context, validatorID, fromSpanID, toSpanID uint64) ([]types.ValidatorPerformance, error) {
+	performances := make([]types.ValidatorPerformance, 0)
+
+	for spanID := fromSpanID; spanID <= toSpanID; spanID++ {
+		performance, err := k.ValidatorPerformances.Get(ctx, collections.Join(spanID, validatorID))
+		if err == nil {
+			performances = append(performances, performance)
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		// avoid the overflow on the last span ID
+		if spanID == toSpanID {
+			break
+		}
+	}
+
+	return performances, nil
+}
+
+// updateValidatorPerformance applies the update to the performance of a validator in a span
+func (k *Keeper) updateValidatorPerformance(ctx context.Context, spanID, validatorID uint64, update func(performance *types.ValidatorPerformance)) error {
+	key := collections.Join(spanID, validatorID)
+
+	performance, err := k.ValidatorPerformances.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		performance = types.ValidatorPerformance{SpanId: spanID, ValidatorId: validatorID}
+	} else if err != nil {
+		return err
+	}
+
+	update(&performance)
+
+	return k.ValidatorPerformances.Set(ctx, key, performance)
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+	staketypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorPerformanceHistory() {
+	require := s.Require()
+	ctx, borKeeper := s.ctx, s.borKeeper
+
+	newSpan := func(id, startBlock, endBlock, producerID uint64) *types.Span {
+		return &types.Span{
+			Id:                id,
+			StartBlock:        startBlock,
+			EndBlock:          endBlock,
+			SelectedProducers: []staketypes.Validator{{ValId: producerID}},
+			BorChainId:        "1",
+		}
+	}
+	addSpan := func(span *types.Span) {
+		require.NoError(borKeeper.AddNewSpan(ctx, span))
+		require.NoError(borKeeper.RecordSpanAssignment(ctx, span))
+	}
+
+	addSpan(newSpan(0, 0, 99, 20))
+	addSpan(newSpan(1, 100, 199, 10))
+	addSpan(newSpan(2, 200, 299, 20))
+
+	// span 3 rotates the producer of span 2 out from block 250
+	addSpan(newSpan(3, 250, 399, 30))
+
+	// the milestone blocks are split between the spans they belong to
+	supporting := map[uint64]struct{}{10: {}, 30: {}}
+	require.NoError(borKeeper.RecordValidatorPerformance(ctx, supporting, 150, 260))
+
+	history, err := borKeeper.GetValidatorPerformanceHistory(ctx, 10, 1, 3)
+	require.NoError(err)
+	require.Equal([]types.ValidatorPerformance{
+		{SpanId: 1, ValidatorId: 10, Score: 50, BlocksAssigned: 100, BlocksProduced: 50},
+		{SpanId: 2, ValidatorId: 10, Score: 50},
+		{SpanId: 3, ValidatorId: 10, Score: 11},
+	}, history)
+
+	history, err = borKeeper.GetValidatorPerformanceHistory(ctx, 20, 1, 3)
+	require.NoError(err)
+	require.Equal([]types.ValidatorPerformance{
+		{SpanId: 2, ValidatorId: 20, BlocksAssigned: 50, BlocksProduced: 50},
+	}, history)
+
+	history, err = borKeeper.GetValidatorPerformanceHistory(ctx, 30, 3, 3)
+	require.NoError(err)
+	require.Equal([]types.ValidatorPerformance{
+		{SpanId: 3, ValidatorId: 30, Score: 11, BlocksAssigned: 150, BlocksProduced: 11},
+	}, history)
+
+	// the next milestones accumulate
+	require.NoError(borKeeper.RecordValidatorPerformance(ctx, supporting, 261, 300))
+
+	history, err = borKeeper.GetValidatorPerformanceHistory(ctx, 30, 3, 3)
+	require.NoError(err)
+	require.Equal(uint64(51), history[0].Score)
+	require.Equal(uint64(51), history[0].BlocksProduced)
+
+	// the query serves the history by validator and span range
+	res, err := s.queryClient.GetValidatorPerformanceHistory(ctx, &types.QueryValidatorPerformanceHistoryRequest{ValidatorId: 10, FromSpanId: 2, ToSpanId: 3})
+	require.NoError(err)
+	require.Len(res.ValidatorPerformances, 2)
+
+	_, err = s.queryClient.GetValidatorPerformanceHistory(ctx, &types.QueryValidatorPerformanceHistoryRequest{ValidatorId: 10, FromSpanId: 3, ToSpanId: 2})
+	require.Error(err)
+
+	_, err = s.queryClient.GetValidatorPerformanceHistory(ctx, &types.QueryValidatorPerformanceHistoryRequest{ValidatorId: 10, FromSpanId: 0, ToSpanId: types.MaxPerformanceHistoryQuerySpans})
+	require.Error(err)
+
+	// the performance of the spans leaving the history is pruned
+	require.NoError(borKeeper.AddNewRawSpan(ctx, newSpan(types.PerformanceHistorySpans, 400, 499, 10)))
+	addSpan(newSpan(types.PerformanceHistorySpans+1, 500, 599, 20))
+
+	history, err = borKeeper.GetValidatorPerformanceHistory(ctx, 10, 1, 3)
+	require.NoError(err)
+	require.Len(history, 2)
+	require.Equal(uint64(2), history[0].SpanId)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+// GetProducerPlannedDowntimes returns the planned downtime windows of a producer, ordered by start block.
+// Before the producer downtime windows height, a producer has at most one window.
+func (k *Keeper) GetProducerPlannedDowntimes(ctx context.Context, producerID uint64) ([]types.BlockRange, error) {
+	if !helper.IsProducerDowntimeWindows(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		downtime, err := k.ProducerPlannedDowntime.Get(ctx, producerID)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return []types.BlockRange{downtime}, nil
+	}
+
+	iter, err := k.ProducerDowntimeWindows.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](producerID))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// ValidateProducerPlannedDowntime checks that a new downtime window can be planned by the producer:
+// after the producer downtime windows height, it must not overlap the other windows of the producer,
+// and the producer must have less than MaxPlannedDowntimeWindows windows not past yet.
+func (k *Keeper) ValidateProducerPlannedDowntime(ctx context.Context, producerID uint64, downtime types.BlockRange) error {
+	if !helper.IsProducerDowntimeWindows(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil
+	}
+
+	windows, err := k.GetProducerPlannedDowntimes(ctx, producerID)
+	if err != nil {
+		return err
+	}
+
+	pastBlock, err := k.pastDowntimeBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	upcoming := 0
+	for _, window := range windows {
+		if window.EndBlock < pastBlock {
+			continue
+		}
+
+		if downtime.StartBlock <= window.EndBlock && downtime.EndBlock >= window.StartBlock {
+			return fmt.Errorf("%w: requested [%d, %d], planned [%d, %d]", types.ErrOverlappingDowntime,
+				downtime.StartBlock, downtime.EndBlock, window.StartBlock, window.EndBlock)
+		}
+
+		upcoming++
+	}
+
+	if upcoming >= types.MaxPlannedDowntimeWindows {
+		return fmt.Errorf("%w: producer %d already planned %d windows", types.ErrTooManyDowntimeWindows, producerID, upcoming)
+	}
+
+	return nil
+}
+
+// AddProducerPlannedDowntime plans a downtime window for the producer, once validated.
+// Before the producer downtime windows height, it replaces the window of the producer.
+// After it, it is added to the windows of the producer, whose past windows are removed.
+func (k *Keeper) AddProducerPlannedDowntime(ctx context.Context, producerID uint64, downtime types.BlockRange) error {
+	if !helper.IsProducerDowntimeWindows(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return k.ProducerPlannedDowntime.Set(ctx, producerID, downtime)
+	}
+
+	if err := k.ValidateProducerPlannedDowntime(ctx, producerID, downtime); err != nil {
+		return err
+	}
+
+	windows, err := k.GetProducerPlannedDowntimes(ctx, producerID)
+	if err != nil {
+		return err
+	}
+
+	pastBlock, err := k.pastDowntimeBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the past windows can't overlap the spans to come anymore
+	for _, window := range windows {
+		if window.EndBlock < pastBlock {
+			if err := k.ProducerDowntimeWindows.Remove(ctx, collections.Join(producerID, window.StartBlock)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.ProducerDowntimeWindows.Set(ctx, collections.Join(producerID, downtime.StartBlock), downtime)
+}
+
+// CancelProducerPlannedDowntime removes the downtime window of the producer starting at the given block.
+// The spans already planned around the window are kept.
+func (k *Keeper) CancelProducerPlannedDowntime(ctx context.Context, producerID uint64, startBlock uint64) error {
+	key := collections.Join(producerID, startBlock)
+
+	found, err := k.ProducerDowntimeWindows.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%w: producer %d has no window starting at block %d", types.ErrDowntimeNotFound, producerID, startBlock)
+	}
+
+	return k.ProducerDowntimeWindows.Remove(ctx, key)
+}
+
+// MigrateProducerPlannedDowntimes moves the single planned downtime window of each producer to its downtime windows.
+// It runs at every block from the producer downtime windows height, and does nothing once the windows are moved.
+func (k *Keeper) MigrateProducerPlannedDowntimes(ctx context.Context) error {
+	iter, err := k.ProducerPlannedDowntime.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	downtimes, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	if len(downtimes) == 0 {
+		return nil
+	}
+
+	for _, downtime := range downtimes {
+		if err := k.ProducerDowntimeWindows.Set(ctx, collections.Join(downtime.Key, downtime.Value.StartBlock), downtime.Value); err != nil {
+			return err
+		}
+
+		if err := k.ProducerPlannedDowntime.Remove(ctx, downtime.Key); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("Migrated the producer planned downtimes", "count", len(downtimes))
+
+	return nil
+}
+
+// pastDowntimeBlock returns the bor block before which the downtime windows are past:
+// the spans to come start after the start of the last span.
+func (k *Keeper) pastDowntimeBlock(ctx context.Context) (uint64, error) {
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastSpan.StartBlock, nil
+}
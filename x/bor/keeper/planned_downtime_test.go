@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+func (s *KeeperTestSuite) TestProducerPlannedDowntimeWindows() {
+	require := s.Require()
+
+	helper.SetProducerDowntimeWindowsHeight(1)
+	defer helper.SetProducerDowntimeWindowsHeight(0)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	borKeeper := s.borKeeper
+	producerID := uint64(1)
+
+	require.NoError(borKeeper.AddNewSpan(ctx, &types.Span{Id: 1, StartBlock: 1000, EndBlock: 1999, BorChainId: "1"}))
+
+	// several windows are planned, and returned ordered by start block
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: 5000, EndBlock: 5500}))
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: 3000, EndBlock: 3500}))
+
+	windows, err := borKeeper.GetProducerPlannedDowntimes(ctx, producerID)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 3000, EndBlock: 3500}, {StartBlock: 5000, EndBlock: 5500}}, windows)
+
+	// the producer is down in each of its windows only
+	down, err := borKeeper.IsProducerDownForBlockRange(ctx, 3400, 3600, producerID)
+	require.NoError(err)
+	require.True(down)
+
+	down, err = borKeeper.IsProducerDownForBlockRange(ctx, 5500, 6000, producerID)
+	require.NoError(err)
+	require.True(down)
+
+	down, err = borKeeper.IsProducerDownForBlockRange(ctx, 3600, 4900, producerID)
+	require.NoError(err)
+	require.False(down)
+
+	// the windows of a producer can't overlap
+	err = borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: 3500, EndBlock: 4000})
+	require.ErrorIs(err, types.ErrOverlappingDowntime)
+
+	// the windows of the other producers are independent
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, 2, types.BlockRange{StartBlock: 3000, EndBlock: 3500}))
+
+	// a window is cancelled by its start block
+	require.NoError(borKeeper.CancelProducerPlannedDowntime(ctx, producerID, 3000))
+	require.ErrorIs(borKeeper.CancelProducerPlannedDowntime(ctx, producerID, 3000), types.ErrDowntimeNotFound)
+
+	windows, err = borKeeper.GetProducerPlannedDowntimes(ctx, producerID)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 5000, EndBlock: 5500}}, windows)
+
+	windows, err = borKeeper.GetProducerPlannedDowntimes(ctx, 2)
+	require.NoError(err)
+	require.Len(windows, 1)
+}
+
+func (s *KeeperTestSuite) TestProducerPlannedDowntimeWindowsLimit() {
+	require := s.Require()
+
+	helper.SetProducerDowntimeWindowsHeight(1)
+	defer helper.SetProducerDowntimeWindowsHeight(0)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	borKeeper := s.borKeeper
+	producerID := uint64(1)
+
+	require.NoError(borKeeper.AddNewSpan(ctx, &types.Span{Id: 1, StartBlock: 1000, EndBlock: 1999, BorChainId: "1"}))
+
+	// a past window doesn't count
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: 100, EndBlock: 200}))
+
+	for i := uint64(0); i < types.MaxPlannedDowntimeWindows; i++ {
+		start := 10_000 + i*1000
+		require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: start, EndBlock: start + 500}))
+	}
+
+	err := borKeeper.AddProducerPlannedDowntime(ctx, producerID, types.BlockRange{StartBlock: 50_000, EndBlock: 50_500})
+	require.ErrorIs(err, types.ErrTooManyDowntimeWindows)
+
+	// the past window was removed on add
+	windows, err := borKeeper.GetProducerPlannedDowntimes(ctx, producerID)
+	require.NoError(err)
+	require.Len(windows, types.MaxPlannedDowntimeWindows)
+	require.Equal(uint64(10_000), windows[0].StartBlock)
+}
+
+func (s *KeeperTestSuite) TestMigrateProducerPlannedDowntimes() {
+	require := s.Require()
+
+	helper.SetProducerDowntimeWindowsHeight(100)
+	defer helper.SetProducerDowntimeWindowsHeight(0)
+
+	borKeeper := s.borKeeper
+
+	// before the fork, a new window replaces the one of the producer
+	ctx := s.ctx.WithBlockHeight(99)
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, 1, types.BlockRange{StartBlock: 3000, EndBlock: 3500}))
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, 1, types.BlockRange{StartBlock: 5000, EndBlock: 5500}))
+	require.NoError(borKeeper.AddProducerPlannedDowntime(ctx, 2, types.BlockRange{StartBlock: 4000, EndBlock: 4500}))
+
+	windows, err := borKeeper.GetProducerPlannedDowntimes(ctx, 1)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 5000, EndBlock: 5500}}, windows)
+
+	// at the fork, the windows are moved
+	ctx = s.ctx.WithBlockHeight(100)
+	require.NoError(borKeeper.MigrateProducerPlannedDowntimes(ctx))
+
+	windows, err = borKeeper.GetProducerPlannedDowntimes(ctx, 1)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 5000, EndBlock: 5500}}, windows)
+
+	windows, err = borKeeper.GetProducerPlannedDowntimes(ctx, 2)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 4000, EndBlock: 4500}}, windows)
+
+	found, err := borKeeper.ProducerPlannedDowntime.Has(ctx, 1)
+	require.NoError(err)
+	require.False(found)
+
+	// the migration runs at every block after the fork, and keeps the windows planned since
+	ctx = s.ctx.WithBlockHeight(101)
+	require.NoError(borKeeper.ProducerDowntimeWindows.Set(ctx, collections.Join(uint64(1), uint64(7000)), types.BlockRange{StartBlock: 7000, EndBlock: 7500}))
+	require.NoError(borKeeper.MigrateProducerPlannedDowntimes(ctx))
+
+	windows, err = borKeeper.GetProducerPlannedDowntimes(ctx, 1)
+	require.NoError(err)
+	require.Equal([]types.BlockRange{{StartBlock: 5000, EndBlock: 5500}, {StartBlock: 7000, EndBlock: 7500}}, windows)
+}
+
+func (s *KeeperTestSuite) TestCancelProducerDowntime() {
+	require := s.Require()
+
+	producer := common.HexToAddress("0x0000000000000000000000000000000000000001").Hex()
+	s.stakeKeeper.EXPECT().GetValIdFromAddress(gomock.Any(), producer).Return(uint64(1), nil).AnyTimes()
+
+	msg := types.NewMsgCancelProducerDowntime(producer, 3000)
+
+	// cancellation isn't allowed before the fork
+	_, err := s.msgServer.CancelProducerDowntime(s.ctx, msg)
+	require.ErrorContains(err, "before the producerDowntimeWindowsHeight")
+
+	helper.SetProducerDowntimeWindowsHeight(1)
+	defer helper.SetProducerDowntimeWindowsHeight(0)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	require.NoError(s.borKeeper.AddNewSpan(ctx, &types.Span{Id: 1, StartBlock: 1000, EndBlock: 1999, BorChainId: "1"}))
+	require.NoError(s.borKeeper.AddProducerPlannedDowntime(ctx, 1, types.BlockRange{StartBlock: 3000, EndBlock: 3500}))
+
+	_, err = s.msgServer.CancelProducerDowntime(ctx, msg)
+	require.NoError(err)
+
+	windows, err := s.borKeeper.GetProducerPlannedDowntimes(ctx, 1)
+	require.NoError(err)
+	require.Empty(windows)
+
+	_, err = s.msgServer.CancelProducerDowntime(ctx, msg)
+	require.ErrorIs(err, types.ErrDowntimeNotFound)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+// SimulateProducerSet simulates the election of the producer of the next span, as run once the milestones reach
+// the last span, with the given producer votes and planned downtimes applied on a branch of the state.
+// The branch is discarded, so the state is left unchanged.
+func (k *Keeper) SimulateProducerSet(ctx sdk.Context, votes []types.SimulatedProducerVotes, downtimes []types.SimulatedProducerDowntime) (*types.QuerySimulateProducerSetResponse, error) {
+	simCtx, _ := ctx.CacheContext()
+
+	for _, vote := range votes {
+		if len(vote.Votes) == 0 {
+			if err := k.RemoveProducerVotes(simCtx, vote.ValidatorId); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := k.SetProducerVotes(simCtx, vote.ValidatorId, types.ProducerVotes{Votes: vote.Votes}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, downtime := range downtimes {
+		if err := k.AddProducerPlannedDowntime(simCtx, downtime.ProducerId, downtime.DowntimeRange); err != nil {
+			return nil, err
+		}
+	}
+
+	producerScores, err := k.RankProducerCandidates(simCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	producerSetLimit := helper.GetProducerSetLimit(simCtx)
+
+	producerSet, err := k.CalculateProducerSet(simCtx, producerSetLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSpan, err := k.GetLastSpan(simCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.GetParams(simCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentProducer, err := k.FindCurrentProducerID(simCtx, lastSpan.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// the milestone supporters, deciding the next span, are simulated with the ones of the last milestone
+	activeValidatorIDs, err := k.GetLatestActiveProducer(simCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	spanRange := types.BlockRange{StartBlock: lastSpan.EndBlock + 1, EndBlock: lastSpan.EndBlock + params.SpanDuration}
+
+	nextProducer, err := k.SelectNextSpanProducer(simCtx, currentProducer, activeValidatorIDs, producerSetLimit,
+		spanRange.StartBlock, spanRange.EndBlock, types.RoundRobinDefault, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateProducerSetResponse{
+		ProducerScores:  producerScores,
+		ProducerSet:     producerSet,
+		CurrentProducer: currentProducer,
+		SpanRange:       spanRange,
+		NextProducer:    nextProducer,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+	staketypes "github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+func (s *KeeperTestSuite) TestSimulateProducerSet() {
+	require := s.Require()
+	ctx, borKeeper, stakeKeeper := s.ctx, s.borKeeper, s.stakeKeeper
+
+	val1 := staketypes.Validator{ValId: 1, VotingPower: 100}
+	val2 := staketypes.Validator{ValId: 2, VotingPower: 90}
+	val3 := staketypes.Validator{ValId: 3, VotingPower: 80}
+	validators := map[uint64]staketypes.Validator{1: val1, 2: val2, 3: val3}
+
+	stakeKeeper.EXPECT().GetValidatorSet(gomock.Any()).Return(staketypes.ValidatorSet{
+		Validators: []*staketypes.Validator{&val1, &val2, &val3},
+	}, nil).AnyTimes()
+	stakeKeeper.EXPECT().GetValidatorFromValID(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, valID uint64) (staketypes.Validator, error) {
+			return validators[valID], nil
+		}).AnyTimes()
+
+	require.NoError(borKeeper.AddNewSpan(ctx, &types.Span{
+		Id:                0,
+		StartBlock:        0,
+		EndBlock:          1001,
+		SelectedProducers: []staketypes.Validator{val2},
+	}))
+	require.NoError(borKeeper.UpdateLatestActiveProducer(ctx, map[uint64]struct{}{2: {}, 3: {}}))
+
+	for _, val := range []staketypes.Validator{val1, val2, val3} {
+		require.NoError(borKeeper.SetProducerVotes(ctx, val.ValId, types.ProducerVotes{Votes: []uint64{2, 3}}))
+	}
+
+	params, err := borKeeper.GetParams(ctx)
+	require.NoError(err)
+	spanRange := types.BlockRange{StartBlock: 1002, EndBlock: 1001 + params.SpanDuration}
+
+	// the current state elects the producers in vote order, and the next one rotates
+	res, err := s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{})
+	require.NoError(err)
+	require.Equal([]types.ProducerScore{
+		{ValidatorId: 2, Score: 3 * 270, RequiredScore: 3*270*2/3 + 1},
+		{ValidatorId: 3, Score: 2 * 270, RequiredScore: 2*270*2/3 + 1},
+	}, res.ProducerScores)
+	require.Equal([]uint64{2, 3}, res.ProducerSet)
+	require.Equal(uint64(2), res.CurrentProducer)
+	require.Equal(spanRange, res.SpanRange)
+	require.Equal(uint64(3), res.NextProducer)
+
+	// a planned downtime of the next producer skips it
+	res, err = s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{
+		ProducerDowntimes: []types.SimulatedProducerDowntime{{ProducerId: 3, DowntimeRange: spanRange}},
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), res.NextProducer)
+
+	// changed votes change the producer set
+	res, err = s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{
+		ProducerVotes: []types.SimulatedProducerVotes{
+			{ValidatorId: 1, Votes: []uint64{3, 2}},
+			{ValidatorId: 2, Votes: []uint64{3, 2}},
+			{ValidatorId: 3, Votes: []uint64{3, 2}},
+		},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{3, 2}, res.ProducerSet)
+	require.Equal(uint64(3), res.NextProducer)
+
+	// removed votes leave a candidate short of its required score
+	res, err = s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{
+		ProducerVotes: []types.SimulatedProducerVotes{{ValidatorId: 1}, {ValidatorId: 2}},
+	})
+	require.NoError(err)
+	require.Equal(int64(3*80), res.ProducerScores[0].Score)
+	require.Empty(res.ProducerSet)
+
+	// the state is left unchanged
+	votes, err := borKeeper.GetProducerVotes(ctx, 1)
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, votes.Votes)
+
+	down, err := borKeeper.IsProducerDownForBlockRange(ctx, spanRange.StartBlock, spanRange.EndBlock, 3)
+	require.NoError(err)
+	require.False(down)
+
+	// invalid hypotheses are rejected
+	_, err = s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{
+		ProducerVotes: []types.SimulatedProducerVotes{{ValidatorId: 1, Votes: []uint64{2, 2}}},
+	})
+	require.ErrorContains(err, "duplicate vote")
+
+	_, err = s.queryClient.SimulateProducerSet(ctx, &types.QuerySimulateProducerSetRequest{
+		ProducerDowntimes: []types.SimulatedProducerDowntime{{ProducerId: 3, DowntimeRange: types.BlockRange{StartBlock: 10, EndBlock: 10}}},
+	})
+	require.ErrorContains(err, "must start before it ends")
+}
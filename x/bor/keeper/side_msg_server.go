@@ -493,7 +493,7 @@ func (srv sideMsgServer) PostHandleMsgBackfillSpans(ctx sdk.Context, msgI sdk.Ms
 	borSpans := types.GenerateBorCommittedSpans(latestMilestone.EndBlock, &latestSpan)
 	spansOverlap := 0
 	for i := range borSpans {
-		if _, err := srv.k.GetSpan(ctx, borSpans[i].Id); err == nil {
+		if _, err := srv.k.getSpanOrSummary(ctx, borSpans[i].Id); err == nil {
 			spansOverlap++
 		}
 		if spansOverlap > 1 {
@@ -504,6 +504,10 @@ func (srv sideMsgServer) PostHandleMsgBackfillSpans(ctx sdk.Context, msgI sdk.Ms
 			logger.Error("Unable to store spans", "error", err)
 			return err
 		}
+		if err = srv.k.setSpanSeed(ctx, borSpans[i].Id, nil); err != nil {
+			logger.Error("Unable to store span seed", "error", err)
+			return err
+		}
 	}
 
 	txBytes := ctx.TxBytes()
@@ -574,44 +578,29 @@ func (srv sideMsgServer) PostHandleSetProducerDowntime(ctx sdk.Context, msgI sdk
 	}
 
 	// Only return an error if the requested downtime overlaps with every other producer
-	overlapCount := 0
-	foundCount := 0 // number of "other" producers that actually have a planned downtime record
+	overlapCount := 0 // number of "other" producers with a planned downtime overlapping the requested range
 	for _, p := range producers {
 		if p == validatorId {
 			continue
 		}
 
-		found, err := srv.k.ProducerPlannedDowntime.Has(ctx, p)
-		if err != nil {
-			return err
-		}
-		if !found {
-			// Another producer without planned downtime means "not everyone is down"
-			// so we won't be able to reach the "all others overlap" condition.
-			continue
-		}
-
-		foundCount++
-
-		downtime, err := srv.k.ProducerPlannedDowntime.Get(ctx, p)
+		// Another producer without overlapping planned downtime means "not everyone is down"
+		isDown, err := srv.k.IsProducerDownForBlockRange(ctx, msg.DowntimeRange.StartBlock, msg.DowntimeRange.EndBlock, p)
 		if err != nil {
 			return err
 		}
-
-		// Inclusive overlap: [reqStart, reqEnd] overlaps [dtStart, dtEnd]
-		reqStart, reqEnd := msg.DowntimeRange.StartBlock, msg.DowntimeRange.EndBlock
-		if reqStart <= downtime.EndBlock && reqEnd >= downtime.StartBlock {
+		if isDown {
 			overlapCount++
 		}
 	}
 
 	otherProducers := len(producers) - 1
-	// Reject only if EVERY other producer has a planned downtime AND all of them overlap the requested range
-	if otherProducers > 0 && foundCount == otherProducers && overlapCount == otherProducers {
+	// Reject only if EVERY other producer has a planned downtime overlapping the requested range
+	if otherProducers > 0 && overlapCount == otherProducers {
 		return fmt.Errorf("producer with id %d has overlapping planned downtime with all other producers", validatorId)
 	}
 
-	if err := srv.k.ProducerPlannedDowntime.Set(ctx, validatorId, types.BlockRange{
+	if err := srv.k.AddProducerPlannedDowntime(ctx, validatorId, types.BlockRange{
 		StartBlock: msg.DowntimeRange.StartBlock,
 		EndBlock:   msg.DowntimeRange.EndBlock,
 	}); err != nil {
@@ -653,13 +642,8 @@ func (srv sideMsgServer) PostHandleSetProducerDowntime(ctx sdk.Context, msgI sdk
 		cur := lastSpan
 
 		for {
-			isDown, dErr := srv.k.IsProducerDownForBlockRange(ctx, cur.StartBlock, cur.EndBlock, validatorId)
-			if dErr != nil {
-				logger.Error("Failed to check producer downtime overlap", "spanId", cur.Id, "error", dErr)
-				return false, dErr
-			}
-
-			if isDown {
+			// only the requested window matters: the spans overlapping the other windows were handled when they were set
+			if cur.StartBlock <= msg.DowntimeRange.EndBlock && cur.EndBlock >= msg.DowntimeRange.StartBlock {
 				return true, nil
 			}
 
@@ -669,7 +653,7 @@ func (srv sideMsgServer) PostHandleSetProducerDowntime(ctx sdk.Context, msgI sdk
 			}
 
 			// Move to the previous span; if not found, stop scanning.
-			prev, gErr := srv.k.GetSpan(ctx, cur.Id-1)
+			prev, gErr := srv.k.getSpanOrSummary(ctx, cur.Id-1)
 			if gErr != nil {
 				logger.Debug("Stopping backward scan while traversing previous spans",
 					"fromSpanId", cur.Id, "error", gErr)
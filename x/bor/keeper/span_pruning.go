@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+// GetSpanSummary fetches the summary of a pruned span indexed by id from store
+func (k *Keeper) GetSpanSummary(ctx context.Context, id uint64) (types.SpanSummary, error) {
+	summary, err := k.spanSummaries.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SpanSummary{}, fmt.Errorf("span summary not found for id: %v", id)
+	}
+
+	return summary, err
+}
+
+// GetAllSpanSummaries fetches the summaries of all the pruned spans from store
+func (k *Keeper) GetAllSpanSummaries(ctx context.Context) ([]types.SpanSummary, error) {
+	iter, err := k.spanSummaries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(iter collections.Iterator[uint64, types.SpanSummary]) {
+		if err := iter.Close(); err != nil {
+			k.Logger(ctx).Error("Error closing span summary iterator", "err", err)
+		}
+	}(iter)
+
+	return iter.Values()
+}
+
+// GetAllSpanSeeds fetches the seeds of the spans not pruned yet from store
+func (k *Keeper) GetAllSpanSeeds(ctx context.Context) ([]types.SpanSeed, error) {
+	iter, err := k.spanSeeds.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(iter collections.Iterator[uint64, []byte]) {
+		if err := iter.Close(); err != nil {
+			k.Logger(ctx).Error("Error closing span seed iterator", "err", err)
+		}
+	}(iter)
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var seeds []types.SpanSeed
+	for _, kv := range kvs {
+		seeds = append(seeds, types.SpanSeed{SpanId: kv.Key, Seed: kv.Value})
+	}
+
+	return seeds, nil
+}
+
+// getSpanOrSummary fetches the span indexed by id, or the span rebuilt from its summary when its body is pruned.
+// The selected producers of a rebuilt span only have their ID and signer set, and its validator set is empty.
+func (k *Keeper) getSpanOrSummary(ctx context.Context, id uint64) (types.Span, error) {
+	ok, err := k.spans.Has(ctx, id)
+	if err != nil {
+		return types.Span{}, err
+	}
+
+	if ok {
+		return k.spans.Get(ctx, id)
+	}
+
+	summary, err := k.spanSummaries.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Span{}, fmt.Errorf("span not found for id: %v", id)
+	}
+	if err != nil {
+		return types.Span{}, err
+	}
+
+	return summary.ToSpan(), nil
+}
+
+// PruneSpans replaces the bodies of the spans older than the span retention with their summaries, keeping
+// the block range, the producers and the seed of each span. Up to MaxSpansPrunedPerBlock spans are pruned
+// per block, in ID order. Only the spans with a stored seed, i.e. the ones added once the hardfork is active,
+// are pruned: the seed of the spans frozen before isn't part of the state, so they keep their full body.
+func (k *Keeper) PruneSpans(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsBorSpanPruning(sdkCtx.BlockHeight()) {
+		return nil
+	}
+
+	params, err := k.FetchParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.SpanRetention == 0 {
+		return nil
+	}
+
+	ok, err := k.latestSpan.Has(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	lastSpanID, err := k.latestSpan.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if lastSpanID < params.SpanRetention {
+		return nil
+	}
+
+	seeds, err := k.collectPrunableSpanSeeds(ctx, lastSpanID-params.SpanRetention)
+	if err != nil || len(seeds) == 0 {
+		return err
+	}
+
+	for _, seed := range seeds {
+		span, err := k.spans.Get(ctx, seed.SpanId)
+		if err != nil {
+			return fmt.Errorf("failed to get span %d: %w", seed.SpanId, err)
+		}
+
+		if err := k.spanSummaries.Set(ctx, span.Id, types.NewSpanSummary(span, seed.Seed)); err != nil {
+			return fmt.Errorf("failed to store summary of span %d: %w", span.Id, err)
+		}
+
+		if err := k.spans.Remove(ctx, span.Id); err != nil {
+			return fmt.Errorf("failed to remove span %d: %w", span.Id, err)
+		}
+
+		if err := k.spanSeeds.Remove(ctx, span.Id); err != nil {
+			return fmt.Errorf("failed to remove seed of span %d: %w", span.Id, err)
+		}
+	}
+
+	k.Logger(ctx).Debug("Pruned spans", "fromID", seeds[0].SpanId, "toID", seeds[len(seeds)-1].SpanId)
+
+	return nil
+}
+
+// collectPrunableSpanSeeds returns the seeds of the oldest spans which have one, up to the given span ID.
+func (k *Keeper) collectPrunableSpanSeeds(ctx context.Context, toID uint64) ([]types.SpanSeed, error) {
+	iterator, err := k.spanSeeds.Iterate(ctx, new(collections.Range[uint64]).EndInclusive(toID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate span seeds for pruning: %w", err)
+	}
+	defer func() {
+		if err := iterator.Close(); err != nil {
+			k.Logger(ctx).Error("failed to close iterator in collectPrunableSpanSeeds", "error", err)
+		}
+	}()
+
+	var seeds []types.SpanSeed
+	for ; iterator.Valid() && len(seeds) < types.MaxSpansPrunedPerBlock; iterator.Next() {
+		kv, err := iterator.KeyValue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read span seed for pruning: %w", err)
+		}
+
+		seeds = append(seeds, types.SpanSeed{SpanId: kv.Key, Seed: kv.Value})
+	}
+
+	return seeds, nil
+}
+
+// setSpanSeed keeps the seed of a span added once the span pruning hardfork is active, so that it's part of the
+// span summary once the span body gets pruned. The spans not selected from a seed get an empty one, as only the
+// spans with a stored seed are pruned.
+func (k *Keeper) setSpanSeed(ctx sdk.Context, id uint64, seed []byte) error {
+	if !helper.IsBorSpanPruning(ctx.BlockHeight()) {
+		return nil
+	}
+
+	if seed == nil {
+		seed = []byte{}
+	}
+
+	return k.spanSeeds.Set(ctx, id, seed)
+}
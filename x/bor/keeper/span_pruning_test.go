@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+func enableSpanPruningForTest(height int64) func() {
+	original := helper.GetBorSpanPruningHeight()
+	helper.SetBorSpanPruningHeight(height)
+	return func() { helper.SetBorSpanPruningHeight(original) }
+}
+
+func spanTestSeed(id uint64) []byte {
+	return common.BigToHash(new(big.Int).SetUint64(id)).Bytes()
+}
+
+// initPruningTestSpans stores the spans 1-count, each one with a seed, through the genesis.
+func (s *KeeperTestSuite) initPruningTestSpans(count uint64) []types.Span {
+	spans := make([]types.Span, 0, count)
+	seeds := make([]types.SpanSeed, 0, count)
+	for _, span := range s.genTestSpans(count) {
+		spans = append(spans, *span)
+		seeds = append(seeds, types.SpanSeed{SpanId: span.Id, Seed: spanTestSeed(span.Id)})
+	}
+
+	s.borKeeper.InitGenesis(s.ctx, &types.GenesisState{
+		Params:    types.DefaultParams(),
+		Spans:     spans,
+		SpanSeeds: seeds,
+	})
+
+	return spans
+}
+
+func (s *KeeperTestSuite) setSpanRetention(retention uint64) {
+	params := types.DefaultParams()
+	params.SpanRetention = retention
+	s.Require().NoError(s.borKeeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestPruneSpans() {
+	require, borKeeper := s.Require(), s.borKeeper
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	spans := s.initPruningTestSpans(250)
+
+	// disabled by the default params
+	require.NoError(borKeeper.PruneSpans(ctx))
+	ok, err := borKeeper.HasSpan(ctx, 1)
+	require.NoError(err)
+	require.True(ok)
+
+	// the spans 1-150 are past the retention, pruned by at most MaxSpansPrunedPerBlock per block
+	s.setSpanRetention(100)
+	require.NoError(borKeeper.PruneSpans(ctx))
+	ok, err = borKeeper.HasSpan(ctx, types.MaxSpansPrunedPerBlock)
+	require.NoError(err)
+	require.False(ok)
+	ok, err = borKeeper.HasSpan(ctx, types.MaxSpansPrunedPerBlock+1)
+	require.NoError(err)
+	require.True(ok)
+
+	require.NoError(borKeeper.PruneSpans(ctx))
+	ok, err = borKeeper.HasSpan(ctx, 150)
+	require.NoError(err)
+	require.False(ok)
+	ok, err = borKeeper.HasSpan(ctx, 151)
+	require.NoError(err)
+	require.True(ok)
+
+	require.NoError(borKeeper.PruneSpans(ctx))
+	ok, err = borKeeper.HasSpan(ctx, 151)
+	require.NoError(err)
+	require.True(ok)
+
+	summary, err := borKeeper.GetSpanSummary(ctx, 42)
+	require.NoError(err)
+	require.Equal(types.NewSpanSummary(spans[41], spanTestSeed(42)), summary)
+
+	_, err = borKeeper.GetSpanSummary(ctx, 151)
+	require.Error(err)
+
+	genesis := borKeeper.ExportGenesis(ctx)
+	require.Len(genesis.Spans, 100)
+	require.Len(genesis.SpanSummaries, 150)
+	require.Len(genesis.SpanSeeds, 100)
+	require.Equal(uint64(151), genesis.SpanSeeds[0].SpanId)
+}
+
+func (s *KeeperTestSuite) TestPruneSpansWithoutSeed() {
+	require, borKeeper, queryClient := s.Require(), s.borKeeper, s.queryClient
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	// the spans 1-50 were frozen before the hardfork, without a seed, and the span 60 wasn't selected from a seed
+	spans := make([]types.Span, 0, 250)
+	seeds := make([]types.SpanSeed, 0, 200)
+	for _, span := range s.genTestSpans(250) {
+		spans = append(spans, *span)
+		switch {
+		case span.Id <= 50:
+		case span.Id == 60:
+			seeds = append(seeds, types.SpanSeed{SpanId: span.Id})
+		default:
+			seeds = append(seeds, types.SpanSeed{SpanId: span.Id, Seed: spanTestSeed(span.Id)})
+		}
+	}
+
+	borKeeper.InitGenesis(ctx, &types.GenesisState{Params: types.DefaultParams(), Spans: spans, SpanSeeds: seeds})
+	s.setSpanRetention(100)
+
+	// only the spans 51-150 are pruned, the ones without a seed keep their body
+	require.NoError(borKeeper.PruneSpans(ctx))
+	require.NoError(borKeeper.PruneSpans(ctx))
+
+	for id := uint64(1); id <= 150; id++ {
+		ok, err := borKeeper.HasSpan(ctx, id)
+		require.NoError(err)
+		require.Equal(id <= 50, ok, "span %d", id)
+	}
+
+	summaries, err := borKeeper.GetAllSpanSummaries(ctx)
+	require.NoError(err)
+	require.Len(summaries, 100)
+	for _, summary := range summaries {
+		if summary.Id == 60 {
+			require.Empty(summary.Seed)
+			continue
+		}
+		require.Equal(spanTestSeed(summary.Id), summary.Seed)
+	}
+
+	// the body of a pruned span can't be read anymore
+	_, err = borKeeper.GetSpan(ctx, 51)
+	require.ErrorIs(err, types.ErrSpanPruned)
+
+	_, err = queryClient.GetSpanById(ctx, &types.QuerySpanByIdRequest{Id: "51"})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = queryClient.GetSpanById(ctx, &types.QuerySpanByIdRequest{Id: "1"})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPruneSpansBeforeHardfork() {
+	require, borKeeper := s.Require(), s.borKeeper
+	defer enableSpanPruningForTest(100)()
+	ctx := s.ctx.WithBlockHeight(99)
+
+	s.initPruningTestSpans(250)
+	s.setSpanRetention(100)
+
+	require.NoError(borKeeper.PruneSpans(ctx))
+	ok, err := borKeeper.HasSpan(ctx, 1)
+	require.NoError(err)
+	require.True(ok)
+
+	require.NoError(borKeeper.PruneSpans(ctx.WithBlockHeight(100)))
+	ok, err = borKeeper.HasSpan(ctx, 1)
+	require.NoError(err)
+	require.False(ok)
+}
+
+func (s *KeeperTestSuite) TestProducersByBlockNumberOfPrunedSpans() {
+	require, borKeeper := s.Require(), s.borKeeper
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	spans := s.initPruningTestSpans(250)
+	s.setSpanRetention(100)
+	require.NoError(borKeeper.PruneSpans(ctx))
+
+	pruned := spans[9]
+	span, err := borKeeper.SpanByBlockNumber(ctx, pruned.StartBlock+1)
+	require.NoError(err)
+	require.Equal(pruned.Id, span.Id)
+	require.Equal(pruned.StartBlock, span.StartBlock)
+	require.Equal(pruned.EndBlock, span.EndBlock)
+	require.Len(span.SelectedProducers, len(pruned.SelectedProducers))
+	for i, producer := range pruned.SelectedProducers {
+		require.Equal(producer.ValId, span.SelectedProducers[i].ValId)
+	}
+
+	producers, err := borKeeper.GetProducersByBlockNumber(ctx, pruned.StartBlock+1)
+	require.NoError(err)
+	require.Len(producers, len(pruned.SelectedProducers))
+	for i, producer := range pruned.SelectedProducers {
+		require.Equal(common.HexToAddress(producer.Signer), producers[i])
+	}
+
+	producerID, err := borKeeper.FindCurrentProducerID(ctx, pruned.StartBlock+1)
+	require.NoError(err)
+	require.Equal(pruned.SelectedProducers[0].ValId, producerID)
+}
+
+func (s *KeeperTestSuite) TestGetSpanSummaryById() {
+	require, queryClient, borKeeper := s.Require(), s.queryClient, s.borKeeper
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	s.initPruningTestSpans(150)
+	s.setSpanRetention(100)
+	require.NoError(borKeeper.PruneSpans(ctx))
+
+	res, err := queryClient.GetSpanSummaryById(ctx, &types.QuerySpanSummaryByIdRequest{Id: 50})
+	require.NoError(err)
+	require.Equal(uint64(50), res.SpanSummary.Id)
+	require.NotEmpty(res.SpanSummary.Seed)
+
+	_, err = queryClient.GetSpanSummaryById(ctx, &types.QuerySpanSummaryByIdRequest{Id: 51})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	_, err = queryClient.GetSpanSummaryById(ctx, nil)
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestInitExportGenesisWithSpanSummaries() {
+	require, borKeeper := s.Require(), s.borKeeper
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	s.initPruningTestSpans(150)
+	s.setSpanRetention(100)
+	require.NoError(borKeeper.PruneSpans(ctx))
+
+	genesis := borKeeper.ExportGenesis(ctx)
+	require.Len(genesis.SpanSummaries, 50)
+	require.Len(genesis.SpanSeeds, 100)
+
+	s.SetupTest()
+	s.borKeeper.InitGenesis(s.ctx, genesis)
+	require.Equal(genesis, s.borKeeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestFreezeSetStoresSpanSeed() {
+	require, stakeKeeper, borKeeper := s.Require(), s.stakeKeeper, s.borKeeper
+	defer enableSpanPruningForTest(1)()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	valSet, vals := s.genTestValidators()
+	require.NoError(borKeeper.AddNewSpan(ctx, &types.Span{Id: 0}))
+
+	params := types.DefaultParams()
+	params.ProducerCount = uint64(len(vals))
+	require.NoError(borKeeper.SetParams(ctx, params))
+
+	stakeKeeper.EXPECT().GetSpanEligibleValidators(ctx).Return(vals).Times(1)
+	stakeKeeper.EXPECT().GetValidatorSet(ctx).Return(valSet, nil).Times(1)
+
+	seed := common.HexToHash("0x1234")
+	require.NoError(borKeeper.FreezeSet(ctx, 1, 1, 100, "test-chain", seed))
+
+	genesis := borKeeper.ExportGenesis(ctx)
+	require.Equal([]types.SpanSeed{{SpanId: 1, Seed: seed.Bytes()}}, genesis.SpanSeeds)
+}
@@ -61,6 +61,16 @@ func (k *Keeper) AddNewVeBlopSpan(ctx sdk.Context, currentProducer uint64, start
 		return err
 	}
 
+	if err := k.setSpanSeed(ctx, newSpan.Id, nil); err != nil {
+		return err
+	}
+
+	if helper.IsValidatorPerformanceHistory(ctx.BlockHeight()) {
+		if err := k.RecordSpanAssignment(ctx, newSpan); err != nil {
+			return err
+		}
+	}
+
 	return k.SetLastSpanBlock(ctx, heimdallBlock)
 }
 
@@ -71,7 +81,7 @@ func (k *Keeper) FindCurrentProducerID(ctx context.Context, blockNum uint64) (ui
 	}
 
 	for i := lastSpan.Id; ; i-- {
-		span, err := k.GetSpan(ctx, i)
+		span, err := k.getSpanOrSummary(ctx, i)
 		if err != nil {
 			return 0, err
 		}
@@ -96,7 +106,7 @@ func (k *Keeper) FindPastBackupProducerIDs(ctx context.Context, blockNum uint64)
 
 	producerIDs := make([]uint64, 0)
 	for i := lastSpan.Id; i > 0; i-- {
-		span, err := k.GetSpan(ctx, i)
+		span, err := k.getSpanOrSummary(ctx, i)
 		if err != nil {
 			return nil, err
 		}
@@ -352,6 +362,47 @@ func (k *Keeper) resolveVoterStake(ctx context.Context, validatorID uint64, zuri
 // CalculateProducerSet ranks producer candidates by the sum of the stake from validators who voted for them,
 // weighted by their relative position in the candidate list.
 func (k *Keeper) CalculateProducerSet(ctx context.Context, producerSetLimit uint64) ([]uint64, error) {
+	rankedProducers, err := k.RankProducerCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rankedProducers) == 0 {
+		return []uint64{}, nil
+	}
+
+	finalCandidates := make([]uint64, 0, producerSetLimit)
+	for i, sp := range rankedProducers {
+		if i >= int(producerSetLimit) {
+			break // Reached producer set limit
+		}
+
+		// The candidate needs >= 2/3 of the max possible weighted vote at their position
+		k.Logger(ctx).Debug("Threshold check for candidate",
+			"candidateID", sp.ValidatorId,
+			"candidateScore", sp.Score,
+			"position", i+1,
+			"positionalRequiredScore", sp.RequiredScore)
+
+		if sp.Score >= sp.RequiredScore {
+			finalCandidates = append(finalCandidates, sp.ValidatorId)
+		} else {
+			k.Logger(ctx).Debug("Candidate failed to meet positional threshold, stopping further selection.",
+				"candidateID", sp.ValidatorId,
+				"candidateScore", sp.Score,
+				"positionalRequiredScore", sp.RequiredScore)
+			break // Stop adding candidates if one fails to qualify
+		}
+	}
+
+	k.Logger(ctx).Debug("Calculated producer set", "count", len(finalCandidates), "candidates", finalCandidates)
+	return finalCandidates, nil
+}
+
+// RankProducerCandidates returns the weighted scores of the producer candidates, ranked by score,
+// along with the score each one requires to enter the producer set at its rank.
+// It returns no candidates when none can qualify.
+func (k *Keeper) RankProducerCandidates(ctx context.Context) ([]types.ProducerScore, error) {
 	allValidators, err := k.sk.GetValidatorSet(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", heimdallTypes.ErrMsgFailedToGetValidatorSet, err)
@@ -360,7 +411,7 @@ func (k *Keeper) CalculateProducerSet(ctx context.Context, producerSetLimit uint
 	totalPotentialProducers := uint64(len(allValidators.Validators))
 	if totalPotentialProducers == 0 {
 		k.Logger(ctx).Info("No validators found, cannot calculate producer set.")
-		return []uint64{}, nil
+		return nil, nil
 	}
 
 	producerWeightedScores := make(map[uint64]int64) // Will now be the sum of stakes
@@ -420,70 +471,42 @@ func (k *Keeper) CalculateProducerSet(ctx context.Context, producerSetLimit uint
 
 	if len(producerWeightedScores) == 0 {
 		k.Logger(ctx).Warn("No producer votes recorded or no valid votes found.")
-		return []uint64{}, nil
+		return nil, nil
+	}
+
+	// Calculate the total stake of all validators for threshold calculation
+	var totalStakeOfAllValidators int64
+	for _, val := range allValidators.Validators {
+		totalStakeOfAllValidators += val.VotingPower
 	}
 
-	type scoredProducer struct {
-		ID    uint64
-		Score int64
+	if totalStakeOfAllValidators == 0 {
+		k.Logger(ctx).Warn("Total stake of all validators is 0. No producers can qualify under threshold logic.")
+		return nil, nil
 	}
 
-	rankedProducers := make([]scoredProducer, 0, len(producerWeightedScores))
+	rankedProducers := make([]types.ProducerScore, 0, len(producerWeightedScores))
 	for id, score := range producerWeightedScores {
-		rankedProducers = append(rankedProducers, scoredProducer{ID: id, Score: score})
+		rankedProducers = append(rankedProducers, types.ProducerScore{ValidatorId: id, Score: score})
 	}
 
 	// Sort producers by score in descending order.
 	// If scores are equal, sort by ID ascending for determinism.
 	sort.SliceStable(rankedProducers, func(i, j int) bool {
 		if rankedProducers[i].Score == rankedProducers[j].Score {
-			return rankedProducers[i].ID < rankedProducers[j].ID
+			return rankedProducers[i].ValidatorId < rankedProducers[j].ValidatorId
 		}
 		return rankedProducers[i].Score > rankedProducers[j].Score
 	})
 
-	// Calculate the total stake of all validators for threshold calculation
-	var totalStakeOfAllValidators int64
-	for _, val := range allValidators.Validators {
-		totalStakeOfAllValidators += val.VotingPower
-	}
-
-	if totalStakeOfAllValidators == 0 {
-		k.Logger(ctx).Warn("Total stake of all validators is 0. No producers can qualify under threshold logic.")
-		return []uint64{}, nil
-	}
-
-	finalCandidates := make([]uint64, 0, producerSetLimit)
-	for i, sp := range rankedProducers {
-		if i >= int(producerSetLimit) {
-			break // Reached producer set limit
-		}
-
+	for i := range rankedProducers {
 		// Calculate the positional threshold: candidate needs >= 2/3 of the max possible weighted vote at their position
 		position := uint64(i) + 1 // 1-indexed position
 		maxPossibleWeightedVoteAtPosition := int64(totalPotentialProducers-position+1) * totalStakeOfAllValidators
-		positionalRequiredScore := (maxPossibleWeightedVoteAtPosition * 2 / 3) + 1
-
-		k.Logger(ctx).Debug("Threshold check for candidate",
-			"candidateID", sp.ID,
-			"candidateScore", sp.Score,
-			"position", position,
-			"maxPossibleWeightedVoteAtPosition", maxPossibleWeightedVoteAtPosition,
-			"positionalRequiredScore", positionalRequiredScore)
-
-		if sp.Score >= positionalRequiredScore {
-			finalCandidates = append(finalCandidates, sp.ID)
-		} else {
-			k.Logger(ctx).Debug("Candidate failed to meet positional threshold, stopping further selection.",
-				"candidateID", sp.ID,
-				"candidateScore", sp.Score,
-				"positionalRequiredScore", positionalRequiredScore)
-			break // Stop adding candidates if one fails to qualify
-		}
+		rankedProducers[i].RequiredScore = (maxPossibleWeightedVoteAtPosition * 2 / 3) + 1
 	}
 
-	k.Logger(ctx).Debug("Calculated producer set", "count", len(finalCandidates), "candidates", finalCandidates)
-	return finalCandidates, nil
+	return rankedProducers, nil
 }
 
 // filterExcludedProducers drops any candidate present in excluded, preserving order. A nil/empty
@@ -564,21 +587,15 @@ func (k *Keeper) SelectProducer(ctx sdk.Context, currentProducer uint64, candida
 
 // IsProducerDownForBlockRange checks if a producer has planned downtime overlapping with the given block range.
 func (k *Keeper) IsProducerDownForBlockRange(ctx sdk.Context, startBlock, endBlock, producerID uint64) (bool, error) {
-	found, err := k.ProducerPlannedDowntime.Has(ctx, producerID)
+	downtimes, err := k.GetProducerPlannedDowntimes(ctx, producerID)
 	if err != nil {
 		return false, err
 	}
-	if !found {
-		return false, nil
-	}
 
-	downtime, err := k.ProducerPlannedDowntime.Get(ctx, producerID)
-	if err != nil {
-		return false, err
-	}
-
-	if startBlock <= downtime.EndBlock && endBlock >= downtime.StartBlock {
-		return true, nil
+	for _, downtime := range downtimes {
+		if startBlock <= downtime.EndBlock && endBlock >= downtime.StartBlock {
+			return true, nil
+		}
 	}
 
 	return false, nil
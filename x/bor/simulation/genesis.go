@@ -0,0 +1,86 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+const (
+	SprintDuration = "sprint_duration"
+	SpanDuration   = "span_duration"
+	ProducerCount  = "producer_count"
+	SpanRetention  = "span_retention"
+)
+
+// genSprintDuration returns a randomized sprint duration
+func genSprintDuration(r *rand.Rand) uint64 {
+	return uint64(r.Intn(64) + 1)
+}
+
+// genSpanDuration returns a randomized span duration, made of whole sprints
+func genSpanDuration(r *rand.Rand, sprintDuration uint64) uint64 {
+	return sprintDuration * uint64(r.Intn(400)+1)
+}
+
+// genProducerCount returns a randomized producer count
+func genProducerCount(r *rand.Rand) uint64 {
+	return uint64(r.Intn(int(types.DefaultProducerCount)*2) + 1)
+}
+
+// genSpanRetention returns a randomized span retention, disabling the pruning of the spans half the time
+func genSpanRetention(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+
+	return types.MinSpanRetention + uint64(r.Intn(1000))
+}
+
+// RandomizedGenState generates a random GenesisState for bor.
+// The spans are left empty: the first one is built from the validator set of the stake genesis,
+// which is only known once all the modules have generated their genesis.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		sprintDuration uint64
+		spanDuration   uint64
+		producerCount  uint64
+		spanRetention  uint64
+	)
+
+	simState.AppParams.GetOrGenerate(SprintDuration, &sprintDuration, simState.Rand, func(r *rand.Rand) {
+		sprintDuration = genSprintDuration(r)
+	})
+
+	simState.AppParams.GetOrGenerate(SpanDuration, &spanDuration, simState.Rand, func(r *rand.Rand) {
+		spanDuration = genSpanDuration(r, sprintDuration)
+	})
+
+	simState.AppParams.GetOrGenerate(ProducerCount, &producerCount, simState.Rand, func(r *rand.Rand) {
+		producerCount = genProducerCount(r)
+	})
+
+	simState.AppParams.GetOrGenerate(SpanRetention, &spanRetention, simState.Rand, func(r *rand.Rand) {
+		spanRetention = genSpanRetention(r)
+	})
+
+	params := types.Params{
+		SprintDuration: sprintDuration,
+		SpanDuration:   spanDuration,
+		ProducerCount:  producerCount,
+		SpanRetention:  spanRetention,
+	}
+
+	borGenesis := types.NewGenesisState(params, nil)
+
+	bz, err := json.MarshalIndent(&borGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated bor parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(borGenesis)
+}
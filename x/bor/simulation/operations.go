@@ -0,0 +1,287 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/bor/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/bor/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgProposeSpan            = "op_weight_msg_propose_span"
+	OpWeightMsgBackfillSpans          = "op_weight_msg_backfill_spans"
+	OpWeightMsgVoteProducers          = "op_weight_msg_vote_producers"
+	OpWeightMsgSetProducerDowntime    = "op_weight_msg_set_producer_downtime"
+	OpWeightMsgCancelProducerDowntime = "op_weight_msg_cancel_producer_downtime"
+
+	DefaultWeightMsgProposeSpan            = 10
+	DefaultWeightMsgBackfillSpans          = 5
+	DefaultWeightMsgVoteProducers          = 30
+	DefaultWeightMsgSetProducerDowntime    = 20
+	DefaultWeightMsgCancelProducerDowntime = 10
+)
+
+// WeightedOperations returns all the operations from the bor module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	k *keeper.Keeper,
+	stakeKeeper types.StakeKeeper,
+	chainKeeper types.ChainManagerKeeper,
+	milestoneKeeper types.MilestoneKeeper,
+) []hmSimulation.WeightedOperation {
+	var (
+		weightMsgProposeSpan            int
+		weightMsgBackfillSpans          int
+		weightMsgVoteProducers          int
+		weightMsgSetProducerDowntime    int
+		weightMsgCancelProducerDowntime int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgProposeSpan, &weightMsgProposeSpan, nil, func(_ *rand.Rand) {
+		weightMsgProposeSpan = DefaultWeightMsgProposeSpan
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgBackfillSpans, &weightMsgBackfillSpans, nil, func(_ *rand.Rand) {
+		weightMsgBackfillSpans = DefaultWeightMsgBackfillSpans
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgVoteProducers, &weightMsgVoteProducers, nil, func(_ *rand.Rand) {
+		weightMsgVoteProducers = DefaultWeightMsgVoteProducers
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgSetProducerDowntime, &weightMsgSetProducerDowntime, nil, func(_ *rand.Rand) {
+		weightMsgSetProducerDowntime = DefaultWeightMsgSetProducerDowntime
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgCancelProducerDowntime, &weightMsgCancelProducerDowntime, nil, func(_ *rand.Rand) {
+		weightMsgCancelProducerDowntime = DefaultWeightMsgCancelProducerDowntime
+	})
+
+	return []hmSimulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgProposeSpan, SimulateMsgProposeSpan(k, stakeKeeper, chainKeeper)),
+		hmSimulation.NewWeightedOperation(weightMsgBackfillSpans, SimulateMsgBackfillSpans(k, chainKeeper, milestoneKeeper)),
+		hmSimulation.NewWeightedOperation(weightMsgVoteProducers, SimulateMsgVoteProducers(k, stakeKeeper)),
+		hmSimulation.NewWeightedOperation(weightMsgSetProducerDowntime, SimulateMsgSetProducerDowntime(k, stakeKeeper)),
+		hmSimulation.NewWeightedOperation(weightMsgCancelProducerDowntime, SimulateMsgCancelProducerDowntime(k, stakeKeeper)),
+	}
+}
+
+// SimulateMsgProposeSpan generates a MsgProposeSpan following the last span.
+// It is only generated once the spans are produced by the votes of the producers, where it is always rejected:
+// before that, the validators fetch the span seeds from bor, which isn't simulated.
+func SimulateMsgProposeSpan(k *keeper.Keeper, stakeKeeper types.StakeKeeper, chainKeeper types.ChainManagerKeeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgProposeSpan{})
+
+		lastSpan, err := k.GetLastSpan(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the last span"), err
+		}
+
+		if !helper.IsRio(lastSpan.EndBlock + 1) {
+			return hmSimulation.NoOpMsg(msgType, "span seeds are fetched from bor"), nil
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the bor params"), err
+		}
+
+		chainParams, err := chainKeeper.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the chainmanager params"), err
+		}
+
+		validators := stakeKeeper.GetSpanEligibleValidators(ctx)
+		if len(validators) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no span eligible validator"), nil
+		}
+
+		proposer, _ := simtypes.RandomAcc(r, accs)
+		seed := make([]byte, common.HashLength)
+		r.Read(seed)
+
+		msg := types.NewMsgProposeSpan(
+			lastSpan.Id+1,
+			proposer.Address.String(),
+			lastSpan.EndBlock+1,
+			lastSpan.EndBlock+params.SpanDuration,
+			chainParams.ChainParams.BorChainId,
+			seed,
+			validators[r.Intn(len(validators))].Signer,
+		)
+
+		return hmSimulation.NewOperationMsg(msg, proposer, ""), nil
+	}
+}
+
+// SimulateMsgBackfillSpans generates a MsgBackfillSpans for the spans bor used past the last span, up to the last milestone
+func SimulateMsgBackfillSpans(k *keeper.Keeper, chainKeeper types.ChainManagerKeeper, milestoneKeeper types.MilestoneKeeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBackfillSpans{})
+
+		milestone, err := milestoneKeeper.GetLastMilestone(ctx)
+		if err != nil || milestone == nil {
+			return hmSimulation.NoOpMsg(msgType, "no milestone"), nil
+		}
+
+		lastSpan, err := k.GetLastSpan(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the last span"), err
+		}
+
+		borSpanID, err := types.CalcCurrentBorSpanId(milestone.EndBlock, &lastSpan)
+		if err != nil || borSpanID <= lastSpan.Id {
+			return hmSimulation.NoOpMsg(msgType, "no span to backfill"), nil
+		}
+
+		chainParams, err := chainKeeper.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the chainmanager params"), err
+		}
+
+		proposer, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgBackfillSpans{
+			Proposer:        util.FormatAddress(proposer.Address.String()),
+			ChainId:         chainParams.ChainParams.BorChainId,
+			LatestSpanId:    lastSpan.Id,
+			LatestBorSpanId: borSpanID,
+		}
+
+		return hmSimulation.NewOperationMsg(msg, proposer, ""), nil
+	}
+}
+
+// SimulateMsgVoteProducers generates a MsgVoteProducers of a random validator, voting for a random subset of the validators
+func SimulateMsgVoteProducers(k *keeper.Keeper, stakeKeeper types.StakeKeeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVoteProducers{})
+
+		if err := k.CanVoteProducers(ctx); err != nil {
+			return hmSimulation.NoOpMsg(msgType, "producer votes are not enabled"), nil
+		}
+
+		validatorSet, err := stakeKeeper.GetValidatorSet(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the validator set"), err
+		}
+
+		if len(validatorSet.Validators) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "empty validator set"), nil
+		}
+
+		voter := validatorSet.Validators[r.Intn(len(validatorSet.Validators))]
+
+		voterAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(voter.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "voter is not a simulation account"), nil
+		}
+
+		votes := make([]uint64, 0, len(validatorSet.Validators))
+		for _, i := range r.Perm(len(validatorSet.Validators))[:r.Intn(len(validatorSet.Validators))+1] {
+			votes = append(votes, validatorSet.Validators[i].ValId)
+		}
+
+		msg := &types.MsgVoteProducers{
+			Voter:   util.FormatAddress(voterAcc.Address.String()),
+			VoterId: voter.ValId,
+			Votes:   types.ProducerVotes{Votes: votes},
+		}
+
+		return hmSimulation.NewOperationMsg(msg, voterAcc, ""), nil
+	}
+}
+
+// SimulateMsgSetProducerDowntime generates a MsgSetProducerDowntime planning a random downtime for a producer of the
+// current producer set, within the bounds allowed past the latest bor block
+func SimulateMsgSetProducerDowntime(k *keeper.Keeper, stakeKeeper types.StakeKeeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetProducerDowntime{})
+
+		nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		if err := k.CanSetProducerDowntime(nextCtx); err != nil {
+			return hmSimulation.NoOpMsg(msgType, "producer downtimes are not enabled"), nil
+		}
+
+		candidates, err := k.CalculateProducerSet(ctx, helper.GetProducerSetLimit(nextCtx))
+		if err != nil || len(candidates) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no producer"), nil
+		}
+
+		producerID := candidates[r.Intn(len(candidates))]
+
+		producer, err := stakeKeeper.GetValidatorFromValID(ctx, producerID)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the producer"), err
+		}
+
+		producerAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(producer.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "producer is not a simulation account"), nil
+		}
+
+		childBlock, err := contractCaller.GetBorChainBlock(ctx, nil)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the latest bor block"), err
+		}
+
+		start := childBlock.Number.Uint64() + types.PlannedDowntimeMinimumTimeInFuture +
+			uint64(r.Int63n(int64(types.PlannedDowntimeMaximumTimeInFuture-types.PlannedDowntimeMinimumTimeInFuture-types.PlannedDowntimeMaxRange)))
+		end := start + types.PlannedDowntimeMinRange + uint64(r.Int63n(int64(types.PlannedDowntimeMaxRange-types.PlannedDowntimeMinRange)))
+
+		msg := types.NewMsgSetProducerDowntime(producerAcc.Address.String(), start, end, types.RoundRobinDefault)
+
+		return hmSimulation.NewOperationMsg(msg, producerAcc, ""), nil
+	}
+}
+
+// SimulateMsgCancelProducerDowntime generates a MsgCancelProducerDowntime for a random planned downtime window
+func SimulateMsgCancelProducerDowntime(k *keeper.Keeper, stakeKeeper types.StakeKeeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelProducerDowntime{})
+
+		if !helper.IsProducerDowntimeWindows(ctx.BlockHeight() + 1) {
+			return hmSimulation.NoOpMsg(msgType, "producer downtime windows are not enabled"), nil
+		}
+
+		iter, err := k.ProducerDowntimeWindows.Iterate(ctx, nil)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to iterate the downtime windows"), err
+		}
+
+		windows, err := iter.KeyValues()
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the downtime windows"), err
+		}
+
+		if len(windows) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no planned downtime"), nil
+		}
+
+		window := windows[r.Intn(len(windows))]
+
+		producer, err := stakeKeeper.GetValidatorFromValID(ctx, window.Key.K1())
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the producer"), err
+		}
+
+		producerAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(producer.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "producer is not a simulation account"), nil
+		}
+
+		msg := types.NewMsgCancelProducerDowntime(producerAcc.Address.String(), window.Value.StartBlock)
+
+		return hmSimulation.NewOperationMsg(msg, producerAcc, ""), nil
+	}
+}
@@ -132,6 +132,9 @@ type Params struct {
 	// Number of producers to select from the validator set for each span.
 	// Producers are selected based on their voting power.
 	ProducerCount uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
+	// Number of the most recent spans kept with their full body. The older
+	// spans are pruned into span summaries. Zero disables the pruning.
+	SpanRetention uint64 `protobuf:"varint,4,opt,name=span_retention,json=spanRetention,proto3" json:"span_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -188,6 +191,13 @@ func (m *Params) GetProducerCount() uint64 {
 	return 0
 }
 
+func (m *Params) GetSpanRetention() uint64 {
+	if m != nil {
+		return m.SpanRetention
+	}
+	return 0
+}
+
 // ProducerVotes contains the validator IDs that a validator has voted for
 // to be included in the next span's producer set.
 type ProducerVotes struct {
@@ -292,47 +302,306 @@ func (m *BlockRange) GetEndBlock() uint64 {
 	return 0
 }
 
+// ValidatorPerformance is the performance of a validator during a span,
+// recorded from the milestones finalizing the blocks of the span.
+type ValidatorPerformance struct {
+	// ID of the span.
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	// ID of the validator.
+	ValidatorId uint64 `protobuf:"varint,2,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Number of blocks of the span finalized by the milestones the validator
+	// supported, the span counterpart of the validator performance score.
+	Score uint64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Number of blocks of the span assigned to the validator as producer,
+	// excluding the blocks taken over by a later span.
+	BlocksAssigned uint64 `protobuf:"varint,4,opt,name=blocks_assigned,json=blocksAssigned,proto3" json:"blocks_assigned,omitempty"`
+	// Number of blocks of the span produced by the validator and finalized by
+	// a milestone.
+	BlocksProduced uint64 `protobuf:"varint,5,opt,name=blocks_produced,json=blocksProduced,proto3" json:"blocks_produced,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed6109dea23871eb, []int{4}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetBlocksAssigned() uint64 {
+	if m != nil {
+		return m.BlocksAssigned
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetBlocksProduced() uint64 {
+	if m != nil {
+		return m.BlocksProduced
+	}
+	return 0
+}
+
+// SpanSummary is the compact record of a span whose full body was pruned,
+// keeping what the lookups of the producers of its blocks need.
+type SpanSummary struct {
+	// ID of the span.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// First block number included in the span (inclusive).
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// Last block number included in the span (inclusive).
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// IDs of the selected producers of the span, in the span order.
+	ProducerIds []uint64 `protobuf:"varint,4,rep,packed,name=producer_ids,json=producerIds,proto3" json:"producer_ids,omitempty"`
+	// Signers of the selected producers at the time of the span, in the same
+	// order, as the validators can rotate their signers later.
+	ProducerSigners []string `protobuf:"bytes,5,rep,name=producer_signers,json=producerSigners,proto3" json:"producer_signers,omitempty"`
+	// Seed of the producer selection, empty for the spans not selected from a
+	// seed.
+	Seed []byte `protobuf:"bytes,6,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Chain ID of the Bor chain the span applies to.
+	BorChainId string `protobuf:"bytes,7,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id,omitempty"`
+}
+
+func (m *SpanSummary) Reset()         { *m = SpanSummary{} }
+func (m *SpanSummary) String() string { return proto.CompactTextString(m) }
+func (*SpanSummary) ProtoMessage()    {}
+func (*SpanSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed6109dea23871eb, []int{5}
+}
+func (m *SpanSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpanSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpanSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpanSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpanSummary.Merge(m, src)
+}
+func (m *SpanSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpanSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpanSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpanSummary proto.InternalMessageInfo
+
+func (m *SpanSummary) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SpanSummary) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *SpanSummary) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *SpanSummary) GetProducerIds() []uint64 {
+	if m != nil {
+		return m.ProducerIds
+	}
+	return nil
+}
+
+func (m *SpanSummary) GetProducerSigners() []string {
+	if m != nil {
+		return m.ProducerSigners
+	}
+	return nil
+}
+
+func (m *SpanSummary) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *SpanSummary) GetBorChainId() string {
+	if m != nil {
+		return m.BorChainId
+	}
+	return ""
+}
+
+// SpanSeed is the seed of the producer selection of a span, kept until the
+// span is pruned into its summary.
+type SpanSeed struct {
+	// ID of the span.
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	// Seed of the producer selection.
+	Seed []byte `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *SpanSeed) Reset()         { *m = SpanSeed{} }
+func (m *SpanSeed) String() string { return proto.CompactTextString(m) }
+func (*SpanSeed) ProtoMessage()    {}
+func (*SpanSeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed6109dea23871eb, []int{6}
+}
+func (m *SpanSeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpanSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpanSeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpanSeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpanSeed.Merge(m, src)
+}
+func (m *SpanSeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpanSeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpanSeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpanSeed proto.InternalMessageInfo
+
+func (m *SpanSeed) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *SpanSeed) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Span)(nil), "heimdallv2.bor.Span")
 	proto.RegisterType((*Params)(nil), "heimdallv2.bor.Params")
 	proto.RegisterType((*ProducerVotes)(nil), "heimdallv2.bor.ProducerVotes")
 	proto.RegisterType((*BlockRange)(nil), "heimdallv2.bor.BlockRange")
+	proto.RegisterType((*ValidatorPerformance)(nil), "heimdallv2.bor.ValidatorPerformance")
+	proto.RegisterType((*SpanSummary)(nil), "heimdallv2.bor.SpanSummary")
+	proto.RegisterType((*SpanSeed)(nil), "heimdallv2.bor.SpanSeed")
 }
 
 func init() { proto.RegisterFile("heimdallv2/bor/bor.proto", fileDescriptor_ed6109dea23871eb) }
 
 var fileDescriptor_ed6109dea23871eb = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x3b, 0xe9, 0x1f, 0xec, 0xf4, 0x8f, 0xec, 0xa0, 0x10, 0x76, 0x21, 0x1b, 0x72, 0xd0,
-	0xb2, 0xd4, 0x44, 0xea, 0xcd, 0x63, 0x57, 0x04, 0x2f, 0x52, 0x76, 0x71, 0x11, 0x2f, 0x61, 0x92,
-	0x19, 0xd2, 0x61, 0xd3, 0x99, 0x30, 0x33, 0x0d, 0xbb, 0xdf, 0xc2, 0xa3, 0x47, 0x8f, 0x1e, 0xbd,
-	0xfa, 0x0d, 0xf6, 0xd8, 0xa3, 0x27, 0x91, 0xf6, 0xa0, 0x1f, 0x43, 0x92, 0x74, 0xda, 0x54, 0x44,
-	0xf6, 0x90, 0xf0, 0xf2, 0xfe, 0x9e, 0x3c, 0xc9, 0xfb, 0x3e, 0x19, 0x68, 0xcf, 0x29, 0x5b, 0x10,
-	0x9c, 0xa6, 0xf9, 0x24, 0x88, 0x84, 0x2c, 0x2e, 0x3f, 0x93, 0x42, 0x0b, 0x34, 0xdc, 0x13, 0x3f,
-	0x12, 0xf2, 0xf8, 0x08, 0x2f, 0x18, 0x17, 0x41, 0x79, 0xaf, 0x24, 0xc7, 0x8f, 0x12, 0x91, 0x88,
-	0xb2, 0x0c, 0x8a, 0x6a, 0xdb, 0x75, 0x6b, 0x96, 0x4a, 0xe3, 0x6b, 0x1a, 0xe4, 0x38, 0x65, 0x04,
-	0x6b, 0x63, 0xed, 0x7d, 0xb3, 0x60, 0xeb, 0x32, 0xc3, 0x1c, 0x3d, 0x86, 0x16, 0x23, 0x36, 0x70,
-	0xc1, 0xa8, 0x35, 0x6d, 0x7f, 0xf9, 0xf5, 0xf5, 0x0c, 0x5c, 0x58, 0x8c, 0xa0, 0x27, 0xb0, 0xa7,
-	0x34, 0x96, 0x3a, 0x8c, 0x52, 0x11, 0x5f, 0xdb, 0x56, 0x9d, 0xc3, 0x92, 0x4c, 0x0b, 0x80, 0x3c,
-	0xd8, 0xa5, 0x9c, 0x6c, 0x55, 0xcd, 0xba, 0xea, 0x01, 0xe5, 0xa4, 0xd2, 0xbc, 0x85, 0x83, 0xdd,
-	0xeb, 0x43, 0x45, 0xb5, 0xdd, 0x72, 0xc1, 0xa8, 0x37, 0x71, 0xfc, 0xda, 0x78, 0xe5, 0x57, 0xfa,
-	0x57, 0x46, 0x76, 0x49, 0xf5, 0xb4, 0x7b, 0xf7, 0xe3, 0xb4, 0x51, 0x79, 0xf5, 0xf3, 0x1a, 0x40,
-	0xef, 0x20, 0x52, 0x34, 0xa5, 0xb1, 0xa6, 0x24, 0xcc, 0xa4, 0x20, 0xcb, 0x98, 0x4a, 0x65, 0xb7,
-	0xdd, 0xe6, 0xa8, 0x37, 0x39, 0xf9, 0x8f, 0x69, 0xdd, 0xf1, 0xc8, 0x38, 0xcc, 0x8c, 0x01, 0x7a,
-	0x0a, 0xfb, 0x91, 0x90, 0x61, 0x3c, 0xc7, 0x8c, 0x87, 0x8c, 0xd8, 0x1d, 0x17, 0x8c, 0xba, 0xbb,
-	0x99, 0x23, 0x21, 0xcf, 0x0b, 0xf2, 0x86, 0x78, 0x9f, 0x00, 0xec, 0xcc, 0xb0, 0xc4, 0x0b, 0x85,
-	0x7c, 0xf8, 0x50, 0x65, 0x92, 0x71, 0x1d, 0x92, 0xa5, 0xc4, 0x9a, 0x09, 0x7e, 0xb8, 0xca, 0x61,
-	0x45, 0x5f, 0x6d, 0x21, 0x3a, 0x83, 0x03, 0x95, 0x61, 0xbe, 0x57, 0x1f, 0x2c, 0xb6, 0x5f, 0xb0,
-	0x9d, 0x76, 0x0c, 0x87, 0x66, 0xba, 0x30, 0x16, 0x4b, 0xae, 0x0f, 0xf7, 0x3b, 0x30, 0xf0, 0xbc,
-	0x60, 0x2f, 0x5b, 0xbf, 0x3f, 0x9f, 0x02, 0x6f, 0x0c, 0x07, 0x66, 0xa0, 0x2b, 0xa1, 0xa9, 0x42,
-	0x27, 0xb0, 0x9d, 0x17, 0x85, 0x0d, 0xdc, 0xe6, 0xfe, 0xd9, 0xaa, 0xe7, 0xbd, 0x87, 0xb0, 0x4c,
-	0xe8, 0x02, 0xf3, 0x84, 0xfe, 0x1d, 0x39, 0xb8, 0x57, 0xe4, 0xd6, 0x3f, 0x23, 0x9f, 0xbe, 0xbe,
-	0x5b, 0x3b, 0x60, 0xb5, 0x76, 0xc0, 0xcf, 0xb5, 0x03, 0x3e, 0x6e, 0x9c, 0xc6, 0x6a, 0xe3, 0x34,
-	0xbe, 0x6f, 0x9c, 0xc6, 0x87, 0x71, 0xc2, 0xf4, 0x7c, 0x19, 0xf9, 0xb1, 0x58, 0x04, 0xcf, 0x6f,
-	0x66, 0x22, 0xbd, 0x4d, 0x04, 0x0f, 0x4c, 0x68, 0xcf, 0xf2, 0x49, 0x70, 0x53, 0x9e, 0x02, 0x7d,
-	0x9b, 0x51, 0x15, 0x75, 0xca, 0xbf, 0xf5, 0xc5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x01,
-	0xc9, 0x25, 0x24, 0x03, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x63, 0xd7, 0x49, 0xdb, 0xcb, 0x4b, 0xe9, 0xa9, 0x48, 0xa6, 0x95, 0xdc, 0xc8, 0x03,
+	0x44, 0x55, 0x89, 0xab, 0xb0, 0xb1, 0x91, 0x02, 0x52, 0x16, 0x14, 0x35, 0xa2, 0x42, 0x2c, 0xd6,
+	0xd9, 0x77, 0xa4, 0x56, 0xe3, 0xbb, 0xe8, 0xee, 0x12, 0xb5, 0xdf, 0xa2, 0x1f, 0x81, 0x91, 0x91,
+	0x95, 0x2f, 0x80, 0x3a, 0x76, 0x64, 0x01, 0xa1, 0x66, 0x80, 0x8f, 0x81, 0xee, 0xfc, 0x12, 0xbb,
+	0x2d, 0x2f, 0x13, 0x43, 0x22, 0xeb, 0xf9, 0xfd, 0xfd, 0x3f, 0x3f, 0x6f, 0x07, 0xec, 0x13, 0x12,
+	0xc5, 0x18, 0x4d, 0x26, 0xf3, 0x9e, 0x17, 0x30, 0xae, 0x7e, 0xdd, 0x29, 0x67, 0x92, 0xc1, 0xd6,
+	0x92, 0x74, 0x03, 0xc6, 0xb7, 0x37, 0x51, 0x1c, 0x51, 0xe6, 0xe9, 0xff, 0x44, 0xb2, 0xbd, 0x35,
+	0x66, 0x63, 0xa6, 0x1f, 0x3d, 0xf5, 0x94, 0x46, 0xdb, 0x05, 0x4b, 0x21, 0xd1, 0x29, 0xf1, 0xe6,
+	0x68, 0x12, 0x61, 0x24, 0x33, 0x6b, 0xf7, 0x93, 0x09, 0xac, 0xd1, 0x14, 0x51, 0x78, 0x1f, 0x98,
+	0x11, 0xb6, 0x8d, 0xb6, 0xd1, 0xb1, 0xfa, 0xd5, 0x0f, 0x3f, 0x3e, 0xee, 0x19, 0x47, 0x66, 0x84,
+	0xe1, 0x43, 0x50, 0x17, 0x12, 0x71, 0xe9, 0x07, 0x13, 0x16, 0x9e, 0xda, 0x66, 0x91, 0x03, 0x4d,
+	0xfa, 0x0a, 0x40, 0x17, 0xac, 0x13, 0x8a, 0x53, 0xd5, 0x4a, 0x51, 0xb5, 0x46, 0x28, 0x4e, 0x34,
+	0xaf, 0x40, 0x33, 0x3f, 0xde, 0x17, 0x44, 0xda, 0x56, 0xdb, 0xe8, 0xd4, 0x7b, 0x4e, 0xb7, 0x90,
+	0x9e, 0xfe, 0xca, 0xee, 0x71, 0x26, 0x1b, 0x11, 0xd9, 0x5f, 0xbf, 0xfc, 0xb6, 0x5b, 0x49, 0xbc,
+	0x1a, 0xf3, 0x02, 0x80, 0xaf, 0x01, 0x14, 0x64, 0x42, 0x42, 0x49, 0xb0, 0x3f, 0xe5, 0x0c, 0xcf,
+	0x42, 0xc2, 0x85, 0x5d, 0x6d, 0xaf, 0x74, 0xea, 0xbd, 0x9d, 0x3f, 0x98, 0x16, 0x1d, 0x37, 0x33,
+	0x87, 0x61, 0x66, 0x00, 0x1f, 0x81, 0x46, 0xc0, 0xb8, 0x1f, 0x9e, 0xa0, 0x88, 0xfa, 0x11, 0xb6,
+	0x6b, 0x6d, 0xa3, 0xb3, 0x9e, 0xe7, 0x1c, 0x30, 0x7e, 0xa8, 0xc8, 0x00, 0xbb, 0x9f, 0x0d, 0x50,
+	0x1b, 0x22, 0x8e, 0x62, 0x01, 0xbb, 0x60, 0x43, 0x4c, 0x79, 0x44, 0xa5, 0x8f, 0x67, 0x1c, 0xc9,
+	0x88, 0xd1, 0x72, 0x29, 0x5b, 0x09, 0x7d, 0x9e, 0x42, 0xb8, 0x07, 0x9a, 0x62, 0x8a, 0xe8, 0x52,
+	0x5d, 0x2a, 0x6c, 0x43, 0xb1, 0x5c, 0xbb, 0x0f, 0x5a, 0x59, 0x76, 0x7e, 0xc8, 0x66, 0x54, 0x96,
+	0xeb, 0xdb, 0xcc, 0xe0, 0xa1, 0x62, 0x4a, 0xad, 0x9d, 0x39, 0x91, 0x84, 0x6a, 0x6b, 0xab, 0xa4,
+	0x56, 0xf0, 0x28, 0x63, 0x4f, 0xad, 0x9f, 0xef, 0x77, 0x0d, 0x77, 0x1f, 0x34, 0xb3, 0xf4, 0x8f,
+	0x99, 0x24, 0x02, 0xee, 0x80, 0xea, 0x5c, 0x3d, 0xd8, 0x46, 0x7b, 0x65, 0xf9, 0x6e, 0x12, 0x73,
+	0xdf, 0x00, 0xa0, 0xfb, 0x79, 0x84, 0xe8, 0x98, 0xdc, 0x1c, 0x10, 0xe3, 0x9f, 0x06, 0xc4, 0xbc,
+	0x73, 0x40, 0xdc, 0xaf, 0x06, 0xd8, 0xca, 0xbb, 0x34, 0x24, 0xfc, 0x1d, 0xe3, 0x31, 0xa2, 0x21,
+	0x81, 0x0e, 0x58, 0xd5, 0x49, 0xdd, 0x9c, 0xd0, 0x9a, 0x8a, 0x0e, 0x30, 0xec, 0x80, 0xe5, 0x64,
+	0x28, 0x51, 0xc9, 0xbf, 0x9e, 0xa3, 0x01, 0x56, 0x99, 0x89, 0x90, 0x71, 0x52, 0xae, 0x61, 0x12,
+	0x53, 0x5d, 0xd4, 0xdf, 0x27, 0x7c, 0x24, 0x44, 0x34, 0xa6, 0x04, 0x97, 0x8b, 0xd7, 0x4a, 0xe8,
+	0xb3, 0x14, 0x16, 0xf4, 0x69, 0x0f, 0xb0, 0x5d, 0xbd, 0x43, 0x9f, 0xd6, 0x16, 0xbb, 0x17, 0x26,
+	0xa8, 0xab, 0x65, 0x1b, 0xcd, 0xe2, 0x18, 0xf1, 0xf3, 0xff, 0xb1, 0x73, 0x1d, 0xd0, 0xc8, 0x87,
+	0x27, 0xc2, 0xc2, 0xb6, 0x8a, 0x0d, 0xad, 0x67, 0x68, 0x80, 0x05, 0x3c, 0x00, 0xf7, 0x72, 0xa5,
+	0xce, 0x2f, 0xdd, 0xa5, 0x7c, 0xf4, 0x37, 0x32, 0x3c, 0x4a, 0x28, 0x7c, 0x00, 0x2c, 0x41, 0x48,
+	0xb2, 0x20, 0x8d, 0x4c, 0xa5, 0x43, 0xb7, 0x76, 0x68, 0xf5, 0x77, 0x3b, 0xf4, 0x02, 0xac, 0xe9,
+	0x8a, 0xa8, 0x97, 0xfe, 0xd6, 0xe5, 0xec, 0x3c, 0xf3, 0xd6, 0x79, 0xfd, 0x97, 0x97, 0xd7, 0x8e,
+	0x71, 0x75, 0xed, 0x18, 0xdf, 0xaf, 0x1d, 0xe3, 0x62, 0xe1, 0x54, 0xae, 0x16, 0x4e, 0xe5, 0xcb,
+	0xc2, 0xa9, 0xbc, 0xdd, 0x1f, 0x47, 0xf2, 0x64, 0x16, 0x74, 0x43, 0x16, 0x7b, 0x07, 0x67, 0x43,
+	0x36, 0x39, 0x1f, 0x33, 0xea, 0x65, 0x97, 0xc3, 0xe3, 0x79, 0xcf, 0x3b, 0xd3, 0xb7, 0xad, 0x3c,
+	0x9f, 0x12, 0x11, 0xd4, 0xf4, 0xad, 0xf8, 0xe4, 0xd7, 0x00, 0x4b, 0x4c, 0xc8, 0x14, 0x8c, 0x05,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -363,6 +632,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ProducerCount != that1.ProducerCount {
 		return false
 	}
+	if this.SpanRetention != that1.SpanRetention {
+		return false
+	}
 	return true
 }
 func (m *Span) Marshal() (dAtA []byte, err error) {
@@ -454,6 +726,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpanRetention != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanRetention))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ProducerCount != 0 {
 		i = encodeVarintBor(dAtA, i, uint64(m.ProducerCount))
 		i--
@@ -546,94 +823,338 @@ func (m *BlockRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBor(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBor(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Span) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBor(uint64(m.Id))
+	if m.BlocksProduced != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.BlocksProduced))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.StartBlock != 0 {
-		n += 1 + sovBor(uint64(m.StartBlock))
+	if m.BlocksAssigned != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.BlocksAssigned))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.EndBlock != 0 {
-		n += 1 + sovBor(uint64(m.EndBlock))
+	if m.Score != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.ValidatorSet.Size()
-	n += 1 + l + sovBor(uint64(l))
-	if len(m.SelectedProducers) > 0 {
-		for _, e := range m.SelectedProducers {
-			l = e.Size()
-			n += 1 + l + sovBor(uint64(l))
-		}
+	if m.ValidatorId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BorChainId)
-	if l > 0 {
-		n += 1 + l + sovBor(uint64(l))
+	if m.SpanId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SprintDuration != 0 {
-		n += 1 + sovBor(uint64(m.SprintDuration))
-	}
-	if m.SpanDuration != 0 {
-		n += 1 + sovBor(uint64(m.SpanDuration))
-	}
-	if m.ProducerCount != 0 {
-		n += 1 + sovBor(uint64(m.ProducerCount))
+func (m *SpanSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProducerVotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		l = 0
-		for _, e := range m.Votes {
-			l += sovBor(uint64(e))
-		}
-		n += 1 + sovBor(uint64(l)) + l
-	}
-	return n
+func (m *SpanSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SpanSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartBlock != 0 {
-		n += 1 + sovBor(uint64(m.StartBlock))
+	if len(m.BorChainId) > 0 {
+		i -= len(m.BorChainId)
+		copy(dAtA[i:], m.BorChainId)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.BorChainId)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.EndBlock != 0 {
-		n += 1 + sovBor(uint64(m.EndBlock))
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if len(m.ProducerSigners) > 0 {
+		for iNdEx := len(m.ProducerSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProducerSigners[iNdEx])
+			copy(dAtA[i:], m.ProducerSigners[iNdEx])
+			i = encodeVarintBor(dAtA, i, uint64(len(m.ProducerSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProducerIds) > 0 {
+		dAtA5 := make([]byte, len(m.ProducerIds)*10)
+		var j4 int
+		for _, num := range m.ProducerIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintBor(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpanSeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpanSeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpanSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Span) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBor(uint64(m.Id))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBor(uint64(m.EndBlock))
+	}
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovBor(uint64(l))
+	if len(m.SelectedProducers) > 0 {
+		for _, e := range m.SelectedProducers {
+			l = e.Size()
+			n += 1 + l + sovBor(uint64(l))
+		}
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SprintDuration != 0 {
+		n += 1 + sovBor(uint64(m.SprintDuration))
+	}
+	if m.SpanDuration != 0 {
+		n += 1 + sovBor(uint64(m.SpanDuration))
+	}
+	if m.ProducerCount != 0 {
+		n += 1 + sovBor(uint64(m.ProducerCount))
+	}
+	if m.SpanRetention != 0 {
+		n += 1 + sovBor(uint64(m.SpanRetention))
+	}
+	return n
+}
+
+func (m *ProducerVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		l = 0
+		for _, e := range m.Votes {
+			l += sovBor(uint64(e))
+		}
+		n += 1 + sovBor(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BlockRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBor(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovBor(uint64(m.SpanId))
+	}
+	if m.ValidatorId != 0 {
+		n += 1 + sovBor(uint64(m.ValidatorId))
+	}
+	if m.Score != 0 {
+		n += 1 + sovBor(uint64(m.Score))
+	}
+	if m.BlocksAssigned != 0 {
+		n += 1 + sovBor(uint64(m.BlocksAssigned))
+	}
+	if m.BlocksProduced != 0 {
+		n += 1 + sovBor(uint64(m.BlocksProduced))
+	}
+	return n
+}
+
+func (m *SpanSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBor(uint64(m.Id))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBor(uint64(m.EndBlock))
+	}
+	if len(m.ProducerIds) > 0 {
+		l = 0
+		for _, e := range m.ProducerIds {
+			l += sovBor(uint64(e))
+		}
+		n += 1 + sovBor(uint64(l)) + l
+	}
+	if len(m.ProducerSigners) > 0 {
+		for _, s := range m.ProducerSigners {
+			l = len(s)
+			n += 1 + l + sovBor(uint64(l))
+		}
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	return n
+}
+
+func (m *SpanSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovBor(uint64(m.SpanId))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	return n
 }
 
 func sovBor(x uint64) (n int) {
@@ -934,6 +1455,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanRetention", wireType)
+			}
+			m.SpanRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBor(dAtA[iNdEx:])
@@ -1169,6 +1709,535 @@ func (m *BlockRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksAssigned", wireType)
+			}
+			m.BlocksAssigned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksAssigned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksProduced", wireType)
+			}
+			m.BlocksProduced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksProduced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpanSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpanSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpanSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProducerIds = append(m.ProducerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBor
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBor
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProducerIds) == 0 {
+					m.ProducerIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProducerIds = append(m.ProducerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerSigners = append(m.ProducerSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpanSeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpanSeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpanSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
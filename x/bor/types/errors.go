@@ -10,4 +10,8 @@ var (
 	ErrFailedToQueryBor        = errors.New("failed to query bor")
 	ErrBorBlockNotFound        = errors.New("bor block not found locally")
 	ErrLatestMilestoneNotFound = errors.New("latest milestone not found")
+	ErrOverlappingDowntime     = errors.New("planned downtime overlaps another window of the producer")
+	ErrTooManyDowntimeWindows  = errors.New("too many planned downtime windows")
+	ErrDowntimeNotFound        = errors.New("planned downtime not found")
+	ErrSpanPruned              = errors.New("span pruned")
 )
@@ -31,6 +31,10 @@ type GenesisState struct {
 	// Initial spans loaded at genesis.
 	// This allows the chain to start with pre-configured spans.
 	Spans []Span `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans"`
+	// Summaries of the spans whose full body was pruned.
+	SpanSummaries []SpanSummary `protobuf:"bytes,3,rep,name=span_summaries,json=spanSummaries,proto3" json:"span_summaries"`
+	// Seeds of the spans not pruned yet.
+	SpanSeeds []SpanSeed `protobuf:"bytes,4,rep,name=span_seeds,json=spanSeeds,proto3" json:"span_seeds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +84,20 @@ func (m *GenesisState) GetSpans() []Span {
 	return nil
 }
 
+func (m *GenesisState) GetSpanSummaries() []SpanSummary {
+	if m != nil {
+		return m.SpanSummaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpanSeeds() []SpanSeed {
+	if m != nil {
+		return m.SpanSeeds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "heimdallv2.bor.GenesisState")
 }
@@ -87,23 +105,27 @@ func init() {
 func init() { proto.RegisterFile("heimdallv2/bor/genesis.proto", fileDescriptor_f5f94422538537a0) }
 
 var fileDescriptor_f5f94422538537a0 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0x29, 0x33, 0xd2, 0x4f, 0xca, 0x2f, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x43, 0xc8, 0xea, 0x25, 0xe5, 0x17,
-	0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xa5, 0xf4, 0x41, 0x2c, 0x88, 0x2a, 0x29, 0x09, 0x34,
-	0x33, 0x92, 0xf2, 0x8b, 0xa0, 0x32, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x22,
-	0xa4, 0xd4, 0xc0, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x24, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x92,
-	0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c,
-	0x0f, 0xd5, 0x52, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f,
-	0xd0, 0x62, 0x0c, 0x82, 0x6a, 0x10, 0x32, 0xe5, 0x62, 0x2d, 0x2e, 0x48, 0xcc, 0x2b, 0x96, 0x60,
-	0x52, 0x60, 0xd6, 0xe0, 0x36, 0x12, 0x41, 0xd7, 0x19, 0x5c, 0x90, 0x98, 0x87, 0xac, 0x0f, 0xa2,
-	0xda, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33,
-	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x0d, 0x2a, 0x02, 0xf2, 0x73, 0x2a, 0xd3,
-	0xf3, 0xf3, 0xf4, 0x61, 0xa6, 0xea, 0x96, 0x19, 0xe9, 0x57, 0x80, 0x7d, 0x58, 0x52, 0x59, 0x90,
-	0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x91, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xab, 0x8b, 0xa2, 0x74,
-	0x44, 0x01, 0x00, 0x00,
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4a, 0xfb, 0x40,
+	0x10, 0xc7, 0xb3, 0xed, 0xef, 0x57, 0xe8, 0x56, 0x0b, 0x86, 0x22, 0x4b, 0x95, 0xb5, 0x78, 0x2a,
+	0xa2, 0x59, 0x89, 0x78, 0xf0, 0xda, 0x83, 0x9e, 0x84, 0x62, 0x6f, 0x5e, 0x64, 0x63, 0x97, 0x74,
+	0xa1, 0x9b, 0x0d, 0x3b, 0x69, 0x69, 0xde, 0x42, 0xf0, 0x25, 0x3c, 0xfa, 0x18, 0x3d, 0xf6, 0xe8,
+	0x49, 0x24, 0x39, 0xf8, 0x1a, 0xd2, 0x4d, 0xd4, 0x58, 0x7a, 0x19, 0x86, 0xef, 0x9f, 0xcf, 0xc0,
+	0xe0, 0xc3, 0x89, 0x90, 0x6a, 0xcc, 0xa7, 0xd3, 0xb9, 0xcf, 0x02, 0x6d, 0x58, 0x28, 0x22, 0x01,
+	0x12, 0xbc, 0xd8, 0xe8, 0x44, 0xbb, 0xed, 0x5f, 0xd7, 0x0b, 0xb4, 0xe9, 0x76, 0x42, 0x1d, 0x6a,
+	0x6b, 0xb1, 0xf5, 0x56, 0xa4, 0xba, 0x64, 0x83, 0x11, 0x68, 0x53, 0x3a, 0x7b, 0x5c, 0xc9, 0x48,
+	0x33, 0x3b, 0x0b, 0xe9, 0xf8, 0xb9, 0x86, 0x77, 0x6e, 0x8a, 0x23, 0xa3, 0x84, 0x27, 0xc2, 0xbd,
+	0xc2, 0x8d, 0x98, 0x1b, 0xae, 0x80, 0xa0, 0x1e, 0xea, 0xb7, 0xfc, 0x7d, 0xef, 0xef, 0x51, 0x6f,
+	0x68, 0xdd, 0x41, 0x73, 0xf9, 0x7e, 0xe4, 0xbc, 0x7c, 0xbe, 0x9e, 0xa0, 0xbb, 0xb2, 0xe0, 0x5e,
+	0xe2, 0xff, 0x10, 0xf3, 0x08, 0x48, 0xad, 0x57, 0xef, 0xb7, 0xfc, 0xce, 0x66, 0x73, 0x14, 0xf3,
+	0xa8, 0xda, 0x2b, 0xd2, 0xee, 0x2d, 0x6e, 0xaf, 0x97, 0x07, 0x98, 0x29, 0xc5, 0x8d, 0x14, 0x40,
+	0xea, 0xb6, 0x7f, 0xb0, 0xad, 0x3f, 0xb2, 0xa1, 0xb4, 0x8a, 0xd9, 0x85, 0x1f, 0x5d, 0x0a, 0x70,
+	0x07, 0x18, 0x17, 0x38, 0x21, 0xc6, 0x40, 0xfe, 0x59, 0x14, 0xd9, 0x8a, 0x12, 0x62, 0x5c, 0xe5,
+	0x34, 0xa1, 0x14, 0x61, 0x70, 0xbd, 0xcc, 0x28, 0x5a, 0x65, 0x14, 0x7d, 0x64, 0x14, 0x3d, 0xe5,
+	0xd4, 0x59, 0xe5, 0xd4, 0x79, 0xcb, 0xa9, 0x73, 0x7f, 0x1a, 0xca, 0x64, 0x32, 0x0b, 0xbc, 0x47,
+	0xad, 0xd8, 0xf9, 0x62, 0xa8, 0xa7, 0x69, 0xa8, 0x23, 0xf6, 0x4d, 0x3f, 0x9b, 0xfb, 0x6c, 0x61,
+	0x9f, 0x9e, 0xa4, 0xb1, 0x80, 0xa0, 0x61, 0x9f, 0x7c, 0xf1, 0x35, 0x00, 0xbd, 0xce, 0x4e, 0x4e,
+	0xd7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +148,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpanSeeds) > 0 {
+		for iNdEx := len(m.SpanSeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpanSeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpanSummaries) > 0 {
+		for iNdEx := len(m.SpanSummaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpanSummaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Spans) > 0 {
 		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +228,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SpanSummaries) > 0 {
+		for _, e := range m.SpanSummaries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpanSeeds) > 0 {
+		for _, e := range m.SpanSeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +345,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanSummaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanSummaries = append(m.SpanSummaries, SpanSummary{})
+			if err := m.SpanSummaries[len(m.SpanSummaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanSeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanSeeds = append(m.SpanSeeds, SpanSeed{})
+			if err := m.SpanSeeds[len(m.SpanSeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
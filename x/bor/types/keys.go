@@ -25,4 +25,8 @@ var (
 	LatestFailedProducerKey    = collections.NewPrefix(0x3C) // Key to store the latest failed producer in the store
 	LastSpanBlockKey           = collections.NewPrefix(0x3D) // Key to store the last span block in the store
 	ProducerPlannedDowntimeKey = collections.NewPrefix(0x3E) // Key to store the producer-planned downtime in the store
+	ProducerDowntimeWindowsKey = collections.NewPrefix(0x3F) // Prefix key to store the producer-planned downtime windows, by producer and start block
+	ValidatorPerformancesKey   = collections.NewPrefix(0x40) // Prefix key to store the validator performance in each span, by span and validator
+	SpanSummaryKey             = collections.NewPrefix(0x41) // Prefix key to store the summaries of the pruned spans
+	SpanSeedKey                = collections.NewPrefix(0x42) // Prefix key to store the seeds of the spans not pruned yet
 )
@@ -74,6 +74,26 @@ func TestProducerPlannedDowntimeKey(t *testing.T) {
 	require.IsType(t, collections.Prefix{}, types.ProducerPlannedDowntimeKey)
 }
 
+func TestProducerDowntimeWindowsKey(t *testing.T) {
+	require.NotNil(t, types.ProducerDowntimeWindowsKey)
+	require.IsType(t, collections.Prefix{}, types.ProducerDowntimeWindowsKey)
+}
+
+func TestValidatorPerformancesKey(t *testing.T) {
+	require.NotNil(t, types.ValidatorPerformancesKey)
+	require.IsType(t, collections.Prefix{}, types.ValidatorPerformancesKey)
+}
+
+func TestSpanSummaryKey(t *testing.T) {
+	require.NotNil(t, types.SpanSummaryKey)
+	require.IsType(t, collections.Prefix{}, types.SpanSummaryKey)
+}
+
+func TestSpanSeedKey(t *testing.T) {
+	require.NotNil(t, types.SpanSeedKey)
+	require.IsType(t, collections.Prefix{}, types.SpanSeedKey)
+}
+
 func TestKeyUniqueness(t *testing.T) {
 	// Test that all keys are unique
 	keys := []collections.Prefix{
@@ -87,6 +107,10 @@ func TestKeyUniqueness(t *testing.T) {
 		types.LatestFailedProducerKey,
 		types.LastSpanBlockKey,
 		types.ProducerPlannedDowntimeKey,
+		types.ProducerDowntimeWindowsKey,
+		types.ValidatorPerformancesKey,
+		types.SpanSummaryKey,
+		types.SpanSeedKey,
 	}
 
 	// Check all keys are distinct
@@ -130,6 +154,10 @@ func TestAllKeysInitialized(t *testing.T) {
 		"LatestFailedProducerKey":    types.LatestFailedProducerKey,
 		"LastSpanBlockKey":           types.LastSpanBlockKey,
 		"ProducerPlannedDowntimeKey": types.ProducerPlannedDowntimeKey,
+		"ProducerDowntimeWindowsKey": types.ProducerDowntimeWindowsKey,
+		"ValidatorPerformancesKey":   types.ValidatorPerformancesKey,
+		"SpanSummaryKey":             types.SpanSummaryKey,
+		"SpanSeedKey":                types.SpanSeedKey,
 	}
 
 	for name, key := range keys {
@@ -138,7 +166,7 @@ func TestAllKeysInitialized(t *testing.T) {
 }
 
 func TestKeyValueRange(t *testing.T) {
-	// Keys should use a specific range (0x35-0x3E according to comments)
+	// Keys should use a specific range (0x35-0x40 according to comments)
 	// We can't directly inspect the byte values, but we can verify they're different
 	keys := []collections.Prefix{
 		types.LastSpanIDKey,              // 0x35
@@ -151,8 +179,12 @@ func TestKeyValueRange(t *testing.T) {
 		types.LatestFailedProducerKey,    // 0x3C
 		types.LastSpanBlockKey,           // 0x3D
 		types.ProducerPlannedDowntimeKey, // 0x3E
+		types.ProducerDowntimeWindowsKey, // 0x3F
+		types.ValidatorPerformancesKey,   // 0x40
+		types.SpanSummaryKey,             // 0x41
+		types.SpanSeedKey,                // 0x42
 	}
 
-	// Verify we have 10 distinct keys
-	require.Len(t, keys, 10)
+	// Verify we have 14 distinct keys
+	require.Len(t, keys, 14)
 }
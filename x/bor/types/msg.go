@@ -59,6 +59,14 @@ func NewMsgSetProducerDowntime(
 	}
 }
 
+// NewMsgCancelProducerDowntime creates a new MsgCancelProducerDowntime instance
+func NewMsgCancelProducerDowntime(producer string, startBorBlock uint64) *MsgCancelProducerDowntime {
+	return &MsgCancelProducerDowntime{
+		Producer:   util.FormatAddress(producer),
+		StartBlock: startBorBlock,
+	}
+}
+
 // Route returns the message route for x/bor MsgProposeSpan.
 func (msg MsgProposeSpan) Route() string {
 	return RouterKey
@@ -8,6 +8,14 @@ const (
 	DefaultSpanDuration      uint64 = 400 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+
+	// MinSpanRetention is the minimum number of spans kept with their full body when the pruning is enabled,
+	// twice the 50 spans looked back at by the span seed selection.
+	MinSpanRetention uint64 = 100
+
+	// MaxSpansPrunedPerBlock bounds the spans pruned per block, so that enabling the pruning on a long chain
+	// spreads it over several blocks.
+	MaxSpansPrunedPerBlock = 100
 )
 
 // DefaultParams returns default parameters for bor module
@@ -33,6 +41,10 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if p.SpanRetention != 0 && p.SpanRetention < MinSpanRetention {
+		return fmt.Errorf("invalid value provided %d for bor param span retention, should be zero or at least %d", p.SpanRetention, MinSpanRetention)
+	}
+
 	return nil
 }
 
@@ -125,6 +125,21 @@ func TestParams_ValidateBasic(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid value")
 	})
 
+	t.Run("validates span retention", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.DefaultParams()
+		require.Zero(t, params.SpanRetention)
+
+		params.SpanRetention = types.MinSpanRetention
+		require.NoError(t, params.ValidateBasic())
+
+		params.SpanRetention = types.MinSpanRetention - 1
+		err := params.ValidateBasic()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "span retention")
+	})
+
 	t.Run("accepts very large values", func(t *testing.T) {
 		t.Parallel()
 
@@ -878,6 +878,100 @@ func (m *QueryProducerPlannedDowntimeResponse) GetDowntimeRange() BlockRange {
 	return BlockRange{}
 }
 
+// QueryProducerPlannedDowntimesRequest is the request type for the
+// GetProducerPlannedDowntimes query.
+type QueryProducerPlannedDowntimesRequest struct {
+	// ID of the producer whose planned downtime windows to retrieve.
+	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+}
+
+func (m *QueryProducerPlannedDowntimesRequest) Reset()         { *m = QueryProducerPlannedDowntimesRequest{} }
+func (m *QueryProducerPlannedDowntimesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerPlannedDowntimesRequest) ProtoMessage()    {}
+func (*QueryProducerPlannedDowntimesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{18}
+}
+func (m *QueryProducerPlannedDowntimesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProducerPlannedDowntimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerPlannedDowntimesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProducerPlannedDowntimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerPlannedDowntimesRequest.Merge(m, src)
+}
+func (m *QueryProducerPlannedDowntimesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProducerPlannedDowntimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerPlannedDowntimesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProducerPlannedDowntimesRequest proto.InternalMessageInfo
+
+func (m *QueryProducerPlannedDowntimesRequest) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
+}
+
+// QueryProducerPlannedDowntimesResponse is the response type for the
+// GetProducerPlannedDowntimes query.
+type QueryProducerPlannedDowntimesResponse struct {
+	// Block ranges during which the producer has planned downtime.
+	DowntimeRanges []BlockRange `protobuf:"bytes,1,rep,name=downtime_ranges,json=downtimeRanges,proto3" json:"downtime_ranges"`
+}
+
+func (m *QueryProducerPlannedDowntimesResponse) Reset()         { *m = QueryProducerPlannedDowntimesResponse{} }
+func (m *QueryProducerPlannedDowntimesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerPlannedDowntimesResponse) ProtoMessage()    {}
+func (*QueryProducerPlannedDowntimesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{19}
+}
+func (m *QueryProducerPlannedDowntimesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProducerPlannedDowntimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerPlannedDowntimesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProducerPlannedDowntimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerPlannedDowntimesResponse.Merge(m, src)
+}
+func (m *QueryProducerPlannedDowntimesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProducerPlannedDowntimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerPlannedDowntimesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProducerPlannedDowntimesResponse proto.InternalMessageInfo
+
+func (m *QueryProducerPlannedDowntimesResponse) GetDowntimeRanges() []BlockRange {
+	if m != nil {
+		return m.DowntimeRanges
+	}
+	return nil
+}
+
 // QueryValidatorPerformanceScoreRequest is the request type for the
 // GetValidatorPerformanceScore query.
 type QueryValidatorPerformanceScoreRequest struct {
@@ -887,7 +981,7 @@ func (m *QueryValidatorPerformanceScoreRequest) Reset()         { *m = QueryVali
 func (m *QueryValidatorPerformanceScoreRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorPerformanceScoreRequest) ProtoMessage()    {}
 func (*QueryValidatorPerformanceScoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b3050b896ec07f, []int{18}
+	return fileDescriptor_75b3050b896ec07f, []int{20}
 }
 func (m *QueryValidatorPerformanceScoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1024,7 @@ func (m *QueryValidatorPerformanceScoreResponse) Reset() {
 func (m *QueryValidatorPerformanceScoreResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorPerformanceScoreResponse) ProtoMessage()    {}
 func (*QueryValidatorPerformanceScoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b3050b896ec07f, []int{19}
+	return fileDescriptor_75b3050b896ec07f, []int{21}
 }
 func (m *QueryValidatorPerformanceScoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,1043 +1060,1287 @@ func (m *QueryValidatorPerformanceScoreResponse) GetValidatorPerformanceScore()
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySpanByIdRequest)(nil), "heimdallv2.bor.QuerySpanByIdRequest")
-	proto.RegisterType((*QuerySpanByIdResponse)(nil), "heimdallv2.bor.QuerySpanByIdResponse")
-	proto.RegisterType((*QuerySpanListRequest)(nil), "heimdallv2.bor.QuerySpanListRequest")
-	proto.RegisterType((*QuerySpanListResponse)(nil), "heimdallv2.bor.QuerySpanListResponse")
-	proto.RegisterType((*QueryLatestSpanRequest)(nil), "heimdallv2.bor.QueryLatestSpanRequest")
-	proto.RegisterType((*QueryLatestSpanResponse)(nil), "heimdallv2.bor.QueryLatestSpanResponse")
-	proto.RegisterType((*QueryNextSpanSeedRequest)(nil), "heimdallv2.bor.QueryNextSpanSeedRequest")
-	proto.RegisterType((*QueryNextSpanSeedResponse)(nil), "heimdallv2.bor.QueryNextSpanSeedResponse")
-	proto.RegisterType((*QueryNextSpanRequest)(nil), "heimdallv2.bor.QueryNextSpanRequest")
-	proto.RegisterType((*QueryNextSpanResponse)(nil), "heimdallv2.bor.QueryNextSpanResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "heimdallv2.bor.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "heimdallv2.bor.QueryParamsResponse")
-	proto.RegisterType((*QueryProducerVotesRequest)(nil), "heimdallv2.bor.QueryProducerVotesRequest")
-	proto.RegisterType((*QueryProducerVotesResponse)(nil), "heimdallv2.bor.QueryProducerVotesResponse")
-	proto.RegisterMapType((map[uint64]ProducerVotes)(nil), "heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry")
-	proto.RegisterType((*QueryProducerVotesByValidatorIdRequest)(nil), "heimdallv2.bor.QueryProducerVotesByValidatorIdRequest")
-	proto.RegisterType((*QueryProducerVotesByValidatorIdResponse)(nil), "heimdallv2.bor.QueryProducerVotesByValidatorIdResponse")
-	proto.RegisterType((*QueryProducerPlannedDowntimeRequest)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimeRequest")
-	proto.RegisterType((*QueryProducerPlannedDowntimeResponse)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimeResponse")
-	proto.RegisterType((*QueryValidatorPerformanceScoreRequest)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreRequest")
-	proto.RegisterType((*QueryValidatorPerformanceScoreResponse)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreResponse")
-	proto.RegisterMapType((map[uint64]uint64)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry")
+// QueryValidatorPerformanceHistoryRequest is the request type for the
+// GetValidatorPerformanceHistory query.
+type QueryValidatorPerformanceHistoryRequest struct {
+	// ID of the validator whose performance history to retrieve.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// First span ID of the range (inclusive).
+	FromSpanId uint64 `protobuf:"varint,2,opt,name=from_span_id,json=fromSpanId,proto3" json:"from_span_id,omitempty"`
+	// Last span ID of the range (inclusive).
+	ToSpanId uint64 `protobuf:"varint,3,opt,name=to_span_id,json=toSpanId,proto3" json:"to_span_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("heimdallv2/bor/query.proto", fileDescriptor_75b3050b896ec07f) }
-
-var fileDescriptor_75b3050b896ec07f = []byte{
-	// 1215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0xaf, 0xb3, 0x76, 0xd0, 0x6f, 0xda, 0x6d, 0x7d, 0x4d, 0x4b, 0xea, 0x76, 0x59, 0xf1, 0xba,
-	0xb6, 0xab, 0x48, 0x4c, 0x5b, 0x18, 0x63, 0xe2, 0xb2, 0x00, 0x0b, 0x95, 0xca, 0x94, 0xa5, 0x62,
-	0x87, 0x1d, 0x08, 0x2f, 0xf1, 0x23, 0xb5, 0xe6, 0xf8, 0xb9, 0xf6, 0x4b, 0x68, 0x54, 0x55, 0x42,
-	0x5c, 0x00, 0x71, 0x41, 0xe2, 0xc4, 0x8d, 0x23, 0xdc, 0xf8, 0x03, 0xb8, 0x20, 0x2e, 0x3b, 0x0e,
-	0x71, 0x41, 0x1c, 0x10, 0x6a, 0x91, 0xf8, 0x37, 0x90, 0x9f, 0x9f, 0xeb, 0x67, 0xc7, 0x49, 0x5a,
-	0xb8, 0xb4, 0xd6, 0xfb, 0xfe, 0xf8, 0x7c, 0xbe, 0x3f, 0xfc, 0x3e, 0x0e, 0xa8, 0xfb, 0xc4, 0x6c,
-	0x1b, 0xd8, 0xb2, 0xba, 0x5b, 0x7a, 0x83, 0xba, 0xfa, 0x41, 0x87, 0xb8, 0xbd, 0x92, 0xe3, 0x52,
-	0x46, 0xd1, 0x95, 0xc8, 0x56, 0x6a, 0x50, 0x57, 0x9d, 0xc1, 0x6d, 0xd3, 0xa6, 0x3a, 0xff, 0x1b,
-	0xb8, 0xa8, 0x1b, 0x4d, 0xea, 0xb5, 0xa9, 0xa7, 0x37, 0xb0, 0x47, 0x82, 0x58, 0xbd, 0xbb, 0xd9,
-	0x20, 0x0c, 0x6f, 0xea, 0x0e, 0x6e, 0x99, 0x36, 0x66, 0x26, 0xb5, 0x85, 0xef, 0xa2, 0xf0, 0x0d,
-	0xdd, 0x64, 0x2c, 0x35, 0xd7, 0xa2, 0x2d, 0xca, 0x1f, 0x75, 0xff, 0x49, 0x9c, 0x2e, 0xb5, 0x28,
-	0x6d, 0x59, 0x44, 0xc7, 0x8e, 0xa9, 0x63, 0xdb, 0xa6, 0x8c, 0xe7, 0xf3, 0x84, 0x35, 0x9f, 0xe0,
-	0xde, 0xa0, 0x6e, 0x60, 0xd1, 0x8a, 0x90, 0x7b, 0xe4, 0x27, 0xdf, 0x73, 0xb0, 0x5d, 0xee, 0xed,
-	0x18, 0x35, 0x72, 0xd0, 0x21, 0x1e, 0x43, 0x73, 0x90, 0x31, 0x8d, 0xbc, 0xb2, 0xac, 0xac, 0x4f,
-	0x96, 0x27, 0xbe, 0xff, 0xe7, 0xc7, 0x0d, 0xa5, 0x96, 0x31, 0x0d, 0xed, 0x3d, 0x98, 0x4b, 0xb8,
-	0x7b, 0x0e, 0xb5, 0x3d, 0x82, 0x74, 0x18, 0xf7, 0x1c, 0x6c, 0xf3, 0x88, 0xec, 0x56, 0xae, 0x14,
-	0x6f, 0x48, 0x89, 0xfb, 0x8b, 0x3c, 0xdc, 0x51, 0x33, 0x25, 0xe0, 0x5d, 0xd3, 0x63, 0x21, 0xf0,
-	0x23, 0x80, 0xa8, 0x1f, 0x22, 0xdd, 0x6a, 0x29, 0x68, 0x48, 0xc9, 0x6f, 0x5e, 0x29, 0x68, 0x86,
-	0x68, 0x5e, 0xa9, 0x8a, 0x5b, 0x44, 0xc4, 0x96, 0x27, 0x9f, 0xfd, 0x79, 0x63, 0x2c, 0x00, 0x91,
-	0x92, 0x68, 0x3f, 0x28, 0x12, 0xeb, 0x00, 0x4b, 0xb0, 0x7e, 0x0b, 0x26, 0x7d, 0x32, 0x75, 0xcb,
-	0xf4, 0x58, 0x5e, 0x59, 0xbe, 0x34, 0x90, 0xba, 0x94, 0xf9, 0x45, 0x4f, 0x64, 0x41, 0xb5, 0x18,
-	0xd5, 0x0c, 0xa7, 0xba, 0x36, 0x92, 0x6a, 0x00, 0x3d, 0x88, 0x6b, 0x1e, 0xe6, 0x39, 0xd5, 0x5d,
-	0xcc, 0x88, 0xc7, 0x7c, 0x6c, 0x51, 0x9c, 0xf6, 0x10, 0x5e, 0xea, 0xb3, 0x88, 0x32, 0xb6, 0xcf,
-	0xd1, 0x7c, 0x09, 0x2f, 0x18, 0xc0, 0x26, 0xe4, 0x79, 0xbe, 0x87, 0xe4, 0x90, 0x67, 0xdb, 0x23,
-	0x24, 0x65, 0xfa, 0xe3, 0xf2, 0xf4, 0x3f, 0x84, 0x85, 0x94, 0x10, 0x41, 0x62, 0x01, 0xc6, 0x3d,
-	0x42, 0x12, 0x3b, 0xc3, 0x8f, 0xd0, 0x2a, 0x64, 0xfd, 0xff, 0x75, 0xdc, 0x61, 0xfb, 0xd4, 0xe5,
-	0x9d, 0x3a, 0xf3, 0x00, 0xdf, 0x72, 0x9f, 0x1b, 0xb4, 0xcf, 0x15, 0xb1, 0x14, 0x21, 0x40, 0xc8,
-	0xa7, 0x00, 0x2f, 0xf0, 0x39, 0x25, 0x49, 0x5d, 0xf6, 0x4f, 0x77, 0x02, 0x00, 0x86, 0x5d, 0x56,
-	0x6f, 0x58, 0xb4, 0xf9, 0x94, 0x03, 0x8c, 0x47, 0x00, 0xbe, 0xa5, 0xec, 0x1b, 0xd0, 0x1a, 0x4c,
-	0x35, 0xa8, 0x5b, 0x6f, 0xee, 0x63, 0x93, 0x27, 0xbb, 0x14, 0x63, 0xd2, 0xa0, 0xee, 0xdb, 0xbe,
-	0x65, 0xc7, 0xd0, 0x76, 0xc5, 0xc6, 0x44, 0x44, 0xfe, 0x4f, 0xab, 0x73, 0x80, 0x78, 0xb6, 0x2a,
-	0x76, 0x71, 0xdb, 0x0b, 0x07, 0x5a, 0x85, 0xd9, 0xd8, 0xa9, 0x40, 0x78, 0x13, 0x2e, 0x3b, 0xfc,
-	0x44, 0x60, 0xcc, 0x27, 0x31, 0x02, 0x7f, 0x19, 0x45, 0x04, 0x68, 0x8b, 0x62, 0x3e, 0x55, 0x97,
-	0x1a, 0x9d, 0x26, 0x71, 0x1f, 0x53, 0x46, 0xce, 0xe0, 0xfe, 0x50, 0x40, 0x4d, 0xb3, 0x0a, 0xd8,
-	0x8f, 0x60, 0x12, 0x5b, 0x56, 0xbd, 0xeb, 0x1f, 0x8a, 0x57, 0xe1, 0x6e, 0x12, 0x79, 0x70, 0x78,
-	0xe9, 0xbe, 0x65, 0xf1, 0x83, 0x77, 0x6d, 0xe6, 0xf6, 0x62, 0xaf, 0x0b, 0x16, 0x16, 0xf5, 0x09,
-	0x4c, 0xc7, 0xbc, 0xd0, 0x35, 0xb8, 0xf4, 0x94, 0xf4, 0x82, 0x89, 0xd6, 0xfc, 0x47, 0xb4, 0x0d,
-	0x13, 0x5d, 0x6c, 0x75, 0x88, 0x78, 0x99, 0xae, 0xf7, 0x95, 0x1e, 0xc3, 0x0e, 0x7c, 0xef, 0x65,
-	0xee, 0x2a, 0x5a, 0x0d, 0x56, 0xfb, 0xc9, 0x95, 0x7b, 0x8f, 0xb1, 0x65, 0x1a, 0x98, 0x51, 0x37,
-	0xba, 0xd8, 0xd6, 0x61, 0xaa, 0x1b, 0x9e, 0xf6, 0xed, 0x53, 0xb6, 0x1b, 0x05, 0x68, 0x0f, 0x60,
-	0x6d, 0x64, 0x4e, 0xd1, 0xbc, 0x45, 0x98, 0x88, 0x1a, 0x77, 0x96, 0x2d, 0x38, 0xd3, 0xde, 0x87,
-	0x9b, 0xb1, 0x3c, 0x55, 0x0b, 0xdb, 0x36, 0x31, 0xde, 0xa1, 0x9f, 0xd8, 0xcc, 0x6c, 0x87, 0x97,
-	0x97, 0xbf, 0xc3, 0x8e, 0xf0, 0xe8, 0xe3, 0x05, 0xa1, 0x65, 0xc7, 0xd0, 0x18, 0xac, 0x0c, 0x4f,
-	0x27, 0x38, 0xed, 0xc2, 0x15, 0x43, 0x9c, 0xd5, 0x5d, 0x6c, 0xb7, 0x88, 0xd8, 0x27, 0x35, 0xd9,
-	0x54, 0xfe, 0x6a, 0xd4, 0x7c, 0x0f, 0x79, 0x6e, 0xd3, 0x61, 0x30, 0xb7, 0x68, 0x6b, 0x70, 0x8b,
-	0xa3, 0x9e, 0x55, 0x5f, 0x25, 0xee, 0xc7, 0xd4, 0x6d, 0x63, 0xbb, 0x49, 0xf6, 0x9a, 0xd4, 0x0d,
-	0xcb, 0xd0, 0xbe, 0xcd, 0x88, 0x51, 0x0c, 0xf1, 0x14, 0x0c, 0xbf, 0x53, 0x60, 0x31, 0x9a, 0x85,
-	0x13, 0xb9, 0xd5, 0x3d, 0xdf, 0x4f, 0x6c, 0xe1, 0x07, 0xa9, 0x5b, 0x38, 0x32, 0x7b, 0x69, 0xa0,
-	0x47, 0xdf, 0x8a, 0x2e, 0x74, 0x07, 0xb9, 0xaa, 0xbb, 0x50, 0x18, 0x9e, 0x27, 0x65, 0x89, 0x73,
-	0xf2, 0x12, 0x8f, 0x4b, 0x5b, 0xba, 0xf5, 0x6b, 0x16, 0x26, 0x38, 0x7b, 0x74, 0x08, 0xd9, 0x0a,
-	0x61, 0xa1, 0x1e, 0xa1, 0x95, 0xd4, 0x1a, 0x13, 0xd2, 0xa8, 0xde, 0x1a, 0xe1, 0x15, 0x14, 0xae,
-	0x2d, 0x7d, 0xe1, 0x57, 0xf5, 0xd9, 0x6f, 0x7f, 0x7f, 0x93, 0x99, 0x41, 0x57, 0xb9, 0xde, 0xfb,
-	0xb7, 0x90, 0xa7, 0xfb, 0x2a, 0x87, 0x3e, 0x55, 0x60, 0xba, 0x42, 0x58, 0xa4, 0x22, 0x68, 0x35,
-	0x35, 0x6d, 0x9f, 0x00, 0xa9, 0x6b, 0x23, 0xfd, 0x04, 0x81, 0x42, 0x44, 0x60, 0x16, 0xcd, 0xc8,
-	0x04, 0xb8, 0x2f, 0xfa, 0x52, 0x81, 0xab, 0x15, 0xc2, 0x64, 0x15, 0x41, 0xeb, 0xa9, 0xc9, 0x53,
-	0xb4, 0x49, 0xbd, 0x7d, 0x0e, 0x4f, 0x41, 0xe4, 0xe5, 0x88, 0xc8, 0x3c, 0xca, 0x49, 0x44, 0x7c,
-	0xcd, 0xd1, 0x8f, 0x4c, 0xe3, 0x18, 0x1d, 0xf1, 0x41, 0x84, 0xd1, 0x03, 0x06, 0x91, 0x90, 0xa3,
-	0x01, 0x83, 0x48, 0x6a, 0x85, 0x76, 0x23, 0x82, 0xcf, 0x21, 0x24, 0xc1, 0x3b, 0x2e, 0x71, 0xb0,
-	0x4b, 0xa4, 0x2d, 0xf0, 0xbf, 0xa5, 0x86, 0x6c, 0x81, 0xf4, 0x65, 0x36, 0x64, 0x0b, 0xe4, 0x0f,
-	0xb2, 0xc1, 0x5b, 0xc0, 0xcb, 0x3e, 0x80, 0xa9, 0x0a, 0x61, 0x65, 0xea, 0x06, 0x62, 0x82, 0xb4,
-	0xf4, 0xab, 0x5e, 0xd6, 0x2b, 0xf5, 0xe6, 0x50, 0x1f, 0x01, 0x9b, 0x8f, 0x60, 0xa7, 0x51, 0x96,
-	0xc3, 0x06, 0xe2, 0x84, 0xbe, 0x52, 0xe0, 0x5a, 0x85, 0xb0, 0xd8, 0x6d, 0x8a, 0x6e, 0x9f, 0x47,
-	0x62, 0x02, 0xf8, 0x8d, 0xf3, 0xab, 0x91, 0xb6, 0x1c, 0xb1, 0x98, 0x43, 0xb3, 0x01, 0x0b, 0xe1,
-	0x58, 0xe4, 0x97, 0x32, 0xfa, 0x59, 0x81, 0xeb, 0x49, 0x36, 0xb1, 0xbb, 0x1d, 0xdd, 0x19, 0x8d,
-	0x97, 0x26, 0x30, 0xea, 0x1b, 0x17, 0x8e, 0x13, 0xa4, 0xf5, 0x88, 0xf4, 0x0a, 0xd2, 0x52, 0x48,
-	0xeb, 0x47, 0xb2, 0x72, 0x1d, 0xa3, 0x5f, 0x14, 0x50, 0xa5, 0x1a, 0x12, 0x42, 0x80, 0xb6, 0x87,
-	0x12, 0x49, 0x57, 0x21, 0xf5, 0xb5, 0x8b, 0x05, 0x09, 0xea, 0xf7, 0x22, 0xea, 0x3a, 0x2a, 0xc6,
-	0xa8, 0x7b, 0xba, 0x13, 0x04, 0x15, 0x43, 0x41, 0xd1, 0x8f, 0x24, 0x9d, 0x3b, 0x46, 0x3f, 0x29,
-	0xb0, 0x54, 0x21, 0x6c, 0xe0, 0x35, 0x8b, 0x5e, 0xbf, 0xa8, 0x00, 0x04, 0x95, 0xdc, 0xf9, 0x6f,
-	0xba, 0xa1, 0x15, 0xa3, 0x5a, 0x34, 0xb4, 0xcc, 0x6b, 0x39, 0x6b, 0x7b, 0x51, 0x12, 0xa9, 0x22,
-	0x17, 0xa9, 0xf2, 0x83, 0x67, 0x27, 0x05, 0xe5, 0xf9, 0x49, 0x41, 0xf9, 0xeb, 0xa4, 0xa0, 0x7c,
-	0x7d, 0x5a, 0x18, 0x7b, 0x7e, 0x5a, 0x18, 0xfb, 0xfd, 0xb4, 0x30, 0xf6, 0xe4, 0x95, 0x96, 0xc9,
-	0xf6, 0x3b, 0x8d, 0x52, 0x93, 0xb6, 0xf5, 0x57, 0x0f, 0xab, 0xd4, 0xea, 0xb5, 0xa8, 0xad, 0x87,
-	0xa4, 0x8a, 0xdd, 0x2d, 0xfd, 0x90, 0x67, 0x67, 0x3d, 0x87, 0x78, 0x8d, 0xcb, 0xfc, 0xf7, 0xd8,
-	0xf6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7a, 0xbe, 0x05, 0x67, 0x0e, 0x00, 0x00,
+func (m *QueryValidatorPerformanceHistoryRequest) Reset() {
+	*m = QueryValidatorPerformanceHistoryRequest{}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// GetSpanList queries a paginated list of spans.
-	GetSpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error)
-	// GetLatestSpan queries the latest span.
-	GetLatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error)
-	// GetNextSpanSeed queries the seed for generating the next span.
-	// The seed is used for deterministic random selection of producers.
-	GetNextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error)
-	// GetNextSpan prepares and returns the next span based on the current
-	// validator set. This is used by proposers to create span proposals.
-	GetNextSpan(ctx context.Context, in *QueryNextSpanRequest, opts ...grpc.CallOption) (*QueryNextSpanResponse, error)
-	// GetSpanById retrieves a specific span by its ID.
-	GetSpanById(ctx context.Context, in *QuerySpanByIdRequest, opts ...grpc.CallOption) (*QuerySpanByIdResponse, error)
-	// GetBorParams queries the parameters of x/bor module.
-	GetBorParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetProducerVotes queries producer votes from all validators.
-	// Returns a map of validator ID to their producer votes.
-	GetProducerVotes(ctx context.Context, in *QueryProducerVotesRequest, opts ...grpc.CallOption) (*QueryProducerVotesResponse, error)
-	// GetProducerVotesByValidatorId queries the producer votes cast by a specific
-	// validator.
-	GetProducerVotesByValidatorId(ctx context.Context, in *QueryProducerVotesByValidatorIdRequest, opts ...grpc.CallOption) (*QueryProducerVotesByValidatorIdResponse, error)
-	// GetProducerPlannedDowntime queries the planned downtime window for a
-	// specific producer. Producers can signal planned maintenance periods during
-	// which they won't produce blocks.
-	GetProducerPlannedDowntime(ctx context.Context, in *QueryProducerPlannedDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimeResponse, error)
-	// GetValidatorPerformanceScore queries the performance scores of all
-	// validators. Performance scores track block production reliability.
-	GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error)
+func (m *QueryValidatorPerformanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceHistoryRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{22}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryValidatorPerformanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryValidatorPerformanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceHistoryRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetSpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error) {
-	out := new(QuerySpanListResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetSpanList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPerformanceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceHistoryRequest) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetLatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error) {
-	out := new(QueryLatestSpanResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetLatestSpan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceHistoryRequest) GetFromSpanId() uint64 {
+	if m != nil {
+		return m.FromSpanId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetNextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error) {
-	out := new(QueryNextSpanSeedResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetNextSpanSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceHistoryRequest) GetToSpanId() uint64 {
+	if m != nil {
+		return m.ToSpanId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetNextSpan(ctx context.Context, in *QueryNextSpanRequest, opts ...grpc.CallOption) (*QueryNextSpanResponse, error) {
-	out := new(QueryNextSpanResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetNextSpan", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryValidatorPerformanceHistoryResponse is the response type for the
+// GetValidatorPerformanceHistory query.
+type QueryValidatorPerformanceHistoryResponse struct {
+	// Performance of the validator in each span of the range it was recorded
+	// for, ordered by span ID.
+	ValidatorPerformances []ValidatorPerformance `protobuf:"bytes,1,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
 }
 
-func (c *queryClient) GetSpanById(ctx context.Context, in *QuerySpanByIdRequest, opts ...grpc.CallOption) (*QuerySpanByIdResponse, error) {
-	out := new(QuerySpanByIdResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetSpanById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceHistoryResponse) Reset() {
+	*m = QueryValidatorPerformanceHistoryResponse{}
+}
+func (m *QueryValidatorPerformanceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceHistoryResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{23}
+}
+func (m *QueryValidatorPerformanceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformanceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceHistoryResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBorParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetBorParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPerformanceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceHistoryResponse) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProducerVotes(ctx context.Context, in *QueryProducerVotesRequest, opts ...grpc.CallOption) (*QueryProducerVotesResponse, error) {
-	out := new(QueryProducerVotesResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SimulatedProducerVotes are the hypothetical producer votes of a validator.
+type SimulatedProducerVotes struct {
+	// ID of the voting validator.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Validator IDs voted for, replacing the current votes of the validator.
+	// No votes removes the current votes of the validator.
+	Votes []uint64 `protobuf:"varint,2,rep,packed,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (c *queryClient) GetProducerVotesByValidatorId(ctx context.Context, in *QueryProducerVotesByValidatorIdRequest, opts ...grpc.CallOption) (*QueryProducerVotesByValidatorIdResponse, error) {
-	out := new(QueryProducerVotesByValidatorIdResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerVotesByValidatorId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SimulatedProducerVotes) Reset()         { *m = SimulatedProducerVotes{} }
+func (m *SimulatedProducerVotes) String() string { return proto.CompactTextString(m) }
+func (*SimulatedProducerVotes) ProtoMessage()    {}
+func (*SimulatedProducerVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{24}
+}
+func (m *SimulatedProducerVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedProducerVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedProducerVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SimulatedProducerVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedProducerVotes.Merge(m, src)
+}
+func (m *SimulatedProducerVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedProducerVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedProducerVotes.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProducerPlannedDowntime(ctx context.Context, in *QueryProducerPlannedDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimeResponse, error) {
-	out := new(QueryProducerPlannedDowntimeResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerPlannedDowntime", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SimulatedProducerVotes proto.InternalMessageInfo
+
+func (m *SimulatedProducerVotes) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error) {
-	out := new(QueryValidatorPerformanceScoreResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetValidatorPerformanceScore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SimulatedProducerVotes) GetVotes() []uint64 {
+	if m != nil {
+		return m.Votes
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// GetSpanList queries a paginated list of spans.
-	GetSpanList(context.Context, *QuerySpanListRequest) (*QuerySpanListResponse, error)
-	// GetLatestSpan queries the latest span.
-	GetLatestSpan(context.Context, *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error)
-	// GetNextSpanSeed queries the seed for generating the next span.
-	// The seed is used for deterministic random selection of producers.
-	GetNextSpanSeed(context.Context, *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error)
-	// GetNextSpan prepares and returns the next span based on the current
-	// validator set. This is used by proposers to create span proposals.
-	GetNextSpan(context.Context, *QueryNextSpanRequest) (*QueryNextSpanResponse, error)
-	// GetSpanById retrieves a specific span by its ID.
-	GetSpanById(context.Context, *QuerySpanByIdRequest) (*QuerySpanByIdResponse, error)
-	// GetBorParams queries the parameters of x/bor module.
-	GetBorParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetProducerVotes queries producer votes from all validators.
-	// Returns a map of validator ID to their producer votes.
-	GetProducerVotes(context.Context, *QueryProducerVotesRequest) (*QueryProducerVotesResponse, error)
-	// GetProducerVotesByValidatorId queries the producer votes cast by a specific
-	// validator.
-	GetProducerVotesByValidatorId(context.Context, *QueryProducerVotesByValidatorIdRequest) (*QueryProducerVotesByValidatorIdResponse, error)
-	// GetProducerPlannedDowntime queries the planned downtime window for a
-	// specific producer. Producers can signal planned maintenance periods during
-	// which they won't produce blocks.
-	GetProducerPlannedDowntime(context.Context, *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error)
-	// GetValidatorPerformanceScore queries the performance scores of all
-	// validators. Performance scores track block production reliability.
-	GetValidatorPerformanceScore(context.Context, *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error)
+// SimulatedProducerDowntime is a hypothetical planned downtime of a producer.
+type SimulatedProducerDowntime struct {
+	// ID of the producer.
+	ProducerId uint64 `protobuf:"varint,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Block range of the downtime, planned as with MsgSetProducerDowntime.
+	DowntimeRange BlockRange `protobuf:"bytes,2,opt,name=downtime_range,json=downtimeRange,proto3" json:"downtime_range"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *SimulatedProducerDowntime) Reset()         { *m = SimulatedProducerDowntime{} }
+func (m *SimulatedProducerDowntime) String() string { return proto.CompactTextString(m) }
+func (*SimulatedProducerDowntime) ProtoMessage()    {}
+func (*SimulatedProducerDowntime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{25}
 }
-
-func (*UnimplementedQueryServer) GetSpanList(ctx context.Context, req *QuerySpanListRequest) (*QuerySpanListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSpanList not implemented")
+func (m *SimulatedProducerDowntime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetLatestSpan(ctx context.Context, req *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestSpan not implemented")
+func (m *SimulatedProducerDowntime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedProducerDowntime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetNextSpanSeed(ctx context.Context, req *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextSpanSeed not implemented")
+func (m *SimulatedProducerDowntime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedProducerDowntime.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetNextSpan(ctx context.Context, req *QueryNextSpanRequest) (*QueryNextSpanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextSpan not implemented")
+func (m *SimulatedProducerDowntime) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetSpanById(ctx context.Context, req *QuerySpanByIdRequest) (*QuerySpanByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSpanById not implemented")
+func (m *SimulatedProducerDowntime) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedProducerDowntime.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetBorParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBorParams not implemented")
+
+var xxx_messageInfo_SimulatedProducerDowntime proto.InternalMessageInfo
+
+func (m *SimulatedProducerDowntime) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetProducerVotes(ctx context.Context, req *QueryProducerVotesRequest) (*QueryProducerVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProducerVotes not implemented")
+
+func (m *SimulatedProducerDowntime) GetDowntimeRange() BlockRange {
+	if m != nil {
+		return m.DowntimeRange
+	}
+	return BlockRange{}
 }
-func (*UnimplementedQueryServer) GetProducerVotesByValidatorId(ctx context.Context, req *QueryProducerVotesByValidatorIdRequest) (*QueryProducerVotesByValidatorIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProducerVotesByValidatorId not implemented")
+
+// ProducerScore is the weighted score of a producer candidate in the
+// election of the producer set.
+type ProducerScore struct {
+	// ID of the candidate.
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	// Sum of the stake of the validators voting for the candidate, weighted by
+	// the position of the candidate in their votes.
+	Score int64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Score required for the candidate to enter the producer set at its rank.
+	RequiredScore int64 `protobuf:"varint,3,opt,name=required_score,json=requiredScore,proto3" json:"required_score,omitempty"`
 }
-func (*UnimplementedQueryServer) GetProducerPlannedDowntime(ctx context.Context, req *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProducerPlannedDowntime not implemented")
+
+func (m *ProducerScore) Reset()         { *m = ProducerScore{} }
+func (m *ProducerScore) String() string { return proto.CompactTextString(m) }
+func (*ProducerScore) ProtoMessage()    {}
+func (*ProducerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{26}
 }
-func (*UnimplementedQueryServer) GetValidatorPerformanceScore(ctx context.Context, req *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceScore not implemented")
+func (m *ProducerScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ProducerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProducerScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProducerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerScore.Merge(m, src)
+}
+func (m *ProducerScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProducerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerScore.DiscardUnknown(m)
 }
 
-func _Query_GetSpanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpanListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSpanList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetSpanList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSpanList(ctx, req.(*QuerySpanListRequest))
+var xxx_messageInfo_ProducerScore proto.InternalMessageInfo
+
+func (m *ProducerScore) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetLatestSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestSpanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLatestSpan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetLatestSpan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLatestSpan(ctx, req.(*QueryLatestSpanRequest))
+func (m *ProducerScore) GetScore() int64 {
+	if m != nil {
+		return m.Score
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetNextSpanSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextSpanSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNextSpanSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetNextSpanSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNextSpanSeed(ctx, req.(*QueryNextSpanSeedRequest))
+func (m *ProducerScore) GetRequiredScore() int64 {
+	if m != nil {
+		return m.RequiredScore
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetNextSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextSpanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNextSpan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetNextSpan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNextSpan(ctx, req.(*QueryNextSpanRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySimulateProducerSetRequest is the request type for the
+// SimulateProducerSet query.
+type QuerySimulateProducerSetRequest struct {
+	// Hypothetical producer votes.
+	ProducerVotes []SimulatedProducerVotes `protobuf:"bytes,1,rep,name=producer_votes,json=producerVotes,proto3" json:"producer_votes"`
+	// Hypothetical planned downtimes.
+	ProducerDowntimes []SimulatedProducerDowntime `protobuf:"bytes,2,rep,name=producer_downtimes,json=producerDowntimes,proto3" json:"producer_downtimes"`
 }
 
-func _Query_GetSpanById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpanByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSpanById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetSpanById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSpanById(ctx, req.(*QuerySpanByIdRequest))
+func (m *QuerySimulateProducerSetRequest) Reset()         { *m = QuerySimulateProducerSetRequest{} }
+func (m *QuerySimulateProducerSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProducerSetRequest) ProtoMessage()    {}
+func (*QuerySimulateProducerSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{27}
+}
+func (m *QuerySimulateProducerSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProducerSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProducerSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulateProducerSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProducerSetRequest.Merge(m, src)
+}
+func (m *QuerySimulateProducerSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProducerSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProducerSetRequest.DiscardUnknown(m)
 }
 
-func _Query_GetBorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBorParams(ctx, in)
+var xxx_messageInfo_QuerySimulateProducerSetRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProducerSetRequest) GetProducerVotes() []SimulatedProducerVotes {
+	if m != nil {
+		return m.ProducerVotes
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetBorParams",
+	return nil
+}
+
+func (m *QuerySimulateProducerSetRequest) GetProducerDowntimes() []SimulatedProducerDowntime {
+	if m != nil {
+		return m.ProducerDowntimes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBorParams(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QuerySimulateProducerSetResponse is the response type for the
+// SimulateProducerSet query.
+type QuerySimulateProducerSetResponse struct {
+	// Weighted scores of the candidates, ranked.
+	ProducerScores []ProducerScore `protobuf:"bytes,1,rep,name=producer_scores,json=producerScores,proto3" json:"producer_scores"`
+	// Producer set computed from the scores.
+	ProducerSet []uint64 `protobuf:"varint,2,rep,packed,name=producer_set,json=producerSet,proto3" json:"producer_set,omitempty"`
+	// Producer of the current span, replaced by the next producer.
+	CurrentProducer uint64 `protobuf:"varint,3,opt,name=current_producer,json=currentProducer,proto3" json:"current_producer,omitempty"`
+	// Block range of the next span.
+	SpanRange BlockRange `protobuf:"bytes,4,opt,name=span_range,json=spanRange,proto3" json:"span_range"`
+	// Producer selected for the next span.
+	NextProducer uint64 `protobuf:"varint,5,opt,name=next_producer,json=nextProducer,proto3" json:"next_producer,omitempty"`
+}
+
+func (m *QuerySimulateProducerSetResponse) Reset()         { *m = QuerySimulateProducerSetResponse{} }
+func (m *QuerySimulateProducerSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProducerSetResponse) ProtoMessage()    {}
+func (*QuerySimulateProducerSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{28}
+}
+func (m *QuerySimulateProducerSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProducerSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProducerSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulateProducerSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProducerSetResponse.Merge(m, src)
+}
+func (m *QuerySimulateProducerSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProducerSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProducerSetResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProducerVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProducerVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateProducerSetResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProducerSetResponse) GetProducerScores() []ProducerScore {
+	if m != nil {
+		return m.ProducerScores
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProducerVotes(ctx, in)
+	return nil
+}
+
+func (m *QuerySimulateProducerSetResponse) GetProducerSet() []uint64 {
+	if m != nil {
+		return m.ProducerSet
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetProducerVotes",
+	return nil
+}
+
+func (m *QuerySimulateProducerSetResponse) GetCurrentProducer() uint64 {
+	if m != nil {
+		return m.CurrentProducer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProducerVotes(ctx, req.(*QueryProducerVotesRequest))
+	return 0
+}
+
+func (m *QuerySimulateProducerSetResponse) GetSpanRange() BlockRange {
+	if m != nil {
+		return m.SpanRange
 	}
-	return interceptor(ctx, in, info, handler)
+	return BlockRange{}
 }
 
-func _Query_GetProducerVotesByValidatorId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProducerVotesByValidatorIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProducerVotesByValidatorId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetProducerVotesByValidatorId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProducerVotesByValidatorId(ctx, req.(*QueryProducerVotesByValidatorIdRequest))
+func (m *QuerySimulateProducerSetResponse) GetNextProducer() uint64 {
+	if m != nil {
+		return m.NextProducer
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProducerPlannedDowntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProducerPlannedDowntimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProducerPlannedDowntime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetProducerPlannedDowntime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProducerPlannedDowntime(ctx, req.(*QueryProducerPlannedDowntimeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySpanSummaryByIdRequest is the request type for the GetSpanSummaryById
+// query.
+type QuerySpanSummaryByIdRequest struct {
+	// ID of the pruned span.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_GetValidatorPerformanceScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorPerformanceScoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetValidatorPerformanceScore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.bor.Query/GetValidatorPerformanceScore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetValidatorPerformanceScore(ctx, req.(*QueryValidatorPerformanceScoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySpanSummaryByIdRequest) Reset()         { *m = QuerySpanSummaryByIdRequest{} }
+func (m *QuerySpanSummaryByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanSummaryByIdRequest) ProtoMessage()    {}
+func (*QuerySpanSummaryByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{29}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "heimdallv2.bor.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSpanList",
-			Handler:    _Query_GetSpanList_Handler,
-		},
-		{
-			MethodName: "GetLatestSpan",
-			Handler:    _Query_GetLatestSpan_Handler,
-		},
-		{
-			MethodName: "GetNextSpanSeed",
-			Handler:    _Query_GetNextSpanSeed_Handler,
-		},
-		{
-			MethodName: "GetNextSpan",
-			Handler:    _Query_GetNextSpan_Handler,
-		},
-		{
-			MethodName: "GetSpanById",
-			Handler:    _Query_GetSpanById_Handler,
-		},
-		{
-			MethodName: "GetBorParams",
-			Handler:    _Query_GetBorParams_Handler,
-		},
-		{
-			MethodName: "GetProducerVotes",
-			Handler:    _Query_GetProducerVotes_Handler,
-		},
-		{
-			MethodName: "GetProducerVotesByValidatorId",
-			Handler:    _Query_GetProducerVotesByValidatorId_Handler,
-		},
-		{
-			MethodName: "GetProducerPlannedDowntime",
-			Handler:    _Query_GetProducerPlannedDowntime_Handler,
-		},
-		{
-			MethodName: "GetValidatorPerformanceScore",
-			Handler:    _Query_GetValidatorPerformanceScore_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "heimdallv2/bor/query.proto",
+func (m *QuerySpanSummaryByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySpanByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpanSummaryByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanSummaryByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySpanByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpanSummaryByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanSummaryByIdRequest.Merge(m, src)
 }
-
-func (m *QuerySpanByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySpanSummaryByIdRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QuerySpanByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySpanSummaryByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanSummaryByIdRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySpanByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QuerySpanSummaryByIdRequest proto.InternalMessageInfo
 
-func (m *QuerySpanByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Span != nil {
-		{
-			size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySpanSummaryByIdRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QuerySpanListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QuerySpanSummaryByIdResponse is the response type for the
+// GetSpanSummaryById query.
+type QuerySpanSummaryByIdResponse struct {
+	// Summary of the pruned span.
+	SpanSummary SpanSummary `protobuf:"bytes,1,opt,name=span_summary,json=spanSummary,proto3" json:"span_summary"`
 }
 
-func (m *QuerySpanListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpanSummaryByIdResponse) Reset()         { *m = QuerySpanSummaryByIdResponse{} }
+func (m *QuerySpanSummaryByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanSummaryByIdResponse) ProtoMessage()    {}
+func (*QuerySpanSummaryByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b3050b896ec07f, []int{30}
 }
-
-func (m *QuerySpanListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySpanSummaryByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanSummaryByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanSummaryByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySpanListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySpanSummaryByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanSummaryByIdResponse.Merge(m, src)
 }
-
-func (m *QuerySpanListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpanSummaryByIdResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QuerySpanListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.SpanList) > 0 {
-		for iNdEx := len(m.SpanList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpanList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySpanSummaryByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanSummaryByIdResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestSpanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpanSummaryByIdResponse proto.InternalMessageInfo
+
+func (m *QuerySpanSummaryByIdResponse) GetSpanSummary() SpanSummary {
+	if m != nil {
+		return m.SpanSummary
 	}
-	return dAtA[:n], nil
+	return SpanSummary{}
 }
 
-func (m *QueryLatestSpanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QuerySpanByIdRequest)(nil), "heimdallv2.bor.QuerySpanByIdRequest")
+	proto.RegisterType((*QuerySpanByIdResponse)(nil), "heimdallv2.bor.QuerySpanByIdResponse")
+	proto.RegisterType((*QuerySpanListRequest)(nil), "heimdallv2.bor.QuerySpanListRequest")
+	proto.RegisterType((*QuerySpanListResponse)(nil), "heimdallv2.bor.QuerySpanListResponse")
+	proto.RegisterType((*QueryLatestSpanRequest)(nil), "heimdallv2.bor.QueryLatestSpanRequest")
+	proto.RegisterType((*QueryLatestSpanResponse)(nil), "heimdallv2.bor.QueryLatestSpanResponse")
+	proto.RegisterType((*QueryNextSpanSeedRequest)(nil), "heimdallv2.bor.QueryNextSpanSeedRequest")
+	proto.RegisterType((*QueryNextSpanSeedResponse)(nil), "heimdallv2.bor.QueryNextSpanSeedResponse")
+	proto.RegisterType((*QueryNextSpanRequest)(nil), "heimdallv2.bor.QueryNextSpanRequest")
+	proto.RegisterType((*QueryNextSpanResponse)(nil), "heimdallv2.bor.QueryNextSpanResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdallv2.bor.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdallv2.bor.QueryParamsResponse")
+	proto.RegisterType((*QueryProducerVotesRequest)(nil), "heimdallv2.bor.QueryProducerVotesRequest")
+	proto.RegisterType((*QueryProducerVotesResponse)(nil), "heimdallv2.bor.QueryProducerVotesResponse")
+	proto.RegisterMapType((map[uint64]ProducerVotes)(nil), "heimdallv2.bor.QueryProducerVotesResponse.AllVotesEntry")
+	proto.RegisterType((*QueryProducerVotesByValidatorIdRequest)(nil), "heimdallv2.bor.QueryProducerVotesByValidatorIdRequest")
+	proto.RegisterType((*QueryProducerVotesByValidatorIdResponse)(nil), "heimdallv2.bor.QueryProducerVotesByValidatorIdResponse")
+	proto.RegisterType((*QueryProducerPlannedDowntimeRequest)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimeRequest")
+	proto.RegisterType((*QueryProducerPlannedDowntimeResponse)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimeResponse")
+	proto.RegisterType((*QueryProducerPlannedDowntimesRequest)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimesRequest")
+	proto.RegisterType((*QueryProducerPlannedDowntimesResponse)(nil), "heimdallv2.bor.QueryProducerPlannedDowntimesResponse")
+	proto.RegisterType((*QueryValidatorPerformanceScoreRequest)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreRequest")
+	proto.RegisterType((*QueryValidatorPerformanceScoreResponse)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreResponse")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "heimdallv2.bor.QueryValidatorPerformanceScoreResponse.ValidatorPerformanceScoreEntry")
+	proto.RegisterType((*QueryValidatorPerformanceHistoryRequest)(nil), "heimdallv2.bor.QueryValidatorPerformanceHistoryRequest")
+	proto.RegisterType((*QueryValidatorPerformanceHistoryResponse)(nil), "heimdallv2.bor.QueryValidatorPerformanceHistoryResponse")
+	proto.RegisterType((*SimulatedProducerVotes)(nil), "heimdallv2.bor.SimulatedProducerVotes")
+	proto.RegisterType((*SimulatedProducerDowntime)(nil), "heimdallv2.bor.SimulatedProducerDowntime")
+	proto.RegisterType((*ProducerScore)(nil), "heimdallv2.bor.ProducerScore")
+	proto.RegisterType((*QuerySimulateProducerSetRequest)(nil), "heimdallv2.bor.QuerySimulateProducerSetRequest")
+	proto.RegisterType((*QuerySimulateProducerSetResponse)(nil), "heimdallv2.bor.QuerySimulateProducerSetResponse")
+	proto.RegisterType((*QuerySpanSummaryByIdRequest)(nil), "heimdallv2.bor.QuerySpanSummaryByIdRequest")
+	proto.RegisterType((*QuerySpanSummaryByIdResponse)(nil), "heimdallv2.bor.QuerySpanSummaryByIdResponse")
 }
 
-func (m *QueryLatestSpanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("heimdallv2/bor/query.proto", fileDescriptor_75b3050b896ec07f) }
+
+var fileDescriptor_75b3050b896ec07f = []byte{
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcb, 0x6f, 0xdb, 0x46,
+	0x1a, 0x37, 0x65, 0x3b, 0x1b, 0x7f, 0x7e, 0xc5, 0x63, 0xd9, 0x91, 0x69, 0x47, 0xf6, 0x32, 0x7e,
+	0xc5, 0x1b, 0x89, 0x7e, 0xe4, 0xb5, 0xd9, 0xc5, 0x02, 0xf1, 0x66, 0xe3, 0x18, 0xf0, 0x1a, 0x8e,
+	0x8d, 0x0d, 0x16, 0x39, 0x54, 0xa5, 0xa4, 0x89, 0x4c, 0x44, 0xe2, 0xd0, 0xe4, 0x48, 0xb1, 0x60,
+	0x04, 0x28, 0x8a, 0x02, 0x6d, 0xd1, 0x4b, 0x1f, 0xe8, 0xa1, 0xa7, 0xb6, 0xb7, 0x16, 0xbd, 0xf4,
+	0x0f, 0xe8, 0xa5, 0xc8, 0xa1, 0x39, 0x06, 0xc8, 0xa5, 0xe8, 0xa1, 0x08, 0x92, 0x02, 0xfd, 0x37,
+	0x0a, 0xce, 0x0c, 0xc5, 0x21, 0x45, 0x59, 0x52, 0x73, 0x49, 0x84, 0xf9, 0x1e, 0xbf, 0xdf, 0xf7,
+	0x1a, 0xce, 0x67, 0x50, 0x0f, 0xb1, 0x59, 0x29, 0x1a, 0xe5, 0x72, 0x6d, 0x5d, 0xcf, 0x13, 0x47,
+	0x3f, 0xaa, 0x62, 0xa7, 0x9e, 0xb5, 0x1d, 0x42, 0x09, 0x1a, 0x09, 0x64, 0xd9, 0x3c, 0x71, 0xd4,
+	0x31, 0xa3, 0x62, 0x5a, 0x44, 0x67, 0xff, 0x72, 0x15, 0x75, 0xa5, 0x40, 0xdc, 0x0a, 0x71, 0xf5,
+	0xbc, 0xe1, 0x62, 0x6e, 0xab, 0xd7, 0xd6, 0xf2, 0x98, 0x1a, 0x6b, 0xba, 0x6d, 0x94, 0x4c, 0xcb,
+	0xa0, 0x26, 0xb1, 0x84, 0xee, 0xb4, 0xd0, 0xf5, 0xd5, 0x64, 0x2c, 0x35, 0x59, 0x22, 0x25, 0xc2,
+	0x7e, 0xea, 0xde, 0x2f, 0x71, 0x3a, 0x53, 0x22, 0xa4, 0x54, 0xc6, 0xba, 0x61, 0x9b, 0xba, 0x61,
+	0x59, 0x84, 0x32, 0x7f, 0xae, 0x90, 0xa6, 0x22, 0xdc, 0xf3, 0xc4, 0xe1, 0x12, 0x2d, 0x03, 0xc9,
+	0x7b, 0x9e, 0xf3, 0x03, 0xdb, 0xb0, 0x36, 0xeb, 0xdb, 0xc5, 0x7d, 0x7c, 0x54, 0xc5, 0x2e, 0x45,
+	0x13, 0x90, 0x30, 0x8b, 0x29, 0x65, 0x4e, 0x59, 0x1e, 0xd8, 0xec, 0xff, 0xe6, 0xf7, 0xef, 0x57,
+	0x94, 0xfd, 0x84, 0x59, 0xd4, 0xee, 0xc2, 0x44, 0x44, 0xdd, 0xb5, 0x89, 0xe5, 0x62, 0xa4, 0x43,
+	0x9f, 0x6b, 0x1b, 0x16, 0xb3, 0x18, 0x5c, 0x4f, 0x66, 0xc3, 0x09, 0xc9, 0x32, 0x7d, 0xe1, 0x87,
+	0x29, 0x6a, 0xa6, 0x04, 0xbc, 0x63, 0xba, 0xd4, 0x07, 0xbe, 0x07, 0x10, 0xe4, 0x43, 0xb8, 0x5b,
+	0xcc, 0xf2, 0x84, 0x64, 0xbd, 0xe4, 0x65, 0x79, 0x32, 0x44, 0xf2, 0xb2, 0x7b, 0x46, 0x09, 0x0b,
+	0xdb, 0xcd, 0x81, 0x67, 0xbf, 0xce, 0xf6, 0x70, 0x10, 0xc9, 0x89, 0xf6, 0xad, 0x02, 0x13, 0x11,
+	0x2c, 0xc1, 0xfa, 0x9f, 0x30, 0xe0, 0x91, 0xc9, 0x95, 0x4d, 0x97, 0xa6, 0x94, 0xb9, 0xde, 0x96,
+	0xd4, 0x25, 0xcf, 0x67, 0x5d, 0xe1, 0x05, 0xed, 0x87, 0xa8, 0x26, 0x18, 0xd5, 0xa5, 0xb6, 0x54,
+	0x39, 0x74, 0x2b, 0xae, 0x29, 0x98, 0x64, 0x54, 0x77, 0x0c, 0x8a, 0x5d, 0xea, 0x61, 0x8b, 0xe0,
+	0xb4, 0x5d, 0x38, 0xdf, 0x24, 0x11, 0x61, 0x6c, 0x74, 0x90, 0x7c, 0x09, 0x8f, 0x17, 0x60, 0x0d,
+	0x52, 0xcc, 0xdf, 0x2e, 0x3e, 0x66, 0xde, 0x0e, 0x30, 0x8e, 0xa9, 0x7e, 0x9f, 0x5c, 0xfd, 0xb7,
+	0x60, 0x2a, 0xc6, 0x44, 0x90, 0x98, 0x82, 0x3e, 0x17, 0xe3, 0x48, 0xcf, 0xb0, 0x23, 0xb4, 0x08,
+	0x83, 0xde, 0xff, 0x39, 0xa3, 0x4a, 0x0f, 0x89, 0x93, 0x4a, 0xc8, 0x1a, 0xe0, 0x49, 0x6e, 0x31,
+	0x81, 0xf6, 0xbe, 0x02, 0xc9, 0x10, 0x80, 0xcf, 0x27, 0x0d, 0x7f, 0x61, 0x75, 0x8a, 0x92, 0x3a,
+	0xe3, 0x9d, 0x6e, 0x73, 0x00, 0x6a, 0x38, 0x34, 0x97, 0x2f, 0x93, 0xc2, 0xa3, 0x54, 0x42, 0xd6,
+	0x01, 0x26, 0xd9, 0xf4, 0x04, 0x68, 0x09, 0x86, 0xf2, 0xc4, 0xc9, 0x15, 0x0e, 0x0d, 0x93, 0x39,
+	0xeb, 0x0d, 0x31, 0xc9, 0x13, 0xe7, 0xdf, 0x9e, 0x64, 0xbb, 0xa8, 0xed, 0xc0, 0x44, 0x84, 0xc8,
+	0x9b, 0xa4, 0x3a, 0x09, 0x88, 0x79, 0xdb, 0x33, 0x1c, 0xa3, 0xe2, 0xfa, 0x05, 0xdd, 0x83, 0xf1,
+	0xd0, 0xa9, 0x40, 0xf8, 0x3b, 0x9c, 0xb1, 0xd9, 0x89, 0xc0, 0x98, 0x8c, 0x62, 0x70, 0x7d, 0x19,
+	0x45, 0x18, 0x68, 0xd3, 0xa2, 0x3e, 0x7b, 0x0e, 0x29, 0x56, 0x0b, 0xd8, 0xb9, 0x4f, 0x28, 0x6e,
+	0xc0, 0xfd, 0xa2, 0x80, 0x1a, 0x27, 0x15, 0xb0, 0x6f, 0xc3, 0x80, 0x51, 0x2e, 0xe7, 0x6a, 0xde,
+	0xa1, 0x18, 0x85, 0x1b, 0x51, 0xe4, 0xd6, 0xe6, 0xd9, 0x5b, 0xe5, 0x32, 0x3b, 0xf8, 0x8f, 0x45,
+	0x9d, 0x7a, 0x68, 0x5c, 0x0c, 0x21, 0x51, 0x1f, 0xc0, 0x70, 0x48, 0x0b, 0x9d, 0x83, 0xde, 0x47,
+	0xb8, 0xce, 0x2b, 0xba, 0xef, 0xfd, 0x44, 0x1b, 0xd0, 0x5f, 0x33, 0xca, 0x55, 0x2c, 0x86, 0xe9,
+	0x42, 0x53, 0xe8, 0x21, 0x6c, 0xae, 0x7b, 0x33, 0x71, 0x43, 0xd1, 0xf6, 0x61, 0xb1, 0x99, 0xdc,
+	0x66, 0xfd, 0xbe, 0x51, 0x36, 0x8b, 0x06, 0x25, 0x4e, 0x70, 0xb1, 0x2d, 0xc3, 0x50, 0xcd, 0x3f,
+	0x6d, 0xea, 0xa7, 0xc1, 0x5a, 0x60, 0xa0, 0xdd, 0x81, 0xa5, 0xb6, 0x3e, 0x45, 0xf2, 0xa6, 0xa1,
+	0x3f, 0x48, 0x5c, 0xc3, 0x1b, 0x3f, 0xd3, 0xfe, 0x0b, 0x17, 0x43, 0x7e, 0xf6, 0xca, 0x86, 0x65,
+	0xe1, 0xe2, 0x6d, 0xf2, 0xd8, 0xa2, 0x66, 0xc5, 0xbf, 0xbc, 0xbc, 0x1e, 0xb6, 0x85, 0x46, 0x13,
+	0x2f, 0xf0, 0x25, 0xdb, 0x45, 0x8d, 0xc2, 0xfc, 0xe9, 0xee, 0x04, 0xa7, 0x1d, 0x18, 0x29, 0x8a,
+	0xb3, 0x9c, 0x63, 0x58, 0x25, 0x2c, 0xfa, 0x49, 0x8d, 0x26, 0x95, 0x8d, 0xc6, 0xbe, 0xa7, 0x21,
+	0xd7, 0x6d, 0xd8, 0x37, 0x66, 0x12, 0x6d, 0xf7, 0x74, 0x54, 0xb7, 0xdb, 0x28, 0x1e, 0xc3, 0x42,
+	0x1b, 0x7f, 0x22, 0x8c, 0x5d, 0x18, 0x0d, 0x87, 0xe1, 0x77, 0x67, 0x87, 0x71, 0x8c, 0x84, 0xe2,
+	0x70, 0xb5, 0x25, 0x01, 0xdc, 0x28, 0xe3, 0x1e, 0x76, 0x1e, 0x12, 0xa7, 0x62, 0x58, 0x05, 0x7c,
+	0x50, 0x20, 0x8e, 0x5f, 0x0f, 0xed, 0x8b, 0x04, 0x2c, 0xb6, 0xd3, 0x14, 0x1c, 0xbf, 0x52, 0x60,
+	0x3a, 0x68, 0x2a, 0x3b, 0x50, 0xcb, 0xb9, 0x9e, 0x9e, 0x20, 0xfc, 0xbf, 0xd8, 0x71, 0x6a, 0xeb,
+	0x3d, 0xdb, 0x52, 0xa3, 0x69, 0xd6, 0xa6, 0x6a, 0xad, 0x54, 0xd5, 0x1d, 0x48, 0x9f, 0xee, 0x27,
+	0x66, 0x1a, 0x93, 0xf2, 0x34, 0xf6, 0xc9, 0xe3, 0xf6, 0xb5, 0x22, 0x66, 0x23, 0xce, 0xe7, 0x5d,
+	0xd3, 0xa5, 0xc4, 0xa9, 0x77, 0x3d, 0x70, 0xde, 0xed, 0xfc, 0xd0, 0x21, 0x95, 0x9c, 0x7f, 0xd5,
+	0x87, 0xaf, 0x71, 0x4f, 0x74, 0xc0, 0xaf, 0xfb, 0x8b, 0x00, 0x94, 0x34, 0xd4, 0x7a, 0x65, 0xb5,
+	0xb3, 0x94, 0x70, 0x25, 0xed, 0x53, 0x05, 0x96, 0xdb, 0x73, 0x14, 0x15, 0x7c, 0x08, 0x93, 0xb1,
+	0x05, 0xf4, 0x9b, 0x6d, 0x3e, 0x5a, 0xbb, 0x38, 0xa7, 0x72, 0x29, 0x26, 0xe2, 0x4a, 0xe1, 0x6a,
+	0x39, 0x98, 0x3c, 0x30, 0x2b, 0xd5, 0xb2, 0x41, 0x71, 0x31, 0x74, 0xaf, 0x74, 0x91, 0xa6, 0xc6,
+	0x65, 0x93, 0x88, 0xb9, 0x6c, 0x3e, 0x51, 0x60, 0xaa, 0x09, 0xc1, 0x9f, 0xaa, 0x4e, 0xa7, 0x33,
+	0xe6, 0xee, 0x48, 0xbc, 0xc1, 0xdd, 0xf1, 0x9e, 0x02, 0xc3, 0x3e, 0x15, 0xd6, 0x70, 0xdd, 0x05,
+	0xcb, 0x67, 0xc8, 0x23, 0xd0, 0xdb, 0x08, 0x96, 0x9d, 0xa1, 0xcb, 0x30, 0xe2, 0xe0, 0xa3, 0xaa,
+	0xe9, 0xe0, 0xa2, 0x98, 0xb4, 0x5e, 0x59, 0x6b, 0xd8, 0x17, 0x32, 0x50, 0xed, 0xa5, 0x02, 0xb3,
+	0xfc, 0x19, 0x28, 0xf2, 0xd3, 0xe0, 0x84, 0x1b, 0xaf, 0xcf, 0xff, 0xc3, 0x48, 0x23, 0x41, 0xf2,
+	0xa7, 0x70, 0xb1, 0xe9, 0x43, 0x1f, 0x5b, 0xc5, 0x50, 0x12, 0xec, 0x50, 0x7d, 0x0b, 0x80, 0x1a,
+	0x9e, 0xfd, 0xf4, 0xf0, 0x12, 0x0e, 0xae, 0x5f, 0x6a, 0xeb, 0xdd, 0xaf, 0xa0, 0x0c, 0x30, 0x66,
+	0x47, 0x84, 0xae, 0xf6, 0x34, 0x01, 0x73, 0xad, 0x43, 0x14, 0xbd, 0x7e, 0x0f, 0x46, 0x1b, 0x4c,
+	0x58, 0xd6, 0xfc, 0x20, 0x5b, 0x7e, 0x6e, 0x59, 0xfe, 0x42, 0x97, 0xaa, 0x2d, 0x4b, 0x58, 0xf3,
+	0x06, 0x2e, 0x31, 0x0d, 0x77, 0x66, 0xa3, 0xe5, 0x0e, 0x30, 0x45, 0xab, 0x70, 0xae, 0x50, 0x75,
+	0x1c, 0x6c, 0xd1, 0x9c, 0x7f, 0x1c, 0x1e, 0xe0, 0x51, 0x21, 0xf6, 0xb1, 0xd1, 0x6d, 0x00, 0x36,
+	0xe9, 0xbc, 0x0f, 0xfb, 0xba, 0xe9, 0x43, 0xf6, 0xb8, 0x67, 0xa7, 0x68, 0x05, 0x86, 0x2d, 0x7c,
+	0x2c, 0x81, 0xf6, 0xcb, 0xa0, 0x43, 0x9e, 0xcc, 0x47, 0xd4, 0xae, 0xc0, 0x74, 0x63, 0x5d, 0x38,
+	0xa8, 0x56, 0x2a, 0x86, 0x53, 0x8f, 0x5f, 0x8d, 0x42, 0x8f, 0x63, 0x13, 0x66, 0xe2, 0xad, 0x44,
+	0xda, 0xb7, 0x61, 0x88, 0xc5, 0xe1, 0x72, 0x99, 0xf8, 0x1a, 0x4f, 0xc7, 0xbd, 0x20, 0x7d, 0x73,
+	0x29, 0x94, 0x41, 0x37, 0x38, 0x5f, 0xff, 0x6e, 0x0c, 0xfa, 0x19, 0x16, 0x3a, 0x86, 0xc1, 0x2d,
+	0x4c, 0xfd, 0xbd, 0x06, 0xcd, 0xc7, 0x7e, 0x62, 0x22, 0x2b, 0x96, 0xba, 0xd0, 0x46, 0x8b, 0x13,
+	0xd6, 0x66, 0x3e, 0xf0, 0x90, 0xdf, 0x7d, 0xf1, 0xdb, 0x67, 0x89, 0x31, 0x34, 0xca, 0xf6, 0x46,
+	0x8f, 0x84, 0xab, 0x7b, 0xdb, 0x12, 0x7a, 0x47, 0x81, 0xe1, 0x2d, 0x4c, 0x83, 0x6d, 0x04, 0x2d,
+	0xc6, 0xba, 0x6d, 0x5a, 0x64, 0xd4, 0xa5, 0xb6, 0x7a, 0x82, 0x40, 0x3a, 0x20, 0x30, 0x8e, 0xc6,
+	0x64, 0x02, 0x4c, 0x17, 0x7d, 0xa8, 0xc0, 0xe8, 0x16, 0xa6, 0xf2, 0x36, 0x82, 0x96, 0x63, 0x9d,
+	0xc7, 0xec, 0x38, 0xea, 0xa5, 0x0e, 0x34, 0x05, 0x91, 0xbf, 0x06, 0x44, 0x26, 0x51, 0x52, 0x22,
+	0xe2, 0xed, 0x2e, 0xfa, 0x89, 0x59, 0x7c, 0x82, 0x4e, 0x58, 0x21, 0x7c, 0xeb, 0x16, 0x85, 0x88,
+	0xac, 0x35, 0xea, 0x42, 0x1b, 0x2d, 0x01, 0x3f, 0x1b, 0xc0, 0x27, 0x11, 0x92, 0xe0, 0x6d, 0x07,
+	0xdb, 0x86, 0x83, 0xa5, 0x2e, 0xf0, 0x3a, 0xee, 0x94, 0x2e, 0x90, 0xda, 0x58, 0x5d, 0x68, 0xa3,
+	0xd5, 0xae, 0x0b, 0x58, 0xd8, 0x47, 0x30, 0xb4, 0x85, 0xe9, 0x26, 0x71, 0xf8, 0x52, 0x82, 0xb4,
+	0xf8, 0x95, 0x41, 0xde, 0x7b, 0xd4, 0x8b, 0xa7, 0xea, 0x08, 0xd8, 0x54, 0x00, 0x3b, 0x8c, 0x06,
+	0x19, 0x2c, 0x5f, 0x72, 0xd0, 0x47, 0x0a, 0x9c, 0xdb, 0xc2, 0x34, 0xfc, 0xf5, 0xbc, 0xd4, 0xc9,
+	0xaa, 0xc2, 0xe1, 0x57, 0x3a, 0xdf, 0x6a, 0xb4, 0xb9, 0x80, 0xc5, 0x04, 0x1a, 0xe7, 0x2c, 0x84,
+	0x62, 0x86, 0x7d, 0x1e, 0xd0, 0x8f, 0x0a, 0x5c, 0x88, 0xb2, 0x09, 0xed, 0x08, 0xe8, 0x5a, 0x7b,
+	0xbc, 0xb8, 0x45, 0x45, 0xbd, 0xde, 0xb5, 0x9d, 0x20, 0xad, 0x07, 0xa4, 0xe7, 0x91, 0x16, 0x43,
+	0x5a, 0x3f, 0x91, 0x3f, 0xbe, 0x4f, 0xd0, 0x53, 0x05, 0x54, 0x29, 0x86, 0xc8, 0x53, 0x1c, 0x6d,
+	0x9c, 0x4a, 0x24, 0x7e, 0x9b, 0x51, 0xaf, 0x74, 0x67, 0x24, 0xa8, 0xdf, 0x0c, 0xa8, 0xeb, 0x28,
+	0x13, 0xa2, 0xee, 0xea, 0x36, 0x37, 0xca, 0xf8, 0x5f, 0x4f, 0xfd, 0x44, 0x7a, 0xcb, 0x3c, 0x41,
+	0x3f, 0x29, 0x30, 0xdd, 0x3a, 0x0a, 0x17, 0x75, 0xc5, 0xa8, 0xd1, 0x2d, 0x57, 0xbb, 0xb4, 0x12,
+	0x81, 0xfc, 0x23, 0x08, 0x64, 0x15, 0x65, 0xdb, 0x04, 0xe2, 0x46, 0x22, 0xf9, 0x41, 0x81, 0x99,
+	0x2d, 0x4c, 0x5b, 0xbe, 0xd7, 0xd1, 0xd5, 0x6e, 0x37, 0x09, 0x1e, 0xcb, 0xb5, 0x3f, 0xb7, 0x80,
+	0x68, 0x99, 0x20, 0x18, 0x0d, 0xcd, 0xb1, 0x60, 0x1a, 0x0d, 0x94, 0x91, 0x1e, 0xcb, 0x19, 0xfe,
+	0x2a, 0x7b, 0xa1, 0x40, 0xba, 0x05, 0x7d, 0xf1, 0xec, 0x46, 0xd7, 0x3b, 0x66, 0x12, 0x5e, 0x26,
+	0xd4, 0x1b, 0xdd, 0x1b, 0x8a, 0x20, 0xfe, 0x15, 0x04, 0xb1, 0x81, 0xd6, 0x4e, 0x09, 0xe2, 0x90,
+	0x1b, 0x46, 0x87, 0xe4, 0x4b, 0x05, 0xc6, 0x63, 0x5e, 0x55, 0x48, 0x8f, 0xbf, 0x46, 0x5b, 0x3e,
+	0x31, 0xd5, 0xd5, 0xce, 0x0d, 0x04, 0xf5, 0x05, 0xc6, 0x7a, 0xf6, 0xa6, 0xb2, 0xa2, 0xa9, 0xe1,
+	0x71, 0x76, 0x31, 0xd5, 0x5d, 0x61, 0x89, 0x3e, 0x57, 0x00, 0x89, 0xcf, 0x80, 0xf4, 0xfe, 0x40,
+	0x7f, 0x6b, 0x79, 0xcf, 0x37, 0xbf, 0x6d, 0xd4, 0xcb, 0x9d, 0x29, 0x0b, 0x62, 0xf3, 0x41, 0x4e,
+	0xa7, 0xd0, 0x79, 0xf9, 0xbb, 0xc8, 0x95, 0xd9, 0x37, 0x62, 0xf3, 0xce, 0xb3, 0x57, 0x69, 0xe5,
+	0xf9, 0xab, 0xb4, 0xf2, 0xf2, 0x55, 0x5a, 0xf9, 0xf8, 0x75, 0xba, 0xe7, 0xf9, 0xeb, 0x74, 0xcf,
+	0xcf, 0xaf, 0xd3, 0x3d, 0x0f, 0x2e, 0x97, 0x4c, 0x7a, 0x58, 0xcd, 0x67, 0x0b, 0xa4, 0xa2, 0xaf,
+	0x1e, 0xef, 0x91, 0x72, 0xbd, 0x44, 0x2c, 0xdd, 0x67, 0x90, 0xa9, 0xad, 0xeb, 0xc7, 0xcc, 0x29,
+	0xad, 0xdb, 0xd8, 0xcd, 0x9f, 0x61, 0x7f, 0xb1, 0xde, 0xf8, 0x63, 0x00, 0x22, 0xa1, 0xfc, 0x31,
+	0x89, 0x17, 0x00, 0x00,
 }
 
-func (m *QueryLatestSpanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetSpanList queries a paginated list of spans.
+	GetSpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error)
+	// GetLatestSpan queries the latest span.
+	GetLatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error)
+	// GetNextSpanSeed queries the seed for generating the next span.
+	// The seed is used for deterministic random selection of producers.
+	GetNextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error)
+	// GetNextSpan prepares and returns the next span based on the current
+	// validator set. This is used by proposers to create span proposals.
+	GetNextSpan(ctx context.Context, in *QueryNextSpanRequest, opts ...grpc.CallOption) (*QueryNextSpanResponse, error)
+	// GetSpanById retrieves a specific span by its ID.
+	GetSpanById(ctx context.Context, in *QuerySpanByIdRequest, opts ...grpc.CallOption) (*QuerySpanByIdResponse, error)
+	// GetBorParams queries the parameters of x/bor module.
+	GetBorParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetProducerVotes queries producer votes from all validators.
+	// Returns a map of validator ID to their producer votes.
+	GetProducerVotes(ctx context.Context, in *QueryProducerVotesRequest, opts ...grpc.CallOption) (*QueryProducerVotesResponse, error)
+	// GetProducerVotesByValidatorId queries the producer votes cast by a specific
+	// validator.
+	GetProducerVotesByValidatorId(ctx context.Context, in *QueryProducerVotesByValidatorIdRequest, opts ...grpc.CallOption) (*QueryProducerVotesByValidatorIdResponse, error)
+	// GetProducerPlannedDowntime queries the planned downtime window for a
+	// specific producer. Producers can signal planned maintenance periods during
+	// which they won't produce blocks. When the producer planned several
+	// windows, the first one is returned.
+	GetProducerPlannedDowntime(ctx context.Context, in *QueryProducerPlannedDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimeResponse, error)
+	// GetProducerPlannedDowntimes queries all the planned downtime windows of a
+	// specific producer, ordered by start block.
+	GetProducerPlannedDowntimes(ctx context.Context, in *QueryProducerPlannedDowntimesRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimesResponse, error)
+	// GetValidatorPerformanceScore queries the performance scores of all
+	// validators. Performance scores track block production reliability.
+	GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error)
+	// GetValidatorPerformanceHistory queries the performance of a validator
+	// during each span of a span range, as recorded from the milestones.
+	GetValidatorPerformanceHistory(ctx context.Context, in *QueryValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceHistoryResponse, error)
+	// SimulateProducerSet simulates the election of the producer of the next
+	// span, with hypothetical producer votes and planned downtimes applied on
+	// top of the current state, which is left unchanged.
+	SimulateProducerSet(ctx context.Context, in *QuerySimulateProducerSetRequest, opts ...grpc.CallOption) (*QuerySimulateProducerSetResponse, error)
+	// GetSpanSummaryById queries the summary of a span whose full body was
+	// pruned.
+	GetSpanSummaryById(ctx context.Context, in *QuerySpanSummaryByIdRequest, opts ...grpc.CallOption) (*QuerySpanSummaryByIdResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetSpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error) {
+	out := new(QuerySpanListResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetSpanList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLatestSpanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error) {
+	out := new(QueryLatestSpanResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetLatestSpan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLatestSpanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetNextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error) {
+	out := new(QueryNextSpanSeedResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetNextSpanSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNextSpan(ctx context.Context, in *QueryNextSpanRequest, opts ...grpc.CallOption) (*QueryNextSpanResponse, error) {
+	out := new(QueryNextSpanResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetNextSpan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetSpanById(ctx context.Context, in *QuerySpanByIdRequest, opts ...grpc.CallOption) (*QuerySpanByIdResponse, error) {
+	out := new(QuerySpanByIdResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetSpanById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetBorParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetBorParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProducerVotes(ctx context.Context, in *QueryProducerVotesRequest, opts ...grpc.CallOption) (*QueryProducerVotesResponse, error) {
+	out := new(QueryProducerVotesResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerVotes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProducerVotesByValidatorId(ctx context.Context, in *QueryProducerVotesByValidatorIdRequest, opts ...grpc.CallOption) (*QueryProducerVotesByValidatorIdResponse, error) {
+	out := new(QueryProducerVotesByValidatorIdResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerVotesByValidatorId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNextSpanSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SeedAuthor) > 0 {
-		i -= len(m.SeedAuthor)
-		copy(dAtA[i:], m.SeedAuthor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SeedAuthor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Seed) > 0 {
-		i -= len(m.Seed)
-		copy(dAtA[i:], m.Seed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetProducerPlannedDowntime(ctx context.Context, in *QueryProducerPlannedDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimeResponse, error) {
+	out := new(QueryProducerPlannedDowntimeResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerPlannedDowntime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNextSpanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProducerPlannedDowntimes(ctx context.Context, in *QueryProducerPlannedDowntimesRequest, opts ...grpc.CallOption) (*QueryProducerPlannedDowntimesResponse, error) {
+	out := new(QueryProducerPlannedDowntimesResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetProducerPlannedDowntimes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNextSpanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetValidatorPerformanceScore(ctx context.Context, in *QueryValidatorPerformanceScoreRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceScoreResponse, error) {
+	out := new(QueryValidatorPerformanceScoreResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetValidatorPerformanceScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNextSpanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BorChainId) > 0 {
-		i -= len(m.BorChainId)
-		copy(dAtA[i:], m.BorChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.StartBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SpanId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpanId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetValidatorPerformanceHistory(ctx context.Context, in *QueryValidatorPerformanceHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceHistoryResponse, error) {
+	out := new(QueryValidatorPerformanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetValidatorPerformanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNextSpanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateProducerSet(ctx context.Context, in *QuerySimulateProducerSetRequest, opts ...grpc.CallOption) (*QuerySimulateProducerSetResponse, error) {
+	out := new(QuerySimulateProducerSetResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/SimulateProducerSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNextSpanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetSpanSummaryById(ctx context.Context, in *QuerySpanSummaryByIdRequest, opts ...grpc.CallOption) (*QuerySpanSummaryByIdResponse, error) {
+	out := new(QuerySpanSummaryByIdResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Query/GetSpanSummaryById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNextSpanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetSpanList queries a paginated list of spans.
+	GetSpanList(context.Context, *QuerySpanListRequest) (*QuerySpanListResponse, error)
+	// GetLatestSpan queries the latest span.
+	GetLatestSpan(context.Context, *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error)
+	// GetNextSpanSeed queries the seed for generating the next span.
+	// The seed is used for deterministic random selection of producers.
+	GetNextSpanSeed(context.Context, *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error)
+	// GetNextSpan prepares and returns the next span based on the current
+	// validator set. This is used by proposers to create span proposals.
+	GetNextSpan(context.Context, *QueryNextSpanRequest) (*QueryNextSpanResponse, error)
+	// GetSpanById retrieves a specific span by its ID.
+	GetSpanById(context.Context, *QuerySpanByIdRequest) (*QuerySpanByIdResponse, error)
+	// GetBorParams queries the parameters of x/bor module.
+	GetBorParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetProducerVotes queries producer votes from all validators.
+	// Returns a map of validator ID to their producer votes.
+	GetProducerVotes(context.Context, *QueryProducerVotesRequest) (*QueryProducerVotesResponse, error)
+	// GetProducerVotesByValidatorId queries the producer votes cast by a specific
+	// validator.
+	GetProducerVotesByValidatorId(context.Context, *QueryProducerVotesByValidatorIdRequest) (*QueryProducerVotesByValidatorIdResponse, error)
+	// GetProducerPlannedDowntime queries the planned downtime window for a
+	// specific producer. Producers can signal planned maintenance periods during
+	// which they won't produce blocks. When the producer planned several
+	// windows, the first one is returned.
+	GetProducerPlannedDowntime(context.Context, *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error)
+	// GetProducerPlannedDowntimes queries all the planned downtime windows of a
+	// specific producer, ordered by start block.
+	GetProducerPlannedDowntimes(context.Context, *QueryProducerPlannedDowntimesRequest) (*QueryProducerPlannedDowntimesResponse, error)
+	// GetValidatorPerformanceScore queries the performance scores of all
+	// validators. Performance scores track block production reliability.
+	GetValidatorPerformanceScore(context.Context, *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error)
+	// GetValidatorPerformanceHistory queries the performance of a validator
+	// during each span of a span range, as recorded from the milestones.
+	GetValidatorPerformanceHistory(context.Context, *QueryValidatorPerformanceHistoryRequest) (*QueryValidatorPerformanceHistoryResponse, error)
+	// SimulateProducerSet simulates the election of the producer of the next
+	// span, with hypothetical producer votes and planned downtimes applied on
+	// top of the current state, which is left unchanged.
+	SimulateProducerSet(context.Context, *QuerySimulateProducerSetRequest) (*QuerySimulateProducerSetResponse, error)
+	// GetSpanSummaryById queries the summary of a span whose full body was
+	// pruned.
+	GetSpanSummaryById(context.Context, *QuerySpanSummaryByIdRequest) (*QuerySpanSummaryByIdResponse, error)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetSpanList(ctx context.Context, req *QuerySpanListRequest) (*QuerySpanListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpanList not implemented")
+}
+func (*UnimplementedQueryServer) GetLatestSpan(ctx context.Context, req *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestSpan not implemented")
+}
+func (*UnimplementedQueryServer) GetNextSpanSeed(ctx context.Context, req *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextSpanSeed not implemented")
+}
+func (*UnimplementedQueryServer) GetNextSpan(ctx context.Context, req *QueryNextSpanRequest) (*QueryNextSpanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextSpan not implemented")
+}
+func (*UnimplementedQueryServer) GetSpanById(ctx context.Context, req *QuerySpanByIdRequest) (*QuerySpanByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpanById not implemented")
+}
+func (*UnimplementedQueryServer) GetBorParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorParams not implemented")
+}
+func (*UnimplementedQueryServer) GetProducerVotes(ctx context.Context, req *QueryProducerVotesRequest) (*QueryProducerVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerVotes not implemented")
+}
+func (*UnimplementedQueryServer) GetProducerVotesByValidatorId(ctx context.Context, req *QueryProducerVotesByValidatorIdRequest) (*QueryProducerVotesByValidatorIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerVotesByValidatorId not implemented")
+}
+func (*UnimplementedQueryServer) GetProducerPlannedDowntime(ctx context.Context, req *QueryProducerPlannedDowntimeRequest) (*QueryProducerPlannedDowntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerPlannedDowntime not implemented")
+}
+func (*UnimplementedQueryServer) GetProducerPlannedDowntimes(ctx context.Context, req *QueryProducerPlannedDowntimesRequest) (*QueryProducerPlannedDowntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerPlannedDowntimes not implemented")
+}
+func (*UnimplementedQueryServer) GetValidatorPerformanceScore(ctx context.Context, req *QueryValidatorPerformanceScoreRequest) (*QueryValidatorPerformanceScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceScore not implemented")
+}
+func (*UnimplementedQueryServer) GetValidatorPerformanceHistory(ctx context.Context, req *QueryValidatorPerformanceHistoryRequest) (*QueryValidatorPerformanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformanceHistory not implemented")
+}
+func (*UnimplementedQueryServer) SimulateProducerSet(ctx context.Context, req *QuerySimulateProducerSetRequest) (*QuerySimulateProducerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProducerSet not implemented")
+}
+func (*UnimplementedQueryServer) GetSpanSummaryById(ctx context.Context, req *QuerySpanSummaryByIdRequest) (*QuerySpanSummaryByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpanSummaryById not implemented")
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetSpanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetSpanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetSpanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSpanList(ctx, req.(*QuerySpanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetLatestSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestSpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetLatestSpan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetLatestSpan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLatestSpan(ctx, req.(*QueryLatestSpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetNextSpanSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSpanSeedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextSpanSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetNextSpanSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextSpanSeed(ctx, req.(*QueryNextSpanSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetNextSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextSpan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetNextSpan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextSpan(ctx, req.(*QueryNextSpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetSpanById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSpanById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetSpanById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSpanById(ctx, req.(*QuerySpanByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetBorParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProducerVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProducerVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetProducerVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProducerVotes(ctx, req.(*QueryProducerVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllVotes) > 0 {
-		for k := range m.AllVotes {
-			v := m.AllVotes[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetProducerVotesByValidatorId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerVotesByValidatorIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProducerVotesByValidatorId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetProducerVotesByValidatorId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProducerVotesByValidatorId(ctx, req.(*QueryProducerVotesByValidatorIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesByValidatorIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProducerPlannedDowntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerPlannedDowntimeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProducerPlannedDowntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetProducerPlannedDowntime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProducerPlannedDowntime(ctx, req.(*QueryProducerPlannedDowntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesByValidatorIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProducerPlannedDowntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerPlannedDowntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProducerPlannedDowntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetProducerPlannedDowntimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProducerPlannedDowntimes(ctx, req.(*QueryProducerPlannedDowntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesByValidatorIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetValidatorPerformanceScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformanceScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetValidatorPerformanceScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformanceScore(ctx, req.(*QueryValidatorPerformanceScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProducerVotesByValidatorIdResponse) Marshal() (dAtA []byte, err error) {
+func _Query_GetValidatorPerformanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetValidatorPerformanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformanceHistory(ctx, req.(*QueryValidatorPerformanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProducerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProducerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProducerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/SimulateProducerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProducerSet(ctx, req.(*QuerySimulateProducerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSpanSummaryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanSummaryByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSpanSummaryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Query/GetSpanSummaryById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSpanSummaryById(ctx, req.(*QuerySpanSummaryByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.bor.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSpanList",
+			Handler:    _Query_GetSpanList_Handler,
+		},
+		{
+			MethodName: "GetLatestSpan",
+			Handler:    _Query_GetLatestSpan_Handler,
+		},
+		{
+			MethodName: "GetNextSpanSeed",
+			Handler:    _Query_GetNextSpanSeed_Handler,
+		},
+		{
+			MethodName: "GetNextSpan",
+			Handler:    _Query_GetNextSpan_Handler,
+		},
+		{
+			MethodName: "GetSpanById",
+			Handler:    _Query_GetSpanById_Handler,
+		},
+		{
+			MethodName: "GetBorParams",
+			Handler:    _Query_GetBorParams_Handler,
+		},
+		{
+			MethodName: "GetProducerVotes",
+			Handler:    _Query_GetProducerVotes_Handler,
+		},
+		{
+			MethodName: "GetProducerVotesByValidatorId",
+			Handler:    _Query_GetProducerVotesByValidatorId_Handler,
+		},
+		{
+			MethodName: "GetProducerPlannedDowntime",
+			Handler:    _Query_GetProducerPlannedDowntime_Handler,
+		},
+		{
+			MethodName: "GetProducerPlannedDowntimes",
+			Handler:    _Query_GetProducerPlannedDowntimes_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformanceScore",
+			Handler:    _Query_GetValidatorPerformanceScore_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformanceHistory",
+			Handler:    _Query_GetValidatorPerformanceHistory_Handler,
+		},
+		{
+			MethodName: "SimulateProducerSet",
+			Handler:    _Query_SimulateProducerSet_Handler,
+		},
+		{
+			MethodName: "GetSpanSummaryById",
+			Handler:    _Query_GetSpanSummaryById_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdallv2/bor/query.proto",
+}
+
+func (m *QuerySpanByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2012,38 +2350,27 @@ func (m *QueryProducerVotesByValidatorIdResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryProducerVotesByValidatorIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpanByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProducerVotesByValidatorIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpanByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Votes) > 0 {
-		dAtA9 := make([]byte, len(m.Votes)*10)
-		var j8 int
-		for _, num := range m.Votes {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerPlannedDowntimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpanByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2053,25 +2380,32 @@ func (m *QueryProducerPlannedDowntimeRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryProducerPlannedDowntimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpanByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProducerPlannedDowntimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpanByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProducerId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProducerId))
+	if m.Span != nil {
+		{
+			size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerPlannedDowntimeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpanListRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2081,18 +2415,18 @@ func (m *QueryProducerPlannedDowntimeResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryProducerPlannedDowntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpanListRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProducerPlannedDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpanListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.DowntimeRange.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2104,7 +2438,7 @@ func (m *QueryProducerPlannedDowntimeResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorPerformanceScoreRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpanListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2114,21 +2448,45 @@ func (m *QueryValidatorPerformanceScoreRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorPerformanceScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpanListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorPerformanceScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpanListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryValidatorPerformanceScoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SpanList) > 0 {
+		for iNdEx := len(m.SpanList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpanList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSpanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -2137,309 +2495,2649 @@ func (m *QueryValidatorPerformanceScoreResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorPerformanceScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLatestSpanRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorPerformanceScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLatestSpanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorPerformanceScore) > 0 {
-		for k := range m.ValidatorPerformanceScore {
-			v := m.ValidatorPerformanceScore[k]
-			baseI := i
-			i = encodeVarintQuery(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QuerySpanByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QuerySpanByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Span != nil {
-		l = m.Span.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLatestSpanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySpanListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pagination.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryLatestSpanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpanListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLatestSpanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpanList) > 0 {
-		for _, e := range m.SpanList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.Pagination.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestSpanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextSpanSeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLatestSpanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Span.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryNextSpanSeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextSpanSeedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNextSpanSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextSpanSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextSpanSeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSpanSeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSpanSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SeedAuthor) > 0 {
+		i -= len(m.SeedAuthor)
+		copy(dAtA[i:], m.SeedAuthor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SeedAuthor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SeedAuthor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextSpanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextSpanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSpanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSpanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpanId != 0 {
-		n += 1 + sovQuery(uint64(m.SpanId))
+	if len(m.BorChainId) > 0 {
+		i -= len(m.BorChainId)
+		copy(dAtA[i:], m.BorChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.StartBlock != 0 {
-		n += 1 + sovQuery(uint64(m.StartBlock))
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BorChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextSpanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextSpanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Span.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextSpanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSpanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.AllVotes) > 0 {
-		for k, v := range m.AllVotes {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		for k := range m.AllVotes {
+			v := m.AllVotes[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerVotesByValidatorIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerVotesByValidatorIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerVotesByValidatorIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerVotesByValidatorIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ValidatorId != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerVotesByValidatorIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerVotesByValidatorIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerVotesByValidatorIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerVotesByValidatorIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Votes) > 0 {
-		l = 0
-		for _, e := range m.Votes {
-			l += sovQuery(uint64(e))
+		dAtA9 := make([]byte, len(m.Votes)*10)
+		var j8 int
+		for _, num := range m.Votes {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerPlannedDowntimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerPlannedDowntimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerPlannedDowntimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerPlannedDowntimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ProducerId != 0 {
-		n += 1 + sovQuery(uint64(m.ProducerId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProducerPlannedDowntimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerPlannedDowntimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerPlannedDowntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerPlannedDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DowntimeRange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProducerPlannedDowntimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerPlannedDowntimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerPlannedDowntimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProducerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProducerPlannedDowntimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerPlannedDowntimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerPlannedDowntimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DowntimeRanges) > 0 {
+		for iNdEx := len(m.DowntimeRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPerformanceScore) > 0 {
+		for k := range m.ValidatorPerformanceScore {
+			v := m.ValidatorPerformanceScore[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToSpanId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromSpanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedProducerVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedProducerVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedProducerVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		dAtA12 := make([]byte, len(m.Votes)*10)
+		var j11 int
+		for _, num := range m.Votes {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedProducerDowntime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedProducerDowntime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedProducerDowntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DowntimeRange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProducerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiredScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProducerSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProducerSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProducerSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProducerDowntimes) > 0 {
+		for iNdEx := len(m.ProducerDowntimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProducerDowntimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProducerVotes) > 0 {
+		for iNdEx := len(m.ProducerVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProducerVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProducerSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProducerSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProducerSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextProducer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextProducer))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.SpanRange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentProducer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentProducer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProducerSet) > 0 {
+		dAtA16 := make([]byte, len(m.ProducerSet)*10)
+		var j15 int
+		for _, num := range m.ProducerSet {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProducerScores) > 0 {
+		for iNdEx := len(m.ProducerScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProducerScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpanSummaryByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpanSummaryByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanSummaryByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpanSummaryByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpanSummaryByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanSummaryByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpanSummary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySpanByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpanByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Span != nil {
+		l = m.Span.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpanListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpanListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpanList) > 0 {
+		for _, e := range m.SpanList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestSpanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestSpanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Span.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNextSpanSeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryNextSpanSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SeedAuthor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSpanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovQuery(uint64(m.SpanId))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.StartBlock))
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSpanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Span.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProducerVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProducerVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllVotes) > 0 {
+		for k, v := range m.AllVotes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryProducerVotesByValidatorIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	return n
+}
+
+func (m *QueryProducerVotesByValidatorIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		l = 0
+		for _, e := range m.Votes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryProducerPlannedDowntimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProducerId != 0 {
+		n += 1 + sovQuery(uint64(m.ProducerId))
+	}
+	return n
+}
+
+func (m *QueryProducerPlannedDowntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DowntimeRange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProducerPlannedDowntimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProducerId != 0 {
+		n += 1 + sovQuery(uint64(m.ProducerId))
+	}
+	return n
+}
+
+func (m *QueryProducerPlannedDowntimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DowntimeRanges) > 0 {
+		for _, e := range m.DowntimeRanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorPerformanceScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorPerformanceScore) > 0 {
+		for k, v := range m.ValidatorPerformanceScore {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	if m.FromSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.FromSpanId))
+	}
+	if m.ToSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.ToSpanId))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedProducerVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	if len(m.Votes) > 0 {
+		l = 0
+		for _, e := range m.Votes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SimulatedProducerDowntime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProducerId != 0 {
+		n += 1 + sovQuery(uint64(m.ProducerId))
+	}
+	l = m.DowntimeRange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ProducerScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	if m.RequiredScore != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredScore))
+	}
+	return n
+}
+
+func (m *QuerySimulateProducerSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProducerVotes) > 0 {
+		for _, e := range m.ProducerVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProducerDowntimes) > 0 {
+		for _, e := range m.ProducerDowntimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateProducerSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProducerScores) > 0 {
+		for _, e := range m.ProducerScores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProducerSet) > 0 {
+		l = 0
+		for _, e := range m.ProducerSet {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.CurrentProducer != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentProducer))
+	}
+	l = m.SpanRange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextProducer != 0 {
+		n += 1 + sovQuery(uint64(m.NextProducer))
+	}
+	return n
+}
+
+func (m *QuerySpanSummaryByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySpanSummaryByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpanSummary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySpanByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Span == nil {
+				m.Span = &Span{}
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanList = append(m.SpanList, Span{})
+			if err := m.SpanList[len(m.SpanList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSpanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSpanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSpanSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSpanSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSpanSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSpanSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeedAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSpanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSpanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSpanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.DowntimeRange.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryValidatorPerformanceScoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *QueryProducerVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProducerVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProducerVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryValidatorPerformanceScoreResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorPerformanceScore) > 0 {
-		for k, v := range m.ValidatorPerformanceScore {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + sovQuery(uint64(v))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	return nil
+}
+func (m *QueryProducerVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProducerVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProducerVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllVotes == nil {
+				m.AllVotes = make(map[uint64]ProducerVotes)
+			}
+			var mapkey uint64
+			mapvalue := &ProducerVotes{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ProducerVotes{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllVotes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySpanByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerVotesByValidatorIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2462,17 +5160,17 @@ func (m *QuerySpanByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
 			}
-			var stringLen uint64
+			m.ValidatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2482,24 +5180,11 @@ func (m *QuerySpanByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ValidatorId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2521,7 +5206,7 @@ func (m *QuerySpanByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerVotesByValidatorIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2544,48 +5229,88 @@ func (m *QuerySpanByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Votes = append(m.Votes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Votes) == 0 {
+					m.Votes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Votes = append(m.Votes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Span == nil {
-				m.Span = &Span{}
-			}
-			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2607,7 +5332,7 @@ func (m *QuerySpanByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerPlannedDowntimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2630,17 +5355,17 @@ func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
 			}
-			var msglen int
+			m.ProducerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2650,25 +5375,11 @@ func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProducerId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2690,7 +5401,7 @@ func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerPlannedDowntimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2713,49 +5424,15 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpanList = append(m.SpanList, Span{})
-			if err := m.SpanList[len(m.SpanList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2782,7 +5459,7 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DowntimeRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2807,7 +5484,7 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerPlannedDowntimesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2830,12 +5507,31 @@ func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestSpanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+			}
+			m.ProducerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2857,7 +5553,7 @@ func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerPlannedDowntimesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2880,15 +5576,15 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestSpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerPlannedDowntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeRanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2915,7 +5611,8 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DowntimeRanges = append(m.DowntimeRanges, BlockRange{})
+			if err := m.DowntimeRanges[len(m.DowntimeRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2940,7 +5637,7 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceScoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2954,40 +5651,21 @@ func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanSeedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3009,7 +5687,7 @@ func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceScoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3032,17 +5710,17 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformanceScore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3052,55 +5730,90 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seed = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedAuthor", wireType)
+			if m.ValidatorPerformanceScore == nil {
+				m.ValidatorPerformanceScore = make(map[uint64]uint64)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SeedAuthor = string(dAtA[iNdEx:postIndex])
+			m.ValidatorPerformanceScore[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3123,7 +5836,7 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,17 +5859,17 @@ func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
 			}
-			m.SpanId = 0
+			m.ValidatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3166,16 +5879,16 @@ func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpanId |= uint64(b&0x7F) << shift
+				m.ValidatorId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpanId", wireType)
 			}
-			m.StartBlock = 0
+			m.FromSpanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3185,16 +5898,16 @@ func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlock |= uint64(b&0x7F) << shift
+				m.FromSpanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpanId", wireType)
 			}
-			var stringLen uint64
+			m.ToSpanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3204,24 +5917,11 @@ func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToSpanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BorChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3243,7 +5943,7 @@ func (m *QueryNextSpanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,15 +5966,15 @@ func (m *QueryNextSpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3301,7 +6001,8 @@ func (m *QueryNextSpanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3326,7 +6027,7 @@ func (m *QueryNextSpanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulatedProducerVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,16 +6046,111 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedProducerVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedProducerVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Votes = append(m.Votes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Votes) == 0 {
+					m.Votes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Votes = append(m.Votes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3376,7 +6172,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulatedProducerDowntime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3399,15 +6195,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedProducerDowntime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedProducerDowntime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+			}
+			m.ProducerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3434,7 +6249,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DowntimeRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3459,7 +6274,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProducerVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *ProducerScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3482,12 +6297,69 @@ func (m *QueryProducerVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProducerScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProducerScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredScore", wireType)
+			}
+			m.RequiredScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3509,7 +6381,7 @@ func (m *QueryProducerVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProducerVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProducerSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3532,15 +6404,15 @@ func (m *QueryProducerVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProducerSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProducerSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerVotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3567,91 +6439,44 @@ func (m *QueryProducerVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllVotes == nil {
-				m.AllVotes = make(map[uint64]ProducerVotes)
+			m.ProducerVotes = append(m.ProducerVotes, SimulatedProducerVotes{})
+			if err := m.ProducerVotes[len(m.ProducerVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			mapvalue := &ProducerVotes{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerDowntimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ProducerVotes{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AllVotes[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerDowntimes = append(m.ProducerDowntimes, SimulatedProducerDowntime{})
+			if err := m.ProducerDowntimes[len(m.ProducerDowntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3674,7 +6499,7 @@ func (m *QueryProducerVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProducerVotesByValidatorIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProducerSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3697,17 +6522,17 @@ func (m *QueryProducerVotesByValidatorIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProducerSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProducerSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerScores", wireType)
 			}
-			m.ValidatorId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3717,62 +6542,27 @@ func (m *QueryProducerVotesByValidatorIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProducerVotesByValidatorIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProducerScores = append(m.ProducerScores, ProducerScore{})
+			if err := m.ProducerScores[len(m.ProducerScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerVotesByValidatorIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3789,7 +6579,7 @@ func (m *QueryProducerVotesByValidatorIdResponse) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Votes = append(m.Votes, v)
+				m.ProducerSet = append(m.ProducerSet, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3824,8 +6614,8 @@ func (m *QueryProducerVotesByValidatorIdResponse) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Votes) == 0 {
-					m.Votes = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.ProducerSet) == 0 {
+					m.ProducerSet = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -3843,66 +6633,16 @@ func (m *QueryProducerVotesByValidatorIdResponse) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Votes = append(m.Votes, v)
+					m.ProducerSet = append(m.ProducerSet, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProducerPlannedDowntimeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerSet", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerPlannedDowntimeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerPlannedDowntimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentProducer", wireType)
 			}
-			m.ProducerId = 0
+			m.CurrentProducer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3912,64 +6652,14 @@ func (m *QueryProducerPlannedDowntimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProducerId |= uint64(b&0x7F) << shift
+				m.CurrentProducer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProducerPlannedDowntimeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProducerPlannedDowntimeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProducerPlannedDowntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3996,10 +6686,29 @@ func (m *QueryProducerPlannedDowntimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DowntimeRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpanRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProducer", wireType)
+			}
+			m.NextProducer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProducer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4021,7 +6730,7 @@ func (m *QueryProducerPlannedDowntimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPerformanceScoreRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanSummaryByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4044,12 +6753,31 @@ func (m *QueryValidatorPerformanceScoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanSummaryByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPerformanceScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanSummaryByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4071,7 +6799,7 @@ func (m *QueryValidatorPerformanceScoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPerformanceScoreResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanSummaryByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4094,15 +6822,15 @@ func (m *QueryValidatorPerformanceScoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanSummaryByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPerformanceScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanSummaryByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformanceScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanSummary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4129,75 +6857,9 @@ func (m *QueryValidatorPerformanceScoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatorPerformanceScore == nil {
-				m.ValidatorPerformanceScore = make(map[uint64]uint64)
-			}
-			var mapkey uint64
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SpanSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorPerformanceScore[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -574,6 +574,100 @@ func (m *MsgSetProducerDowntimeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetProducerDowntimeResponse proto.InternalMessageInfo
 
+// MsgCancelProducerDowntime defines the message for cancelling a planned
+// downtime window of a producer.
+type MsgCancelProducerDowntime struct {
+	// Address of the producer cancelling its downtime.
+	Producer string `protobuf:"bytes,1,opt,name=producer,proto3" json:"producer,omitempty"`
+	// First block of the planned downtime window to cancel.
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+}
+
+func (m *MsgCancelProducerDowntime) Reset()         { *m = MsgCancelProducerDowntime{} }
+func (m *MsgCancelProducerDowntime) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProducerDowntime) ProtoMessage()    {}
+func (*MsgCancelProducerDowntime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bf2ab4e186306bb, []int{10}
+}
+func (m *MsgCancelProducerDowntime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProducerDowntime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProducerDowntime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProducerDowntime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProducerDowntime.Merge(m, src)
+}
+func (m *MsgCancelProducerDowntime) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProducerDowntime) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProducerDowntime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProducerDowntime proto.InternalMessageInfo
+
+func (m *MsgCancelProducerDowntime) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *MsgCancelProducerDowntime) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+// MsgCancelProducerDowntimeResponse defines the response for
+// MsgCancelProducerDowntime.
+type MsgCancelProducerDowntimeResponse struct {
+}
+
+func (m *MsgCancelProducerDowntimeResponse) Reset()         { *m = MsgCancelProducerDowntimeResponse{} }
+func (m *MsgCancelProducerDowntimeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProducerDowntimeResponse) ProtoMessage()    {}
+func (*MsgCancelProducerDowntimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bf2ab4e186306bb, []int{11}
+}
+func (m *MsgCancelProducerDowntimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProducerDowntimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProducerDowntimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProducerDowntimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProducerDowntimeResponse.Merge(m, src)
+}
+func (m *MsgCancelProducerDowntimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProducerDowntimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProducerDowntimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProducerDowntimeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgProposeSpan)(nil), "heimdallv2.bor.MsgProposeSpan")
 	proto.RegisterType((*MsgProposeSpanResponse)(nil), "heimdallv2.bor.MsgProposeSpanResponse")
@@ -585,64 +679,69 @@ func init() {
 	proto.RegisterType((*MsgVoteProducersResponse)(nil), "heimdallv2.bor.MsgVoteProducersResponse")
 	proto.RegisterType((*MsgSetProducerDowntime)(nil), "heimdallv2.bor.MsgSetProducerDowntime")
 	proto.RegisterType((*MsgSetProducerDowntimeResponse)(nil), "heimdallv2.bor.MsgSetProducerDowntimeResponse")
+	proto.RegisterType((*MsgCancelProducerDowntime)(nil), "heimdallv2.bor.MsgCancelProducerDowntime")
+	proto.RegisterType((*MsgCancelProducerDowntimeResponse)(nil), "heimdallv2.bor.MsgCancelProducerDowntimeResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/bor/tx.proto", fileDescriptor_2bf2ab4e186306bb) }
 
 var fileDescriptor_2bf2ab4e186306bb = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xd3, 0x34, 0x69, 0x26, 0xdd, 0xec, 0x32, 0xac, 0x36, 0xae, 0xd1, 0x3a, 0x51, 0xc4,
-	0x8f, 0xa8, 0xec, 0xda, 0x6c, 0x40, 0xa0, 0xe6, 0x80, 0xd4, 0x08, 0x21, 0x45, 0x22, 0x52, 0xe4,
-	0xa8, 0x08, 0xc1, 0x21, 0x72, 0xe2, 0xc1, 0xb1, 0x1a, 0x7b, 0xac, 0x99, 0x69, 0x68, 0x6f, 0x08,
-	0x6e, 0x9c, 0xf8, 0x33, 0x38, 0xf6, 0x80, 0x04, 0x7f, 0x42, 0x2f, 0x48, 0x15, 0x27, 0x4e, 0x08,
-	0xb5, 0x12, 0x15, 0xff, 0x05, 0x9a, 0x19, 0x3b, 0xc9, 0x24, 0xde, 0x36, 0x97, 0xda, 0xf3, 0xde,
-	0x37, 0x6f, 0xde, 0xf7, 0xbd, 0xcf, 0xd3, 0x80, 0xda, 0x14, 0x05, 0xa1, 0xe7, 0xce, 0x66, 0xf3,
-	0xb6, 0x3d, 0xc6, 0xc4, 0x66, 0xe7, 0x56, 0x4c, 0x30, 0xc3, 0xb0, 0xba, 0x4c, 0x58, 0x63, 0x4c,
-	0x8c, 0x37, 0xdc, 0x30, 0x88, 0xb0, 0x2d, 0xfe, 0x4a, 0x88, 0x51, 0x9b, 0x60, 0x1a, 0x62, 0x6a,
-	0x87, 0xd4, 0xb7, 0xe7, 0xaf, 0xf8, 0x23, 0x49, 0x1c, 0xc8, 0xc4, 0x48, 0xac, 0x6c, 0xb9, 0x48,
-	0x52, 0x4f, 0x7d, 0xec, 0x63, 0x19, 0xe7, 0x6f, 0x49, 0x54, 0x5f, 0xeb, 0x62, 0x8c, 0x89, 0xcc,
-	0x34, 0x7f, 0xcf, 0x83, 0x6a, 0x9f, 0xfa, 0x03, 0x82, 0x63, 0x4c, 0xd1, 0x30, 0x76, 0x23, 0x58,
-	0x03, 0x25, 0x1a, 0xbb, 0xd1, 0x28, 0xf0, 0x74, 0xad, 0xa1, 0xb5, 0x0a, 0x4e, 0x91, 0x2f, 0x7b,
-	0x1e, 0xfc, 0x08, 0xec, 0xc5, 0x12, 0x47, 0xf4, 0x7c, 0x43, 0x6b, 0x95, 0xbb, 0xfa, 0x9f, 0xbf,
-	0xbe, 0x7c, 0x9a, 0x9c, 0x7f, 0xec, 0x79, 0x04, 0x51, 0x3a, 0x64, 0x24, 0x88, 0x7c, 0x67, 0x81,
-	0x84, 0x75, 0x50, 0xa1, 0xcc, 0x25, 0x6c, 0x34, 0x9e, 0xe1, 0xc9, 0xa9, 0xbe, 0x23, 0x4a, 0x02,
-	0x11, 0xea, 0xf2, 0x08, 0x7c, 0x0b, 0x94, 0x51, 0xe4, 0x25, 0xe9, 0x82, 0x48, 0xef, 0xa1, 0xc8,
-	0x93, 0xc9, 0x03, 0xb0, 0x37, 0x99, 0xba, 0x81, 0xe8, 0x66, 0x97, 0x9f, 0xe9, 0x94, 0xc4, 0xba,
-	0xe7, 0x41, 0x08, 0x0a, 0x14, 0x21, 0x4f, 0x2f, 0x36, 0xb4, 0xd6, 0xbe, 0x23, 0xde, 0xe1, 0x11,
-	0xa8, 0xf0, 0xe7, 0xc8, 0x3d, 0x63, 0x53, 0x4c, 0xf4, 0xd2, 0x03, 0x5d, 0x02, 0x0e, 0x3e, 0x16,
-	0xd8, 0x8e, 0xfd, 0xc3, 0xdd, 0xe5, 0xe1, 0xa2, 0xed, 0x9f, 0xee, 0x2e, 0x0f, 0x9f, 0xaf, 0xa9,
-	0xa6, 0xea, 0xd4, 0xd4, 0xc1, 0x33, 0x35, 0xe2, 0x20, 0x1a, 0xe3, 0x88, 0xa2, 0xe6, 0x6f, 0x1a,
-	0x78, 0xdc, 0xa7, 0xfe, 0x49, 0xec, 0xb9, 0x0c, 0x0d, 0x5c, 0xe2, 0x86, 0x14, 0x7e, 0x0c, 0xca,
-	0xb2, 0xa9, 0x80, 0x5d, 0x08, 0x5d, 0xef, 0xeb, 0x6b, 0x09, 0x85, 0x47, 0xa0, 0x18, 0x8b, 0x0a,
-	0x42, 0xf2, 0x4a, 0xfb, 0x99, 0xa5, 0x1a, 0xc7, 0x92, 0xf5, 0xbb, 0xe5, 0xab, 0xbf, 0xeb, 0xb9,
-	0x5f, 0xee, 0x2e, 0x0f, 0x35, 0x27, 0xd9, 0xd0, 0x79, 0xc5, 0x19, 0x2d, 0x4b, 0x71, 0x4a, 0xe6,
-	0x26, 0xa5, 0xd5, 0x2e, 0x9b, 0x07, 0xa0, 0xb6, 0x16, 0x5a, 0x90, 0xfa, 0x4f, 0x03, 0x4f, 0xfa,
-	0xd4, 0xef, 0xba, 0x93, 0xd3, 0x6f, 0x83, 0xd9, 0x8c, 0x13, 0xa6, 0x8a, 0x25, 0xb4, 0xad, 0x2d,
-	0xb1, 0x3a, 0xd4, 0xbc, 0x3a, 0xd4, 0xb7, 0x41, 0x75, 0xe6, 0x32, 0x44, 0xd9, 0x28, 0xf5, 0xa0,
-	0x34, 0xcc, 0xbe, 0x8c, 0x0e, 0xa5, 0x13, 0xdf, 0x07, 0x30, 0x41, 0x8d, 0x31, 0x59, 0x20, 0xa5,
-	0x77, 0x1e, 0xcb, 0x4c, 0x17, 0x13, 0x09, 0x96, 0x32, 0x28, 0x83, 0xad, 0x6f, 0xaa, 0xa0, 0xd0,
-	0x6a, 0x1a, 0x40, 0x5f, 0x8f, 0x2d, 0x74, 0xf8, 0x43, 0xea, 0xf0, 0x25, 0x66, 0x68, 0x40, 0xb0,
-	0x77, 0x36, 0x41, 0x84, 0x42, 0x0b, 0xec, 0xce, 0x31, 0xdb, 0x42, 0x04, 0x09, 0xe3, 0x0a, 0x88,
-	0x97, 0x54, 0x81, 0x82, 0x53, 0x12, 0xeb, 0x9e, 0x07, 0x3f, 0x95, 0xa5, 0xa8, 0x20, 0x5e, 0x69,
-	0x3f, 0xdf, 0x98, 0x77, 0x72, 0x28, 0x6f, 0x40, 0x19, 0xbb, 0xdc, 0xd6, 0xb1, 0x38, 0x5d, 0x79,
-	0xcc, 0x6b, 0xb8, 0x2a, 0xad, 0x27, 0x5c, 0x95, 0xd8, 0x82, 0xeb, 0x8f, 0x79, 0xe1, 0xf1, 0x21,
-	0x62, 0x69, 0xee, 0x33, 0xfc, 0x5d, 0xc4, 0x82, 0x10, 0x25, 0x93, 0x17, 0xb1, 0xad, 0x26, 0x2f,
-	0x90, 0xf0, 0x0b, 0x50, 0xf5, 0x92, 0x0a, 0x23, 0xe2, 0x46, 0x3e, 0x4a, 0x5c, 0x6d, 0xac, 0xb3,
-	0x14, 0x5f, 0xbf, 0xc3, 0x11, 0xab, 0x14, 0x1f, 0xa5, 0x9b, 0x45, 0x06, 0xbe, 0x00, 0x90, 0xb9,
-	0xc4, 0x47, 0x6c, 0x94, 0x1e, 0xb0, 0x34, 0xcc, 0x13, 0x99, 0x49, 0xfb, 0xee, 0x79, 0x9d, 0x4f,
-	0x52, 0x1f, 0x88, 0x00, 0xd7, 0xe6, 0x9d, 0x4d, 0x6d, 0x32, 0xa8, 0x36, 0x1b, 0xc0, 0xcc, 0xce,
-	0xa4, 0x3a, 0xb5, 0xff, 0xdd, 0x01, 0x3b, 0x7d, 0xea, 0xc3, 0x13, 0x50, 0x59, 0xbd, 0x49, 0xcd,
-	0x75, 0x56, 0xea, 0x7d, 0x61, 0xbc, 0x7b, 0x7f, 0x3e, 0x2d, 0x0f, 0xbf, 0x02, 0xfb, 0xca, 0x5d,
-	0x52, 0xcf, 0xd8, 0xb7, 0x0a, 0x30, 0xde, 0x7b, 0x00, 0xb0, 0xa8, 0xfc, 0x0d, 0x78, 0xa4, 0x7e,
-	0xd0, 0x8d, 0x8c, 0x9d, 0x0a, 0xc2, 0x68, 0x3d, 0x84, 0x58, 0x2d, 0xae, 0x7e, 0x25, 0x59, 0xc5,
-	0x15, 0x44, 0x66, 0xf1, 0x4c, 0x6b, 0xc2, 0x10, 0xbc, 0x99, 0x65, 0xcb, 0x2c, 0x49, 0x33, 0x70,
-	0x86, 0xb5, 0x1d, 0x2e, 0x3d, 0xce, 0xd8, 0xfd, 0x9e, 0x1b, 0xb0, 0xfb, 0xf9, 0xd5, 0x8d, 0xa9,
-	0x5d, 0xdf, 0x98, 0xda, 0x3f, 0x37, 0xa6, 0xf6, 0xf3, 0xad, 0x99, 0xbb, 0xbe, 0x35, 0x73, 0x7f,
-	0xdd, 0x9a, 0xb9, 0xaf, 0x5f, 0xf8, 0x01, 0x9b, 0x9e, 0x8d, 0xad, 0x09, 0x0e, 0xed, 0x0f, 0xce,
-	0x07, 0x78, 0x76, 0xe1, 0xe3, 0xc8, 0x4e, 0x0f, 0x79, 0x39, 0x6f, 0xdb, 0xe7, 0xf2, 0x07, 0xc0,
-	0x45, 0x8c, 0xe8, 0xb8, 0x28, 0xfe, 0xfb, 0x7e, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x53,
-	0x82, 0x66, 0xfa, 0x1f, 0x08, 0x00, 0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xb3, 0xe9, 0xbf, 0x97, 0x6e, 0x77, 0x19, 0x56, 0xad, 0x6b, 0xb4, 0x6e, 0x08, 0xff,
+	0x42, 0xd9, 0x8d, 0x69, 0x40, 0xa0, 0xcd, 0x01, 0x69, 0x03, 0x42, 0xaa, 0x44, 0xa4, 0xca, 0xd5,
+	0x22, 0x04, 0x87, 0xc8, 0x89, 0x07, 0xd7, 0x5a, 0xdb, 0x63, 0xcd, 0x4c, 0x43, 0x7b, 0x43, 0x70,
+	0xe3, 0xc4, 0xc7, 0xe0, 0x84, 0x7a, 0x40, 0x82, 0x8f, 0xb0, 0x17, 0xa4, 0x15, 0x27, 0x4e, 0x2b,
+	0xd4, 0x1e, 0x2a, 0xbe, 0x05, 0x9a, 0x19, 0xdb, 0xcd, 0x38, 0x2e, 0xc9, 0x61, 0x2f, 0xb1, 0xe7,
+	0xbd, 0xdf, 0xbc, 0xf7, 0x7e, 0xbf, 0xf7, 0x66, 0x62, 0xd8, 0x3e, 0xc6, 0x61, 0xec, 0x7b, 0x51,
+	0x34, 0xe9, 0x3a, 0x23, 0x42, 0x1d, 0x7e, 0xda, 0x49, 0x29, 0xe1, 0x04, 0x6d, 0x5e, 0x3b, 0x3a,
+	0x23, 0x42, 0xad, 0x57, 0xbc, 0x38, 0x4c, 0x88, 0x23, 0x7f, 0x15, 0xc4, 0xda, 0x1e, 0x13, 0x16,
+	0x13, 0xe6, 0xc4, 0x2c, 0x70, 0x26, 0xfb, 0xe2, 0x91, 0x39, 0x76, 0x94, 0x63, 0x28, 0x57, 0x8e,
+	0x5a, 0x64, 0xae, 0x7b, 0x01, 0x09, 0x88, 0xb2, 0x8b, 0xb7, 0xcc, 0x6a, 0x96, 0xaa, 0x18, 0x11,
+	0xaa, 0x3c, 0xad, 0x3f, 0x6a, 0xb0, 0x39, 0x60, 0xc1, 0x21, 0x25, 0x29, 0x61, 0xf8, 0x28, 0xf5,
+	0x12, 0xb4, 0x0d, 0xab, 0x2c, 0xf5, 0x92, 0x61, 0xe8, 0x9b, 0x46, 0xd3, 0x68, 0xd7, 0xdd, 0x15,
+	0xb1, 0x3c, 0xf0, 0xd1, 0x87, 0xb0, 0x96, 0x2a, 0x1c, 0x35, 0x6b, 0x4d, 0xa3, 0xbd, 0xde, 0x37,
+	0xff, 0xfa, 0xed, 0xe1, 0xbd, 0x2c, 0xff, 0x63, 0xdf, 0xa7, 0x98, 0xb1, 0x23, 0x4e, 0xc3, 0x24,
+	0x70, 0x0b, 0x24, 0xda, 0x85, 0x06, 0xe3, 0x1e, 0xe5, 0xc3, 0x51, 0x44, 0xc6, 0x4f, 0xcd, 0x5b,
+	0x32, 0x24, 0x48, 0x53, 0x5f, 0x58, 0xd0, 0x6b, 0xb0, 0x8e, 0x13, 0x3f, 0x73, 0xd7, 0xa5, 0x7b,
+	0x0d, 0x27, 0xbe, 0x72, 0xee, 0xc0, 0xda, 0xf8, 0xd8, 0x0b, 0x65, 0x35, 0xcb, 0x22, 0xa7, 0xbb,
+	0x2a, 0xd7, 0x07, 0x3e, 0x42, 0x50, 0x67, 0x18, 0xfb, 0xe6, 0x4a, 0xd3, 0x68, 0x6f, 0xb8, 0xf2,
+	0x1d, 0x3d, 0x82, 0x86, 0x78, 0x0e, 0xbd, 0x13, 0x7e, 0x4c, 0xa8, 0xb9, 0x3a, 0xa7, 0x4a, 0x10,
+	0xe0, 0xc7, 0x12, 0xdb, 0x73, 0x7e, 0xb8, 0x3a, 0xdf, 0x2b, 0xca, 0xfe, 0xe9, 0xea, 0x7c, 0xef,
+	0x7e, 0x49, 0x35, 0x5d, 0xa7, 0x96, 0x09, 0x5b, 0xba, 0xc5, 0xc5, 0x2c, 0x25, 0x09, 0xc3, 0xad,
+	0xdf, 0x0d, 0xb8, 0x33, 0x60, 0xc1, 0x93, 0xd4, 0xf7, 0x38, 0x3e, 0xf4, 0xa8, 0x17, 0x33, 0xf4,
+	0x11, 0xac, 0xab, 0xa2, 0x42, 0x7e, 0x66, 0x1a, 0x73, 0xea, 0xba, 0x86, 0xa2, 0x47, 0xb0, 0x92,
+	0xca, 0x08, 0x52, 0xf2, 0x46, 0x77, 0xab, 0xa3, 0x0f, 0x4e, 0x47, 0xc5, 0xef, 0xaf, 0x3f, 0x7b,
+	0xb1, 0xbb, 0xf4, 0xcb, 0xd5, 0xf9, 0x9e, 0xe1, 0x66, 0x1b, 0x7a, 0xfb, 0x82, 0xd1, 0x75, 0x28,
+	0x41, 0xc9, 0x9e, 0xa5, 0x34, 0x5d, 0x65, 0x6b, 0x07, 0xb6, 0x4b, 0xa6, 0x82, 0xd4, 0xbf, 0x06,
+	0xdc, 0x1d, 0xb0, 0xa0, 0xef, 0x8d, 0x9f, 0x7e, 0x1b, 0x46, 0x91, 0x20, 0xcc, 0xb4, 0x91, 0x30,
+	0x16, 0x1e, 0x89, 0xe9, 0xa6, 0xd6, 0xf4, 0xa6, 0xbe, 0x09, 0x9b, 0x91, 0xc7, 0x31, 0xe3, 0xc3,
+	0x7c, 0x06, 0xd5, 0xc0, 0x6c, 0x28, 0xeb, 0x91, 0x9a, 0xc4, 0xf7, 0x00, 0x65, 0xa8, 0x11, 0xa1,
+	0x05, 0x52, 0xcd, 0xce, 0x1d, 0xe5, 0xe9, 0x13, 0xaa, 0xc0, 0x4a, 0x06, 0xad, 0xb1, 0xbb, 0xb3,
+	0x2a, 0x68, 0xb4, 0x5a, 0x16, 0x98, 0x65, 0x5b, 0xa1, 0xc3, 0x9f, 0x4a, 0x87, 0x2f, 0x09, 0xc7,
+	0x87, 0x94, 0xf8, 0x27, 0x63, 0x4c, 0x19, 0xea, 0xc0, 0xf2, 0x84, 0xf0, 0x05, 0x44, 0x50, 0x30,
+	0xa1, 0x80, 0x7c, 0xc9, 0x15, 0xa8, 0xbb, 0xab, 0x72, 0x7d, 0xe0, 0xa3, 0x4f, 0x54, 0x28, 0x26,
+	0x89, 0x37, 0xba, 0xf7, 0x67, 0xfa, 0x9d, 0x25, 0x15, 0x05, 0x68, 0x6d, 0x57, 0xdb, 0x7a, 0x1d,
+	0x41, 0x57, 0xa5, 0xb9, 0x81, 0xab, 0x56, 0x7a, 0xc6, 0x55, 0xb3, 0x15, 0x5c, 0x7f, 0xac, 0xc9,
+	0x19, 0x3f, 0xc2, 0x3c, 0xf7, 0x7d, 0x46, 0xbe, 0x4b, 0x78, 0x18, 0xe3, 0xac, 0xf3, 0xd2, 0xb6,
+	0x50, 0xe7, 0x25, 0x12, 0x7d, 0x01, 0x9b, 0x7e, 0x16, 0x61, 0x48, 0xbd, 0x24, 0xc0, 0xd9, 0x54,
+	0x5b, 0x65, 0x96, 0xf2, 0xf4, 0xbb, 0x02, 0x31, 0x4d, 0xf1, 0x76, 0xbe, 0x59, 0x7a, 0xd0, 0x03,
+	0x40, 0xdc, 0xa3, 0x01, 0xe6, 0xc3, 0x3c, 0xc1, 0xf5, 0xc0, 0xdc, 0x55, 0x9e, 0xbc, 0xee, 0x03,
+	0xbf, 0xf7, 0x71, 0x3e, 0x07, 0xd2, 0x20, 0xb4, 0x79, 0x6b, 0x56, 0x9b, 0x0a, 0xaa, 0xad, 0x26,
+	0xd8, 0xd5, 0x9e, 0x42, 0xa7, 0x5f, 0x0d, 0xd8, 0x19, 0xb0, 0xe0, 0x53, 0x2f, 0x19, 0xe3, 0xe8,
+	0x25, 0x49, 0x55, 0xba, 0x37, 0x6b, 0xe5, 0x7b, 0xb3, 0xd7, 0x9b, 0xe1, 0xd3, 0x9e, 0xe5, 0x53,
+	0x5d, 0x52, 0xeb, 0x0d, 0x78, 0xfd, 0x46, 0x67, 0xce, 0xaa, 0xfb, 0xa2, 0x0e, 0xb7, 0x06, 0x2c,
+	0x40, 0x4f, 0xa0, 0x31, 0xfd, 0xff, 0x60, 0x97, 0x7b, 0xa5, 0xdf, 0x82, 0xd6, 0xdb, 0xff, 0xef,
+	0xcf, 0xc3, 0xa3, 0xaf, 0x60, 0x43, 0xbb, 0x21, 0x77, 0x2b, 0xf6, 0x4d, 0x03, 0xac, 0x77, 0xe6,
+	0x00, 0x8a, 0xc8, 0xdf, 0xc0, 0x6d, 0xfd, 0x9a, 0x6a, 0x56, 0xec, 0xd4, 0x10, 0x56, 0x7b, 0x1e,
+	0x62, 0x3a, 0xb8, 0x7e, 0xf6, 0xab, 0x82, 0x6b, 0x08, 0xab, 0x3d, 0x0f, 0x51, 0x04, 0x8f, 0xe1,
+	0xd5, 0xaa, 0xc3, 0x56, 0x25, 0x69, 0x05, 0xce, 0xea, 0x2c, 0x86, 0x2b, 0xd2, 0x4d, 0x60, 0xeb,
+	0x86, 0x99, 0x7d, 0xb7, 0x22, 0x52, 0x35, 0xd4, 0xda, 0x5f, 0x18, 0x9a, 0xe7, 0xb5, 0x96, 0xbf,
+	0x17, 0xc7, 0xb9, 0xff, 0xf9, 0xb3, 0x0b, 0xdb, 0x78, 0x7e, 0x61, 0x1b, 0xff, 0x5c, 0xd8, 0xc6,
+	0xcf, 0x97, 0xf6, 0xd2, 0xf3, 0x4b, 0x7b, 0xe9, 0xef, 0x4b, 0x7b, 0xe9, 0xeb, 0x07, 0x41, 0xc8,
+	0x8f, 0x4f, 0x46, 0x9d, 0x31, 0x89, 0x9d, 0xf7, 0x4f, 0x0f, 0x49, 0x74, 0x16, 0x90, 0xc4, 0xc9,
+	0xf3, 0x3c, 0x9c, 0x74, 0x9d, 0x53, 0xf5, 0x39, 0x75, 0x96, 0x62, 0x36, 0x5a, 0x91, 0xdf, 0x32,
+	0x1f, 0xfc, 0x37, 0x00, 0x32, 0x37, 0xdd, 0x52, 0x6d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -672,6 +771,9 @@ type MsgClient interface {
 	// producer. Producers can signal maintenance windows during which they won't
 	// produce blocks.
 	SetProducerDowntime(ctx context.Context, in *MsgSetProducerDowntime, opts ...grpc.CallOption) (*MsgSetProducerDowntimeResponse, error)
+	// CancelProducerDowntime defines a method for a producer to cancel one of
+	// its planned downtime windows.
+	CancelProducerDowntime(ctx context.Context, in *MsgCancelProducerDowntime, opts ...grpc.CallOption) (*MsgCancelProducerDowntimeResponse, error)
 }
 
 type msgClient struct {
@@ -727,6 +829,15 @@ func (c *msgClient) SetProducerDowntime(ctx context.Context, in *MsgSetProducerD
 	return out, nil
 }
 
+func (c *msgClient) CancelProducerDowntime(ctx context.Context, in *MsgCancelProducerDowntime, opts ...grpc.CallOption) (*MsgCancelProducerDowntimeResponse, error) {
+	out := new(MsgCancelProducerDowntimeResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.bor.Msg/CancelProducerDowntime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ProposeSpan defines a method for proposing a new bor span.
@@ -744,6 +855,9 @@ type MsgServer interface {
 	// producer. Producers can signal maintenance windows during which they won't
 	// produce blocks.
 	SetProducerDowntime(context.Context, *MsgSetProducerDowntime) (*MsgSetProducerDowntimeResponse, error)
+	// CancelProducerDowntime defines a method for a producer to cancel one of
+	// its planned downtime windows.
+	CancelProducerDowntime(context.Context, *MsgCancelProducerDowntime) (*MsgCancelProducerDowntimeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -765,6 +879,9 @@ func (*UnimplementedMsgServer) VoteProducers(ctx context.Context, req *MsgVotePr
 func (*UnimplementedMsgServer) SetProducerDowntime(ctx context.Context, req *MsgSetProducerDowntime) (*MsgSetProducerDowntimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProducerDowntime not implemented")
 }
+func (*UnimplementedMsgServer) CancelProducerDowntime(ctx context.Context, req *MsgCancelProducerDowntime) (*MsgCancelProducerDowntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProducerDowntime not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -860,6 +977,25 @@ func _Msg_SetProducerDowntime_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProducerDowntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProducerDowntime)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProducerDowntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.bor.Msg/CancelProducerDowntime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProducerDowntime(ctx, req.(*MsgCancelProducerDowntime))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.bor.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -884,6 +1020,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetProducerDowntime",
 			Handler:    _Msg_SetProducerDowntime_Handler,
 		},
+		{
+			MethodName: "CancelProducerDowntime",
+			Handler:    _Msg_CancelProducerDowntime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/bor/tx.proto",
@@ -1247,6 +1387,64 @@ func (m *MsgSetProducerDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProducerDowntime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProducerDowntime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProducerDowntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Producer) > 0 {
+		i -= len(m.Producer)
+		copy(dAtA[i:], m.Producer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Producer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProducerDowntimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProducerDowntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProducerDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1411,6 +1609,31 @@ func (m *MsgSetProducerDowntimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProducerDowntime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Producer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovTx(uint64(m.StartBlock))
+	}
+	return n
+}
+
+func (m *MsgCancelProducerDowntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2439,6 +2662,157 @@ func (m *MsgSetProducerDowntimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProducerDowntime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProducerDowntime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProducerDowntime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProducerDowntimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProducerDowntimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProducerDowntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -104,6 +104,9 @@ const (
 	PlannedDowntimeMinRange            = 150                       // It will be down minimum for the whole span, this here is just for tx validation.
 	PlannedDowntimeMaxRange            = 14 * DefaultSpanDuration  // ~48 hours
 	RoundRobinDefault                  = 0                         // No preferred replacement, the next producer is chosen via round-robin instead.
+	MaxPlannedDowntimeWindows          = 10                        // Max number of planned downtime windows of a producer, past ones excluded.
+	PerformanceHistorySpans            = 2000                      // Number of spans the validator performance is kept for, ~10 months of default spans.
+	MaxPerformanceHistoryQuerySpans    = 1000                      // Max number of spans in a validator performance history query.
 )
 
 // LogSpan returns a human-readable summary of the span for logging purposes.
@@ -134,3 +137,45 @@ func (s *Span) LogSpan() string {
 		s.BorChainId,
 	)
 }
+
+// NewSpanSummary returns the summary of a span, kept when its full body is pruned.
+func NewSpanSummary(span Span, seed []byte) SpanSummary {
+	producerIDs := make([]uint64, 0, len(span.SelectedProducers))
+	producerSigners := make([]string, 0, len(span.SelectedProducers))
+	for _, producer := range span.SelectedProducers {
+		producerIDs = append(producerIDs, producer.ValId)
+		producerSigners = append(producerSigners, producer.Signer)
+	}
+
+	return SpanSummary{
+		Id:              span.Id,
+		StartBlock:      span.StartBlock,
+		EndBlock:        span.EndBlock,
+		ProducerIds:     producerIDs,
+		ProducerSigners: producerSigners,
+		Seed:            seed,
+		BorChainId:      span.BorChainId,
+	}
+}
+
+// ToSpan returns the span of the summary, whose selected producers only have their ID and signer set,
+// and without the validator set.
+func (s SpanSummary) ToSpan() Span {
+	producers := make([]staketypes.Validator, 0, len(s.ProducerIds))
+	for i, producerID := range s.ProducerIds {
+		producer := staketypes.Validator{ValId: producerID}
+		if i < len(s.ProducerSigners) {
+			producer.Signer = s.ProducerSigners[i]
+		}
+
+		producers = append(producers, producer)
+	}
+
+	return Span{
+		Id:                s.Id,
+		StartBlock:        s.StartBlock,
+		EndBlock:          s.EndBlock,
+		SelectedProducers: producers,
+		BorChainId:        s.BorChainId,
+	}
+}
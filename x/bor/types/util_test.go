@@ -541,3 +541,41 @@ func TestLogSpan(t *testing.T) {
 		require.Contains(t, logStr, "borChainId=")
 	})
 }
+
+func TestSpanSummary(t *testing.T) {
+	t.Parallel()
+
+	span := types.Span{
+		Id:         42,
+		StartBlock: 1000,
+		EndBlock:   2000,
+		BorChainId: "137",
+		SelectedProducers: []staketypes.Validator{
+			{ValId: 2, Signer: "0xbbb", VotingPower: 10},
+			{ValId: 1, Signer: "0xaaa", VotingPower: 20},
+		},
+		ValidatorSet: staketypes.ValidatorSet{
+			Validators: []*staketypes.Validator{
+				{ValId: 1},
+				{ValId: 2},
+			},
+		},
+	}
+
+	summary := types.NewSpanSummary(span, []byte{0x01, 0x02})
+	require.Equal(t, uint64(42), summary.Id)
+	require.Equal(t, uint64(1000), summary.StartBlock)
+	require.Equal(t, uint64(2000), summary.EndBlock)
+	require.Equal(t, []uint64{2, 1}, summary.ProducerIds)
+	require.Equal(t, []string{"0xbbb", "0xaaa"}, summary.ProducerSigners)
+	require.Equal(t, []byte{0x01, 0x02}, summary.Seed)
+	require.Equal(t, "137", summary.BorChainId)
+
+	rebuilt := summary.ToSpan()
+	require.Equal(t, span.Id, rebuilt.Id)
+	require.Equal(t, span.StartBlock, rebuilt.StartBlock)
+	require.Equal(t, span.EndBlock, rebuilt.EndBlock)
+	require.Equal(t, span.BorChainId, rebuilt.BorChainId)
+	require.Equal(t, []staketypes.Validator{{ValId: 2, Signer: "0xbbb"}, {ValId: 1, Signer: "0xaaa"}}, rebuilt.SelectedProducers)
+	require.Empty(t, rebuilt.ValidatorSet.Validators)
+}
@@ -17,6 +17,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query values set as chainmanager parameters.",
 					Long:      "Query the current chainmanager parameters information",
 				},
+				{
+					RpcMethod: "GetPendingAddressMigrations",
+					Use:       "address-migrations",
+					Short:     "Query the pending root chain contract address migrations.",
+					Long:      "Query the root chain contract address migrations scheduled by governance at future heights",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -27,6 +33,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ScheduleAddressMigration",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CancelAddressMigration",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "VerifyAddressMigration",
+					Use:            "verify-address-migration [height]",
+					Short:          "Verify the new addresses of a pending address migration.",
+					Long:           "Broadcast the side tx for the validators to verify the new addresses of the address migration scheduled at the height against the root chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
 			},
 		},
 	}
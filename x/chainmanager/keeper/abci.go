@@ -2,14 +2,18 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/collections"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0xPolygon/heimdall-v2/helper"
+	cmTypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 	"github.com/0xPolygon/heimdall-v2/x/stake/types"
 )
 
@@ -39,5 +43,57 @@ func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error)
 			return nil, err
 		}
 	}
+
+	// Apply the address migration scheduled by governance at this height, if any
+	if err := k.applyAddressMigration(ctx); err != nil {
+		return nil, err
+	}
+
 	return nil, nil
 }
+
+// applyAddressMigration changes the root chain contract addresses to the ones of the
+// address migration scheduled at the current height when verified, and removes it.
+func (k *Keeper) applyAddressMigration(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	migration, err := k.addressMigrations.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error fetching address migration at height %d: %w", height, err)
+	}
+
+	if err = k.addressMigrations.Remove(ctx, height); err != nil {
+		return fmt.Errorf("error removing address migration at height %d: %w", height, err)
+	}
+
+	// the new addresses were never verified against the root chain by the validators
+	if !migration.Verified {
+		k.Logger(ctx).Error("Dropped unverified root chain contract address migration", "height", height)
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			cmTypes.EventTypeAddressMigrationDropped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, cmTypes.AttributeValueCategory),
+			sdk.NewAttribute(cmTypes.AttributeKeyMigrationHeight, strconv.FormatInt(height, 10)),
+		))
+
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.ChainParams = migration.Apply(params.ChainParams)
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("error updating chain manager params: %w", err)
+	}
+
+	k.Logger(ctx).Info("Applied root chain contract address migration", "height", height)
+
+	return nil
+}
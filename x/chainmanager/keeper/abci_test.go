@@ -1,5 +1,11 @@
 package keeper_test
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
 func (s *KeeperTestSuite) TestEndBlocker() {
 	ctx, require, cmKeeper := s.ctx, s.Require(), s.cmKeeper
 
@@ -19,6 +25,73 @@ func (s *KeeperTestSuite) TestEndBlocker() {
 	})
 }
 
+func (s *KeeperTestSuite) TestEndBlockerAppliesAddressMigration() {
+	require, cmKeeper := s.Require(), s.cmKeeper
+
+	params := s.getParams()
+	require.NoError(cmKeeper.SetParams(s.ctx, params))
+
+	newRootChainAddress := "0x1111111111111111111111111111111111111111"
+	newStateSenderAddress := "0x2222222222222222222222222222222222222222"
+	require.NoError(cmKeeper.SetAddressMigration(s.ctx, types.AddressMigration{
+		Height:             50,
+		RootChainAddress:   newRootChainAddress,
+		StateSenderAddress: newStateSenderAddress,
+		Verified:           true,
+	}))
+	require.NoError(cmKeeper.SetAddressMigration(s.ctx, types.AddressMigration{
+		Height:           40,
+		RootChainAddress: "0x3333333333333333333333333333333333333333",
+	}))
+
+	s.Run("drops the unverified migration at its height", func() {
+		ctx := s.ctx.WithBlockHeight(40).WithEventManager(sdk.NewEventManager())
+		_, err := cmKeeper.EndBlocker(ctx)
+		require.NoError(err)
+
+		events := ctx.EventManager().Events()
+		require.Len(events, 1)
+		require.Equal(types.EventTypeAddressMigrationDropped, events[0].Type)
+		height, hasHeight := events[0].GetAttribute(types.AttributeKeyMigrationHeight)
+		require.True(hasHeight)
+		require.Equal("40", height.Value)
+
+		res, err := cmKeeper.GetParams(s.ctx)
+		require.NoError(err)
+		require.Equal(params, res)
+
+		found, err := cmKeeper.HasAddressMigration(s.ctx, 40)
+		require.NoError(err)
+		require.False(found)
+	})
+
+	s.Run("leaves the addresses unchanged before the migration height", func() {
+		_, err := cmKeeper.EndBlocker(s.ctx.WithBlockHeight(49))
+		require.NoError(err)
+
+		res, err := cmKeeper.GetParams(s.ctx)
+		require.NoError(err)
+		require.Equal(params, res)
+	})
+
+	s.Run("changes the migrated addresses at the migration height", func() {
+		_, err := cmKeeper.EndBlocker(s.ctx.WithBlockHeight(50))
+		require.NoError(err)
+
+		expParams := params
+		expParams.ChainParams.RootChainAddress = newRootChainAddress
+		expParams.ChainParams.StateSenderAddress = newStateSenderAddress
+
+		res, err := cmKeeper.GetParams(s.ctx)
+		require.NoError(err)
+		require.Equal(expParams, res)
+
+		found, err := cmKeeper.HasAddressMigration(s.ctx, 50)
+		require.NoError(err)
+		require.False(found)
+	})
+}
+
 func (s *KeeperTestSuite) TestLogger() {
 	ctx, require, cmKeeper := s.ctx, s.Require(), s.cmKeeper
 
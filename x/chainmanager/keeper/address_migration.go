@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+// VerifyAddressMigration checks against the root chain that the new addresses of the migration
+// are the contracts they replace, by calling one of their view methods.
+// It depends on the root chain RPC of the node, so it's only used by the side handler of MsgVerifyAddressMigration,
+// whose result is voted by the validators, and by the bridge before sending the msg.
+func VerifyAddressMigration(ctx context.Context, contractCaller helper.IContractCaller, migration types.AddressMigration) error {
+	ctx, cancel := context.WithTimeout(ctx, helper.GetMainChainCallTimeout())
+	defer cancel()
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	if migration.PolTokenAddress != "" {
+		instance, err := contractCaller.GetTokenInstance(migration.PolTokenAddress)
+		if err == nil {
+			_, err = instance.TotalSupply(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("pol token contract not found at %s: %w", migration.PolTokenAddress, err)
+		}
+	}
+
+	if migration.RootChainAddress != "" {
+		instance, err := contractCaller.GetRootChainInstance(migration.RootChainAddress)
+		if err == nil {
+			_, err = instance.CurrentHeaderBlock(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("root chain contract not found at %s: %w", migration.RootChainAddress, err)
+		}
+	}
+
+	if migration.StakingManagerAddress != "" {
+		instance, err := contractCaller.GetStakeManagerInstance(migration.StakingManagerAddress)
+		if err == nil {
+			_, err = instance.CurrentEpoch(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("staking manager contract not found at %s: %w", migration.StakingManagerAddress, err)
+		}
+	}
+
+	if migration.SlashManagerAddress != "" {
+		instance, err := contractCaller.GetSlashManagerInstance(migration.SlashManagerAddress)
+		if err == nil {
+			_, err = instance.HeimdallId(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("slash manager contract not found at %s: %w", migration.SlashManagerAddress, err)
+		}
+	}
+
+	if migration.StakingInfoAddress != "" {
+		instance, err := contractCaller.GetStakingInfoInstance(migration.StakingInfoAddress)
+		if err == nil {
+			_, err = instance.Registry(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("staking info contract not found at %s: %w", migration.StakingInfoAddress, err)
+		}
+	}
+
+	if migration.StateSenderAddress != "" {
+		instance, err := contractCaller.GetStateSenderInstance(migration.StateSenderAddress)
+		if err == nil {
+			_, err = instance.Counter(opts)
+		}
+		if err != nil {
+			return fmt.Errorf("state sender contract not found at %s: %w", migration.StateSenderAddress, err)
+		}
+	}
+
+	return nil
+}
+
+// getUnverifiedAddressMigration returns the address migration scheduled at the given height,
+// failing when there is none or when it's already verified.
+func (k Keeper) getUnverifiedAddressMigration(ctx context.Context, height int64) (types.AddressMigration, error) {
+	migration, err := k.GetAddressMigration(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AddressMigration{}, errorsmod.Wrapf(types.ErrAddressMigrationNotFound, "%d", height)
+	}
+	if err != nil {
+		return types.AddressMigration{}, err
+	}
+
+	if migration.Verified {
+		return types.AddressMigration{}, errorsmod.Wrapf(types.ErrInvalidAddressMigration, "migration at height %d is already verified", height)
+	}
+
+	return migration, nil
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+func (s *KeeperTestSuite) TestVerifyAddressMigration() {
+	ctx, require := s.ctx, s.Require()
+
+	s.Run("fails when the contract cannot be bound", func() {
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetStateSenderInstance", StateSenderAddress).Return(nil, errors.New("dial failed"))
+
+		err := keeper.VerifyAddressMigration(ctx, contractCaller, types.AddressMigration{Height: 10, StateSenderAddress: StateSenderAddress})
+		require.ErrorContains(err, "state sender contract not found at "+StateSenderAddress)
+		contractCaller.AssertExpectations(s.T())
+	})
+
+	s.Run("skips the unchanged addresses", func() {
+		contractCaller := &mocks.IContractCaller{}
+
+		require.NoError(keeper.VerifyAddressMigration(ctx, contractCaller, types.AddressMigration{Height: 10}))
+		contractCaller.AssertExpectations(s.T())
+	})
+}
@@ -12,6 +12,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(fmt.Errorf("failed to set chainmanager params: %w", err))
 	}
+
+	for _, m := range data.AddressMigrations {
+		if err := k.SetAddressMigration(ctx, m); err != nil {
+			panic(fmt.Errorf("failed to set chainmanager address migration at height %d: %w", m.Height, err))
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for chainmanager.
@@ -21,7 +27,15 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(fmt.Errorf("failed to get chainmanager params: %w", err))
 	}
 
-	return types.NewGenesisState(
+	addressMigrations, err := k.GetAddressMigrations(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to get chainmanager address migrations: %w", err))
+	}
+
+	genesis := types.NewGenesisState(
 		params,
 	)
+	genesis.AddressMigrations = addressMigrations
+
+	return genesis
 }
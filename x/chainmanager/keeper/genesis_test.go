@@ -18,3 +18,18 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 	actualParams := cmKeeper.ExportGenesis(ctx)
 	require.Equal(genesisState, actualParams)
 }
+
+func (s *KeeperTestSuite) TestInitExportGenesisWithAddressMigrations() {
+	cmKeeper, ctx, require := s.cmKeeper, s.ctx, s.Require()
+
+	genesisState := &types.GenesisState{
+		Params: s.getParams(),
+		AddressMigrations: []types.AddressMigration{
+			{Height: 10, RootChainAddress: RootChainAddress},
+			{Height: 20, StakingInfoAddress: StakingInfoAddress, StateSenderAddress: StateSenderAddress},
+		},
+	}
+	cmKeeper.InitGenesis(ctx, genesisState)
+
+	require.Equal(genesisState, cmKeeper.ExportGenesis(ctx))
+}
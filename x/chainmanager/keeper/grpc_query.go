@@ -31,3 +31,13 @@ func (q queryServer) GetChainManagerParams(ctx context.Context, _ *types.QueryPa
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// GetPendingAddressMigrations implements the gRPC service handler for querying the pending address migrations.
+func (q queryServer) GetPendingAddressMigrations(ctx context.Context, _ *types.QueryPendingAddressMigrationsRequest) (*types.QueryPendingAddressMigrationsResponse, error) {
+	migrations, err := q.k.GetAddressMigrations(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get address migrations: %s", err)
+	}
+
+	return &types.QueryPendingAddressMigrationsResponse{Migrations: migrations}, nil
+}
@@ -13,3 +13,20 @@ func (s *KeeperTestSuite) TestGRPCQueryParams() {
 	require.NotNil(res)
 	require.Equal(expParams, res.Params)
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryPendingAddressMigrations() {
+	queryClient, require, ctx, cmKeeper := s.queryClient, s.Require(), s.ctx, s.cmKeeper
+
+	res, err := queryClient.GetPendingAddressMigrations(ctx, &types.QueryPendingAddressMigrationsRequest{})
+	require.NoError(err)
+	require.Empty(res.Migrations)
+
+	later := types.AddressMigration{Height: 300, RootChainAddress: RootChainAddress}
+	earlier := types.AddressMigration{Height: 20, PolTokenAddress: PolTokenAddress}
+	require.NoError(cmKeeper.SetAddressMigration(ctx, later))
+	require.NoError(cmKeeper.SetAddressMigration(ctx, earlier))
+
+	res, err = queryClient.GetPendingAddressMigrations(ctx, &types.QueryPendingAddressMigrationsRequest{})
+	require.NoError(err)
+	require.Equal([]types.AddressMigration{earlier, later}, res.Migrations)
+}
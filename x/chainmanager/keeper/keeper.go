@@ -14,6 +14,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 )
 
@@ -23,9 +24,13 @@ type Keeper struct {
 	storeService store.KVStoreService
 	Schema       collections.Schema
 	params       collections.Item[types.Params]
+	// addressMigrations are the pending address migrations by height.
+	addressMigrations collections.Map[int64, types.AddressMigration]
 	// The address capable of executing a `MsgUpdateParams` message.
 	// This should be the x/gov module account.
 	authority string
+	// contractCaller verifies the new addresses of the address migrations against the main chain
+	contractCaller helper.IContractCaller
 }
 
 // NewKeeper create new keeper
@@ -33,6 +38,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	authority string,
+	contractCaller helper.IContractCaller,
 ) Keeper {
 	bz, err := address.NewHexCodec().StringToBytes(authority)
 	if err != nil {
@@ -50,7 +56,10 @@ func NewKeeper(
 		cdc:          cdc,
 		storeService: storeService,
 		params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		authority:    authority,
+		addressMigrations: collections.NewMap(sb, types.AddressMigrationsKey, "address_migrations",
+			collections.Int64Key, codec.CollValue[types.AddressMigration](cdc)),
+		authority:      authority,
+		contractCaller: contractCaller,
 	}
 
 	schema, err := sb.Build()
@@ -90,3 +99,35 @@ func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	}
 	return p, nil
 }
+
+// Address migrations
+
+// SetAddressMigration schedules the address migration at its height.
+func (k Keeper) SetAddressMigration(ctx context.Context, migration types.AddressMigration) error {
+	return k.addressMigrations.Set(ctx, migration.Height, migration)
+}
+
+// GetAddressMigration returns the address migration scheduled at the given height.
+func (k Keeper) GetAddressMigration(ctx context.Context, height int64) (types.AddressMigration, error) {
+	return k.addressMigrations.Get(ctx, height)
+}
+
+// RemoveAddressMigration removes the address migration scheduled at the given height.
+func (k Keeper) RemoveAddressMigration(ctx context.Context, height int64) error {
+	return k.addressMigrations.Remove(ctx, height)
+}
+
+// HasAddressMigration checks if an address migration is scheduled at the given height.
+func (k Keeper) HasAddressMigration(ctx context.Context, height int64) (bool, error) {
+	return k.addressMigrations.Has(ctx, height)
+}
+
+// GetAddressMigrations returns the pending address migrations, ordered by height.
+func (k Keeper) GetAddressMigrations(ctx context.Context) ([]types.AddressMigration, error) {
+	iter, err := k.addressMigrations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
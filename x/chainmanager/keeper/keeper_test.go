@@ -15,6 +15,8 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/keeper"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 )
@@ -22,10 +24,12 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	cmKeeper    keeper.Keeper
-	queryClient types.QueryClient
-	msgServer   types.MsgServer
+	ctx            sdk.Context
+	cmKeeper       keeper.Keeper
+	queryClient    types.QueryClient
+	msgServer      types.MsgServer
+	sideMsgCfg     sidetxs.SideTxConfigurator
+	contractCaller mocks.IContractCaller
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -36,7 +40,8 @@ func (s *KeeperTestSuite) SetupTest() {
 	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: cmttime.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
-	cmKeeper := keeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.contractCaller = mocks.IContractCaller{}
+	cmKeeper := keeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress(govtypes.ModuleName).String(), &s.contractCaller)
 	require.NoError(cmKeeper.SetParams(ctx, types.DefaultParams()))
 
 	s.ctx = ctx
@@ -46,6 +51,9 @@ func (s *KeeperTestSuite) SetupTest() {
 	types.RegisterQueryServer(queryHelper, keeper.NewQueryServer(&cmKeeper))
 	s.queryClient = types.NewQueryClient(queryHelper)
 	s.msgServer = keeper.NewMsgServerImpl(cmKeeper)
+	s.sideMsgCfg = sidetxs.NewSideTxConfigurator()
+
+	types.RegisterSideMsgServer(s.sideMsgCfg, keeper.NewSideMsgServerImpl(&cmKeeper))
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -37,3 +38,81 @@ func (srv msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (srv msgServer) ScheduleAddressMigration(ctx context.Context, req *types.MsgScheduleAddressMigration) (*types.MsgScheduleAddressMigrationResponse, error) {
+	if srv.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Migration.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddressMigration, "%s", err)
+	}
+
+	// the validators verify the new addresses with a side tx once the migration is scheduled
+	if req.Migration.Verified {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddressMigration, "migration can't be scheduled as verified")
+	}
+
+	// the migration is applied at the end of the block at its height, so it must not be reached yet
+	if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); req.Migration.Height <= height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddressMigration, "height %d is not after the current height %d", req.Migration.Height, height)
+	}
+
+	exists, err := srv.HasAddressMigration(ctx, req.Migration.Height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to get address migration; %s", err)
+	}
+
+	if exists {
+		return nil, errorsmod.Wrapf(types.ErrAddressMigrationExists, "%d", req.Migration.Height)
+	}
+
+	if err := srv.SetAddressMigration(ctx, req.Migration); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to schedule address migration; %s", err)
+	}
+
+	srv.Logger(ctx).Info("Scheduled root chain contract address migration", "height", req.Migration.Height)
+
+	return &types.MsgScheduleAddressMigrationResponse{}, nil
+}
+
+func (srv msgServer) CancelAddressMigration(ctx context.Context, req *types.MsgCancelAddressMigration) (*types.MsgCancelAddressMigrationResponse, error) {
+	if srv.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.GetAuthority(), req.Authority)
+	}
+
+	exists, err := srv.HasAddressMigration(ctx, req.Height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to get address migration; %s", err)
+	}
+
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrAddressMigrationNotFound, "%d", req.Height)
+	}
+
+	if err := srv.RemoveAddressMigration(ctx, req.Height); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to cancel address migration; %s", err)
+	}
+
+	srv.Logger(ctx).Info("Cancelled root chain contract address migration", "height", req.Height)
+
+	return &types.MsgCancelAddressMigrationResponse{}, nil
+}
+
+// VerifyAddressMigration checks that the address migration is pending and not verified yet.
+// The new addresses are verified against the main chain by the validators, in the side handler.
+func (srv msgServer) VerifyAddressMigration(ctx context.Context, req *types.MsgVerifyAddressMigration) (*types.MsgVerifyAddressMigrationResponse, error) {
+	migration, err := srv.getUnverifiedAddressMigration(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the migration is verified by the post handler at the next block, so it must not be applied before
+	if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); migration.Height <= height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddressMigration, "height %d is not after the current height %d", migration.Height, height)
+	}
+
+	srv.Logger(ctx).Debug("Verifying root chain contract address migration", "height", migration.Height, "from", req.From)
+
+	return &types.MsgVerifyAddressMigrationResponse{}, nil
+}
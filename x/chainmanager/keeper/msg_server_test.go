@@ -14,6 +14,7 @@ const (
 	RootChainAddress      = "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287"
 	StakingInfoAddress    = "0xa59c847bd5ac0172ff4fe912c5d29e5a71a7512b"
 	StateSenderAddress    = "0x28e4f3a7f651294b9564800b2d01f35189a5bfbe"
+	AccountAddress        = "0x000000000000000000000000000000000000dead"
 )
 
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
@@ -76,6 +77,134 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgScheduleAddressMigration() {
+	require, cmKeeper, msgServer := s.Require(), s.cmKeeper, s.msgServer
+	ctx := s.ctx.WithBlockHeight(100)
+
+	_, err := msgServer.ScheduleAddressMigration(ctx, &types.MsgScheduleAddressMigration{
+		Authority: cmKeeper.GetAuthority(),
+		Migration: types.AddressMigration{Height: 200, StateSenderAddress: StateSenderAddress},
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgScheduleAddressMigration
+		expErr    error
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: "invalid",
+				Migration: types.AddressMigration{Height: 150, RootChainAddress: RootChainAddress},
+			},
+			expErr:    govtypes.ErrInvalidSigner,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "no address",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: cmKeeper.GetAuthority(),
+				Migration: types.AddressMigration{Height: 150},
+			},
+			expErr:    types.ErrInvalidAddressMigration,
+			expErrMsg: "no address to migrate",
+		},
+		{
+			name: "invalid address",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: cmKeeper.GetAuthority(),
+				Migration: types.AddressMigration{Height: 150, RootChainAddress: "def"},
+			},
+			expErr:    types.ErrInvalidAddressMigration,
+			expErrMsg: "invalid address for value def for root_chain_address",
+		},
+		{
+			name: "height not after the current height",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: cmKeeper.GetAuthority(),
+				Migration: types.AddressMigration{Height: 100, RootChainAddress: RootChainAddress},
+			},
+			expErr:    types.ErrInvalidAddressMigration,
+			expErrMsg: "height 100 is not after the current height 100",
+		},
+		{
+			name: "scheduled as verified",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: cmKeeper.GetAuthority(),
+				Migration: types.AddressMigration{Height: 150, RootChainAddress: RootChainAddress, Verified: true},
+			},
+			expErr:    types.ErrInvalidAddressMigration,
+			expErrMsg: "migration can't be scheduled as verified",
+		},
+		{
+			name: "already scheduled at height",
+			input: &types.MsgScheduleAddressMigration{
+				Authority: cmKeeper.GetAuthority(),
+				Migration: types.AddressMigration{Height: 200, RootChainAddress: RootChainAddress},
+			},
+			expErr: types.ErrAddressMigrationExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.ScheduleAddressMigration(ctx, tc.input)
+			require.ErrorIs(err, tc.expErr)
+			require.Contains(err.Error(), tc.expErrMsg)
+		})
+	}
+
+	migrations, err := cmKeeper.GetAddressMigrations(ctx)
+	require.NoError(err)
+	require.Equal([]types.AddressMigration{{Height: 200, StateSenderAddress: StateSenderAddress}}, migrations)
+}
+
+func (s *KeeperTestSuite) TestMsgCancelAddressMigration() {
+	require, cmKeeper, msgServer := s.Require(), s.cmKeeper, s.msgServer
+	ctx := s.ctx.WithBlockHeight(100)
+
+	require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 200, StateSenderAddress: StateSenderAddress}))
+
+	_, err := msgServer.CancelAddressMigration(ctx, &types.MsgCancelAddressMigration{Authority: "invalid", Height: 200})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CancelAddressMigration(ctx, &types.MsgCancelAddressMigration{Authority: cmKeeper.GetAuthority(), Height: 150})
+	require.ErrorIs(err, types.ErrAddressMigrationNotFound)
+
+	_, err = msgServer.CancelAddressMigration(ctx, &types.MsgCancelAddressMigration{Authority: cmKeeper.GetAuthority(), Height: 200})
+	require.NoError(err)
+
+	found, err := cmKeeper.HasAddressMigration(ctx, 200)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestMsgVerifyAddressMigration() {
+	require, cmKeeper, msgServer := s.Require(), s.cmKeeper, s.msgServer
+	ctx := s.ctx.WithBlockHeight(100)
+
+	require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 101, StateSenderAddress: StateSenderAddress}))
+	require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 100, StateSenderAddress: StateSenderAddress}))
+	require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 150, StateSenderAddress: StateSenderAddress, Verified: true}))
+
+	_, err := msgServer.VerifyAddressMigration(ctx, &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 101})
+	require.NoError(err)
+
+	_, err = msgServer.VerifyAddressMigration(ctx, &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 120})
+	require.ErrorIs(err, types.ErrAddressMigrationNotFound)
+
+	_, err = msgServer.VerifyAddressMigration(ctx, &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 150})
+	require.ErrorIs(err, types.ErrInvalidAddressMigration)
+	require.ErrorContains(err, "already verified")
+
+	// the post handler runs at the next block, after the migration is applied
+	_, err = msgServer.VerifyAddressMigration(ctx, &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 100})
+	require.ErrorIs(err, types.ErrInvalidAddressMigration)
+	require.ErrorContains(err, "height 100 is not after the current height 100")
+}
+
 func (s *KeeperTestSuite) getParams() types.Params {
 	s.T().Helper()
 
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
+	heimdallTypes "github.com/0xPolygon/heimdall-v2/types"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+var verifyAddressMigrationMsgTypeURL = sdk.MsgTypeURL(&types.MsgVerifyAddressMigration{})
+
+type sideMsgServer struct {
+	k *Keeper
+}
+
+// NewSideMsgServerImpl returns an implementation of the x/chainmanager SideMsgServer interface for the provided Keeper.
+func NewSideMsgServerImpl(keeper *Keeper) sidetxs.SideMsgServer {
+	return &sideMsgServer{k: keeper}
+}
+
+// SideTxHandler redirects to the right sideMsgServer side_handler based on methodName
+func (s sideMsgServer) SideTxHandler(methodName string) sidetxs.SideTxHandler {
+	switch methodName {
+	case verifyAddressMigrationMsgTypeURL:
+		return s.SideHandleMsgVerifyAddressMigration
+	default:
+		return nil
+	}
+}
+
+// PostTxHandler redirects to the right sideMsgServer post_handler based on methodName
+func (s sideMsgServer) PostTxHandler(methodName string) sidetxs.PostTxHandler {
+	switch methodName {
+	case verifyAddressMigrationMsgTypeURL:
+		return s.PostHandleMsgVerifyAddressMigration
+	default:
+		return nil
+	}
+}
+
+// SideHandleMsgVerifyAddressMigration votes on the new addresses of a pending address migration,
+// checking that they hold the contracts they replace on the root chain
+func (s sideMsgServer) SideHandleMsgVerifyAddressMigration(ctx sdk.Context, msgI sdk.Msg) sidetxs.Vote {
+	logger := s.k.Logger(ctx)
+
+	msg, ok := msgI.(*types.MsgVerifyAddressMigration)
+	if !ok {
+		logger.Error(helper.ErrTypeMismatch("MsgVerifyAddressMigration"))
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	logger.Debug(helper.LogValidatingExternalCall("AddressMigration"), "height", msg.Height)
+
+	migration, err := s.k.getUnverifiedAddressMigration(ctx, msg.Height)
+	if err != nil {
+		logger.Error("Address migration can't be verified", "height", msg.Height, heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if err := VerifyAddressMigration(ctx, s.k.contractCaller, migration); err != nil {
+		logger.Error("Invalid address migration", "height", msg.Height, heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	logger.Debug(helper.LogSuccessfullyValidated("AddressMigration"))
+
+	return sidetxs.Vote_VOTE_YES
+}
+
+// PostHandleMsgVerifyAddressMigration marks the address migration as verified, so it's applied at its height
+func (s sideMsgServer) PostHandleMsgVerifyAddressMigration(ctx sdk.Context, msgI sdk.Msg, sideTxResult sidetxs.Vote) error {
+	logger := s.k.Logger(ctx)
+
+	msg, ok := msgI.(*types.MsgVerifyAddressMigration)
+	if !ok {
+		err := errors.New(helper.ErrTypeMismatch("MsgVerifyAddressMigration"))
+		logger.Error(err.Error())
+		return err
+	}
+
+	// skip handler if the migration is not approved
+	if !helper.IsSideTxApproved(sideTxResult) {
+		logger.Debug(helper.ErrSkippingMsg("VerifyAddressMigration"))
+		return errors.New(heimdallTypes.ErrMsgSideTxRejected)
+	}
+
+	// the migration may have been cancelled or verified since the msg was included
+	migration, err := s.k.getUnverifiedAddressMigration(ctx, msg.Height)
+	if err != nil {
+		logger.Error("Address migration can't be verified", "height", msg.Height, heimdallTypes.LogKeyError, err)
+		return err
+	}
+
+	migration.Verified = true
+	if err := s.k.SetAddressMigration(ctx, migration); err != nil {
+		logger.Error("Error while verifying address migration", "height", msg.Height, heimdallTypes.LogKeyError, err)
+		return err
+	}
+
+	logger.Info("Verified root chain contract address migration", "height", msg.Height)
+
+	return nil
+}
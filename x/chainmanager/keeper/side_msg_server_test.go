@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPolygon/heimdall-v2/contracts/statesender"
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+// counterBackend answers the calls of the bound contracts with a counter, or fails them when there is no contract
+type counterBackend struct {
+	bind.ContractBackend
+	hasCode bool
+}
+
+func (b counterBackend) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	if !b.hasCode {
+		return nil, errors.New("execution reverted")
+	}
+
+	return common.LeftPadBytes(big.NewInt(1).Bytes(), 32), nil
+}
+
+func (s *KeeperTestSuite) TestSideHandleMsgVerifyAddressMigration() {
+	require, cmKeeper, contractCaller := s.Require(), s.cmKeeper, &s.contractCaller
+	ctx := s.ctx.WithBlockHeight(100)
+
+	msg := &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 200}
+	sideHandler := s.sideMsgCfg.GetSideHandler(msg)
+
+	s.Run("no migration at the height", func() {
+		require.Equal(sidetxs.Vote_VOTE_NO, sideHandler(ctx, msg))
+	})
+
+	require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 200, StateSenderAddress: StateSenderAddress}))
+
+	s.Run("no contract at the new address", func() {
+		instance, err := statesender.NewStatesender(common.HexToAddress(StateSenderAddress), counterBackend{})
+		require.NoError(err)
+		contractCaller.On("GetStateSenderInstance", StateSenderAddress).Return(instance, nil).Once()
+
+		require.Equal(sidetxs.Vote_VOTE_NO, sideHandler(ctx, msg))
+	})
+
+	s.Run("contract at the new address", func() {
+		instance, err := statesender.NewStatesender(common.HexToAddress(StateSenderAddress), counterBackend{hasCode: true})
+		require.NoError(err)
+		contractCaller.On("GetStateSenderInstance", StateSenderAddress).Return(instance, nil).Once()
+
+		require.Equal(sidetxs.Vote_VOTE_YES, sideHandler(ctx, msg))
+	})
+
+	s.Run("migration already verified", func() {
+		require.NoError(cmKeeper.SetAddressMigration(ctx, types.AddressMigration{Height: 200, StateSenderAddress: StateSenderAddress, Verified: true}))
+
+		require.Equal(sidetxs.Vote_VOTE_NO, sideHandler(ctx, msg))
+	})
+
+	contractCaller.AssertExpectations(s.T())
+}
+
+func (s *KeeperTestSuite) TestPostHandleMsgVerifyAddressMigration() {
+	require, cmKeeper := s.Require(), s.cmKeeper
+	ctx := s.ctx.WithBlockHeight(100)
+
+	msg := &types.MsgVerifyAddressMigration{From: AccountAddress, Height: 200}
+	postHandler := s.sideMsgCfg.GetPostHandler(msg)
+
+	migration := types.AddressMigration{Height: 200, StateSenderAddress: StateSenderAddress}
+	require.NoError(cmKeeper.SetAddressMigration(ctx, migration))
+
+	s.Run("rejected by the validators", func() {
+		require.Error(postHandler(ctx, msg, sidetxs.Vote_VOTE_NO))
+
+		res, err := cmKeeper.GetAddressMigration(ctx, 200)
+		require.NoError(err)
+		require.False(res.Verified)
+	})
+
+	s.Run("approved by the validators", func() {
+		require.NoError(postHandler(ctx, msg, sidetxs.Vote_VOTE_YES))
+
+		res, err := cmKeeper.GetAddressMigration(ctx, 200)
+		require.NoError(err)
+		require.True(res.Verified)
+	})
+
+	s.Run("migration cancelled meanwhile", func() {
+		require.NoError(cmKeeper.RemoveAddressMigration(ctx, 200))
+
+		require.ErrorIs(postHandler(ctx, msg, sidetxs.Vote_VOTE_YES), types.ErrAddressMigrationNotFound)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/keeper"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/simulation"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
@@ -30,6 +31,8 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.AppModuleBasic      = AppModule{}
 
+	_ sidetxs.HasSideMsgServices = AppModule{}
+
 	_ appmodule.AppModule = AppModule{}
 )
 
@@ -84,6 +87,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 }
 
+// RegisterSideMsgServices registers side handler module services.
+func (am AppModule) RegisterSideMsgServices(sideCfg sidetxs.SideTxConfigurator) {
+	types.RegisterSideMsgServer(sideCfg, keeper.NewSideMsgServerImpl(&am.keeper))
+}
+
 // NewAppModule creates a new AppModule object
 func NewAppModule(
 	keeper keeper.Keeper,
@@ -122,6 +130,11 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
+}
+
 // RegisterStoreDecoder registers a decoder for chainmanager module's types
 func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
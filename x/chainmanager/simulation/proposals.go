@@ -0,0 +1,102 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams             int = 100
+	DefaultWeightMsgScheduleAddressMigration int = 20
+
+	OpWeightMsgUpdateParams             = "op_weight_msg_update_params"
+	OpWeightMsgScheduleAddressMigration = "op_weight_msg_schedule_address_migration"
+)
+
+// maxMigrationDistance bounds the distance between the current height and the simulated address migrations
+const maxMigrationDistance = 1000
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgScheduleAddressMigration,
+			DefaultWeightMsgScheduleAddressMigration,
+			SimulateMsgScheduleAddressMigration,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams, changing the tx confirmations.
+// The chain params are kept, as the simulated txs of the other modules are bound to them.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			params = types.DefaultParams()
+		}
+
+		params.MainChainTxConfirmations = genMainChainTxConfirmations(r)
+		params.BorChainTxConfirmations = genBorChainTxConfirmations(r)
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
+
+// SimulateMsgScheduleAddressMigration returns a random MsgScheduleAddressMigration, changing a random subset
+// of the root chain contract addresses in the next blocks
+func SimulateMsgScheduleAddressMigration(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	migration := types.AddressMigration{
+		Height: ctx.BlockHeight() + 1 + r.Int63n(maxMigrationDistance),
+	}
+
+	// at least one address is migrated
+	for migration.PolTokenAddress == "" && migration.RootChainAddress == "" && migration.StakingManagerAddress == "" &&
+		migration.SlashManagerAddress == "" && migration.StakingInfoAddress == "" && migration.StateSenderAddress == "" {
+		if r.Intn(2) == 0 {
+			migration.PolTokenAddress = genAddress(r)
+		}
+		if r.Intn(2) == 0 {
+			migration.RootChainAddress = genAddress(r)
+		}
+		if r.Intn(2) == 0 {
+			migration.StakingManagerAddress = genAddress(r)
+		}
+		if r.Intn(2) == 0 {
+			migration.SlashManagerAddress = genAddress(r)
+		}
+		if r.Intn(2) == 0 {
+			migration.StakingInfoAddress = genAddress(r)
+		}
+		if r.Intn(2) == 0 {
+			migration.StateSenderAddress = genAddress(r)
+		}
+	}
+
+	return &types.MsgScheduleAddressMigration{
+		Authority: authority.String(),
+		Migration: migration,
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidateBasic checks that the migration changes at least one address, and that the changed ones are valid.
+func (m AddressMigration) ValidateBasic() error {
+	if m.Height <= 0 {
+		return fmt.Errorf("invalid height %d", m.Height)
+	}
+
+	changed := false
+	for _, a := range m.addresses() {
+		if a.value == "" {
+			continue
+		}
+
+		if err := validateHeimdallAddress(a.key, a.value); err != nil {
+			return err
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return errors.New("no address to migrate")
+	}
+
+	return nil
+}
+
+// Apply returns the chain params with the non-empty addresses of the migration.
+func (m AddressMigration) Apply(chainParams ChainParams) ChainParams {
+	if m.PolTokenAddress != "" {
+		chainParams.PolTokenAddress = m.PolTokenAddress
+	}
+
+	if m.RootChainAddress != "" {
+		chainParams.RootChainAddress = m.RootChainAddress
+	}
+
+	if m.StakingManagerAddress != "" {
+		chainParams.StakingManagerAddress = m.StakingManagerAddress
+	}
+
+	if m.SlashManagerAddress != "" {
+		chainParams.SlashManagerAddress = m.SlashManagerAddress
+	}
+
+	if m.StakingInfoAddress != "" {
+		chainParams.StakingInfoAddress = m.StakingInfoAddress
+	}
+
+	if m.StateSenderAddress != "" {
+		chainParams.StateSenderAddress = m.StateSenderAddress
+	}
+
+	return chainParams
+}
+
+type migratedAddress struct {
+	key   string
+	value string
+}
+
+func (m AddressMigration) addresses() []migratedAddress {
+	return []migratedAddress{
+		{"pol_token_address", m.PolTokenAddress},
+		{"root_chain_address", m.RootChainAddress},
+		{"staking_manager_address", m.StakingManagerAddress},
+		{"slash_manager_address", m.SlashManagerAddress},
+		{"staking_info_address", m.StakingInfoAddress},
+		{"state_sender_address", m.StateSenderAddress},
+	}
+}
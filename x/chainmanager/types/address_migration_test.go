@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+func TestAddressMigration_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		migration types.AddressMigration
+		expErr    string
+	}{
+		{
+			name:      "valid",
+			migration: types.AddressMigration{Height: 10, RootChainAddress: "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287"},
+		},
+		{
+			name:      "non-positive height",
+			migration: types.AddressMigration{Height: 0, RootChainAddress: "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287"},
+			expErr:    "invalid height 0",
+		},
+		{
+			name:      "no address",
+			migration: types.AddressMigration{Height: 10},
+			expErr:    "no address to migrate",
+		},
+		{
+			name:      "invalid address",
+			migration: types.AddressMigration{Height: 10, RootChainAddress: "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287", StateSenderAddress: "abc"},
+			expErr:    "invalid address for value abc for state_sender_address",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.migration.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestAddressMigration_Apply(t *testing.T) {
+	t.Parallel()
+
+	chainParams := types.ChainParams{
+		PolTokenAddress:    "0x7d1afa7b718fb893db30a3abc0cfc608aacfebb0",
+		RootChainAddress:   "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287",
+		StateSenderAddress: "0x28e4f3a7f651294b9564800b2d01f35189a5bfbe",
+	}
+	migration := types.AddressMigration{
+		Height:           10,
+		RootChainAddress: "0x1111111111111111111111111111111111111111",
+	}
+
+	expected := chainParams
+	expected.RootChainAddress = migration.RootChainAddress
+
+	require.Equal(t, expected, migration.Apply(chainParams))
+}
@@ -24,6 +24,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConfirmationMode selects how main chain transactions are considered
+// confirmed.
+type ConfirmationMode int32
+
+const (
+	// Included in a finalized block when the main chain RPC exposes it, else
+	// main_chain_tx_confirmations blocks deep.
+	ConfirmationMode_CONFIRMATION_MODE_UNSPECIFIED ConfirmationMode = 0
+	// main_chain_tx_confirmations blocks deep.
+	ConfirmationMode_CONFIRMATION_MODE_DEPTH ConfirmationMode = 1
+	// Included in a finalized block.
+	ConfirmationMode_CONFIRMATION_MODE_FINALITY ConfirmationMode = 2
+)
+
+var ConfirmationMode_name = map[int32]string{
+	0: "CONFIRMATION_MODE_UNSPECIFIED",
+	1: "CONFIRMATION_MODE_DEPTH",
+	2: "CONFIRMATION_MODE_FINALITY",
+}
+
+var ConfirmationMode_value = map[string]int32{
+	"CONFIRMATION_MODE_UNSPECIFIED": 0,
+	"CONFIRMATION_MODE_DEPTH":       1,
+	"CONFIRMATION_MODE_FINALITY":    2,
+}
+
+func (x ConfirmationMode) String() string {
+	return proto.EnumName(ConfirmationMode_name, int32(x))
+}
+
+func (ConfirmationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_81d5c74e35ef83d3, []int{0}
+}
+
 // ChainParams contains configuration for both chains and contract addresses.
 type ChainParams struct {
 	// Chain ID of the Bor (child) chain.
@@ -159,6 +193,8 @@ type Params struct {
 	MainChainTxConfirmations uint64 `protobuf:"varint,2,opt,name=main_chain_tx_confirmations,json=mainChainTxConfirmations,proto3" json:"main_chain_tx_confirmations,omitempty"`
 	// Number of confirmations required for Bor chain transactions.
 	BorChainTxConfirmations uint64 `protobuf:"varint,3,opt,name=bor_chain_tx_confirmations,json=borChainTxConfirmations,proto3" json:"bor_chain_tx_confirmations,omitempty"`
+	// How main chain transactions are considered confirmed.
+	MainChainConfirmationMode ConfirmationMode `protobuf:"varint,4,opt,name=main_chain_confirmation_mode,json=mainChainConfirmationMode,proto3,enum=heimdallv2.chainmanager.ConfirmationMode" json:"main_chain_confirmation_mode,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -215,9 +251,131 @@ func (m *Params) GetBorChainTxConfirmations() uint64 {
 	return 0
 }
 
+func (m *Params) GetMainChainConfirmationMode() ConfirmationMode {
+	if m != nil {
+		return m.MainChainConfirmationMode
+	}
+	return ConfirmationMode_CONFIRMATION_MODE_UNSPECIFIED
+}
+
+// AddressMigration is a change of the root chain contract addresses of the
+// chain params, scheduled by governance and applied at the end of the block at
+// its height. Empty addresses are left unchanged.
+type AddressMigration struct {
+	// Height of the block at the end of which the addresses change.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// New address of the POL token contract.
+	PolTokenAddress string `protobuf:"bytes,2,opt,name=pol_token_address,json=polTokenAddress,proto3" json:"pol_token_address,omitempty"`
+	// New address of the root chain contract.
+	RootChainAddress string `protobuf:"bytes,3,opt,name=root_chain_address,json=rootChainAddress,proto3" json:"root_chain_address,omitempty"`
+	// New address of the staking manager contract.
+	StakingManagerAddress string `protobuf:"bytes,4,opt,name=staking_manager_address,json=stakingManagerAddress,proto3" json:"staking_manager_address,omitempty"`
+	// New address of the slash manager contract.
+	SlashManagerAddress string `protobuf:"bytes,5,opt,name=slash_manager_address,json=slashManagerAddress,proto3" json:"slash_manager_address,omitempty"`
+	// New address of the staking info contract.
+	StakingInfoAddress string `protobuf:"bytes,6,opt,name=staking_info_address,json=stakingInfoAddress,proto3" json:"staking_info_address,omitempty"`
+	// New address of the state sender contract.
+	StateSenderAddress string `protobuf:"bytes,7,opt,name=state_sender_address,json=stateSenderAddress,proto3" json:"state_sender_address,omitempty"`
+	// Whether the validators verified the new addresses against the main chain
+	// with a MsgVerifyAddressMigration side tx. An unverified migration is
+	// dropped at its height.
+	Verified bool `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *AddressMigration) Reset()         { *m = AddressMigration{} }
+func (m *AddressMigration) String() string { return proto.CompactTextString(m) }
+func (*AddressMigration) ProtoMessage()    {}
+func (*AddressMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d5c74e35ef83d3, []int{2}
+}
+func (m *AddressMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressMigration.Merge(m, src)
+}
+func (m *AddressMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressMigration proto.InternalMessageInfo
+
+func (m *AddressMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AddressMigration) GetPolTokenAddress() string {
+	if m != nil {
+		return m.PolTokenAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetRootChainAddress() string {
+	if m != nil {
+		return m.RootChainAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetStakingManagerAddress() string {
+	if m != nil {
+		return m.StakingManagerAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetSlashManagerAddress() string {
+	if m != nil {
+		return m.SlashManagerAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetStakingInfoAddress() string {
+	if m != nil {
+		return m.StakingInfoAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetStateSenderAddress() string {
+	if m != nil {
+		return m.StateSenderAddress
+	}
+	return ""
+}
+
+func (m *AddressMigration) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("heimdallv2.chainmanager.ConfirmationMode", ConfirmationMode_name, ConfirmationMode_value)
 	proto.RegisterType((*ChainParams)(nil), "heimdallv2.chainmanager.ChainParams")
 	proto.RegisterType((*Params)(nil), "heimdallv2.chainmanager.Params")
+	proto.RegisterType((*AddressMigration)(nil), "heimdallv2.chainmanager.AddressMigration")
 }
 
 func init() {
@@ -225,39 +383,50 @@ func init() {
 }
 
 var fileDescriptor_81d5c74e35ef83d3 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0xd6, 0x15, 0xea, 0x4e, 0x82, 0x85, 0x8d, 0x56, 0x45, 0xca, 0xd0, 0x84, 0x04,
-	0x9a, 0x44, 0x02, 0x9d, 0x10, 0x02, 0xc4, 0x81, 0x8e, 0xcb, 0x0e, 0x88, 0xa9, 0xdb, 0x89, 0x4b,
-	0xe4, 0x26, 0x6e, 0x6a, 0x2d, 0xf1, 0x17, 0xd9, 0xa6, 0xea, 0xde, 0x82, 0x47, 0xe0, 0xc8, 0x91,
-	0x3b, 0x2f, 0xb0, 0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0x0f, 0x70, 0xe0, 0x21, 0x50, 0xec, 0x38, 0x75,
-	0x3a, 0x71, 0xa9, 0x9a, 0xf8, 0xf7, 0xfb, 0xc7, 0x9f, 0xf5, 0x37, 0x3a, 0x98, 0x12, 0x9a, 0xc5,
-	0x38, 0x4d, 0x67, 0x83, 0x20, 0x9a, 0x62, 0xca, 0x32, 0xcc, 0x70, 0x42, 0x78, 0xed, 0xc1, 0xcf,
-	0x39, 0x48, 0x70, 0xbb, 0x2b, 0xd6, 0xb7, 0x97, 0xfb, 0x3b, 0x09, 0x24, 0xa0, 0x98, 0xa0, 0xf8,
-	0xa7, 0xf1, 0xfe, 0x36, 0xce, 0x28, 0x83, 0x40, 0xfd, 0xea, 0x57, 0xfb, 0xdf, 0x9b, 0xa8, 0x73,
-	0x54, 0x98, 0x27, 0x98, 0xe3, 0x4c, 0xb8, 0x8f, 0xd0, 0xd6, 0x18, 0x78, 0xa8, 0xc2, 0x42, 0x1a,
-	0xf7, 0x9c, 0x07, 0xce, 0xe3, 0xf6, 0x70, 0xf3, 0xeb, 0xef, 0x6f, 0x07, 0xce, 0x08, 0x8d, 0x81,
-	0x2b, 0xf8, 0x38, 0x76, 0x9f, 0xa1, 0x6d, 0xf3, 0xf1, 0x15, 0x7d, 0xc3, 0xa6, 0x6f, 0x9b, 0x75,
-	0x4b, 0xc9, 0x21, 0x0d, 0x25, 0x9c, 0x13, 0x16, 0xe2, 0x38, 0xe6, 0x44, 0x88, 0xde, 0x46, 0x4d,
-	0xc9, 0x21, 0x3d, 0x2b, 0x96, 0xdf, 0xea, 0x55, 0xf7, 0x0d, 0xea, 0x0a, 0x89, 0xcf, 0x29, 0x4b,
-	0xc2, 0x72, 0xb4, 0x4a, 0x6c, 0xda, 0xe2, 0x6e, 0x49, 0xbd, 0xd7, 0x90, 0xd1, 0x5f, 0xa2, 0x5d,
-	0x91, 0x62, 0x31, 0xbd, 0x26, 0x6f, 0xda, 0xf2, 0x5d, 0xc5, 0xac, 0xa9, 0x87, 0xc8, 0xe5, 0x00,
-	0xb2, 0x9c, 0xcd, 0x78, 0x2d, 0xdb, 0xbb, 0x53, 0x00, 0x6a, 0x38, 0x23, 0xbd, 0x40, 0x3b, 0x66,
-	0xbb, 0x94, 0x4d, 0xa0, 0xd2, 0x6e, 0xda, 0x9a, 0x5b, 0x22, 0xc7, 0x6c, 0x02, 0x75, 0x51, 0x92,
-	0x50, 0x10, 0x16, 0x5b, 0xfb, 0xbc, 0xb5, 0x2e, 0x4a, 0x72, 0xaa, 0x08, 0x23, 0xbe, 0x46, 0xf7,
-	0xb4, 0xc8, 0x49, 0x44, 0xe8, 0xcc, 0x52, 0xdb, 0xb6, 0xaa, 0xd3, 0x47, 0x25, 0x63, 0x1d, 0xcf,
-	0x0c, 0xa7, 0x34, 0xc6, 0x12, 0x78, 0x28, 0x88, 0xac, 0x5c, 0x54, 0x3b, 0x9e, 0x8a, 0x39, 0x25,
-	0xb2, 0x54, 0x5f, 0x35, 0xff, 0x7c, 0xd9, 0x73, 0xf6, 0xff, 0x3a, 0xa8, 0x55, 0x16, 0x67, 0x84,
-	0xb6, 0xf4, 0x51, 0xe5, 0xea, 0x59, 0x15, 0xa7, 0x33, 0x78, 0xe8, 0xff, 0xa7, 0xa1, 0xbe, 0x55,
-	0xba, 0x61, 0xfb, 0xf2, 0xe7, 0x5e, 0x43, 0x7f, 0xac, 0x13, 0x59, 0x65, 0x7c, 0x87, 0xee, 0x67,
-	0x45, 0xa4, 0x0e, 0x96, 0xf3, 0x30, 0x02, 0x36, 0xa1, 0x3c, 0xc3, 0x92, 0x02, 0x13, 0xaa, 0x6d,
-	0x4d, 0xb3, 0xcb, 0x5e, 0x41, 0xaa, 0xd0, 0xb3, 0xf9, 0x91, 0x8d, 0xb9, 0x43, 0xd4, 0x5f, 0x55,
-	0xfa, 0x5a, 0xc8, 0x86, 0x1d, 0xd2, 0x35, 0x05, 0x5f, 0xcb, 0xd0, 0xe3, 0x0e, 0x3f, 0x5c, 0x2e,
-	0x3c, 0xe7, 0x6a, 0xe1, 0x39, 0xbf, 0x16, 0x9e, 0xf3, 0x79, 0xe9, 0x35, 0xae, 0x96, 0x5e, 0xe3,
-	0xc7, 0xd2, 0x6b, 0x7c, 0x7c, 0x9e, 0x50, 0x39, 0xfd, 0x34, 0xf6, 0x23, 0xc8, 0x82, 0xa7, 0xf3,
-	0x13, 0x48, 0x2f, 0x12, 0x60, 0x81, 0x99, 0xfd, 0xc9, 0x6c, 0x10, 0xcc, 0xeb, 0x97, 0x59, 0x5e,
-	0xe4, 0x44, 0x8c, 0x5b, 0xea, 0x12, 0x1e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xc6, 0x17,
-	0x5c, 0xf4, 0x03, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x4d, 0x6f, 0x12, 0x41,
+	0x18, 0xc7, 0x59, 0x4a, 0x69, 0x3b, 0x6d, 0x94, 0x8e, 0xad, 0x54, 0x6a, 0xb7, 0xda, 0x98, 0xa8,
+	0x44, 0xa1, 0xd2, 0xa8, 0x51, 0xe3, 0xa1, 0xbc, 0x34, 0x6e, 0x22, 0x2f, 0xa1, 0x78, 0xd0, 0xcb,
+	0x66, 0x60, 0x87, 0x65, 0xd2, 0xdd, 0x1d, 0x9c, 0x1d, 0x09, 0xfd, 0x16, 0x7e, 0x04, 0x8f, 0x1e,
+	0xbd, 0xfb, 0x05, 0x7a, 0xec, 0xd1, 0x93, 0x9a, 0xf6, 0xa0, 0x5f, 0xc2, 0xc4, 0x30, 0xfb, 0xc2,
+	0xb0, 0x80, 0x5e, 0x9a, 0xce, 0x3c, 0xff, 0xdf, 0x33, 0x33, 0x0f, 0xff, 0x3f, 0x80, 0x6c, 0x0f,
+	0x13, 0xdb, 0x40, 0x96, 0x35, 0x28, 0xe4, 0x3b, 0x3d, 0x44, 0x1c, 0x1b, 0x39, 0xc8, 0xc4, 0x6c,
+	0x62, 0x91, 0xeb, 0x33, 0xca, 0x29, 0x4c, 0x8f, 0xb5, 0x39, 0xb9, 0x9c, 0xd9, 0x30, 0xa9, 0x49,
+	0x85, 0x26, 0x3f, 0xfa, 0xcf, 0x93, 0x67, 0xd6, 0x91, 0x4d, 0x1c, 0x9a, 0x17, 0x7f, 0xbd, 0xad,
+	0xbd, 0xaf, 0x09, 0xb0, 0x5a, 0x1a, 0x91, 0x0d, 0xc4, 0x90, 0xed, 0xc2, 0xbb, 0x60, 0xad, 0x4d,
+	0x99, 0x2e, 0x9a, 0xe9, 0xc4, 0xd8, 0x52, 0x6e, 0x29, 0xf7, 0x56, 0x8a, 0x8b, 0x9f, 0x7f, 0x7d,
+	0xc9, 0x2a, 0x4d, 0xd0, 0xa6, 0x4c, 0x88, 0x35, 0x03, 0x3e, 0x02, 0xeb, 0xc1, 0xe1, 0x63, 0x75,
+	0x5c, 0x56, 0x5f, 0x0d, 0xea, 0x12, 0xd2, 0xa7, 0x96, 0xce, 0xe9, 0x09, 0x76, 0x74, 0x64, 0x18,
+	0x0c, 0xbb, 0xee, 0xd6, 0xc2, 0x04, 0xd2, 0xa7, 0x56, 0x6b, 0x54, 0x3e, 0xf4, 0xaa, 0xf0, 0x25,
+	0x48, 0xbb, 0x1c, 0x9d, 0x10, 0xc7, 0xd4, 0xfd, 0xa7, 0x85, 0x60, 0x42, 0x06, 0x37, 0x7d, 0x55,
+	0xd5, 0x13, 0x05, 0xf8, 0x33, 0xb0, 0xe9, 0x5a, 0xc8, 0xed, 0x4d, 0xc1, 0x8b, 0x32, 0x7c, 0x4d,
+	0x68, 0x22, 0xe8, 0x01, 0x80, 0x8c, 0x52, 0xee, 0xbf, 0x2d, 0xe0, 0x92, 0x32, 0x97, 0x1a, 0x09,
+	0xc4, 0xe3, 0x02, 0xe8, 0x29, 0xd8, 0x08, 0xae, 0x4b, 0x9c, 0x2e, 0x0d, 0xb1, 0x25, 0x19, 0x83,
+	0xbe, 0x44, 0x73, 0xba, 0x74, 0x12, 0xe4, 0x58, 0x77, 0xb1, 0x63, 0x48, 0xf7, 0x5c, 0x8e, 0x82,
+	0x1c, 0x1f, 0x0b, 0x45, 0x00, 0xbe, 0x00, 0xd7, 0x3d, 0x90, 0xe1, 0x0e, 0x26, 0x03, 0x09, 0x5d,
+	0x91, 0x51, 0xaf, 0x7b, 0xd3, 0xd7, 0x48, 0xe3, 0x19, 0x20, 0x8b, 0x18, 0x88, 0x53, 0xa6, 0xbb,
+	0x98, 0x87, 0x2c, 0x98, 0x18, 0x4f, 0xa8, 0x39, 0xc6, 0xdc, 0x47, 0x9f, 0x27, 0x7e, 0x7f, 0xda,
+	0x55, 0xf6, 0x7e, 0xc4, 0x41, 0xd2, 0x37, 0x4e, 0x13, 0xac, 0x79, 0xa3, 0xea, 0x8b, 0xb5, 0x30,
+	0xce, 0x6a, 0xe1, 0x4e, 0x6e, 0x8e, 0x43, 0x73, 0x92, 0xe9, 0x8a, 0x2b, 0x67, 0xdf, 0x77, 0x63,
+	0xde, 0x61, 0xab, 0x9d, 0xf1, 0x3e, 0x2c, 0x83, 0x6d, 0x7b, 0xd4, 0xd2, 0x6b, 0xcc, 0x87, 0x7a,
+	0x87, 0x3a, 0x5d, 0xc2, 0x6c, 0xc4, 0x09, 0x75, 0x5c, 0xe1, 0xb6, 0x44, 0x70, 0xcb, 0xad, 0x91,
+	0x52, 0x34, 0x6d, 0x0d, 0x4b, 0xb2, 0x0c, 0x16, 0x41, 0x66, 0x6c, 0xe9, 0xa9, 0x26, 0x0b, 0x72,
+	0x93, 0x74, 0x60, 0xf0, 0x68, 0x8f, 0xf7, 0xe0, 0xa6, 0x74, 0x13, 0xb9, 0x83, 0x6e, 0x53, 0x03,
+	0x0b, 0x33, 0x5e, 0x29, 0xdc, 0x9f, 0xff, 0x5a, 0x89, 0xa8, 0x52, 0x03, 0x07, 0x07, 0xde, 0x08,
+	0x6f, 0x1d, 0x55, 0xf8, 0x13, 0xfe, 0x13, 0x07, 0x29, 0x7f, 0xe6, 0x55, 0x62, 0x32, 0x51, 0x86,
+	0x3b, 0x20, 0xd9, 0xc3, 0xc4, 0xec, 0x71, 0x31, 0xe5, 0x85, 0xa0, 0x99, 0xbf, 0x09, 0xb3, 0xb3,
+	0x72, 0x26, 0xa2, 0x39, 0x1d, 0xb0, 0x07, 0x33, 0x6d, 0x2e, 0x42, 0x39, 0xc3, 0xdf, 0x4f, 0xfe,
+	0x13, 0xc7, 0x79, 0x39, 0x2c, 0xfc, 0x33, 0x87, 0xb3, 0x03, 0xb8, 0x3f, 0x27, 0x4b, 0x22, 0x82,
+	0x33, 0x43, 0xb4, 0x3f, 0x27, 0x44, 0x4b, 0x21, 0x11, 0x4d, 0x4f, 0x06, 0x2c, 0x0f, 0x30, 0x23,
+	0x5d, 0x82, 0x0d, 0x11, 0xb5, 0xe5, 0x66, 0xb8, 0xf6, 0xe6, 0x9f, 0x65, 0x20, 0x15, 0xfd, 0x64,
+	0xe0, 0x6d, 0xb0, 0x53, 0xaa, 0xd7, 0x8e, 0xb4, 0x66, 0xf5, 0xb0, 0xa5, 0xd5, 0x6b, 0x7a, 0xb5,
+	0x5e, 0xae, 0xe8, 0x6f, 0x6a, 0xc7, 0x8d, 0x4a, 0x49, 0x3b, 0xd2, 0x2a, 0xe5, 0x54, 0x0c, 0x6e,
+	0x83, 0xf4, 0xb4, 0xa4, 0x5c, 0x69, 0xb4, 0x5e, 0xa5, 0x14, 0xa8, 0x82, 0xcc, 0x74, 0xf1, 0x48,
+	0xab, 0x1d, 0xbe, 0xd6, 0x5a, 0x6f, 0x53, 0xf1, 0x62, 0xfd, 0xec, 0x42, 0x55, 0xce, 0x2f, 0x54,
+	0xe5, 0xe7, 0x85, 0xaa, 0x7c, 0xbc, 0x54, 0x63, 0xe7, 0x97, 0x6a, 0xec, 0xdb, 0xa5, 0x1a, 0x7b,
+	0xf7, 0xd8, 0x24, 0xbc, 0xf7, 0xa1, 0x9d, 0xeb, 0x50, 0x3b, 0xbf, 0x3f, 0x6c, 0x50, 0xeb, 0xd4,
+	0xa4, 0x4e, 0x3e, 0x30, 0xdd, 0xc3, 0x41, 0x21, 0x3f, 0x9c, 0xfc, 0xcd, 0xe0, 0xa7, 0x7d, 0xec,
+	0xb6, 0x93, 0xe2, 0xbb, 0xfe, 0xe0, 0xef, 0x00, 0x30, 0x8a, 0xf5, 0xc7, 0x5b, 0x06, 0x00, 0x00,
 }
 
 func (this *ChainParams) Equal(that interface{}) bool {
@@ -339,6 +508,54 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BorChainTxConfirmations != that1.BorChainTxConfirmations {
 		return false
 	}
+	if this.MainChainConfirmationMode != that1.MainChainConfirmationMode {
+		return false
+	}
+	return true
+}
+func (this *AddressMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressMigration)
+	if !ok {
+		that2, ok := that.(AddressMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.PolTokenAddress != that1.PolTokenAddress {
+		return false
+	}
+	if this.RootChainAddress != that1.RootChainAddress {
+		return false
+	}
+	if this.StakingManagerAddress != that1.StakingManagerAddress {
+		return false
+	}
+	if this.SlashManagerAddress != that1.SlashManagerAddress {
+		return false
+	}
+	if this.StakingInfoAddress != that1.StakingInfoAddress {
+		return false
+	}
+	if this.StateSenderAddress != that1.StateSenderAddress {
+		return false
+	}
+	if this.Verified != that1.Verified {
+		return false
+	}
 	return true
 }
 func (m *ChainParams) Marshal() (dAtA []byte, err error) {
@@ -454,6 +671,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MainChainConfirmationMode != 0 {
+		i = encodeVarintChainmanager(dAtA, i, uint64(m.MainChainConfirmationMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BorChainTxConfirmations != 0 {
 		i = encodeVarintChainmanager(dAtA, i, uint64(m.BorChainTxConfirmations))
 		i--
@@ -477,6 +699,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AddressMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.StateSenderAddress) > 0 {
+		i -= len(m.StateSenderAddress)
+		copy(dAtA[i:], m.StateSenderAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.StateSenderAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StakingInfoAddress) > 0 {
+		i -= len(m.StakingInfoAddress)
+		copy(dAtA[i:], m.StakingInfoAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.StakingInfoAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SlashManagerAddress) > 0 {
+		i -= len(m.SlashManagerAddress)
+		copy(dAtA[i:], m.SlashManagerAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.SlashManagerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingManagerAddress) > 0 {
+		i -= len(m.StakingManagerAddress)
+		copy(dAtA[i:], m.StakingManagerAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.StakingManagerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RootChainAddress) > 0 {
+		i -= len(m.RootChainAddress)
+		copy(dAtA[i:], m.RootChainAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.RootChainAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolTokenAddress) > 0 {
+		i -= len(m.PolTokenAddress)
+		copy(dAtA[i:], m.PolTokenAddress)
+		i = encodeVarintChainmanager(dAtA, i, uint64(len(m.PolTokenAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintChainmanager(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChainmanager(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChainmanager(v)
 	base := offset
@@ -551,6 +853,48 @@ func (m *Params) Size() (n int) {
 	if m.BorChainTxConfirmations != 0 {
 		n += 1 + sovChainmanager(uint64(m.BorChainTxConfirmations))
 	}
+	if m.MainChainConfirmationMode != 0 {
+		n += 1 + sovChainmanager(uint64(m.MainChainConfirmationMode))
+	}
+	return n
+}
+
+func (m *AddressMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovChainmanager(uint64(m.Height))
+	}
+	l = len(m.PolTokenAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	l = len(m.RootChainAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	l = len(m.StakingManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	l = len(m.SlashManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	l = len(m.StakingInfoAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	l = len(m.StateSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovChainmanager(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
 	return n
 }
 
@@ -1030,6 +1374,306 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainChainConfirmationMode", wireType)
+			}
+			m.MainChainConfirmationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MainChainConfirmationMode |= ConfirmationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainmanager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainmanager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolTokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolTokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootChainAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootChainAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingInfoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingInfoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainmanager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainmanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainmanager(dAtA[iNdEx:])
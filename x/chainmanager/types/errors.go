@@ -2,4 +2,9 @@ package types
 
 import "cosmossdk.io/errors"
 
-var ErrInvalidParams = errors.Register(ModuleName, 1, "invalid params")
+var (
+	ErrInvalidParams            = errors.Register(ModuleName, 1, "invalid params")
+	ErrInvalidAddressMigration  = errors.Register(ModuleName, 2, "invalid address migration")
+	ErrAddressMigrationExists   = errors.Register(ModuleName, 3, "address migration already scheduled at height")
+	ErrAddressMigrationNotFound = errors.Register(ModuleName, 4, "no address migration scheduled at height")
+)
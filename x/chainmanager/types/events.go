@@ -0,0 +1,10 @@
+package types
+
+// chainmanager module event types
+const (
+	EventTypeAddressMigrationDropped = "address-migration-dropped"
+
+	AttributeKeyMigrationHeight = "migration-height"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+)
+
+func TestEventTypeAddressMigrationDropped(t *testing.T) {
+	require.Equal(t, "address-migration-dropped", types.EventTypeAddressMigrationDropped)
+	require.NotEmpty(t, types.EventTypeAddressMigrationDropped)
+}
+
+func TestAttributeKeyMigrationHeight(t *testing.T) {
+	require.Equal(t, "migration-height", types.AttributeKeyMigrationHeight)
+	require.NotEmpty(t, types.AttributeKeyMigrationHeight)
+}
+
+func TestAttributeValueCategory(t *testing.T) {
+	require.Equal(t, types.ModuleName, types.AttributeValueCategory)
+}
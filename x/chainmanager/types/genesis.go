@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -21,6 +22,19 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic validation of chainmanager genesis data, returning an
 // error for any failed validation criteria.
 func (gs GenesisState) Validate() error {
+	heights := make(map[int64]struct{}, len(gs.AddressMigrations))
+	for _, m := range gs.AddressMigrations {
+		if err := m.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid address migration at height %d: %w", m.Height, err)
+		}
+
+		if _, ok := heights[m.Height]; ok {
+			return fmt.Errorf("duplicate address migration at height %d", m.Height)
+		}
+
+		heights[m.Height] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Module parameters at genesis.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Address migrations scheduled at future heights.
+	AddressMigrations []AddressMigration `protobuf:"bytes,2,rep,name=address_migrations,json=addressMigrations,proto3" json:"address_migrations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAddressMigrations() []AddressMigration {
+	if m != nil {
+		return m.AddressMigrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "heimdallv2.chainmanager.GenesisState")
 }
@@ -79,21 +88,24 @@ func init() {
 }
 
 var fileDescriptor_982f01eab872b873 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
+	// 268 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x48, 0xcd, 0xcc,
 	0x4d, 0x49, 0xcc, 0xc9, 0x29, 0x33, 0xd2, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x4d, 0xcc, 0x4b,
 	0x4c, 0x4f, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x47, 0x28, 0xd3, 0x43, 0x56, 0x26, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56,
 	0xa3, 0x0f, 0x62, 0x41, 0x94, 0x4b, 0x69, 0xe1, 0x32, 0x15, 0x99, 0x03, 0x55, 0x2b, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x41, 0x5c, 0x3c, 0xee, 0x10, 0xeb, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x9c, 0xb8, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0x38, 0x47, 0x2f, 0x00, 0xac, 0xcc, 0x89, 0xf3,
-	0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0x75, 0x3a, 0xf9, 0x9f, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x41, 0x45, 0x40, 0x7e, 0x4e, 0x65, 0x7a, 0x7e, 0x9e, 0x3e, 0xcc,
-	0x06, 0xdd, 0x32, 0x23, 0xfd, 0x0a, 0x54, 0x4f, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0xdd, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xce, 0xb6, 0x97, 0x42, 0x01, 0x00, 0x00,
+	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0xdb, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0xf6,
+	0x07, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b,
+	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1, 0x70, 0x8f, 0x5e, 0x00, 0x58, 0x99, 0x13,
+	0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0x14, 0x4a, 0xe6,
+	0x12, 0x4a, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x8e, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9,
+	0xcc, 0xcf, 0x2b, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xc4, 0x69, 0x9e, 0x23, 0x44,
+	0x8b, 0x2f, 0x4c, 0x07, 0xb2, 0xc9, 0x82, 0x89, 0x68, 0x92, 0xc5, 0x4e, 0xfe, 0x27, 0x1e, 0xc9,
+	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
+	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
+	0x9c, 0x9f, 0xab, 0x6f, 0x50, 0x11, 0x90, 0x9f, 0x53, 0x99, 0x9e, 0x9f, 0xa7, 0x0f, 0xb3, 0x56,
+	0xb7, 0xcc, 0x48, 0xbf, 0x02, 0x35, 0xa8, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x21,
+	0x62, 0x0c, 0x18, 0x00, 0xea, 0xa4, 0xef, 0xda, 0xa8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +128,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddressMigrations) > 0 {
+		for iNdEx := len(m.AddressMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -148,6 +174,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AddressMigrations) > 0 {
+		for _, e := range m.AddressMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +251,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressMigrations = append(m.AddressMigrations, AddressMigration{})
+			if err := m.AddressMigrations[len(m.AddressMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -79,6 +79,31 @@ func TestGenesisState_Validate(t *testing.T) {
 		err := gs.Validate()
 		require.NoError(t, err)
 	})
+
+	t.Run("rejects invalid address migration", func(t *testing.T) {
+		t.Parallel()
+
+		gs := types.GenesisState{
+			Params:            types.DefaultParams(),
+			AddressMigrations: []types.AddressMigration{{Height: 10}},
+		}
+
+		err := gs.Validate()
+		require.ErrorContains(t, err, "invalid address migration at height 10")
+	})
+
+	t.Run("rejects duplicate address migration height", func(t *testing.T) {
+		t.Parallel()
+
+		m := types.AddressMigration{Height: 10, RootChainAddress: "0x86e4dc95c7fbdbf52e33d563bbdb00823894c287"}
+		gs := types.GenesisState{
+			Params:            types.DefaultParams(),
+			AddressMigrations: []types.AddressMigration{m, m},
+		}
+
+		err := gs.Validate()
+		require.ErrorContains(t, err, "duplicate address migration at height 10")
+	})
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
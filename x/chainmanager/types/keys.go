@@ -13,4 +13,7 @@ const (
 	RouterKey = ModuleName
 )
 
-var ParamsKey = collections.NewPrefix(0) // ParamsKey is the key to store the params in the store
+var (
+	ParamsKey            = collections.NewPrefix(0) // ParamsKey is the key to store the params in the store
+	AddressMigrationsKey = collections.NewPrefix(1) // AddressMigrationsKey is the prefix to store the address migrations by height
+)
@@ -74,6 +74,10 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if _, ok := ConfirmationMode_name[int32(p.MainChainConfirmationMode)]; !ok {
+		return fmt.Errorf("invalid main_chain_confirmation_mode %d", p.MainChainConfirmationMode)
+	}
+
 	return nil
 }
 
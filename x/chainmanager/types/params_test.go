@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 )
 
@@ -87,6 +88,28 @@ func TestParams_ValidateBasic(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("validates main chain confirmation modes", func(t *testing.T) {
+		t.Parallel()
+
+		validAddress := "0x1234567890123456789012345678901234567890"
+		params := types.NewParams(6, 10, types.ChainParams{
+			PolTokenAddress:       validAddress,
+			StakingManagerAddress: validAddress,
+			SlashManagerAddress:   validAddress,
+			RootChainAddress:      validAddress,
+			StakingInfoAddress:    validAddress,
+			StateSenderAddress:    validAddress,
+			StateReceiverAddress:  validAddress,
+			ValidatorSetAddress:   validAddress,
+		})
+
+		params.MainChainConfirmationMode = types.ConfirmationMode_CONFIRMATION_MODE_FINALITY
+		require.NoError(t, params.ValidateBasic())
+
+		params.MainChainConfirmationMode = types.ConfirmationMode(3)
+		require.ErrorContains(t, params.ValidateBasic(), "main_chain_confirmation_mode")
+	})
+
 	t.Run("rejects invalid pol token address", func(t *testing.T) {
 		t.Parallel()
 
@@ -279,3 +302,12 @@ func TestDefaultConstants(t *testing.T) {
 		require.Equal(t, "0x0000000000000000000000000000000000001000", types.DefaultValidatorSetAddress)
 	})
 }
+
+func TestConfirmationMode_MatchesHelper(t *testing.T) {
+	t.Parallel()
+
+	// the contract caller takes the mode as a helper.ConfirmationMode
+	require.Equal(t, helper.ConfirmationModeDefault, helper.ConfirmationMode(types.ConfirmationMode_CONFIRMATION_MODE_UNSPECIFIED))
+	require.Equal(t, helper.ConfirmationModeDepth, helper.ConfirmationMode(types.ConfirmationMode_CONFIRMATION_MODE_DEPTH))
+	require.Equal(t, helper.ConfirmationModeFinality, helper.ConfirmationMode(types.ConfirmationMode_CONFIRMATION_MODE_FINALITY))
+}
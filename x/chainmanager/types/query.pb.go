@@ -114,9 +114,96 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPendingAddressMigrationsRequest is the request type for the
+// GetPendingAddressMigrations query.
+type QueryPendingAddressMigrationsRequest struct {
+}
+
+func (m *QueryPendingAddressMigrationsRequest) Reset()         { *m = QueryPendingAddressMigrationsRequest{} }
+func (m *QueryPendingAddressMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAddressMigrationsRequest) ProtoMessage()    {}
+func (*QueryPendingAddressMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cd7bbae29d5b72a, []int{2}
+}
+func (m *QueryPendingAddressMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAddressMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAddressMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAddressMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAddressMigrationsRequest.Merge(m, src)
+}
+func (m *QueryPendingAddressMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAddressMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAddressMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAddressMigrationsRequest proto.InternalMessageInfo
+
+// QueryPendingAddressMigrationsResponse is the response type for the
+// GetPendingAddressMigrations query.
+type QueryPendingAddressMigrationsResponse struct {
+	// Address migrations scheduled at future heights, ordered by height.
+	Migrations []AddressMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations"`
+}
+
+func (m *QueryPendingAddressMigrationsResponse) Reset()         { *m = QueryPendingAddressMigrationsResponse{} }
+func (m *QueryPendingAddressMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAddressMigrationsResponse) ProtoMessage()    {}
+func (*QueryPendingAddressMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cd7bbae29d5b72a, []int{3}
+}
+func (m *QueryPendingAddressMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAddressMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAddressMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAddressMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAddressMigrationsResponse.Merge(m, src)
+}
+func (m *QueryPendingAddressMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAddressMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAddressMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAddressMigrationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingAddressMigrationsResponse) GetMigrations() []AddressMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "heimdallv2.chainmanager.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "heimdallv2.chainmanager.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingAddressMigrationsRequest)(nil), "heimdallv2.chainmanager.QueryPendingAddressMigrationsRequest")
+	proto.RegisterType((*QueryPendingAddressMigrationsResponse)(nil), "heimdallv2.chainmanager.QueryPendingAddressMigrationsResponse")
 }
 
 func init() {
@@ -124,28 +211,34 @@ func init() {
 }
 
 var fileDescriptor_8cd7bbae29d5b72a = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0x29, 0x33, 0xd2, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x47, 0x28, 0xd2, 0x43, 0x56, 0x24, 0x25, 0x9d, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x0c, 0x51,
-	0xac, 0x5f, 0x66, 0x88, 0xac, 0x4b, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42,
-	0x85, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x93, 0x9e, 0x9f,
-	0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92, 0x58, 0x92,
-	0x99, 0x9f, 0x57, 0x0c, 0x95, 0xd5, 0xc2, 0xe5, 0x42, 0x64, 0x0e, 0x44, 0xad, 0x92, 0x08, 0x97,
-	0x50, 0x20, 0xc8, 0x05, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5,
-	0x25, 0x4a, 0x91, 0x5c, 0xc2, 0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x4e, 0x5c,
-	0x6c, 0x05, 0x60, 0x11, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0xde, 0xd4,
-	0x83, 0x68, 0x74, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50,
-	0x9d, 0x46, 0xf3, 0x19, 0xb9, 0x58, 0xc1, 0x66, 0x0b, 0x4d, 0x65, 0xe4, 0x12, 0x75, 0x4f, 0x2d,
-	0x71, 0x06, 0xe9, 0xf3, 0x85, 0xe8, 0x83, 0x68, 0x13, 0xd2, 0xc6, 0x69, 0x2e, 0xa6, 0x5b, 0xa5,
-	0x74, 0x88, 0x53, 0x0c, 0xf1, 0x82, 0x92, 0x62, 0x07, 0xc8, 0x35, 0x4d, 0x97, 0x9f, 0x4c, 0x66,
-	0x12, 0x13, 0x12, 0x41, 0x0d, 0x1e, 0x88, 0x0b, 0x9d, 0xfc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0xdf, 0xa0, 0x22, 0x20, 0x3f, 0xa7, 0x32, 0x3d, 0x3f, 0x4f, 0x1f, 0x66, 0xbd, 0x6e, 0x99, 0x91,
-	0x7e, 0x05, 0xaa, 0x89, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xa0, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x21, 0x6b, 0x76, 0x47, 0x3a, 0x02, 0x00, 0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x3f, 0x8f, 0xd3, 0x30,
+	0x1c, 0x8d, 0x0f, 0x71, 0x12, 0xbe, 0x09, 0x53, 0xe0, 0x94, 0x43, 0x69, 0x09, 0x7f, 0x54, 0x0a,
+	0x8d, 0x21, 0x88, 0x11, 0x24, 0xca, 0xd0, 0xa9, 0xa2, 0x54, 0x2c, 0xb0, 0xb9, 0x8d, 0xe5, 0x5a,
+	0x4a, 0xec, 0x34, 0x76, 0xab, 0x76, 0x60, 0x61, 0x62, 0x44, 0x42, 0x7c, 0x07, 0x46, 0x3e, 0x46,
+	0x25, 0x96, 0x4a, 0x2c, 0x4c, 0x08, 0xb5, 0x48, 0x4c, 0x7c, 0x07, 0x94, 0x38, 0xa5, 0x09, 0x28,
+	0x14, 0xdd, 0x12, 0x39, 0xbf, 0xbc, 0xf7, 0x7e, 0xef, 0xbd, 0x18, 0x5e, 0x1b, 0x53, 0x1e, 0x05,
+	0x24, 0x0c, 0x67, 0x3e, 0x1e, 0x8d, 0x09, 0x17, 0x11, 0x11, 0x84, 0xd1, 0x04, 0x4f, 0xa6, 0x34,
+	0x59, 0x78, 0x71, 0x22, 0xb5, 0x44, 0x97, 0x77, 0x20, 0xaf, 0x08, 0xb2, 0x4f, 0x46, 0x52, 0x45,
+	0x52, 0x19, 0x30, 0x9e, 0xdd, 0x2b, 0xb2, 0xec, 0xf3, 0x24, 0xe2, 0x42, 0xe2, 0xec, 0x99, 0x8f,
+	0x6a, 0x4c, 0x32, 0x99, 0x1d, 0x71, 0x7a, 0xca, 0xa7, 0x57, 0x98, 0x94, 0x2c, 0xa4, 0x98, 0xc4,
+	0x1c, 0x13, 0x21, 0xa4, 0x26, 0x9a, 0x4b, 0xa1, 0xf2, 0xaf, 0xad, 0x2a, 0x87, 0xc5, 0x17, 0x83,
+	0x75, 0x6b, 0x10, 0x3d, 0x4b, 0x1d, 0xf4, 0x49, 0x42, 0x22, 0x35, 0xa0, 0x93, 0x29, 0x55, 0xda,
+	0x7d, 0x01, 0x2f, 0x94, 0xa6, 0x2a, 0x96, 0x42, 0x51, 0xd4, 0x81, 0x87, 0x71, 0x36, 0x39, 0x06,
+	0x0d, 0xd0, 0x3c, 0xf2, 0xeb, 0x5e, 0x45, 0x4c, 0xcf, 0x10, 0x3b, 0xe7, 0x96, 0x5f, 0xeb, 0xd6,
+	0x87, 0x1f, 0x1f, 0x5b, 0x60, 0x90, 0x33, 0xdd, 0x9b, 0xf0, 0xba, 0x91, 0xa6, 0x22, 0xe0, 0x82,
+	0x3d, 0x0e, 0x82, 0x84, 0x2a, 0xd5, 0xe3, 0x2c, 0x31, 0x19, 0xb6, 0x16, 0x5e, 0xc1, 0x1b, 0x7b,
+	0x70, 0xb9, 0xa9, 0xe7, 0x10, 0x46, 0xbf, 0xa7, 0xc7, 0xa0, 0x71, 0xa6, 0x79, 0xe4, 0xdf, 0xaa,
+	0x34, 0xf6, 0xa7, 0x4e, 0xd1, 0x62, 0x41, 0xc7, 0xff, 0x79, 0x00, 0xcf, 0x66, 0xfb, 0xd1, 0x7b,
+	0x00, 0x2f, 0x76, 0xa9, 0x7e, 0x92, 0xaa, 0xf4, 0x8c, 0x8a, 0x49, 0x87, 0x6e, 0x57, 0x6e, 0xf9,
+	0xbb, 0x52, 0xfb, 0xce, 0xff, 0x81, 0x4d, 0x28, 0xf7, 0xea, 0x9b, 0xd4, 0xd1, 0xeb, 0xcf, 0xdf,
+	0xdf, 0x1d, 0x5c, 0x42, 0xb5, 0xf2, 0x5f, 0x34, 0x45, 0xa2, 0x4f, 0x00, 0x9e, 0x74, 0xa9, 0xae,
+	0xea, 0x07, 0x3d, 0xdc, 0xb3, 0xf0, 0xdf, 0xfd, 0xdb, 0x8f, 0x4e, 0x4b, 0xcf, 0x13, 0xb4, 0x77,
+	0x09, 0x5c, 0xd4, 0x28, 0x27, 0x20, 0x86, 0xd5, 0xde, 0xf5, 0xdd, 0x79, 0xba, 0x5c, 0x3b, 0x60,
+	0xb5, 0x76, 0xc0, 0xb7, 0xb5, 0x03, 0xde, 0x6e, 0x1c, 0x6b, 0xb5, 0x71, 0xac, 0x2f, 0x1b, 0xc7,
+	0x7a, 0xf9, 0x80, 0x71, 0x3d, 0x9e, 0x0e, 0xbd, 0x91, 0x8c, 0xf0, 0xdd, 0x79, 0x5f, 0x86, 0x0b,
+	0x26, 0x05, 0xde, 0x9a, 0x6b, 0xcf, 0x7c, 0x3c, 0x2f, 0xab, 0xeb, 0x45, 0x4c, 0xd5, 0xf0, 0x30,
+	0xbb, 0xdf, 0xf7, 0x7f, 0x0d, 0x00, 0xb3, 0x4c, 0xc6, 0x5b, 0xaf, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -162,6 +255,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// GetChainManagerParams queries the chainmanager module parameters.
 	GetChainManagerParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetPendingAddressMigrations queries the address migrations scheduled at
+	// future heights, ordered by height.
+	GetPendingAddressMigrations(ctx context.Context, in *QueryPendingAddressMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingAddressMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -181,10 +277,22 @@ func (c *queryClient) GetChainManagerParams(ctx context.Context, in *QueryParams
 	return out, nil
 }
 
+func (c *queryClient) GetPendingAddressMigrations(ctx context.Context, in *QueryPendingAddressMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingAddressMigrationsResponse, error) {
+	out := new(QueryPendingAddressMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.chainmanager.Query/GetPendingAddressMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetChainManagerParams queries the chainmanager module parameters.
 	GetChainManagerParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetPendingAddressMigrations queries the address migrations scheduled at
+	// future heights, ordered by height.
+	GetPendingAddressMigrations(context.Context, *QueryPendingAddressMigrationsRequest) (*QueryPendingAddressMigrationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -194,6 +302,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) GetChainManagerParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChainManagerParams not implemented")
 }
+func (*UnimplementedQueryServer) GetPendingAddressMigrations(ctx context.Context, req *QueryPendingAddressMigrationsRequest) (*QueryPendingAddressMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAddressMigrations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -217,6 +328,25 @@ func _Query_GetChainManagerParams_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPendingAddressMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAddressMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingAddressMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.chainmanager.Query/GetPendingAddressMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingAddressMigrations(ctx, req.(*QueryPendingAddressMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.chainmanager.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -225,6 +355,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetChainManagerParams",
 			Handler:    _Query_GetChainManagerParams_Handler,
 		},
+		{
+			MethodName: "GetPendingAddressMigrations",
+			Handler:    _Query_GetPendingAddressMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/chainmanager/query.proto",
@@ -286,6 +420,66 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingAddressMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAddressMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAddressMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAddressMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAddressMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAddressMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -317,6 +511,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingAddressMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingAddressMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -456,6 +674,140 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingAddressMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingAddressMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingAddressMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingAddressMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingAddressMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingAddressMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, AddressMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -124,38 +124,339 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleAddressMigration defines the message for scheduling a root chain
+// contract address migration.
+type MsgScheduleAddressMigration struct {
+	// Address of the governance authority (typically the governance module).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Address migration to schedule.
+	Migration AddressMigration `protobuf:"bytes,2,opt,name=migration,proto3" json:"migration"`
+}
+
+func (m *MsgScheduleAddressMigration) Reset()         { *m = MsgScheduleAddressMigration{} }
+func (m *MsgScheduleAddressMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleAddressMigration) ProtoMessage()    {}
+func (*MsgScheduleAddressMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{2}
+}
+func (m *MsgScheduleAddressMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleAddressMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleAddressMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleAddressMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleAddressMigration.Merge(m, src)
+}
+func (m *MsgScheduleAddressMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleAddressMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleAddressMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleAddressMigration proto.InternalMessageInfo
+
+func (m *MsgScheduleAddressMigration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleAddressMigration) GetMigration() AddressMigration {
+	if m != nil {
+		return m.Migration
+	}
+	return AddressMigration{}
+}
+
+// MsgScheduleAddressMigrationResponse defines the response for
+// MsgScheduleAddressMigration.
+type MsgScheduleAddressMigrationResponse struct {
+}
+
+func (m *MsgScheduleAddressMigrationResponse) Reset()         { *m = MsgScheduleAddressMigrationResponse{} }
+func (m *MsgScheduleAddressMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleAddressMigrationResponse) ProtoMessage()    {}
+func (*MsgScheduleAddressMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{3}
+}
+func (m *MsgScheduleAddressMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleAddressMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleAddressMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleAddressMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleAddressMigrationResponse.Merge(m, src)
+}
+func (m *MsgScheduleAddressMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleAddressMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleAddressMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleAddressMigrationResponse proto.InternalMessageInfo
+
+// MsgCancelAddressMigration defines the message for cancelling a pending root
+// chain contract address migration.
+type MsgCancelAddressMigration struct {
+	// Address of the governance authority (typically the governance module).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height of the address migration to cancel.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgCancelAddressMigration) Reset()         { *m = MsgCancelAddressMigration{} }
+func (m *MsgCancelAddressMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAddressMigration) ProtoMessage()    {}
+func (*MsgCancelAddressMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{4}
+}
+func (m *MsgCancelAddressMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAddressMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAddressMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAddressMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAddressMigration.Merge(m, src)
+}
+func (m *MsgCancelAddressMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAddressMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAddressMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAddressMigration proto.InternalMessageInfo
+
+func (m *MsgCancelAddressMigration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelAddressMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgCancelAddressMigrationResponse defines the response for
+// MsgCancelAddressMigration.
+type MsgCancelAddressMigrationResponse struct {
+}
+
+func (m *MsgCancelAddressMigrationResponse) Reset()         { *m = MsgCancelAddressMigrationResponse{} }
+func (m *MsgCancelAddressMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAddressMigrationResponse) ProtoMessage()    {}
+func (*MsgCancelAddressMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{5}
+}
+func (m *MsgCancelAddressMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAddressMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAddressMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAddressMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAddressMigrationResponse.Merge(m, src)
+}
+func (m *MsgCancelAddressMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAddressMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAddressMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAddressMigrationResponse proto.InternalMessageInfo
+
+// MsgVerifyAddressMigration defines the side tx message for verifying the new
+// addresses of a pending root chain contract address migration.
+type MsgVerifyAddressMigration struct {
+	// Address of the sender.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Height of the address migration to verify.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgVerifyAddressMigration) Reset()         { *m = MsgVerifyAddressMigration{} }
+func (m *MsgVerifyAddressMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyAddressMigration) ProtoMessage()    {}
+func (*MsgVerifyAddressMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{6}
+}
+func (m *MsgVerifyAddressMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyAddressMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyAddressMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyAddressMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyAddressMigration.Merge(m, src)
+}
+func (m *MsgVerifyAddressMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyAddressMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyAddressMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyAddressMigration proto.InternalMessageInfo
+
+func (m *MsgVerifyAddressMigration) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgVerifyAddressMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgVerifyAddressMigrationResponse defines the response for
+// MsgVerifyAddressMigration.
+type MsgVerifyAddressMigrationResponse struct {
+}
+
+func (m *MsgVerifyAddressMigrationResponse) Reset()         { *m = MsgVerifyAddressMigrationResponse{} }
+func (m *MsgVerifyAddressMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyAddressMigrationResponse) ProtoMessage()    {}
+func (*MsgVerifyAddressMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f7c1577c2bdcbce, []int{7}
+}
+func (m *MsgVerifyAddressMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyAddressMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyAddressMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyAddressMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyAddressMigrationResponse.Merge(m, src)
+}
+func (m *MsgVerifyAddressMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyAddressMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyAddressMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyAddressMigrationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "heimdallv2.chainmanager.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "heimdallv2.chainmanager.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleAddressMigration)(nil), "heimdallv2.chainmanager.MsgScheduleAddressMigration")
+	proto.RegisterType((*MsgScheduleAddressMigrationResponse)(nil), "heimdallv2.chainmanager.MsgScheduleAddressMigrationResponse")
+	proto.RegisterType((*MsgCancelAddressMigration)(nil), "heimdallv2.chainmanager.MsgCancelAddressMigration")
+	proto.RegisterType((*MsgCancelAddressMigrationResponse)(nil), "heimdallv2.chainmanager.MsgCancelAddressMigrationResponse")
+	proto.RegisterType((*MsgVerifyAddressMigration)(nil), "heimdallv2.chainmanager.MsgVerifyAddressMigration")
+	proto.RegisterType((*MsgVerifyAddressMigrationResponse)(nil), "heimdallv2.chainmanager.MsgVerifyAddressMigrationResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/chainmanager/tx.proto", fileDescriptor_7f7c1577c2bdcbce) }
 
 var fileDescriptor_7f7c1577c2bdcbce = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0x29, 0x33, 0xd2, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x47, 0xa8,
-	0xd0, 0x43, 0x56, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa3, 0x0f, 0x62, 0x41, 0x94,
-	0x4b, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x43, 0x24, 0x20, 0x1c, 0xa8, 0x94, 0x38,
-	0x84, 0xa7, 0x9f, 0x5b, 0x9c, 0xae, 0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x5a, 0xb8, 0x1c, 0x81,
-	0xcc, 0x81, 0xaa, 0x15, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0xa5, 0x73,
-	0x8c, 0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89,
-	0xb9, 0xc5, 0x42, 0xd6, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0xb2, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x1d, 0xe4, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0xbe, 0xe2, 0xf9, 0x06, 0x2d, 0xc6,
-	0x20, 0x84, 0x7a, 0x21, 0x27, 0x2e, 0xb6, 0x02, 0xb0, 0x31, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc,
-	0x46, 0xf2, 0x7a, 0x38, 0xc2, 0x40, 0x0f, 0x62, 0x9b, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x10,
-	0x63, 0xa0, 0x3a, 0xad, 0xac, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0x98, 0xd9, 0xf5, 0x7c, 0x83, 0x96,
-	0x3a, 0x2e, 0x6f, 0xa2, 0x39, 0x5e, 0x49, 0x92, 0x4b, 0x1c, 0x4d, 0x28, 0x28, 0xb5, 0xb8, 0x20,
-	0x3f, 0xaf, 0x38, 0xd5, 0xa8, 0x82, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28, 0x8b, 0x8b, 0x07, 0xc5,
-	0xbb, 0x1a, 0x38, 0x5d, 0x88, 0x66, 0x90, 0x94, 0x01, 0xb1, 0x2a, 0x61, 0x56, 0x4a, 0xb1, 0x36,
-	0x80, 0x3c, 0xe6, 0xe4, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa6,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x06, 0x15, 0x01, 0xf9, 0x39,
-	0x95, 0xe9, 0xf9, 0x79, 0xfa, 0x30, 0x6b, 0x74, 0xcb, 0x8c, 0xf4, 0x2b, 0xd0, 0xd2, 0x56, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xf6, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x4f,
-	0x06, 0x47, 0x83, 0x02, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0xb4, 0x8d, 0x94, 0x03, 0x09, 0x11, 0x55, 0x24, 0x31, 0xaa, 0x1b, 0x52, 0x21,
+	0x42, 0xa4, 0xc6, 0x4d, 0x0a, 0x8b, 0x41, 0x42, 0x04, 0xc4, 0x16, 0x51, 0xa5, 0x82, 0x81, 0x05,
+	0x5d, 0xed, 0xeb, 0xf9, 0x90, 0xed, 0xb3, 0x7c, 0x4e, 0x94, 0x6c, 0x88, 0x09, 0x31, 0x20, 0xfe,
+	0x03, 0x56, 0x26, 0x94, 0x81, 0x95, 0xbd, 0x13, 0xaa, 0x98, 0x98, 0x10, 0x4a, 0x86, 0xfc, 0x07,
+	0xcc, 0x28, 0xfe, 0x91, 0xa4, 0x8e, 0xcf, 0x6d, 0xa1, 0x4b, 0x92, 0xbb, 0xf7, 0x7d, 0xef, 0xbe,
+	0x9f, 0x97, 0x77, 0x3a, 0x58, 0x36, 0x30, 0xb5, 0x74, 0x64, 0x9a, 0xbd, 0xa6, 0xa2, 0x19, 0x88,
+	0xda, 0x16, 0xb2, 0x11, 0xc1, 0xae, 0xe2, 0xf5, 0xeb, 0x8e, 0xcb, 0x3c, 0x96, 0x2f, 0xcc, 0x15,
+	0xf5, 0x45, 0x85, 0xb4, 0x4e, 0x18, 0x61, 0xbe, 0x46, 0x99, 0xfe, 0x0a, 0xe4, 0x52, 0x49, 0x63,
+	0xdc, 0x62, 0xfc, 0x55, 0x10, 0x08, 0x16, 0x61, 0xa8, 0x10, 0xac, 0x14, 0x8b, 0x13, 0xa5, 0xd7,
+	0x98, 0x7e, 0x85, 0x81, 0x9a, 0xc8, 0xc4, 0xe2, 0x22, 0xd4, 0x5e, 0x43, 0x16, 0xb5, 0x99, 0xe2,
+	0x7f, 0x06, 0x5b, 0x95, 0xef, 0x00, 0x5e, 0x6d, 0x73, 0xf2, 0xdc, 0xd1, 0x91, 0x87, 0xf7, 0x90,
+	0x8b, 0x2c, 0x9e, 0xbf, 0x0f, 0x73, 0xa8, 0xeb, 0x19, 0xcc, 0xa5, 0xde, 0xa0, 0x08, 0xca, 0xa0,
+	0x9a, 0x6b, 0x6d, 0xfc, 0xf8, 0xba, 0xbd, 0x1e, 0x1a, 0x7a, 0xa4, 0xeb, 0x2e, 0xe6, 0x7c, 0xdf,
+	0x73, 0xa9, 0x4d, 0x3e, 0x4f, 0x86, 0x35, 0xd0, 0x99, 0xeb, 0xf3, 0x2d, 0x98, 0x75, 0xfc, 0x32,
+	0xc5, 0x4b, 0x65, 0x50, 0xbd, 0xdc, 0xdc, 0xac, 0x0b, 0x7a, 0x50, 0x0f, 0x4e, 0x6b, 0xe5, 0x8e,
+	0x7e, 0x6d, 0x66, 0x82, 0x32, 0x61, 0xa6, 0xaa, 0xbe, 0x9d, 0x0c, 0x6b, 0xf3, 0x9a, 0xef, 0x27,
+	0xc3, 0xda, 0x6d, 0x11, 0x66, 0xcc, 0x7c, 0xa5, 0x04, 0x0b, 0xb1, 0xad, 0x0e, 0xe6, 0x0e, 0xb3,
+	0x39, 0xae, 0xfc, 0x01, 0xf0, 0x46, 0x9b, 0x93, 0x7d, 0xcd, 0xc0, 0x7a, 0xd7, 0xc4, 0x21, 0x47,
+	0x9b, 0x12, 0x17, 0x79, 0x94, 0xd9, 0xff, 0xc7, 0xdd, 0x81, 0x39, 0x2b, 0xaa, 0x14, 0xa2, 0xdf,
+	0x11, 0xa2, 0xc7, 0x8f, 0x5e, 0x6c, 0xc2, 0xbc, 0x8c, 0xfa, 0x74, 0xb9, 0x0f, 0xbb, 0x29, 0x7d,
+	0x10, 0x81, 0x55, 0x6e, 0xc1, 0xad, 0x94, 0xf0, 0xac, 0x3f, 0xdf, 0x00, 0x2c, 0xb5, 0x39, 0x79,
+	0x8c, 0x6c, 0x0d, 0x9b, 0x17, 0xdb, 0x9d, 0x0d, 0x98, 0x35, 0x30, 0x25, 0x86, 0xe7, 0xb7, 0x66,
+	0xa5, 0xb5, 0x16, 0xfe, 0xe1, 0xc1, 0xa6, 0xfa, 0x64, 0x19, 0xb4, 0x91, 0x02, 0x9a, 0xec, 0xb0,
+	0xb2, 0x05, 0x6f, 0x0a, 0x83, 0x33, 0xc8, 0x2f, 0x01, 0xe4, 0x0b, 0xec, 0xd2, 0xc3, 0xc1, 0x12,
+	0x64, 0x03, 0xae, 0x1e, 0xba, 0xcc, 0x3a, 0x1b, 0x9f, 0x2f, 0x3d, 0x0d, 0xed, 0xe1, 0x14, 0xcd,
+	0x57, 0x9e, 0x46, 0x95, 0x6c, 0x29, 0xa4, 0x4a, 0x0e, 0x46, 0x54, 0xcd, 0x4f, 0xab, 0x70, 0xa5,
+	0xcd, 0x49, 0xfe, 0x35, 0xbc, 0x72, 0xe2, 0x2a, 0x57, 0x85, 0x23, 0x18, 0xbb, 0x24, 0xd2, 0xce,
+	0x59, 0x95, 0xd1, 0x99, 0xf9, 0x0f, 0x00, 0x16, 0x85, 0x77, 0xe9, 0x6e, 0x5a, 0x39, 0x51, 0x96,
+	0xf4, 0xe0, 0x5f, 0xb2, 0x66, 0x86, 0xde, 0x01, 0x78, 0x5d, 0x30, 0xbc, 0xcd, 0xb4, 0xc2, 0xc9,
+	0x39, 0x92, 0x7a, 0xfe, 0x9c, 0x13, 0x56, 0x04, 0x23, 0x96, 0x6a, 0x25, 0x39, 0x47, 0x52, 0xcf,
+	0x9f, 0x13, 0x59, 0x91, 0xd6, 0xde, 0x4c, 0xe7, 0xb1, 0xf5, 0xec, 0x68, 0x24, 0x83, 0xe3, 0x91,
+	0x0c, 0x7e, 0x8f, 0x64, 0xf0, 0x71, 0x2c, 0x67, 0x8e, 0xc7, 0x72, 0xe6, 0xe7, 0x58, 0xce, 0xbc,
+	0xbc, 0x47, 0xa8, 0x67, 0x74, 0x0f, 0xea, 0x1a, 0xb3, 0x94, 0x9d, 0xfe, 0x1e, 0x33, 0x07, 0x84,
+	0xd9, 0x4a, 0x74, 0xe0, 0x76, 0xaf, 0xa9, 0xf4, 0x63, 0xcf, 0xdb, 0xc0, 0xc1, 0xfc, 0x20, 0xeb,
+	0x3f, 0x20, 0xbb, 0x7f, 0x07, 0x00, 0xe7, 0x8f, 0xa5, 0xdd, 0x06, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +474,17 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the chainmanager
 	// module parameters. Only the governance authority can execute this.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleAddressMigration defines a governance operation for scheduling a
+	// change of the root chain contract addresses at a future height. Only the
+	// governance authority can execute this.
+	ScheduleAddressMigration(ctx context.Context, in *MsgScheduleAddressMigration, opts ...grpc.CallOption) (*MsgScheduleAddressMigrationResponse, error)
+	// CancelAddressMigration defines a governance operation for cancelling a
+	// pending root chain contract address migration. Only the governance
+	// authority can execute this.
+	CancelAddressMigration(ctx context.Context, in *MsgCancelAddressMigration, opts ...grpc.CallOption) (*MsgCancelAddressMigrationResponse, error)
+	// VerifyAddressMigration defines a side tx for the validators to verify the
+	// new addresses of a pending address migration against the main chain.
+	VerifyAddressMigration(ctx context.Context, in *MsgVerifyAddressMigration, opts ...grpc.CallOption) (*MsgVerifyAddressMigrationResponse, error)
 }
 
 type msgClient struct {
@@ -192,11 +504,49 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleAddressMigration(ctx context.Context, in *MsgScheduleAddressMigration, opts ...grpc.CallOption) (*MsgScheduleAddressMigrationResponse, error) {
+	out := new(MsgScheduleAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.chainmanager.Msg/ScheduleAddressMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAddressMigration(ctx context.Context, in *MsgCancelAddressMigration, opts ...grpc.CallOption) (*MsgCancelAddressMigrationResponse, error) {
+	out := new(MsgCancelAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.chainmanager.Msg/CancelAddressMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyAddressMigration(ctx context.Context, in *MsgVerifyAddressMigration, opts ...grpc.CallOption) (*MsgVerifyAddressMigrationResponse, error) {
+	out := new(MsgVerifyAddressMigrationResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.chainmanager.Msg/VerifyAddressMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the chainmanager
 	// module parameters. Only the governance authority can execute this.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleAddressMigration defines a governance operation for scheduling a
+	// change of the root chain contract addresses at a future height. Only the
+	// governance authority can execute this.
+	ScheduleAddressMigration(context.Context, *MsgScheduleAddressMigration) (*MsgScheduleAddressMigrationResponse, error)
+	// CancelAddressMigration defines a governance operation for cancelling a
+	// pending root chain contract address migration. Only the governance
+	// authority can execute this.
+	CancelAddressMigration(context.Context, *MsgCancelAddressMigration) (*MsgCancelAddressMigrationResponse, error)
+	// VerifyAddressMigration defines a side tx for the validators to verify the
+	// new addresses of a pending address migration against the main chain.
+	VerifyAddressMigration(context.Context, *MsgVerifyAddressMigration) (*MsgVerifyAddressMigrationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -206,6 +556,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleAddressMigration(ctx context.Context, req *MsgScheduleAddressMigration) (*MsgScheduleAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAddressMigration not implemented")
+}
+func (*UnimplementedMsgServer) CancelAddressMigration(ctx context.Context, req *MsgCancelAddressMigration) (*MsgCancelAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAddressMigration not implemented")
+}
+func (*UnimplementedMsgServer) VerifyAddressMigration(ctx context.Context, req *MsgVerifyAddressMigration) (*MsgVerifyAddressMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAddressMigration not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -229,6 +588,61 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.chainmanager.Msg/ScheduleAddressMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleAddressMigration(ctx, req.(*MsgScheduleAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.chainmanager.Msg/CancelAddressMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAddressMigration(ctx, req.(*MsgCancelAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyAddressMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyAddressMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyAddressMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.chainmanager.Msg/VerifyAddressMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyAddressMigration(ctx, req.(*MsgVerifyAddressMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.chainmanager.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -237,6 +651,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleAddressMigration",
+			Handler:    _Msg_ScheduleAddressMigration_Handler,
+		},
+		{
+			MethodName: "CancelAddressMigration",
+			Handler:    _Msg_CancelAddressMigration_Handler,
+		},
+		{
+			MethodName: "VerifyAddressMigration",
+			Handler:    _Msg_VerifyAddressMigration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/chainmanager/tx.proto",
@@ -305,30 +731,209 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleAddressMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgScheduleAddressMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleAddressMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleAddressMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleAddressMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleAddressMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAddressMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAddressMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAddressMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAddressMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAddressMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAddressMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyAddressMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyAddressMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyAddressMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyAddressMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyAddressMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyAddressMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
 
 func (m *MsgUpdateParamsResponse) Size() (n int) {
@@ -340,6 +945,80 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleAddressMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Migration.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleAddressMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAddressMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelAddressMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVerifyAddressMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgVerifyAddressMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -511,6 +1190,473 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleAddressMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleAddressMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleAddressMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleAddressMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleAddressMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleAddressMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAddressMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAddressMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAddressMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAddressMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAddressMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAddressMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyAddressMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyAddressMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyAddressMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyAddressMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyAddressMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyAddressMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
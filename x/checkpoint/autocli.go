@@ -12,7 +12,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: checkpoint.Query_ServiceDesc.ServiceName,
+			Service:              checkpoint.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod:      "GetCheckpointParams",
@@ -40,6 +41,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "number"},
 					},
 				},
+				{
+					RpcMethod: "GetCheckpointProof",
+					Use:       "get-checkpoint-proof [block_number]",
+					Short:     "Get the checkpoint inclusion proof of a bor block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "block_number"},
+					},
+				},
 				{
 					RpcMethod:      "GetCheckpointLatest",
 					Use:            "get-checkpoint-latest",
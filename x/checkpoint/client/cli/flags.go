@@ -11,4 +11,6 @@ const (
 	FlagCheckpointTxHash   = "tx-hash"
 	FlagCheckpointLogIndex = "log-index"
 	FlagAutoConfigure      = "auto-configure"
+	FlagBorTxHash          = "bor-tx-hash"
+	FlagRootChain          = "root-chain"
 )
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	chainmanagerTypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+	checkpointTypes "github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+)
+
+// NewQueryCmd returns a root CLI command handler for the x/checkpoint query commands
+// not generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        checkpointTypes.ModuleName,
+		Short:                      "Querying commands for the Checkpoint module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		VerifyCheckpointProofCmd(),
+	)
+
+	return queryCmd
+}
+
+// VerifyCheckpointProofCmd returns a CLI command handler verifying the checkpoint inclusion proof of a bor block
+// against the bor chain, and optionally against the checkpoint submitted to the root chain contract.
+func VerifyCheckpointProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [block-number]",
+		Short: "Verify the inclusion of a bor block or tx in its checkpoint",
+		Long: `Fetch the checkpoint inclusion proof of a bor block, recompute the block leaf from the bor header
+and check the proof against the checkpoint root hash. The block can be given by number, or
+resolved from a bor tx with --bor-tx-hash, in which case the receipt proof of the tx is also checked
+against the receipts root of the bor header. With --root-chain, the root hash is also checked
+against the header block submitted to the root chain contract.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			borTxHash, err := cmd.Flags().GetString(FlagBorTxHash)
+			if err != nil {
+				return err
+			}
+
+			var (
+				blockNumber uint64
+				txReceipt   *ethTypes.Receipt
+			)
+
+			switch {
+			case len(args) == 1 && borTxHash == "":
+				blockNumber, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid block number: %w", err)
+				}
+			case len(args) == 0 && borTxHash != "":
+				txReceipt, err = contractCaller.GetBorTxReceipt(common.HexToHash(borTxHash))
+				if err != nil {
+					return fmt.Errorf("failed to get bor tx receipt: %w", err)
+				}
+
+				blockNumber = txReceipt.BlockNumber.Uint64()
+			default:
+				return fmt.Errorf("either a block number or --%s must be given", FlagBorTxHash)
+			}
+
+			checkpointQueryClient := checkpointTypes.NewQueryClient(clientCtx)
+			proofResponse, err := checkpointQueryClient.GetCheckpointProof(cmd.Context(), &checkpointTypes.QueryCheckpointProofRequest{BlockNumber: blockNumber, TxHash: borTxHash})
+			if err != nil {
+				return fmt.Errorf("failed to fetch checkpoint proof: %w", err)
+			}
+
+			header, err := contractCaller.GetBorChainBlock(cmd.Context(), new(big.Int).SetUint64(blockNumber))
+			if err != nil {
+				return fmt.Errorf("failed to get bor block %d: %w", blockNumber, err)
+			}
+
+			checkpoint := proofResponse.Checkpoint
+
+			leaf := checkpointTypes.GetBlockLeaf(header)
+			if !bytes.Equal(leaf.Bytes(), proofResponse.Leaf) {
+				return fmt.Errorf("leaf of bor block %d is %s, proof is for leaf %s", blockNumber, leaf.Hex(), common.BytesToHash(proofResponse.Leaf).Hex())
+			}
+
+			if proofResponse.LeafIndex != blockNumber-checkpoint.StartBlock {
+				return fmt.Errorf("proof leaf index %d does not match block %d in checkpoint starting at %d", proofResponse.LeafIndex, blockNumber, checkpoint.StartBlock)
+			}
+
+			if !checkpointTypes.VerifyBlockProof(leaf.Bytes(), proofResponse.LeafIndex, proofResponse.Proof, checkpoint.RootHash) {
+				return fmt.Errorf("proof of bor block %d does not match the root hash of checkpoint %d", blockNumber, checkpoint.Id)
+			}
+
+			if txReceipt != nil {
+				if proofResponse.TxIndex != uint64(txReceipt.TransactionIndex) {
+					return fmt.Errorf("proof tx index %d does not match the index %d of bor tx %s", proofResponse.TxIndex, txReceipt.TransactionIndex, borTxHash)
+				}
+
+				receipt, err := checkpointTypes.VerifyReceiptProof(header.ReceiptHash, proofResponse.TxIndex, proofResponse.ReceiptProof)
+				if err != nil {
+					return fmt.Errorf("receipt proof of bor tx %s does not match the receipts root of block %d: %w", borTxHash, blockNumber, err)
+				}
+
+				encoded, err := txReceipt.MarshalBinary()
+				if err != nil {
+					return err
+				}
+
+				if !bytes.Equal(receipt, encoded) || !bytes.Equal(receipt, proofResponse.Receipt) {
+					return fmt.Errorf("proven receipt does not match the receipt of bor tx %s", borTxHash)
+				}
+			}
+
+			verifyRootChain, err := cmd.Flags().GetBool(FlagRootChain)
+			if err != nil {
+				return err
+			}
+
+			if verifyRootChain {
+				chainManagerQueryClient := chainmanagerTypes.NewQueryClient(clientCtx)
+				chainManagerParams, err := chainManagerQueryClient.GetChainManagerParams(cmd.Context(), &chainmanagerTypes.QueryParamsRequest{})
+				if err != nil {
+					return fmt.Errorf("failed to fetch chain manager params: %w", err)
+				}
+
+				rootChainInstance, err := contractCaller.GetRootChainInstance(chainManagerParams.Params.ChainParams.RootChainAddress)
+				if err != nil {
+					return fmt.Errorf("failed to get root chain instance: %w", err)
+				}
+
+				headerBlock, err := rootChainInstance.HeaderBlocks(nil, new(big.Int).SetUint64(proofResponse.HeaderBlockNumber))
+				if err != nil {
+					return fmt.Errorf("failed to get header block %d: %w", proofResponse.HeaderBlockNumber, err)
+				}
+
+				if !bytes.Equal(headerBlock.Root[:], checkpoint.RootHash) ||
+					headerBlock.Start.Uint64() != checkpoint.StartBlock || headerBlock.End.Uint64() != checkpoint.EndBlock {
+					return fmt.Errorf("checkpoint %d does not match header block %d on the root chain", checkpoint.Id, proofResponse.HeaderBlockNumber)
+				}
+			}
+
+			return clientCtx.PrintProto(proofResponse)
+		},
+	}
+
+	cmd.Flags().String(FlagBorTxHash, "", "--bor-tx-hash=<bor-tx-hash>")
+	cmd.Flags().Bool(FlagRootChain, false, "--root-chain=true/false")
+
+	return cmd
+}
@@ -1,16 +1,22 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/0xPolygon/heimdall-v2/common/hex"
 	"github.com/0xPolygon/heimdall-v2/metrics/api"
 	hmTypes "github.com/0xPolygon/heimdall-v2/types"
+	borgrpc "github.com/0xPolygon/heimdall-v2/x/bor/grpc"
 	"github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 )
 
@@ -311,6 +317,142 @@ func (q queryServer) GetCheckpointSignatures(ctx context.Context, req *types.Que
 	return &types.QueryCheckpointSignaturesResponse{Signatures: checkpointSignatures.Signatures}, nil
 }
 
+// GetCheckpointProof returns the Merkle proof of inclusion of a bor block in the acknowledged checkpoint covering it.
+// The checkpoint tree is rebuilt from the bor headers of the checkpoint range, and checked against its root hash.
+// When a tx hash is given, the proof of inclusion of its receipt in the receipts root of the block is also returned.
+func (q queryServer) GetCheckpointProof(ctx context.Context, req *types.QueryCheckpointProofRequest) (*types.QueryCheckpointProofResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordCheckpointQueryMetric(api.GetCheckpointProofMethod, startTime, &err)
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	blockNumber := req.BlockNumber
+
+	var txReceipt *ethTypes.Receipt
+	if hex.IsTxHashNonEmpty(req.TxHash) {
+		txReceipt, err = q.k.IContractCaller.GetBorTxReceipt(common.HexToHash(req.TxHash))
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		if txReceipt == nil || txReceipt.BlockNumber == nil {
+			return nil, status.Errorf(codes.NotFound, "bor tx %s not found", req.TxHash)
+		}
+
+		if blockNumber != 0 && blockNumber != txReceipt.BlockNumber.Uint64() {
+			return nil, status.Errorf(codes.InvalidArgument, "bor tx %s is in block %d, not %d", req.TxHash, txReceipt.BlockNumber.Uint64(), blockNumber)
+		}
+
+		blockNumber = txReceipt.BlockNumber.Uint64()
+	}
+
+	checkpoint, err := q.k.GetCheckpointByBlockNumber(ctx, blockNumber)
+	if err != nil {
+		if errors.Is(err, types.ErrNoCheckpointFound) {
+			return nil, status.Errorf(codes.NotFound, "no acknowledged checkpoint covers block %d", blockNumber)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	headers, err := q.getCheckpointHeaders(ctx, checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil {
+		q.k.Logger(ctx).Error("Could not fetch the checkpoint blocks", "start", checkpoint.StartBlock, "end", checkpoint.EndBlock, "error", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	leaves := make([]common.Hash, 0, len(headers))
+	for _, header := range headers {
+		leaves = append(leaves, types.GetBlockLeaf(header))
+	}
+
+	leafIndex := blockNumber - checkpoint.StartBlock
+
+	proof, rootHash, err := types.GetBlockProof(leaves, leafIndex)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !bytes.Equal(rootHash.Bytes(), checkpoint.RootHash) {
+		err = errors.New("root hash of the bor blocks does not match the checkpoint root hash")
+		q.k.Logger(ctx).Error("Checkpoint root hash mismatch", "checkpoint", checkpoint.Id,
+			"computed", rootHash.Hex(), "expected", common.Bytes2Hex(checkpoint.RootHash))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res := &types.QueryCheckpointProofResponse{
+		Checkpoint:        checkpoint,
+		HeaderBlockNumber: checkpoint.Id * params.ChildChainBlockInterval,
+		Leaf:              leaves[leafIndex].Bytes(),
+		LeafIndex:         leafIndex,
+		Proof:             proof,
+	}
+
+	if txReceipt == nil {
+		return res, nil
+	}
+
+	header := headers[leafIndex]
+	if txReceipt.BlockHash != header.Hash() {
+		return nil, status.Errorf(codes.FailedPrecondition, "bor tx %s is in block %s, not in the canonical block %d", req.TxHash, txReceipt.BlockHash.Hex(), blockNumber)
+	}
+
+	receipts, err := q.k.IContractCaller.GetBorBlockReceipts(ctx, blockNumber)
+	if err != nil {
+		q.k.Logger(ctx).Error("Could not fetch the block receipts", "block", blockNumber, "error", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	txIndex := uint64(txReceipt.TransactionIndex)
+
+	receipt, receiptProof, receiptsRoot, err := types.GetReceiptProof(receipts, txIndex, blockNumber, header.Hash())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if receiptsRoot != header.ReceiptHash {
+		err = errors.New("root hash of the block receipts does not match the block receipts root")
+		q.k.Logger(ctx).Error("Receipts root mismatch", "block", blockNumber,
+			"computed", receiptsRoot.Hex(), "expected", header.ReceiptHash.Hex())
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res.TxIndex = txIndex
+	res.Receipt = receipt
+	res.ReceiptProof = receiptProof
+
+	return res, nil
+}
+
+// getCheckpointHeaders fetches the bor headers of the inclusive range [start, end].
+func (q queryServer) getCheckpointHeaders(ctx context.Context, start, end uint64) ([]*ethTypes.Header, error) {
+	headers := make([]*ethTypes.Header, 0, end-start+1)
+
+	for batchStart := start; batchStart <= end; batchStart += borgrpc.MaxBlockInfoBatchSize {
+		batchEnd := min(batchStart+borgrpc.MaxBlockInfoBatchSize-1, end)
+
+		batch, _, _, err := q.k.IContractCaller.GetBorChainBlockInfoInBatch(ctx, int64(batchStart), int64(batchEnd))
+		if err != nil {
+			return nil, err
+		}
+
+		// only the contiguous prefix of the blocks found on the chain is returned
+		if uint64(len(batch)) != batchEnd-batchStart+1 {
+			return nil, fmt.Errorf("fetched %d of the %d bor blocks from %d", len(batch), batchEnd-batchStart+1, batchStart)
+		}
+
+		headers = append(headers, batch...)
+	}
+
+	return headers, nil
+}
+
 func recordCheckpointQueryMetric(method string, start time.Time, err *error) {
 	success := *err == nil
 	api.RecordAPICallWithStart(api.CheckpointSubsystem, method, api.QueryType, success, start)
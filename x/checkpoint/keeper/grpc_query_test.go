@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 
@@ -205,3 +208,104 @@ func (s *KeeperTestSuite) TestGetCheckpointList() {
 	require.NoError(err)
 	require.Equal(expCheckpoints[:2], res.CheckpointList)
 }
+
+func (s *KeeperTestSuite) TestQueryCheckpointProof() {
+	ctx, require, keeper, queryClient, contractCaller := s.ctx, s.Require(), s.checkpointKeeper, s.queryClient, s.contractCaller
+
+	// the checkpoint range spans two bor batches
+	startBlock, endBlock := uint64(10), uint64(300)
+
+	// the receipts of the bor block holding the proven tx
+	txBlockNumber := uint64(100)
+	receipts := make([]*ethTypes.Receipt, 0, 3)
+	for i := range 3 {
+		receipts = append(receipts, &ethTypes.Receipt{
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21_000 * (i + 1)),
+			Logs:              []*ethTypes.Log{},
+			TxHash:            common.BytesToHash(chSim.RandomBytes()),
+			BlockNumber:       new(big.Int).SetUint64(txBlockNumber),
+			TransactionIndex:  uint(i),
+		})
+	}
+
+	headers := make([]*ethTypes.Header, 0, endBlock-startBlock+1)
+	leaves := make([]common.Hash, 0, endBlock-startBlock+1)
+	for number := startBlock; number <= endBlock; number++ {
+		header := &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(number),
+			Time:        1_700_000_000 + 2*number,
+			TxHash:      common.BytesToHash(chSim.RandomBytes()),
+			ReceiptHash: common.BytesToHash(chSim.RandomBytes()),
+		}
+		if number == txBlockNumber {
+			header.ReceiptHash = ethTypes.DeriveSha(ethTypes.Receipts(receipts), trie.NewStackTrie(nil))
+			for _, receipt := range receipts {
+				receipt.BlockHash = header.Hash()
+			}
+		}
+		headers = append(headers, header)
+		leaves = append(leaves, types.GetBlockLeaf(header))
+	}
+
+	_, rootHash, err := types.GetBlockProof(leaves, 0)
+	require.NoError(err)
+
+	checkpoints := []types.Checkpoint{
+		types.CreateCheckpoint(1, 0, startBlock-1, chSim.RandomBytes(), common.HexToAddress(AccountHash).String(), TestBorChainID, uint64(time.Now().Unix())),
+		types.CreateCheckpoint(2, startBlock, endBlock, rootHash.Bytes(), common.HexToAddress(AccountHash).String(), TestBorChainID, uint64(time.Now().Unix())),
+		types.CreateCheckpoint(3, endBlock+1, endBlock+10, chSim.RandomBytes(), common.HexToAddress(AccountHash).String(), TestBorChainID, uint64(time.Now().Unix())),
+	}
+	for _, checkpoint := range checkpoints {
+		require.NoError(keeper.AddCheckpoint(ctx, checkpoint))
+	}
+	require.NoError(keeper.UpdateAckCountWithValue(ctx, uint64(len(checkpoints))))
+
+	contractCaller.On("GetBorChainBlockInfoInBatch", mock.Anything, int64(10), int64(265)).Return(headers[:256], nil, nil, nil)
+	contractCaller.On("GetBorChainBlockInfoInBatch", mock.Anything, int64(266), int64(300)).Return(headers[256:], nil, nil, nil)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	for _, blockNumber := range []uint64{startBlock, 265, 266, endBlock} {
+		res, err := queryClient.GetCheckpointProof(ctx, &types.QueryCheckpointProofRequest{BlockNumber: blockNumber})
+		require.NoError(err)
+
+		require.Equal(uint64(2), res.Checkpoint.Id)
+		require.Equal(2*params.ChildChainBlockInterval, res.HeaderBlockNumber)
+		require.Equal(blockNumber-startBlock, res.LeafIndex)
+		require.Equal(leaves[res.LeafIndex].Bytes(), res.Leaf)
+		require.Len(res.Proof, 9)
+		require.True(types.VerifyBlockProof(res.Leaf, res.LeafIndex, res.Proof, res.Checkpoint.RootHash))
+	}
+
+	// the receipt of a tx is proven against the receipts root of its block
+	txReceipt := receipts[1]
+	contractCaller.On("GetBorTxReceipt", txReceipt.TxHash).Return(txReceipt, nil)
+	contractCaller.On("GetBorBlockReceipts", mock.Anything, txBlockNumber).Return(receipts, nil)
+
+	res, err := queryClient.GetCheckpointProof(ctx, &types.QueryCheckpointProofRequest{TxHash: txReceipt.TxHash.Hex()})
+	require.NoError(err)
+	require.Equal(txBlockNumber-startBlock, res.LeafIndex)
+	require.True(types.VerifyBlockProof(res.Leaf, res.LeafIndex, res.Proof, res.Checkpoint.RootHash))
+	require.Equal(uint64(1), res.TxIndex)
+
+	proven, err := types.VerifyReceiptProof(headers[res.LeafIndex].ReceiptHash, res.TxIndex, res.ReceiptProof)
+	require.NoError(err)
+	require.Equal(res.Receipt, proven)
+
+	encoded, err := txReceipt.MarshalBinary()
+	require.NoError(err)
+	require.Equal(encoded, res.Receipt)
+
+	_, err = queryClient.GetCheckpointProof(ctx, &types.QueryCheckpointProofRequest{BlockNumber: txBlockNumber + 1, TxHash: txReceipt.TxHash.Hex()})
+	require.ErrorContains(err, "is in block 100, not 101")
+
+	// the blocks of the third checkpoint don't match its root hash
+	contractCaller.On("GetBorChainBlockInfoInBatch", mock.Anything, int64(301), int64(310)).Return(headers[:10], nil, nil, nil)
+	_, err = queryClient.GetCheckpointProof(ctx, &types.QueryCheckpointProofRequest{BlockNumber: endBlock + 1})
+	require.ErrorContains(err, "does not match the checkpoint root hash")
+
+	_, err = queryClient.GetCheckpointProof(ctx, &types.QueryCheckpointProofRequest{BlockNumber: endBlock + 11})
+	require.ErrorContains(err, "no acknowledged checkpoint covers block 311")
+}
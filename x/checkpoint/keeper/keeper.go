@@ -178,6 +178,37 @@ func (k *Keeper) GetCheckpointByNumber(ctx context.Context, number uint64) (type
 	return checkpoint, nil
 }
 
+// GetCheckpointByBlockNumber gets the acknowledged checkpoint whose block range contains the given bor block.
+// Checkpoints cover consecutive block ranges, so it is looked up by binary search over the checkpoint numbers.
+func (k *Keeper) GetCheckpointByBlockNumber(ctx context.Context, blockNumber uint64) (types.Checkpoint, error) {
+	ackCount, err := k.GetAckCount(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Error while fetching the ack count", "err", err)
+		return types.Checkpoint{}, err
+	}
+
+	low, high := uint64(1), ackCount
+	for low <= high {
+		number := low + (high-low)/2
+
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return types.Checkpoint{}, err
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = number - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = number + 1
+		default:
+			return checkpoint, nil
+		}
+	}
+
+	return types.Checkpoint{}, types.ErrNoCheckpointFound
+}
+
 // GetLastCheckpoint gets the last checkpoint, where its number is equal to the ack count
 func (k *Keeper) GetLastCheckpoint(ctx context.Context) (checkpoint types.Checkpoint, err error) {
 	ackCount, err := k.GetAckCount(ctx)
@@ -150,6 +150,40 @@ func (s *KeeperTestSuite) TestAddAndGetCheckpoints() {
 	require.Equal(2, len(checkpoints))
 }
 
+func (s *KeeperTestSuite) TestGetCheckpointByBlockNumber() {
+	ctx, require, keeper := s.ctx, s.Require(), s.checkpointKeeper
+
+	// no acknowledged checkpoint yet
+	_, err := keeper.GetCheckpointByBlockNumber(ctx, 0)
+	require.ErrorIs(err, types.ErrNoCheckpointFound)
+
+	ranges := [][2]uint64{{0, 255}, {256, 511}, {512, 600}, {601, 1000}, {1001, 1001}}
+	for i, r := range ranges {
+		checkpoint := types.CreateCheckpoint(
+			uint64(i+1),
+			r[0],
+			r[1],
+			testutil.RandomBytes(),
+			common.Address{}.String(),
+			TestBorChainID,
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(keeper.AddCheckpoint(ctx, checkpoint))
+	}
+	require.NoError(keeper.UpdateAckCountWithValue(ctx, uint64(len(ranges))))
+
+	for i, r := range ranges {
+		for _, blockNumber := range []uint64{r[0], (r[0] + r[1]) / 2, r[1]} {
+			checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, blockNumber)
+			require.NoError(err)
+			require.Equal(uint64(i+1), checkpoint.Id, "block %d", blockNumber)
+		}
+	}
+
+	_, err = keeper.GetCheckpointByBlockNumber(ctx, 1002)
+	require.ErrorIs(err, types.ErrNoCheckpointFound)
+}
+
 func (s *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	ctx, require, keeper := s.ctx, s.Require(), s.checkpointKeeper
 
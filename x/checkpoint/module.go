@@ -11,12 +11,14 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	"github.com/0xPolygon/heimdall-v2/x/checkpoint/client/cli"
 	"github.com/0xPolygon/heimdall-v2/x/checkpoint/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/checkpoint/simulation"
 	"github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
 )
 
@@ -24,9 +26,10 @@ import (
 const ConsensusVersion = 1
 
 var (
-	_ module.HasGenesis     = AppModule{}
-	_ module.HasServices    = AppModule{}
-	_ module.AppModuleBasic = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ module.AppModuleBasic      = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
 
 	_ appmodule.AppModule        = AppModule{}
 	_ sidetxs.HasSideMsgServices = AppModule{}
@@ -51,6 +54,11 @@ func (am AppModule) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
+// GetQueryCmd returns the root query command for the checkpoint module.
+func (am AppModule) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 // Name returns the checkpoint module's name.
 func (AppModule) Name() string {
 	return types.ModuleName
@@ -122,6 +130,27 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the checkpoint module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
+}
+
+// RegisterStoreDecoder registers a decoder for checkpoint module's types
+func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+
+// WeightedOperations returns nil, as the checkpoint operations are composed by the app simulation,
+// with the keepers of the other modules they depend on.
+func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
@@ -0,0 +1,122 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+)
+
+const (
+	CheckpointBufferTime    = "checkpoint_buffer_time"
+	AvgCheckpointLength     = "avg_checkpoint_length"
+	MaxCheckpointLength     = "max_checkpoint_length"
+	ChildChainBlockInterval = "child_chain_block_interval"
+	AckCount                = "ack_count"
+)
+
+// genCheckpointBufferTime returns a randomized checkpoint buffer time
+func genCheckpointBufferTime(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(3600)+60) * time.Second
+}
+
+// genAvgCheckpointLength returns a randomized avg checkpoint length
+func genAvgCheckpointLength(r *rand.Rand) uint64 {
+	return uint64(r.Intn(512) + 1)
+}
+
+// genMaxCheckpointLength returns a randomized max checkpoint length, not lower than the avg one
+func genMaxCheckpointLength(r *rand.Rand, avgCheckpointLength uint64) uint64 {
+	return avgCheckpointLength + uint64(r.Intn(1024))
+}
+
+// genChildChainBlockInterval returns a randomized child chain block interval
+func genChildChainBlockInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10000) + 1)
+}
+
+// genAckCount returns a randomized number of checkpoints acknowledged before the genesis
+func genAckCount(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10))
+}
+
+// genCheckpoints returns ackCount contiguous checkpoints, proposed by random simulation accounts
+func genCheckpoints(simState *module.SimulationState, ackCount, maxCheckpointLength uint64) []types.Checkpoint {
+	checkpoints := make([]types.Checkpoint, 0, ackCount)
+
+	startBlock := uint64(0)
+	for id := uint64(1); id <= ackCount; id++ {
+		endBlock := startBlock + uint64(simState.Rand.Int63n(int64(maxCheckpointLength)))
+		rootHash := make([]byte, common.HashLength)
+		simState.Rand.Read(rootHash)
+		proposer := simState.Accounts[simState.Rand.Intn(len(simState.Accounts))]
+
+		checkpoints = append(checkpoints, types.Checkpoint{
+			Id:         id,
+			Proposer:   proposer.Address.String(),
+			StartBlock: startBlock,
+			EndBlock:   endBlock,
+			RootHash:   rootHash,
+			BorChainId: helper.DefaultBorChainID,
+			Timestamp:  uint64(simState.GenTimestamp.Unix()) - (ackCount-id+1)*uint64(time.Hour.Seconds()),
+		})
+
+		startBlock = endBlock + 1
+	}
+
+	return checkpoints
+}
+
+// RandomizedGenState generates a random GenesisState for checkpoint
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		checkpointBufferTime    time.Duration
+		avgCheckpointLength     uint64
+		maxCheckpointLength     uint64
+		childChainBlockInterval uint64
+		ackCount                uint64
+	)
+
+	simState.AppParams.GetOrGenerate(CheckpointBufferTime, &checkpointBufferTime, simState.Rand, func(r *rand.Rand) {
+		checkpointBufferTime = genCheckpointBufferTime(r)
+	})
+
+	simState.AppParams.GetOrGenerate(AvgCheckpointLength, &avgCheckpointLength, simState.Rand, func(r *rand.Rand) {
+		avgCheckpointLength = genAvgCheckpointLength(r)
+	})
+
+	simState.AppParams.GetOrGenerate(MaxCheckpointLength, &maxCheckpointLength, simState.Rand, func(r *rand.Rand) {
+		maxCheckpointLength = genMaxCheckpointLength(r, avgCheckpointLength)
+	})
+
+	simState.AppParams.GetOrGenerate(ChildChainBlockInterval, &childChainBlockInterval, simState.Rand, func(r *rand.Rand) {
+		childChainBlockInterval = genChildChainBlockInterval(r)
+	})
+
+	simState.AppParams.GetOrGenerate(AckCount, &ackCount, simState.Rand, func(r *rand.Rand) {
+		ackCount = genAckCount(r)
+	})
+
+	params := types.Params{
+		CheckpointBufferTime:    checkpointBufferTime,
+		AvgCheckpointLength:     avgCheckpointLength,
+		MaxCheckpointLength:     maxCheckpointLength,
+		ChildChainBlockInterval: childChainBlockInterval,
+	}
+
+	checkpoints := genCheckpoints(simState, ackCount, maxCheckpointLength)
+	checkpointGenesis := types.NewGenesisState(params, nil, 0, ackCount, checkpoints)
+
+	bz, err := json.MarshalIndent(&checkpointGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated checkpoint parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&checkpointGenesis)
+}
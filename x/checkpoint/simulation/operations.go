@@ -0,0 +1,233 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmTypes "github.com/0xPolygon/heimdall-v2/types"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/checkpoint/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/checkpoint/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCheckpoint = "op_weight_msg_checkpoint"
+	OpWeightMsgCpAck      = "op_weight_msg_cp_ack"
+	OpWeightMsgCpNoAck    = "op_weight_msg_cp_no_ack"
+
+	DefaultWeightMsgCheckpoint = 50
+	DefaultWeightMsgCpAck      = 50
+	DefaultWeightMsgCpNoAck    = 10
+)
+
+// WeightedOperations returns all the operations from the checkpoint module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	k *keeper.Keeper,
+	stakeKeeper types.StakeKeeper,
+	topupKeeper types.TopupKeeper,
+	chainKeeper types.ChainManagerKeeper,
+) []hmSimulation.WeightedOperation {
+	var (
+		weightMsgCheckpoint int
+		weightMsgCpAck      int
+		weightMsgCpNoAck    int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCheckpoint, &weightMsgCheckpoint, nil, func(_ *rand.Rand) {
+		weightMsgCheckpoint = DefaultWeightMsgCheckpoint
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgCpAck, &weightMsgCpAck, nil, func(_ *rand.Rand) {
+		weightMsgCpAck = DefaultWeightMsgCpAck
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgCpNoAck, &weightMsgCpNoAck, nil, func(_ *rand.Rand) {
+		weightMsgCpNoAck = DefaultWeightMsgCpNoAck
+	})
+
+	return []hmSimulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgCheckpoint, SimulateMsgCheckpoint(k, stakeKeeper, topupKeeper, chainKeeper)),
+		hmSimulation.NewWeightedOperation(weightMsgCpAck, SimulateMsgCpAck(k)),
+		hmSimulation.NewWeightedOperation(weightMsgCpNoAck, SimulateMsgCpNoAck(k, stakeKeeper)),
+	}
+}
+
+// SimulateMsgCheckpoint generates a MsgCheckpoint proposed by the current proposer, for a random range of bor blocks
+// following the last checkpoint. The root hashes of the ranges are the ones of hmSimulation.BorChainRootHash.
+func SimulateMsgCheckpoint(
+	k *keeper.Keeper,
+	stakeKeeper types.StakeKeeper,
+	topupKeeper types.TopupKeeper,
+	chainKeeper types.ChainManagerKeeper,
+) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCheckpoint{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the checkpoint params"), err
+		}
+
+		checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+		if err == nil && checkpointBuffer.Timestamp != 0 &&
+			ctx.BlockTime().Before(time.Unix(int64(checkpointBuffer.Timestamp), 0).Add(params.CheckpointBufferTime)) {
+			return hmSimulation.NoOpMsg(msgType, "checkpoint already in buffer"), nil
+		}
+
+		var start uint64
+		lastCheckpoint, err := k.GetLastCheckpoint(ctx)
+		switch {
+		case err == nil:
+			start = lastCheckpoint.EndBlock + 1
+		case !errors.Is(err, types.ErrNoCheckpointFound):
+			return hmSimulation.NoOpMsg(msgType, "unable to get the last checkpoint"), err
+		}
+
+		proposer := stakeKeeper.GetCurrentProposer(ctx)
+		if proposer == nil {
+			return hmSimulation.NoOpMsg(msgType, "no proposer in the validator set"), nil
+		}
+
+		proposerAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(proposer.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "proposer is not a simulation account"), nil
+		}
+
+		dividendAccounts, err := topupKeeper.GetAllDividendAccounts(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the dividend accounts"), err
+		}
+
+		accountRootHash, err := hmTypes.GetAccountRootHash(dividendAccounts)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to compute the account root hash"), err
+		}
+
+		chainParams, err := chainKeeper.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the chainmanager params"), err
+		}
+
+		// a checkpoint spans at least two blocks, and at most the max checkpoint length
+		length := 2 + r.Intn(max(int(params.MaxCheckpointLength)-1, 1))
+		end := start + uint64(length) - 1
+
+		msg := types.NewMsgCheckpointBlock(
+			proposerAcc.Address.String(),
+			start,
+			end,
+			hmSimulation.BorChainRootHash(start, end),
+			accountRootHash,
+			chainParams.ChainParams.BorChainId,
+		)
+
+		return hmSimulation.NewOperationMsg(msg, proposerAcc, ""), nil
+	}
+}
+
+// SimulateMsgCpAck generates a MsgCpAck acknowledging the checkpoint in buffer,
+// as submitted on the root chain by its proposer
+func SimulateMsgCpAck(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCpAck{})
+
+		checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+		if err != nil || keeper.IsBufferedCheckpointZero(checkpointBuffer) {
+			return hmSimulation.NoOpMsg(msgType, "no checkpoint in buffer"), nil
+		}
+
+		number := uint64(1)
+		lastCheckpoint, err := k.GetLastCheckpoint(ctx)
+		switch {
+		case err == nil:
+			number = lastCheckpoint.Id + 1
+		case !errors.Is(err, types.ErrNoCheckpointFound):
+			return hmSimulation.NoOpMsg(msgType, "unable to get the last checkpoint"), err
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the checkpoint params"), err
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgCpAck(
+			from.Address.String(),
+			number,
+			checkpointBuffer.Proposer,
+			checkpointBuffer.StartBlock,
+			checkpointBuffer.EndBlock,
+			checkpointBuffer.RootHash,
+		)
+
+		contractCaller.On("GetHeaderInfo", mock.Anything, number, mock.Anything, params.ChildChainBlockInterval).Return(
+			common.BytesToHash(checkpointBuffer.RootHash),
+			checkpointBuffer.StartBlock,
+			checkpointBuffer.EndBlock,
+			checkpointBuffer.Timestamp,
+			checkpointBuffer.Proposer,
+			nil,
+		).Once()
+
+		return hmSimulation.NewOperationMsg(&msg, from, ""), nil
+	}
+}
+
+// SimulateMsgCpNoAck generates a MsgCpNoAck sent by the next proposer, once the last checkpoint is older than
+// the checkpoint buffer time
+func SimulateMsgCpNoAck(k *keeper.Keeper, stakeKeeper types.StakeKeeper) hmSimulation.Operation {
+	return func(_ *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCpNoAck{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the checkpoint params"), err
+		}
+
+		lastCheckpoint, err := k.GetLastCheckpoint(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "no checkpoint acknowledged yet"), nil
+		}
+
+		lastNoAck, err := k.GetLastNoAck(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the last no-ack"), err
+		}
+
+		if ctx.BlockTime().Before(time.Unix(int64(lastCheckpoint.Timestamp), 0).Add(params.CheckpointBufferTime)) ||
+			ctx.BlockTime().Before(time.Unix(int64(lastNoAck), 0).Add(params.CheckpointBufferTime)) {
+			return hmSimulation.NoOpMsg(msgType, "checkpoint buffer time not elapsed"), nil
+		}
+
+		validatorSet, err := stakeKeeper.GetValidatorSet(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the validator set"), err
+		}
+
+		// the next proposer is allowed to send the no-ack as soon as the buffer time is elapsed
+		validatorSet.IncrementProposerPriority(1)
+		proposer := validatorSet.GetProposer()
+		if proposer == nil {
+			return hmSimulation.NoOpMsg(msgType, "no proposer in the validator set"), nil
+		}
+
+		from, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(proposer.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "proposer is not a simulation account"), nil
+		}
+
+		msg := types.NewMsgCheckpointNoAck(from.Address.String())
+
+		return hmSimulation.NewOperationMsg(&msg, from, ""), nil
+	}
+}
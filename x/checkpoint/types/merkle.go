@@ -3,12 +3,22 @@ package types
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
 	"github.com/0xPolygon/heimdall-v2/common/cache"
 	"github.com/0xPolygon/heimdall-v2/helper"
+	heimdallTypes "github.com/0xPolygon/heimdall-v2/types"
 	borTypes "github.com/0xPolygon/heimdall-v2/x/bor/types"
 )
 
@@ -96,3 +106,154 @@ func IsValidCheckpoint(ctx context.Context, start uint64, end uint64, rootHash [
 
 	return false, nil
 }
+
+// GetBlockLeaf returns the leaf of a bor block in the checkpoint Merkle tree,
+// computed as bor does it: keccak256 of its number, time, tx root and receipts
+// root, each left-padded to 32 bytes.
+func GetBlockLeaf(header *ethTypes.Header) common.Hash {
+	return crypto.Keccak256Hash(heimdallTypes.AppendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetBlockProof returns the sibling hashes from the leaf at the given index up to
+// the root of the checkpoint Merkle tree of the given leaves, along with that
+// root. As in bor, the leaves are padded with zero hashes to the next power of
+// two, and each parent is the keccak256 of its left and right children.
+func GetBlockProof(leaves []common.Hash, index uint64) ([][]byte, common.Hash, error) {
+	if index >= uint64(len(leaves)) {
+		return nil, common.Hash{}, fmt.Errorf("leaf index %d out of range of %d leaves", index, len(leaves))
+	}
+
+	width := 1
+	for width < len(leaves) {
+		width <<= 1
+	}
+
+	level := make([]common.Hash, width)
+	copy(level, leaves)
+
+	var proof [][]byte
+
+	for len(level) > 1 {
+		proof = append(proof, level[index^1].Bytes())
+
+		parents := make([]common.Hash, len(level)/2)
+		for i := range parents {
+			parents[i] = crypto.Keccak256Hash(level[2*i].Bytes(), level[2*i+1].Bytes())
+		}
+
+		level = parents
+		index /= 2
+	}
+
+	return proof, level[0], nil
+}
+
+// VerifyBlockProof checks that the given proof, as returned by GetBlockProof,
+// links the leaf at the given index to the checkpoint root hash.
+func VerifyBlockProof(leaf []byte, index uint64, proof [][]byte, rootHash []byte) bool {
+	if len(proof) < 64 && index>>len(proof) != 0 {
+		return false
+	}
+
+	computed := leaf
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			computed = crypto.Keccak256(computed, sibling)
+		} else {
+			computed = crypto.Keccak256(sibling, computed)
+		}
+
+		index /= 2
+	}
+
+	return bytes.Equal(computed, rootHash)
+}
+
+// GetReceiptProof returns the consensus encoding of the receipt at the given tx
+// index, and the receipt trie nodes from the root of the given block receipts
+// down to it, along with that root. The bor state sync receipt that older bor
+// blocks append to their receipts is not part of the receipt trie, and skipped.
+func GetReceiptProof(receipts []*ethTypes.Receipt, txIndex uint64, blockNumber uint64, blockHash common.Hash) ([]byte, [][]byte, common.Hash, error) {
+	borReceiptTxHash := ethTypes.GetDerivedBorTxHash(ethTypes.BorReceiptKey(blockNumber, blockHash))
+
+	trieReceipts := make(ethTypes.Receipts, 0, len(receipts))
+	for _, receipt := range receipts {
+		if receipt.TxHash != borReceiptTxHash {
+			trieReceipts = append(trieReceipts, receipt)
+		}
+	}
+
+	if txIndex >= uint64(len(trieReceipts)) {
+		return nil, nil, common.Hash{}, fmt.Errorf("tx index %d out of range of the %d receipts of the block", txIndex, len(trieReceipts))
+	}
+
+	receiptTrie := trie.NewEmpty(nil)
+
+	var (
+		value  []byte
+		buffer bytes.Buffer
+	)
+
+	for i := range trieReceipts {
+		buffer.Reset()
+		trieReceipts.EncodeIndex(i, &buffer)
+
+		encoded := common.CopyBytes(buffer.Bytes())
+		if uint64(i) == txIndex {
+			value = encoded
+		}
+
+		if err := receiptTrie.Update(rlp.AppendUint64(nil, uint64(i)), encoded); err != nil {
+			return nil, nil, common.Hash{}, err
+		}
+	}
+
+	proof := &proofList{}
+	if err := receiptTrie.Prove(rlp.AppendUint64(nil, txIndex), proof); err != nil {
+		return nil, nil, common.Hash{}, err
+	}
+
+	return value, proof.nodes, receiptTrie.Hash(), nil
+}
+
+// VerifyReceiptProof checks that the given proof, as returned by GetReceiptProof,
+// links the receipt at the given tx index to the receipts root of its block, and
+// returns the proven receipt encoding.
+func VerifyReceiptProof(receiptsRoot common.Hash, txIndex uint64, proof [][]byte) ([]byte, error) {
+	proofDB := memorydb.New()
+	for _, node := range proof {
+		if err := proofDB.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := trie.VerifyProof(receiptsRoot, rlp.AppendUint64(nil, txIndex), proofDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("no receipt at tx index %d under receipts root %s", txIndex, receiptsRoot.Hex())
+	}
+
+	return value, nil
+}
+
+// proofList collects the trie nodes of a proof, in order from the root.
+type proofList struct {
+	nodes [][]byte
+}
+
+func (p *proofList) Put(_ []byte, value []byte) error {
+	p.nodes = append(p.nodes, value)
+	return nil
+}
+
+func (p *proofList) Delete([]byte) error {
+	return errors.New("proof list does not support deletes")
+}
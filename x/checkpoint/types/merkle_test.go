@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -70,3 +75,102 @@ func TestIsValidCheckpoint_BorQueryFailures_WrapDistinctSentinels(t *testing.T)
 		})
 	}
 }
+
+func TestGetBlockLeaf(t *testing.T) {
+	header := &ethTypes.Header{
+		Number:      big.NewInt(0x1234),
+		Time:        0x5678,
+		TxHash:      common.HexToHash("0xaa"),
+		ReceiptHash: common.HexToHash("0xbb"),
+	}
+
+	// number, time, tx root and receipts root, each left-padded to 32 bytes
+	encoded := common.FromHex(
+		"0000000000000000000000000000000000000000000000000000000000001234" +
+			"0000000000000000000000000000000000000000000000000000000000005678" +
+			"00000000000000000000000000000000000000000000000000000000000000aa" +
+			"00000000000000000000000000000000000000000000000000000000000000bb")
+
+	require.Equal(t, crypto.Keccak256Hash(encoded), checkpointTypes.GetBlockLeaf(header))
+}
+
+func TestGetBlockProof(t *testing.T) {
+	hash := func(left, right common.Hash) common.Hash {
+		return crypto.Keccak256Hash(left.Bytes(), right.Bytes())
+	}
+
+	leaves := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+
+	// leaves are padded with zero hashes to the next power of two
+	expectedRoot := hash(hash(leaves[0], leaves[1]), hash(leaves[2], common.Hash{}))
+
+	for index := range leaves {
+		proof, root, err := checkpointTypes.GetBlockProof(leaves, uint64(index))
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot, root)
+		require.Len(t, proof, 2)
+		require.True(t, checkpointTypes.VerifyBlockProof(leaves[index].Bytes(), uint64(index), proof, root.Bytes()))
+
+		// the proof does not hold for another leaf or index
+		require.False(t, checkpointTypes.VerifyBlockProof(leaves[(index+1)%3].Bytes(), uint64(index), proof, root.Bytes()))
+		require.False(t, checkpointTypes.VerifyBlockProof(leaves[index].Bytes(), uint64(index^1), proof, root.Bytes()))
+		require.False(t, checkpointTypes.VerifyBlockProof(leaves[index].Bytes(), uint64(index+4), proof, root.Bytes()))
+	}
+
+	proof, root, err := checkpointTypes.GetBlockProof(leaves[:1], 0)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.Equal(t, leaves[0], root)
+
+	_, _, err = checkpointTypes.GetBlockProof(leaves, 3)
+	require.Error(t, err)
+}
+
+func TestGetReceiptProof(t *testing.T) {
+	blockNumber, blockHash := uint64(64), common.HexToHash("0x40")
+
+	receipts := make([]*ethTypes.Receipt, 0, 20)
+	for i := range 20 {
+		receipts = append(receipts, &ethTypes.Receipt{
+			Type:              ethTypes.DynamicFeeTxType,
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21_000 * (i + 1)),
+			Logs:              []*ethTypes.Log{},
+			TxHash:            common.BigToHash(big.NewInt(int64(i + 1))),
+			TransactionIndex:  uint(i),
+		})
+	}
+
+	expectedRoot := ethTypes.DeriveSha(ethTypes.Receipts(receipts), trie.NewStackTrie(nil))
+
+	// the bor state sync receipt appended to the block receipts is not in the receipt trie
+	borReceipt := &ethTypes.Receipt{
+		TxHash: ethTypes.GetDerivedBorTxHash(ethTypes.BorReceiptKey(blockNumber, blockHash)),
+		Logs:   []*ethTypes.Log{},
+	}
+	blockReceipts := append(receipts, borReceipt)
+
+	for index := range receipts {
+		receipt, proof, root, err := checkpointTypes.GetReceiptProof(blockReceipts, uint64(index), blockNumber, blockHash)
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot, root)
+
+		encoded, err := receipts[index].MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, encoded, receipt)
+
+		proven, err := checkpointTypes.VerifyReceiptProof(root, uint64(index), proof)
+		require.NoError(t, err)
+		require.Equal(t, receipt, proven)
+
+		// the proof does not hold for another index or root
+		_, err = checkpointTypes.VerifyReceiptProof(root, uint64(index+1), proof)
+		require.Error(t, err)
+
+		_, err = checkpointTypes.VerifyReceiptProof(common.HexToHash("0x01"), uint64(index), proof)
+		require.Error(t, err)
+	}
+
+	_, _, _, err := checkpointTypes.GetReceiptProof(blockReceipts, uint64(len(receipts)), blockNumber, blockHash)
+	require.Error(t, err)
+}
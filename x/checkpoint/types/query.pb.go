@@ -946,6 +946,175 @@ func (m *QueryCheckpointOverviewResponse) GetValidatorSet() types.ValidatorSet {
 	return types.ValidatorSet{}
 }
 
+// QueryCheckpointProofRequest is the request type for the GetCheckpointProof
+// query.
+type QueryCheckpointProofRequest struct {
+	// Bor block number to prove the inclusion of.
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Optional bor tx hash to also prove the inclusion of the receipt of, in the
+	// receipts root of its block. The block number may then be left to 0.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryCheckpointProofRequest) Reset()         { *m = QueryCheckpointProofRequest{} }
+func (m *QueryCheckpointProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointProofRequest) ProtoMessage()    {}
+func (*QueryCheckpointProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01834be0e35c5db2, []int{20}
+}
+func (m *QueryCheckpointProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointProofRequest.Merge(m, src)
+}
+func (m *QueryCheckpointProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointProofRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointProofRequest) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryCheckpointProofRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryCheckpointProofResponse is the response type for the
+// GetCheckpointProof query.
+type QueryCheckpointProofResponse struct {
+	// Acknowledged checkpoint covering the block.
+	Checkpoint Checkpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
+	// Header block number of the checkpoint on the root chain contract.
+	HeaderBlockNumber uint64 `protobuf:"varint,2,opt,name=header_block_number,json=headerBlockNumber,proto3" json:"header_block_number,omitempty"`
+	// Merkle leaf of the block, keccak256 of its number, time, tx root and
+	// receipts root.
+	Leaf []byte `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// Index of the leaf in the checkpoint tree, from the checkpoint start block.
+	LeafIndex uint64 `protobuf:"varint,4,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// Sibling hashes from the leaf up to the checkpoint root hash.
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// Index of the tx in its block, set when a tx hash is given.
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Consensus encoding of the tx receipt, set when a tx hash is given.
+	Receipt []byte `protobuf:"bytes,7,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// Receipt trie nodes from the receipts root of the block down to the
+	// receipt, set when a tx hash is given.
+	ReceiptProof [][]byte `protobuf:"bytes,8,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *QueryCheckpointProofResponse) Reset()         { *m = QueryCheckpointProofResponse{} }
+func (m *QueryCheckpointProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointProofResponse) ProtoMessage()    {}
+func (*QueryCheckpointProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01834be0e35c5db2, []int{21}
+}
+func (m *QueryCheckpointProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointProofResponse.Merge(m, src)
+}
+func (m *QueryCheckpointProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointProofResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointProofResponse) GetCheckpoint() Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return Checkpoint{}
+}
+
+func (m *QueryCheckpointProofResponse) GetHeaderBlockNumber() uint64 {
+	if m != nil {
+		return m.HeaderBlockNumber
+	}
+	return 0
+}
+
+func (m *QueryCheckpointProofResponse) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *QueryCheckpointProofResponse) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *QueryCheckpointProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryCheckpointProofResponse) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *QueryCheckpointProofResponse) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *QueryCheckpointProofResponse) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCheckpointSignaturesRequest)(nil), "heimdallv2.checkpoint.QueryCheckpointSignaturesRequest")
 	proto.RegisterType((*QueryCheckpointSignaturesResponse)(nil), "heimdallv2.checkpoint.QueryCheckpointSignaturesResponse")
@@ -967,81 +1136,94 @@ func init() {
 	proto.RegisterType((*QueryCheckpointListResponse)(nil), "heimdallv2.checkpoint.QueryCheckpointListResponse")
 	proto.RegisterType((*QueryCheckpointOverviewRequest)(nil), "heimdallv2.checkpoint.QueryCheckpointOverviewRequest")
 	proto.RegisterType((*QueryCheckpointOverviewResponse)(nil), "heimdallv2.checkpoint.QueryCheckpointOverviewResponse")
+	proto.RegisterType((*QueryCheckpointProofRequest)(nil), "heimdallv2.checkpoint.QueryCheckpointProofRequest")
+	proto.RegisterType((*QueryCheckpointProofResponse)(nil), "heimdallv2.checkpoint.QueryCheckpointProofResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/checkpoint/query.proto", fileDescriptor_01834be0e35c5db2) }
 
 var fileDescriptor_01834be0e35c5db2 = []byte{
-	// 1089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x69, 0x13, 0xc8, 0x24, 0x4d, 0xc9, 0x24, 0x6e, 0xc2, 0x26, 0xd9, 0xd8, 0x0b,
-	0x94, 0x62, 0xf0, 0x2e, 0x71, 0x80, 0x22, 0x71, 0xa1, 0x2e, 0x52, 0x40, 0x4a, 0xd3, 0x34, 0x15,
-	0x20, 0xb8, 0x58, 0xe3, 0xcd, 0x74, 0xbd, 0xb2, 0xbd, 0xe3, 0xee, 0x8c, 0x8d, 0x43, 0xd5, 0x0b,
-	0x27, 0xb8, 0x20, 0x04, 0x37, 0x24, 0x2e, 0x9c, 0x10, 0x27, 0x3e, 0x00, 0x17, 0x6e, 0x39, 0x70,
-	0xa8, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x12, 0x5f, 0x03, 0x79, 0x67, 0xd6, 0xb3, 0x63, 0xef, 0xda,
-	0x6b, 0xa4, 0x5e, 0x2c, 0x6b, 0xe7, 0xbd, 0xf7, 0xff, 0xbd, 0x37, 0xb3, 0xef, 0xcd, 0x82, 0x42,
-	0x1d, 0x7b, 0xad, 0x13, 0xd4, 0x6c, 0x76, 0xcb, 0xb6, 0x53, 0xc7, 0x4e, 0xa3, 0x4d, 0x3c, 0x9f,
-	0xd9, 0x0f, 0x3b, 0x38, 0x38, 0xb5, 0xda, 0x01, 0x61, 0x04, 0xe6, 0xa4, 0x89, 0x25, 0x4d, 0xf4,
-	0x15, 0xd4, 0xf2, 0x7c, 0x62, 0x87, 0xbf, 0xdc, 0x52, 0x2f, 0x3a, 0x84, 0xb6, 0x08, 0xb5, 0x6b,
-	0x88, 0x62, 0x1e, 0xc2, 0xee, 0xee, 0xd6, 0x30, 0x43, 0xbb, 0x76, 0x1b, 0xb9, 0x9e, 0x8f, 0x98,
-	0x47, 0x7c, 0x61, 0xbb, 0x29, 0x6c, 0x23, 0xb3, 0xb8, 0xa4, 0xbe, 0xe6, 0x12, 0x97, 0x84, 0x7f,
-	0xed, 0xfe, 0x3f, 0xf1, 0x74, 0xcb, 0x25, 0xc4, 0x6d, 0x62, 0x1b, 0xb5, 0x3d, 0x1b, 0xf9, 0x3e,
-	0x61, 0x61, 0x3c, 0x2a, 0x56, 0xaf, 0x27, 0x67, 0x22, 0xff, 0x0a, 0xbb, 0xdd, 0x49, 0x76, 0x55,
-	0xea, 0xb9, 0x3e, 0x62, 0x9d, 0x00, 0x47, 0xa1, 0x8d, 0x64, 0x17, 0xd6, 0x13, 0xeb, 0xf9, 0xd8,
-	0x3a, 0x65, 0xa8, 0x81, 0xed, 0x2e, 0x6a, 0x7a, 0x27, 0x88, 0x91, 0x80, 0x5b, 0x98, 0x15, 0x90,
-	0xbf, 0xd7, 0xcf, 0xef, 0xf6, 0xc0, 0xfb, 0xfe, 0x40, 0xe4, 0x18, 0x3f, 0xec, 0x60, 0xca, 0xa0,
-	0x01, 0x9e, 0x61, 0xbd, 0x6a, 0x1d, 0xd1, 0xfa, 0x86, 0x96, 0xd7, 0x6e, 0x2c, 0x54, 0xe6, 0x7e,
-	0xfa, 0xf7, 0x97, 0xa2, 0x76, 0x3c, 0xcf, 0x7a, 0xef, 0x23, 0x5a, 0x37, 0x3f, 0x07, 0x85, 0x31,
-	0x31, 0x68, 0x9b, 0xf8, 0x14, 0xc3, 0x0f, 0x01, 0x90, 0xf8, 0x1b, 0x5a, 0xfe, 0xd2, 0x8d, 0xc5,
-	0x72, 0xd1, 0x4a, 0xdc, 0x41, 0x2b, 0x21, 0x50, 0x65, 0xe1, 0xec, 0xaf, 0x9d, 0x19, 0xae, 0x1b,
-	0x0b, 0x64, 0xae, 0x01, 0x18, 0x6a, 0x1f, 0xa1, 0x00, 0xb5, 0x22, 0x62, 0xf3, 0x63, 0xb0, 0xaa,
-	0x3c, 0x15, 0x0c, 0xef, 0x82, 0xf9, 0x76, 0xf8, 0x24, 0xcc, 0x63, 0xb1, 0xbc, 0x9d, 0xa2, 0xcf,
-	0xdd, 0xe2, 0x92, 0xc2, 0xcf, 0xbc, 0x06, 0xd6, 0xc2, 0xc0, 0xb7, 0x9c, 0xc6, 0x6d, 0xd2, 0xf1,
-	0x59, 0x24, 0xf8, 0x0e, 0xc8, 0x0d, 0x3d, 0x17, 0x92, 0x26, 0x58, 0x40, 0x4e, 0xa3, 0xea, 0xf4,
-	0x1f, 0x86, 0xaa, 0x97, 0xa3, 0xea, 0x3d, 0x8b, 0x84, 0xad, 0xb9, 0x2e, 0x9c, 0x0f, 0x10, 0x65,
-	0x87, 0xe4, 0x96, 0xd3, 0x88, 0xa2, 0xbe, 0x07, 0xae, 0x0d, 0x2f, 0x88, 0xb0, 0x45, 0xb0, 0xdc,
-	0x44, 0x94, 0x55, 0x7d, 0x52, 0xed, 0x87, 0xf7, 0x4e, 0xd4, 0xd8, 0x8b, 0xcd, 0xc8, 0xe3, 0x83,
-	0x13, 0xd3, 0x00, 0x5b, 0x43, 0xdb, 0x53, 0xe9, 0x3c, 0x78, 0x80, 0x83, 0x48, 0xa5, 0x05, 0xb6,
-	0x53, 0xd6, 0x85, 0xd8, 0x01, 0x00, 0xb2, 0x38, 0xa2, 0x74, 0x85, 0x89, 0x5b, 0xa7, 0xec, 0x98,
-	0xb4, 0x30, 0x6f, 0x8a, 0xa4, 0xa4, 0x65, 0x74, 0xce, 0xb6, 0xc1, 0xbc, 0xdf, 0x69, 0xd5, 0x70,
-	0xa0, 0x26, 0x23, 0x1e, 0x9a, 0x2e, 0x58, 0x1f, 0x71, 0x7c, 0x2a, 0x84, 0xa3, 0x05, 0x3b, 0x40,
-	0x0c, 0x53, 0x96, 0x5e, 0xb0, 0x68, 0xfd, 0xa9, 0xe0, 0x6c, 0x01, 0x3d, 0x94, 0x3b, 0xc4, 0x3d,
-	0x36, 0x52, 0x34, 0xd3, 0x07, 0x9b, 0x89, 0xab, 0x02, 0xe5, 0x6e, 0x02, 0xca, 0x8b, 0x29, 0x28,
-	0x77, 0xa8, 0x3b, 0x99, 0x86, 0x08, 0x9a, 0x58, 0xf2, 0xde, 0xa0, 0x34, 0xf0, 0x1e, 0x00, 0xb2,
-	0xa1, 0x0a, 0xb9, 0xeb, 0x16, 0xef, 0xa8, 0x56, 0xbf, 0xfb, 0x5a, 0xbc, 0x9b, 0x8a, 0xee, 0x6b,
-	0x1d, 0x21, 0x17, 0x0b, 0x5f, 0x45, 0x50, 0x06, 0x31, 0xcf, 0x34, 0x91, 0xe1, 0xb0, 0xe2, 0xa0,
-	0xb1, 0x5c, 0x8d, 0xb5, 0xc8, 0xa6, 0x47, 0x99, 0xe8, 0x2e, 0xd3, 0x55, 0x7c, 0xd9, 0x51, 0xc2,
-	0xc3, 0x63, 0x25, 0x93, 0xd9, 0x30, 0x93, 0x97, 0x27, 0x66, 0xc2, 0x99, 0xd2, 0x52, 0xc9, 0x03,
-	0x63, 0x28, 0x93, 0xbb, 0x5d, 0x1c, 0x74, 0x3d, 0xfc, 0x59, 0xb4, 0x9b, 0xbf, 0xcf, 0x82, 0x9d,
-	0x54, 0x93, 0xec, 0x2d, 0x25, 0xa1, 0x3f, 0xcc, 0xa6, 0xf5, 0x07, 0xf8, 0x09, 0x58, 0xa9, 0x85,
-	0x2f, 0x7c, 0x35, 0x76, 0x52, 0x2e, 0xfd, 0x8f, 0x43, 0xfb, 0x1c, 0x0f, 0x23, 0x17, 0xa1, 0x05,
-	0xae, 0x0e, 0x06, 0x8e, 0x00, 0xbe, 0x1c, 0xe7, 0x58, 0x1e, 0xac, 0x72, 0xec, 0x43, 0x70, 0x45,
-	0xda, 0x53, 0xcc, 0x36, 0xe6, 0x42, 0x0c, 0x23, 0x8e, 0x11, 0xce, 0x31, 0xeb, 0xa3, 0xc8, 0xec,
-	0x3e, 0x56, 0x18, 0x96, 0xba, 0xb1, 0x85, 0xf2, 0x6f, 0x4b, 0x60, 0x2e, 0x2c, 0x27, 0xfc, 0x5a,
-	0x03, 0xab, 0xfb, 0x38, 0xf6, 0x86, 0xf0, 0x1e, 0x0f, 0x5f, 0x49, 0xc9, 0x70, 0x74, 0xa8, 0xe8,
-	0xc5, 0x2c, 0xa6, 0x7c, 0x8f, 0xcc, 0xfc, 0x97, 0x7d, 0x9a, 0x2f, 0xfe, 0xf8, 0xe7, 0xbb, 0xd9,
-	0x1c, 0x5c, 0x8d, 0xcd, 0x66, 0x6a, 0xf3, 0x49, 0x02, 0x7f, 0xd6, 0x40, 0x4e, 0x01, 0x8a, 0xf6,
-	0x19, 0xbe, 0x39, 0x4e, 0x27, 0xf5, 0xe8, 0xe8, 0x6f, 0x4d, 0xeb, 0x26, 0x50, 0x4d, 0x89, 0xba,
-	0x0e, 0x73, 0x0a, 0x2a, 0x89, 0x90, 0xbe, 0xd2, 0xc0, 0xe2, 0x3e, 0x66, 0xd1, 0x74, 0x83, 0xaf,
-	0x8e, 0xd3, 0x1a, 0x9a, 0x8d, 0xfa, 0x6b, 0xd9, 0x8c, 0x05, 0xce, 0x8e, 0xc4, 0x59, 0x83, 0x50,
-	0xc1, 0x09, 0x0f, 0x10, 0xfc, 0x71, 0x78, 0x27, 0x79, 0xf3, 0x85, 0x7b, 0xd9, 0xf2, 0x57, 0x5a,
-	0xb9, 0xfe, 0xc6, 0x74, 0x4e, 0x93, 0x77, 0xb7, 0xc9, 0x61, 0x46, 0x20, 0xf9, 0x48, 0xcd, 0x0a,
-	0xa9, 0x0c, 0xe8, 0xac, 0x90, 0xea, 0xd4, 0x1e, 0x07, 0xc9, 0xdf, 0x53, 0xf8, 0xad, 0x06, 0x96,
-	0xf6, 0x31, 0x1b, 0xdc, 0x2e, 0xe0, 0xd8, 0x9d, 0x1a, 0xbe, 0x9d, 0xe8, 0xa5, 0x8c, 0xd6, 0x82,
-	0xe7, 0x25, 0xc9, 0xa3, 0xc3, 0x8d, 0xa1, 0xa2, 0x51, 0x56, 0xf2, 0x49, 0x09, 0x39, 0x0d, 0xf8,
-	0x83, 0x06, 0x56, 0xf6, 0x31, 0x53, 0xc7, 0x19, 0xdc, 0x1d, 0xa7, 0x95, 0x38, 0x18, 0xf5, 0xf2,
-	0x34, 0x2e, 0x82, 0xb1, 0x10, 0xe2, 0x6d, 0xc2, 0xe7, 0xd5, 0x37, 0x36, 0xc0, 0x6d, 0x14, 0xe0,
-	0x92, 0x8f, 0x7b, 0x0c, 0x7e, 0xcf, 0xf9, 0xd4, 0x61, 0x34, 0x9e, 0x2f, 0x71, 0x54, 0x8e, 0xe7,
-	0x4b, 0x9e, 0x75, 0xa6, 0x21, 0x6b, 0xb8, 0x0a, 0x57, 0xd4, 0x1a, 0xf6, 0x31, 0x7e, 0xd5, 0xc0,
-	0xba, 0x02, 0x27, 0x2f, 0xe2, 0xf0, 0x66, 0x36, 0xbd, 0x91, 0xeb, 0xbf, 0xfe, 0xf6, 0xf4, 0x8e,
-	0x02, 0xd7, 0x92, 0xb8, 0x2f, 0xc0, 0x82, 0x82, 0x2b, 0xaf, 0xf0, 0xf6, 0x23, 0xf1, 0x71, 0xf1,
-	0xb8, 0x7f, 0x20, 0xaf, 0x28, 0xf8, 0xb0, 0x94, 0x4d, 0x3b, 0x42, 0xb5, 0xb2, 0x9a, 0x4f, 0xee,
-	0x7d, 0x8f, 0xf8, 0xad, 0xf3, 0x71, 0xe5, 0xce, 0xd9, 0xb9, 0xa1, 0x3d, 0x39, 0x37, 0xb4, 0xbf,
-	0xcf, 0x0d, 0xed, 0x9b, 0x0b, 0x63, 0xe6, 0xc9, 0x85, 0x31, 0xf3, 0xe7, 0x85, 0x31, 0xf3, 0xe9,
-	0x9e, 0xeb, 0xb1, 0x7a, 0xa7, 0x66, 0x39, 0xa4, 0x65, 0xbf, 0xde, 0x3b, 0x22, 0xcd, 0x53, 0x97,
-	0xf8, 0x76, 0x44, 0x50, 0xea, 0x96, 0xed, 0x9e, 0xf2, 0x55, 0x76, 0xda, 0xc6, 0xb4, 0x36, 0x1f,
-	0x7e, 0x77, 0xed, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x64, 0x09, 0xa0, 0xe0, 0x0e, 0x00,
-	0x00,
+	// 1274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa6, 0x4d, 0xd2, 0xbc, 0x24, 0x2d, 0x99, 0x24, 0x8d, 0xbb, 0x49, 0xb7, 0xce, 0xb6,
+	0x94, 0x36, 0xe0, 0x5d, 0xe2, 0xb4, 0x14, 0x89, 0x0b, 0x75, 0x91, 0x42, 0xa5, 0x36, 0x4d, 0x5d,
+	0x01, 0x82, 0x8b, 0x19, 0xaf, 0x27, 0xf6, 0xca, 0xf6, 0x8e, 0xbb, 0x3b, 0x36, 0x0e, 0x51, 0x2e,
+	0x9c, 0xe0, 0x82, 0x10, 0xdc, 0x90, 0xb8, 0x70, 0xaa, 0x38, 0xf1, 0x01, 0xf8, 0x00, 0x39, 0x70,
+	0xa8, 0xc4, 0x05, 0x09, 0x09, 0xa1, 0x04, 0x89, 0x0b, 0x1f, 0x02, 0xed, 0xec, 0xac, 0x77, 0xc7,
+	0x5e, 0xff, 0x43, 0xca, 0x25, 0xd9, 0xcc, 0xfb, 0xf3, 0xfb, 0xbd, 0xf7, 0x66, 0xdf, 0x7b, 0x1b,
+	0xd8, 0xa8, 0x10, 0xbb, 0x5e, 0xc2, 0xb5, 0x5a, 0x2b, 0x6b, 0x5a, 0x15, 0x62, 0x55, 0x1b, 0xd4,
+	0x76, 0x98, 0xf9, 0xbc, 0x49, 0xdc, 0x03, 0xa3, 0xe1, 0x52, 0x46, 0xd1, 0x4a, 0xa4, 0x62, 0x44,
+	0x2a, 0xea, 0x22, 0xae, 0xdb, 0x0e, 0x35, 0xf9, 0xcf, 0x40, 0x53, 0xdd, 0xb4, 0xa8, 0x57, 0xa7,
+	0x9e, 0x59, 0xc4, 0x1e, 0x09, 0x5c, 0x98, 0xad, 0xad, 0x22, 0x61, 0x78, 0xcb, 0x6c, 0xe0, 0xb2,
+	0xed, 0x60, 0x66, 0x53, 0x47, 0xe8, 0xae, 0x09, 0xdd, 0x50, 0x2d, 0x0e, 0xa9, 0x2e, 0x97, 0x69,
+	0x99, 0xf2, 0x47, 0xd3, 0x7f, 0x12, 0xa7, 0xeb, 0x65, 0x4a, 0xcb, 0x35, 0x62, 0xe2, 0x86, 0x6d,
+	0x62, 0xc7, 0xa1, 0x8c, 0xfb, 0xf3, 0x84, 0xf4, 0x66, 0x72, 0x24, 0xd1, 0xa3, 0xd0, 0xdb, 0x1a,
+	0xa6, 0x57, 0xf0, 0xec, 0xb2, 0x83, 0x59, 0xd3, 0x25, 0xa1, 0x6b, 0x2d, 0xd9, 0x84, 0xb5, 0x85,
+	0x3c, 0x1d, 0x93, 0x7b, 0x0c, 0x57, 0x89, 0xd9, 0xc2, 0x35, 0xbb, 0x84, 0x19, 0x75, 0x03, 0x0d,
+	0x3d, 0x07, 0xe9, 0xa7, 0x7e, 0x7c, 0x0f, 0x3a, 0xd6, 0xcf, 0x3a, 0x20, 0x79, 0xf2, 0xbc, 0x49,
+	0x3c, 0x86, 0x34, 0x98, 0x61, 0xed, 0x42, 0x05, 0x7b, 0x95, 0x94, 0x92, 0x56, 0x6e, 0xcd, 0xe6,
+	0xa6, 0x5e, 0xfc, 0xf3, 0xf3, 0xa6, 0x92, 0x9f, 0x66, 0xed, 0xf7, 0xb1, 0x57, 0xd1, 0x3f, 0x87,
+	0x8d, 0x01, 0x3e, 0xbc, 0x06, 0x75, 0x3c, 0x82, 0x3e, 0x00, 0x88, 0xe8, 0xa7, 0x94, 0xf4, 0xb9,
+	0x5b, 0x73, 0xd9, 0x4d, 0x23, 0xb1, 0x82, 0x46, 0x82, 0xa3, 0xdc, 0xec, 0xf1, 0x9f, 0xd7, 0x26,
+	0x02, 0xdc, 0x98, 0x23, 0x7d, 0x19, 0x10, 0xc7, 0xde, 0xc3, 0x2e, 0xae, 0x87, 0x8c, 0xf5, 0x8f,
+	0x60, 0x49, 0x3a, 0x15, 0x1c, 0xde, 0x85, 0xe9, 0x06, 0x3f, 0xe1, 0x71, 0xcc, 0x65, 0xaf, 0xf6,
+	0xc1, 0x0f, 0xcc, 0xe2, 0x90, 0xc2, 0x4e, 0xbf, 0x0c, 0xcb, 0xdc, 0xf1, 0x7d, 0xab, 0xfa, 0x80,
+	0x36, 0x1d, 0x16, 0x02, 0xbe, 0x03, 0x2b, 0x5d, 0xe7, 0x02, 0x52, 0x87, 0x59, 0x6c, 0x55, 0x0b,
+	0x96, 0x7f, 0xc8, 0x51, 0xcf, 0x87, 0xd9, 0xbb, 0x80, 0x85, 0xae, 0xbe, 0x2a, 0x8c, 0x1f, 0x61,
+	0x8f, 0xed, 0xd2, 0xfb, 0x56, 0x35, 0xf4, 0xfa, 0x1e, 0x5c, 0xee, 0x16, 0x08, 0xb7, 0x9b, 0x70,
+	0xb1, 0x86, 0x3d, 0x56, 0x70, 0x68, 0xc1, 0x77, 0x6f, 0x97, 0x64, 0xdf, 0x73, 0xb5, 0xd0, 0xe2,
+	0x61, 0x49, 0xd7, 0x60, 0xbd, 0xab, 0x3c, 0xb9, 0xe6, 0xfe, 0x3e, 0x71, 0x43, 0x94, 0x3a, 0x5c,
+	0xed, 0x23, 0x17, 0x60, 0x8f, 0x00, 0xa2, 0xe4, 0x88, 0xd4, 0x6d, 0x0c, 0x2d, 0x9d, 0x54, 0xb1,
+	0x48, 0x43, 0xbf, 0x27, 0x82, 0x8a, 0x34, 0xc3, 0x7b, 0x76, 0x15, 0xa6, 0x9d, 0x66, 0xbd, 0x48,
+	0x5c, 0x39, 0x18, 0x71, 0xa8, 0x97, 0x61, 0xb5, 0xc7, 0xf0, 0x4c, 0x18, 0xf6, 0x26, 0xec, 0x11,
+	0x66, 0xc4, 0x63, 0xfd, 0x13, 0x16, 0xca, 0xcf, 0x84, 0xce, 0x3a, 0xa8, 0x1c, 0x6e, 0x97, 0xb4,
+	0x59, 0x4f, 0xd2, 0x74, 0x07, 0xd6, 0x12, 0xa5, 0x82, 0xca, 0x93, 0x04, 0x2a, 0x37, 0xfa, 0x50,
+	0x79, 0xec, 0x95, 0x87, 0xb3, 0xa1, 0x82, 0x4d, 0x2c, 0x78, 0xbb, 0x93, 0x1a, 0xf4, 0x14, 0x20,
+	0x6a, 0xa8, 0x02, 0xee, 0xa6, 0x11, 0x74, 0x54, 0xc3, 0xef, 0xbe, 0x46, 0xd0, 0x4d, 0x45, 0xf7,
+	0x35, 0xf6, 0x70, 0x99, 0x08, 0x5b, 0x09, 0x30, 0x72, 0xa2, 0x1f, 0x2b, 0xb0, 0x96, 0x88, 0xd8,
+	0x69, 0x2c, 0x97, 0x62, 0x2d, 0xb2, 0x66, 0x7b, 0x4c, 0x74, 0x97, 0xf1, 0x32, 0x7e, 0xd1, 0x92,
+	0xdc, 0xa3, 0xbc, 0x14, 0xc9, 0x24, 0x8f, 0xe4, 0xb5, 0xa1, 0x91, 0x04, 0x9c, 0xfa, 0x85, 0x92,
+	0x06, 0xad, 0x2b, 0x92, 0x27, 0x2d, 0xe2, 0xb6, 0x6c, 0xf2, 0x59, 0x58, 0xcd, 0x5f, 0x27, 0xe1,
+	0x5a, 0x5f, 0x95, 0xd1, 0x5b, 0x4a, 0x42, 0x7f, 0x98, 0xec, 0xd7, 0x1f, 0xd0, 0xc7, 0xb0, 0x58,
+	0xe4, 0x2f, 0x7c, 0x21, 0x76, 0x53, 0xce, 0xfd, 0x8f, 0x4b, 0xfb, 0x4a, 0xe0, 0x26, 0x12, 0x22,
+	0x03, 0x2e, 0x75, 0x06, 0x8e, 0x20, 0x7c, 0x3e, 0xce, 0xe3, 0x62, 0x47, 0x1a, 0xd0, 0xde, 0x85,
+	0x85, 0x48, 0xdf, 0x23, 0x2c, 0x35, 0xc5, 0x69, 0x68, 0x71, 0x1a, 0x7c, 0x8e, 0x19, 0x1f, 0x86,
+	0x6a, 0xcf, 0x88, 0xc4, 0x61, 0xbe, 0x15, 0x13, 0xe8, 0x9f, 0xf6, 0x5c, 0x9d, 0x3d, 0x97, 0xd2,
+	0xfd, 0xf0, 0xb6, 0xde, 0x82, 0xf9, 0x62, 0x8d, 0x5a, 0xd5, 0x42, 0x52, 0xdb, 0x99, 0xe3, 0xa2,
+	0x5d, 0x2e, 0x41, 0xab, 0xd1, 0x08, 0xf4, 0x13, 0x39, 0xdb, 0x99, 0x7d, 0x7f, 0x4c, 0xc2, 0x7a,
+	0x32, 0xc4, 0x59, 0xf4, 0x02, 0x74, 0x17, 0x96, 0x2a, 0x04, 0x97, 0x88, 0x5b, 0x90, 0x88, 0x4b,
+	0xc5, 0x5d, 0x0c, 0x34, 0x72, 0x31, 0xfa, 0x57, 0xe0, 0x7c, 0x8d, 0xe0, 0x7d, 0x5e, 0xd5, 0xf9,
+	0x50, 0x8f, 0x1f, 0xa1, 0x1b, 0x00, 0xfe, 0xef, 0x82, 0xed, 0x94, 0x48, 0x5b, 0xae, 0xce, 0xac,
+	0x2f, 0x78, 0xe8, 0x9f, 0xa3, 0x35, 0x98, 0x6a, 0xf8, 0x61, 0xa5, 0xa6, 0xd2, 0xe7, 0x22, 0x0f,
+	0xc1, 0x19, 0xba, 0x02, 0x17, 0x58, 0x5b, 0x38, 0x98, 0xf6, 0x1d, 0xe4, 0x67, 0x58, 0x3b, 0xb0,
+	0x4b, 0xc1, 0x8c, 0x4b, 0x2c, 0x62, 0x37, 0x58, 0x6a, 0xc6, 0xc7, 0xce, 0x87, 0x7f, 0xa2, 0xeb,
+	0xb0, 0x20, 0x1e, 0x0b, 0x81, 0xe7, 0x0b, 0xbe, 0xe7, 0xfc, 0xbc, 0x38, 0xe4, 0x49, 0xcc, 0xfe,
+	0xbb, 0x00, 0x53, 0x3c, 0xbb, 0xe8, 0x6b, 0x05, 0x96, 0x76, 0x48, 0xac, 0xc3, 0x05, 0x33, 0x1a,
+	0xdd, 0xee, 0x93, 0xca, 0xde, 0xa5, 0x40, 0xdd, 0x1c, 0x45, 0x35, 0xa8, 0x9a, 0x9e, 0xfe, 0xd2,
+	0x0f, 0xf0, 0x8b, 0xdf, 0xfe, 0xfe, 0x6e, 0x72, 0x05, 0x2d, 0xc5, 0x76, 0x2b, 0xcf, 0x0c, 0x36,
+	0x01, 0xf4, 0x93, 0x02, 0x2b, 0x12, 0xa1, 0xf0, 0x3d, 0x45, 0x77, 0x07, 0xe1, 0xf4, 0x7d, 0xf5,
+	0xd5, 0xb7, 0xc6, 0x35, 0x13, 0x54, 0xf5, 0x88, 0xea, 0x2a, 0x5a, 0x91, 0xa8, 0xd2, 0x90, 0xd2,
+	0x57, 0x0a, 0xcc, 0xed, 0x10, 0x16, 0x6e, 0x27, 0xe8, 0xf5, 0x41, 0x58, 0x5d, 0xbb, 0x8d, 0xfa,
+	0xc6, 0x68, 0xca, 0x82, 0xce, 0xb5, 0x88, 0xce, 0x32, 0x42, 0x12, 0x1d, 0xde, 0x00, 0xd0, 0x8f,
+	0xdd, 0x95, 0x0c, 0x86, 0x27, 0xda, 0x1e, 0x2d, 0x7e, 0x69, 0x14, 0xab, 0x77, 0xc6, 0x33, 0x1a,
+	0x5e, 0xdd, 0x5a, 0x40, 0xa6, 0x87, 0x64, 0xb0, 0x12, 0x8d, 0x4a, 0x52, 0x5a, 0xb0, 0xd4, 0x3b,
+	0xe3, 0x19, 0x0d, 0x27, 0x19, 0xf4, 0x59, 0xf4, 0xad, 0x02, 0xf3, 0x3b, 0x84, 0x75, 0xb6, 0x43,
+	0x34, 0xb0, 0x52, 0xdd, 0xdb, 0xa5, 0x9a, 0x19, 0x51, 0x5b, 0xf0, 0x79, 0x35, 0xe2, 0xa3, 0xa2,
+	0x54, 0x57, 0xd2, 0x3c, 0x96, 0x71, 0x68, 0x06, 0x5b, 0x55, 0xf4, 0x83, 0x02, 0x8b, 0x3b, 0x84,
+	0xc9, 0xeb, 0x08, 0xda, 0x1a, 0x84, 0x95, 0xb8, 0xd8, 0xa8, 0xd9, 0x71, 0x4c, 0x04, 0xc7, 0x0d,
+	0x4e, 0x6f, 0x0d, 0x5d, 0x91, 0xdf, 0x58, 0x97, 0x34, 0xb0, 0x4b, 0x32, 0x0e, 0x69, 0x33, 0xf4,
+	0x7d, 0xc0, 0x4f, 0x5e, 0x26, 0x06, 0xf3, 0x4b, 0x5c, 0x75, 0xd4, 0xec, 0x38, 0x26, 0x82, 0x9f,
+	0x16, 0xe5, 0x70, 0x09, 0x2d, 0xca, 0x39, 0xf4, 0x69, 0xfc, 0xa2, 0xc0, 0xaa, 0x44, 0x2e, 0xfa,
+	0x90, 0x42, 0xf7, 0x46, 0xc3, 0xeb, 0xf9, 0x7c, 0x53, 0xdf, 0x1e, 0xdf, 0x50, 0xd0, 0x35, 0x22,
+	0xba, 0xd7, 0xd1, 0x86, 0x44, 0x37, 0xfa, 0x04, 0x33, 0x0f, 0xc5, 0x64, 0x3c, 0xf2, 0x2f, 0xe4,
+	0x82, 0x44, 0x1f, 0x65, 0x46, 0xc3, 0x0e, 0xa9, 0x1a, 0xa3, 0xaa, 0x0f, 0xef, 0x7d, 0x87, 0xc1,
+	0x68, 0x3c, 0x42, 0x2f, 0x14, 0x40, 0xf2, 0xe4, 0xe0, 0x43, 0x6b, 0xc4, 0xf2, 0xc5, 0xf7, 0x05,
+	0x75, 0x7b, 0x2c, 0x1b, 0xc1, 0xf1, 0x76, 0x62, 0xfe, 0xf8, 0xcc, 0x33, 0x0f, 0xe3, 0x53, 0xfc,
+	0x28, 0xf7, 0xf8, 0xf8, 0x44, 0x53, 0x5e, 0x9e, 0x68, 0xca, 0x5f, 0x27, 0x9a, 0xf2, 0xcd, 0xa9,
+	0x36, 0xf1, 0xf2, 0x54, 0x9b, 0xf8, 0xfd, 0x54, 0x9b, 0xf8, 0x64, 0xbb, 0x6c, 0xb3, 0x4a, 0xb3,
+	0x68, 0x58, 0xb4, 0x6e, 0xbe, 0xd9, 0xde, 0xa3, 0xb5, 0x83, 0x32, 0x75, 0xcc, 0x90, 0x4d, 0xa6,
+	0x95, 0x35, 0xdb, 0xd2, 0x3f, 0x00, 0x0e, 0x1a, 0xc4, 0x2b, 0x4e, 0xf3, 0x4f, 0xfc, 0xed, 0xff,
+	0x06, 0x00, 0x7f, 0xec, 0x85, 0x0a, 0x4b, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1078,6 +1260,9 @@ type QueryClient interface {
 	GetCheckpointSignatures(ctx context.Context, in *QueryCheckpointSignaturesRequest, opts ...grpc.CallOption) (*QueryCheckpointSignaturesResponse, error)
 	// GetCheckpoint queries a specific checkpoint by its ID number.
 	GetCheckpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+	// GetCheckpointProof queries the Merkle proof of inclusion of a bor block
+	// in the acknowledged checkpoint covering it.
+	GetCheckpointProof(ctx context.Context, in *QueryCheckpointProofRequest, opts ...grpc.CallOption) (*QueryCheckpointProofResponse, error)
 }
 
 type queryClient struct {
@@ -1178,6 +1363,15 @@ func (c *queryClient) GetCheckpoint(ctx context.Context, in *QueryCheckpointRequ
 	return out, nil
 }
 
+func (c *queryClient) GetCheckpointProof(ctx context.Context, in *QueryCheckpointProofRequest, opts ...grpc.CallOption) (*QueryCheckpointProofResponse, error) {
+	out := new(QueryCheckpointProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.checkpoint.Query/GetCheckpointProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetCheckpointParams queries the checkpoint module parameters.
@@ -1202,6 +1396,9 @@ type QueryServer interface {
 	GetCheckpointSignatures(context.Context, *QueryCheckpointSignaturesRequest) (*QueryCheckpointSignaturesResponse, error)
 	// GetCheckpoint queries a specific checkpoint by its ID number.
 	GetCheckpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+	// GetCheckpointProof queries the Merkle proof of inclusion of a bor block
+	// in the acknowledged checkpoint covering it.
+	GetCheckpointProof(context.Context, *QueryCheckpointProofRequest) (*QueryCheckpointProofResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1238,6 +1435,9 @@ func (*UnimplementedQueryServer) GetCheckpointSignatures(ctx context.Context, re
 func (*UnimplementedQueryServer) GetCheckpoint(ctx context.Context, req *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpoint not implemented")
 }
+func (*UnimplementedQueryServer) GetCheckpointProof(ctx context.Context, req *QueryCheckpointProofRequest) (*QueryCheckpointProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpointProof not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1423,6 +1623,25 @@ func _Query_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCheckpointProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCheckpointProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.checkpoint.Query/GetCheckpointProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCheckpointProof(ctx, req.(*QueryCheckpointProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.checkpoint.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1467,6 +1686,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCheckpoint",
 			Handler:    _Query_GetCheckpoint_Handler,
 		},
+		{
+			MethodName: "GetCheckpointProof",
+			Handler:    _Query_GetCheckpointProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/checkpoint/query.proto",
@@ -2087,6 +2310,121 @@ func (m *QueryCheckpointOverviewResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCheckpointProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HeaderBlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeaderBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2330,6 +2668,62 @@ func (m *QueryCheckpointOverviewResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCheckpointProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HeaderBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.HeaderBlockNumber))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LeafIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LeafIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3847,6 +4241,379 @@ func (m *QueryCheckpointOverviewResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCheckpointProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderBlockNumber", wireType)
+			}
+			m.HeaderBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = append(m.Receipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Receipt == nil {
+				m.Receipt = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -68,6 +68,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "record-count",
 					Short:     "Query the total number of event records.",
 				},
+				{
+					RpcMethod: "GetRecordsByContract",
+					Use:       "records-by-contract [contract] [from-id]",
+					Short:     "Query the records targeting a receiver contract from an id.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "from_id"},
+					},
+				},
+				{
+					RpcMethod: "GetRecordsByTxHash",
+					Use:       "records-by-tx-hash [tx-hash]",
+					Short:     "Query the records emitted by a root chain tx.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tx_hash"},
+					},
+				},
+				{
+					RpcMethod: "GetRecordsByVisibilityHeight",
+					Use:       "records-by-visibility-height [from-height] [to-height]",
+					Short:     "Query the records which became visible within a height range.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from_height"},
+						{ProtoField: "to_height"},
+					},
+				},
+				{
+					RpcMethod: "GetClerkParams",
+					Use:       "params",
+					Short:     "Query the clerk module parameters.",
+				},
+				{
+					RpcMethod: "GetRecordAccumulator",
+					Use:       "record-accumulator",
+					Short:     "Query the accumulator over the pruned records.",
+				},
+				{
+					RpcMethod: "GetRecordAccumulatorProof",
+					Use:       "record-accumulator-proof [record-id]",
+					Short:     "Query a pruned record and its inclusion proof in the accumulator.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "GetBorConsumedRecordId",
+					Use:       "bor-consumed-record-id",
+					Short:     "Query the id of the last record consumed by bor.",
+				},
+				{
+					// server-streaming, served on gRPC and bridged to server-sent events by the REST server
+					RpcMethod: "SubscribeRecords",
+					Skip:      true,
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -88,6 +142,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "chain_id"},
 					},
 				},
+				{
+					RpcMethod: "HandleMsgRecordsConsumed",
+					Skip:      true, // sent by the bridge
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
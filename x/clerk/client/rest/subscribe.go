@@ -0,0 +1,127 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// SubscribeRecordsPath is the REST path of the record subscriptions.
+const SubscribeRecordsPath = "/clerk/event-records/subscribe"
+
+// subscribeHeartbeatInterval is how often a comment line is sent on idle subscriptions,
+// to keep them open through proxies.
+const subscribeHeartbeatInterval = 15 * time.Second
+
+// SubscribeRecordsHandler bridges the SubscribeRecords gRPC stream of the node to server-sent events.
+// Each record is sent as a JSON SubscribeRecordsResponse event with the record ID as event ID. The records
+// start from the from_id query parameter, or after the ID of the Last-Event-ID header sent by reconnecting
+// EventSource clients.
+func SubscribeRecordsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fromID, err := subscribeFromID(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if clientCtx.GRPCClient == nil {
+			http.Error(w, "record subscriptions require the gRPC server to be enabled", http.StatusServiceUnavailable)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		stream, err := types.NewQueryClient(clientCtx.GRPCClient).SubscribeRecords(r.Context(), &types.SubscribeRecordsRequest{FromId: fromID})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to subscribe to the event records: %v", err), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		type received struct {
+			response *types.SubscribeRecordsResponse
+			err      error
+		}
+
+		// Recv blocks until the next record, so it is read in its own goroutine, which ends
+		// with the stream once the request context is done.
+		records := make(chan received)
+		go func() {
+			for {
+				response, err := stream.Recv()
+				select {
+				case records <- received{response: response, err: err}:
+				case <-r.Context().Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(subscribeHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-heartbeat.C:
+				_, _ = fmt.Fprint(w, ": heartbeat\n\n")
+			case rec := <-records:
+				if rec.err != nil {
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", rec.err.Error())
+					flusher.Flush()
+					return
+				}
+
+				bz, err := clientCtx.Codec.MarshalJSON(rec.response)
+				if err != nil {
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+					flusher.Flush()
+					return
+				}
+
+				_, _ = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", rec.response.Record.Id, bz)
+			}
+
+			flusher.Flush()
+		}
+	}
+}
+
+// subscribeFromID returns the first record ID to stream: the one following the Last-Event-ID header
+// when an EventSource client reconnects, the from_id query parameter otherwise.
+func subscribeFromID(r *http.Request) (uint64, error) {
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		lastID, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Last-Event-ID header: %w", err)
+		}
+
+		return lastID + 1, nil
+	}
+
+	fromID, err := strconv.ParseUint(r.URL.Query().Get("from_id"), 10, 64)
+	if err != nil || fromID < 1 {
+		return 0, fmt.Errorf("from_id must be a record ID of at least 1")
+	}
+
+	return fromID, nil
+}
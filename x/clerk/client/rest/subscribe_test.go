@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+func TestSubscribeFromID(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		lastEventID string
+		want        uint64
+		wantErr     bool
+	}{
+		{name: "from_id", query: "?from_id=42", want: 42},
+		{name: "last event id takes precedence", query: "?from_id=42", lastEventID: "99", want: 100},
+		{name: "missing from_id", wantErr: true},
+		{name: "zero from_id", query: "?from_id=0", wantErr: true},
+		{name: "invalid last event id", lastEventID: "abc", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, SubscribeRecordsPath+tc.query, nil)
+			if tc.lastEventID != "" {
+				r.Header.Set("Last-Event-ID", tc.lastEventID)
+			}
+
+			got, err := subscribeFromID(r)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSubscribeRecordsHandler_RequiresGRPC(t *testing.T) {
+	w := httptest.NewRecorder()
+	SubscribeRecordsHandler(client.Context{})(w, httptest.NewRequest(http.MethodGet, SubscribeRecordsPath+"?from_id=1", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	SubscribeRecordsHandler(client.Context{})(w, httptest.NewRequest(http.MethodGet, SubscribeRecordsPath, nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// recordStreamServer streams the given records, then fails the subscription
+type recordStreamServer struct {
+	types.UnimplementedQueryServer
+	records []types.EventRecord
+}
+
+func (s *recordStreamServer) SubscribeRecords(request *types.SubscribeRecordsRequest, stream types.Query_SubscribeRecordsServer) error {
+	for _, record := range s.records {
+		if record.Id < request.FromId {
+			continue
+		}
+		if err := stream.Send(&types.SubscribeRecordsResponse{Record: record, VisibilityHeight: 10}); err != nil {
+			return err
+		}
+	}
+
+	return status.Error(codes.Unavailable, "node stopping")
+}
+
+func TestSubscribeRecordsHandler(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(cdc.GRPCCodec()))
+	types.RegisterQueryServer(server, &recordStreamServer{records: []types.EventRecord{{Id: 1}, {Id: 2}, {Id: 3}}})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	clientCtx := client.Context{}.WithCodec(cdc).WithGRPCClient(conn)
+
+	w := httptest.NewRecorder()
+	SubscribeRecordsHandler(clientCtx)(w, httptest.NewRequest(http.MethodGet, SubscribeRecordsPath+"?from_id=2", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	require.NotContains(t, body, "id: 1\n")
+	require.Contains(t, body, "id: 2\ndata: {\"record\":{\"id\":\"2\"")
+	require.Contains(t, body, "\"visibility_height\":\"10\"}\n\n")
+	require.Contains(t, body, "id: 3\n")
+	require.Contains(t, body, "event: error\ndata: rpc error: code = Unavailable desc = node stopping\n\n")
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+var (
+	archivedRecordPrefix = []byte{0x00}
+	archivedNodePrefix   = []byte{0x01}
+)
+
+// recordArchive is the record archive kept in a local database, outside the consensus state.
+type recordArchive struct {
+	db  dbm.DB
+	cdc codec.BinaryCodec
+}
+
+var _ types.RecordArchive = (*recordArchive)(nil)
+
+// NewRecordArchive returns a record archive kept in the given database.
+func NewRecordArchive(db dbm.DB, cdc codec.BinaryCodec) types.RecordArchive {
+	return &recordArchive{db: db, cdc: cdc}
+}
+
+func archivedRecordKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, archivedRecordPrefix...), id)
+}
+
+func archivedNodeKey(level uint32, index uint64) []byte {
+	key := binary.BigEndian.AppendUint32(append([]byte{}, archivedNodePrefix...), level)
+	return binary.BigEndian.AppendUint64(key, index)
+}
+
+// Archive stores the pruned records and the accumulator nodes in a single batch.
+func (a *recordArchive) Archive(records []types.EventRecord, nodes []types.AccumulatorNode) error {
+	batch := a.db.NewBatch()
+	defer batch.Close()
+
+	for i := range records {
+		bz, err := a.cdc.Marshal(&records[i])
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Set(archivedRecordKey(records[i].Id), bz); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		if err := batch.Set(archivedNodeKey(node.Level, node.Index), node.Hash); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// GetRecord returns the archived record with the given ID.
+func (a *recordArchive) GetRecord(id uint64) (*types.EventRecord, error) {
+	bz, err := a.db.Get(archivedRecordKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("record %d is not archived", id)
+	}
+
+	var record types.EventRecord
+	if err := a.cdc.Unmarshal(bz, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// GetAccumulatorNode returns the hash of the archived accumulator node at the given level and index.
+func (a *recordArchive) GetAccumulatorNode(level uint32, index uint64) ([]byte, error) {
+	hash, err := a.db.Get(archivedNodeKey(level, index))
+	if err != nil {
+		return nil, err
+	}
+	if hash == nil {
+		return nil, fmt.Errorf("accumulator node %d at level %d is not archived", index, level)
+	}
+
+	return hash, nil
+}
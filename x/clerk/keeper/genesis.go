@@ -39,6 +39,27 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.Logger(ctx).Error("Error setting block time entry", "time", entry.BlockTime, "height", entry.Height, "error", err)
 		}
 	}
+
+	// Pruning fields. Genesis files exported before the clerk params were introduced get the default ones.
+	params := data.Params
+	if params == (types.Params{}) {
+		params = types.DefaultParams()
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
+	if data.RecordAccumulator.Count > 0 {
+		if err := k.RecordAccumulator.Set(ctx, data.RecordAccumulator); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.BorConsumedRecordId > 0 {
+		if err := k.BorConsumedRecordID.Set(ctx, data.BorConsumedRecordId); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -50,6 +71,25 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RecordSequences: k.GetRecordSequences(ctx),
 	}
 
+	// Export the pruning fields.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.Params = params
+
+	accumulator, err := k.GetRecordAccumulator(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.RecordAccumulator = accumulator
+
+	consumedRecordID, err := k.GetBorConsumedRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.BorConsumedRecordId = consumedRecordID
+
 	// Export pending visibility events.
 	pendingIter, err := k.PendingVisibilityEvents.Iterate(ctx, nil)
 	if err != nil {
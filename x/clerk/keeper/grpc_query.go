@@ -32,6 +32,9 @@ const (
 	maxRecordListScan = MaxRecordListOffset + MaxRecordListLimit
 
 	errEmptyRequest = "empty request"
+
+	// recordSubscriptionPollInterval is how often the record subscriptions check for a new committed block.
+	recordSubscriptionPollInterval = time.Second
 )
 
 var _ types.QueryServer = queryServer{}
@@ -57,6 +60,12 @@ func (q queryServer) GetRecordById(ctx context.Context, request *types.RecordReq
 	}
 
 	record, err := q.k.GetEventRecord(ctx, request.RecordId)
+	if errors.Is(err, collections.ErrNotFound) {
+		record, err = q.prunedRecord(ctx, request.RecordId)
+		if errors.Is(err, ErrRecordArchiveDisabled) {
+			return nil, status.Errorf(codes.NotFound, "event record %d is pruned", request.RecordId)
+		}
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -64,6 +73,19 @@ func (q queryServer) GetRecordById(ctx context.Context, request *types.RecordReq
 	return &types.RecordResponse{Record: *record}, nil
 }
 
+// prunedRecord returns a record missing from the state from the record archive, when it is pruned.
+func (q queryServer) prunedRecord(ctx context.Context, id uint64) (*types.EventRecord, error) {
+	pruned, err := q.k.IsRecordPruned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !pruned {
+		return nil, collections.ErrNotFound
+	}
+
+	return q.k.GetArchivedEventRecord(id)
+}
+
 func (q queryServer) GetRecordList(ctx context.Context, request *types.RecordListRequest) (*types.RecordListResponse, error) {
 	var err error
 	startTime := time.Now()
@@ -120,6 +142,15 @@ func (q queryServer) GetRecordListWithTime(ctx context.Context, request *types.R
 		return nil, status.Errorf(codes.InvalidArgument, "to_time must be greater than Unix epoch")
 	}
 
+	// bor needs contiguous state ids: a pruned fromId must not silently resume from the next surviving record
+	pruned, err := q.k.IsRecordPruned(ctx, request.FromId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if event record %d is pruned: %v", request.FromId, err)
+	}
+	if pruned {
+		return nil, status.Errorf(codes.FailedPrecondition, "event record %d is pruned", request.FromId)
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if helper.IsZurichHardfork(sdkCtx.BlockHeight()) {
 		return q.recordListWithTimeDeterministic(ctx, request)
@@ -220,6 +251,161 @@ func (q queryServer) recordListWithTimeDeterministic(ctx context.Context, reques
 	return &types.RecordListWithTimeResponse{EventRecords: records}, nil
 }
 
+// GetRecordsByContract returns the event records targeting a receiver contract, from the contract index.
+func (q queryServer) GetRecordsByContract(ctx context.Context, request *types.RecordsByContractRequest) (_ *types.RecordsByContractResponse, err error) {
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetRecordsByContractMethod, startTime, &err)
+
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	if !common.IsHexAddress(request.Contract) {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address")
+	}
+	if request.FromId < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "fromId cannot be less than 1")
+	}
+	if err = validateRecordIndexPagination(request.Pagination); err != nil {
+		return nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](types.NormalizeContract(request.Contract)).StartInclusive(request.FromId)
+
+	records, err := recordsFromIndex(ctx, q, q.k.RecordsByContract, rng, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RecordsByContractResponse{EventRecords: records}, nil
+}
+
+// GetRecordsByTxHash returns the event records emitted by a root chain tx, from the tx hash index.
+func (q queryServer) GetRecordsByTxHash(ctx context.Context, request *types.RecordsByTxHashRequest) (_ *types.RecordsByTxHashResponse, err error) {
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetRecordsByTxHashMethod, startTime, &err)
+
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	if !hex.IsTxHashNonEmpty(request.TxHash) {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx hash")
+	}
+	if err = validateRecordIndexPagination(request.Pagination); err != nil {
+		return nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](types.NormalizeTxHash(request.TxHash))
+
+	records, err := recordsFromIndex(ctx, q, q.k.RecordsByTxHash, rng, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RecordsByTxHashResponse{EventRecords: records}, nil
+}
+
+// GetRecordsByVisibilityHeight returns the event records which became visible within a height range,
+// from the visibility height index. Records committed before visibility heights were introduced have none,
+// so they are never returned.
+func (q queryServer) GetRecordsByVisibilityHeight(ctx context.Context, request *types.RecordsByVisibilityHeightRequest) (_ *types.RecordsByVisibilityHeightResponse, err error) {
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetRecordsByVisibilityHeightMethod, startTime, &err)
+
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	if request.FromHeight < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "fromHeight cannot be less than 1")
+	}
+	if request.ToHeight < request.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "toHeight cannot be less than fromHeight")
+	}
+	if err = validateRecordIndexPagination(request.Pagination); err != nil {
+		return nil, err
+	}
+
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		StartInclusive(collections.Join(request.FromHeight, uint64(0))).
+		EndInclusive(collections.Join(request.ToHeight, ^uint64(0)))
+
+	records, err := recordsFromIndex(ctx, q, q.k.RecordsByVisibilityHeight, rng, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RecordsByVisibilityHeightResponse{EventRecords: records}, nil
+}
+
+// validateRecordIndexPagination checks the offset and limit of the queries served from the record indexes.
+func validateRecordIndexPagination(pagination query.PageRequest) error {
+	if isPaginationEmpty(pagination) {
+		return status.Errorf(codes.InvalidArgument, "pagination request is empty (at least one argument must be set)")
+	}
+	if pagination.Limit == 0 || pagination.Limit > MaxRecordListLimit {
+		return status.Errorf(codes.InvalidArgument, "limit cannot be 0 or greater than %d", MaxRecordListLimit)
+	}
+	if pagination.Offset > MaxRecordListOffset {
+		return status.Errorf(codes.InvalidArgument, "offset cannot be greater than %d", MaxRecordListOffset)
+	}
+
+	return nil
+}
+
+// recordsFromIndex returns a page of the event records referenced by the keys of a record index within the range.
+// The event ID is the second part of every index key.
+func recordsFromIndex[K any](
+	ctx context.Context,
+	q queryServer,
+	index collections.KeySet[collections.Pair[K, uint64]],
+	rng collections.Ranger[collections.Pair[K, uint64]],
+	pagination query.PageRequest,
+) ([]types.EventRecord, error) {
+	ready, err := q.k.HasRecordIndexes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ready {
+		return nil, status.Error(codes.FailedPrecondition, "record indexes are not built yet")
+	}
+
+	iterator, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func(iterator collections.KeySetIterator[collections.Pair[K, uint64]]) {
+		if err := iterator.Close(); err != nil {
+			q.k.Logger(ctx).Error("Error in closing record index iterator", "error", err)
+		}
+	}(iterator)
+
+	result := make([]types.EventRecord, 0, pagination.Limit)
+	skipped := uint64(0)
+
+	for ; iterator.Valid() && uint64(len(result)) < pagination.Limit; iterator.Next() {
+		if skipped < pagination.Offset {
+			skipped++
+			continue
+		}
+
+		key, err := iterator.Key()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error reading record index key: %v", err)
+		}
+
+		record, err := q.k.GetEventRecord(ctx, key.K2())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get indexed event record %d: %v", key.K2(), err)
+		}
+
+		result = append(result, *record)
+	}
+
+	return result, nil
+}
+
 func (q queryServer) GetRecordSequence(ctx context.Context, request *types.RecordSequenceRequest) (*types.RecordSequenceResponse, error) {
 	var err error
 	startTime := time.Now()
@@ -240,7 +426,7 @@ func (q queryServer) GetRecordSequence(ctx context.Context, request *types.Recor
 
 	// Get the main tx receipt.
 	txHash := common.FromHex(request.TxHash)
-	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.BytesToHash(txHash), chainParams.GetMainChainTxConfirmations())
+	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.BytesToHash(txHash), chainParams.GetMainChainTxConfirmations(), helper.ConfirmationMode(chainParams.GetMainChainConfirmationMode()))
 	if err != nil || receipt == nil {
 		return nil, status.Errorf(codes.Internal, "transaction is not confirmed yet. please wait for sometime and try again")
 	}
@@ -277,7 +463,7 @@ func (q queryServer) IsClerkTxOld(ctx context.Context, request *types.RecordSequ
 
 	// Get the main tx receipt.
 	txHash := common.FromHex(request.TxHash)
-	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.BytesToHash(txHash), chainParams.GetMainChainTxConfirmations())
+	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.BytesToHash(txHash), chainParams.GetMainChainTxConfirmations(), helper.ConfirmationMode(chainParams.GetMainChainConfirmationMode()))
 	if err != nil || receipt == nil {
 		return nil, status.Errorf(codes.Internal, "transaction is not confirmed yet. please wait for sometime and try again")
 	}
@@ -332,6 +518,188 @@ func (q queryServer) GetRecordCount(ctx context.Context, _ *types.RecordCountReq
 	return &types.RecordCountResponse{Count: q.k.GetEventRecordCount(ctx)}, nil
 }
 
+// GetClerkParams implements the gRPC service handler to query the clerk module parameters.
+func (q queryServer) GetClerkParams(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetClerkParamsMethod, startTime, &err)
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// GetRecordAccumulator implements the gRPC service handler to query the accumulator over the pruned records.
+func (q queryServer) GetRecordAccumulator(ctx context.Context, _ *types.RecordAccumulatorRequest) (*types.RecordAccumulatorResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetRecordAccumulatorMethod, startTime, &err)
+
+	accumulator, err := q.k.GetRecordAccumulator(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.RecordAccumulatorResponse{Accumulator: accumulator, Root: accumulator.Root()}, nil
+}
+
+// GetRecordAccumulatorProof implements the gRPC service handler to query a pruned record and its inclusion proof
+// in the current accumulator. It is only served by the nodes keeping a record archive.
+func (q queryServer) GetRecordAccumulatorProof(ctx context.Context, request *types.RecordAccumulatorProofRequest) (*types.RecordAccumulatorProofResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetRecordAccumulatorProofMethod, startTime, &err)
+
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	accumulator, err := q.k.GetRecordAccumulator(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !accumulator.Contains(request.RecordId) {
+		return nil, status.Errorf(codes.NotFound, "event record %d is not pruned", request.RecordId)
+	}
+
+	record, leafIndex, proof, err := q.k.GetRecordAccumulatorProof(request.RecordId, accumulator)
+	if errors.Is(err, ErrRecordArchiveDisabled) {
+		return nil, status.Error(codes.Unavailable, "pruned records are not served by this node")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.RecordAccumulatorProofResponse{
+		Record:      *record,
+		LeafIndex:   leafIndex,
+		Proof:       proof,
+		Accumulator: accumulator,
+		Root:        accumulator.Root(),
+	}, nil
+}
+
+// GetBorConsumedRecordId implements the gRPC service handler to query the ID of the last record consumed by bor.
+func (q queryServer) GetBorConsumedRecordId(ctx context.Context, _ *types.BorConsumedRecordIdRequest) (*types.BorConsumedRecordIdResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.GetBorConsumedRecordIdMethod, startTime, &err)
+
+	recordID, err := q.k.GetBorConsumedRecordID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.BorConsumedRecordIdResponse{RecordId: recordID}, nil
+}
+
+// SubscribeRecords implements the gRPC server-streaming handler sending the event records in ID order from the
+// requested ID, each one once its visibility_height is committed. On every new committed block, the records
+// following the last sent one are sent, up to the first record not committed yet or still pending visibility,
+// so that a client resuming from the ID after its last received record misses none and receives none twice.
+func (q queryServer) SubscribeRecords(request *types.SubscribeRecordsRequest, stream types.Query_SubscribeRecordsServer) error {
+	var err error
+	startTime := time.Now()
+	defer recordClerkQueryMetric(api.SubscribeRecordsMethod, startTime, &err)
+
+	if request == nil {
+		return status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+	if request.FromId < 1 {
+		return status.Errorf(codes.InvalidArgument, "fromId cannot be less than 1")
+	}
+	if q.k.queryContextFn == nil {
+		return status.Error(codes.Unavailable, "record subscriptions are not served by this node")
+	}
+
+	ticker := time.NewTicker(recordSubscriptionPollInterval)
+	defer ticker.Stop()
+
+	nextID := request.FromId
+	lastHeight := int64(-1)
+
+	for {
+		var ctx sdk.Context
+		ctx, err = q.k.queryContextFn(0, false)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if ctx.BlockHeight() != lastHeight {
+			var responses []*types.SubscribeRecordsResponse
+			responses, err = q.visibleRecordsFrom(ctx, nextID, MaxRecordListLimit)
+			if err != nil {
+				return err
+			}
+
+			for _, response := range responses {
+				if err = stream.Send(response); err != nil {
+					return err
+				}
+				nextID = response.Record.Id + 1
+			}
+
+			// a full batch may be followed by more visible records at the same height
+			if len(responses) < MaxRecordListLimit {
+				lastHeight = ctx.BlockHeight()
+			}
+			continue
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// visibleRecordsFrom returns up to limit consecutive event records from the given ID, stopping at the first record
+// not committed yet or still awaiting its visibility_height. Pre-HF (legacy) records have no visibility_height and
+// are returned with a zero one.
+func (q queryServer) visibleRecordsFrom(ctx context.Context, fromID uint64, limit int) ([]*types.SubscribeRecordsResponse, error) {
+	var responses []*types.SubscribeRecordsResponse
+
+	pruned, err := q.k.IsRecordPruned(ctx, fromID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if event record %d is pruned: %v", fromID, err)
+	}
+	if pruned {
+		return nil, status.Errorf(codes.FailedPrecondition, "event record %d is pruned", fromID)
+	}
+
+	for id := fromID; len(responses) < limit; id++ {
+		record, err := q.k.RecordsWithID.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error reading event record %d: %v", id, err)
+		}
+
+		visibilityHeight, err := q.k.GetVisibilityHeightForEvent(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			isPending, pErr := q.k.HasPendingVisibilityEvent(ctx, id)
+			if pErr != nil {
+				return nil, status.Errorf(codes.Internal, "failed to evaluate visibility for event %d: %v", id, pErr)
+			}
+			if isPending {
+				break
+			}
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to evaluate visibility for event %d: %v", id, err)
+		}
+
+		responses = append(responses, &types.SubscribeRecordsResponse{Record: record, VisibilityHeight: visibilityHeight})
+	}
+
+	return responses, nil
+}
+
 // recordListVisibleAtHeight is the shared deterministic filtering implementation
 // invoked by the post-HF branch of GetRecordListWithTime after it resolves the
 // Heimdall height for the cutoff time. Visibility-height filtering lives here
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -10,7 +11,10 @@ import (
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
@@ -19,13 +23,24 @@ import (
 // ErrNoBlockFound is returned when no committed block exists at or before the requested cutoff time.
 var ErrNoBlockFound = errors.New("no block found before cutoff")
 
+// RecordIndexBackfillBatchSize is the number of records added to the secondary indexes per block during the backfill.
+const RecordIndexBackfillBatchSize = 10000
+
 // Keeper stores all the related data.
 type Keeper struct {
 	storeService storetypes.KVStoreService
 	cdc          codec.BinaryCodec
+	authority    string
+
+	ChainKeeper     types.ChainKeeper
+	milestoneKeeper types.MilestoneKeeper
+	contractCaller  helper.IContractCaller
+
+	// archive keeps the pruned records on the nodes opting out of pruning; nil otherwise
+	archive types.RecordArchive
 
-	ChainKeeper    types.ChainKeeper
-	contractCaller helper.IContractCaller
+	// queryContextFn creates contexts on the committed state, for the record subscriptions
+	queryContextFn func(height int64, prove bool) (sdk.Context, error)
 
 	Schema                  collections.Schema
 	RecordsWithID           collections.Map[uint64, types.EventRecord]
@@ -34,20 +49,45 @@ type Keeper struct {
 	PendingVisibilityEvents collections.Map[uint64, []byte]
 	BlockTimeReverseIndex   collections.Map[collections.Pair[uint64, uint64], uint64] // (blockTime, height) → height for O(log N) cutoff lookup
 	VisibilityHeightByID    collections.Map[uint64, uint64]                           // event_id → heimdall block height where visibility was assigned
+
+	// Secondary indexes, maintained from the clerk record index height onward.
+	RecordsByContract         collections.KeySet[collections.Pair[string, uint64]] // (contract, event_id)
+	RecordsByTxHash           collections.KeySet[collections.Pair[string, uint64]] // (tx_hash, event_id)
+	RecordsByVisibilityHeight collections.KeySet[collections.Pair[uint64, uint64]] // (visibility_height, event_id)
+	RecordIndexBackfillCursor collections.Item[uint64]                             // next event_id to backfill; 0 once the backfill is complete
+
+	// Pruning, active from the clerk record pruning height onward.
+	Params              collections.Item[types.Params]
+	RecordAccumulator   collections.Item[types.RecordAccumulator] // accumulator over the pruned records
+	BorConsumedRecordID collections.Item[uint64]                  // last event_id consumed by bor, as of the last milestone
 }
 
 // NewKeeper creates a new keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService storetypes.KVStoreService,
+	authority string,
 	ChainKeeper types.ChainKeeper,
+	milestoneKeeper types.MilestoneKeeper,
 	contractCaller helper.IContractCaller,
 ) Keeper {
+	bz, err := address.NewHexCodec().StringToBytes(authority)
+	if err != nil {
+		panic(fmt.Errorf("invalid clerk authority address: %w", err))
+	}
+
+	// ensure only gov has the authority to update the params
+	if !bytes.Equal(bz, authtypes.NewModuleAddress(govtypes.ModuleName)) {
+		panic(fmt.Errorf("invalid clerk authority address: %s", authority))
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	keeper := Keeper{
 		storeService:            storeService,
 		cdc:                     cdc,
+		authority:               authority,
 		ChainKeeper:             ChainKeeper,
+		milestoneKeeper:         milestoneKeeper,
 		contractCaller:          contractCaller,
 		RecordsWithID:           collections.NewMap(sb, types.RecordsWithIDKeyPrefix, "recordsWithID", collections.Uint64Key, codec.CollValue[types.EventRecord](cdc)),
 		RecordsWithTime:         collections.NewMap(sb, types.RecordsWithTimeKeyPrefix, "recordsWithTime", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
@@ -55,6 +95,15 @@ func NewKeeper(
 		PendingVisibilityEvents: collections.NewMap(sb, types.PendingVisibilityEventsKeyPrefix, "pendingVisibilityEvents", collections.Uint64Key, collections.BytesValue),
 		BlockTimeReverseIndex:   collections.NewMap(sb, types.BlockTimeReverseIndexKeyPrefix, "blockTimeReverseIndex", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.Uint64Value),
 		VisibilityHeightByID:    collections.NewMap(sb, types.VisibilityHeightByIDKeyPrefix, "visibilityHeightByID", collections.Uint64Key, collections.Uint64Value),
+
+		RecordsByContract:         collections.NewKeySet(sb, types.RecordsByContractKeyPrefix, "recordsByContract", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		RecordsByTxHash:           collections.NewKeySet(sb, types.RecordsByTxHashKeyPrefix, "recordsByTxHash", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		RecordsByVisibilityHeight: collections.NewKeySet(sb, types.RecordsByVisibilityKeyPrefix, "recordsByVisibilityHeight", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		RecordIndexBackfillCursor: collections.NewItem(sb, types.RecordIndexBackfillKeyPrefix, "recordIndexBackfillCursor", collections.Uint64Value),
+
+		Params:              collections.NewItem(sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		RecordAccumulator:   collections.NewItem(sb, types.RecordAccumulatorKeyPrefix, "recordAccumulator", codec.CollValue[types.RecordAccumulator](cdc)),
+		BorConsumedRecordID: collections.NewItem(sb, types.BorConsumedRecordIDKeyPrefix, "borConsumedRecordID", collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -72,6 +121,22 @@ func (k *Keeper) SetContractCaller(contractCaller helper.IContractCaller) {
 	k.contractCaller = contractCaller
 }
 
+// SetRecordArchive sets the archive keeping the pruned records, on the nodes opting out of pruning.
+func (k *Keeper) SetRecordArchive(archive types.RecordArchive) {
+	k.archive = archive
+}
+
+// GetAuthority returns x/clerk module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetQueryContextFn sets the function creating contexts on the committed state,
+// which the record subscriptions use to follow the new blocks.
+func (k *Keeper) SetQueryContextFn(queryContextFn func(height int64, prove bool) (sdk.Context, error)) {
+	k.queryContextFn = queryContextFn
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
@@ -102,7 +167,28 @@ func (k *Keeper) SetEventRecord(ctx context.Context, record types.EventRecord) e
 		return err
 	}
 
-	return k.SetEventRecordWithTime(ctx, record)
+	if err := k.SetEventRecordWithTime(ctx, record); err != nil {
+		return err
+	}
+
+	if helper.IsClerkRecordIndex(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return k.indexEventRecord(ctx, record)
+	}
+
+	return nil
+}
+
+// indexEventRecord adds the record to the contract and tx hash indexes.
+func (k *Keeper) indexEventRecord(ctx context.Context, record types.EventRecord) error {
+	if err := k.RecordsByContract.Set(ctx, collections.Join(types.NormalizeContract(record.Contract), record.Id)); err != nil {
+		return fmt.Errorf("failed to index record %d by contract: %w", record.Id, err)
+	}
+
+	if err := k.RecordsByTxHash.Set(ctx, collections.Join(types.NormalizeTxHash(record.TxHash), record.Id)); err != nil {
+		return fmt.Errorf("failed to index record %d by tx hash: %w", record.Id, err)
+	}
+
+	return nil
 }
 
 // GetEventRecord returns record from the store.
@@ -351,6 +437,11 @@ func (k *Keeper) ProcessPendingVisibilityEvents(ctx context.Context) error {
 		if err := k.VisibilityHeightByID.Set(ctx, eventID, blockHeight); err != nil {
 			return fmt.Errorf("failed to set visibility height for event %d: %w", eventID, err)
 		}
+		if helper.IsClerkRecordIndex(sdkCtx.BlockHeight()) {
+			if err := k.RecordsByVisibilityHeight.Set(ctx, collections.Join(blockHeight, eventID)); err != nil {
+				return fmt.Errorf("failed to index event %d by visibility height: %w", eventID, err)
+			}
+		}
 		if err := k.PendingVisibilityEvents.Remove(ctx, eventID); err != nil {
 			return fmt.Errorf("failed to remove pending event %d: %w", eventID, err)
 		}
@@ -359,6 +450,81 @@ func (k *Keeper) ProcessPendingVisibilityEvents(ctx context.Context) error {
 	return nil
 }
 
+// BackfillRecordIndexes adds up to limit records, committed before the clerk record index height,
+// to the secondary indexes. It resumes from the stored cursor, and is a no-op once every record is indexed.
+// Records stored after the index height are indexed on write, so indexing them again here is harmless.
+func (k *Keeper) BackfillRecordIndexes(ctx context.Context, limit uint64) error {
+	cursor, err := k.RecordIndexBackfillCursor.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		cursor = 1
+	case err != nil:
+		return fmt.Errorf("failed to get record index backfill cursor: %w", err)
+	case cursor == 0:
+		return nil
+	}
+
+	iterator, err := k.RecordsWithID.Iterate(ctx, new(collections.Range[uint64]).StartInclusive(cursor))
+	if err != nil {
+		return fmt.Errorf("failed to iterate records for index backfill: %w", err)
+	}
+	defer func() {
+		if err := iterator.Close(); err != nil {
+			k.Logger(ctx).Error("failed to close iterator in BackfillRecordIndexes", "error", err)
+		}
+	}()
+
+	indexed := uint64(0)
+	for ; iterator.Valid() && indexed < limit; iterator.Next() {
+		record, err := iterator.Value()
+		if err != nil {
+			return fmt.Errorf("failed to read record for index backfill: %w", err)
+		}
+
+		if err := k.indexEventRecord(ctx, record); err != nil {
+			return err
+		}
+
+		visibilityHeight, err := k.VisibilityHeightByID.Get(ctx, record.Id)
+		switch {
+		case err == nil:
+			if err := k.RecordsByVisibilityHeight.Set(ctx, collections.Join(visibilityHeight, record.Id)); err != nil {
+				return fmt.Errorf("failed to index event %d by visibility height: %w", record.Id, err)
+			}
+		case !errors.Is(err, collections.ErrNotFound):
+			return fmt.Errorf("failed to get visibility height for event %d: %w", record.Id, err)
+		}
+
+		cursor = record.Id + 1
+		indexed++
+	}
+
+	if !iterator.Valid() {
+		k.Logger(ctx).Info("Backfill of the record indexes completed", "lastRecordID", cursor-1)
+		cursor = 0
+	}
+
+	return k.RecordIndexBackfillCursor.Set(ctx, cursor)
+}
+
+// HasRecordIndexes reports whether the secondary indexes cover every record, i.e. whether
+// the clerk record index height is reached and the backfill is complete.
+func (k *Keeper) HasRecordIndexes(ctx context.Context) (bool, error) {
+	if !helper.IsClerkRecordIndex(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return false, nil
+	}
+
+	cursor, err := k.RecordIndexBackfillCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return cursor == 0, nil
+}
+
 // StoreBlockTime stores the current block's (blockTime, height) → height mapping in the
 // reverse index, enabling O(log N) cutoff lookups via GetBlockHeightByTime.
 // Called in PreBlocker for each block from the visibility_height activation height onward.
@@ -447,7 +613,13 @@ func (k *Keeper) GetEventRecordCount(ctx context.Context) uint64 {
 
 	// Get the first (highest) key from the reverse iterator.
 	if !iterator.Valid() {
-		return 0 // No records exist.
+		// No records exist, unless all of them are pruned.
+		accumulator, err := k.GetRecordAccumulator(ctx)
+		if err != nil || accumulator.Count == 0 {
+			return 0
+		}
+
+		return accumulator.NextRecordID() - 1
 	}
 
 	highestKey, err := iterator.Key()
@@ -15,6 +15,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -36,13 +38,14 @@ var (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx            sdk.Context
-	keeper         clerkKeeper.Keeper
-	chainId        string
-	msgServer      types.MsgServer
-	sideMsgCfg     sidetxs.SideTxConfigurator
-	queryClient    types.QueryClient
-	contractCaller mocks.IContractCaller
+	ctx             sdk.Context
+	keeper          clerkKeeper.Keeper
+	chainId         string
+	msgServer       types.MsgServer
+	sideMsgCfg      sidetxs.SideTxConfigurator
+	queryClient     types.QueryClient
+	contractCaller  mocks.IContractCaller
+	milestoneKeeper *testutil.MockMilestoneKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -61,10 +64,14 @@ func (s *KeeperTestSuite) SetupTest() {
 	chainKeeper := testutil.NewMockChainKeeper(ctrl)
 	s.contractCaller = mocks.IContractCaller{}
 
+	s.milestoneKeeper = testutil.NewMockMilestoneKeeper(ctrl)
+
 	keeper := clerkKeeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		chainKeeper,
+		s.milestoneKeeper,
 		&s.contractCaller,
 	)
 
@@ -264,6 +271,7 @@ func (s *KeeperTestSuite) TestInitExportGenesis_RoundTripDeterministicStateSyncF
 			{BlockTime: 1_700_000_100, Height: 100},
 			{BlockTime: 1_700_000_200, Height: 101},
 		},
+		Params: types.DefaultParams(),
 	}
 
 	ck.InitGenesis(ctx, &genesisState)
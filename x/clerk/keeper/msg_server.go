@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/metrics/api"
@@ -48,6 +49,15 @@ func (srv msgServer) HandleMsgEventRecord(ctx context.Context, msg *types.MsgEve
 		return nil, types.ErrEventRecordAlreadySynced
 	}
 
+	// check if the event record is pruned
+	pruned, err := srv.IsRecordPruned(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if pruned {
+		return nil, types.ErrEventRecordPruned
+	}
+
 	// chainManager params
 	params, err := srv.ChainKeeper.GetParams(ctx)
 	if err != nil {
@@ -87,6 +97,76 @@ func (srv msgServer) HandleMsgEventRecord(ctx context.Context, msg *types.MsgEve
 	return &types.MsgEventRecordResponse{}, nil
 }
 
+// HandleMsgRecordsConsumed validates the last record consumed by bor, which is then checked against bor by the side handler.
+func (srv msgServer) HandleMsgRecordsConsumed(ctx context.Context, msg *types.MsgRecordsConsumed) (*types.MsgRecordsConsumedResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkTransactionMetric(api.HandleMsgRecordsConsumedMethod, startTime, &err)
+
+	logger := srv.Logger(ctx)
+
+	if !helper.IsClerkRecordPruning(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		err = errors.Wrap(sdkerrors.ErrInvalidRequest, "records consumed msg not allowed before the clerk record pruning height")
+		return nil, err
+	}
+
+	if err = srv.validateConsumedRecordID(ctx, msg.LastRecordId); err != nil {
+		logger.Debug("Invalid consumed record id", "lastRecordID", msg.LastRecordId, "error", err)
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordsConsumed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.LastRecordId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBorBlockNumber, strconv.FormatUint(msg.BorBlockNumber, 10)),
+		),
+	})
+
+	return &types.MsgRecordsConsumedResponse{}, nil
+}
+
+// validateConsumedRecordID checks that the record ID is past the current consumed record ID, and already synced.
+func (k Keeper) validateConsumedRecordID(ctx context.Context, recordID uint64) error {
+	consumedRecordID, err := k.GetBorConsumedRecordID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if recordID <= consumedRecordID {
+		return errors.Wrapf(types.ErrInvalidConsumedRecordID, "record id %d is not after the consumed record id %d", recordID, consumedRecordID)
+	}
+
+	if latestRecordID := k.GetEventRecordCount(ctx); recordID > latestRecordID {
+		return errors.Wrapf(types.ErrInvalidConsumedRecordID, "record id %d is after the latest record id %d", recordID, latestRecordID)
+	}
+
+	return nil
+}
+
+// UpdateParams defines a method to update the params in x/clerk module.
+func (srv msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkTransactionMetric(api.ClerkUpdateParamsMethod, startTime, &err)
+
+	if srv.authority != msg.Authority {
+		err = errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.authority, msg.Authority)
+		return nil, err
+	}
+
+	if err = msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err = srv.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
 func recordClerkTransactionMetric(method string, start time.Time, err *error) {
 	success := *err == nil
 	api.RecordAPICallWithStart(api.ClerkSubsystem, method, api.TxType, success, start)
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// ErrRecordArchiveDisabled is returned when a pruned record is requested from a node not keeping a record archive.
+var ErrRecordArchiveDisabled = errors.New("record archive is disabled")
+
+// SetParams sets the x/clerk module parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		k.Logger(ctx).Error("Error while validating params", "err", err)
+		return err
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		k.Logger(ctx).Error("Error while storing params in store", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetParams gets the x/clerk module parameters. Chains which never set them get the default ones, disabling pruning.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	if err != nil {
+		k.Logger(ctx).Error("Error while fetching params from store", "err", err)
+		return types.Params{}, err
+	}
+
+	return params, nil
+}
+
+// GetRecordAccumulator returns the accumulator over the pruned records, empty when no record is pruned yet.
+func (k Keeper) GetRecordAccumulator(ctx context.Context) (types.RecordAccumulator, error) {
+	accumulator, err := k.RecordAccumulator.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RecordAccumulator{}, nil
+	}
+
+	return accumulator, err
+}
+
+// GetBorConsumedRecordID returns the ID of the last record consumed by bor, zero when not known yet.
+func (k Keeper) GetBorConsumedRecordID(ctx context.Context) (uint64, error) {
+	recordID, err := k.BorConsumedRecordID.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return recordID, err
+}
+
+// IsRecordPruned reports whether the record with the given ID belongs to the pruned range, i.e. whether it
+// is below the next record to prune. Records in this range can't be synced anymore.
+func (k Keeper) IsRecordPruned(ctx context.Context, id uint64) (bool, error) {
+	accumulator, err := k.GetRecordAccumulator(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return accumulator.Count > 0 && id < accumulator.NextRecordID(), nil
+}
+
+// PruneRecords removes from the state up to max_records_pruned_per_block records which are consumed by bor,
+// and older than the retention period unless consumed records are pruned, adding them to the record accumulator. Records are pruned in ID order,
+// and the pruning stops at the first record which is not eligible or missing, so that the accumulator leaves
+// stay contiguous. The record sequences are kept, as they guard against the replay of the L1 events.
+func (k *Keeper) PruneRecords(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsClerkRecordPruning(sdkCtx.BlockHeight()) {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsPruningEnabled() {
+		return nil
+	}
+
+	accumulator, err := k.GetRecordAccumulator(ctx)
+	if err != nil {
+		return err
+	}
+
+	consumedRecordID, err := k.GetBorConsumedRecordID(ctx)
+	if err != nil {
+		return err
+	}
+
+	records, err := k.collectPrunableRecords(ctx, params, accumulator.NextRecordID(), consumedRecordID)
+	if err != nil || len(records) == 0 {
+		return err
+	}
+
+	nodes := make([]types.AccumulatorNode, 0, 2*len(records))
+	for _, record := range records {
+		leaf, err := types.RecordLeafHash(record)
+		if err != nil {
+			return fmt.Errorf("failed to hash record %d: %w", record.Id, err)
+		}
+
+		created, err := accumulator.Append(record.Id, leaf)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, created...)
+
+		if err := k.removeEventRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RecordAccumulator.Set(ctx, accumulator); err != nil {
+		return fmt.Errorf("failed to store record accumulator: %w", err)
+	}
+
+	// the archive is written outside the consensus state: a failure only degrades the local history,
+	// and writing it again when the block is replayed is harmless
+	if k.archive != nil {
+		if err := k.archive.Archive(records, nodes); err != nil {
+			k.Logger(ctx).Error("Failed to archive the pruned records", "fromID", records[0].Id, "toID", records[len(records)-1].Id, "error", err)
+		}
+	}
+
+	k.Logger(ctx).Debug("Pruned event records", "fromID", records[0].Id, "toID", records[len(records)-1].Id,
+		"accumulatorCount", accumulator.Count)
+
+	return nil
+}
+
+// collectPrunableRecords returns the contiguous records eligible for pruning, starting from the given ID,
+// or from the first stored record when no record is pruned yet.
+func (k *Keeper) collectPrunableRecords(ctx context.Context, params types.Params, fromID, consumedRecordID uint64) ([]types.EventRecord, error) {
+	iterator, err := k.RecordsWithID.Iterate(ctx, new(collections.Range[uint64]).StartInclusive(fromID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate records for pruning: %w", err)
+	}
+	defer func() {
+		if err := iterator.Close(); err != nil {
+			k.Logger(ctx).Error("failed to close iterator in collectPrunableRecords", "error", err)
+		}
+	}()
+
+	var records []types.EventRecord
+	for ; iterator.Valid() && uint64(len(records)) < params.MaxRecordsPrunedPerBlock; iterator.Next() {
+		record, err := iterator.Value()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read record for pruning: %w", err)
+		}
+
+		if fromID != 0 && record.Id != fromID {
+			break
+		}
+
+		eligible, err := k.isRecordPrunable(ctx, params, record.Id, consumedRecordID)
+		if err != nil || !eligible {
+			return records, err
+		}
+
+		records = append(records, record)
+		fromID = record.Id + 1
+	}
+
+	return records, nil
+}
+
+// isRecordPrunable reports whether the record is consumed by bor, or consumed and older than the retention
+// period. Records not consumed by bor yet, or still waiting for their visibility height, are never pruned, so
+// that a bor node syncing from them can still fetch them. Records synced before the Zurich hardfork have no
+// visibility height, and are older than the retention period once the hardfork is.
+func (k *Keeper) isRecordPrunable(ctx context.Context, params types.Params, id, consumedRecordID uint64) (bool, error) {
+	if id > consumedRecordID {
+		return false, nil
+	}
+
+	pending, err := k.HasPendingVisibilityEvent(ctx, id)
+	if err != nil || pending {
+		return false, err
+	}
+
+	if params.PruneConsumedRecords {
+		return true, nil
+	}
+
+	retention := params.RecordRetentionHeights
+	if retention == 0 {
+		return false, nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if height <= retention {
+		return false, nil
+	}
+
+	visibilityHeight, err := k.VisibilityHeightByID.Get(ctx, id)
+	switch {
+	case err == nil:
+		return visibilityHeight+retention <= height, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return helper.IsZurichHardfork(int64(height - retention)), nil
+	default:
+		return false, fmt.Errorf("failed to get visibility height for event %d: %w", id, err)
+	}
+}
+
+// removeEventRecord removes the record, and its index entries, from the state.
+func (k *Keeper) removeEventRecord(ctx context.Context, record types.EventRecord) error {
+	if err := k.RecordsWithID.Remove(ctx, record.Id); err != nil {
+		return fmt.Errorf("failed to remove record %d: %w", record.Id, err)
+	}
+
+	if err := k.RecordsWithTime.Remove(ctx, collections.Join(record.RecordTime, record.Id)); err != nil {
+		return fmt.Errorf("failed to remove record %d by time: %w", record.Id, err)
+	}
+
+	if err := k.RecordsByContract.Remove(ctx, collections.Join(types.NormalizeContract(record.Contract), record.Id)); err != nil {
+		return fmt.Errorf("failed to remove record %d from the contract index: %w", record.Id, err)
+	}
+
+	if err := k.RecordsByTxHash.Remove(ctx, collections.Join(types.NormalizeTxHash(record.TxHash), record.Id)); err != nil {
+		return fmt.Errorf("failed to remove record %d from the tx hash index: %w", record.Id, err)
+	}
+
+	visibilityHeight, err := k.VisibilityHeightByID.Get(ctx, record.Id)
+	switch {
+	case err == nil:
+		if err := k.RecordsByVisibilityHeight.Remove(ctx, collections.Join(visibilityHeight, record.Id)); err != nil {
+			return fmt.Errorf("failed to remove record %d from the visibility height index: %w", record.Id, err)
+		}
+		if err := k.VisibilityHeightByID.Remove(ctx, record.Id); err != nil {
+			return fmt.Errorf("failed to remove visibility height of record %d: %w", record.Id, err)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return fmt.Errorf("failed to get visibility height for event %d: %w", record.Id, err)
+	}
+
+	return nil
+}
+
+// GetArchivedEventRecord returns a pruned record from the record archive.
+func (k *Keeper) GetArchivedEventRecord(id uint64) (*types.EventRecord, error) {
+	if k.archive == nil {
+		return nil, ErrRecordArchiveDisabled
+	}
+
+	return k.archive.GetRecord(id)
+}
+
+// GetRecordAccumulatorProof returns a pruned record, from the record archive, along with its leaf index
+// and its inclusion proof in the given accumulator.
+func (k *Keeper) GetRecordAccumulatorProof(id uint64, accumulator types.RecordAccumulator) (*types.EventRecord, uint64, [][]byte, error) {
+	if !accumulator.Contains(id) {
+		return nil, 0, nil, fmt.Errorf("record %d is not pruned", id)
+	}
+
+	record, err := k.GetArchivedEventRecord(id)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	leafIndex := id - accumulator.FirstRecordId
+	proofNodes, err := accumulator.ProofNodes(leafIndex)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	proof := make([][]byte, 0, len(proofNodes))
+	for _, node := range proofNodes {
+		hash, err := k.archive.GetAccumulatorNode(node.Level, node.Index)
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("failed to get accumulator node %d at level %d: %w", node.Index, node.Level, err)
+		}
+		proof = append(proof, hash)
+	}
+
+	return record, leafIndex, proof, nil
+}
@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/sidetxs"
+	hmTypes "github.com/0xPolygon/heimdall-v2/types"
+	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+	clerkKeeper "github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/testutil"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+	milestonetypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
+)
+
+func enableRecordPruningForTest(_ *KeeperTestSuite, height int64) func() {
+	original := helper.GetClerkRecordPruningHeight()
+	helper.SetClerkRecordPruningHeight(height)
+	return func() { helper.SetClerkRecordPruningHeight(original) }
+}
+
+func newMsgRecordsConsumed(from string, lastRecordID, borBlockNumber uint64) *types.MsgRecordsConsumed {
+	msg := types.NewMsgRecordsConsumed(from, lastRecordID, borBlockNumber)
+	return &msg
+}
+
+// storePruningTestRecords stores records 1-count, each one visible at 10+id.
+func (s *KeeperTestSuite) storePruningTestRecords(count uint64) []types.EventRecord {
+	require := s.Require()
+	ctx, ck := s.ctx.WithBlockHeight(1), s.keeper
+
+	records := make([]types.EventRecord, 0, count)
+	for i := uint64(1); i <= count; i++ {
+		record := types.NewEventRecord(TxHash1, i, i, Address1, []byte{byte(i)}, "1", time.Unix(int64(i), 0).UTC())
+		require.NoError(ck.SetEventRecord(ctx, record))
+		require.NoError(ck.VisibilityHeightByID.Set(ctx, i, 10+i))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (s *KeeperTestSuite) TestPruneRecordsByRetention() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordPruningForTest(s, 1)()
+
+	s.storePruningTestRecords(6)
+
+	// disabled by the default params
+	ctx := s.ctx.WithBlockHeight(1000)
+	require.NoError(ck.PruneRecords(ctx))
+	require.True(ck.HasEventRecord(ctx, 1))
+
+	params := types.DefaultParams()
+	params.RecordRetentionHeights = 100
+	params.MaxRecordsPrunedPerBlock = 2
+	require.NoError(ck.SetParams(ctx, params))
+
+	// records not consumed by bor are kept past the retention
+	ctx = s.ctx.WithBlockHeight(113)
+	require.NoError(ck.PruneRecords(ctx))
+	require.True(ck.HasEventRecord(ctx, 1))
+
+	// records 1-3 are visible at 11-13, so only they are past the retention at height 113
+	require.NoError(ck.BorConsumedRecordID.Set(ctx, 6))
+	require.NoError(ck.PruneRecords(ctx))
+	require.False(ck.HasEventRecord(ctx, 2))
+	require.True(ck.HasEventRecord(ctx, 3))
+
+	require.NoError(ck.PruneRecords(ctx))
+	require.False(ck.HasEventRecord(ctx, 3))
+	require.True(ck.HasEventRecord(ctx, 4))
+
+	require.NoError(ck.PruneRecords(ctx))
+	require.True(ck.HasEventRecord(ctx, 4))
+
+	accumulator, err := ck.GetRecordAccumulator(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1), accumulator.FirstRecordId)
+	require.Equal(uint64(3), accumulator.Count)
+	require.NoError(accumulator.Validate())
+
+	pruned, err := ck.IsRecordPruned(ctx, 3)
+	require.NoError(err)
+	require.True(pruned)
+
+	pruned, err = ck.IsRecordPruned(ctx, 4)
+	require.NoError(err)
+	require.False(pruned)
+
+	// the indexes no longer point to the pruned records
+	_, err = ck.VisibilityHeightByID.Get(ctx, 1)
+	require.Error(err)
+	require.Equal(uint64(6), ck.GetEventRecordCount(ctx))
+
+	// the records from a pruned ID are not served from the next surviving one
+	queryServer := clerkKeeper.NewQueryServer(&ck)
+	request := &types.RecordListWithTimeRequest{FromId: 2, ToTime: time.Unix(100, 0).UTC(), Pagination: query.PageRequest{Limit: 10}}
+	_, err = queryServer.GetRecordListWithTime(ctx, request)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	request.FromId = 4
+	res, err := queryServer.GetRecordListWithTime(ctx, request)
+	require.NoError(err)
+	require.Len(res.EventRecords, 3)
+	require.Equal(uint64(4), res.EventRecords[0].Id)
+}
+
+func (s *KeeperTestSuite) TestPruneRecordsConsumedAndPending() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordPruningForTest(s, 1)()
+
+	s.storePruningTestRecords(5)
+	ctx := s.ctx.WithBlockHeight(20)
+
+	// record 3 is still waiting for its visibility height
+	require.NoError(ck.VisibilityHeightByID.Remove(ctx, 3))
+	require.NoError(ck.AddPendingVisibilityEvent(ctx, 3))
+
+	params := types.DefaultParams()
+	params.PruneConsumedRecords = true
+	require.NoError(ck.SetParams(ctx, params))
+	require.NoError(ck.BorConsumedRecordID.Set(ctx, 4))
+
+	require.NoError(ck.PruneRecords(ctx))
+	require.False(ck.HasEventRecord(ctx, 2))
+	require.True(ck.HasEventRecord(ctx, 3))
+	require.True(ck.HasEventRecord(ctx, 4))
+
+	accumulator, err := ck.GetRecordAccumulator(ctx)
+	require.NoError(err)
+	require.Equal(uint64(3), accumulator.NextRecordID())
+}
+
+func (s *KeeperTestSuite) TestPruneRecordsBeforePruningHeight() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordPruningForTest(s, 500)()
+
+	s.storePruningTestRecords(2)
+	ctx := s.ctx.WithBlockHeight(499)
+
+	params := types.DefaultParams()
+	params.PruneConsumedRecords = true
+	require.NoError(ck.SetParams(ctx, params))
+	require.NoError(ck.BorConsumedRecordID.Set(ctx, 2))
+
+	require.NoError(ck.PruneRecords(ctx))
+	require.True(ck.HasEventRecord(ctx, 1))
+
+	require.NoError(ck.PruneRecords(ctx.WithBlockHeight(500)))
+	require.False(ck.HasEventRecord(ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestPrunedRecordArchive() {
+	require := s.Require()
+	ck := &s.keeper
+	defer enableRecordPruningForTest(s, 1)()
+
+	records := s.storePruningTestRecords(7)
+	ctx := s.ctx.WithBlockHeight(20)
+
+	ck.SetRecordArchive(clerkKeeper.NewRecordArchive(dbm.NewMemDB(), moduletestutil.MakeTestEncodingConfig().Codec))
+	queryServer := clerkKeeper.NewQueryServer(ck)
+
+	params := types.DefaultParams()
+	params.PruneConsumedRecords = true
+	params.MaxRecordsPrunedPerBlock = 4
+	require.NoError(ck.SetParams(ctx, params))
+	require.NoError(ck.BorConsumedRecordID.Set(ctx, 6))
+
+	require.NoError(ck.PruneRecords(ctx))
+	require.NoError(ck.PruneRecords(ctx))
+
+	accResp, err := queryServer.GetRecordAccumulator(ctx, &types.RecordAccumulatorRequest{})
+	require.NoError(err)
+	require.Equal(uint64(6), accResp.Accumulator.Count)
+	require.Equal(accResp.Accumulator.Root(), accResp.Root)
+
+	for _, record := range records[:6] {
+		recordResp, err := queryServer.GetRecordById(ctx, &types.RecordRequest{RecordId: record.Id})
+		require.NoError(err)
+		require.Equal(record, recordResp.Record)
+
+		proofResp, err := queryServer.GetRecordAccumulatorProof(ctx, &types.RecordAccumulatorProofRequest{RecordId: record.Id})
+		require.NoError(err)
+		require.NoError(types.VerifyRecordAccumulatorProof(proofResp.Record, proofResp.LeafIndex, proofResp.Proof, proofResp.Accumulator))
+	}
+
+	// record 7 is not pruned
+	_, err = queryServer.GetRecordAccumulatorProof(ctx, &types.RecordAccumulatorProofRequest{RecordId: 7})
+	require.Error(err)
+
+	// without the archive, the pruned records are not found
+	ck.SetRecordArchive(nil)
+	_, err = queryServer.GetRecordById(ctx, &types.RecordRequest{RecordId: 1})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	_, err = queryServer.GetRecordAccumulatorProof(ctx, &types.RecordAccumulatorProofRequest{RecordId: 1})
+	require.Equal(codes.Unavailable, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestHandleMsgEventRecordPruned() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordPruningForTest(s, 1)()
+
+	s.storePruningTestRecords(2)
+	ctx := s.ctx.WithBlockHeight(20)
+
+	params := types.DefaultParams()
+	params.PruneConsumedRecords = true
+	require.NoError(ck.SetParams(ctx, params))
+	require.NoError(ck.BorConsumedRecordID.Set(ctx, 2))
+	require.NoError(ck.PruneRecords(ctx))
+
+	contract, err := address.NewHexCodec().StringToBytes(Address2)
+	require.NoError(err)
+
+	msg := types.NewMsgEventRecord(
+		util.FormatAddress(Address1),
+		TxHash1,
+		10,
+		50,
+		1,
+		contract,
+		make([]byte, 0),
+		s.chainId,
+	)
+
+	_, err = s.msgServer.HandleMsgEventRecord(ctx, &msg)
+	require.ErrorIs(err, types.ErrEventRecordPruned)
+
+	require.Equal(sidetxs.Vote_VOTE_NO, s.sideHandler(ctx, &msg))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+	ctx, ck := s.ctx, s.keeper
+
+	params := types.DefaultParams()
+	params.RecordRetentionHeights = 500
+
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: Address1, Params: params})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.MaxRecordsPrunedPerBlock = 0
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: ck.GetAuthority(), Params: invalid})
+	require.Error(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: ck.GetAuthority(), Params: params})
+	require.NoError(err)
+
+	resp, err := s.queryClient.GetClerkParams(ctx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, resp.Params)
+	require.Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), ck.GetAuthority())
+}
+
+func (s *KeeperTestSuite) TestHandleMsgRecordsConsumed() {
+	require := s.Require()
+	defer enableRecordPruningForTest(s, 10)()
+
+	s.storePruningTestRecords(3)
+
+	_, err := s.msgServer.HandleMsgRecordsConsumed(s.ctx.WithBlockHeight(9), newMsgRecordsConsumed(Address1, 2, 100))
+	require.Error(err)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	_, err = s.msgServer.HandleMsgRecordsConsumed(ctx, newMsgRecordsConsumed(Address1, 4, 100))
+	require.ErrorIs(err, types.ErrInvalidConsumedRecordID)
+
+	_, err = s.msgServer.HandleMsgRecordsConsumed(ctx, newMsgRecordsConsumed(Address1, 2, 100))
+	require.NoError(err)
+
+	require.NoError(s.keeper.BorConsumedRecordID.Set(ctx, 2))
+	_, err = s.msgServer.HandleMsgRecordsConsumed(ctx, newMsgRecordsConsumed(Address1, 2, 100))
+	require.ErrorIs(err, types.ErrInvalidConsumedRecordID)
+}
+
+func (s *KeeperTestSuite) TestSideHandleMsgRecordsConsumed() {
+	require := s.Require()
+	ck, contractCaller := s.keeper, &s.contractCaller
+	defer enableRecordPruningForTest(s, 1)()
+
+	s.storePruningTestRecords(3)
+	ctx := s.ctx.WithBlockHeight(20)
+	chainParams := chainmanagertypes.DefaultParams()
+
+	s.Run("bor block not finalized", func() {
+		s.milestoneKeeper.EXPECT().GetLastMilestone(gomock.Any()).Return(&milestonetypes.Milestone{EndBlock: 99}, nil).Times(1)
+
+		require.Equal(sidetxs.Vote_VOTE_NO, s.sideHandler(ctx, newMsgRecordsConsumed(Address1, 2, 100)))
+	})
+
+	s.Run("last state id mismatch", func() {
+		s.milestoneKeeper.EXPECT().GetLastMilestone(gomock.Any()).Return(&milestonetypes.Milestone{EndBlock: 100}, nil).Times(1)
+		ck.ChainKeeper.(*testutil.MockChainKeeper).EXPECT().GetParams(gomock.Any()).Return(chainParams, nil).Times(1)
+		contractCaller.On("GetBorChainLastStateID", mock.Anything, chainParams.ChainParams.StateReceiverAddress, big.NewInt(90)).Return(uint64(1), nil).Once()
+
+		require.Equal(sidetxs.Vote_VOTE_NO, s.sideHandler(ctx, newMsgRecordsConsumed(Address1, 2, 90)))
+	})
+
+	s.Run("success", func() {
+		s.milestoneKeeper.EXPECT().GetLastMilestone(gomock.Any()).Return(&milestonetypes.Milestone{EndBlock: 100}, nil).Times(1)
+		ck.ChainKeeper.(*testutil.MockChainKeeper).EXPECT().GetParams(gomock.Any()).Return(chainParams, nil).Times(1)
+		contractCaller.On("GetBorChainLastStateID", mock.Anything, chainParams.ChainParams.StateReceiverAddress, big.NewInt(100)).Return(uint64(2), nil).Once()
+
+		msg := newMsgRecordsConsumed(Address1, 2, 100)
+		require.Equal(sidetxs.Vote_VOTE_YES, s.sideHandler(ctx, msg))
+
+		// a rejected msg fails like the other post handlers
+		err := s.sideMsgCfg.GetPostHandler(msg)(ctx, msg, sidetxs.Vote_VOTE_NO)
+		require.ErrorContains(err, hmTypes.ErrMsgSideTxRejected)
+		consumedRecordID, err := ck.GetBorConsumedRecordID(ctx)
+		require.NoError(err)
+		require.Zero(consumedRecordID)
+
+		s.postHandler(ctx, msg, sidetxs.Vote_VOTE_YES)
+		consumedRecordID, err = ck.GetBorConsumedRecordID(ctx)
+		require.NoError(err)
+		require.Equal(uint64(2), consumedRecordID)
+
+		resp, err := s.queryClient.GetBorConsumedRecordId(ctx, &types.BorConsumedRecordIdRequest{})
+		require.NoError(err)
+		require.Equal(uint64(2), resp.RecordId)
+	})
+
+	s.Run("not after the consumed record id", func() {
+		require.Equal(sidetxs.Vote_VOTE_NO, s.sideHandler(ctx, newMsgRecordsConsumed(Address1, 2, 100)))
+	})
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	clerkKeeper "github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+const TxHash2 = "0x000000000000000000000000000000000000000000000000000000000000beef"
+
+func enableRecordIndexForTest(_ *KeeperTestSuite, height int64) func() {
+	original := helper.GetClerkRecordIndexHeight()
+	helper.SetClerkRecordIndexHeight(height)
+	return func() { helper.SetClerkRecordIndexHeight(original) }
+}
+
+// storeIndexTestRecords stores records 1-5 before the index height: records 1, 3 and 5 target Address1 and were
+// emitted by TxHash1, the others target Address2 and were emitted by TxHash2. Records 1-4 become visible at 100+id.
+func (s *KeeperTestSuite) storeIndexTestRecords() {
+	require := s.Require()
+	ctx, ck := s.ctx.WithBlockHeight(1), s.keeper
+
+	for i := uint64(1); i <= 5; i++ {
+		contract, txHash := Address1, TxHash1
+		if i%2 == 0 {
+			contract, txHash = Address2, TxHash2
+		}
+
+		require.NoError(ck.SetEventRecord(ctx, types.NewEventRecord(txHash, i, i, contract, make([]byte, 1), "1", time.Now().UTC())))
+		if i < 5 {
+			require.NoError(ck.VisibilityHeightByID.Set(ctx, i, 100+i))
+		}
+	}
+}
+
+func recordIDs(records []types.EventRecord) []uint64 {
+	ids := make([]uint64, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.Id)
+	}
+
+	return ids
+}
+
+func (s *KeeperTestSuite) TestBackfillRecordIndexes() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordIndexForTest(s, 10)()
+
+	s.storeIndexTestRecords()
+
+	// records stored before the index height are not indexed on write
+	empty, err := ck.RecordsByContract.Iterate(s.ctx, nil)
+	require.NoError(err)
+	require.False(empty.Valid())
+	require.NoError(empty.Close())
+
+	ctx := s.ctx.WithBlockHeight(10)
+
+	ready, err := ck.HasRecordIndexes(ctx)
+	require.NoError(err)
+	require.False(ready)
+
+	require.NoError(ck.BackfillRecordIndexes(ctx, 3))
+	cursor, err := ck.RecordIndexBackfillCursor.Get(ctx)
+	require.NoError(err)
+	require.Equal(uint64(4), cursor)
+
+	ready, err = ck.HasRecordIndexes(ctx)
+	require.NoError(err)
+	require.False(ready)
+
+	require.NoError(ck.BackfillRecordIndexes(ctx, 3))
+	cursor, err = ck.RecordIndexBackfillCursor.Get(ctx)
+	require.NoError(err)
+	require.Zero(cursor)
+
+	ready, err = ck.HasRecordIndexes(ctx)
+	require.NoError(err)
+	require.True(ready)
+
+	// no-op once complete
+	require.NoError(ck.BackfillRecordIndexes(ctx, 3))
+
+	has, err := ck.RecordsByContract.Has(ctx, collections.Join(types.NormalizeContract(strings.ToUpper(Address2)), uint64(4)))
+	require.NoError(err)
+	require.True(has)
+
+	has, err = ck.RecordsByVisibilityHeight.Has(ctx, collections.Join(uint64(103), uint64(3)))
+	require.NoError(err)
+	require.True(has)
+
+	// record 5 has no visibility height yet
+	has, err = ck.RecordsByVisibilityHeight.Has(ctx, collections.Join(uint64(0), uint64(5)))
+	require.NoError(err)
+	require.False(has)
+
+	// records stored and made visible after the index height are indexed on write
+	record := types.NewEventRecord(TxHash2, 6, 6, Address1, make([]byte, 1), "1", time.Now().UTC())
+	require.NoError(ck.SetEventRecord(ctx, record))
+	require.NoError(ck.AddPendingVisibilityEvent(ctx, record.Id))
+	require.NoError(ck.ProcessPendingVisibilityEvents(ctx.WithBlockHeight(11)))
+
+	has, err = ck.RecordsByTxHash.Has(ctx, collections.Join(types.NormalizeTxHash(TxHash2), uint64(6)))
+	require.NoError(err)
+	require.True(has)
+
+	has, err = ck.RecordsByVisibilityHeight.Has(ctx, collections.Join(uint64(11), uint64(6)))
+	require.NoError(err)
+	require.True(has)
+}
+
+func (s *KeeperTestSuite) TestBackfillRecordIndexes_NoRecords() {
+	require := s.Require()
+	defer enableRecordIndexForTest(s, 1)()
+
+	ctx := s.ctx.WithBlockHeight(1)
+	require.NoError(s.keeper.BackfillRecordIndexes(ctx, clerkKeeper.RecordIndexBackfillBatchSize))
+
+	ready, err := s.keeper.HasRecordIndexes(ctx)
+	require.NoError(err)
+	require.True(ready)
+}
+
+func (s *KeeperTestSuite) TestGetRecordsByContract() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordIndexForTest(s, 10)()
+
+	s.storeIndexTestRecords()
+	ctx := s.ctx.WithBlockHeight(10)
+	queryServer := clerkKeeper.NewQueryServer(&ck)
+
+	_, err := queryServer.GetRecordsByContract(ctx, &types.RecordsByContractRequest{
+		Contract:   Address1,
+		FromId:     1,
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(ck.BackfillRecordIndexes(ctx, clerkKeeper.RecordIndexBackfillBatchSize))
+
+	resp, err := queryServer.GetRecordsByContract(ctx, &types.RecordsByContractRequest{
+		Contract:   strings.ToUpper(Address1[2:]),
+		FromId:     1,
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{1, 3, 5}, recordIDs(resp.EventRecords))
+
+	resp, err = queryServer.GetRecordsByContract(ctx, &types.RecordsByContractRequest{
+		Contract:   Address1,
+		FromId:     2,
+		Pagination: query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{5}, recordIDs(resp.EventRecords))
+
+	resp, err = queryServer.GetRecordsByContract(ctx, &types.RecordsByContractRequest{
+		Contract:   "0x0000000000000000000000000000000000000001",
+		FromId:     1,
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.Empty(resp.EventRecords)
+
+	for _, req := range []*types.RecordsByContractRequest{
+		{Contract: "0x1234", FromId: 1, Pagination: query.PageRequest{Limit: 10}},
+		{Contract: Address1, FromId: 0, Pagination: query.PageRequest{Limit: 10}},
+		{Contract: Address1, FromId: 1},
+		{Contract: Address1, FromId: 1, Pagination: query.PageRequest{Limit: clerkKeeper.MaxRecordListLimit + 1}},
+		{Contract: Address1, FromId: 1, Pagination: query.PageRequest{Limit: 1, Offset: clerkKeeper.MaxRecordListOffset + 1}},
+	} {
+		_, err = queryServer.GetRecordsByContract(ctx, req)
+		require.Equal(codes.InvalidArgument, status.Code(err), "request %v", req)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRecordsByTxHash() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordIndexForTest(s, 10)()
+
+	s.storeIndexTestRecords()
+	ctx := s.ctx.WithBlockHeight(10)
+	require.NoError(ck.BackfillRecordIndexes(ctx, clerkKeeper.RecordIndexBackfillBatchSize))
+
+	queryServer := clerkKeeper.NewQueryServer(&ck)
+
+	resp, err := queryServer.GetRecordsByTxHash(ctx, &types.RecordsByTxHashRequest{
+		TxHash:     strings.ToUpper(TxHash2[2:]),
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{2, 4}, recordIDs(resp.EventRecords))
+
+	_, err = queryServer.GetRecordsByTxHash(ctx, &types.RecordsByTxHashRequest{
+		TxHash:     "",
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestGetRecordsByVisibilityHeight() {
+	require := s.Require()
+	ck := s.keeper
+	defer enableRecordIndexForTest(s, 10)()
+
+	s.storeIndexTestRecords()
+	ctx := s.ctx.WithBlockHeight(10)
+	require.NoError(ck.BackfillRecordIndexes(ctx, clerkKeeper.RecordIndexBackfillBatchSize))
+
+	queryServer := clerkKeeper.NewQueryServer(&ck)
+
+	resp, err := queryServer.GetRecordsByVisibilityHeight(ctx, &types.RecordsByVisibilityHeightRequest{
+		FromHeight: 102,
+		ToHeight:   103,
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, recordIDs(resp.EventRecords))
+
+	resp, err = queryServer.GetRecordsByVisibilityHeight(ctx, &types.RecordsByVisibilityHeightRequest{
+		FromHeight: 1,
+		ToHeight:   1000,
+		Pagination: query.PageRequest{Offset: 1, Limit: 2},
+	})
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, recordIDs(resp.EventRecords))
+
+	for _, req := range []*types.RecordsByVisibilityHeightRequest{
+		{FromHeight: 0, ToHeight: 10, Pagination: query.PageRequest{Limit: 10}},
+		{FromHeight: 10, ToHeight: 9, Pagination: query.PageRequest{Limit: 10}},
+	} {
+		_, err = queryServer.GetRecordsByVisibilityHeight(ctx, req)
+		require.Equal(codes.InvalidArgument, status.Code(err), "request %v", req)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -22,7 +23,10 @@ type sideMsgServer struct {
 	*Keeper
 }
 
-var msgEventRecord = sdk.MsgTypeURL(&types.MsgEventRecord{})
+var (
+	msgEventRecord     = sdk.MsgTypeURL(&types.MsgEventRecord{})
+	msgRecordsConsumed = sdk.MsgTypeURL(&types.MsgRecordsConsumed{})
+)
 
 // NewSideMsgServerImpl returns an implementation of the clerk SideMsgServer interface
 // for the provided Keeper.
@@ -35,6 +39,8 @@ func (srv *sideMsgServer) SideTxHandler(methodName string) sidetxs.SideTxHandler
 	switch methodName {
 	case msgEventRecord:
 		return srv.SideHandleMsgEventRecord
+	case msgRecordsConsumed:
+		return srv.SideHandleMsgRecordsConsumed
 	default:
 		return nil
 	}
@@ -45,6 +51,8 @@ func (srv *sideMsgServer) PostTxHandler(methodName string) sidetxs.PostTxHandler
 	switch methodName {
 	case msgEventRecord:
 		return srv.PostHandleMsgEventRecord
+	case msgRecordsConsumed:
+		return srv.PostHandleMsgRecordsConsumed
 	default:
 		return nil
 	}
@@ -73,6 +81,12 @@ func (srv *sideMsgServer) SideHandleMsgEventRecord(ctx sdk.Context, m sdk.Msg) (
 		return sidetxs.Vote_VOTE_NO
 	}
 
+	// check if the event record is pruned
+	if pruned, err := srv.IsRecordPruned(ctx, msg.Id); err != nil || pruned {
+		srv.Logger(ctx).Info("Msg event record already pruned in clerk side handler, voting NO", "id", msg.Id)
+		return sidetxs.Vote_VOTE_NO
+	}
+
 	// chainManager params
 	params, err := srv.ChainKeeper.GetParams(ctx)
 	if err != nil {
@@ -101,10 +115,11 @@ func (srv *sideMsgServer) SideHandleMsgEventRecord(ctx sdk.Context, m sdk.Msg) (
 		ctx,
 		srv.contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         common.HexToHash(msg.TxHash).Bytes(),
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.GetMainChainTxConfirmations(),
-			ModuleName:     "clerk",
+			TxHash:           common.HexToHash(msg.TxHash).Bytes(),
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.GetMainChainTxConfirmations(),
+			ConfirmationMode: helper.ConfirmationMode(params.GetMainChainConfirmationMode()),
+			ModuleName:       "clerk",
 		},
 		srv.Logger(ctx),
 	)
@@ -195,6 +210,16 @@ func (srv *sideMsgServer) PostHandleMsgEventRecord(ctx sdk.Context, m sdk.Msg, s
 		return err
 	}
 
+	pruned, err := srv.IsRecordPruned(ctx, msg.Id)
+	if err != nil {
+		return err
+	}
+	if pruned {
+		err = types.ErrEventRecordPruned
+		logger.Debug("Skipping new clerk record as it's already pruned", "id", msg.Id)
+		return err
+	}
+
 	logger.Debug("Persisting clerk state", "sideTxResult", sideTxResult)
 
 	// sequence id
@@ -254,6 +279,128 @@ func (srv *sideMsgServer) PostHandleMsgEventRecord(ctx sdk.Context, m sdk.Msg, s
 	return nil
 }
 
+// SideHandleMsgRecordsConsumed votes YES when the bor state receiver contract has committed the given
+// last record at the given bor block, which must be finalized by a milestone.
+func (srv *sideMsgServer) SideHandleMsgRecordsConsumed(ctx sdk.Context, m sdk.Msg) (result sidetxs.Vote) {
+	var err error
+	startTime := time.Now()
+	defer recordClerkMetric(api.SideHandleMsgRecordsConsumedMethod, api.SideType, startTime, &err)
+
+	logger := srv.Logger(ctx)
+
+	msg, ok := m.(*types.MsgRecordsConsumed)
+	if !ok {
+		logger.Error(helper.ErrTypeMismatch("MsgRecordsConsumed"))
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	logger.Debug(helper.LogValidatingExternalCall("ClerkRecordsConsumed"),
+		"lastRecordID", msg.LastRecordId,
+		"borBlockNumber", msg.BorBlockNumber,
+	)
+
+	if !helper.IsClerkRecordPruning(ctx.BlockHeight()) {
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if err = srv.validateConsumedRecordID(ctx, msg.LastRecordId); err != nil {
+		logger.Error("Invalid consumed record id in clerk side handler, voting NO", "lastRecordID", msg.LastRecordId, heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// only the bor blocks finalized by a milestone can't be reorged
+	milestone, err := srv.milestoneKeeper.GetLastMilestone(ctx)
+	if err != nil {
+		logger.Error("Error fetching the last milestone", heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if msg.BorBlockNumber > milestone.EndBlock {
+		logger.Error("Bor block is not finalized by a milestone",
+			"borBlockNumber", msg.BorBlockNumber,
+			"milestoneEndBlock", milestone.EndBlock,
+		)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	params, err := srv.ChainKeeper.GetParams(ctx)
+	if err != nil {
+		logger.Error(heimdallTypes.ErrMsgFailedToGetChainManagerParams, heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	lastStateID, err := srv.contractCaller.GetBorChainLastStateID(ctx, params.ChainParams.StateReceiverAddress, new(big.Int).SetUint64(msg.BorBlockNumber))
+	if err != nil {
+		logger.Error("Error fetching the last state id from bor", heimdallTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if lastStateID != msg.LastRecordId {
+		logger.Error("Last state id on bor does not match the msg last record id",
+			"lastStateID", lastStateID,
+			"lastRecordID", msg.LastRecordId,
+		)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	return sidetxs.Vote_VOTE_YES
+}
+
+// PostHandleMsgRecordsConsumed stores the last record consumed by bor.
+func (srv *sideMsgServer) PostHandleMsgRecordsConsumed(ctx sdk.Context, m sdk.Msg, sideTxResult sidetxs.Vote) error {
+	var err error
+	startTime := time.Now()
+	defer recordClerkMetric(api.PostHandleMsgRecordsConsumedMethod, api.PostType, startTime, &err)
+
+	logger := srv.Logger(ctx)
+
+	msg, ok := m.(*types.MsgRecordsConsumed)
+	if !ok {
+		err = errors.New(helper.ErrTypeMismatch("MsgRecordsConsumed"))
+		logger.Error(err.Error())
+		return err
+	}
+
+	if !helper.IsSideTxApproved(sideTxResult) {
+		logger.Debug(helper.ErrSkippingMsg("ClerkRecordsConsumed"))
+		err = errors.New(heimdallTypes.ErrMsgSideTxRejected)
+		return err
+	}
+
+	// another msg may have moved the consumed record id in the meantime
+	consumedRecordID, err := srv.GetBorConsumedRecordID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if msg.LastRecordId <= consumedRecordID {
+		logger.Debug("Skipping consumed record id as it's not after the current one",
+			"lastRecordID", msg.LastRecordId,
+			"consumedRecordID", consumedRecordID,
+		)
+		return nil
+	}
+
+	if err = srv.BorConsumedRecordID.Set(ctx, msg.LastRecordId); err != nil {
+		logger.Error("Unable to update the bor consumed record id", "id", msg.LastRecordId, heimdallTypes.LogKeyError, err)
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecordsConsumed,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(heimdallTypes.AttributeKeyTxHash, common.Bytes2Hex(ctx.TxBytes())),
+			sdk.NewAttribute(heimdallTypes.AttributeKeySideTxResult, sideTxResult.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.LastRecordId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBorBlockNumber, strconv.FormatUint(msg.BorBlockNumber, 10)),
+		),
+	})
+
+	return nil
+}
+
 // recordClerkMetric records metrics for side and post-handlers.
 func recordClerkMetric(method string, apiType string, start time.Time, err *error) {
 	success := *err == nil
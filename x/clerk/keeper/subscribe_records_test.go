@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clerkKeeper "github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// fakeRecordStream collects the records sent by SubscribeRecords.
+type fakeRecordStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.SubscribeRecordsResponse
+}
+
+func (f *fakeRecordStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeRecordStream) Send(response *types.SubscribeRecordsResponse) error {
+	f.sent <- response
+	return nil
+}
+
+func (s *KeeperTestSuite) TestSubscribeRecords_InvalidRequest() {
+	require := s.Require()
+
+	stream := &fakeRecordStream{ctx: context.Background()}
+
+	// without a query context function, subscriptions are not served
+	err := clerkKeeper.NewQueryServer(&s.keeper).SubscribeRecords(&types.SubscribeRecordsRequest{FromId: 1}, stream)
+	require.Equal(codes.Unavailable, status.Code(err))
+
+	ck := s.keeper
+	ck.SetQueryContextFn(func(int64, bool) (sdk.Context, error) { return s.ctx, nil })
+
+	err = clerkKeeper.NewQueryServer(&ck).SubscribeRecords(&types.SubscribeRecordsRequest{FromId: 0}, stream)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestSubscribeRecords() {
+	ctx, ck := s.ctx, s.keeper
+	require := s.Require()
+
+	recordTime := time.Date(2026, 1, 12, 17, 5, 40, 0, time.UTC)
+	for id := uint64(1); id <= 4; id++ {
+		rec := types.NewEventRecord(TxHash1, id, id, Address1, make([]byte, 1), "1", recordTime)
+		require.NoError(ck.SetEventRecord(ctx, rec))
+	}
+
+	// 1 is a legacy record, 2 is visible, 3 and 4 are pending
+	require.NoError(ck.VisibilityHeightByID.Set(ctx, 2, 5))
+	require.NoError(ck.AddPendingVisibilityEvent(ctx, 3))
+	require.NoError(ck.AddPendingVisibilityEvent(ctx, 4))
+
+	// The query context function hands the committed heights over from the test, which only
+	// writes to the store while the subscription waits for the next height.
+	entered := make(chan struct{})
+	heights := make(chan int64)
+	ck.SetQueryContextFn(func(int64, bool) (sdk.Context, error) {
+		entered <- struct{}{}
+		return ctx.WithBlockHeight(<-heights), nil
+	})
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &fakeRecordStream{ctx: streamCtx, sent: make(chan *types.SubscribeRecordsResponse, 10)}
+
+	done := make(chan error)
+	go func() {
+		done <- clerkKeeper.NewQueryServer(&ck).SubscribeRecords(&types.SubscribeRecordsRequest{FromId: 1}, stream)
+	}()
+
+	receive := func() (ids []uint64, visibilityHeights []uint64) {
+		for len(stream.sent) > 0 {
+			response := <-stream.sent
+			ids = append(ids, response.Record.Id)
+			visibilityHeights = append(visibilityHeights, response.VisibilityHeight)
+		}
+		return ids, visibilityHeights
+	}
+
+	<-entered
+	heights <- 5
+
+	// the stream stops at the first pending record
+	<-entered
+	ids, visibilityHeights := receive()
+	require.Equal([]uint64{1, 2}, ids)
+	require.Equal([]uint64{0, 5}, visibilityHeights)
+
+	// the next block assigns the pending visibility heights, and a new record is pending
+	require.NoError(ck.ProcessPendingVisibilityEvents(ctx.WithBlockHeight(6)))
+	rec := types.NewEventRecord(TxHash1, 5, 5, Address1, make([]byte, 1), "1", recordTime)
+	require.NoError(ck.SetEventRecord(ctx, rec))
+	require.NoError(ck.AddPendingVisibilityEvent(ctx, 5))
+	heights <- 6
+
+	<-entered
+	ids, visibilityHeights = receive()
+	require.Equal([]uint64{3, 4}, ids)
+	require.Equal([]uint64{6, 6}, visibilityHeights)
+
+	// the subscription ends with the stream
+	cancel()
+	heights <- 6
+	require.NoError(<-done)
+	require.Empty(stream.sent)
+}
@@ -0,0 +1,119 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+const (
+	RecordRetentionHeights   = "record_retention_heights"
+	PruneConsumedRecords     = "prune_consumed_records"
+	MaxRecordsPrunedPerBlock = "max_records_pruned_per_block"
+	EventRecordCount         = "event_record_count"
+)
+
+// genRecordRetentionHeights returns randomized record retention heights, disabling the pruning by height half the time
+func genRecordRetentionHeights(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+
+	return uint64(r.Intn(1000) + 1)
+}
+
+// genPruneConsumedRecords returns whether the records consumed by bor are pruned
+func genPruneConsumedRecords(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// genMaxRecordsPrunedPerBlock returns a randomized maximum number of records pruned per block
+func genMaxRecordsPrunedPerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100) + 1)
+}
+
+// genEventRecordCount returns a randomized number of event records synced before the genesis
+func genEventRecordCount(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// genEventRecords returns count event records with sequential ids, along with the sequences of their events
+func genEventRecords(simState *module.SimulationState, count uint64) ([]types.EventRecord, []string) {
+	records := make([]types.EventRecord, 0, count)
+	sequences := make([]string, 0, count)
+
+	for id := uint64(1); id <= count; id++ {
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(simState.Rand)
+		contract := make([]byte, common.AddressLength)
+		simState.Rand.Read(contract)
+		data := make([]byte, simState.Rand.Intn(256))
+		simState.Rand.Read(data)
+
+		records = append(records, types.NewEventRecord(
+			txHash.Hex(),
+			logIndex,
+			id,
+			common.BytesToAddress(contract).Hex(),
+			data,
+			helper.DefaultBorChainID,
+			simState.GenTimestamp.Add(-time.Duration(count-id+1)*time.Minute).UTC(),
+		))
+		sequences = append(sequences, helper.CalculateSequence(blockNumber, logIndex))
+	}
+
+	return records, sequences
+}
+
+// RandomizedGenState generates a random GenesisState for clerk
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		recordRetentionHeights   uint64
+		pruneConsumedRecords     bool
+		maxRecordsPrunedPerBlock uint64
+		eventRecordCount         uint64
+	)
+
+	simState.AppParams.GetOrGenerate(RecordRetentionHeights, &recordRetentionHeights, simState.Rand, func(r *rand.Rand) {
+		recordRetentionHeights = genRecordRetentionHeights(r)
+	})
+
+	simState.AppParams.GetOrGenerate(PruneConsumedRecords, &pruneConsumedRecords, simState.Rand, func(r *rand.Rand) {
+		pruneConsumedRecords = genPruneConsumedRecords(r)
+	})
+
+	simState.AppParams.GetOrGenerate(MaxRecordsPrunedPerBlock, &maxRecordsPrunedPerBlock, simState.Rand, func(r *rand.Rand) {
+		maxRecordsPrunedPerBlock = genMaxRecordsPrunedPerBlock(r)
+	})
+
+	simState.AppParams.GetOrGenerate(EventRecordCount, &eventRecordCount, simState.Rand, func(r *rand.Rand) {
+		eventRecordCount = genEventRecordCount(r)
+	})
+
+	params := types.Params{
+		RecordRetentionHeights:   recordRetentionHeights,
+		PruneConsumedRecords:     pruneConsumedRecords,
+		MaxRecordsPrunedPerBlock: maxRecordsPrunedPerBlock,
+	}
+
+	records, sequences := genEventRecords(simState, eventRecordCount)
+
+	clerkGenesis := types.DefaultGenesisState()
+	clerkGenesis.Params = params
+	clerkGenesis.EventRecords = records
+	clerkGenesis.RecordSequences = sequences
+
+	bz, err := json.MarshalIndent(&clerkGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated clerk parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(clerkGenesis)
+}
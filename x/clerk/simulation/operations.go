@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/0xPolygon/heimdall-v2/contracts/statesender"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEventRecord     = "op_weight_msg_event_record"
+	OpWeightMsgRecordsConsumed = "op_weight_msg_records_consumed"
+
+	DefaultWeightMsgEventRecord     = 100
+	DefaultWeightMsgRecordsConsumed = 20
+)
+
+// maxStateSyncDataSize bounds the size of the data of the simulated state syncs
+const maxStateSyncDataSize = 1024
+
+// WeightedOperations returns all the operations from the clerk module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper) []hmSimulation.WeightedOperation {
+	var (
+		weightMsgEventRecord     int
+		weightMsgRecordsConsumed int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgEventRecord, &weightMsgEventRecord, nil, func(_ *rand.Rand) {
+		weightMsgEventRecord = DefaultWeightMsgEventRecord
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgRecordsConsumed, &weightMsgRecordsConsumed, nil, func(_ *rand.Rand) {
+		weightMsgRecordsConsumed = DefaultWeightMsgRecordsConsumed
+	})
+
+	return []hmSimulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgEventRecord, SimulateMsgEventRecord(k)),
+		hmSimulation.NewWeightedOperation(weightMsgRecordsConsumed, SimulateMsgRecordsConsumed(k)),
+	}
+}
+
+// SimulateMsgEventRecord generates a MsgEventRecord syncing the state sync following the last event record
+func SimulateMsgEventRecord(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEventRecord{})
+
+		params, err := k.ChainKeeper.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the chainmanager params"), err
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		contract, _ := simtypes.RandomAcc(r, accs)
+		data := make([]byte, r.Intn(maxStateSyncDataSize))
+		r.Read(data)
+
+		id := k.GetEventRecordCount(ctx) + 1
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg := types.NewMsgEventRecord(
+			from.Address.String(),
+			txHash.Hex(),
+			logIndex,
+			blockNumber,
+			id,
+			contract.Address,
+			data,
+			params.ChainParams.BorChainId,
+		)
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeStateSyncedEvent", mock.Anything, receipt, logIndex).Return(&statesender.StatesenderStateSynced{
+			Id:              new(big.Int).SetUint64(id),
+			ContractAddress: common.BytesToAddress(contract.Address),
+			Data:            data,
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(&msg, from, ""), nil
+	}
+}
+
+// SimulateMsgRecordsConsumed generates a MsgRecordsConsumed reporting a random event record as consumed by bor,
+// once the clerk records are pruned
+func SimulateMsgRecordsConsumed(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRecordsConsumed{})
+
+		if !helper.IsClerkRecordPruning(ctx.BlockHeight() + 1) {
+			return hmSimulation.NoOpMsg(msgType, "clerk record pruning is not enabled"), nil
+		}
+
+		consumedRecordID, err := k.GetBorConsumedRecordID(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the consumed record id"), err
+		}
+
+		latestRecordID := k.GetEventRecordCount(ctx)
+		if latestRecordID <= consumedRecordID {
+			return hmSimulation.NoOpMsg(msgType, "no record left to consume"), nil
+		}
+
+		params, err := k.ChainKeeper.GetParams(ctx)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to get the chainmanager params"), err
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		lastRecordID := consumedRecordID + 1 + uint64(r.Int63n(int64(latestRecordID-consumedRecordID)))
+		borBlockNumber := uint64(r.Int63n(int64(lastRecordID)*16) + 1)
+
+		msg := types.NewMsgRecordsConsumed(from.Address.String(), lastRecordID, borBlockNumber)
+
+		contractCaller.On("GetBorChainLastStateID", mock.Anything, params.ChainParams.StateReceiverAddress, new(big.Int).SetUint64(borBlockNumber)).
+			Return(lastRecordID, nil).Once()
+
+		return hmSimulation.NewOperationMsg(&msg, from, ""), nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+	types0 "github.com/0xPolygon/heimdall-v2/x/milestone/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -49,3 +50,41 @@ func (mr *MockChainKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockChainKeeper)(nil).GetParams), ctx)
 }
+
+// MockMilestoneKeeper is a mock of MilestoneKeeper interface.
+type MockMilestoneKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockMilestoneKeeperMockRecorder
+}
+
+// MockMilestoneKeeperMockRecorder is the mock recorder for MockMilestoneKeeper.
+type MockMilestoneKeeperMockRecorder struct {
+	mock *MockMilestoneKeeper
+}
+
+// NewMockMilestoneKeeper creates a new mock instance.
+func NewMockMilestoneKeeper(ctrl *gomock.Controller) *MockMilestoneKeeper {
+	mock := &MockMilestoneKeeper{ctrl: ctrl}
+	mock.recorder = &MockMilestoneKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMilestoneKeeper) EXPECT() *MockMilestoneKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetLastMilestone mocks base method.
+func (m *MockMilestoneKeeper) GetLastMilestone(ctx context.Context) (*types0.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMilestone", ctx)
+	ret0, _ := ret[0].(*types0.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMilestone indicates an expected call of GetLastMilestone.
+func (mr *MockMilestoneKeeperMockRecorder) GetLastMilestone(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMilestone", reflect.TypeOf((*MockMilestoneKeeper)(nil).GetLastMilestone), ctx)
+}
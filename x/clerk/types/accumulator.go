@@ -0,0 +1,191 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// accumulatorLeafPrefix and accumulatorNodePrefix domain-separate the leaf and the inner node hashes,
+	// so that an inner node can't be passed off as a record.
+	accumulatorLeafPrefix = byte(0x00)
+	accumulatorNodePrefix = byte(0x01)
+)
+
+// AccumulatorNode is a node of the record accumulator, at the given level (0 for the leaves) and index within it.
+type AccumulatorNode struct {
+	Level uint32
+	Index uint64
+	Hash  []byte
+}
+
+// RecordArchive keeps the event records pruned from the state, along with the accumulator nodes
+// needed to prove their inclusion. It is local to the node, and outside the consensus state.
+type RecordArchive interface {
+	// Archive stores the pruned records and the accumulator nodes created by their insertion.
+	Archive(records []EventRecord, nodes []AccumulatorNode) error
+	// GetRecord returns the archived record with the given ID.
+	GetRecord(id uint64) (*EventRecord, error)
+	// GetAccumulatorNode returns the hash of the archived accumulator node at the given level and index.
+	GetAccumulatorNode(level uint32, index uint64) ([]byte, error)
+}
+
+// RecordLeafHash returns the accumulator leaf hash of the event record.
+func RecordLeafHash(record EventRecord) ([]byte, error) {
+	bz, err := record.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256([]byte{accumulatorLeafPrefix}, bz), nil
+}
+
+// hashAccumulatorNode returns the hash of the inner node with the given children.
+func hashAccumulatorNode(left, right []byte) []byte {
+	return crypto.Keccak256([]byte{accumulatorNodePrefix}, left, right)
+}
+
+// NextRecordID returns the ID of the next record to be added to the accumulator.
+// It is zero while the accumulator is empty, as the first pruned record sets its start.
+func (a RecordAccumulator) NextRecordID() uint64 {
+	if a.Count == 0 {
+		return 0
+	}
+
+	return a.FirstRecordId + a.Count
+}
+
+// Contains reports whether the record with the given ID is a leaf of the accumulator.
+func (a RecordAccumulator) Contains(id uint64) bool {
+	return a.Count > 0 && id >= a.FirstRecordId && id-a.FirstRecordId < a.Count
+}
+
+// Append adds the leaf hash of the record with the given ID to the accumulator, merging the mountains of
+// equal height. It returns the created nodes: the leaf itself and the merged inner nodes.
+func (a *RecordAccumulator) Append(id uint64, leaf []byte) ([]AccumulatorNode, error) {
+	if a.Count == 0 {
+		a.FirstRecordId = id
+	} else if id != a.NextRecordID() {
+		return nil, fmt.Errorf("record %d is not the next record %d of the accumulator", id, a.NextRecordID())
+	}
+
+	index := a.Count
+	nodes := []AccumulatorNode{{Level: 0, Index: index, Hash: leaf}}
+
+	node := leaf
+	for level := uint32(0); a.Count>>level&1 == 1; level++ {
+		last := len(a.Peaks) - 1
+		node = hashAccumulatorNode(a.Peaks[last], node)
+		a.Peaks = a.Peaks[:last]
+		index >>= 1
+		nodes = append(nodes, AccumulatorNode{Level: level + 1, Index: index, Hash: node})
+	}
+
+	a.Peaks = append(a.Peaks, node)
+	a.Count++
+
+	return nodes, nil
+}
+
+// Root returns the root hash of the accumulator, committing to the number of leaves and the peaks.
+func (a RecordAccumulator) Root() []byte {
+	count := make([]byte, 8)
+	binary.BigEndian.PutUint64(count, a.Count)
+
+	return crypto.Keccak256(append([][]byte{count}, a.Peaks...)...)
+}
+
+// Validate checks that the accumulator peaks match its number of leaves.
+func (a RecordAccumulator) Validate() error {
+	if a.Count > 0 && a.FirstRecordId == 0 {
+		return errors.New("accumulator first record id should not be zero")
+	}
+
+	if len(a.Peaks) != bits.OnesCount64(a.Count) {
+		return fmt.Errorf("accumulator with %d leaves should have %d peaks, got %d", a.Count, bits.OnesCount64(a.Count), len(a.Peaks))
+	}
+
+	for i, peak := range a.Peaks {
+		if len(peak) != 32 {
+			return fmt.Errorf("accumulator peak %d should be 32 bytes long, got %d", i, len(peak))
+		}
+	}
+
+	return nil
+}
+
+// mountain returns the height of the mountain holding the given leaf, and the position of its peak.
+func (a RecordAccumulator) mountain(leafIndex uint64) (height int, peak int) {
+	offset := uint64(0)
+	for height = 63; height >= 0; height-- {
+		if a.Count>>height&1 == 0 {
+			continue
+		}
+
+		size := uint64(1) << height
+		if leafIndex < offset+size {
+			return height, peak
+		}
+
+		offset += size
+		peak++
+	}
+
+	return -1, -1
+}
+
+// ProofNodes returns the level and index of the nodes making up the inclusion proof of the given leaf,
+// i.e. the siblings on the path from the leaf up to its mountain peak.
+func (a RecordAccumulator) ProofNodes(leafIndex uint64) ([]AccumulatorNode, error) {
+	if leafIndex >= a.Count {
+		return nil, fmt.Errorf("leaf %d is out of the accumulator with %d leaves", leafIndex, a.Count)
+	}
+
+	height, _ := a.mountain(leafIndex)
+	nodes := make([]AccumulatorNode, 0, height)
+	for level := 0; level < height; level++ {
+		nodes = append(nodes, AccumulatorNode{Level: uint32(level), Index: leafIndex>>level ^ 1})
+	}
+
+	return nodes, nil
+}
+
+// VerifyRecordAccumulatorProof checks that the record is the leaf at the given index of the accumulator.
+func VerifyRecordAccumulatorProof(record EventRecord, leafIndex uint64, proof [][]byte, accumulator RecordAccumulator) error {
+	if err := accumulator.Validate(); err != nil {
+		return err
+	}
+
+	if !accumulator.Contains(record.Id) || record.Id-accumulator.FirstRecordId != leafIndex {
+		return fmt.Errorf("record %d is not the leaf %d of the accumulator", record.Id, leafIndex)
+	}
+
+	height, peak := accumulator.mountain(leafIndex)
+	if len(proof) != height {
+		return fmt.Errorf("proof of leaf %d should have %d nodes, got %d", leafIndex, height, len(proof))
+	}
+
+	node, err := RecordLeafHash(record)
+	if err != nil {
+		return err
+	}
+
+	for level, sibling := range proof {
+		if leafIndex>>level&1 == 0 {
+			node = hashAccumulatorNode(node, sibling)
+		} else {
+			node = hashAccumulatorNode(sibling, node)
+		}
+	}
+
+	if !bytes.Equal(node, accumulator.Peaks[peak]) {
+		return errors.New("proof does not match the accumulator peak")
+	}
+
+	return nil
+}
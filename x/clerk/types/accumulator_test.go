@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+// buildAccumulator appends records fromID..fromID+count-1, and returns them along with every created node.
+func buildAccumulator(t *testing.T, fromID, count uint64) (types.RecordAccumulator, []types.EventRecord, map[[2]uint64][]byte) {
+	t.Helper()
+
+	var accumulator types.RecordAccumulator
+	records := make([]types.EventRecord, 0, count)
+	nodes := make(map[[2]uint64][]byte)
+
+	for id := fromID; id < fromID+count; id++ {
+		record := types.NewEventRecord("0xabcdef", id, id, "0x1234567890123456789012345678901234567890", []byte{byte(id)}, "1", time.Unix(int64(id), 0).UTC())
+		leaf, err := types.RecordLeafHash(record)
+		require.NoError(t, err)
+
+		created, err := accumulator.Append(id, leaf)
+		require.NoError(t, err)
+		for _, node := range created {
+			nodes[[2]uint64{uint64(node.Level), node.Index}] = node.Hash
+		}
+
+		records = append(records, record)
+	}
+
+	return accumulator, records, nodes
+}
+
+func TestRecordAccumulator_Append(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps one peak per set bit of the count", func(t *testing.T) {
+		t.Parallel()
+
+		for count := uint64(1); count <= 20; count++ {
+			accumulator, _, _ := buildAccumulator(t, 5, count)
+
+			require.Equal(t, count, accumulator.Count)
+			require.Equal(t, uint64(5), accumulator.FirstRecordId)
+			require.Equal(t, 5+count, accumulator.NextRecordID())
+			require.NoError(t, accumulator.Validate())
+		}
+	})
+
+	t.Run("rejects non-contiguous records", func(t *testing.T) {
+		t.Parallel()
+
+		accumulator, _, _ := buildAccumulator(t, 1, 3)
+
+		_, err := accumulator.Append(5, make([]byte, 32))
+		require.Error(t, err)
+		require.Equal(t, uint64(3), accumulator.Count)
+	})
+
+	t.Run("root commits to the leaves", func(t *testing.T) {
+		t.Parallel()
+
+		accumulator, _, _ := buildAccumulator(t, 1, 7)
+		root := accumulator.Root()
+
+		other, _, _ := buildAccumulator(t, 1, 7)
+		require.Equal(t, root, other.Root())
+
+		other.Peaks[1] = make([]byte, 32)
+		require.NotEqual(t, root, other.Root())
+
+		longer, _, _ := buildAccumulator(t, 1, 8)
+		require.NotEqual(t, root, longer.Root())
+	})
+}
+
+func TestRecordAccumulator_Contains(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, types.RecordAccumulator{}.Contains(0))
+
+	accumulator, _, _ := buildAccumulator(t, 3, 4)
+	require.False(t, accumulator.Contains(2))
+	require.True(t, accumulator.Contains(3))
+	require.True(t, accumulator.Contains(6))
+	require.False(t, accumulator.Contains(7))
+}
+
+func TestVerifyRecordAccumulatorProof(t *testing.T) {
+	t.Parallel()
+
+	accumulator, records, nodes := buildAccumulator(t, 10, 13)
+
+	proofFor := func(leafIndex uint64) [][]byte {
+		proofNodes, err := accumulator.ProofNodes(leafIndex)
+		require.NoError(t, err)
+
+		proof := make([][]byte, 0, len(proofNodes))
+		for _, node := range proofNodes {
+			hash, ok := nodes[[2]uint64{uint64(node.Level), node.Index}]
+			require.True(t, ok)
+			proof = append(proof, hash)
+		}
+
+		return proof
+	}
+
+	t.Run("verifies every leaf", func(t *testing.T) {
+		t.Parallel()
+
+		for i, record := range records {
+			require.NoError(t, types.VerifyRecordAccumulatorProof(record, uint64(i), proofFor(uint64(i)), accumulator))
+		}
+	})
+
+	t.Run("rejects a tampered record", func(t *testing.T) {
+		t.Parallel()
+
+		record := records[4]
+		record.Data = []byte("tampered")
+
+		require.Error(t, types.VerifyRecordAccumulatorProof(record, 4, proofFor(4), accumulator))
+	})
+
+	t.Run("rejects a wrong leaf index", func(t *testing.T) {
+		t.Parallel()
+
+		require.Error(t, types.VerifyRecordAccumulatorProof(records[4], 5, proofFor(5), accumulator))
+	})
+
+	t.Run("rejects a truncated proof", func(t *testing.T) {
+		t.Parallel()
+
+		proof := proofFor(2)
+		require.Error(t, types.VerifyRecordAccumulatorProof(records[2], 2, proof[:len(proof)-1], accumulator))
+	})
+
+	t.Run("rejects an out of range leaf", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := accumulator.ProofNodes(accumulator.Count)
+		require.Error(t, err)
+	})
+}
+
+func TestRecordAccumulator_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, types.RecordAccumulator{}.Validate())
+
+	accumulator, _, _ := buildAccumulator(t, 1, 6)
+	require.NoError(t, accumulator.Validate())
+
+	missingPeak := accumulator
+	missingPeak.Peaks = missingPeak.Peaks[:1]
+	require.Error(t, missingPeak.Validate())
+
+	shortPeak := accumulator
+	shortPeak.Peaks = [][]byte{accumulator.Peaks[0], {0x01}}
+	require.Error(t, shortPeak.Validate())
+
+	zeroFirst := accumulator
+	zeroFirst.FirstRecordId = 0
+	require.Error(t, zeroFirst.Validate())
+}
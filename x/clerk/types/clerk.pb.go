@@ -83,42 +83,215 @@ func (m *EventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventRecord proto.InternalMessageInfo
 
+// Params defines the parameters for the clerk module.
+type Params struct {
+	// Number of heimdall heights after its visibility height at which an event
+	// record consumed by bor is pruned. Zero disables height-based pruning.
+	RecordRetentionHeights uint64 `protobuf:"varint,1,opt,name=record_retention_heights,json=recordRetentionHeights,proto3" json:"record_retention_heights,omitempty"`
+	// Whether the event records already consumed by bor are pruned.
+	PruneConsumedRecords bool `protobuf:"varint,2,opt,name=prune_consumed_records,json=pruneConsumedRecords,proto3" json:"prune_consumed_records,omitempty"`
+	// Maximum number of event records pruned per block.
+	MaxRecordsPrunedPerBlock uint64 `protobuf:"varint,3,opt,name=max_records_pruned_per_block,json=maxRecordsPrunedPerBlock,proto3" json:"max_records_pruned_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94e73a41bf24ef06, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRecordRetentionHeights() uint64 {
+	if m != nil {
+		return m.RecordRetentionHeights
+	}
+	return 0
+}
+
+func (m *Params) GetPruneConsumedRecords() bool {
+	if m != nil {
+		return m.PruneConsumedRecords
+	}
+	return false
+}
+
+func (m *Params) GetMaxRecordsPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecordsPrunedPerBlock
+	}
+	return 0
+}
+
+// RecordAccumulator is the Merkle mountain range accumulator over the pruned
+// event records. Its leaves are the pruned records, in ID order.
+type RecordAccumulator struct {
+	// ID of the first pruned event record, the leaf at index 0.
+	FirstRecordId uint64 `protobuf:"varint,1,opt,name=first_record_id,json=firstRecordId,proto3" json:"first_record_id,omitempty"`
+	// Number of pruned event records.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Peaks of the mountain range, from the highest mountain to the lowest.
+	Peaks [][]byte `protobuf:"bytes,3,rep,name=peaks,proto3" json:"peaks,omitempty"`
+}
+
+func (m *RecordAccumulator) Reset()         { *m = RecordAccumulator{} }
+func (m *RecordAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RecordAccumulator) ProtoMessage()    {}
+func (*RecordAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94e73a41bf24ef06, []int{2}
+}
+func (m *RecordAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordAccumulator.Merge(m, src)
+}
+func (m *RecordAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordAccumulator proto.InternalMessageInfo
+
+func (m *RecordAccumulator) GetFirstRecordId() uint64 {
+	if m != nil {
+		return m.FirstRecordId
+	}
+	return 0
+}
+
+func (m *RecordAccumulator) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RecordAccumulator) GetPeaks() [][]byte {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventRecord)(nil), "heimdallv2.clerk.EventRecord")
+	proto.RegisterType((*Params)(nil), "heimdallv2.clerk.Params")
+	proto.RegisterType((*RecordAccumulator)(nil), "heimdallv2.clerk.RecordAccumulator")
 }
 
 func init() { proto.RegisterFile("heimdallv2/clerk/clerk.proto", fileDescriptor_94e73a41bf24ef06) }
 
 var fileDescriptor_94e73a41bf24ef06 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x52, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x3e, 0x87, 0x34, 0x4d, 0x9d, 0x22, 0x81, 0x55, 0xa4, 0x6b, 0x04, 0x77, 0x51, 0x17, 0x22,
-	0xa4, 0x9e, 0x51, 0x98, 0x60, 0x23, 0x08, 0xa9, 0x65, 0x42, 0x85, 0x89, 0xe5, 0xe4, 0x3b, 0x1b,
-	0x9f, 0xc5, 0xdd, 0xbd, 0xc8, 0x76, 0xa3, 0xeb, 0x3f, 0x60, 0xec, 0x4f, 0xe8, 0xc8, 0xc8, 0xc0,
-	0xca, 0xde, 0xb1, 0x62, 0x62, 0x02, 0x94, 0x0c, 0xf0, 0x33, 0xd0, 0xd9, 0x17, 0xc8, 0xf2, 0xe4,
-	0xf7, 0x7d, 0xef, 0x7d, 0xfa, 0xfc, 0xd9, 0xf8, 0x7e, 0x21, 0x54, 0xc5, 0x59, 0x59, 0x2e, 0x67,
-	0x34, 0x2f, 0x85, 0xfe, 0xe0, 0x6b, 0xb2, 0xd0, 0x60, 0x81, 0xdc, 0xf9, 0xcf, 0x26, 0x0e, 0x1f,
-	0xdf, 0x65, 0x95, 0xaa, 0x81, 0xba, 0xea, 0x87, 0xc6, 0x87, 0x39, 0x98, 0x0a, 0x4c, 0xea, 0x3a,
-	0xea, 0x9b, 0x8e, 0x3a, 0x90, 0x20, 0xc1, 0xe3, 0xed, 0xa9, 0x43, 0x63, 0x09, 0x20, 0x4b, 0x41,
-	0x5d, 0x97, 0x9d, 0xbf, 0xa7, 0x56, 0x55, 0xc2, 0x58, 0x56, 0x2d, 0xfc, 0xc0, 0xd1, 0xd7, 0x1e,
-	0x1e, 0xbd, 0x5c, 0x8a, 0xda, 0x9e, 0x89, 0x1c, 0x34, 0x27, 0xf7, 0x70, 0x4f, 0xf1, 0x10, 0x4d,
-	0xd0, 0xb4, 0x3f, 0xdf, 0xf9, 0xf4, 0xfb, 0xf3, 0x23, 0x74, 0xd6, 0x53, 0x9c, 0x3c, 0xc5, 0xc3,
-	0x1c, 0x6a, 0xab, 0x59, 0x6e, 0xc3, 0xde, 0x04, 0x4d, 0xf7, 0xe6, 0x0f, 0xbe, 0x7d, 0x39, 0x3e,
-	0xe8, 0x1c, 0x3c, 0xe7, 0x5c, 0x0b, 0x63, 0xde, 0x58, 0xad, 0x6a, 0xe9, 0x97, 0xfe, 0x8d, 0x93,
-	0x43, 0xdc, 0xe7, 0xcc, 0xb2, 0xf0, 0xd6, 0x04, 0x4d, 0xf7, 0x37, 0x9a, 0x0e, 0x22, 0x11, 0xde,
-	0xb5, 0x4d, 0x5a, 0x30, 0x53, 0x84, 0x7d, 0x27, 0xda, 0xb1, 0x03, 0xdb, 0x9c, 0x30, 0x53, 0x90,
-	0x23, 0xbc, 0x57, 0x82, 0x4c, 0x55, 0xcd, 0x45, 0x13, 0xee, 0x6c, 0x7b, 0x1a, 0x96, 0x20, 0x4f,
-	0x5b, 0x98, 0x3c, 0xc4, 0xfb, 0x19, 0xe8, 0x34, 0x2f, 0x98, 0xaa, 0x53, 0xc5, 0xc3, 0xc1, 0xb6,
-	0x10, 0xce, 0x40, 0xbf, 0x68, 0x99, 0x53, 0x4e, 0x5e, 0xe1, 0x91, 0x76, 0x77, 0x4c, 0xdb, 0x0c,
-	0xc2, 0xdd, 0x09, 0x9a, 0x8e, 0x66, 0xe3, 0xc4, 0x07, 0x94, 0x6c, 0x02, 0x4a, 0xde, 0x6e, 0x02,
-	0x9a, 0xdf, 0xbe, 0xfe, 0x11, 0x07, 0x97, 0x3f, 0x63, 0xd4, 0x69, 0xf9, 0xed, 0x96, 0x7f, 0x36,
-	0xfc, 0x78, 0x15, 0x07, 0x7f, 0xae, 0xe2, 0x60, 0x7e, 0x72, 0xbd, 0x8a, 0xd0, 0xcd, 0x2a, 0x42,
-	0xbf, 0x56, 0x11, 0xba, 0x5c, 0x47, 0xc1, 0xcd, 0x3a, 0x0a, 0xbe, 0xaf, 0xa3, 0xe0, 0x5d, 0x22,
-	0x95, 0x2d, 0xce, 0xb3, 0x24, 0x87, 0x8a, 0x3e, 0x6e, 0x5e, 0x43, 0x79, 0x21, 0xa1, 0xa6, 0x9b,
-	0x57, 0x3e, 0x5e, 0xce, 0x68, 0xd3, 0x7d, 0x03, 0x7b, 0xb1, 0x10, 0x26, 0x1b, 0x38, 0x0b, 0x4f,
-	0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x90, 0xaf, 0xbb, 0x84, 0x27, 0x02, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0x31, 0x4f, 0xdc, 0x3c,
+	0x1c, 0xc6, 0xcf, 0x77, 0x70, 0x1c, 0x06, 0xf4, 0xbe, 0x58, 0x14, 0x05, 0x4a, 0x73, 0xa7, 0x5b,
+	0x7a, 0xaa, 0x44, 0x52, 0xd1, 0xa9, 0xed, 0x50, 0x71, 0x08, 0x09, 0x3a, 0x9d, 0x68, 0xa7, 0x2e,
+	0x96, 0x2f, 0x36, 0x89, 0x45, 0x62, 0x47, 0xb6, 0x83, 0x82, 0xd4, 0x0f, 0xd0, 0x91, 0x8f, 0xc0,
+	0xd8, 0xb1, 0x43, 0xd7, 0xee, 0x8c, 0xa8, 0x4b, 0x3b, 0xb5, 0x15, 0x0c, 0xe5, 0x63, 0x54, 0xb1,
+	0x13, 0xb8, 0x76, 0x89, 0xe2, 0xe7, 0xf9, 0xfd, 0xff, 0xb6, 0x1f, 0xdb, 0x70, 0x2b, 0x61, 0x3c,
+	0xa3, 0x24, 0x4d, 0x4f, 0x77, 0xc2, 0x28, 0x65, 0xea, 0xc4, 0x7d, 0x83, 0x5c, 0x49, 0x23, 0xd1,
+	0xff, 0xf7, 0x6e, 0x60, 0xf5, 0xcd, 0x55, 0x92, 0x71, 0x21, 0x43, 0xfb, 0x75, 0xd0, 0xe6, 0x46,
+	0x24, 0x75, 0x26, 0x35, 0xb6, 0xa3, 0xd0, 0x0d, 0x6a, 0x6b, 0x2d, 0x96, 0xb1, 0x74, 0x7a, 0xf5,
+	0x57, 0xab, 0xfd, 0x58, 0xca, 0x38, 0x65, 0xa1, 0x1d, 0x4d, 0x8b, 0xe3, 0xd0, 0xf0, 0x8c, 0x69,
+	0x43, 0xb2, 0xdc, 0x01, 0xc3, 0x2f, 0x6d, 0xb8, 0xb4, 0x7f, 0xca, 0x84, 0x39, 0x62, 0x91, 0x54,
+	0x14, 0x3d, 0x80, 0x6d, 0x4e, 0x3d, 0x30, 0x00, 0xa3, 0xb9, 0xf1, 0xfc, 0xc7, 0xdf, 0x9f, 0x9e,
+	0x80, 0xa3, 0x36, 0xa7, 0xe8, 0x39, 0xec, 0x45, 0x52, 0x18, 0x45, 0x22, 0xe3, 0xb5, 0x07, 0x60,
+	0xb4, 0x38, 0x7e, 0xf4, 0xf5, 0xf3, 0xf6, 0x5a, 0xbd, 0x82, 0x5d, 0x4a, 0x15, 0xd3, 0xfa, 0x8d,
+	0x51, 0x5c, 0xc4, 0xae, 0xe8, 0x0e, 0x47, 0x1b, 0x70, 0x8e, 0x12, 0x43, 0xbc, 0xce, 0x00, 0x8c,
+	0x96, 0x9b, 0x9e, 0x56, 0x42, 0x3e, 0x5c, 0x30, 0x25, 0x4e, 0x88, 0x4e, 0xbc, 0x39, 0xdb, 0xb4,
+	0x76, 0xbb, 0xa6, 0x3c, 0x20, 0x3a, 0x41, 0x43, 0xb8, 0x98, 0xca, 0x18, 0x73, 0x41, 0x59, 0xe9,
+	0xcd, 0xcf, 0xae, 0xa9, 0x97, 0xca, 0xf8, 0xb0, 0x92, 0xd1, 0x63, 0xb8, 0x3c, 0x95, 0x0a, 0x47,
+	0x09, 0xe1, 0x02, 0x73, 0xea, 0x75, 0x67, 0x1b, 0xc1, 0xa9, 0x54, 0x7b, 0x95, 0x73, 0x48, 0xd1,
+	0x6b, 0xb8, 0xa4, 0xec, 0x1e, 0x71, 0x95, 0x81, 0xb7, 0x30, 0x00, 0xa3, 0xa5, 0x9d, 0xcd, 0xc0,
+	0x05, 0x14, 0x34, 0x01, 0x05, 0x6f, 0x9b, 0x80, 0xc6, 0x2b, 0x97, 0x3f, 0xfa, 0xad, 0xf3, 0x9f,
+	0x7d, 0x50, 0xf7, 0x72, 0xd5, 0x95, 0xff, 0xa2, 0xf7, 0xe1, 0xa2, 0xdf, 0xba, 0xbd, 0xe8, 0xb7,
+	0x86, 0xdf, 0x00, 0xec, 0x4e, 0x88, 0x22, 0x99, 0x46, 0xaf, 0xa0, 0x57, 0x4f, 0xa0, 0x98, 0x61,
+	0xc2, 0x70, 0x29, 0x70, 0xc2, 0x78, 0x9c, 0x18, 0xfd, 0x77, 0xa0, 0xeb, 0x0e, 0x3b, 0x6a, 0xa8,
+	0x03, 0x07, 0xa1, 0x97, 0x70, 0x3d, 0x57, 0x85, 0x60, 0x38, 0x92, 0x42, 0x17, 0x19, 0xa3, 0xd8,
+	0x81, 0xda, 0x46, 0xde, 0x6b, 0xca, 0xd7, 0x2c, 0xb4, 0x57, 0x33, 0xee, 0xdc, 0x34, 0xda, 0x87,
+	0x5b, 0x19, 0x29, 0x9b, 0x0a, 0x6c, 0x19, 0x8a, 0x73, 0xa6, 0xf0, 0x34, 0x95, 0xd1, 0x89, 0xd7,
+	0x99, 0x5d, 0x81, 0x97, 0x91, 0xb2, 0x2e, 0x9c, 0x58, 0x70, 0xc2, 0xd4, 0xb8, 0xc2, 0xec, 0xce,
+	0xc0, 0xed, 0x45, 0x1f, 0x0c, 0xdf, 0xc3, 0x55, 0x87, 0xec, 0x46, 0x51, 0x91, 0x15, 0x29, 0x31,
+	0x52, 0xa1, 0x6d, 0xf8, 0xdf, 0x31, 0x57, 0xda, 0xd4, 0xf3, 0xe0, 0x7f, 0xef, 0xca, 0x8a, 0x75,
+	0x5d, 0xdd, 0x21, 0x45, 0x0f, 0xe1, 0x7c, 0x24, 0x0b, 0xe1, 0xee, 0xcc, 0x1d, 0xe4, 0xb4, 0xca,
+	0xcc, 0x19, 0x39, 0xd1, 0x5e, 0x67, 0xd0, 0xb9, 0xbf, 0x19, 0x4e, 0x1b, 0x1f, 0x5c, 0x5e, 0xfb,
+	0xe0, 0xea, 0xda, 0x07, 0xbf, 0xae, 0x7d, 0x70, 0x7e, 0xe3, 0xb7, 0xae, 0x6e, 0xfc, 0xd6, 0xf7,
+	0x1b, 0xbf, 0xf5, 0x2e, 0x88, 0xb9, 0x49, 0x8a, 0x69, 0x10, 0xc9, 0x2c, 0x7c, 0x5a, 0x4e, 0x64,
+	0x7a, 0x16, 0x4b, 0x11, 0x36, 0xaf, 0x67, 0xfb, 0x74, 0x27, 0x2c, 0xeb, 0xe7, 0x65, 0xce, 0x72,
+	0xa6, 0xa7, 0x5d, 0x7b, 0xb4, 0xcf, 0xfe, 0x0c, 0x00, 0x77, 0x47, 0x8d, 0x00, 0x7f, 0x03, 0x00,
+	0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordRetentionHeights != that1.RecordRetentionHeights {
+		return false
+	}
+	if this.PruneConsumedRecords != that1.PruneConsumedRecords {
+		return false
+	}
+	if this.MaxRecordsPrunedPerBlock != that1.MaxRecordsPrunedPerBlock {
+		return false
+	}
+	return true
+}
 func (m *EventRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -188,6 +361,91 @@ func (m *EventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecordsPrunedPerBlock != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.MaxRecordsPrunedPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PruneConsumedRecords {
+		i--
+		if m.PruneConsumedRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecordRetentionHeights != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.RecordRetentionHeights))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintClerk(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstRecordId != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.FirstRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClerk(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClerk(v)
 	base := offset
@@ -232,6 +490,45 @@ func (m *EventRecord) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordRetentionHeights != 0 {
+		n += 1 + sovClerk(uint64(m.RecordRetentionHeights))
+	}
+	if m.PruneConsumedRecords {
+		n += 2
+	}
+	if m.MaxRecordsPrunedPerBlock != 0 {
+		n += 1 + sovClerk(uint64(m.MaxRecordsPrunedPerBlock))
+	}
+	return n
+}
+
+func (m *RecordAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstRecordId != 0 {
+		n += 1 + sovClerk(uint64(m.FirstRecordId))
+	}
+	if m.Count != 0 {
+		n += 1 + sovClerk(uint64(m.Count))
+	}
+	if len(m.Peaks) > 0 {
+		for _, b := range m.Peaks {
+			l = len(b)
+			n += 1 + l + sovClerk(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovClerk(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -489,6 +786,234 @@ func (m *EventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClerk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRetentionHeights", wireType)
+			}
+			m.RecordRetentionHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRetentionHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneConsumedRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneConsumedRecords = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPrunedPerBlock", wireType)
+			}
+			m.MaxRecordsPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClerk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClerk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstRecordId", wireType)
+			}
+			m.FirstRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peaks = append(m.Peaks, make([]byte, postIndex-iNdEx))
+			copy(m.Peaks[len(m.Peaks)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClerk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClerk(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,12 +12,16 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEventRecord{}, "heimdall-v2/clerk//MsgEventRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgRecordsConsumed{}, "heimdallv2/clerk/MsgRecordsConsumed")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "heimdallv2/clerk/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the x/clerk interfaces types with the interface registry
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgEventRecord{},
+		&MsgRecordsConsumed{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
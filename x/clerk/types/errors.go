@@ -8,4 +8,6 @@ var (
 	ErrEventRecordAlreadySynced = errors.Register(ModuleName, 5400, "Event record already synced")
 	ErrSizeExceed               = errors.Register(ModuleName, 5401, "Data size exceed")
 	ErrInvalidTxHash            = errors.Register(ModuleName, 5402, "Invalid tx hash")
+	ErrEventRecordPruned        = errors.Register(ModuleName, 5403, "Event record already pruned")
+	ErrInvalidConsumedRecordID  = errors.Register(ModuleName, 5404, "Invalid consumed record id")
 )
@@ -1,12 +1,14 @@
 package types
 
 var (
-	EventTypeRecord = "record"
+	EventTypeRecord          = "record"
+	EventTypeRecordsConsumed = "records-consumed"
 
 	AttributeKeyRecordTxHash     = "record-tx-hash"
 	AttributeKeyRecordTxLogIndex = "record-tx-log-index"
 	AttributeKeyRecordID         = "record-id"
 	AttributeKeyRecordContract   = "record-contract"
+	AttributeKeyBorBlockNumber   = "bor-block-number"
 
 	AttributeValueCategory = ModuleName
 )
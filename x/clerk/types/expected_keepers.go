@@ -4,9 +4,15 @@ import (
 	"context"
 
 	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
+	milestonetypes "github.com/0xPolygon/heimdall-v2/x/milestone/types"
 )
 
 // ChainKeeper defines the chain keeper contract used by x/clerk module
 type ChainKeeper interface {
 	GetParams(ctx context.Context) (chainmanagertypes.Params, error)
 }
+
+// MilestoneKeeper defines the milestone keeper contract used by x/clerk module
+type MilestoneKeeper interface {
+	GetLastMilestone(ctx context.Context) (*milestonetypes.Milestone, error)
+}
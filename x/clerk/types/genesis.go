@@ -7,6 +7,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		EventRecords:    make([]EventRecord, 0),
 		RecordSequences: nil,
+		Params:          DefaultParams(),
 	}
 }
 
@@ -19,5 +20,22 @@ func Validate(data GenesisState) error {
 		}
 	}
 
+	// genesis files exported before the clerk params were introduced have none, and get the default ones
+	if data.Params != (Params{}) {
+		if err := data.Params.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := data.RecordAccumulator.Validate(); err != nil {
+		return err
+	}
+
+	for _, record := range data.EventRecords {
+		if data.RecordAccumulator.Contains(record.Id) {
+			return errors.New("event record already pruned into the accumulator")
+		}
+	}
+
 	return nil
 }
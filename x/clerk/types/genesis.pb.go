@@ -149,6 +149,12 @@ type GenesisState struct {
 	VisibilityHeightsById []Uint64Pair `protobuf:"bytes,5,rep,name=visibility_heights_by_id,json=visibilityHeightsById,proto3" json:"visibility_heights_by_id"`
 	// Block time reverse index: (block_time, height) → height for cutoff lookups.
 	BlockTimeEntries []BlockTimeEntry `protobuf:"bytes,6,rep,name=block_time_entries,json=blockTimeEntries,proto3" json:"block_time_entries"`
+	// Module parameters.
+	Params Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
+	// Accumulator over the pruned event records.
+	RecordAccumulator RecordAccumulator `protobuf:"bytes,8,opt,name=record_accumulator,json=recordAccumulator,proto3" json:"record_accumulator"`
+	// ID of the last event record consumed by bor.
+	BorConsumedRecordId uint64 `protobuf:"varint,9,opt,name=bor_consumed_record_id,json=borConsumedRecordId,proto3" json:"bor_consumed_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -226,6 +232,27 @@ func (m *GenesisState) GetBlockTimeEntries() []BlockTimeEntry {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRecordAccumulator() RecordAccumulator {
+	if m != nil {
+		return m.RecordAccumulator
+	}
+	return RecordAccumulator{}
+}
+
+func (m *GenesisState) GetBorConsumedRecordId() uint64 {
+	if m != nil {
+		return m.BorConsumedRecordId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Uint64Pair)(nil), "heimdallv2.clerk.Uint64Pair")
 	proto.RegisterType((*BlockTimeEntry)(nil), "heimdallv2.clerk.BlockTimeEntry")
@@ -235,38 +262,43 @@ func init() {
 func init() { proto.RegisterFile("heimdallv2/clerk/genesis.proto", fileDescriptor_5fd4c4f420a779a6) }
 
 var fileDescriptor_5fd4c4f420a779a6 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xa5, 0xad, 0x54, 0x33, 0xa0, 0x58, 0x03, 0x42, 0xd5, 0x85, 0xa8, 0xa7, 0x0a,
-	0x89, 0x64, 0x2a, 0x13, 0x17, 0x0e, 0x48, 0x91, 0xa6, 0xad, 0x07, 0xa4, 0x29, 0xdb, 0x38, 0xc0,
-	0x21, 0xca, 0x9f, 0x57, 0xa9, 0xd5, 0xc4, 0x2e, 0xb1, 0x1b, 0x2d, 0xdf, 0x82, 0x8f, 0xc1, 0x91,
-	0x8f, 0xc0, 0x71, 0xc7, 0x1e, 0x39, 0x21, 0xd4, 0x1e, 0xf8, 0x1a, 0x28, 0x76, 0x4a, 0xca, 0x7a,
-	0xb1, 0xec, 0xf7, 0x79, 0xf2, 0x73, 0xde, 0xe7, 0x35, 0x32, 0x67, 0x40, 0xb2, 0x38, 0x48, 0xd3,
-	0x62, 0xe2, 0x44, 0x29, 0xe4, 0x73, 0x27, 0x01, 0x0a, 0x9c, 0x70, 0x7b, 0x91, 0x33, 0xc1, 0x70,
-	0xbf, 0xd1, 0x6d, 0xa9, 0x0f, 0x9e, 0x04, 0x19, 0xa1, 0xcc, 0x91, 0xab, 0x32, 0x0d, 0x8e, 0x12,
-	0x96, 0x30, 0xb9, 0x75, 0xaa, 0x5d, 0x5d, 0x1d, 0xee, 0xa1, 0xe5, 0xaa, 0xd4, 0xd1, 0x29, 0x42,
-	0x37, 0x84, 0x8a, 0xb7, 0xa7, 0x97, 0x01, 0xc9, 0x71, 0x1f, 0xe9, 0x73, 0x28, 0x0d, 0xcd, 0xd2,
-	0xc6, 0x6d, 0xaf, 0xda, 0xe2, 0x23, 0xd4, 0x29, 0x82, 0x74, 0x09, 0xc6, 0x81, 0xac, 0xa9, 0xc3,
-	0xe8, 0x1c, 0x3d, 0x72, 0x53, 0x16, 0xcd, 0xaf, 0x49, 0x06, 0x67, 0x54, 0xe4, 0x25, 0x3e, 0x46,
-	0x28, 0xac, 0x2a, 0xbe, 0x20, 0x19, 0xd4, 0x80, 0x5e, 0xb8, 0xf5, 0xe0, 0x67, 0xa8, 0x3b, 0x03,
-	0x92, 0xcc, 0x44, 0xcd, 0xa9, 0x4f, 0xa3, 0x1f, 0x3a, 0x3a, 0x3c, 0x57, 0x9d, 0x5e, 0x89, 0x40,
-	0x00, 0xfe, 0x80, 0x1e, 0x42, 0x01, 0x54, 0xf8, 0x39, 0x44, 0x2c, 0x8f, 0xb9, 0xa1, 0x59, 0xfa,
-	0xf8, 0xc1, 0xe4, 0xd8, 0xbe, 0x1f, 0x80, 0x7d, 0x56, 0xd9, 0x3c, 0xe9, 0x72, 0x7b, 0x77, 0xbf,
-	0x5e, 0xb6, 0xbe, 0xfd, 0xf9, 0xfe, 0x4a, 0xf3, 0x0e, 0xa1, 0xa9, 0x73, 0x7c, 0x82, 0xfa, 0x0a,
-	0xe4, 0x73, 0xf8, 0xb2, 0x04, 0x1a, 0x01, 0x37, 0x0e, 0x2c, 0x7d, 0xdc, 0x73, 0x3b, 0xca, 0xfe,
-	0x58, 0xc9, 0x57, 0x5b, 0x15, 0xbf, 0x43, 0x83, 0x82, 0x70, 0x12, 0x92, 0x94, 0x88, 0x52, 0x76,
-	0xe3, 0x2f, 0x17, 0x49, 0x1e, 0xc4, 0xe0, 0x93, 0xd8, 0xd0, 0xe5, 0xdf, 0x3f, 0x6f, 0x1c, 0x55,
-	0x77, 0x37, 0x4a, 0x9f, 0xc6, 0xf8, 0x3d, 0x1a, 0x2e, 0x80, 0xc6, 0x84, 0x26, 0xfe, 0x0e, 0x44,
-	0x35, 0x44, 0x62, 0x6e, 0xb4, 0x2d, 0x7d, 0xdc, 0xf6, 0x5e, 0xd4, 0x9e, 0x8f, 0xff, 0x2c, 0xb2,
-	0x97, 0x69, 0xcc, 0xf1, 0x67, 0x64, 0xec, 0x7c, 0xa8, 0x42, 0xe2, 0x7e, 0x58, 0x56, 0x77, 0x77,
-	0x64, 0x12, 0xc3, 0xfd, 0x24, 0x9a, 0x01, 0xba, 0xed, 0x2a, 0x08, 0xef, 0x69, 0xc3, 0xb8, 0x50,
-	0x08, 0xb7, 0x9c, 0xc6, 0xf8, 0x1a, 0xe1, 0x66, 0x46, 0x3e, 0x50, 0x91, 0x13, 0xe0, 0x46, 0x57,
-	0x62, 0xad, 0x7d, 0xec, 0xff, 0x13, 0xae, 0xd1, 0xfd, 0x70, 0xb7, 0x4a, 0x80, 0xbb, 0x17, 0x77,
-	0x6b, 0x53, 0x5b, 0xad, 0x4d, 0xed, 0xf7, 0xda, 0xd4, 0xbe, 0x6e, 0xcc, 0xd6, 0x6a, 0x63, 0xb6,
-	0x7e, 0x6e, 0xcc, 0xd6, 0x27, 0x3b, 0x21, 0x62, 0xb6, 0x0c, 0xed, 0x88, 0x65, 0xce, 0xc9, 0xed,
-	0x25, 0x4b, 0xcb, 0x84, 0x51, 0x67, 0x7b, 0xcf, 0xeb, 0x62, 0xe2, 0xdc, 0xd6, 0x2f, 0x52, 0x94,
-	0x0b, 0xe0, 0x61, 0x57, 0x3e, 0xc9, 0x37, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xdd, 0x6b,
-	0x22, 0x0d, 0x03, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0xe6, 0xe3, 0xff, 0xcf, 0xb4, 0x40, 0x3a, 0x94, 0x62, 0xaa, 0xd4, 0x58, 0x61,
+	0x13, 0x21, 0x61, 0x57, 0x69, 0xc5, 0x86, 0x05, 0xc2, 0xa8, 0x6a, 0xb3, 0x40, 0x8a, 0xd2, 0x96,
+	0x05, 0x08, 0x59, 0xfe, 0xb8, 0x72, 0x46, 0xb1, 0x3d, 0x61, 0x66, 0x1c, 0xd5, 0x6f, 0x01, 0x6f,
+	0xc1, 0x92, 0xc7, 0xe8, 0xb2, 0x4b, 0x56, 0x08, 0x25, 0x0b, 0x5e, 0x03, 0x79, 0xc6, 0xc1, 0xa1,
+	0xd9, 0x58, 0x33, 0xf7, 0x9c, 0xf9, 0x65, 0xee, 0x3d, 0x19, 0x64, 0x4c, 0x80, 0x24, 0xa1, 0x17,
+	0xc7, 0xf3, 0x81, 0x1d, 0xc4, 0xc0, 0xa6, 0x76, 0x04, 0x29, 0x70, 0xc2, 0xad, 0x19, 0xa3, 0x82,
+	0xe2, 0x4e, 0xa5, 0x5b, 0x52, 0x3f, 0xd8, 0xf5, 0x12, 0x92, 0x52, 0x5b, 0x7e, 0x95, 0xe9, 0x60,
+	0x2f, 0xa2, 0x11, 0x95, 0x4b, 0xbb, 0x58, 0x95, 0xd5, 0xee, 0x06, 0x5a, 0x7e, 0x95, 0xda, 0x3b,
+	0x41, 0xe8, 0x8a, 0xa4, 0xe2, 0xe5, 0xc9, 0xc8, 0x23, 0x0c, 0x77, 0x50, 0x7d, 0x0a, 0xb9, 0xae,
+	0x99, 0x5a, 0xbf, 0x31, 0x2e, 0x96, 0x78, 0x0f, 0x35, 0xe7, 0x5e, 0x9c, 0x81, 0xbe, 0x25, 0x6b,
+	0x6a, 0xd3, 0x3b, 0x43, 0xf7, 0x9d, 0x98, 0x06, 0xd3, 0x4b, 0x92, 0xc0, 0x69, 0x2a, 0x58, 0x8e,
+	0x0f, 0x11, 0xf2, 0x8b, 0x8a, 0x2b, 0x48, 0x02, 0x25, 0xa0, 0xed, 0xaf, 0x3c, 0x78, 0x1f, 0xb5,
+	0x26, 0x40, 0xa2, 0x89, 0x28, 0x39, 0xe5, 0xae, 0xf7, 0xb5, 0x89, 0x76, 0xce, 0x54, 0xa7, 0x17,
+	0xc2, 0x13, 0x80, 0xdf, 0xa1, 0x7b, 0x30, 0x87, 0x54, 0xb8, 0x0c, 0x02, 0xca, 0x42, 0xae, 0x6b,
+	0x66, 0xbd, 0xbf, 0x3d, 0x38, 0xb4, 0xee, 0x0e, 0xc0, 0x3a, 0x2d, 0x6c, 0x63, 0xe9, 0x72, 0xda,
+	0x37, 0x3f, 0x9f, 0xd6, 0xbe, 0xfd, 0xfe, 0xfe, 0x5c, 0x1b, 0xef, 0x40, 0x55, 0xe7, 0xf8, 0x08,
+	0x75, 0x14, 0xc8, 0xe5, 0xf0, 0x39, 0x83, 0x34, 0x00, 0xae, 0x6f, 0x99, 0xf5, 0x7e, 0xdb, 0x69,
+	0x2a, 0xfb, 0x03, 0x25, 0x5f, 0xac, 0x54, 0xfc, 0x0a, 0x1d, 0xcc, 0x09, 0x27, 0x3e, 0x89, 0x89,
+	0xc8, 0x65, 0x37, 0x6e, 0x36, 0x8b, 0x98, 0x17, 0x82, 0x4b, 0x42, 0xbd, 0x2e, 0x6f, 0xff, 0xb8,
+	0x72, 0x14, 0xdd, 0x5d, 0x29, 0x7d, 0x18, 0xe2, 0xd7, 0xa8, 0x3b, 0x83, 0x34, 0x24, 0x69, 0xe4,
+	0xae, 0x41, 0x54, 0x43, 0x24, 0xe4, 0x7a, 0xc3, 0xac, 0xf7, 0x1b, 0xe3, 0x27, 0xa5, 0xe7, 0xfd,
+	0x5f, 0x8b, 0xec, 0x65, 0x18, 0x72, 0xfc, 0x11, 0xe9, 0x6b, 0x07, 0xd5, 0x90, 0xb8, 0xeb, 0xe7,
+	0xc5, 0x6f, 0x37, 0xe5, 0x24, 0xba, 0x9b, 0x93, 0xa8, 0x02, 0x74, 0x1a, 0xc5, 0x20, 0xc6, 0x8f,
+	0x2a, 0xc6, 0xb9, 0x42, 0x38, 0xf9, 0x30, 0xc4, 0x97, 0x08, 0x57, 0x19, 0xb9, 0x90, 0x0a, 0x46,
+	0x80, 0xeb, 0x2d, 0x89, 0x35, 0x37, 0xb1, 0xff, 0x26, 0x5c, 0xa2, 0x3b, 0xfe, 0x7a, 0x95, 0xc8,
+	0x81, 0xb5, 0x66, 0x1e, 0xf3, 0x12, 0xae, 0xff, 0x67, 0x6a, 0xfd, 0xed, 0x81, 0xbe, 0x49, 0x1a,
+	0x49, 0x7d, 0x3d, 0xa5, 0xf2, 0x08, 0xfe, 0x84, 0x70, 0x99, 0x8f, 0x17, 0x04, 0x59, 0x92, 0xc5,
+	0x9e, 0xa0, 0x4c, 0xff, 0x5f, 0x82, 0x9e, 0x6d, 0x82, 0x54, 0xac, 0x6f, 0x2a, 0xeb, 0x3a, 0x73,
+	0x97, 0xdd, 0x55, 0xf1, 0x31, 0xda, 0xf7, 0x29, 0x73, 0x03, 0x9a, 0xf2, 0x2c, 0x81, 0xb0, 0xfc,
+	0x53, 0x15, 0xc3, 0x6c, 0xcb, 0x20, 0x1f, 0xfa, 0x94, 0xbd, 0x2d, 0x45, 0xc5, 0x1e, 0x86, 0xce,
+	0xf9, 0xcd, 0xc2, 0xd0, 0x6e, 0x17, 0x86, 0xf6, 0x6b, 0x61, 0x68, 0x5f, 0x96, 0x46, 0xed, 0x76,
+	0x69, 0xd4, 0x7e, 0x2c, 0x8d, 0xda, 0x07, 0x2b, 0x22, 0x62, 0x92, 0xf9, 0x56, 0x40, 0x13, 0xfb,
+	0xe8, 0x7a, 0x44, 0xe3, 0x3c, 0xa2, 0xa9, 0xbd, 0xba, 0xe5, 0x8b, 0xf9, 0xc0, 0xbe, 0x2e, 0x9f,
+	0x98, 0xc8, 0x67, 0xc0, 0xfd, 0x96, 0x7c, 0x63, 0xc7, 0x7f, 0x06, 0x00, 0x18, 0xdc, 0x55, 0x80,
+	0xde, 0x03, 0x00, 0x00,
 }
 
 func (m *Uint64Pair) Marshal() (dAtA []byte, err error) {
@@ -355,6 +387,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BorConsumedRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BorConsumedRecordId))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.RecordAccumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.BlockTimeEntries) > 0 {
 		for iNdEx := len(m.BlockTimeEntries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -384,20 +441,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PendingVisibilityEventIds) > 0 {
-		dAtA2 := make([]byte, len(m.PendingVisibilityEventIds)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.PendingVisibilityEventIds)*10)
+		var j3 int
 		for _, num := range m.PendingVisibilityEventIds {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -513,6 +570,13 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RecordAccumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BorConsumedRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.BorConsumedRecordId))
+	}
 	return n
 }
 
@@ -956,6 +1020,91 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorConsumedRecordId", wireType)
+			}
+			m.BorConsumedRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorConsumedRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
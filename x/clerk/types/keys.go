@@ -20,6 +20,13 @@ var (
 	PendingVisibilityEventsKeyPrefix = collections.NewPrefix(4)
 	BlockTimeReverseIndexKeyPrefix   = collections.NewPrefix(5)
 	VisibilityHeightByIDKeyPrefix    = collections.NewPrefix(6)
+	RecordsByContractKeyPrefix       = collections.NewPrefix(7)
+	RecordsByTxHashKeyPrefix         = collections.NewPrefix(8)
+	RecordsByVisibilityKeyPrefix     = collections.NewPrefix(9)
+	RecordIndexBackfillKeyPrefix     = collections.NewPrefix(10)
+	ParamsKeyPrefix                  = collections.NewPrefix(11)
+	RecordAccumulatorKeyPrefix       = collections.NewPrefix(12)
+	BorConsumedRecordIDKeyPrefix     = collections.NewPrefix(13)
 
 	// DefaultValue of the record sequence
 	DefaultValue = []byte{0x01}
@@ -82,3 +82,40 @@ func (msg MsgEventRecord) GetTxHash() string {
 func (msg MsgEventRecord) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+// NewMsgRecordsConsumed - construct records consumed msg
+func NewMsgRecordsConsumed(from string, lastRecordID uint64, borBlockNumber uint64) MsgRecordsConsumed {
+	return MsgRecordsConsumed{
+		From:           util.FormatAddress(from),
+		LastRecordId:   lastRecordID,
+		BorBlockNumber: borBlockNumber,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRecordsConsumed) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRecordsConsumed) Type() string { return "records-consumed" }
+
+// ValidateBasic Implements Msg
+func (msg MsgRecordsConsumed) ValidateBasic() error {
+	bytes, err := hexCodec.NewHexCodec().StringToBytes(msg.From)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+
+	if sdk.AccAddress(bytes).Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+
+	if msg.LastRecordId == 0 {
+		return ErrInvalidConsumedRecordID
+	}
+
+	if msg.BorBlockNumber == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bor block number should not be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+const (
+	// DefaultMaxRecordsPrunedPerBlock is the default maximum number of event records pruned per block.
+	DefaultMaxRecordsPrunedPerBlock = uint64(1000)
+
+	// MaxRecordsPrunedPerBlockLimit bounds the records pruned per block, so that pruning can't stall the block production.
+	MaxRecordsPrunedPerBlockLimit = uint64(100000)
+)
+
+// DefaultParams returns the default clerk params, which disable the pruning.
+func DefaultParams() Params {
+	return Params{
+		RecordRetentionHeights:   0,
+		PruneConsumedRecords:     false,
+		MaxRecordsPrunedPerBlock: DefaultMaxRecordsPrunedPerBlock,
+	}
+}
+
+// ValidateBasic checks that the clerk params have valid values.
+func (p Params) ValidateBasic() error {
+	if p.MaxRecordsPrunedPerBlock == 0 {
+		return fmt.Errorf("max records pruned per block should not be zero")
+	}
+	if p.MaxRecordsPrunedPerBlock > MaxRecordsPrunedPerBlockLimit {
+		return fmt.Errorf("max records pruned per block %d exceeds the limit %d", p.MaxRecordsPrunedPerBlock, MaxRecordsPrunedPerBlockLimit)
+	}
+
+	return nil
+}
+
+// IsPruningEnabled reports whether the params prune any event record.
+func (p Params) IsPruningEnabled() bool {
+	return p.RecordRetentionHeights > 0 || p.PruneConsumedRecords
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/clerk/types"
+)
+
+func TestDefaultParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default params disable pruning", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.DefaultParams()
+
+		require.Zero(t, params.RecordRetentionHeights)
+		require.False(t, params.PruneConsumedRecords)
+		require.Equal(t, types.DefaultMaxRecordsPrunedPerBlock, params.MaxRecordsPrunedPerBlock)
+		require.False(t, params.IsPruningEnabled())
+	})
+
+	t.Run("default params are valid", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.DefaultParams()
+
+		require.NoError(t, params.ValidateBasic())
+	})
+}
+
+func TestParams_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validates correct params", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.Params{
+			RecordRetentionHeights:   100000,
+			PruneConsumedRecords:     true,
+			MaxRecordsPrunedPerBlock: types.MaxRecordsPrunedPerBlockLimit,
+		}
+
+		require.NoError(t, params.ValidateBasic())
+	})
+
+	t.Run("rejects zero max records pruned per block", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.DefaultParams()
+		params.MaxRecordsPrunedPerBlock = 0
+
+		require.Error(t, params.ValidateBasic())
+	})
+
+	t.Run("rejects max records pruned per block above the limit", func(t *testing.T) {
+		t.Parallel()
+
+		params := types.DefaultParams()
+		params.MaxRecordsPrunedPerBlock = types.MaxRecordsPrunedPerBlockLimit + 1
+
+		require.Error(t, params.ValidateBasic())
+	})
+}
+
+func TestParams_IsPruningEnabled(t *testing.T) {
+	t.Parallel()
+
+	params := types.DefaultParams()
+	params.RecordRetentionHeights = 10
+	require.True(t, params.IsPruningEnabled())
+
+	params = types.DefaultParams()
+	params.PruneConsumedRecords = true
+	require.True(t, params.IsPruningEnabled())
+}
@@ -663,1006 +663,4235 @@ func (m *RecordCountResponse) GetCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*RecordRequest)(nil), "heimdallv2.clerk.RecordRequest")
-	proto.RegisterType((*RecordResponse)(nil), "heimdallv2.clerk.RecordResponse")
-	proto.RegisterType((*RecordListRequest)(nil), "heimdallv2.clerk.RecordListRequest")
-	proto.RegisterType((*RecordListResponse)(nil), "heimdallv2.clerk.RecordListResponse")
-	proto.RegisterType((*RecordListWithTimeRequest)(nil), "heimdallv2.clerk.RecordListWithTimeRequest")
-	proto.RegisterType((*RecordListWithTimeResponse)(nil), "heimdallv2.clerk.RecordListWithTimeResponse")
-	proto.RegisterType((*RecordSequenceRequest)(nil), "heimdallv2.clerk.RecordSequenceRequest")
-	proto.RegisterType((*RecordSequenceResponse)(nil), "heimdallv2.clerk.RecordSequenceResponse")
-	proto.RegisterType((*IsClerkTxOldResponse)(nil), "heimdallv2.clerk.IsClerkTxOldResponse")
-	proto.RegisterType((*LatestRecordIdRequest)(nil), "heimdallv2.clerk.LatestRecordIdRequest")
-	proto.RegisterType((*LatestRecordIdResponse)(nil), "heimdallv2.clerk.LatestRecordIdResponse")
-	proto.RegisterType((*RecordCountRequest)(nil), "heimdallv2.clerk.RecordCountRequest")
-	proto.RegisterType((*RecordCountResponse)(nil), "heimdallv2.clerk.RecordCountResponse")
+// SubscribeRecordsRequest is the request type for the SubscribeRecords query.
+type SubscribeRecordsRequest struct {
+	// ID of the first event record to stream (inclusive).
+	FromId uint64 `protobuf:"varint,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("heimdallv2/clerk/query.proto", fileDescriptor_9015e8f88cd9cb1b) }
-
-var fileDescriptor_9015e8f88cd9cb1b = []byte{
-	// 929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xb6, 0xcd, 0xbf, 0x97, 0x3a, 0x24, 0x43, 0xd2, 0x3a, 0x9b, 0xe0, 0x98, 0xa1, 0xa4,
-	0x55, 0xc1, 0xbb, 0xd4, 0xe5, 0x86, 0x84, 0x90, 0x2b, 0xd4, 0x58, 0x14, 0x35, 0x0d, 0x91, 0x90,
-	0xe0, 0xb0, 0x5a, 0x7b, 0xa7, 0xeb, 0x51, 0x77, 0x77, 0x5c, 0xcf, 0x38, 0xb2, 0x85, 0x10, 0xa2,
-	0x07, 0x04, 0x12, 0x42, 0x95, 0xf8, 0x12, 0x1c, 0xb9, 0xf0, 0x1d, 0x7a, 0xac, 0xe0, 0xc2, 0x09,
-	0x50, 0x82, 0xc4, 0xd7, 0x40, 0xf3, 0xcf, 0x5e, 0xdb, 0x9b, 0x36, 0x1c, 0xb8, 0x58, 0x9b, 0xf7,
-	0xef, 0xf7, 0x7b, 0xef, 0xfd, 0xe6, 0x05, 0x76, 0x3a, 0x84, 0xa6, 0x51, 0x98, 0x24, 0xc7, 0x75,
-	0xbf, 0x9d, 0x90, 0xde, 0x23, 0xff, 0x71, 0x9f, 0xf4, 0x86, 0x5e, 0xb7, 0xc7, 0x04, 0x43, 0x6b,
-	0x63, 0xaf, 0xa7, 0xbc, 0xee, 0x7a, 0x98, 0xd2, 0x8c, 0xf9, 0xea, 0x57, 0x07, 0xb9, 0x37, 0xdb,
-	0x8c, 0xa7, 0x8c, 0xfb, 0xad, 0x90, 0x13, 0x9d, 0xed, 0x1f, 0xdf, 0x6a, 0x11, 0x11, 0xde, 0xf2,
-	0xbb, 0x61, 0x4c, 0xb3, 0x50, 0x50, 0x96, 0x99, 0xd8, 0x6d, 0x13, 0x6b, 0xc3, 0xf2, 0x68, 0xee,
-	0x46, 0xcc, 0x62, 0xa6, 0x3e, 0x7d, 0xf9, 0x65, 0xac, 0x3b, 0x31, 0x63, 0x71, 0x42, 0xfc, 0xb0,
-	0x4b, 0xfd, 0x30, 0xcb, 0x98, 0x50, 0xf5, 0xb8, 0xf1, 0xee, 0x1a, 0xaf, 0xfa, 0xab, 0xd5, 0x7f,
-	0xe8, 0x0b, 0x9a, 0x12, 0x2e, 0xc2, 0xb4, 0x6b, 0xd3, 0x67, 0x1a, 0x54, 0xbf, 0xda, 0x8b, 0x6f,
-	0x43, 0xe9, 0x90, 0xb4, 0x59, 0x2f, 0x3a, 0x24, 0x8f, 0xfb, 0x84, 0x0b, 0x84, 0x61, 0xb9, 0xa7,
-	0x0c, 0x01, 0x8d, 0xca, 0x4e, 0xd5, 0xb9, 0x71, 0xa9, 0x31, 0xff, 0xd3, 0x3f, 0x3f, 0xdf, 0x74,
-	0x0e, 0x97, 0xb4, 0xbd, 0x19, 0xe1, 0x43, 0x58, 0xb5, 0x49, 0xbc, 0xcb, 0x32, 0x4e, 0xd0, 0x07,
-	0xb0, 0xa0, 0xbd, 0x2a, 0x65, 0xa5, 0xfe, 0x9a, 0x37, 0x3d, 0x38, 0xef, 0xc3, 0x63, 0x92, 0x09,
-	0x9d, 0xd6, 0x58, 0x7e, 0xf6, 0xc7, 0xee, 0x9c, 0xae, 0x6a, 0xf2, 0xf0, 0x47, 0xb0, 0xae, 0x9d,
-	0xf7, 0x28, 0x17, 0x96, 0xcc, 0x16, 0x5c, 0xea, 0x86, 0x31, 0x99, 0xe4, 0xa1, 0x4c, 0x68, 0x1b,
-	0xe6, 0x13, 0x9a, 0x52, 0x51, 0xbe, 0x90, 0xf7, 0x69, 0x1b, 0x6e, 0x03, 0xca, 0x17, 0x33, 0x24,
-	0x3f, 0x86, 0x12, 0x91, 0x24, 0x02, 0x0d, 0xc9, 0xcb, 0x4e, 0xf5, 0xe2, 0x7f, 0xe2, 0x7a, 0x99,
-	0x8c, 0xed, 0x1c, 0xff, 0xea, 0xc0, 0xd6, 0x18, 0xe5, 0x53, 0x2a, 0x3a, 0x47, 0x34, 0x25, 0x96,
-	0x7a, 0x05, 0x16, 0x1f, 0xf6, 0x58, 0x3a, 0x33, 0xc5, 0x05, 0x69, 0x6d, 0x46, 0xa8, 0x01, 0x8b,
-	0x82, 0x05, 0x72, 0x59, 0xaa, 0x83, 0x95, 0xba, 0xeb, 0xe9, 0x4d, 0x7a, 0x76, 0x93, 0xde, 0x91,
-	0xdd, 0x64, 0xa3, 0x24, 0x39, 0x3c, 0xfd, 0x73, 0xd7, 0x31, 0x35, 0x04, 0x93, 0x3e, 0xf4, 0x00,
-	0x60, 0x2c, 0xb0, 0xf2, 0x45, 0x55, 0x66, 0xcf, 0xd3, 0x0a, 0xf3, 0xa4, 0x1a, 0x3d, 0xad, 0x2e,
-	0xa3, 0x46, 0xef, 0x20, 0x8c, 0x2d, 0xbf, 0x7c, 0x5b, 0xb9, 0x22, 0xf8, 0x11, 0xb8, 0x45, 0x3d,
-	0xfd, 0x3f, 0x13, 0xfc, 0x1c, 0x36, 0xf5, 0xe7, 0x27, 0x92, 0x54, 0xd6, 0xce, 0x0f, 0x4f, 0x0c,
-	0x82, 0x4e, 0xc8, 0x3b, 0x6a, 0x78, 0xcb, 0xa3, 0xe1, 0x89, 0xc1, 0x7e, 0xc8, 0x3b, 0x52, 0xa4,
-	0x09, 0x8b, 0x03, 0x9a, 0x45, 0x64, 0x30, 0x29, 0x80, 0xa5, 0x84, 0xc5, 0x4d, 0x69, 0xc6, 0xef,
-	0xc1, 0x95, 0xe9, 0xe2, 0xa6, 0x8b, 0xd7, 0x61, 0x89, 0x1b, 0xdb, 0x94, 0xc2, 0xad, 0x19, 0xbf,
-	0x0b, 0x1b, 0x4d, 0x7e, 0x47, 0x76, 0x72, 0x34, 0xb8, 0x9f, 0x8c, 0x75, 0xbe, 0x03, 0x0b, 0x94,
-	0x07, 0x2c, 0xd1, 0x4b, 0x5d, 0x1a, 0xc9, 0x8e, 0xf2, 0xfb, 0x49, 0x84, 0xaf, 0xc2, 0xe6, 0xbd,
-	0x50, 0x10, 0x6e, 0x1a, 0x6c, 0xda, 0x47, 0x85, 0xbf, 0x73, 0xe0, 0xca, 0xb4, 0xc7, 0x54, 0xf4,
-	0x61, 0x2d, 0x51, 0x9e, 0xe0, 0x8c, 0x67, 0xb7, 0x9a, 0x4c, 0x24, 0xa2, 0xf7, 0xa1, 0x4c, 0x79,
-	0xd0, 0xed, 0xb1, 0x36, 0xe1, 0x9c, 0x44, 0x41, 0x6b, 0x18, 0xd8, 0xe9, 0xab, 0x51, 0x8c, 0x48,
-	0x6d, 0x52, 0x7e, 0x60, 0xa3, 0x1a, 0xc3, 0x7d, 0x13, 0x83, 0x37, 0xec, 0xdb, 0xb8, 0xc3, 0xfa,
-	0x99, 0x7d, 0x69, 0xb8, 0x0e, 0xaf, 0x4e, 0x58, 0x0d, 0xbb, 0x6d, 0x98, 0x6f, 0x4b, 0xc3, 0x24,
-	0x25, 0x6d, 0xab, 0xff, 0xb2, 0x08, 0xf3, 0x0f, 0xa4, 0xc0, 0xd0, 0x37, 0x0e, 0xac, 0xde, 0x25,
-	0x22, 0x57, 0x01, 0x5d, 0x9b, 0xd5, 0xc4, 0x2c, 0xac, 0xfb, 0xe6, 0x4b, 0xa2, 0x34, 0x0d, 0x7c,
-	0xfd, 0x5b, 0x89, 0xfb, 0xe4, 0xb7, 0xbf, 0x7f, 0xbc, 0xb0, 0x83, 0x5c, 0x73, 0xc6, 0x94, 0x94,
-	0x6a, 0x46, 0x89, 0xbe, 0xa2, 0x84, 0x9e, 0x38, 0x50, 0x1a, 0x11, 0x91, 0x12, 0x46, 0x6f, 0x9c,
-	0x85, 0x90, 0xbb, 0x33, 0xee, 0xb5, 0x17, 0x07, 0x19, 0x16, 0x7b, 0x63, 0x16, 0xdb, 0x68, 0xab,
-	0x90, 0x45, 0x22, 0x21, 0x7f, 0x70, 0x60, 0xfd, 0x2e, 0x11, 0x93, 0x0b, 0x47, 0xd7, 0x67, 0x31,
-	0x0a, 0xc5, 0xe2, 0xde, 0x78, 0x79, 0xa0, 0x25, 0xa4, 0xb8, 0x54, 0x51, 0xa5, 0x98, 0x8b, 0x4a,
-	0xaa, 0xd1, 0x08, 0x7d, 0x9d, 0x9f, 0x4a, 0x63, 0xd8, 0x8c, 0xd0, 0xee, 0x59, 0x0d, 0x5b, 0x12,
-	0xd5, 0xb3, 0x03, 0x0c, 0x78, 0x6d, 0x3c, 0x0d, 0x8c, 0xaa, 0x85, 0x0c, 0xbe, 0x18, 0x49, 0xfa,
-	0x4b, 0xf4, 0xbd, 0x03, 0x9b, 0x13, 0x9b, 0xb1, 0xc7, 0x05, 0xbd, 0xf5, 0xa2, 0xe1, 0x4f, 0x9d,
-	0x55, 0xf7, 0xed, 0xf3, 0x05, 0x1b, 0x8e, 0xe5, 0x31, 0xc7, 0x12, 0x5a, 0x31, 0x1c, 0xe5, 0xcd,
-	0x45, 0x5f, 0xa9, 0x15, 0x4d, 0x1e, 0x88, 0xa2, 0x15, 0x15, 0xde, 0xa7, 0xa2, 0x15, 0x15, 0xdf,
-	0x1a, 0x7c, 0x55, 0x81, 0xaf, 0xa3, 0x57, 0x0c, 0xb8, 0xbd, 0x30, 0x68, 0x08, 0x97, 0xf3, 0x17,
-	0xe6, 0xfc, 0xd8, 0x7b, 0xb3, 0x81, 0x45, 0xa7, 0x0a, 0x97, 0x15, 0x32, 0x42, 0x6b, 0x06, 0x99,
-	0xf2, 0x1a, 0x4b, 0xa2, 0x9a, 0x18, 0x34, 0xf6, 0x9f, 0x9d, 0x54, 0x9c, 0xe7, 0x27, 0x15, 0xe7,
-	0xaf, 0x93, 0x8a, 0xf3, 0xf4, 0xb4, 0x32, 0xf7, 0xfc, 0xb4, 0x32, 0xf7, 0xfb, 0x69, 0x65, 0xee,
-	0x33, 0x2f, 0xa6, 0xa2, 0xd3, 0x6f, 0x79, 0x6d, 0x96, 0xfa, 0xef, 0x0c, 0x0e, 0x58, 0x32, 0x8c,
-	0x59, 0xe6, 0x5b, 0xbc, 0xda, 0x71, 0xdd, 0x1f, 0xd8, 0x21, 0x0e, 0xbb, 0x84, 0xb7, 0x16, 0xd4,
-	0xff, 0xaa, 0xdb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x5b, 0x53, 0xe3, 0x45, 0x09, 0x00,
-	0x00,
+func (m *SubscribeRecordsRequest) Reset()         { *m = SubscribeRecordsRequest{} }
+func (m *SubscribeRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRecordsRequest) ProtoMessage()    {}
+func (*SubscribeRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{13}
+}
+func (m *SubscribeRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRecordsRequest.Merge(m, src)
+}
+func (m *SubscribeRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRecordsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SubscribeRecordsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// GetRecordCount queries the total number of event records.
-	GetRecordCount(ctx context.Context, in *RecordCountRequest, opts ...grpc.CallOption) (*RecordCountResponse, error)
-	// GetRecordList queries a paginated list of event records.
-	GetRecordList(ctx context.Context, in *RecordListRequest, opts ...grpc.CallOption) (*RecordListResponse, error)
-	// GetLatestRecordId queries the latest event record ID from the root chain.
-	GetLatestRecordId(ctx context.Context, in *LatestRecordIdRequest, opts ...grpc.CallOption) (*LatestRecordIdResponse, error)
-	// GetRecordById retrieves a specific event record by its ID.
-	GetRecordById(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
-	// GetRecordListWithTime queries event records within a time range.
-	GetRecordListWithTime(ctx context.Context, in *RecordListWithTimeRequest, opts ...grpc.CallOption) (*RecordListWithTimeResponse, error)
-	// GetRecordSequence queries the sequence number for a specific event record.
-	GetRecordSequence(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*RecordSequenceResponse, error)
-	// IsClerkTxOld checks if a clerk transaction has already been submitted.
-	IsClerkTxOld(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*IsClerkTxOldResponse, error)
+func (m *SubscribeRecordsRequest) GetFromId() uint64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// SubscribeRecordsResponse is the type of the messages streamed by the
+// SubscribeRecords query.
+type SubscribeRecordsResponse struct {
+	// The event record.
+	Record EventRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// Heimdall height at which the record became visible; 0 for the records
+	// committed before visibility heights were introduced.
+	VisibilityHeight uint64 `protobuf:"varint,2,opt,name=visibility_height,json=visibilityHeight,proto3" json:"visibility_height,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *SubscribeRecordsResponse) Reset()         { *m = SubscribeRecordsResponse{} }
+func (m *SubscribeRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRecordsResponse) ProtoMessage()    {}
+func (*SubscribeRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{14}
 }
-
-func (c *queryClient) GetRecordCount(ctx context.Context, in *RecordCountRequest, opts ...grpc.CallOption) (*RecordCountResponse, error) {
-	out := new(RecordCountResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscribeRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SubscribeRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRecordsResponse.Merge(m, src)
+}
+func (m *SubscribeRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRecordsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetRecordList(ctx context.Context, in *RecordListRequest, opts ...grpc.CallOption) (*RecordListResponse, error) {
-	out := new(RecordListResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SubscribeRecordsResponse proto.InternalMessageInfo
+
+func (m *SubscribeRecordsResponse) GetRecord() EventRecord {
+	if m != nil {
+		return m.Record
 	}
-	return out, nil
+	return EventRecord{}
 }
 
-func (c *queryClient) GetLatestRecordId(ctx context.Context, in *LatestRecordIdRequest, opts ...grpc.CallOption) (*LatestRecordIdResponse, error) {
-	out := new(LatestRecordIdResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetLatestRecordId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscribeRecordsResponse) GetVisibilityHeight() uint64 {
+	if m != nil {
+		return m.VisibilityHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetRecordById(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
-	out := new(RecordResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RecordsByContractRequest is the request type for the GetRecordsByContract
+// query.
+type RecordsByContractRequest struct {
+	// Address of the receiver contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Starting record ID (inclusive).
+	FromId uint64 `protobuf:"varint,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// Pagination parameters; only offset and limit are used.
+	Pagination query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (c *queryClient) GetRecordListWithTime(ctx context.Context, in *RecordListWithTimeRequest, opts ...grpc.CallOption) (*RecordListWithTimeResponse, error) {
-	out := new(RecordListWithTimeResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordListWithTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordsByContractRequest) Reset()         { *m = RecordsByContractRequest{} }
+func (m *RecordsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordsByContractRequest) ProtoMessage()    {}
+func (*RecordsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{15}
+}
+func (m *RecordsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RecordsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByContractRequest.Merge(m, src)
+}
+func (m *RecordsByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByContractRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetRecordSequence(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*RecordSequenceResponse, error) {
-	out := new(RecordSequenceResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecordsByContractRequest proto.InternalMessageInfo
+
+func (m *RecordsByContractRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) IsClerkTxOld(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*IsClerkTxOldResponse, error) {
-	out := new(IsClerkTxOldResponse)
-	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/IsClerkTxOld", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RecordsByContractRequest) GetFromId() uint64 {
+	if m != nil {
+		return m.FromId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// GetRecordCount queries the total number of event records.
-	GetRecordCount(context.Context, *RecordCountRequest) (*RecordCountResponse, error)
-	// GetRecordList queries a paginated list of event records.
-	GetRecordList(context.Context, *RecordListRequest) (*RecordListResponse, error)
-	// GetLatestRecordId queries the latest event record ID from the root chain.
-	GetLatestRecordId(context.Context, *LatestRecordIdRequest) (*LatestRecordIdResponse, error)
-	// GetRecordById retrieves a specific event record by its ID.
-	GetRecordById(context.Context, *RecordRequest) (*RecordResponse, error)
-	// GetRecordListWithTime queries event records within a time range.
-	GetRecordListWithTime(context.Context, *RecordListWithTimeRequest) (*RecordListWithTimeResponse, error)
-	// GetRecordSequence queries the sequence number for a specific event record.
-	GetRecordSequence(context.Context, *RecordSequenceRequest) (*RecordSequenceResponse, error)
-	// IsClerkTxOld checks if a clerk transaction has already been submitted.
-	IsClerkTxOld(context.Context, *RecordSequenceRequest) (*IsClerkTxOldResponse, error)
+func (m *RecordsByContractRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// RecordsByContractResponse is the response type for the GetRecordsByContract
+// query.
+type RecordsByContractResponse struct {
+	// List of event records targeting the contract.
+	EventRecords []EventRecord `protobuf:"bytes,1,rep,name=event_records,json=eventRecords,proto3" json:"event_records"`
 }
 
-func (*UnimplementedQueryServer) GetRecordCount(ctx context.Context, req *RecordCountRequest) (*RecordCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordCount not implemented")
+func (m *RecordsByContractResponse) Reset()         { *m = RecordsByContractResponse{} }
+func (m *RecordsByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordsByContractResponse) ProtoMessage()    {}
+func (*RecordsByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{16}
 }
-func (*UnimplementedQueryServer) GetRecordList(ctx context.Context, req *RecordListRequest) (*RecordListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordList not implemented")
+func (m *RecordsByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetLatestRecordId(ctx context.Context, req *LatestRecordIdRequest) (*LatestRecordIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestRecordId not implemented")
+func (m *RecordsByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetRecordById(ctx context.Context, req *RecordRequest) (*RecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordById not implemented")
+func (m *RecordsByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByContractResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetRecordListWithTime(ctx context.Context, req *RecordListWithTimeRequest) (*RecordListWithTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordListWithTime not implemented")
+func (m *RecordsByContractResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetRecordSequence(ctx context.Context, req *RecordSequenceRequest) (*RecordSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordSequence not implemented")
+func (m *RecordsByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByContractResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) IsClerkTxOld(ctx context.Context, req *RecordSequenceRequest) (*IsClerkTxOldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsClerkTxOld not implemented")
+
+var xxx_messageInfo_RecordsByContractResponse proto.InternalMessageInfo
+
+func (m *RecordsByContractResponse) GetEventRecords() []EventRecord {
+	if m != nil {
+		return m.EventRecords
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// RecordsByTxHashRequest is the request type for the GetRecordsByTxHash query.
+type RecordsByTxHashRequest struct {
+	// Transaction hash on the root chain.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Pagination parameters; only offset and limit are used.
+	Pagination query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func _Query_GetRecordCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetRecordCount",
+func (m *RecordsByTxHashRequest) Reset()         { *m = RecordsByTxHashRequest{} }
+func (m *RecordsByTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordsByTxHashRequest) ProtoMessage()    {}
+func (*RecordsByTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{17}
+}
+func (m *RecordsByTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordCount(ctx, req.(*RecordCountRequest))
+}
+func (m *RecordsByTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByTxHashRequest.Merge(m, src)
+}
+func (m *RecordsByTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByTxHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordsByTxHashRequest proto.InternalMessageInfo
+
+func (m *RecordsByTxHashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRecordList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RecordsByTxHashRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetRecordList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordList(ctx, req.(*RecordListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return query.PageRequest{}
 }
 
-func _Query_GetLatestRecordId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestRecordIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLatestRecordId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetLatestRecordId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLatestRecordId(ctx, req.(*LatestRecordIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RecordsByTxHashResponse is the response type for the GetRecordsByTxHash
+// query.
+type RecordsByTxHashResponse struct {
+	// List of event records emitted by the transaction.
+	EventRecords []EventRecord `protobuf:"bytes,1,rep,name=event_records,json=eventRecords,proto3" json:"event_records"`
 }
 
-func _Query_GetRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetRecordById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordById(ctx, req.(*RecordRequest))
+func (m *RecordsByTxHashResponse) Reset()         { *m = RecordsByTxHashResponse{} }
+func (m *RecordsByTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordsByTxHashResponse) ProtoMessage()    {}
+func (*RecordsByTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{18}
+}
+func (m *RecordsByTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RecordsByTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByTxHashResponse.Merge(m, src)
+}
+func (m *RecordsByTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByTxHashResponse.DiscardUnknown(m)
 }
 
-func _Query_GetRecordListWithTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordListWithTimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordListWithTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetRecordListWithTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordListWithTime(ctx, req.(*RecordListWithTimeRequest))
+var xxx_messageInfo_RecordsByTxHashResponse proto.InternalMessageInfo
+
+func (m *RecordsByTxHashResponse) GetEventRecords() []EventRecord {
+	if m != nil {
+		return m.EventRecords
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetRecordSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/GetRecordSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordSequence(ctx, req.(*RecordSequenceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RecordsByVisibilityHeightRequest is the request type for the
+// GetRecordsByVisibilityHeight query.
+type RecordsByVisibilityHeightRequest struct {
+	// Start of the heimdall height range (inclusive).
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// End of the heimdall height range (inclusive).
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Pagination parameters; only offset and limit are used.
+	Pagination query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func _Query_IsClerkTxOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsClerkTxOld(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdallv2.clerk.Query/IsClerkTxOld",
+func (m *RecordsByVisibilityHeightRequest) Reset()         { *m = RecordsByVisibilityHeightRequest{} }
+func (m *RecordsByVisibilityHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordsByVisibilityHeightRequest) ProtoMessage()    {}
+func (*RecordsByVisibilityHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{19}
+}
+func (m *RecordsByVisibilityHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByVisibilityHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByVisibilityHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsClerkTxOld(ctx, req.(*RecordSequenceRequest))
+}
+func (m *RecordsByVisibilityHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByVisibilityHeightRequest.Merge(m, src)
+}
+func (m *RecordsByVisibilityHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByVisibilityHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByVisibilityHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordsByVisibilityHeightRequest proto.InternalMessageInfo
+
+func (m *RecordsByVisibilityHeightRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "heimdallv2.clerk.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRecordCount",
-			Handler:    _Query_GetRecordCount_Handler,
-		},
-		{
-			MethodName: "GetRecordList",
-			Handler:    _Query_GetRecordList_Handler,
-		},
-		{
-			MethodName: "GetLatestRecordId",
-			Handler:    _Query_GetLatestRecordId_Handler,
-		},
-		{
-			MethodName: "GetRecordById",
-			Handler:    _Query_GetRecordById_Handler,
-		},
-		{
-			MethodName: "GetRecordListWithTime",
-			Handler:    _Query_GetRecordListWithTime_Handler,
-		},
-		{
-			MethodName: "GetRecordSequence",
-			Handler:    _Query_GetRecordSequence_Handler,
-		},
-		{
-			MethodName: "IsClerkTxOld",
-			Handler:    _Query_IsClerkTxOld_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "heimdallv2/clerk/query.proto",
+func (m *RecordsByVisibilityHeightRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
 }
 
-func (m *RecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecordsByVisibilityHeightRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return query.PageRequest{}
 }
 
-func (m *RecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecordsByVisibilityHeightResponse is the response type for the
+// GetRecordsByVisibilityHeight query.
+type RecordsByVisibilityHeightResponse struct {
+	// List of event records visible within the height range, ordered by
+	// visibility height and ID.
+	EventRecords []EventRecord `protobuf:"bytes,1,rep,name=event_records,json=eventRecords,proto3" json:"event_records"`
 }
 
-func (m *RecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RecordId))
-		i--
-		dAtA[i] = 0x8
+func (m *RecordsByVisibilityHeightResponse) Reset()         { *m = RecordsByVisibilityHeightResponse{} }
+func (m *RecordsByVisibilityHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordsByVisibilityHeightResponse) ProtoMessage()    {}
+func (*RecordsByVisibilityHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{20}
+}
+func (m *RecordsByVisibilityHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByVisibilityHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByVisibilityHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RecordsByVisibilityHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByVisibilityHeightResponse.Merge(m, src)
+}
+func (m *RecordsByVisibilityHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByVisibilityHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByVisibilityHeightResponse.DiscardUnknown(m)
 }
 
-func (m *RecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecordsByVisibilityHeightResponse proto.InternalMessageInfo
+
+func (m *RecordsByVisibilityHeightResponse) GetEventRecords() []EventRecord {
+	if m != nil {
+		return m.EventRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the GetClerkParams query.
+type QueryParamsRequest struct {
 }
 
-func (m *RecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{21}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *RecordListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the GetClerkParams query.
+type QueryParamsResponse struct {
+	// The clerk module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *RecordListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{22}
 }
-
-func (m *RecordListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *RecordListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *RecordListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecordAccumulatorRequest is the request type for the GetRecordAccumulator
+// query.
+type RecordAccumulatorRequest struct {
 }
 
-func (m *RecordListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventRecords) > 0 {
-		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RecordAccumulatorRequest) Reset()         { *m = RecordAccumulatorRequest{} }
+func (m *RecordAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordAccumulatorRequest) ProtoMessage()    {}
+func (*RecordAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{23}
+}
+func (m *RecordAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RecordListWithTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RecordAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordAccumulatorRequest.Merge(m, src)
+}
+func (m *RecordAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordAccumulatorRequest.DiscardUnknown(m)
 }
 
-func (m *RecordListWithTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RecordAccumulatorRequest proto.InternalMessageInfo
+
+// RecordAccumulatorResponse is the response type for the GetRecordAccumulator
+// query.
+type RecordAccumulatorResponse struct {
+	// The accumulator over the pruned event records.
+	Accumulator RecordAccumulator `protobuf:"bytes,1,opt,name=accumulator,proto3" json:"accumulator"`
+	// Root hash of the accumulator.
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
 }
 
-func (m *RecordListWithTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+func (m *RecordAccumulatorResponse) Reset()         { *m = RecordAccumulatorResponse{} }
+func (m *RecordAccumulatorResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordAccumulatorResponse) ProtoMessage()    {}
+func (*RecordAccumulatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{24}
+}
+func (m *RecordAccumulatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordAccumulatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordAccumulatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime):])
-	if err3 != nil {
-		return 0, err3
+		return b[:n], nil
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x12
-	if m.FromId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RecordAccumulatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordAccumulatorResponse.Merge(m, src)
+}
+func (m *RecordAccumulatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordAccumulatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordAccumulatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordAccumulatorResponse proto.InternalMessageInfo
+
+func (m *RecordAccumulatorResponse) GetAccumulator() RecordAccumulator {
+	if m != nil {
+		return m.Accumulator
 	}
-	return len(dAtA) - i, nil
+	return RecordAccumulator{}
 }
 
-func (m *RecordListWithTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecordAccumulatorResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RecordListWithTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecordAccumulatorProofRequest is the request type for the
+// GetRecordAccumulatorProof query.
+type RecordAccumulatorProofRequest struct {
+	// ID of the pruned event record.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 }
 
-func (m *RecordListWithTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventRecords) > 0 {
-		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RecordAccumulatorProofRequest) Reset()         { *m = RecordAccumulatorProofRequest{} }
+func (m *RecordAccumulatorProofRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordAccumulatorProofRequest) ProtoMessage()    {}
+func (*RecordAccumulatorProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{25}
+}
+func (m *RecordAccumulatorProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordAccumulatorProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordAccumulatorProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RecordAccumulatorProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordAccumulatorProofRequest.Merge(m, src)
+}
+func (m *RecordAccumulatorProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordAccumulatorProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordAccumulatorProofRequest.DiscardUnknown(m)
 }
 
-func (m *RecordSequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecordAccumulatorProofRequest proto.InternalMessageInfo
+
+func (m *RecordAccumulatorProofRequest) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RecordSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecordAccumulatorProofResponse is the response type for the
+// GetRecordAccumulatorProof query.
+type RecordAccumulatorProofResponse struct {
+	// The pruned event record.
+	Record EventRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// Index of the record leaf in the accumulator.
+	LeafIndex uint64 `protobuf:"varint,2,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// Sibling hashes from the record leaf up to its mountain peak.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	// The accumulator the proof is for.
+	Accumulator RecordAccumulator `protobuf:"bytes,4,opt,name=accumulator,proto3" json:"accumulator"`
+	// Root hash of the accumulator.
+	Root []byte `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *RecordAccumulatorProofResponse) Reset()         { *m = RecordAccumulatorProofResponse{} }
+func (m *RecordAccumulatorProofResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordAccumulatorProofResponse) ProtoMessage()    {}
+func (*RecordAccumulatorProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{26}
+}
+func (m *RecordAccumulatorProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *RecordSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
-		i--
-		dAtA[i] = 0x10
+func (m *RecordAccumulatorProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordAccumulatorProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RecordAccumulatorProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordAccumulatorProofResponse.Merge(m, src)
+}
+func (m *RecordAccumulatorProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordAccumulatorProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordAccumulatorProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordAccumulatorProofResponse proto.InternalMessageInfo
+
+func (m *RecordAccumulatorProofResponse) GetRecord() EventRecord {
+	if m != nil {
+		return m.Record
 	}
-	return len(dAtA) - i, nil
+	return EventRecord{}
 }
 
-func (m *RecordSequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecordAccumulatorProofResponse) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RecordSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RecordAccumulatorProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *RecordSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (m *RecordAccumulatorProofResponse) GetAccumulator() RecordAccumulator {
+	if m != nil {
+		return m.Accumulator
 	}
-	return len(dAtA) - i, nil
+	return RecordAccumulator{}
 }
 
-func (m *IsClerkTxOldResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecordAccumulatorProofResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IsClerkTxOldResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BorConsumedRecordIdRequest is the request type for the
+// GetBorConsumedRecordId query.
+type BorConsumedRecordIdRequest struct {
 }
 
-func (m *IsClerkTxOldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsOld {
-		i--
-		if m.IsOld {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BorConsumedRecordIdRequest) Reset()         { *m = BorConsumedRecordIdRequest{} }
+func (m *BorConsumedRecordIdRequest) String() string { return proto.CompactTextString(m) }
+func (*BorConsumedRecordIdRequest) ProtoMessage()    {}
+func (*BorConsumedRecordIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{27}
+}
+func (m *BorConsumedRecordIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BorConsumedRecordIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BorConsumedRecordIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BorConsumedRecordIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BorConsumedRecordIdRequest.Merge(m, src)
+}
+func (m *BorConsumedRecordIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BorConsumedRecordIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BorConsumedRecordIdRequest.DiscardUnknown(m)
 }
 
-func (m *LatestRecordIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BorConsumedRecordIdRequest proto.InternalMessageInfo
+
+// BorConsumedRecordIdResponse is the response type for the
+// GetBorConsumedRecordId query.
+type BorConsumedRecordIdResponse struct {
+	// ID of the last event record consumed by bor.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *BorConsumedRecordIdResponse) Reset()         { *m = BorConsumedRecordIdResponse{} }
+func (m *BorConsumedRecordIdResponse) String() string { return proto.CompactTextString(m) }
+func (*BorConsumedRecordIdResponse) ProtoMessage()    {}
+func (*BorConsumedRecordIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9015e8f88cd9cb1b, []int{28}
+}
+func (m *BorConsumedRecordIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BorConsumedRecordIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BorConsumedRecordIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BorConsumedRecordIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BorConsumedRecordIdResponse.Merge(m, src)
+}
+func (m *BorConsumedRecordIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BorConsumedRecordIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BorConsumedRecordIdResponse.DiscardUnknown(m)
 }
 
-func (m *LatestRecordIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BorConsumedRecordIdResponse proto.InternalMessageInfo
+
+func (m *BorConsumedRecordIdResponse) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
 }
 
-func (m *LatestRecordIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*RecordRequest)(nil), "heimdallv2.clerk.RecordRequest")
+	proto.RegisterType((*RecordResponse)(nil), "heimdallv2.clerk.RecordResponse")
+	proto.RegisterType((*RecordListRequest)(nil), "heimdallv2.clerk.RecordListRequest")
+	proto.RegisterType((*RecordListResponse)(nil), "heimdallv2.clerk.RecordListResponse")
+	proto.RegisterType((*RecordListWithTimeRequest)(nil), "heimdallv2.clerk.RecordListWithTimeRequest")
+	proto.RegisterType((*RecordListWithTimeResponse)(nil), "heimdallv2.clerk.RecordListWithTimeResponse")
+	proto.RegisterType((*RecordSequenceRequest)(nil), "heimdallv2.clerk.RecordSequenceRequest")
+	proto.RegisterType((*RecordSequenceResponse)(nil), "heimdallv2.clerk.RecordSequenceResponse")
+	proto.RegisterType((*IsClerkTxOldResponse)(nil), "heimdallv2.clerk.IsClerkTxOldResponse")
+	proto.RegisterType((*LatestRecordIdRequest)(nil), "heimdallv2.clerk.LatestRecordIdRequest")
+	proto.RegisterType((*LatestRecordIdResponse)(nil), "heimdallv2.clerk.LatestRecordIdResponse")
+	proto.RegisterType((*RecordCountRequest)(nil), "heimdallv2.clerk.RecordCountRequest")
+	proto.RegisterType((*RecordCountResponse)(nil), "heimdallv2.clerk.RecordCountResponse")
+	proto.RegisterType((*SubscribeRecordsRequest)(nil), "heimdallv2.clerk.SubscribeRecordsRequest")
+	proto.RegisterType((*SubscribeRecordsResponse)(nil), "heimdallv2.clerk.SubscribeRecordsResponse")
+	proto.RegisterType((*RecordsByContractRequest)(nil), "heimdallv2.clerk.RecordsByContractRequest")
+	proto.RegisterType((*RecordsByContractResponse)(nil), "heimdallv2.clerk.RecordsByContractResponse")
+	proto.RegisterType((*RecordsByTxHashRequest)(nil), "heimdallv2.clerk.RecordsByTxHashRequest")
+	proto.RegisterType((*RecordsByTxHashResponse)(nil), "heimdallv2.clerk.RecordsByTxHashResponse")
+	proto.RegisterType((*RecordsByVisibilityHeightRequest)(nil), "heimdallv2.clerk.RecordsByVisibilityHeightRequest")
+	proto.RegisterType((*RecordsByVisibilityHeightResponse)(nil), "heimdallv2.clerk.RecordsByVisibilityHeightResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdallv2.clerk.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdallv2.clerk.QueryParamsResponse")
+	proto.RegisterType((*RecordAccumulatorRequest)(nil), "heimdallv2.clerk.RecordAccumulatorRequest")
+	proto.RegisterType((*RecordAccumulatorResponse)(nil), "heimdallv2.clerk.RecordAccumulatorResponse")
+	proto.RegisterType((*RecordAccumulatorProofRequest)(nil), "heimdallv2.clerk.RecordAccumulatorProofRequest")
+	proto.RegisterType((*RecordAccumulatorProofResponse)(nil), "heimdallv2.clerk.RecordAccumulatorProofResponse")
+	proto.RegisterType((*BorConsumedRecordIdRequest)(nil), "heimdallv2.clerk.BorConsumedRecordIdRequest")
+	proto.RegisterType((*BorConsumedRecordIdResponse)(nil), "heimdallv2.clerk.BorConsumedRecordIdResponse")
 }
 
-func (m *LatestRecordIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("heimdallv2/clerk/query.proto", fileDescriptor_9015e8f88cd9cb1b) }
+
+var fileDescriptor_9015e8f88cd9cb1b = []byte{
+	// 1522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x4d, 0x9a, 0xbc, 0x34, 0x6d, 0x32, 0x4d, 0x1a, 0x67, 0x93, 0x3a, 0xce, 0x36,
+	0x4d, 0x43, 0x5a, 0xef, 0xb6, 0x2e, 0x1c, 0x50, 0x25, 0x44, 0x1d, 0xa1, 0x26, 0xa2, 0xa8, 0x69,
+	0x1a, 0x81, 0x04, 0x07, 0x6b, 0xbd, 0x9e, 0xd8, 0x4b, 0xd7, 0x3b, 0xee, 0xce, 0x38, 0xb2, 0x55,
+	0x55, 0x88, 0x1e, 0x50, 0x11, 0x08, 0x45, 0x70, 0xe2, 0xc8, 0x05, 0x71, 0x40, 0xa8, 0x5f, 0x81,
+	0x5b, 0x8f, 0x15, 0x5c, 0x38, 0x01, 0x6a, 0x91, 0xf8, 0x10, 0x5c, 0xd0, 0xce, 0xcc, 0x7a, 0x77,
+	0xbd, 0xeb, 0xc4, 0x91, 0x92, 0x4b, 0xe4, 0xbe, 0x3f, 0xf3, 0x7e, 0xef, 0xcd, 0x7b, 0xf3, 0x7e,
+	0x5b, 0x58, 0xa8, 0x61, 0xbb, 0x5e, 0x31, 0x1d, 0x67, 0xaf, 0x60, 0x58, 0x0e, 0xf6, 0x1e, 0x1a,
+	0x8f, 0x9a, 0xd8, 0x6b, 0xeb, 0x0d, 0x8f, 0x30, 0x82, 0x26, 0x43, 0xad, 0xce, 0xb5, 0xea, 0x94,
+	0x59, 0xb7, 0x5d, 0x62, 0xf0, 0xbf, 0xc2, 0x48, 0x5d, 0xb3, 0x08, 0xad, 0x13, 0x6a, 0x94, 0x4d,
+	0x8a, 0x85, 0xb7, 0xb1, 0x77, 0xa3, 0x8c, 0x99, 0x79, 0xc3, 0x68, 0x98, 0x55, 0xdb, 0x35, 0x99,
+	0x4d, 0x5c, 0x69, 0x3b, 0x2f, 0x6d, 0x03, 0xb3, 0x68, 0x34, 0x75, 0xba, 0x4a, 0xaa, 0x84, 0xff,
+	0x34, 0xfc, 0x5f, 0x52, 0xba, 0x50, 0x25, 0xa4, 0xea, 0x60, 0xc3, 0x6c, 0xd8, 0x86, 0xe9, 0xba,
+	0x84, 0xf1, 0xf3, 0xa8, 0xd4, 0x2e, 0x4a, 0x2d, 0xff, 0x57, 0xb9, 0xb9, 0x6b, 0x30, 0xbb, 0x8e,
+	0x29, 0x33, 0xeb, 0x8d, 0xc0, 0x3d, 0x91, 0x20, 0xff, 0x2b, 0xb4, 0xda, 0x4d, 0x98, 0xd8, 0xc6,
+	0x16, 0xf1, 0x2a, 0xdb, 0xf8, 0x51, 0x13, 0x53, 0x86, 0x34, 0x18, 0xf3, 0xb8, 0xa0, 0x64, 0x57,
+	0x32, 0x4a, 0x4e, 0x59, 0x3d, 0x55, 0x1c, 0xfe, 0xe9, 0xdf, 0xe7, 0x6b, 0xca, 0xf6, 0xa8, 0x90,
+	0x6f, 0x56, 0xb4, 0x6d, 0x38, 0x1b, 0x38, 0xd1, 0x06, 0x71, 0x29, 0x46, 0xef, 0xc2, 0x88, 0xd0,
+	0x72, 0x97, 0xf1, 0xc2, 0x45, 0xbd, 0xbb, 0x70, 0xfa, 0x7b, 0x7b, 0xd8, 0x65, 0xc2, 0xad, 0x38,
+	0xf6, 0xe2, 0xcf, 0xc5, 0x01, 0x71, 0xaa, 0xf4, 0xd3, 0xde, 0x87, 0x29, 0xa1, 0xbc, 0x6b, 0x53,
+	0x16, 0x80, 0x99, 0x83, 0x53, 0x0d, 0xb3, 0x8a, 0xe3, 0x38, 0xb8, 0x08, 0xcd, 0xc3, 0xb0, 0x63,
+	0xd7, 0x6d, 0x96, 0x19, 0x8c, 0xea, 0x84, 0x4c, 0xb3, 0x00, 0x45, 0x0f, 0x93, 0x20, 0x3f, 0x80,
+	0x09, 0xec, 0x83, 0x28, 0x89, 0x90, 0x34, 0xa3, 0xe4, 0x86, 0x8e, 0x84, 0xf5, 0x0c, 0x0e, 0xe5,
+	0x54, 0xfb, 0x4d, 0x81, 0xb9, 0x30, 0xca, 0x47, 0x36, 0xab, 0xed, 0xd8, 0x75, 0x1c, 0x40, 0xcf,
+	0xc2, 0xe9, 0x5d, 0x8f, 0xd4, 0x13, 0x55, 0x1c, 0xf1, 0xa5, 0x9b, 0x15, 0x54, 0x84, 0xd3, 0x8c,
+	0x94, 0xfc, 0xcb, 0xe2, 0x19, 0x8c, 0x17, 0x54, 0x5d, 0xdc, 0xa4, 0x1e, 0xdc, 0xa4, 0xbe, 0x13,
+	0xdc, 0x64, 0x71, 0xc2, 0xc7, 0xb0, 0xff, 0xd7, 0xa2, 0x22, 0xcf, 0x60, 0xc4, 0xd7, 0xa1, 0xfb,
+	0x00, 0x61, 0x83, 0x65, 0x86, 0xf8, 0x31, 0x2b, 0xba, 0xe8, 0x30, 0xdd, 0xef, 0x46, 0x5d, 0x74,
+	0x97, 0xec, 0x46, 0x7d, 0xcb, 0xac, 0x06, 0xf8, 0xa2, 0x69, 0x45, 0x0e, 0xd1, 0x1e, 0x82, 0x9a,
+	0x96, 0xd3, 0xc9, 0x54, 0xf0, 0x13, 0x98, 0x11, 0x3f, 0x1f, 0xf8, 0xa0, 0x5c, 0x2b, 0x5a, 0x3c,
+	0xd6, 0x2a, 0xd5, 0x4c, 0x5a, 0xe3, 0xc5, 0x1b, 0xeb, 0x14, 0x8f, 0xb5, 0x36, 0x4c, 0x5a, 0xf3,
+	0x9b, 0xd4, 0x21, 0xd5, 0x92, 0xed, 0x56, 0x70, 0x2b, 0xde, 0x00, 0xa3, 0x0e, 0xa9, 0x6e, 0xfa,
+	0x62, 0xed, 0x16, 0x5c, 0xe8, 0x3e, 0x5c, 0x66, 0xb1, 0x04, 0xa3, 0x54, 0xca, 0xba, 0x3a, 0x3c,
+	0x10, 0x6b, 0x6f, 0xc2, 0xf4, 0x26, 0x5d, 0xf7, 0x33, 0xd9, 0x69, 0xdd, 0x73, 0xc2, 0x3e, 0x5f,
+	0x80, 0x11, 0x9b, 0x96, 0x88, 0x23, 0x2e, 0x75, 0xb4, 0xd3, 0x76, 0x36, 0xbd, 0xe7, 0x54, 0xb4,
+	0x59, 0x98, 0xb9, 0x6b, 0x32, 0x4c, 0x65, 0x82, 0x9b, 0xc1, 0x50, 0x69, 0x5f, 0x2a, 0x70, 0xa1,
+	0x5b, 0x23, 0x4f, 0x34, 0x60, 0xd2, 0xe1, 0x9a, 0x52, 0x8f, 0xb1, 0x3b, 0xeb, 0xc4, 0x1c, 0xd1,
+	0x3b, 0x90, 0xb1, 0x69, 0xa9, 0xe1, 0x11, 0x0b, 0x53, 0x8a, 0x2b, 0xa5, 0x72, 0xbb, 0x14, 0x54,
+	0x3f, 0x33, 0x18, 0x05, 0x35, 0x63, 0xd3, 0xad, 0xc0, 0xaa, 0xd8, 0xde, 0x90, 0x36, 0xda, 0x74,
+	0x30, 0x1b, 0xeb, 0xa4, 0xe9, 0x06, 0x93, 0xa6, 0x15, 0xe0, 0x7c, 0x4c, 0x2a, 0xd1, 0xcd, 0xc3,
+	0xb0, 0xe5, 0x0b, 0xe2, 0x90, 0x84, 0x4c, 0x7b, 0x1b, 0x66, 0x1f, 0x34, 0xcb, 0xd4, 0xf2, 0xec,
+	0x32, 0x96, 0x57, 0xda, 0x67, 0xf7, 0x6b, 0xfb, 0x0a, 0x64, 0x92, 0xbe, 0xc7, 0xf5, 0x98, 0xa0,
+	0x02, 0x4c, 0xed, 0xd9, 0xd4, 0x2e, 0xdb, 0x8e, 0xcd, 0x78, 0x79, 0xaa, 0xb5, 0xae, 0x87, 0x62,
+	0x32, 0xd4, 0x6f, 0x70, 0xb5, 0xf6, 0x5c, 0x81, 0x8c, 0x44, 0x52, 0x6c, 0xaf, 0x13, 0x97, 0x79,
+	0xa6, 0xd5, 0x79, 0x88, 0x96, 0x60, 0xd4, 0x92, 0xa2, 0x78, 0x47, 0x76, 0xc4, 0xd1, 0x94, 0x07,
+	0xd3, 0x06, 0xfe, 0x04, 0x86, 0xf5, 0x53, 0x98, 0x4b, 0x41, 0x7c, 0x32, 0xb3, 0xfa, 0x95, 0x12,
+	0xcc, 0x13, 0x2d, 0xb6, 0x77, 0xf8, 0x18, 0xf6, 0x3b, 0xad, 0xf1, 0xcc, 0x07, 0x8f, 0x23, 0xf3,
+	0x1a, 0xcc, 0x26, 0xc0, 0x9c, 0x4c, 0xde, 0xbf, 0x2a, 0x90, 0xeb, 0x84, 0xfa, 0xb0, 0xab, 0x69,
+	0x82, 0x0a, 0xac, 0xc0, 0x38, 0xbf, 0x7b, 0xd9, 0x69, 0xb1, 0x96, 0x07, 0x5f, 0x23, 0xcc, 0xfd,
+	0x77, 0x8b, 0x91, 0xd4, 0x7e, 0x1c, 0x65, 0x44, 0xda, 0x9c, 0x40, 0x9f, 0x78, 0xb0, 0x74, 0x40,
+	0x0a, 0x27, 0x53, 0xb7, 0x69, 0x40, 0xf7, 0x7d, 0x9c, 0x5b, 0xa6, 0x67, 0xd6, 0x83, 0x77, 0x41,
+	0xdb, 0x86, 0xf3, 0x31, 0xa9, 0x8c, 0x7d, 0x0b, 0x46, 0x1a, 0x5c, 0x22, 0x27, 0x3e, 0x93, 0x0c,
+	0x2a, 0x3c, 0x62, 0xc3, 0x2e, 0x5c, 0x34, 0x35, 0x98, 0xdb, 0xdb, 0x96, 0xd5, 0xac, 0x37, 0x1d,
+	0x93, 0x11, 0x2f, 0x88, 0xf7, 0xac, 0xb3, 0xa3, 0x63, 0x4a, 0x19, 0x76, 0x0b, 0xc6, 0xcd, 0x50,
+	0x2c, 0x63, 0x5f, 0x4a, 0xc6, 0x4e, 0x9c, 0x10, 0x85, 0x11, 0x3d, 0xc2, 0x27, 0x2c, 0x1e, 0x21,
+	0xe2, 0x6e, 0xcf, 0x74, 0x08, 0x8b, 0x2f, 0xd2, 0xd6, 0xe1, 0x62, 0xe2, 0x9c, 0x2d, 0x8f, 0x90,
+	0xdd, 0xa3, 0x30, 0xaf, 0x6f, 0x07, 0x21, 0xdb, 0xeb, 0x94, 0x63, 0x7b, 0x3d, 0x97, 0x01, 0x1c,
+	0x6c, 0xee, 0xa6, 0xad, 0xd7, 0x31, 0x5f, 0xc1, 0xf7, 0xab, 0xbf, 0x1a, 0x1a, 0x7e, 0xe0, 0xcc,
+	0x50, 0x6e, 0x28, 0xcc, 0x55, 0xc8, 0xba, 0x2b, 0x7b, 0xea, 0xf8, 0x2a, 0x3b, 0x9c, 0xac, 0xec,
+	0x02, 0xa8, 0x45, 0xe2, 0xad, 0x13, 0x97, 0x36, 0xeb, 0xb8, 0xd2, 0xbd, 0x7b, 0x6f, 0xc3, 0x7c,
+	0xaa, 0x56, 0x96, 0xab, 0x8f, 0xaa, 0x17, 0xfe, 0x9b, 0x84, 0x61, 0xde, 0xb6, 0xe8, 0x0b, 0x05,
+	0xce, 0xde, 0xc1, 0x2c, 0xb2, 0x2a, 0xd1, 0x72, 0xaf, 0xac, 0xa2, 0xfb, 0x55, 0xbd, 0x7c, 0x88,
+	0x95, 0x40, 0xa3, 0x5d, 0x79, 0xe6, 0x87, 0x7e, 0xfa, 0xfb, 0x3f, 0xdf, 0x0d, 0x2e, 0x20, 0x55,
+	0xf2, 0x75, 0x3e, 0x57, 0x79, 0x39, 0x96, 0x06, 0xdf, 0xbd, 0xe8, 0xa9, 0x02, 0x13, 0x1d, 0x20,
+	0x3e, 0x57, 0x43, 0x3d, 0xab, 0x1b, 0x21, 0xd4, 0xea, 0xf2, 0xc1, 0x46, 0x12, 0xc5, 0x4a, 0x88,
+	0x62, 0x1e, 0xcd, 0xa5, 0xa2, 0x70, 0xfc, 0x90, 0xdf, 0x28, 0x30, 0x75, 0x07, 0xb3, 0x38, 0xb3,
+	0x41, 0x57, 0x92, 0x31, 0x52, 0x59, 0x91, 0xba, 0x7a, 0xb8, 0x61, 0x00, 0x88, 0x63, 0xc9, 0xa1,
+	0x6c, 0x3a, 0x16, 0xee, 0x94, 0xb7, 0x2b, 0xe8, 0xf3, 0x68, 0x55, 0x8a, 0xed, 0xcd, 0x0a, 0x5a,
+	0xec, 0x95, 0x70, 0x00, 0x22, 0xd7, 0xdb, 0x40, 0x06, 0xcf, 0x87, 0xd5, 0xd0, 0x50, 0x2e, 0x15,
+	0xc1, 0xe3, 0x4e, 0x0b, 0x3d, 0x41, 0x5f, 0x2b, 0x30, 0x13, 0xbb, 0x99, 0x80, 0x45, 0xa3, 0xab,
+	0x07, 0x15, 0xbf, 0xeb, 0xfb, 0x41, 0xbd, 0xd6, 0x9f, 0xb1, 0xc4, 0x98, 0x09, 0x31, 0x4e, 0xa0,
+	0x71, 0x89, 0xd1, 0xff, 0xb8, 0x40, 0x9f, 0xf1, 0x2b, 0x8a, 0x33, 0xe1, 0xb4, 0x2b, 0x4a, 0x25,
+	0xe2, 0xea, 0xea, 0xe1, 0x86, 0x12, 0xc1, 0x2c, 0x0f, 0x3e, 0x85, 0xce, 0xc9, 0xe0, 0x01, 0x95,
+	0x46, 0x6d, 0x38, 0x13, 0xa5, 0xd2, 0xfd, 0xc7, 0x5e, 0x49, 0x1a, 0xa6, 0x71, 0x72, 0x2d, 0xc3,
+	0x23, 0x23, 0x34, 0x29, 0x23, 0xdb, 0x34, 0x4f, 0x9c, 0x4a, 0x9e, 0xb5, 0xd0, 0x8f, 0x0a, 0x4c,
+	0x77, 0x92, 0x8f, 0x70, 0x24, 0xb4, 0xd6, 0x0b, 0x43, 0x92, 0xfa, 0xa9, 0x57, 0xfb, 0xb2, 0x95,
+	0x58, 0xde, 0x0a, 0xef, 0x61, 0x0d, 0xad, 0xf6, 0x98, 0x5f, 0xe1, 0x63, 0x3c, 0x0e, 0x7e, 0x3d,
+	0x41, 0xdf, 0x2b, 0x80, 0xa2, 0x40, 0x05, 0xa5, 0x41, 0xab, 0x07, 0x84, 0x8e, 0x51, 0x30, 0xf5,
+	0x8d, 0x3e, 0x2c, 0x25, 0x44, 0x3d, 0x84, 0x78, 0x09, 0x2d, 0xa5, 0x42, 0x64, 0x2d, 0xe3, 0xb1,
+	0x64, 0x74, 0x4f, 0xd0, 0x2f, 0x0a, 0x2c, 0x44, 0xb1, 0x75, 0x13, 0x08, 0x54, 0x38, 0x20, 0x76,
+	0x0f, 0xc2, 0xa4, 0xde, 0x3c, 0x92, 0x8f, 0x44, 0x7e, 0x39, 0x44, 0xae, 0xa2, 0x8c, 0x44, 0x1e,
+	0xf2, 0xf8, 0xbc, 0xe0, 0x55, 0xa8, 0xcd, 0x9f, 0x68, 0xde, 0x28, 0x82, 0x34, 0xa4, 0x3d, 0xd1,
+	0x49, 0x6e, 0xa2, 0x5e, 0x3e, 0xc4, 0x4a, 0xa2, 0x50, 0x43, 0x14, 0xe7, 0xd0, 0x84, 0x44, 0x21,
+	0xa8, 0x08, 0xda, 0x8f, 0x36, 0x5c, 0x64, 0xab, 0xf5, 0x6e, 0xb8, 0x24, 0x67, 0x51, 0xaf, 0xf6,
+	0x65, 0x2b, 0xd1, 0x2c, 0x86, 0x68, 0xa6, 0x11, 0x92, 0x68, 0xa2, 0x7b, 0xf3, 0x67, 0x05, 0xe6,
+	0xd2, 0x20, 0x71, 0xd2, 0x80, 0x8c, 0x3e, 0x62, 0x45, 0x49, 0x8a, 0x7a, 0xbd, 0x7f, 0x07, 0x89,
+	0xf0, 0x1a, 0x07, 0xb7, 0x82, 0x96, 0x93, 0xe0, 0x0c, 0x4e, 0x16, 0x62, 0xaf, 0xe7, 0x0f, 0x0a,
+	0x5c, 0xb8, 0x83, 0x59, 0xca, 0xc6, 0x46, 0x29, 0x2f, 0x62, 0xef, 0xb5, 0xaf, 0xe6, 0xfb, 0xb4,
+	0x96, 0x28, 0xd7, 0xc2, 0x3a, 0x2e, 0xa2, 0x8b, 0x12, 0x6a, 0x99, 0x78, 0x79, 0x4b, 0x7a, 0xc8,
+	0xe1, 0xf0, 0xb7, 0x4c, 0x1d, 0x26, 0xbb, 0xbf, 0x5d, 0x51, 0xca, 0x00, 0xf6, 0xf8, 0x36, 0x56,
+	0xd7, 0xfa, 0x31, 0x95, 0xb0, 0x06, 0xae, 0x2b, 0xc5, 0x8d, 0x17, 0xaf, 0xb2, 0xca, 0xcb, 0x57,
+	0x59, 0xe5, 0xef, 0x57, 0x59, 0x65, 0xff, 0x75, 0x76, 0xe0, 0xe5, 0xeb, 0xec, 0xc0, 0x1f, 0xaf,
+	0xb3, 0x03, 0x1f, 0xeb, 0x55, 0x9b, 0xd5, 0x9a, 0x65, 0xdd, 0x22, 0x75, 0xe3, 0x7a, 0x6b, 0x8b,
+	0x38, 0xed, 0x2a, 0x71, 0x8d, 0xe0, 0xf4, 0xfc, 0x5e, 0xc1, 0x68, 0x05, 0xab, 0xa0, 0xdd, 0xc0,
+	0xb4, 0x3c, 0xc2, 0xff, 0x6b, 0xe9, 0xe6, 0xff, 0x03, 0x00, 0x76, 0x17, 0xfc, 0x74, 0xf4, 0x14,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetRecordCount queries the total number of event records.
+	GetRecordCount(ctx context.Context, in *RecordCountRequest, opts ...grpc.CallOption) (*RecordCountResponse, error)
+	// GetRecordList queries a paginated list of event records.
+	GetRecordList(ctx context.Context, in *RecordListRequest, opts ...grpc.CallOption) (*RecordListResponse, error)
+	// GetLatestRecordId queries the latest event record ID from the root chain.
+	GetLatestRecordId(ctx context.Context, in *LatestRecordIdRequest, opts ...grpc.CallOption) (*LatestRecordIdResponse, error)
+	// GetRecordById retrieves a specific event record by its ID.
+	GetRecordById(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
+	// GetRecordListWithTime queries event records within a time range.
+	GetRecordListWithTime(ctx context.Context, in *RecordListWithTimeRequest, opts ...grpc.CallOption) (*RecordListWithTimeResponse, error)
+	// GetRecordSequence queries the sequence number for a specific event record.
+	GetRecordSequence(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*RecordSequenceResponse, error)
+	// IsClerkTxOld checks if a clerk transaction has already been submitted.
+	IsClerkTxOld(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*IsClerkTxOldResponse, error)
+	// GetRecordsByContract queries the event records targeting a receiver
+	// contract, in ID order from the given ID.
+	GetRecordsByContract(ctx context.Context, in *RecordsByContractRequest, opts ...grpc.CallOption) (*RecordsByContractResponse, error)
+	// GetRecordsByTxHash queries the event records emitted by a root chain
+	// transaction.
+	GetRecordsByTxHash(ctx context.Context, in *RecordsByTxHashRequest, opts ...grpc.CallOption) (*RecordsByTxHashResponse, error)
+	// GetRecordsByVisibilityHeight queries the event records which became
+	// visible within a range of heimdall heights.
+	GetRecordsByVisibilityHeight(ctx context.Context, in *RecordsByVisibilityHeightRequest, opts ...grpc.CallOption) (*RecordsByVisibilityHeightResponse, error)
+	// GetClerkParams queries the clerk module parameters.
+	GetClerkParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetRecordAccumulator queries the accumulator over the pruned event records.
+	GetRecordAccumulator(ctx context.Context, in *RecordAccumulatorRequest, opts ...grpc.CallOption) (*RecordAccumulatorResponse, error)
+	// GetRecordAccumulatorProof queries a pruned event record and its inclusion
+	// proof in the accumulator. It is only served by nodes keeping a record
+	// archive.
+	GetRecordAccumulatorProof(ctx context.Context, in *RecordAccumulatorProofRequest, opts ...grpc.CallOption) (*RecordAccumulatorProofResponse, error)
+	// GetBorConsumedRecordId queries the ID of the last event record consumed by
+	// bor.
+	GetBorConsumedRecordId(ctx context.Context, in *BorConsumedRecordIdRequest, opts ...grpc.CallOption) (*BorConsumedRecordIdResponse, error)
+	// SubscribeRecords streams the event records in ID order from the given ID,
+	// each one once its visibility height is assigned. It is served on gRPC
+	// only; the REST server bridges it to server-sent events on
+	// /clerk/event-records/subscribe.
+	SubscribeRecords(ctx context.Context, in *SubscribeRecordsRequest, opts ...grpc.CallOption) (Query_SubscribeRecordsClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetRecordCount(ctx context.Context, in *RecordCountRequest, opts ...grpc.CallOption) (*RecordCountResponse, error) {
+	out := new(RecordCountResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LatestRecordIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordList(ctx context.Context, in *RecordListRequest, opts ...grpc.CallOption) (*RecordListResponse, error) {
+	out := new(RecordListResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LatestRecordIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsProcessedByHeimdall {
-		i--
-		if m.IsProcessedByHeimdall {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) GetLatestRecordId(ctx context.Context, in *LatestRecordIdRequest, opts ...grpc.CallOption) (*LatestRecordIdResponse, error) {
+	out := new(LatestRecordIdResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetLatestRecordId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LatestRecordId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LatestRecordId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) GetRecordById(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
+	out := new(RecordResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordListWithTime(ctx context.Context, in *RecordListWithTimeRequest, opts ...grpc.CallOption) (*RecordListWithTimeResponse, error) {
+	out := new(RecordListWithTimeResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordListWithTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RecordCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordSequence(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*RecordSequenceResponse, error) {
+	out := new(RecordSequenceResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) IsClerkTxOld(ctx context.Context, in *RecordSequenceRequest, opts ...grpc.CallOption) (*IsClerkTxOldResponse, error) {
+	out := new(IsClerkTxOldResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/IsClerkTxOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordsByContract(ctx context.Context, in *RecordsByContractRequest, opts ...grpc.CallOption) (*RecordsByContractResponse, error) {
+	out := new(RecordsByContractResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordsByContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RecordCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordsByTxHash(ctx context.Context, in *RecordsByTxHashRequest, opts ...grpc.CallOption) (*RecordsByTxHashResponse, error) {
+	out := new(RecordsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetRecordsByVisibilityHeight(ctx context.Context, in *RecordsByVisibilityHeightRequest, opts ...grpc.CallOption) (*RecordsByVisibilityHeightResponse, error) {
+	out := new(RecordsByVisibilityHeightResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordsByVisibilityHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) GetClerkParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetClerkParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *RecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) GetRecordAccumulator(ctx context.Context, in *RecordAccumulatorRequest, opts ...grpc.CallOption) (*RecordAccumulatorResponse, error) {
+	out := new(RecordAccumulatorResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordAccumulator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RecordId != 0 {
-		n += 1 + sovQuery(uint64(m.RecordId))
+	return out, nil
+}
+
+func (c *queryClient) GetRecordAccumulatorProof(ctx context.Context, in *RecordAccumulatorProofRequest, opts ...grpc.CallOption) (*RecordAccumulatorProofResponse, error) {
+	out := new(RecordAccumulatorProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetRecordAccumulatorProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetBorConsumedRecordId(ctx context.Context, in *BorConsumedRecordIdRequest, opts ...grpc.CallOption) (*BorConsumedRecordIdResponse, error) {
+	out := new(BorConsumedRecordIdResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Query/GetBorConsumedRecordId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *RecordListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SubscribeRecords(ctx context.Context, in *SubscribeRecordsRequest, opts ...grpc.CallOption) (Query_SubscribeRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/heimdallv2.clerk.Query/SubscribeRecords", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovQuery(uint64(m.Page))
+	x := &querySubscribeRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *RecordListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.EventRecords) > 0 {
-		for _, e := range m.EventRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+type Query_SubscribeRecordsClient interface {
+	Recv() (*SubscribeRecordsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RecordListWithTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FromId != 0 {
-		n += 1 + sovQuery(uint64(m.FromId))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Pagination.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type querySubscribeRecordsClient struct {
+	grpc.ClientStream
 }
 
-func (m *RecordListWithTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.EventRecords) > 0 {
-		for _, e := range m.EventRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (x *querySubscribeRecordsClient) Recv() (*SubscribeRecordsResponse, error) {
+	m := new(SubscribeRecordsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *RecordSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetRecordCount queries the total number of event records.
+	GetRecordCount(context.Context, *RecordCountRequest) (*RecordCountResponse, error)
+	// GetRecordList queries a paginated list of event records.
+	GetRecordList(context.Context, *RecordListRequest) (*RecordListResponse, error)
+	// GetLatestRecordId queries the latest event record ID from the root chain.
+	GetLatestRecordId(context.Context, *LatestRecordIdRequest) (*LatestRecordIdResponse, error)
+	// GetRecordById retrieves a specific event record by its ID.
+	GetRecordById(context.Context, *RecordRequest) (*RecordResponse, error)
+	// GetRecordListWithTime queries event records within a time range.
+	GetRecordListWithTime(context.Context, *RecordListWithTimeRequest) (*RecordListWithTimeResponse, error)
+	// GetRecordSequence queries the sequence number for a specific event record.
+	GetRecordSequence(context.Context, *RecordSequenceRequest) (*RecordSequenceResponse, error)
+	// IsClerkTxOld checks if a clerk transaction has already been submitted.
+	IsClerkTxOld(context.Context, *RecordSequenceRequest) (*IsClerkTxOldResponse, error)
+	// GetRecordsByContract queries the event records targeting a receiver
+	// contract, in ID order from the given ID.
+	GetRecordsByContract(context.Context, *RecordsByContractRequest) (*RecordsByContractResponse, error)
+	// GetRecordsByTxHash queries the event records emitted by a root chain
+	// transaction.
+	GetRecordsByTxHash(context.Context, *RecordsByTxHashRequest) (*RecordsByTxHashResponse, error)
+	// GetRecordsByVisibilityHeight queries the event records which became
+	// visible within a range of heimdall heights.
+	GetRecordsByVisibilityHeight(context.Context, *RecordsByVisibilityHeightRequest) (*RecordsByVisibilityHeightResponse, error)
+	// GetClerkParams queries the clerk module parameters.
+	GetClerkParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetRecordAccumulator queries the accumulator over the pruned event records.
+	GetRecordAccumulator(context.Context, *RecordAccumulatorRequest) (*RecordAccumulatorResponse, error)
+	// GetRecordAccumulatorProof queries a pruned event record and its inclusion
+	// proof in the accumulator. It is only served by nodes keeping a record
+	// archive.
+	GetRecordAccumulatorProof(context.Context, *RecordAccumulatorProofRequest) (*RecordAccumulatorProofResponse, error)
+	// GetBorConsumedRecordId queries the ID of the last event record consumed by
+	// bor.
+	GetBorConsumedRecordId(context.Context, *BorConsumedRecordIdRequest) (*BorConsumedRecordIdResponse, error)
+	// SubscribeRecords streams the event records in ID order from the given ID,
+	// each one once its visibility height is assigned. It is served on gRPC
+	// only; the REST server bridges it to server-sent events on
+	// /clerk/event-records/subscribe.
+	SubscribeRecords(*SubscribeRecordsRequest, Query_SubscribeRecordsServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetRecordCount(ctx context.Context, req *RecordCountRequest) (*RecordCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordCount not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordList(ctx context.Context, req *RecordListRequest) (*RecordListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordList not implemented")
+}
+func (*UnimplementedQueryServer) GetLatestRecordId(ctx context.Context, req *LatestRecordIdRequest) (*LatestRecordIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestRecordId not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordById(ctx context.Context, req *RecordRequest) (*RecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordById not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordListWithTime(ctx context.Context, req *RecordListWithTimeRequest) (*RecordListWithTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordListWithTime not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordSequence(ctx context.Context, req *RecordSequenceRequest) (*RecordSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordSequence not implemented")
+}
+func (*UnimplementedQueryServer) IsClerkTxOld(ctx context.Context, req *RecordSequenceRequest) (*IsClerkTxOldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsClerkTxOld not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordsByContract(ctx context.Context, req *RecordsByContractRequest) (*RecordsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByContract not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordsByTxHash(ctx context.Context, req *RecordsByTxHashRequest) (*RecordsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByTxHash not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordsByVisibilityHeight(ctx context.Context, req *RecordsByVisibilityHeightRequest) (*RecordsByVisibilityHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByVisibilityHeight not implemented")
+}
+func (*UnimplementedQueryServer) GetClerkParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClerkParams not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordAccumulator(ctx context.Context, req *RecordAccumulatorRequest) (*RecordAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordAccumulator not implemented")
+}
+func (*UnimplementedQueryServer) GetRecordAccumulatorProof(ctx context.Context, req *RecordAccumulatorProofRequest) (*RecordAccumulatorProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordAccumulatorProof not implemented")
+}
+func (*UnimplementedQueryServer) GetBorConsumedRecordId(ctx context.Context, req *BorConsumedRecordIdRequest) (*BorConsumedRecordIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorConsumedRecordId not implemented")
+}
+func (*UnimplementedQueryServer) SubscribeRecords(req *SubscribeRecordsRequest, srv Query_SubscribeRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRecords not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetRecordCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordCount(ctx, in)
 	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordCount",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordCount(ctx, req.(*RecordCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRecordList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordList(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordList(ctx, req.(*RecordListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsClerkTxOldResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetLatestRecordId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestRecordIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.IsOld {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).GetLatestRecordId(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetLatestRecordId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLatestRecordId(ctx, req.(*LatestRecordIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LatestRecordIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordById(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LatestRecordIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRecordListWithTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordListWithTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LatestRecordId != 0 {
-		n += 1 + sovQuery(uint64(m.LatestRecordId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordListWithTime(ctx, in)
 	}
-	if m.IsProcessedByHeimdall {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordListWithTime",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordListWithTime(ctx, req.(*RecordListWithTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRecordSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordSequence(ctx, req.(*RecordSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsClerkTxOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsClerkTxOld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/IsClerkTxOld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsClerkTxOld(ctx, req.(*RecordSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordsByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByContract(ctx, req.(*RecordsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordsByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByTxHash(ctx, req.(*RecordsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByVisibilityHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByVisibilityHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByVisibilityHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordsByVisibilityHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByVisibilityHeight(ctx, req.(*RecordsByVisibilityHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClerkParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClerkParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetClerkParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClerkParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordAccumulator(ctx, req.(*RecordAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordAccumulatorProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAccumulatorProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordAccumulatorProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetRecordAccumulatorProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordAccumulatorProof(ctx, req.(*RecordAccumulatorProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBorConsumedRecordId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BorConsumedRecordIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorConsumedRecordId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Query/GetBorConsumedRecordId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorConsumedRecordId(ctx, req.(*BorConsumedRecordIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeRecords(m, &querySubscribeRecordsServer{stream})
+}
+
+type Query_SubscribeRecordsServer interface {
+	Send(*SubscribeRecordsResponse) error
+	grpc.ServerStream
+}
+
+type querySubscribeRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeRecordsServer) Send(m *SubscribeRecordsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.clerk.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRecordCount",
+			Handler:    _Query_GetRecordCount_Handler,
+		},
+		{
+			MethodName: "GetRecordList",
+			Handler:    _Query_GetRecordList_Handler,
+		},
+		{
+			MethodName: "GetLatestRecordId",
+			Handler:    _Query_GetLatestRecordId_Handler,
+		},
+		{
+			MethodName: "GetRecordById",
+			Handler:    _Query_GetRecordById_Handler,
+		},
+		{
+			MethodName: "GetRecordListWithTime",
+			Handler:    _Query_GetRecordListWithTime_Handler,
+		},
+		{
+			MethodName: "GetRecordSequence",
+			Handler:    _Query_GetRecordSequence_Handler,
+		},
+		{
+			MethodName: "IsClerkTxOld",
+			Handler:    _Query_IsClerkTxOld_Handler,
+		},
+		{
+			MethodName: "GetRecordsByContract",
+			Handler:    _Query_GetRecordsByContract_Handler,
+		},
+		{
+			MethodName: "GetRecordsByTxHash",
+			Handler:    _Query_GetRecordsByTxHash_Handler,
+		},
+		{
+			MethodName: "GetRecordsByVisibilityHeight",
+			Handler:    _Query_GetRecordsByVisibilityHeight_Handler,
+		},
+		{
+			MethodName: "GetClerkParams",
+			Handler:    _Query_GetClerkParams_Handler,
+		},
+		{
+			MethodName: "GetRecordAccumulator",
+			Handler:    _Query_GetRecordAccumulator_Handler,
+		},
+		{
+			MethodName: "GetRecordAccumulatorProof",
+			Handler:    _Query_GetRecordAccumulatorProof_Handler,
+		},
+		{
+			MethodName: "GetBorConsumedRecordId",
+			Handler:    _Query_GetBorConsumedRecordId_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeRecords",
+			Handler:       _Query_SubscribeRecords_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "heimdallv2/clerk/query.proto",
+}
+
+func (m *RecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordListWithTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordListWithTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordListWithTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.FromId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordListWithTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordListWithTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordListWithTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IsClerkTxOldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsClerkTxOldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsClerkTxOldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOld {
+		i--
+		if m.IsOld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestRecordIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestRecordIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestRecordIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestRecordIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestRecordIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestRecordIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsProcessedByHeimdall {
+		i--
+		if m.IsProcessedByHeimdall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LatestRecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VisibilityHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VisibilityHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FromId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByVisibilityHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByVisibilityHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByVisibilityHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByVisibilityHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByVisibilityHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByVisibilityHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for iNdEx := len(m.EventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordAccumulatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordAccumulatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordAccumulatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordAccumulatorProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordAccumulatorProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordAccumulatorProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordAccumulatorProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordAccumulatorProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordAccumulatorProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BorConsumedRecordIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorConsumedRecordIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorConsumedRecordIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BorConsumedRecordIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorConsumedRecordIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorConsumedRecordIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovQuery(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *RecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *RecordListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordListWithTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordListWithTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *RecordSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *IsClerkTxOldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsOld {
+		n += 2
+	}
+	return n
+}
+
+func (m *LatestRecordIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LatestRecordIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.LatestRecordId))
+	}
+	if m.IsProcessedByHeimdall {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecordCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RecordCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *SubscribeRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	return n
+}
+
+func (m *SubscribeRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.VisibilityHeight != 0 {
+		n += 1 + sovQuery(uint64(m.VisibilityHeight))
+	}
+	return n
+}
+
+func (m *RecordsByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordsByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordsByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordsByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordsByVisibilityHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordsByVisibilityHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecordAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RecordAccumulatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accumulator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordAccumulatorProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovQuery(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *RecordAccumulatorProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LeafIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LeafIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Accumulator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BorConsumedRecordIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BorConsumedRecordIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovQuery(uint64(m.RecordId))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventRecords = append(m.EventRecords, EventRecord{})
+			if err := m.EventRecords[len(m.EventRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordListWithTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordListWithTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordListWithTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordListWithTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordListWithTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventRecords = append(m.EventRecords, EventRecord{})
+			if err := m.EventRecords[len(m.EventRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsClerkTxOldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsClerkTxOldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsClerkTxOldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOld = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestRecordIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestRecordIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestRecordIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestRecordIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestRecordIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestRecordId", wireType)
+			}
+			m.LatestRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsProcessedByHeimdall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsProcessedByHeimdall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+			}
+			m.FromId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityHeight", wireType)
+			}
+			m.VisibilityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VisibilityHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *RecordCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RecordRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsByContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1685,17 +4914,49 @@ func (m *RecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-			m.RecordId = 0
+			m.FromId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1705,11 +4966,44 @@ func (m *RecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordId |= uint64(b&0x7F) << shift
+				m.FromId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1731,7 +5025,7 @@ func (m *RecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsByContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,15 +5048,15 @@ func (m *RecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1789,7 +5083,8 @@ func (m *RecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EventRecords = append(m.EventRecords, EventRecord{})
+			if err := m.EventRecords[len(m.EventRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1814,7 +5109,7 @@ func (m *RecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsByTxHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,17 +5132,17 @@ func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByTxHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1857,16 +5152,29 @@ func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1876,11 +5184,25 @@ func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1902,7 +5224,7 @@ func (m *RecordListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordListResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsByTxHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,10 +5247,10 @@ func (m *RecordListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByTxHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1986,7 +5308,7 @@ func (m *RecordListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsByVisibilityHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2009,17 +5331,17 @@ func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordListWithTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByVisibilityHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordListWithTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByVisibilityHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.FromId = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2029,16 +5351,16 @@ func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromId |= uint64(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			var msglen int
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2048,25 +5370,11 @@ func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -2121,7 +5429,7 @@ func (m *RecordListWithTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordListWithTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsByVisibilityHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2144,10 +5452,10 @@ func (m *RecordListWithTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordListWithTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByVisibilityHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordListWithTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByVisibilityHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2205,7 +5513,57 @@ func (m *RecordListWithTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2228,17 +5586,17 @@ func (m *RecordSequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2248,43 +5606,25 @@ func (m *RecordSequenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
-			}
-			m.LogIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2306,7 +5646,7 @@ func (m *RecordSequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordAccumulatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2329,31 +5669,12 @@ func (m *RecordSequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordAccumulatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2375,7 +5696,7 @@ func (m *RecordSequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsClerkTxOldResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordAccumulatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,17 +5719,17 @@ func (m *IsClerkTxOldResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsClerkTxOldResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordAccumulatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsClerkTxOldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordAccumulatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOld", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2418,12 +5739,59 @@ func (m *IsClerkTxOldResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOld = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2445,7 +5813,7 @@ func (m *IsClerkTxOldResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestRecordIdRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordAccumulatorProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2468,12 +5836,31 @@ func (m *LatestRecordIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestRecordIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordAccumulatorProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestRecordIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordAccumulatorProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2495,7 +5882,7 @@ func (m *LatestRecordIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordAccumulatorProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2518,17 +5905,17 @@ func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestRecordIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordAccumulatorProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestRecordIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordAccumulatorProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestRecordId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			m.LatestRecordId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2538,16 +5925,30 @@ func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestRecordId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsProcessedByHeimdall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
 			}
-			var v int
+			m.LeafIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2557,12 +5958,110 @@ func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LeafIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsProcessedByHeimdall = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2584,7 +6083,7 @@ func (m *LatestRecordIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordCountRequest) Unmarshal(dAtA []byte) error {
+func (m *BorConsumedRecordIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2607,10 +6106,10 @@ func (m *RecordCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BorConsumedRecordIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BorConsumedRecordIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2634,7 +6133,7 @@ func (m *RecordCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordCountResponse) Unmarshal(dAtA []byte) error {
+func (m *BorConsumedRecordIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2657,17 +6156,17 @@ func (m *RecordCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BorConsumedRecordIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BorConsumedRecordIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			m.Count = 0
+			m.RecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2677,7 +6176,7 @@ func (m *RecordCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // NewEventRecord creates new record
@@ -24,3 +27,13 @@ func NewEventRecord(
 		RecordTime: recordTime,
 	}
 }
+
+// NormalizeContract returns the key of a contract address in the record indexes
+func NormalizeContract(contract string) string {
+	return strings.ToLower(common.HexToAddress(contract).Hex())
+}
+
+// NormalizeTxHash returns the key of a root chain tx hash in the record indexes
+func NormalizeTxHash(txHash string) string {
+	return strings.ToLower(common.HexToHash(txHash).Hex())
+}
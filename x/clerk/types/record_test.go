@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -154,3 +155,15 @@ func TestNewEventRecord(t *testing.T) {
 		require.Equal(t, 15, record.RecordTime.Day())
 	})
 }
+
+func TestNormalizeRecordIndexKeys(t *testing.T) {
+	t.Parallel()
+
+	contract := "0xA316fa9fa91700d7084D377bfdC81EB9F232F5fF"
+	require.Equal(t, strings.ToLower(contract), types.NormalizeContract(contract))
+	require.Equal(t, strings.ToLower(contract), types.NormalizeContract(contract[2:]))
+
+	txHash := "0x000000000000000000000000000000000000000000000000000000000000DEAD"
+	require.Equal(t, strings.ToLower(txHash), types.NormalizeTxHash(txHash))
+	require.Equal(t, strings.ToLower(txHash), types.NormalizeTxHash("dead"))
+}
@@ -121,45 +121,234 @@ func (m *MsgEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEventRecord proto.InternalMessageInfo
 
+// MsgRecordsConsumed defines the message for submitting the last event record
+// consumed by bor, as of a bor block finalized by a milestone.
+type MsgRecordsConsumed struct {
+	// Address of the validator submitting the message.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// ID of the last event record committed by the bor state receiver contract.
+	LastRecordId uint64 `protobuf:"varint,2,opt,name=last_record_id,json=lastRecordId,proto3" json:"last_record_id,omitempty"`
+	// Bor block at which the state receiver contract was read.
+	BorBlockNumber uint64 `protobuf:"varint,3,opt,name=bor_block_number,json=borBlockNumber,proto3" json:"bor_block_number,omitempty"`
+}
+
+func (m *MsgRecordsConsumed) Reset()         { *m = MsgRecordsConsumed{} }
+func (m *MsgRecordsConsumed) String() string { return proto.CompactTextString(m) }
+func (*MsgRecordsConsumed) ProtoMessage()    {}
+func (*MsgRecordsConsumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb0d5312093e6ca2, []int{2}
+}
+func (m *MsgRecordsConsumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecordsConsumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecordsConsumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecordsConsumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecordsConsumed.Merge(m, src)
+}
+func (m *MsgRecordsConsumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecordsConsumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecordsConsumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecordsConsumed proto.InternalMessageInfo
+
+// MsgRecordsConsumedResponse defines the response for HandleMsgRecordsConsumed.
+type MsgRecordsConsumedResponse struct {
+}
+
+func (m *MsgRecordsConsumedResponse) Reset()         { *m = MsgRecordsConsumedResponse{} }
+func (m *MsgRecordsConsumedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecordsConsumedResponse) ProtoMessage()    {}
+func (*MsgRecordsConsumedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb0d5312093e6ca2, []int{3}
+}
+func (m *MsgRecordsConsumedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecordsConsumedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecordsConsumedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecordsConsumedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecordsConsumedResponse.Merge(m, src)
+}
+func (m *MsgRecordsConsumedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecordsConsumedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecordsConsumedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecordsConsumedResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the message for updating clerk module parameters.
+type MsgUpdateParams struct {
+	// Address of the governance authority (typically the governance module).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// New parameters to set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb0d5312093e6ca2, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response for MsgUpdateParams.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb0d5312093e6ca2, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEventRecordResponse)(nil), "heimdallv2.clerk.MsgEventRecordResponse")
 	proto.RegisterType((*MsgEventRecord)(nil), "heimdallv2.clerk.MsgEventRecord")
+	proto.RegisterType((*MsgRecordsConsumed)(nil), "heimdallv2.clerk.MsgRecordsConsumed")
+	proto.RegisterType((*MsgRecordsConsumedResponse)(nil), "heimdallv2.clerk.MsgRecordsConsumedResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "heimdallv2.clerk.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "heimdallv2.clerk.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/clerk/tx.proto", fileDescriptor_bb0d5312093e6ca2) }
 
 var fileDescriptor_bb0d5312093e6ca2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8f, 0xd3, 0x3c,
-	0x00, 0xc6, 0x93, 0xfe, 0x3f, 0xbf, 0xa7, 0x97, 0xc3, 0x2a, 0x90, 0x56, 0x22, 0x8d, 0x3a, 0x45,
-	0x95, 0x2e, 0xe6, 0x8a, 0x58, 0xd8, 0x38, 0x09, 0xa9, 0x37, 0x1c, 0x42, 0x65, 0x63, 0x89, 0x9c,
-	0xd8, 0x38, 0xd6, 0x25, 0x76, 0x15, 0xfb, 0xaa, 0xdc, 0x86, 0x18, 0x10, 0x62, 0xe2, 0x23, 0xdc,
-	0xc8, 0xd8, 0x81, 0x0f, 0xc1, 0x78, 0x62, 0x62, 0x44, 0xed, 0x50, 0x3e, 0x06, 0xaa, 0xd3, 0x88,
-	0xf6, 0x18, 0x60, 0x49, 0xe2, 0xe7, 0xf7, 0x38, 0x7e, 0x6c, 0x3f, 0xa0, 0x97, 0x50, 0x9e, 0x11,
-	0x9c, 0xa6, 0xf3, 0x31, 0x8a, 0x53, 0x9a, 0x5f, 0x20, 0x5d, 0x04, 0xb3, 0x5c, 0x6a, 0x09, 0x8f,
-	0x7e, 0xa3, 0xc0, 0xa0, 0xfe, 0x5d, 0x9c, 0x71, 0x21, 0x91, 0x79, 0x96, 0xa6, 0xfe, 0x83, 0x58,
-	0xaa, 0x4c, 0x2a, 0x94, 0x29, 0x86, 0xe6, 0x27, 0x9b, 0xd7, 0x16, 0xf4, 0x4a, 0x10, 0x9a, 0x11,
-	0x2a, 0x07, 0x5b, 0xd4, 0x65, 0x92, 0xc9, 0x52, 0xdf, 0x7c, 0x95, 0xea, 0xd0, 0x01, 0xf7, 0xcf,
-	0x15, 0x7b, 0x3e, 0xa7, 0x42, 0x4f, 0x69, 0x2c, 0x73, 0x32, 0xa5, 0x6a, 0x26, 0x85, 0xa2, 0xc3,
-	0xf7, 0x75, 0xf0, 0xff, 0x3e, 0x82, 0x27, 0xa0, 0xf1, 0x26, 0x97, 0x99, 0x63, 0x7b, 0xb6, 0x7f,
-	0x70, 0xfa, 0xf0, 0xdb, 0x97, 0xe3, 0xee, 0x76, 0x89, 0x67, 0x84, 0xe4, 0x54, 0xa9, 0x57, 0x3a,
-	0xe7, 0x82, 0x7d, 0x5e, 0x2f, 0x46, 0xf6, 0xd4, 0x58, 0xa1, 0x0b, 0xda, 0xba, 0x08, 0x13, 0xac,
-	0x12, 0xa7, 0x66, 0x66, 0x35, 0x4b, 0xda, 0xd2, 0xc5, 0x04, 0xab, 0x04, 0x0e, 0xc1, 0x41, 0x2a,
-	0x59, 0xc8, 0x05, 0xa1, 0x85, 0x53, 0xf7, 0x6c, 0xbf, 0x51, 0x39, 0x3a, 0xa9, 0x64, 0x67, 0x1b,
-	0x19, 0xfa, 0xe0, 0x30, 0x4a, 0x65, 0x7c, 0x11, 0x8a, 0xcb, 0x2c, 0xa2, 0xb9, 0xd3, 0xd8, 0xb5,
-	0xfd, 0x67, 0xd0, 0x0b, 0x43, 0xe0, 0x04, 0x1c, 0xc5, 0x52, 0xe8, 0x1c, 0xc7, 0x3a, 0xc4, 0x65,
-	0x24, 0xa7, 0xf9, 0x2f, 0x61, 0xef, 0x54, 0xd3, 0xb6, 0x0c, 0xf6, 0x40, 0x83, 0x60, 0x8d, 0x9d,
-	0x96, 0x67, 0xfb, 0x87, 0xd5, 0x5a, 0x46, 0x82, 0xf7, 0x40, 0x8d, 0x13, 0xa7, 0xbd, 0x1b, 0xa2,
-	0xc6, 0x09, 0xf4, 0x40, 0x27, 0x4e, 0x30, 0x17, 0x21, 0x27, 0x4e, 0x67, 0x77, 0xab, 0x6d, 0x23,
-	0x9f, 0x91, 0xa7, 0x4f, 0x3e, 0x5c, 0x0f, 0xac, 0x9f, 0xd7, 0x03, 0xeb, 0xdd, 0x7a, 0x31, 0x32,
-	0xc7, 0xf3, 0x71, 0xbd, 0x18, 0x0d, 0xfe, 0xe8, 0xc2, 0xfe, 0xa9, 0x8f, 0x67, 0xa0, 0x7e, 0xae,
-	0x18, 0x8c, 0x40, 0x77, 0x82, 0x05, 0x49, 0xe9, 0xad, 0x4b, 0xf1, 0x82, 0xdb, 0x8d, 0x09, 0xf6,
-	0x1d, 0x7d, 0xff, 0x6f, 0x8e, 0xea, 0xce, 0xfb, 0xcd, 0xb7, 0x9b, 0xc4, 0xa7, 0x93, 0xaf, 0x4b,
-	0xd7, 0xbe, 0x59, 0xba, 0xf6, 0x8f, 0xa5, 0x6b, 0x7f, 0x5a, 0xb9, 0xd6, 0xcd, 0xca, 0xb5, 0xbe,
-	0xaf, 0x5c, 0xeb, 0x75, 0xc0, 0xb8, 0x4e, 0x2e, 0xa3, 0x20, 0x96, 0x19, 0x7a, 0x54, 0xbc, 0x94,
-	0xe9, 0x15, 0x93, 0x02, 0x55, 0xbf, 0x3f, 0x9e, 0x8f, 0x51, 0x51, 0x15, 0xfa, 0x6a, 0x46, 0x55,
-	0xd4, 0x32, 0x2d, 0x7b, 0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xf8, 0x43, 0x7d, 0xf1, 0x02,
-	0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0xd3, 0x34, 0x6d, 0xae, 0x51, 0x5b, 0x4e, 0x85, 0x3a, 0x56, 0x71, 0x42, 0x60, 0x08,
+	0x85, 0xc6, 0x34, 0x15, 0x03, 0xed, 0x44, 0x10, 0x52, 0x3a, 0x04, 0x55, 0x45, 0x2c, 0x08, 0xc9,
+	0x3a, 0xfb, 0x0e, 0xdb, 0xaa, 0xed, 0x8b, 0xee, 0x2e, 0x51, 0xba, 0x21, 0x06, 0x84, 0x98, 0xf8,
+	0x09, 0x1d, 0x19, 0x3b, 0x30, 0x23, 0xc6, 0x8e, 0x15, 0x13, 0x13, 0xaa, 0xda, 0xa1, 0xfc, 0x0c,
+	0xe4, 0x73, 0xdc, 0x24, 0x4e, 0xa4, 0x56, 0x2c, 0x4e, 0xee, 0x7d, 0xdf, 0x7b, 0xef, 0xfb, 0xde,
+	0x3b, 0x1b, 0x94, 0x5c, 0xe2, 0x05, 0x18, 0xf9, 0x7e, 0xaf, 0x61, 0xd8, 0x3e, 0x61, 0x07, 0x86,
+	0xe8, 0xd7, 0x3b, 0x8c, 0x0a, 0x0a, 0x97, 0x87, 0x50, 0x5d, 0x42, 0xda, 0x2d, 0x14, 0x78, 0x21,
+	0x35, 0xe4, 0x33, 0x26, 0x69, 0xab, 0x36, 0xe5, 0x01, 0xe5, 0x46, 0xc0, 0x1d, 0xa3, 0xb7, 0x19,
+	0xfd, 0x0c, 0x80, 0x52, 0x0c, 0x98, 0xf2, 0x64, 0xc4, 0x87, 0x01, 0xb4, 0xe2, 0x50, 0x87, 0xc6,
+	0xf1, 0xe8, 0xdf, 0x20, 0xba, 0x36, 0xa1, 0x44, 0x3e, 0x63, 0xb4, 0xaa, 0x82, 0x3b, 0x6d, 0xee,
+	0xbc, 0xec, 0x91, 0x50, 0xec, 0x13, 0x9b, 0x32, 0xbc, 0x4f, 0x78, 0x87, 0x86, 0x9c, 0x54, 0x3f,
+	0xcd, 0x80, 0xc5, 0x71, 0x08, 0x6e, 0x82, 0xdc, 0x7b, 0x46, 0x03, 0x55, 0xa9, 0x28, 0xb5, 0x42,
+	0xf3, 0xee, 0xaf, 0xef, 0x1b, 0x2b, 0x03, 0x01, 0xcf, 0x31, 0x66, 0x84, 0xf3, 0xd7, 0x82, 0x79,
+	0xa1, 0xf3, 0xed, 0xf2, 0x78, 0x5d, 0xd9, 0x97, 0x54, 0xa8, 0x83, 0x39, 0xd1, 0x37, 0x5d, 0xc4,
+	0x5d, 0x35, 0x2b, 0xb3, 0x66, 0x63, 0x34, 0x2f, 0xfa, 0x2d, 0xc4, 0x5d, 0x58, 0x05, 0x05, 0x9f,
+	0x3a, 0xa6, 0x17, 0x62, 0xd2, 0x57, 0x67, 0x2a, 0x4a, 0x2d, 0x97, 0x30, 0xe6, 0x7d, 0xea, 0xec,
+	0x46, 0x61, 0x58, 0x03, 0x45, 0xcb, 0xa7, 0xf6, 0x81, 0x19, 0x76, 0x03, 0x8b, 0x30, 0x35, 0x37,
+	0x4a, 0x5b, 0x90, 0xd0, 0x2b, 0x89, 0xc0, 0x16, 0x58, 0xb6, 0x69, 0x28, 0x18, 0xb2, 0x85, 0x89,
+	0x62, 0x49, 0xea, 0xec, 0x4d, 0xc4, 0x2e, 0x25, 0x69, 0x03, 0x0c, 0x96, 0x40, 0x0e, 0x23, 0x81,
+	0xd4, 0x7c, 0x45, 0xa9, 0x15, 0x93, 0x5e, 0x32, 0x04, 0x6f, 0x83, 0xac, 0x87, 0xd5, 0xb9, 0x51,
+	0x11, 0x59, 0x0f, 0xc3, 0x0a, 0x98, 0xb7, 0x5d, 0xe4, 0x85, 0xa6, 0x87, 0xd5, 0xf9, 0x51, 0xab,
+	0x73, 0x32, 0xbc, 0x8b, 0xb7, 0x9f, 0x7e, 0x3e, 0x2a, 0x67, 0xfe, 0x1e, 0x95, 0x33, 0x1f, 0x2f,
+	0x8f, 0xd7, 0xe5, 0x78, 0xbe, 0x5c, 0x1e, 0xaf, 0x97, 0x27, 0xf6, 0x33, 0x3e, 0xf5, 0xea, 0x99,
+	0x02, 0x60, 0x9b, 0x3b, 0xf1, 0x89, 0xbf, 0xa0, 0x21, 0xef, 0x06, 0xe4, 0xbf, 0x96, 0xf1, 0x08,
+	0x2c, 0xfa, 0x88, 0x0b, 0x93, 0xc9, 0x52, 0x91, 0xd0, 0xec, 0xa8, 0x8b, 0x62, 0x04, 0xc6, 0x6d,
+	0x76, 0x31, 0x34, 0xc0, 0xb2, 0x45, 0x99, 0x39, 0x36, 0xf9, 0xb1, 0x05, 0x2d, 0x5a, 0x94, 0x35,
+	0x87, 0xc3, 0xdf, 0x7e, 0x36, 0xd5, 0xde, 0xfd, 0x69, 0xf6, 0x52, 0x5e, 0xaa, 0x6b, 0x40, 0x9b,
+	0x8c, 0x5e, 0xdd, 0xc4, 0x9f, 0x0a, 0x58, 0x6a, 0x73, 0xe7, 0x4d, 0x07, 0x23, 0x41, 0xf6, 0x10,
+	0x43, 0x01, 0x87, 0x3b, 0xa0, 0x80, 0xba, 0xc2, 0xa5, 0xcc, 0x13, 0x87, 0x37, 0x1b, 0xc1, 0x90,
+	0x0f, 0x77, 0x40, 0xbe, 0x23, 0xcb, 0x48, 0xff, 0x0b, 0x0d, 0xb5, 0x9e, 0x7e, 0x25, 0xeb, 0x71,
+	0x9b, 0x66, 0xe1, 0xe4, 0x4f, 0x39, 0x33, 0xb8, 0xb1, 0x71, 0xca, 0xf6, 0x56, 0x64, 0x6f, 0x58,
+	0x2c, 0xf2, 0x58, 0x99, 0xe6, 0x71, 0x54, 0x6e, 0xb5, 0x04, 0x56, 0x53, 0xa1, 0xc4, 0x5d, 0xe3,
+	0x47, 0x16, 0xcc, 0xb4, 0xb9, 0x03, 0x2d, 0xb0, 0xd2, 0x42, 0x21, 0xf6, 0x49, 0xea, 0xa5, 0xab,
+	0x4c, 0x8a, 0x1b, 0x67, 0x68, 0xb5, 0xeb, 0x18, 0x49, 0x2f, 0x18, 0x02, 0xf5, 0xaa, 0x47, 0xfa,
+	0x3e, 0x3d, 0x98, 0x5a, 0x25, 0xc5, 0xd2, 0x1e, 0xdf, 0x84, 0x75, 0xd5, 0xef, 0x1d, 0x28, 0x8e,
+	0x6d, 0xed, 0xde, 0xd4, 0xec, 0x51, 0x8a, 0xf6, 0xf0, 0x5a, 0x4a, 0x52, 0x5d, 0x9b, 0xfd, 0x10,
+	0x2d, 0xa6, 0xd9, 0x3a, 0x39, 0xd7, 0x95, 0xd3, 0x73, 0x5d, 0x39, 0x3b, 0xd7, 0x95, 0xaf, 0x17,
+	0x7a, 0xe6, 0xf4, 0x42, 0xcf, 0xfc, 0xbe, 0xd0, 0x33, 0x6f, 0xeb, 0x8e, 0x27, 0xdc, 0xae, 0x55,
+	0xb7, 0x69, 0x60, 0x3c, 0xe9, 0xef, 0x51, 0xff, 0xd0, 0xa1, 0xa1, 0x91, 0xd4, 0xdf, 0xe8, 0x35,
+	0x8c, 0x7e, 0xf2, 0x71, 0x3e, 0xec, 0x10, 0x6e, 0xe5, 0xe5, 0x37, 0x71, 0xeb, 0xdf, 0x00, 0x23,
+	0x52, 0x71, 0xc5, 0xbd, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +366,11 @@ type MsgClient interface {
 	// HandleMsgEventRecord processes a state sync event record from the root
 	// chain.
 	HandleMsgEventRecord(ctx context.Context, in *MsgEventRecord, opts ...grpc.CallOption) (*MsgEventRecordResponse, error)
+	// HandleMsgRecordsConsumed records the last event record consumed by bor.
+	HandleMsgRecordsConsumed(ctx context.Context, in *MsgRecordsConsumed, opts ...grpc.CallOption) (*MsgRecordsConsumedResponse, error)
+	// UpdateParams defines a method for updating the clerk module parameters.
+	// Only the governance authority can execute this.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -196,11 +390,34 @@ func (c *msgClient) HandleMsgEventRecord(ctx context.Context, in *MsgEventRecord
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgRecordsConsumed(ctx context.Context, in *MsgRecordsConsumed, opts ...grpc.CallOption) (*MsgRecordsConsumedResponse, error) {
+	out := new(MsgRecordsConsumedResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Msg/HandleMsgRecordsConsumed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.clerk.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// HandleMsgEventRecord processes a state sync event record from the root
 	// chain.
 	HandleMsgEventRecord(context.Context, *MsgEventRecord) (*MsgEventRecordResponse, error)
+	// HandleMsgRecordsConsumed records the last event record consumed by bor.
+	HandleMsgRecordsConsumed(context.Context, *MsgRecordsConsumed) (*MsgRecordsConsumedResponse, error)
+	// UpdateParams defines a method for updating the clerk module parameters.
+	// Only the governance authority can execute this.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -210,6 +427,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) HandleMsgEventRecord(ctx context.Context, req *MsgEventRecord) (*MsgEventRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgEventRecord not implemented")
 }
+func (*UnimplementedMsgServer) HandleMsgRecordsConsumed(ctx context.Context, req *MsgRecordsConsumed) (*MsgRecordsConsumedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRecordsConsumed not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -233,6 +456,43 @@ func _Msg_HandleMsgEventRecord_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgRecordsConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordsConsumed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRecordsConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Msg/HandleMsgRecordsConsumed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRecordsConsumed(ctx, req.(*MsgRecordsConsumed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.clerk.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.clerk.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -241,6 +501,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgEventRecord",
 			Handler:    _Msg_HandleMsgEventRecord_Handler,
 		},
+		{
+			MethodName: "HandleMsgRecordsConsumed",
+			Handler:    _Msg_HandleMsgRecordsConsumed_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/clerk/tx.proto",
@@ -342,6 +610,132 @@ func (m *MsgEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecordsConsumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordsConsumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordsConsumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BorBlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorBlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastRecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastRecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecordsConsumedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecordsConsumedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecordsConsumedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -400,11 +794,63 @@ func (m *MsgEventRecord) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRecordsConsumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LastRecordId != 0 {
+		n += 1 + sovTx(uint64(m.LastRecordId))
+	}
+	if m.BorBlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BorBlockNumber))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRecordsConsumedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgEventRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -725,6 +1171,341 @@ func (m *MsgEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecordsConsumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordsConsumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordsConsumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordId", wireType)
+			}
+			m.LastRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorBlockNumber", wireType)
+			}
+			m.BorBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordsConsumedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordsConsumedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordsConsumedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,44 @@
+package hardfork
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/0xPolygon/heimdall-v2/api/heimdallv2/hardfork"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: hardfork.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "GetForks",
+					Use:       "forks",
+					Short:     "Query the activation heights of all the hard forks",
+				},
+				{
+					RpcMethod: "GetForkByName",
+					Use:       "fork [name]",
+					Short:     "Query the activation height of a hard fork",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              hardfork.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: false,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "ScheduleFork",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UnscheduleFork",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+// InitGenesis sets the hard fork heights for genesis, and applies them to the hard fork gates.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	for _, fork := range data.Forks {
+		if err := k.SetFork(ctx, fork); err != nil {
+			panic(fmt.Errorf("failed to set hard fork %s: %w", fork.Name, err))
+		}
+	}
+
+	if err := k.ApplyForkHeights(ctx); err != nil {
+		panic(fmt.Errorf("failed to apply hard fork heights: %w", err))
+	}
+}
+
+// ExportGenesis returns a GenesisState for hardfork.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	forks, err := k.GetAllForks(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to get hard forks: %w", err))
+	}
+
+	return types.NewGenesisState(forks)
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	ctx, require, hfKeeper := s.ctx, s.Require(), s.hfKeeper
+
+	genesisState := types.NewGenesisState([]types.Fork{
+		types.NewFork(helper.IthacaFork, 300),
+		types.NewFork(helper.ZurichFork, 200),
+	})
+
+	hfKeeper.InitGenesis(ctx, genesisState)
+	require.Equal(int64(200), helper.GetZurichHardforkHeight())
+	require.Equal(int64(300), helper.GetIthacaHeight())
+
+	require.Equal(genesisState, hfKeeper.ExportGenesis(ctx))
+}
+
+func (s *KeeperTestSuite) TestInitGenesisWithUnknownFork() {
+	require, hfKeeper := s.Require(), s.hfKeeper
+
+	require.Panics(func() {
+		hfKeeper.InitGenesis(s.ctx, types.NewGenesisState([]types.Fork{types.NewFork("unknown", 1)}))
+	})
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k *Keeper
+}
+
+// NewQueryServer creates a new querier for hardfork clients.
+func NewQueryServer(k *Keeper) types.QueryServer {
+	return queryServer{
+		k: k,
+	}
+}
+
+// GetForks implements the gRPC service handler for querying the activation heights of all the hard forks.
+func (q queryServer) GetForks(ctx context.Context, _ *types.QueryForksRequest) (*types.QueryForksResponse, error) {
+	names := helper.ForkNames()
+	forks := make([]types.ForkStatus, 0, len(names))
+	for _, name := range names {
+		fork, err := q.k.GetForkStatus(ctx, name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get hard fork %s: %s", name, err)
+		}
+
+		forks = append(forks, fork)
+	}
+
+	return &types.QueryForksResponse{Forks: forks}, nil
+}
+
+// GetForkByName implements the gRPC service handler for querying the activation height of a hard fork.
+func (q queryServer) GetForkByName(ctx context.Context, req *types.QueryForkByNameRequest) (*types.QueryForkByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fork, err := q.k.GetForkStatus(ctx, req.Name)
+	if errors.Is(err, types.ErrUnknownFork) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get hard fork %s: %s", req.Name, err)
+	}
+
+	return &types.QueryForkByNameResponse{Fork: fork}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func (s *KeeperTestSuite) TestGetForks() {
+	ctx, require, hfKeeper, queryClient := s.ctx, s.Require(), s.hfKeeper, s.queryClient
+
+	helper.SetIthacaHeight(0)
+	require.NoError(hfKeeper.SetFork(ctx, types.NewFork(helper.ZurichFork, 5)))
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+
+	res, err := queryClient.GetForks(ctx, &types.QueryForksRequest{})
+	require.NoError(err)
+	require.Len(res.Forks, len(helper.ForkNames()))
+
+	for i, name := range helper.ForkNames() {
+		require.Equal(name, res.Forks[i].Name)
+	}
+
+	fork, err := queryClient.GetForkByName(ctx, &types.QueryForkByNameRequest{Name: helper.ZurichFork})
+	require.NoError(err)
+	require.Equal(types.ForkStatus{Name: helper.ZurichFork, Height: 5, Scheduled: true, Active: true}, fork.Fork)
+
+	fork, err = queryClient.GetForkByName(ctx, &types.QueryForkByNameRequest{Name: helper.IthacaFork})
+	require.NoError(err)
+	require.Equal(types.ForkStatus{Name: helper.IthacaFork}, fork.Fork)
+
+	_, err = queryClient.GetForkByName(ctx, &types.QueryForkByNameRequest{Name: "unknown"})
+	require.Equal(codes.NotFound, status.Code(err))
+}
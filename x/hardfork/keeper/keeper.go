@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+// Keeper stores the activation heights of the hard forks
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	Schema       collections.Schema
+	forks        collections.Map[string, int64]
+	// The address capable of executing the `MsgScheduleFork` and `MsgUnscheduleFork` messages.
+	// This should be the x/gov module account.
+	authority string
+	// codeHeights are the heights set in the code of the hard forks whose height was applied from the registry,
+	// restored once they're unscheduled. It's shared by the copies of the keeper.
+	codeHeights map[string]int64
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+) Keeper {
+	bz, err := address.NewHexCodec().StringToBytes(authority)
+	if err != nil {
+		panic(fmt.Errorf("invalid hardfork authority address: %w", err))
+	}
+
+	// ensure only gov has the authority to schedule the hard forks
+	if !bytes.Equal(bz, authtypes.NewModuleAddress(govtypes.ModuleName)) {
+		panic(fmt.Errorf("invalid hardfork authority address: %s", authority))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		forks:        collections.NewMap(sb, types.ForksKey, "forks", collections.StringKey, collections.Int64Value),
+		authority:    authority,
+		codeHeights:  make(map[string]int64),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns x/hardfork module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetFork stores the activation height of a hard fork. The height is applied to the hard fork gates
+// by the next call to ApplyForkHeights.
+func (k Keeper) SetFork(ctx context.Context, fork types.Fork) error {
+	if err := fork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.forks.Set(ctx, fork.Name, fork.Height)
+}
+
+// DeleteFork removes the activation height of a hard fork from the registry. The height set in the code
+// is restored by the next call to ApplyForkHeights.
+func (k Keeper) DeleteFork(ctx context.Context, name string) error {
+	return k.forks.Remove(ctx, name)
+}
+
+// GetFork returns the activation height of a hard fork stored in the registry, and false when the
+// hard fork keeps the height set in the code for the chain.
+func (k Keeper) GetFork(ctx context.Context, name string) (int64, bool, error) {
+	height, err := k.forks.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return height, true, nil
+}
+
+// GetAllForks returns the activation heights of the hard forks stored in the registry, sorted by name.
+func (k Keeper) GetAllForks(ctx context.Context) ([]types.Fork, error) {
+	iter, err := k.forks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(iter collections.Iterator[string, int64]) {
+		if err := iter.Close(); err != nil {
+			k.Logger(ctx).Error("Error closing forks iterator", "err", err)
+		}
+	}(iter)
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var forks []types.Fork
+	for _, kv := range kvs {
+		forks = append(forks, types.NewFork(kv.Key, kv.Value))
+	}
+
+	return forks, nil
+}
+
+// ApplyForkHeights sets the activation heights stored in the registry to the hard fork gates of the
+// helper package, which the modules read. The hard forks not stored keep the heights set in the code,
+// which are restored for the hard forks unscheduled since the previous call.
+func (k Keeper) ApplyForkHeights(ctx context.Context) error {
+	forks, err := k.GetAllForks(ctx)
+	if err != nil {
+		return err
+	}
+
+	scheduled := make(map[string]bool, len(forks))
+	for _, fork := range forks {
+		scheduled[fork.Name] = true
+
+		current, ok := helper.GetForkHeight(fork.Name)
+		if !ok {
+			return fmt.Errorf("%w: %q", types.ErrUnknownFork, fork.Name)
+		}
+
+		if _, ok := k.codeHeights[fork.Name]; !ok {
+			k.codeHeights[fork.Name] = current
+		}
+
+		if current == fork.Height {
+			continue
+		}
+
+		if err := helper.SetForkHeight(fork.Name, fork.Height); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info("Applied hard fork height from the registry", "fork", fork.Name, "height", fork.Height, "previousHeight", current)
+	}
+
+	for name, height := range k.codeHeights {
+		if scheduled[name] {
+			continue
+		}
+
+		if err := helper.SetForkHeight(name, height); err != nil {
+			return err
+		}
+
+		delete(k.codeHeights, name)
+
+		k.Logger(ctx).Info("Restored hard fork height set in the code", "fork", name, "height", height)
+	}
+
+	return nil
+}
+
+// GetCodeForkHeight returns the activation height of a hard fork set in the code, restored when it's unscheduled.
+func (k Keeper) GetCodeForkHeight(name string) (int64, error) {
+	if height, ok := k.codeHeights[name]; ok {
+		return height, nil
+	}
+
+	height, ok := helper.GetForkHeight(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", types.ErrUnknownFork, name)
+	}
+
+	return height, nil
+}
+
+// GetForkStatus returns the activation height of a hard fork, whether it's stored in the registry,
+// and whether it's active at the current height.
+func (k Keeper) GetForkStatus(ctx context.Context, name string) (types.ForkStatus, error) {
+	height, ok := helper.GetForkHeight(name)
+	if !ok {
+		return types.ForkStatus{}, fmt.Errorf("%w: %q", types.ErrUnknownFork, name)
+	}
+
+	scheduled, err := k.forks.Has(ctx, name)
+	if err != nil {
+		return types.ForkStatus{}, err
+	}
+
+	return types.ForkStatus{
+		Name:      name,
+		Height:    height,
+		Scheduled: scheduled,
+		Active:    helper.IsForkActive(name, sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	hfKeeper    keeper.Keeper
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: cmttime.Now(), Height: 10})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	hfKeeper := keeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	s.ctx = ctx
+	s.hfKeeper = hfKeeper
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServer(&hfKeeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.msgServer = keeper.NewMsgServerImpl(hfKeeper)
+
+	// the fork heights are package-level in helper: restore them after each test
+	heights := make(map[string]int64)
+	for _, name := range helper.ForkNames() {
+		heights[name], _ = helper.GetForkHeight(name)
+	}
+	s.T().Cleanup(func() {
+		for name, height := range heights {
+			s.Require().NoError(helper.SetForkHeight(name, height))
+		}
+	})
+
+	s.setChain("local")
+}
+
+// setChain sets the chain of the node, restored after the test.
+func (s *KeeperTestSuite) setChain(chain string) {
+	original := helper.GetConfig()
+	config := original
+	config.Chain = chain
+	helper.SetTestConfig(helper.CustomAppConfig{Custom: config})
+	s.T().Cleanup(func() { helper.SetTestConfig(helper.CustomAppConfig{Custom: original}) })
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestSetFork() {
+	ctx, require, hfKeeper := s.ctx, s.Require(), s.hfKeeper
+
+	_, ok, err := hfKeeper.GetFork(ctx, helper.ZurichFork)
+	require.NoError(err)
+	require.False(ok)
+
+	require.NoError(hfKeeper.SetFork(ctx, types.NewFork(helper.ZurichFork, 500)))
+	height, ok, err := hfKeeper.GetFork(ctx, helper.ZurichFork)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(int64(500), height)
+
+	require.ErrorIs(hfKeeper.SetFork(ctx, types.NewFork("unknown", 500)), types.ErrUnknownFork)
+	require.ErrorIs(hfKeeper.SetFork(ctx, types.NewFork(helper.ZurichFork, -1)), types.ErrInvalidForkHeight)
+
+	forks, err := hfKeeper.GetAllForks(ctx)
+	require.NoError(err)
+	require.Equal([]types.Fork{types.NewFork(helper.ZurichFork, 500)}, forks)
+}
+
+func (s *KeeperTestSuite) TestApplyForkHeights() {
+	ctx, require, hfKeeper := s.ctx, s.Require(), s.hfKeeper
+
+	helper.SetZurichHardforkHeight(100)
+	helper.SetIthacaHeight(200)
+
+	require.NoError(hfKeeper.SetFork(ctx, types.NewFork(helper.ZurichFork, 500)))
+	require.Equal(int64(100), helper.GetZurichHardforkHeight())
+
+	// the stored heights are applied, the others keep the ones set in the code
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(500), helper.GetZurichHardforkHeight())
+	require.False(helper.IsZurichHardfork(499))
+	require.True(helper.IsZurichHardfork(500))
+	require.Equal(int64(200), helper.GetIthacaHeight())
+}
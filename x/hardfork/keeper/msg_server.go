@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the hardfork MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// ScheduleFork sets the activation height of a hard fork. The new height, and the current one when the
+// hard fork is enabled, must be at least two blocks ahead: the heights are applied at the beginning of
+// the next block, after its proposal was built and processed with the current ones.
+func (srv msgServer) ScheduleFork(ctx context.Context, req *types.MsgScheduleFork) (*types.MsgScheduleForkResponse, error) {
+	if srv.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.GetAuthority(), req.Authority)
+	}
+
+	if helper.IsForkScheduleLocked() {
+		return nil, errorsmod.Wrapf(types.ErrForkScheduleLocked, "chain %s", helper.GetConfig().Chain)
+	}
+
+	fork := types.NewFork(req.Name, req.Height)
+	if err := fork.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	nextHeight := sdk.UnwrapSDKContext(ctx).BlockHeight() + 1
+	if helper.IsForkActive(req.Name, nextHeight) {
+		return nil, errorsmod.Wrapf(types.ErrForkAlreadyActive, "hard fork %s is active at height %d", req.Name, nextHeight)
+	}
+
+	if helper.IsForkHeightActive(req.Name, req.Height, nextHeight) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidForkHeight, "hard fork %s would be active at height %d with height %d", req.Name, nextHeight, req.Height)
+	}
+
+	if err := srv.SetFork(ctx, fork); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to schedule hard fork %s; %s", req.Name, err)
+	}
+
+	return &types.MsgScheduleForkResponse{}, nil
+}
+
+// UnscheduleFork removes the activation height of a hard fork from the registry, restoring the height set in the
+// code at the beginning of the next block. As for ScheduleFork, neither the current height nor the one set in the
+// code may activate the hard fork at the next block.
+func (srv msgServer) UnscheduleFork(ctx context.Context, req *types.MsgUnscheduleFork) (*types.MsgUnscheduleForkResponse, error) {
+	if srv.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.GetAuthority(), req.Authority)
+	}
+
+	if helper.IsForkScheduleLocked() {
+		return nil, errorsmod.Wrapf(types.ErrForkScheduleLocked, "chain %s", helper.GetConfig().Chain)
+	}
+
+	if !helper.IsKnownFork(req.Name) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownFork, "%q", req.Name)
+	}
+
+	_, scheduled, err := srv.GetFork(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !scheduled {
+		return nil, errorsmod.Wrapf(types.ErrForkNotScheduled, "hard fork %s", req.Name)
+	}
+
+	nextHeight := sdk.UnwrapSDKContext(ctx).BlockHeight() + 1
+	if helper.IsForkActive(req.Name, nextHeight) {
+		return nil, errorsmod.Wrapf(types.ErrForkAlreadyActive, "hard fork %s is active at height %d", req.Name, nextHeight)
+	}
+
+	codeHeight, err := srv.GetCodeForkHeight(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if helper.IsForkHeightActive(req.Name, codeHeight, nextHeight) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidForkHeight, "hard fork %s would be active at height %d with height %d", req.Name, nextHeight, codeHeight)
+	}
+
+	if err := srv.DeleteFork(ctx, req.Name); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to unschedule hard fork %s; %s", req.Name, err)
+	}
+
+	return &types.MsgUnscheduleForkResponse{}, nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func (s *KeeperTestSuite) TestMsgScheduleFork() {
+	ctx, require, hfKeeper, msgServer := s.ctx, s.Require(), s.hfKeeper, s.msgServer
+	authority := hfKeeper.GetAuthority()
+
+	// the context is at height 10, so the forks applied at the next block must activate after 11
+	helper.SetZurichHardforkHeight(0)
+	helper.SetPhuketHardforkHeight(5)
+	helper.SetIthacaHeight(11)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgScheduleFork
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgScheduleFork("invalid", helper.ZurichFork, 100),
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "unknown fork",
+			input:  types.NewMsgScheduleFork(authority, "unknown", 100),
+			expErr: types.ErrUnknownFork,
+		},
+		{
+			name:   "negative height",
+			input:  types.NewMsgScheduleFork(authority, helper.ZurichFork, -1),
+			expErr: types.ErrInvalidForkHeight,
+		},
+		{
+			name:   "fork already active",
+			input:  types.NewMsgScheduleFork(authority, helper.PhuketFork, 100),
+			expErr: types.ErrForkAlreadyActive,
+		},
+		{
+			name:   "fork active at the next block",
+			input:  types.NewMsgScheduleFork(authority, helper.IthacaFork, 100),
+			expErr: types.ErrForkAlreadyActive,
+		},
+		{
+			name:   "height not after the next block",
+			input:  types.NewMsgScheduleFork(authority, helper.ZurichFork, 11),
+			expErr: types.ErrInvalidForkHeight,
+		},
+		{
+			name:   "fork active from genesis at zero height",
+			input:  types.NewMsgScheduleFork(authority, helper.TallyFixFork, 0),
+			expErr: types.ErrInvalidForkHeight,
+		},
+	}
+
+	require.NoError(helper.SetForkHeight(helper.TallyFixFork, 100))
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.ScheduleFork(ctx, tc.input)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+
+	forks, err := hfKeeper.GetAllForks(ctx)
+	require.NoError(err)
+	require.Empty(forks)
+
+	_, err = msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(authority, helper.ZurichFork, 12))
+	require.NoError(err)
+	_, err = msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(authority, helper.TallyFixFork, 50))
+	require.NoError(err)
+
+	// the heights are applied at the next block
+	require.Equal(int64(0), helper.GetZurichHardforkHeight())
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(12), helper.GetZurichHardforkHeight())
+	require.Equal(int64(50), helper.GetTallyFixHeight())
+}
+
+func (s *KeeperTestSuite) TestMsgScheduleForkOnPublicNetworks() {
+	ctx, require, hfKeeper, msgServer := s.ctx, s.Require(), s.hfKeeper, s.msgServer
+
+	for _, chain := range []string{helper.MainChain, helper.MumbaiChain, helper.AmoyChain} {
+		s.setChain(chain)
+
+		_, err := msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(hfKeeper.GetAuthority(), helper.ZurichFork, 1000))
+		require.ErrorIs(err, types.ErrForkScheduleLocked)
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnscheduleFork() {
+	ctx, require, hfKeeper, msgServer := s.ctx, s.Require(), s.hfKeeper, s.msgServer
+	authority := hfKeeper.GetAuthority()
+
+	// the context is at height 10: the ithaca height set in the code is active, but a later one comes from the genesis
+	helper.SetZurichHardforkHeight(0)
+	helper.SetIthacaHeight(5)
+	require.NoError(hfKeeper.SetFork(ctx, types.NewFork(helper.IthacaFork, 100)))
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgUnscheduleFork
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgUnscheduleFork("invalid", helper.IthacaFork),
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "unknown fork",
+			input:  types.NewMsgUnscheduleFork(authority, "unknown"),
+			expErr: types.ErrUnknownFork,
+		},
+		{
+			name:   "fork not scheduled",
+			input:  types.NewMsgUnscheduleFork(authority, helper.ZurichFork),
+			expErr: types.ErrForkNotScheduled,
+		},
+		{
+			name:   "fork active at the next block with the height set in the code",
+			input:  types.NewMsgUnscheduleFork(authority, helper.IthacaFork),
+			expErr: types.ErrInvalidForkHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.UnscheduleFork(ctx, tc.input)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+
+	height, ok, err := hfKeeper.GetFork(ctx, helper.IthacaFork)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(int64(100), height)
+}
+
+func (s *KeeperTestSuite) TestRescheduleFork() {
+	ctx, require, hfKeeper, msgServer := s.ctx, s.Require(), s.hfKeeper, s.msgServer
+	authority := hfKeeper.GetAuthority()
+
+	helper.SetZurichHardforkHeight(0)
+
+	_, err := msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(authority, helper.ZurichFork, 100))
+	require.NoError(err)
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(100), helper.GetZurichHardforkHeight())
+
+	// rescheduled, at the next block
+	_, err = msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(authority, helper.ZurichFork, 200))
+	require.NoError(err)
+	require.Equal(int64(100), helper.GetZurichHardforkHeight())
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(200), helper.GetZurichHardforkHeight())
+
+	// unscheduled, restoring the height set in the code at the next block
+	_, err = msgServer.UnscheduleFork(ctx, types.NewMsgUnscheduleFork(authority, helper.ZurichFork))
+	require.NoError(err)
+	require.Equal(int64(200), helper.GetZurichHardforkHeight())
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(0), helper.GetZurichHardforkHeight())
+
+	forks, err := hfKeeper.GetAllForks(ctx)
+	require.NoError(err)
+	require.Empty(forks)
+
+	status, err := hfKeeper.GetForkStatus(ctx, helper.ZurichFork)
+	require.NoError(err)
+	require.False(status.Scheduled)
+
+	// and scheduled again
+	_, err = msgServer.ScheduleFork(ctx, types.NewMsgScheduleFork(authority, helper.ZurichFork, 50))
+	require.NoError(err)
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(50), helper.GetZurichHardforkHeight())
+
+	_, err = msgServer.UnscheduleFork(ctx, types.NewMsgUnscheduleFork(authority, helper.ZurichFork))
+	require.NoError(err)
+	require.NoError(hfKeeper.ApplyForkHeights(ctx))
+	require.Equal(int64(0), helper.GetZurichHardforkHeight())
+}
+
+func (s *KeeperTestSuite) TestMsgUnscheduleForkOnPublicNetworks() {
+	ctx, require, hfKeeper, msgServer := s.ctx, s.Require(), s.hfKeeper, s.msgServer
+
+	for _, chain := range []string{helper.MainChain, helper.MumbaiChain, helper.AmoyChain} {
+		s.setChain(chain)
+
+		_, err := msgServer.UnscheduleFork(ctx, types.NewMsgUnscheduleFork(hfKeeper.GetAuthority(), helper.ZurichFork))
+		require.ErrorIs(err, types.ErrForkScheduleLocked)
+	}
+}
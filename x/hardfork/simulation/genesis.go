@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+// ForkHeightPrefix is the prefix of the app params keys of the simulated hard fork heights, followed by the fork name
+const ForkHeightPrefix = "fork_height_"
+
+// maxSimForkHeight bounds the simulated hard fork heights, so that the forks activate during the simulations
+const maxSimForkHeight = 200
+
+// genForkHeight returns a randomized hard fork height
+func genForkHeight(r *rand.Rand) int64 {
+	return int64(r.Intn(maxSimForkHeight) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for hardfork, activating every hard fork at a random height,
+// so that the simulations go through the fork transitions.
+// The registry overrides the heights of the whole process, which the app simulation restores once it ends.
+func RandomizedGenState(simState *module.SimulationState) {
+	names := helper.ForkNames()
+	forks := make([]types.Fork, len(names))
+
+	for i, name := range names {
+		var height int64
+
+		simState.AppParams.GetOrGenerate(ForkHeightPrefix+name, &height, simState.Rand, func(r *rand.Rand) {
+			height = genForkHeight(r)
+		})
+
+		forks[i] = types.NewFork(name, height)
+	}
+
+	hardforkGenesis := types.NewGenesisState(forks)
+
+	bz, err := json.MarshalIndent(&hardforkGenesis.Forks, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated hard fork heights:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(hardforkGenesis)
+}
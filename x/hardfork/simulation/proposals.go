@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgScheduleFork int = 20
+
+	OpWeightMsgScheduleFork = "op_weight_msg_schedule_fork"
+)
+
+// minForkDistance is the minimum distance between the current height and the simulated fork heights,
+// so that the scheduled forks never activate during a simulation
+const minForkDistance = 1_000_000
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgScheduleFork,
+			DefaultWeightMsgScheduleFork,
+			SimulateMsgScheduleFork,
+		),
+	}
+}
+
+// SimulateMsgScheduleFork returns a random MsgScheduleFork, moving a random hard fork far in the future
+func SimulateMsgScheduleFork(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	names := helper.ForkNames()
+	height := ctx.BlockHeight() + minForkDistance + r.Int63n(minForkDistance)
+
+	return types.NewMsgScheduleFork(authority.String(), names[r.Intn(len(names))], height)
+}
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrUnknownFork        = errors.Register(ModuleName, 1, "unknown hard fork")
+	ErrInvalidForkHeight  = errors.Register(ModuleName, 2, "invalid hard fork height")
+	ErrForkAlreadyActive  = errors.Register(ModuleName, 3, "hard fork already active")
+	ErrForkScheduleLocked = errors.Register(ModuleName, 4, "hard fork heights can't be scheduled on this chain")
+	ErrForkNotScheduled   = errors.Register(ModuleName, 5, "hard fork not scheduled")
+)
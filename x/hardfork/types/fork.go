@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+)
+
+// NewFork creates a new Fork instance
+func NewFork(name string, height int64) Fork {
+	return Fork{
+		Name:   name,
+		Height: height,
+	}
+}
+
+// ValidateBasic checks that the hard fork exists and that its height isn't negative.
+func (f Fork) ValidateBasic() error {
+	if !helper.IsKnownFork(f.Name) {
+		return fmt.Errorf("%w: %q", ErrUnknownFork, f.Name)
+	}
+
+	if f.Height < 0 {
+		return fmt.Errorf("%w: %d for hard fork %s", ErrInvalidForkHeight, f.Height, f.Name)
+	}
+
+	return nil
+}
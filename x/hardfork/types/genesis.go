@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(forks []Fork) *GenesisState {
+	return &GenesisState{
+		Forks: forks,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state, keeping the hard fork heights set in the code for the chain
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic validation of hardfork genesis data, returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	names := make(map[string]struct{}, len(gs.Forks))
+	for _, fork := range gs.Forks {
+		if err := fork.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := names[fork.Name]; ok {
+			return fmt.Errorf("duplicate hard fork %s", fork.Name)
+		}
+		names[fork.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns hardfork GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return &genesisState
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func TestDefaultGenesisState(t *testing.T) {
+	t.Parallel()
+
+	gs := types.DefaultGenesisState()
+	require.Empty(t, gs.Forks)
+	require.NoError(t, gs.Validate())
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		forks   []types.Fork
+		wantErr error
+	}{
+		{
+			name:  "valid forks",
+			forks: []types.Fork{types.NewFork(helper.ZurichFork, 100), types.NewFork(helper.TallyFixFork, 0)},
+		},
+		{
+			name:    "unknown fork",
+			forks:   []types.Fork{types.NewFork("unknown", 100)},
+			wantErr: types.ErrUnknownFork,
+		},
+		{
+			name:    "negative height",
+			forks:   []types.Fork{types.NewFork(helper.ZurichFork, -1)},
+			wantErr: types.ErrInvalidForkHeight,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := types.NewGenesisState(tc.forks).Validate()
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	t.Run("duplicate fork", func(t *testing.T) {
+		t.Parallel()
+
+		gs := types.NewGenesisState([]types.Fork{types.NewFork(helper.ZurichFork, 100), types.NewFork(helper.ZurichFork, 200)})
+		require.ErrorContains(t, gs.Validate(), "duplicate hard fork")
+	})
+}
+
+func TestGetGenesisStateFromAppState(t *testing.T) {
+	t.Parallel()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	gs := types.NewGenesisState([]types.Fork{types.NewFork(helper.ZurichFork, 100)})
+	appState := map[string]json.RawMessage{types.ModuleName: cdc.MustMarshalJSON(gs)}
+	require.Equal(t, gs, types.GetGenesisStateFromAppState(cdc, appState))
+
+	require.Empty(t, types.GetGenesisStateFromAppState(cdc, map[string]json.RawMessage{}).Forks)
+}
@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "hardfork"
+
+	// StoreKey is the store key string for hardfork
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for hardfork
+	RouterKey = ModuleName
+)
+
+var ForksKey = collections.NewPrefix(0) // ForksKey is the key to store the activation heights of the hard forks
@@ -0,0 +1,17 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/hardfork/types"
+)
+
+func TestKeys(t *testing.T) {
+	require.Equal(t, "hardfork", types.ModuleName)
+	require.Equal(t, types.ModuleName, types.StoreKey)
+	require.Equal(t, types.ModuleName, types.RouterKey)
+	require.IsType(t, collections.Prefix{}, types.ForksKey)
+}
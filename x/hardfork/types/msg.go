@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgScheduleFork{}
+	_ sdk.Msg = &MsgUnscheduleFork{}
+)
+
+// NewMsgScheduleFork creates a new MsgScheduleFork instance
+func NewMsgScheduleFork(authority, name string, height int64) *MsgScheduleFork {
+	return &MsgScheduleFork{
+		Authority: authority,
+		Name:      name,
+		Height:    height,
+	}
+}
+
+// NewMsgUnscheduleFork creates a new MsgUnscheduleFork instance
+func NewMsgUnscheduleFork(authority, name string) *MsgUnscheduleFork {
+	return &MsgUnscheduleFork{
+		Authority: authority,
+		Name:      name,
+	}
+}
@@ -0,0 +1,1237 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdallv2/hardfork/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForkStatus is the activation height of a hard fork, along with where it
+// comes from and whether it's active.
+type ForkStatus struct {
+	// Name of the hard fork.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Height at which the hard fork activates.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Whether the height is stored in the registry, instead of set in the code
+	// for the chain.
+	Scheduled bool `protobuf:"varint,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	// Whether the hard fork is active at the height of the query.
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *ForkStatus) Reset()         { *m = ForkStatus{} }
+func (m *ForkStatus) String() string { return proto.CompactTextString(m) }
+func (*ForkStatus) ProtoMessage()    {}
+func (*ForkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b66df58025ca2c, []int{0}
+}
+func (m *ForkStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkStatus.Merge(m, src)
+}
+func (m *ForkStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkStatus proto.InternalMessageInfo
+
+func (m *ForkStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForkStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForkStatus) GetScheduled() bool {
+	if m != nil {
+		return m.Scheduled
+	}
+	return false
+}
+
+func (m *ForkStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+// QueryForksRequest is the request type for the GetForks query.
+type QueryForksRequest struct {
+}
+
+func (m *QueryForksRequest) Reset()         { *m = QueryForksRequest{} }
+func (m *QueryForksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForksRequest) ProtoMessage()    {}
+func (*QueryForksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b66df58025ca2c, []int{1}
+}
+func (m *QueryForksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForksRequest.Merge(m, src)
+}
+func (m *QueryForksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForksRequest proto.InternalMessageInfo
+
+// QueryForksResponse is the response type for the GetForks query.
+type QueryForksResponse struct {
+	// Hard forks, sorted by name.
+	Forks []ForkStatus `protobuf:"bytes,1,rep,name=forks,proto3" json:"forks"`
+}
+
+func (m *QueryForksResponse) Reset()         { *m = QueryForksResponse{} }
+func (m *QueryForksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForksResponse) ProtoMessage()    {}
+func (*QueryForksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b66df58025ca2c, []int{2}
+}
+func (m *QueryForksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForksResponse.Merge(m, src)
+}
+func (m *QueryForksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForksResponse proto.InternalMessageInfo
+
+func (m *QueryForksResponse) GetForks() []ForkStatus {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
+// QueryForkByNameRequest is the request type for the GetForkByName query.
+type QueryForkByNameRequest struct {
+	// Name of the hard fork.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryForkByNameRequest) Reset()         { *m = QueryForkByNameRequest{} }
+func (m *QueryForkByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForkByNameRequest) ProtoMessage()    {}
+func (*QueryForkByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b66df58025ca2c, []int{3}
+}
+func (m *QueryForkByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForkByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForkByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForkByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForkByNameRequest.Merge(m, src)
+}
+func (m *QueryForkByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForkByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForkByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForkByNameRequest proto.InternalMessageInfo
+
+func (m *QueryForkByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryForkByNameResponse is the response type for the GetForkByName query.
+type QueryForkByNameResponse struct {
+	// The hard fork.
+	Fork ForkStatus `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork"`
+}
+
+func (m *QueryForkByNameResponse) Reset()         { *m = QueryForkByNameResponse{} }
+func (m *QueryForkByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForkByNameResponse) ProtoMessage()    {}
+func (*QueryForkByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b66df58025ca2c, []int{4}
+}
+func (m *QueryForkByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForkByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForkByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForkByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForkByNameResponse.Merge(m, src)
+}
+func (m *QueryForkByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForkByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForkByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForkByNameResponse proto.InternalMessageInfo
+
+func (m *QueryForkByNameResponse) GetFork() ForkStatus {
+	if m != nil {
+		return m.Fork
+	}
+	return ForkStatus{}
+}
+
+func init() {
+	proto.RegisterType((*ForkStatus)(nil), "heimdallv2.hardfork.ForkStatus")
+	proto.RegisterType((*QueryForksRequest)(nil), "heimdallv2.hardfork.QueryForksRequest")
+	proto.RegisterType((*QueryForksResponse)(nil), "heimdallv2.hardfork.QueryForksResponse")
+	proto.RegisterType((*QueryForkByNameRequest)(nil), "heimdallv2.hardfork.QueryForkByNameRequest")
+	proto.RegisterType((*QueryForkByNameResponse)(nil), "heimdallv2.hardfork.QueryForkByNameResponse")
+}
+
+func init() { proto.RegisterFile("heimdallv2/hardfork/query.proto", fileDescriptor_82b66df58025ca2c) }
+
+var fileDescriptor_82b66df58025ca2c = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x6b, 0xd4, 0x40,
+	0x18, 0xdd, 0xd9, 0x1f, 0xa5, 0x3b, 0x22, 0xb2, 0x53, 0xa9, 0x71, 0xad, 0xd9, 0x25, 0x05, 0x5d,
+	0xfc, 0x91, 0xb1, 0xdb, 0xbb, 0xc8, 0x1e, 0xf4, 0x20, 0x88, 0x56, 0x10, 0xf4, 0x36, 0xdd, 0x9d,
+	0x26, 0xa1, 0x49, 0xbe, 0x6d, 0x66, 0x12, 0x1a, 0xc4, 0x8b, 0x27, 0xf1, 0xa2, 0xa0, 0x7f, 0x84,
+	0x47, 0xff, 0x8c, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xec, 0x0a, 0xfe, 0x1b, 0x32, 0x93, 0x89, 0x59,
+	0xed, 0x52, 0xed, 0x25, 0x7c, 0xbc, 0xbc, 0xef, 0xbd, 0xf7, 0x3d, 0x06, 0xf7, 0x7c, 0x1e, 0x44,
+	0x13, 0x16, 0x86, 0xd9, 0x90, 0xfa, 0x2c, 0x99, 0xec, 0x41, 0xb2, 0x4f, 0x0f, 0x52, 0x9e, 0xe4,
+	0xee, 0x34, 0x01, 0x09, 0x64, 0xad, 0x22, 0xb8, 0x25, 0xa1, 0x7b, 0x65, 0x0c, 0x22, 0x02, 0x51,
+	0x10, 0x69, 0xb6, 0xb5, 0xb8, 0xd1, 0xed, 0xb0, 0x28, 0x88, 0x81, 0xea, 0xaf, 0x81, 0x2e, 0x7a,
+	0xe0, 0x81, 0x1e, 0xa9, 0x9a, 0x0c, 0xba, 0xe1, 0x01, 0x78, 0x21, 0xa7, 0x6c, 0x1a, 0x50, 0x16,
+	0xc7, 0x20, 0x99, 0x0c, 0x20, 0x16, 0xe6, 0xaf, 0xb3, 0x2c, 0x59, 0x39, 0x14, 0x1c, 0xe7, 0x2d,
+	0xc2, 0xf8, 0x3e, 0x24, 0xfb, 0x4f, 0x25, 0x93, 0xa9, 0x20, 0x97, 0x71, 0x33, 0x66, 0x11, 0xb7,
+	0x50, 0x1f, 0x0d, 0xda, 0xa3, 0xd6, 0xa7, 0x9f, 0x9f, 0x6f, 0xa0, 0x1d, 0x0d, 0x91, 0xab, 0x78,
+	0xc5, 0xe7, 0x81, 0xe7, 0x4b, 0xab, 0xde, 0x47, 0x83, 0x46, 0xf9, 0xd3, 0x80, 0x64, 0x13, 0xb7,
+	0xc5, 0xd8, 0xe7, 0x93, 0x34, 0xe4, 0x13, 0xab, 0xd1, 0x47, 0x83, 0xd5, 0x92, 0x51, 0xe1, 0x4a,
+	0x83, 0x8d, 0x65, 0x90, 0x71, 0xab, 0xb9, 0xc8, 0x30, 0xa0, 0xb3, 0x86, 0x3b, 0x4f, 0x54, 0x0d,
+	0x2a, 0x90, 0xd8, 0xe1, 0x07, 0x29, 0x17, 0xd2, 0x79, 0x86, 0xc9, 0x22, 0x28, 0xa6, 0x10, 0x0b,
+	0x4e, 0xee, 0xe1, 0x96, 0xba, 0x42, 0x58, 0xa8, 0xdf, 0x18, 0x9c, 0x1b, 0xf6, 0xdc, 0x25, 0x25,
+	0xbb, 0xd5, 0x61, 0xa3, 0xf6, 0xd1, 0xb7, 0x5e, 0xad, 0x70, 0x2b, 0x16, 0x9d, 0x6d, 0xbc, 0xfe,
+	0x5b, 0x77, 0x94, 0x3f, 0x62, 0x11, 0x37, 0x8e, 0xa7, 0x94, 0xe0, 0x3c, 0xc7, 0x97, 0x4e, 0x2c,
+	0x99, 0x44, 0x77, 0x71, 0x53, 0x09, 0xeb, 0xad, 0xb3, 0x05, 0xd2, 0x7b, 0xc3, 0x8f, 0x75, 0xdc,
+	0xd2, 0xda, 0x24, 0xc7, 0xab, 0x0f, 0xb8, 0xd4, 0xf7, 0x92, 0x6b, 0x4b, 0x75, 0x4e, 0xb4, 0xd4,
+	0xbd, 0xfe, 0x4f, 0x5e, 0x11, 0xd3, 0xd9, 0x78, 0xa3, 0x3c, 0x5f, 0x7f, 0xf9, 0xf1, 0xa1, 0xde,
+	0x21, 0x17, 0xaa, 0x77, 0xb1, 0xa7, 0xed, 0xde, 0x21, 0x7c, 0xde, 0x78, 0x17, 0xe7, 0x91, 0x9b,
+	0xa7, 0x0b, 0xff, 0xd1, 0x5c, 0xf7, 0xd6, 0xff, 0x91, 0x4d, 0x94, 0xcd, 0x2a, 0x8a, 0x45, 0xd6,
+	0xff, 0x8a, 0x42, 0x5f, 0xaa, 0xc2, 0x5f, 0x8d, 0x1e, 0x1e, 0xcd, 0x6c, 0x74, 0x3c, 0xb3, 0xd1,
+	0xf7, 0x99, 0x8d, 0xde, 0xcf, 0xed, 0xda, 0xf1, 0xdc, 0xae, 0x7d, 0x9d, 0xdb, 0xb5, 0x17, 0x5b,
+	0x5e, 0x20, 0xfd, 0x74, 0xd7, 0x1d, 0x43, 0x44, 0xef, 0x1c, 0x3e, 0x86, 0x30, 0xf7, 0x20, 0xa6,
+	0x65, 0x80, 0xdb, 0xd9, 0x90, 0x1e, 0x56, 0x9a, 0x32, 0x9f, 0x72, 0xb1, 0xbb, 0xa2, 0x1f, 0xfd,
+	0xf6, 0xaf, 0x01, 0x00, 0x50, 0x1b, 0xa9, 0x45, 0xb4, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetForks queries the activation heights of all the hard forks.
+	GetForks(ctx context.Context, in *QueryForksRequest, opts ...grpc.CallOption) (*QueryForksResponse, error)
+	// GetForkByName queries the activation height of a hard fork.
+	GetForkByName(ctx context.Context, in *QueryForkByNameRequest, opts ...grpc.CallOption) (*QueryForkByNameResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetForks(ctx context.Context, in *QueryForksRequest, opts ...grpc.CallOption) (*QueryForksResponse, error) {
+	out := new(QueryForksResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.hardfork.Query/GetForks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetForkByName(ctx context.Context, in *QueryForkByNameRequest, opts ...grpc.CallOption) (*QueryForkByNameResponse, error) {
+	out := new(QueryForkByNameResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.hardfork.Query/GetForkByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetForks queries the activation heights of all the hard forks.
+	GetForks(context.Context, *QueryForksRequest) (*QueryForksResponse, error)
+	// GetForkByName queries the activation height of a hard fork.
+	GetForkByName(context.Context, *QueryForkByNameRequest) (*QueryForkByNameResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetForks(ctx context.Context, req *QueryForksRequest) (*QueryForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForks not implemented")
+}
+func (*UnimplementedQueryServer) GetForkByName(ctx context.Context, req *QueryForkByNameRequest) (*QueryForkByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkByName not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.hardfork.Query/GetForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForks(ctx, req.(*QueryForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetForkByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForkByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetForkByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.hardfork.Query/GetForkByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForkByName(ctx, req.(*QueryForkByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.hardfork.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetForks",
+			Handler:    _Query_GetForks_Handler,
+		},
+		{
+			MethodName: "GetForkByName",
+			Handler:    _Query_GetForkByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdallv2/hardfork/query.proto",
+}
+
+func (m *ForkStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Scheduled {
+		i--
+		if m.Scheduled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forks) > 0 {
+		for iNdEx := len(m.Forks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForkByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForkByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForkByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForkByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForkByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForkByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForkStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Scheduled {
+		n += 2
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryForksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryForksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forks) > 0 {
+		for _, e := range m.Forks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryForkByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForkByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fork.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForkStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scheduled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forks = append(m.Forks, ForkStatus{})
+			if err := m.Forks[len(m.Forks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForkByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForkByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForkByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForkByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForkByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForkByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
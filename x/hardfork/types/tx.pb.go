@@ -0,0 +1,1026 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdallv2/hardfork/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgScheduleFork defines the message for setting the activation height of a
+// hard fork.
+type MsgScheduleFork struct {
+	// Address of the governance authority (typically the governance module).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Name of the hard fork.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Height at which the hard fork activates, after the current height. Zero
+	// disables it.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgScheduleFork) Reset()         { *m = MsgScheduleFork{} }
+func (m *MsgScheduleFork) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleFork) ProtoMessage()    {}
+func (*MsgScheduleFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_703fd39edc95288c, []int{0}
+}
+func (m *MsgScheduleFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleFork.Merge(m, src)
+}
+func (m *MsgScheduleFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleFork proto.InternalMessageInfo
+
+func (m *MsgScheduleFork) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleFork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgScheduleFork) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgScheduleForkResponse defines the response for MsgScheduleFork.
+type MsgScheduleForkResponse struct {
+}
+
+func (m *MsgScheduleForkResponse) Reset()         { *m = MsgScheduleForkResponse{} }
+func (m *MsgScheduleForkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleForkResponse) ProtoMessage()    {}
+func (*MsgScheduleForkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_703fd39edc95288c, []int{1}
+}
+func (m *MsgScheduleForkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleForkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleForkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleForkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleForkResponse.Merge(m, src)
+}
+func (m *MsgScheduleForkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleForkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleForkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleForkResponse proto.InternalMessageInfo
+
+// MsgUnscheduleFork defines the message for removing the activation height of
+// a hard fork from the registry.
+type MsgUnscheduleFork struct {
+	// Address of the governance authority (typically the governance module).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Name of the hard fork.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgUnscheduleFork) Reset()         { *m = MsgUnscheduleFork{} }
+func (m *MsgUnscheduleFork) String() string { return proto.CompactTextString(m) }
+func (*MsgUnscheduleFork) ProtoMessage()    {}
+func (*MsgUnscheduleFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_703fd39edc95288c, []int{2}
+}
+func (m *MsgUnscheduleFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnscheduleFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnscheduleFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnscheduleFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnscheduleFork.Merge(m, src)
+}
+func (m *MsgUnscheduleFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnscheduleFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnscheduleFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnscheduleFork proto.InternalMessageInfo
+
+func (m *MsgUnscheduleFork) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnscheduleFork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgUnscheduleForkResponse defines the response for MsgUnscheduleFork.
+type MsgUnscheduleForkResponse struct {
+}
+
+func (m *MsgUnscheduleForkResponse) Reset()         { *m = MsgUnscheduleForkResponse{} }
+func (m *MsgUnscheduleForkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnscheduleForkResponse) ProtoMessage()    {}
+func (*MsgUnscheduleForkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_703fd39edc95288c, []int{3}
+}
+func (m *MsgUnscheduleForkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnscheduleForkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnscheduleForkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnscheduleForkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnscheduleForkResponse.Merge(m, src)
+}
+func (m *MsgUnscheduleForkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnscheduleForkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnscheduleForkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnscheduleForkResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgScheduleFork)(nil), "heimdallv2.hardfork.MsgScheduleFork")
+	proto.RegisterType((*MsgScheduleForkResponse)(nil), "heimdallv2.hardfork.MsgScheduleForkResponse")
+	proto.RegisterType((*MsgUnscheduleFork)(nil), "heimdallv2.hardfork.MsgUnscheduleFork")
+	proto.RegisterType((*MsgUnscheduleForkResponse)(nil), "heimdallv2.hardfork.MsgUnscheduleForkResponse")
+}
+
+func init() { proto.RegisterFile("heimdallv2/hardfork/tx.proto", fileDescriptor_703fd39edc95288c) }
+
+var fileDescriptor_703fd39edc95288c = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x6b, 0xe2, 0x40,
+	0x18, 0x75, 0xd6, 0x55, 0x70, 0x58, 0x76, 0x31, 0xbb, 0xa0, 0xc9, 0xae, 0x41, 0xb2, 0x6d, 0x11,
+	0xa9, 0x99, 0x6a, 0xa1, 0x14, 0x7b, 0xaa, 0x87, 0x5e, 0x8a, 0x50, 0x94, 0x5e, 0x7a, 0x29, 0xd1,
+	0x4c, 0x67, 0x82, 0x49, 0x46, 0x32, 0x51, 0xf4, 0x56, 0x7a, 0xec, 0xa9, 0x7f, 0xa2, 0xd0, 0xa3,
+	0x87, 0xfe, 0x80, 0x1e, 0x7b, 0x94, 0x9e, 0x4a, 0x4f, 0x45, 0x0f, 0xfe, 0x8d, 0x62, 0x62, 0xb0,
+	0x46, 0x05, 0x4f, 0xbd, 0x24, 0xcc, 0x7b, 0x6f, 0xf2, 0xe6, 0xbd, 0x7c, 0x03, 0xff, 0x51, 0x6c,
+	0x58, 0xba, 0x66, 0x9a, 0xdd, 0x12, 0xa2, 0x9a, 0xa3, 0x5f, 0x31, 0xa7, 0x85, 0xdc, 0x9e, 0xda,
+	0x76, 0x98, 0xcb, 0x84, 0xdf, 0x73, 0x56, 0x0d, 0x58, 0x49, 0x6c, 0x32, 0x6e, 0x31, 0x7e, 0xe9,
+	0x49, 0x90, 0xbf, 0xf0, 0xf5, 0x52, 0xca, 0x5f, 0x21, 0x8b, 0x13, 0xd4, 0x2d, 0x4e, 0x5f, 0x33,
+	0x22, 0xa9, 0x59, 0x86, 0xcd, 0x90, 0xf7, 0xf4, 0x21, 0xe5, 0x09, 0xc0, 0x5f, 0x55, 0x4e, 0xea,
+	0x4d, 0x8a, 0xf5, 0x8e, 0x89, 0x4f, 0x98, 0xd3, 0x12, 0x8e, 0x60, 0x42, 0xeb, 0xb8, 0x94, 0x39,
+	0x86, 0xdb, 0x4f, 0x83, 0x2c, 0xc8, 0x25, 0x2a, 0x99, 0x97, 0xc7, 0xc2, 0x9f, 0x99, 0xc9, 0xb1,
+	0xae, 0x3b, 0x98, 0xf3, 0xba, 0xeb, 0x18, 0x36, 0x79, 0x98, 0x0c, 0xf2, 0xa0, 0x36, 0xd7, 0x0b,
+	0x22, 0xfc, 0x6e, 0x6b, 0x16, 0x4e, 0x7f, 0xf3, 0xf6, 0xc5, 0x7c, 0xde, 0x83, 0x84, 0x0c, 0x8c,
+	0x53, 0x6c, 0x10, 0xea, 0xa6, 0xa3, 0x59, 0x90, 0x8b, 0x06, 0xe4, 0x0c, 0x2c, 0x1f, 0xdc, 0x4c,
+	0x06, 0xf9, 0xf9, 0x97, 0x6e, 0x27, 0x83, 0xfc, 0xff, 0x55, 0xbd, 0x84, 0x8e, 0xab, 0x88, 0x30,
+	0x15, 0x82, 0x6a, 0x98, 0xb7, 0x99, 0xcd, 0xb1, 0x72, 0x0f, 0x60, 0xb2, 0xca, 0xc9, 0xb9, 0xcd,
+	0xbf, 0x20, 0x5f, 0xf9, 0x70, 0x39, 0xc0, 0xf6, 0x9a, 0x00, 0x8b, 0x27, 0x52, 0xfe, 0x42, 0x71,
+	0x09, 0x0c, 0x42, 0x94, 0xde, 0x00, 0x8c, 0x56, 0x39, 0x11, 0x1a, 0xf0, 0xc7, 0xc2, 0x6f, 0xda,
+	0x52, 0x57, 0xcc, 0x85, 0x1a, 0xaa, 0x42, 0xda, 0xdd, 0x44, 0x15, 0x78, 0x09, 0x14, 0xfe, 0x0c,
+	0x95, 0xb5, 0xb3, 0x6e, 0xff, 0xa2, 0x4e, 0x52, 0x37, 0xd3, 0x05, 0x4e, 0x52, 0xec, 0x7a, 0xda,
+	0x5c, 0xe5, 0xf4, 0x79, 0x24, 0x83, 0xe1, 0x48, 0x06, 0xef, 0x23, 0x19, 0xdc, 0x8d, 0xe5, 0xc8,
+	0x70, 0x2c, 0x47, 0x5e, 0xc7, 0x72, 0xe4, 0xa2, 0x48, 0x0c, 0x97, 0x76, 0x1a, 0x6a, 0x93, 0x59,
+	0x68, 0xaf, 0x77, 0xc6, 0xcc, 0x3e, 0x61, 0x36, 0x0a, 0x4c, 0x0a, 0xdd, 0x12, 0xea, 0x7d, 0xba,
+	0x2b, 0xfd, 0x36, 0xe6, 0x8d, 0xb8, 0x37, 0xd3, 0xfb, 0x1f, 0x03, 0x00, 0xcd, 0x84, 0x48, 0x40,
+	0x4f, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ScheduleFork defines a governance operation for setting the activation
+	// height of a hard fork not active yet. Only the governance authority can
+	// execute this, and not on the public networks.
+	ScheduleFork(ctx context.Context, in *MsgScheduleFork, opts ...grpc.CallOption) (*MsgScheduleForkResponse, error)
+	// UnscheduleFork defines a governance operation for removing the activation
+	// height of a hard fork not active yet from the registry, restoring the
+	// height set in the code. Only the governance authority can execute this,
+	// and not on the public networks.
+	UnscheduleFork(ctx context.Context, in *MsgUnscheduleFork, opts ...grpc.CallOption) (*MsgUnscheduleForkResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleFork(ctx context.Context, in *MsgScheduleFork, opts ...grpc.CallOption) (*MsgScheduleForkResponse, error) {
+	out := new(MsgScheduleForkResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.hardfork.Msg/ScheduleFork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnscheduleFork(ctx context.Context, in *MsgUnscheduleFork, opts ...grpc.CallOption) (*MsgUnscheduleForkResponse, error) {
+	out := new(MsgUnscheduleForkResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.hardfork.Msg/UnscheduleFork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ScheduleFork defines a governance operation for setting the activation
+	// height of a hard fork not active yet. Only the governance authority can
+	// execute this, and not on the public networks.
+	ScheduleFork(context.Context, *MsgScheduleFork) (*MsgScheduleForkResponse, error)
+	// UnscheduleFork defines a governance operation for removing the activation
+	// height of a hard fork not active yet from the registry, restoring the
+	// height set in the code. Only the governance authority can execute this,
+	// and not on the public networks.
+	UnscheduleFork(context.Context, *MsgUnscheduleFork) (*MsgUnscheduleForkResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ScheduleFork(ctx context.Context, req *MsgScheduleFork) (*MsgScheduleForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFork not implemented")
+}
+func (*UnimplementedMsgServer) UnscheduleFork(ctx context.Context, req *MsgUnscheduleFork) (*MsgUnscheduleForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnscheduleFork not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ScheduleFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.hardfork.Msg/ScheduleFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleFork(ctx, req.(*MsgScheduleFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnscheduleFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnscheduleFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnscheduleFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.hardfork.Msg/UnscheduleFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnscheduleFork(ctx, req.(*MsgUnscheduleFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdallv2.hardfork.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleFork",
+			Handler:    _Msg_ScheduleFork_Handler,
+		},
+		{
+			MethodName: "UnscheduleFork",
+			Handler:    _Msg_UnscheduleFork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdallv2/hardfork/tx.proto",
+}
+
+func (m *MsgScheduleFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleForkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleForkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleForkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnscheduleFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnscheduleFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnscheduleFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnscheduleForkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnscheduleForkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnscheduleForkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgScheduleFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgScheduleForkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnscheduleFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnscheduleForkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgScheduleFork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleFork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleFork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleForkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleForkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleForkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnscheduleFork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnscheduleFork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnscheduleFork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnscheduleForkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnscheduleForkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnscheduleForkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
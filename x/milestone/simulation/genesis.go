@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	borgrpc "github.com/0xPolygon/heimdall-v2/x/bor/grpc"
+	"github.com/0xPolygon/heimdall-v2/x/milestone/types"
+)
+
+const (
+	MaxMilestonePropositionLength = "max_milestone_proposition_length"
+	FfMilestoneBlockInterval      = "ff_milestone_block_interval"
+	FfMilestoneThreshold          = "ff_milestone_threshold"
+)
+
+// genMaxMilestonePropositionLength returns a randomized max milestone proposition length, within the bor batch size
+func genMaxMilestonePropositionLength(r *rand.Rand) uint64 {
+	return uint64(r.Intn(borgrpc.MaxBlockInfoBatchSize) + 1)
+}
+
+// genFfMilestoneBlockInterval returns a randomized fast-forward milestone block interval
+func genFfMilestoneBlockInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200) + 1)
+}
+
+// genFfMilestoneThreshold returns a randomized fast-forward milestone threshold, a multiple of the block interval above it
+func genFfMilestoneThreshold(r *rand.Rand, ffMilestoneBlockInterval uint64) uint64 {
+	return ffMilestoneBlockInterval * uint64(r.Intn(20)+2)
+}
+
+// RandomizedGenState generates a random GenesisState for milestone
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		maxMilestonePropositionLength uint64
+		ffMilestoneBlockInterval      uint64
+		ffMilestoneThreshold          uint64
+	)
+
+	simState.AppParams.GetOrGenerate(MaxMilestonePropositionLength, &maxMilestonePropositionLength, simState.Rand, func(r *rand.Rand) {
+		maxMilestonePropositionLength = genMaxMilestonePropositionLength(r)
+	})
+
+	simState.AppParams.GetOrGenerate(FfMilestoneBlockInterval, &ffMilestoneBlockInterval, simState.Rand, func(r *rand.Rand) {
+		ffMilestoneBlockInterval = genFfMilestoneBlockInterval(r)
+	})
+
+	simState.AppParams.GetOrGenerate(FfMilestoneThreshold, &ffMilestoneThreshold, simState.Rand, func(r *rand.Rand) {
+		ffMilestoneThreshold = genFfMilestoneThreshold(r, ffMilestoneBlockInterval)
+	})
+
+	params := types.Params{
+		MaxMilestonePropositionLength: maxMilestonePropositionLength,
+		FfMilestoneThreshold:          ffMilestoneThreshold,
+		FfMilestoneBlockInterval:      ffMilestoneBlockInterval,
+	}
+
+	milestoneGenesis := types.NewGenesisState(params)
+
+	bz, err := json.MarshalIndent(&milestoneGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated milestone parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&milestoneGenesis)
+}
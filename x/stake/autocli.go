@@ -58,12 +58,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get the current proposer",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "GetValidatorBlsPubKey",
+					Use:            "validator-bls-pub-key [id]",
+					Short:          "Query the BLS key registered by a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service:              stake.Msg_ServiceDesc.ServiceName,
 			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RegisterBlsKey",
+					Skip:      true, // built from the BLS key file by the custom command
+				},
 				{
 					RpcMethod: "StakeUpdate",
 					Use:       "stake-update [valAddress] [valId] [amount] [txHash] [logIndex] [blockNumber] [nonce]",
@@ -92,6 +102,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "nonce"},
 					},
 				},
+				{
+					RpcMethod: "ValidatorUnjail",
+					Use:       "validator-unjail [valAddress] [valId] [txHash] [logIndex] [blockNumber]",
+					Short:     "Unjail validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from"},
+						{ProtoField: "val_id"},
+						{ProtoField: "tx_hash"},
+						{ProtoField: "log_index"},
+						{ProtoField: "block_number"},
+					},
+				},
 			},
 		},
 	}
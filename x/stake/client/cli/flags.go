@@ -14,4 +14,5 @@ const (
 	FlagValidatorAddress = "validator"
 	FlagFeeAmount        = "fee-amount"
 	FlagAcceptDelegation = "accept-delegation"
+	FlagBlsKeyFile       = "bls-key-file"
 )
@@ -37,6 +37,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewValidatorJoinCmd(ac),
 		NewSignerUpdateCmd(ac),
+		NewRegisterBlsKeyCmd(ac),
 	)
 
 	return txCmd
@@ -101,7 +102,7 @@ func NewValidatorJoinCmd(ac address.Codec) *cobra.Command {
 			}
 
 			// get main tx receipt
-			receipt, err := contractCaller.GetConfirmedTxReceipt(cmd.Context(), common.HexToHash(txHash), cmParams.Params.MainChainTxConfirmations)
+			receipt, err := contractCaller.GetConfirmedTxReceipt(cmd.Context(), common.HexToHash(txHash), cmParams.Params.MainChainTxConfirmations, helper.ConfirmationMode(cmParams.Params.MainChainConfirmationMode))
 			if err != nil || receipt == nil {
 				return fmt.Errorf("transaction %s is not confirmed yet, please wait for some time and try again", txHash)
 			}
@@ -267,3 +268,57 @@ func NewSignerUpdateCmd(ac address.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// NewRegisterBlsKeyCmd returns a CLI command handler for creating a MsgRegisterBlsKey transaction.
+func NewRegisterBlsKeyCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-bls-key",
+		Short: "Register the BLS key of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get proposer
+			proposer := viper.GetString(FlagProposerAddress)
+			if proposer == "" {
+				proposer = clientCtx.GetFromAddress().String()
+			}
+
+			_, err = ac.StringToBytes(proposer)
+			if err != nil {
+				return fmt.Errorf("the proposer address is invalid: %w", err)
+			}
+
+			keyFile := viper.GetString(FlagBlsKeyFile)
+			if keyFile == "" {
+				return fmt.Errorf("the BLS key file is required, from the --%s flag", FlagBlsKeyFile)
+			}
+
+			secretKey, err := helper.LoadBlsKeyFile(keyFile)
+			if err != nil {
+				return err
+			}
+
+			blsPubKey, err := helper.BlsPubKeyFromSecretKey(secretKey)
+			if err != nil {
+				return err
+			}
+
+			proof, err := helper.BlsProofOfPossession(secretKey)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterBlsKey(proposer, blsPubKey, proof)
+
+			return cli.BroadcastMsg(clientCtx, proposer, msg, logger)
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagBlsKeyFile, "", "--bls-key-file=<bls-key-file>")
+
+	return cmd
+}
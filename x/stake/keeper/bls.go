@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+// SetValidatorBlsPubKey registers the BLS key of a validator, replacing the one it registered before.
+// A key can be registered by a single validator.
+func (k *Keeper) SetValidatorBlsPubKey(ctx context.Context, valID uint64, blsPubKey []byte) error {
+	owner, err := k.blsPubKeyOwners.Get(ctx, blsPubKey)
+	switch {
+	case err == nil && owner != valID:
+		return errorsmod.Wrapf(types.ErrBlsKeyAlreadyRegistered, "bls key registered by validator %d", owner)
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	previous, err := k.blsPubKeys.Get(ctx, valID)
+	switch {
+	case err == nil && !bytes.Equal(previous, blsPubKey):
+		if err := k.blsPubKeyOwners.Remove(ctx, previous); err != nil {
+			return err
+		}
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.blsPubKeys.Set(ctx, valID, blsPubKey); err != nil {
+		return err
+	}
+
+	return k.blsPubKeyOwners.Set(ctx, blsPubKey, valID)
+}
+
+// GetValidatorBlsPubKey returns the BLS key registered by a validator
+func (k *Keeper) GetValidatorBlsPubKey(ctx context.Context, valID uint64) ([]byte, error) {
+	blsPubKey, err := k.blsPubKeys.Get(ctx, valID)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching the bls key of validator %d: %w", valID, err)
+	}
+
+	return blsPubKey, nil
+}
+
+// HasValidatorBlsPubKey reports whether a validator registered a BLS key
+func (k *Keeper) HasValidatorBlsPubKey(ctx context.Context, valID uint64) (bool, error) {
+	return k.blsPubKeys.Has(ctx, valID)
+}
+
+// GetAllValidatorBlsPubKeys returns the BLS keys registered by the validators, ordered by validator ID
+func (k *Keeper) GetAllValidatorBlsPubKeys(ctx context.Context) ([]types.ValidatorBlsPubKey, error) {
+	iter, err := k.blsPubKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	blsPubKeys := make([]types.ValidatorBlsPubKey, 0)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		blsPubKeys = append(blsPubKeys, types.ValidatorBlsPubKey{ValId: kv.Key, BlsPubKey: kv.Value})
+	}
+
+	return blsPubKeys, nil
+}
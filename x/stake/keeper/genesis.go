@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -74,6 +76,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) []abc
 		}
 	}
 
+	for _, blsPubKey := range data.ValidatorBlsPubKeys {
+		if err := k.SetValidatorBlsPubKey(ctx, blsPubKey.ValId, blsPubKey.BlsPubKey); err != nil {
+			panic(fmt.Errorf("error in setting validator bls key while initializing stake genesis: %w", err))
+		}
+	}
+
 	validators := k.GetAllValidators(ctx)
 	cometVals := make([]abci.ValidatorUpdate, 0, len(validators))
 	for _, validator := range validators {
@@ -119,12 +127,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return nil
 	}
 
+	// the validator set from 2 blocks ago is only stored from the tally fix height
 	penultimateValidatorSet, err := k.GetPenultimateBlockValidatorSet(ctx)
-	if err != nil {
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		k.Logger(ctx).Error("Error in fetching penultimate validator set from store", "err", err)
 		return nil
 	}
 
+	blsPubKeys, err := k.GetAllValidatorBlsPubKeys(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Error in fetching validator bls keys from store", "err", err)
+		return nil
+	}
+
 	return &types.GenesisState{
 		Validators:                   k.GetAllValidators(ctx),
 		CurrentValidatorSet:          validatorSet,
@@ -132,5 +147,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		PreviousBlockValidatorSet:    previousValidatorSet,
 		LastBlockTxs:                 lastBlockTxs,
 		PenultimateBlockValidatorSet: penultimateValidatorSet,
+		ValidatorBlsPubKeys:          blsPubKeys,
 	}
 }
@@ -44,6 +44,10 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 	validatorSet := types.NewValidatorSet(validators)
 
 	genesisState := types.NewGenesisState(validators, *validatorSet, stakingSequence)
+	genesisState.ValidatorBlsPubKeys = []types.ValidatorBlsPubKey{
+		{ValId: 1, BlsPubKey: []byte("bls-pub-key-1")},
+		{ValId: 3, BlsPubKey: []byte("bls-pub-key-3")},
+	}
 	keeper.InitGenesis(ctx, genesisState)
 	valSet, err := keeper.GetPreviousBlockValidatorSet(ctx)
 	require.NoError(err)
@@ -51,6 +55,11 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 	require.Equal(validatorSet.Proposer.Signer, valSet.Proposer.Signer)
 	require.Equal(validatorSet.TotalVotingPower, valSet.TotalVotingPower)
 
+	// the validator set from 2 blocks ago isn't stored before the tally fix height
+	exported := keeper.ExportGenesis(ctx)
+	require.NotNil(exported)
+	require.Empty(exported.PenultimateBlockValidatorSet.Validators)
+
 	prevN := 3
 	prevAccounts := simulation.RandomAccounts(r, prevN)
 	prevValidators := make([]*types.Validator, prevN)
@@ -90,4 +99,5 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 	require.LessOrEqual(penN, len(actualParams.Validators))
 
 	require.Equal(customTxs, actualParams.LastBlockTxs.Txs)
+	require.Equal(genesisState.ValidatorBlsPubKeys, actualParams.ValidatorBlsPubKeys)
 }
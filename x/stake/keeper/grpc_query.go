@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/0xPolygon/heimdall-v2/common/hex"
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/metrics/api"
 	"github.com/0xPolygon/heimdall-v2/x/stake/types"
 )
@@ -157,7 +158,7 @@ func (q queryServer) IsStakeTxOld(ctx context.Context, req *types.QueryStakeIsOl
 	}
 
 	// get main tx receipt
-	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.HexToHash(req.TxHash), chainParams.MainChainTxConfirmations)
+	receipt, err := q.k.contractCaller.GetConfirmedTxReceipt(ctx, common.HexToHash(req.TxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -231,6 +232,24 @@ func (q queryServer) GetCurrentProposer(ctx context.Context, _ *types.QueryCurre
 	return &types.QueryCurrentProposerResponse{Validator: *proposer}, nil
 }
 
+// GetValidatorBlsPubKey queries the BLS key registered by a validator.
+func (q queryServer) GetValidatorBlsPubKey(ctx context.Context, req *types.QueryValidatorBlsPubKeyRequest) (*types.QueryValidatorBlsPubKeyResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeQueryMetric(api.GetValidatorBlsPubKeyMethod, startTime, &err)
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, errEmptyRequest)
+	}
+
+	blsPubKey, err := q.k.GetValidatorBlsPubKey(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryValidatorBlsPubKeyResponse{BlsPubKey: blsPubKey}, nil
+}
+
 func recordStakeQueryMetric(method string, start time.Time, err *error) {
 	success := *err == nil
 	api.RecordAPICallWithStart(api.StakeSubsystem, method, api.QueryType, success, start)
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/x/stake/testutil"
 	"github.com/0xPolygon/heimdall-v2/x/stake/types"
 )
@@ -136,7 +137,7 @@ func (s *KeeperTestSuite) TestHandleQueryStakingSequence() {
 	err = keeper.SetStakingSequence(ctx, sequence.String())
 	require.NoError(err)
 
-	contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.BytesToHash(common.FromHex(TxHash1)), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+	contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.BytesToHash(common.FromHex(TxHash1)), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 	res, err := queryClient.IsStakeTxOld(ctx, req)
 
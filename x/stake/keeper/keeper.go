@@ -33,6 +33,9 @@ type Keeper struct {
 	sequences    collections.Map[string, bool]
 	lastBlockTxs collections.Item[types.LastBlockTxs]
 
+	blsPubKeys      collections.Map[uint64, []byte]
+	blsPubKeyOwners collections.Map[[]byte, uint64]
+
 	setupComplete bool
 }
 
@@ -61,6 +64,9 @@ func NewKeeper(
 		signer:       collections.NewMap(sb, types.SignerKey, "signer", collections.Uint64Key, collections.StringValue),
 		lastBlockTxs: collections.NewItem(sb, types.LastBlockTxsKey, "last_block_txs", codec.CollValue[types.LastBlockTxs](cdc)),
 
+		blsPubKeys:      collections.NewMap(sb, types.BlsPubKeyKey, "bls_pub_key", collections.Uint64Key, collections.BytesValue),
+		blsPubKeyOwners: collections.NewMap(sb, types.BlsPubKeyOwnerKey, "bls_pub_key_owner", collections.BytesKey, collections.Uint64Value),
+
 		setupComplete: false,
 	}
 
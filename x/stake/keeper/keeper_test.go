@@ -64,7 +64,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.contractCaller = &mocks.IContractCaller{}
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-	cmk := cmKeeper.NewKeeper(encCfg.Codec, storeService, authority.String())
+	cmk := cmKeeper.NewKeeper(encCfg.Codec, storeService, authority.String(), s.contractCaller)
 	err := cmk.SetParams(ctx, cmTypes.DefaultParams())
 	s.Require().NoError(err)
 
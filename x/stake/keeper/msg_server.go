@@ -272,6 +272,155 @@ func (srv msgServer) ValidatorExit(ctx context.Context, msg *types.MsgValidatorE
 	return &types.MsgValidatorExitResponse{}, nil
 }
 
+// ValidatorSlashed defines a method for applying a root chain slashing to the affected validators
+func (srv msgServer) ValidatorSlashed(ctx context.Context, msg *types.MsgValidatorSlashed) (*types.MsgValidatorSlashedResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeTransactionMetric(api.ValidatorSlashedMethod, startTime, &err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsValidatorJail(sdkCtx.BlockHeight()) {
+		err = fmt.Errorf("MsgValidatorSlashed not allowed: block %d is before the validatorJailHeight %d",
+			sdkCtx.BlockHeight(), helper.GetValidatorJailHeight())
+		return nil, err
+	}
+
+	srv.k.Logger(ctx).Debug(helper.LogValidatingExternalCall("ValidatorSlashed"),
+		"slashingNonce", msg.SlashingNonce,
+		"amount", msg.Amount,
+		"validators", len(msg.Validators),
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgValidationFailed, hmTypes.LogKeyError, err)
+		return nil, errorsmod.Wrap(types.ErrInvalidMsg, hmTypes.ErrMsgValidationFailed)
+	}
+
+	// pull the slashed validators from store
+	for _, v := range msg.Validators {
+		if _, err = srv.k.GetValidatorFromValID(ctx, v.ValId); err != nil {
+			srv.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, "validatorID", v.ValId, "error", err)
+			return nil, errorsmod.Wrap(types.ErrNoValidator, hmTypes.ErrMsgFailedToFetchValidator)
+		}
+	}
+
+	// add the sequence
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(types.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if the event has already been processed
+	if srv.k.HasStakingSequence(ctx, sequence.String()) {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgEventAlreadyProcessed, hmTypes.LogKeySequence, sequence.String())
+		return nil, errors.Wrapf(sdkerrors.ErrConflict, hmTypes.ErrMsgOldEventsNotAllowed)
+	}
+
+	return &types.MsgValidatorSlashedResponse{}, nil
+}
+
+// ValidatorUnjail defines a method for unjailing a validator
+func (srv msgServer) ValidatorUnjail(ctx context.Context, msg *types.MsgValidatorUnjail) (*types.MsgValidatorUnjailResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeTransactionMetric(api.ValidatorUnjailMethod, startTime, &err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsValidatorJail(sdkCtx.BlockHeight()) {
+		err = fmt.Errorf("MsgValidatorUnjail not allowed: block %d is before the validatorJailHeight %d",
+			sdkCtx.BlockHeight(), helper.GetValidatorJailHeight())
+		return nil, err
+	}
+
+	srv.k.Logger(ctx).Debug(helper.LogValidatingExternalCall("ValidatorUnjail"),
+		"validatorID", msg.ValId,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgValidationFailed, hmTypes.LogKeyError, err)
+		return nil, errorsmod.Wrap(types.ErrInvalidMsg, hmTypes.ErrMsgValidationFailed)
+	}
+
+	validator, err := srv.k.GetValidatorFromValID(ctx, msg.ValId)
+	if err != nil {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, "validatorID", msg.ValId, "error", err)
+		return nil, errorsmod.Wrap(types.ErrNoValidator, hmTypes.ErrMsgFailedToFetchValidator)
+	}
+
+	// check if the validator is jailed
+	if !validator.Jailed {
+		srv.k.Logger(ctx).Error("Validator is not jailed", "validatorID", msg.ValId)
+		return nil, errorsmod.Wrap(types.ErrValNotJailed, "validator is not jailed")
+	}
+
+	// add the sequence
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(types.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if the event has already been processed
+	if srv.k.HasStakingSequence(ctx, sequence.String()) {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgEventAlreadyProcessed, hmTypes.LogKeySequence, sequence.String())
+		return nil, errors.Wrapf(sdkerrors.ErrConflict, hmTypes.ErrMsgOldEventsNotAllowed)
+	}
+
+	return &types.MsgValidatorUnjailResponse{}, nil
+}
+
+// RegisterBlsKey defines a method for a validator registering the BLS key signing its checkpoints
+func (srv msgServer) RegisterBlsKey(ctx context.Context, msg *types.MsgRegisterBlsKey) (*types.MsgRegisterBlsKeyResponse, error) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeTransactionMetric(api.RegisterBlsKeyMethod, startTime, &err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !helper.IsBlsCheckpoint(sdkCtx.BlockHeight()) {
+		err = fmt.Errorf("MsgRegisterBlsKey not allowed: block %d is before the blsCheckpointHeight %d",
+			sdkCtx.BlockHeight(), helper.GetBlsCheckpointHeight())
+		return nil, err
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgValidationFailed, hmTypes.LogKeyError, err)
+		return nil, errorsmod.Wrap(types.ErrInvalidMsg, hmTypes.ErrMsgValidationFailed)
+	}
+
+	validator, err := srv.k.GetActiveValidatorInfo(ctx, msg.From)
+	if err != nil {
+		srv.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, "signer", msg.From, "error", err)
+		return nil, errorsmod.Wrap(types.ErrNoValidator, hmTypes.ErrMsgFailedToFetchValidator)
+	}
+
+	// the proof of possession prevents a validator from registering a key derived from the ones of the others,
+	// which would let it forge their part of the aggregated signatures
+	if err = helper.VerifyBlsProofOfPossession(msg.BlsPubKey, msg.ProofOfPossession); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBlsKey, err.Error())
+	}
+
+	if err = srv.k.SetValidatorBlsPubKey(ctx, validator.ValId, msg.BlsPubKey); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterBlsKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ValId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlsPubKey, common.Bytes2Hex(msg.BlsPubKey)),
+		),
+	})
+
+	return &types.MsgRegisterBlsKeyResponse{}, nil
+}
+
 func recordStakeTransactionMetric(method string, start time.Time, err *error) {
 	success := *err == nil
 	api.RecordAPICallWithStart(api.StakeSubsystem, method, api.TxType, success, start)
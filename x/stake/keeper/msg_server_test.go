@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
 
 	util "github.com/0xPolygon/heimdall-v2/common/hex"
 	"github.com/0xPolygon/heimdall-v2/helper"
@@ -277,3 +278,76 @@ func (s *KeeperTestSuite) TestValidatorPubKey() {
 	genAddress := util.FormatAddress(modPubKey.Address().String())
 	require.Equal(valAddr, genAddress)
 }
+
+func (s *KeeperTestSuite) TestHandleMsgRegisterBlsKey() {
+	ctx, msgServer, keeper, require, checkpointKeeper, queryClient := s.ctx, s.msgServer, s.stakeKeeper, s.Require(), s.checkpointKeeper, s.queryClient
+
+	testutil.LoadRandomValidatorSet(require, 2, keeper, ctx, false, 0, 0)
+	checkpointKeeper.EXPECT().GetAckCount(gomock.Any()).AnyTimes().Return(uint64(1), nil)
+
+	validators := keeper.GetCurrentValidators(ctx)
+
+	newRegistration := func() (*stakingtypes.MsgRegisterBlsKey, []byte) {
+		secretKey, err := helper.GenerateBlsSecretKey()
+		require.NoError(err)
+
+		pubKey, err := helper.BlsPubKeyFromSecretKey(secretKey)
+		require.NoError(err)
+
+		proof, err := helper.BlsProofOfPossession(secretKey)
+		require.NoError(err)
+
+		return stakingtypes.NewMsgRegisterBlsKey(validators[0].Signer, pubKey, proof), secretKey
+	}
+
+	msg, _ := newRegistration()
+
+	// the registration isn't allowed before the fork
+	_, err := msgServer.RegisterBlsKey(ctx, msg)
+	require.ErrorContains(err, "before the blsCheckpointHeight")
+
+	helper.SetBlsCheckpointHeight(1)
+	defer helper.SetBlsCheckpointHeight(0)
+	ctx = ctx.WithBlockHeight(1)
+
+	// the proof of possession must be the one of the key
+	other, _ := newRegistration()
+	invalidProof := *msg
+	invalidProof.ProofOfPossession = other.ProofOfPossession
+	_, err = msgServer.RegisterBlsKey(ctx, &invalidProof)
+	require.ErrorIs(err, stakingtypes.ErrInvalidBlsKey)
+
+	// only a validator can register a key
+	notValidator := *msg
+	notValidator.From = util.FormatAddress(common.BytesToAddress([]byte("not a validator")).Hex())
+	_, err = msgServer.RegisterBlsKey(ctx, &notValidator)
+	require.ErrorIs(err, stakingtypes.ErrNoValidator)
+
+	_, err = msgServer.RegisterBlsKey(ctx, msg)
+	require.NoError(err)
+
+	res, err := queryClient.GetValidatorBlsPubKey(ctx, &stakingtypes.QueryValidatorBlsPubKeyRequest{Id: validators[0].ValId})
+	require.NoError(err)
+	require.Equal(msg.BlsPubKey, res.BlsPubKey)
+
+	_, err = queryClient.GetValidatorBlsPubKey(ctx, &stakingtypes.QueryValidatorBlsPubKeyRequest{Id: validators[1].ValId})
+	require.Error(err)
+
+	// a key can't be registered by two validators
+	taken := *msg
+	taken.From = validators[1].Signer
+	_, err = msgServer.RegisterBlsKey(ctx, &taken)
+	require.ErrorIs(err, stakingtypes.ErrBlsKeyAlreadyRegistered)
+
+	// a validator rotates its key, and frees the previous one
+	rotation, _ := newRegistration()
+	_, err = msgServer.RegisterBlsKey(ctx, rotation)
+	require.NoError(err)
+
+	_, err = msgServer.RegisterBlsKey(ctx, &taken)
+	require.NoError(err)
+
+	blsPubKeys, err := keeper.GetAllValidatorBlsPubKeys(ctx)
+	require.NoError(err)
+	require.Len(blsPubKeys, 2)
+}
@@ -26,6 +26,8 @@ var (
 	stakeUpdateMethod   = sdk.MsgTypeURL(&types.MsgStakeUpdate{})
 	signerUpdateMethod  = sdk.MsgTypeURL(&types.MsgSignerUpdate{})
 	validatorExitMethod = sdk.MsgTypeURL(&types.MsgValidatorExit{})
+	slashedMethod       = sdk.MsgTypeURL(&types.MsgValidatorSlashed{})
+	unjailMethod        = sdk.MsgTypeURL(&types.MsgValidatorUnjail{})
 )
 
 type sideMsgServer struct {
@@ -49,6 +51,10 @@ func (s *sideMsgServer) SideTxHandler(methodName string) sidetxs.SideTxHandler {
 		return s.SideHandleMsgSignerUpdate
 	case validatorExitMethod:
 		return s.SideHandleMsgValidatorExit
+	case slashedMethod:
+		return s.SideHandleMsgValidatorSlashed
+	case unjailMethod:
+		return s.SideHandleMsgValidatorUnjail
 	default:
 		return nil
 	}
@@ -65,6 +71,10 @@ func (s *sideMsgServer) PostTxHandler(methodName string) sidetxs.PostTxHandler {
 		return s.PostHandleMsgSignerUpdate
 	case validatorExitMethod:
 		return s.PostHandleMsgValidatorExit
+	case slashedMethod:
+		return s.PostHandleMsgValidatorSlashed
+	case unjailMethod:
+		return s.PostHandleMsgValidatorUnjail
 	default:
 		return nil
 	}
@@ -130,10 +140,11 @@ func (s *sideMsgServer) SideHandleMsgValidatorJoin(ctx sdk.Context, msgI sdk.Msg
 		ctx,
 		contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         msg.TxHash,
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.MainChainTxConfirmations,
-			ModuleName:     "stake",
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
 		},
 		s.k.Logger(ctx),
 	)
@@ -307,10 +318,11 @@ func (s *sideMsgServer) SideHandleMsgStakeUpdate(ctx sdk.Context, msgI sdk.Msg)
 		ctx,
 		contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         msg.TxHash,
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.MainChainTxConfirmations,
-			ModuleName:     "stake",
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
 		},
 		s.k.Logger(ctx),
 	)
@@ -408,10 +420,11 @@ func (s *sideMsgServer) SideHandleMsgSignerUpdate(ctx sdk.Context, msgI sdk.Msg)
 		ctx,
 		contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         msg.TxHash,
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.MainChainTxConfirmations,
-			ModuleName:     "stake",
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
 		},
 		s.k.Logger(ctx),
 	)
@@ -549,10 +562,11 @@ func (s *sideMsgServer) SideHandleMsgValidatorExit(ctx sdk.Context, msgI sdk.Msg
 		ctx,
 		contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         msg.TxHash,
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.MainChainTxConfirmations,
-			ModuleName:     "stake",
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
 		},
 		s.k.Logger(ctx),
 	)
@@ -588,6 +602,241 @@ func (s *sideMsgServer) SideHandleMsgValidatorExit(ctx sdk.Context, msgI sdk.Msg
 	return sidetxs.Vote_VOTE_YES
 }
 
+// SideHandleMsgValidatorSlashed handles side msg validator slashed
+func (s *sideMsgServer) SideHandleMsgValidatorSlashed(ctx sdk.Context, msgI sdk.Msg) (result sidetxs.Vote) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeMetric(api.SideHandleMsgValidatorSlashedMethod, api.SideType, startTime, &err)
+
+	msg, ok := msgI.(*types.MsgValidatorSlashed)
+	if !ok {
+		s.k.Logger(ctx).Error(helper.ErrTypeMismatch("MsgValidatorSlashed"))
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	s.k.Logger(ctx).Debug(helper.LogValidatingExternalCall("MsgValidatorSlashed"),
+		"txHash", common.Bytes2Hex(msg.TxHash),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if !helper.IsValidatorJail(ctx.BlockHeight()) {
+		s.k.Logger(ctx).Error("MsgValidatorSlashed not allowed before the validator jail height", "height", ctx.BlockHeight())
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		s.k.Logger(ctx).Error("Failed to validate MsgValidatorSlashed", hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	for _, v := range msg.Validators {
+		if _, err = s.k.GetValidatorFromValID(ctx, v.ValId); err != nil {
+			s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, hmTypes.LogKeyValidatorID, v.ValId, hmTypes.LogKeyError, err)
+			return sidetxs.Vote_VOTE_NO
+		}
+	}
+
+	// add the sequence
+	sequence := helper.CalculateSequence(msg.BlockNumber, msg.LogIndex)
+
+	// check if the event has already been processed
+	if s.k.HasStakingSequence(ctx, sequence) {
+		s.k.Logger(ctx).Error(helper.LogEventAlreadyProcessedIn("stake"), hmTypes.LogKeySequence, sequence)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	contractCaller := s.k.contractCaller
+
+	// chainManager params
+	params, err := s.k.cmKeeper.GetParams(ctx)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchParams, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	chainParams := params.ChainParams
+
+	// get and validate the main tx receipt
+	receipt := helper.FetchAndValidateReceipt(
+		ctx,
+		contractCaller,
+		helper.ReceiptValidationParams{
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
+		},
+		s.k.Logger(ctx),
+	)
+	if receipt == nil {
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// decode slashed event
+	eventLog, err := contractCaller.DecodeSlashedEvent(chainParams.StakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgErrorFetchingLog, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if eventLog.Nonce.Uint64() != msg.SlashingNonce {
+		s.k.Logger(ctx).Error("SlashingNonce in message doesn't match with nonce in log", "msgSlashingNonce", msg.SlashingNonce, "nonceFromTx", eventLog.Nonce)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgAmountMismatch, hmTypes.LogKeyMsgAmount, msg.Amount, hmTypes.LogKeyAmountFromEvent, eventLog.Amount)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// the validators jailed by the slashing are emitted in the same receipt
+	jailedLogs, err := contractCaller.DecodeJailedEvents(chainParams.StakingInfoAddress, receipt)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgErrorFetchingLog, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	jailed := make(map[uint64]bool, len(jailedLogs))
+	for _, jailedLog := range jailedLogs {
+		jailed[jailedLog.ValidatorId.Uint64()] = true
+	}
+
+	for _, v := range msg.Validators {
+		if v.Jailed != jailed[v.ValId] {
+			s.k.Logger(ctx).Error("Jailed status in message doesn't match with the receipt", hmTypes.LogKeyValidatorID, v.ValId, "msgJailed", v.Jailed)
+			return sidetxs.Vote_VOTE_NO
+		}
+		delete(jailed, v.ValId)
+
+		// check the voting power left on the root chain, as of the block of the slashing tx,
+		// which needs an archive node once the block is past the state retention of the node
+		mainChainValidator, err := contractCaller.GetValidatorInfoAtBlock(v.ValId, receipt.BlockNumber, chainParams.StakingInfoAddress)
+		if err != nil {
+			s.k.Logger(ctx).Error("Error while fetching the validator from root chain, eth_archive_rpc_url must point to an archive node to vote on past slashing txs",
+				hmTypes.LogKeyValidatorID, v.ValId, "blockNumber", receipt.BlockNumber, hmTypes.LogKeyError, err)
+			return sidetxs.Vote_VOTE_NO
+		}
+
+		if mainChainValidator.VotingPower != v.VotingPower {
+			s.k.Logger(ctx).Error("Voting power in message doesn't match with root chain", hmTypes.LogKeyValidatorID, v.ValId, "msgVotingPower", v.VotingPower, "mainChainVotingPower", mainChainValidator.VotingPower)
+			return sidetxs.Vote_VOTE_NO
+		}
+	}
+
+	// every validator jailed in the receipt must be part of the message
+	if len(jailed) != 0 {
+		s.k.Logger(ctx).Error("Jailed validators missing from MsgValidatorSlashed", "missing", len(jailed))
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	s.k.Logger(ctx).Debug(helper.LogSuccessfullyValidated("ValidatorSlashed"))
+
+	return sidetxs.Vote_VOTE_YES
+}
+
+// SideHandleMsgValidatorUnjail handles side msg validator unjail
+func (s *sideMsgServer) SideHandleMsgValidatorUnjail(ctx sdk.Context, msgI sdk.Msg) (result sidetxs.Vote) {
+	var err error
+	startTime := time.Now()
+	defer recordStakeMetric(api.SideHandleMsgValidatorUnjailMethod, api.SideType, startTime, &err)
+
+	msg, ok := msgI.(*types.MsgValidatorUnjail)
+	if !ok {
+		s.k.Logger(ctx).Error(helper.ErrTypeMismatch("MsgValidatorUnjail"))
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	s.k.Logger(ctx).Debug(helper.LogValidatingExternalCall("MsgValidatorUnjail"),
+		"txHash", common.Bytes2Hex(msg.TxHash),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if !helper.IsValidatorJail(ctx.BlockHeight()) {
+		s.k.Logger(ctx).Error("MsgValidatorUnjail not allowed before the validator jail height", "height", ctx.BlockHeight())
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		s.k.Logger(ctx).Error("Failed to validate MsgValidatorUnjail", hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	validator, err := s.k.GetValidatorFromValID(ctx, msg.ValId)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, hmTypes.LogKeyValidatorID, msg.ValId, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if !validator.Jailed {
+		s.k.Logger(ctx).Error("Validator is not jailed in stake side handler", hmTypes.LogKeyValidatorID, msg.ValId)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// add the sequence
+	sequence := helper.CalculateSequence(msg.BlockNumber, msg.LogIndex)
+
+	// check if the event has already been processed
+	if s.k.HasStakingSequence(ctx, sequence) {
+		s.k.Logger(ctx).Error(helper.LogEventAlreadyProcessedIn("stake"), hmTypes.LogKeySequence, sequence)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	contractCaller := s.k.contractCaller
+
+	// chainManager params
+	params, err := s.k.cmKeeper.GetParams(ctx)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchParams, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	chainParams := params.ChainParams
+
+	// get and validate the main tx receipt
+	receipt := helper.FetchAndValidateReceipt(
+		ctx,
+		contractCaller,
+		helper.ReceiptValidationParams{
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "stake",
+		},
+		s.k.Logger(ctx),
+	)
+	if receipt == nil {
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// decode unjailed event
+	eventLog, err := contractCaller.DecodeUnJailedEvent(chainParams.StakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgErrorFetchingLog, hmTypes.LogKeyError, err)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ValId {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgIDMismatch, hmTypes.LogKeyMsgID, msg.ValId, hmTypes.LogKeyValidatorIdFromTx, eventLog.ValidatorId)
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	// check signer in the event matches the validator's signer
+	if util.FormatAddress(eventLog.Signer.Hex()) != util.FormatAddress(validator.Signer) {
+		s.k.Logger(ctx).Error("Signer address does not match event log signer address", hmTypes.LogKeySigner, validator.Signer, "mainChainValidator", eventLog.Signer.Hex())
+		return sidetxs.Vote_VOTE_NO
+	}
+
+	s.k.Logger(ctx).Debug(helper.LogSuccessfullyValidated("ValidatorUnjail"))
+
+	return sidetxs.Vote_VOTE_YES
+}
+
 // PostHandleMsgValidatorJoin handles validator join message
 func (s *sideMsgServer) PostHandleMsgValidatorJoin(ctx sdk.Context, msgI sdk.Msg, sideTxResult sidetxs.Vote) error {
 	var err error
@@ -1031,6 +1280,170 @@ func (s *sideMsgServer) PostHandleMsgValidatorExit(ctx sdk.Context, msgI sdk.Msg
 	return nil
 }
 
+// PostHandleMsgValidatorSlashed handles msg validator slashed
+func (s *sideMsgServer) PostHandleMsgValidatorSlashed(ctx sdk.Context, msgI sdk.Msg, sideTxResult sidetxs.Vote) error {
+	var err error
+	startTime := time.Now()
+	defer recordStakeMetric(api.PostHandleMsgValidatorSlashedMethod, api.PostType, startTime, &err)
+
+	msg, ok := msgI.(*types.MsgValidatorSlashed)
+	if !ok {
+		err := errors.New(helper.ErrTypeMismatch("MsgValidatorSlashed"))
+		s.k.Logger(ctx).Error(err.Error())
+		return err
+	}
+
+	// skip handler if validator slashed is not approved
+	if !helper.IsSideTxApproved(sideTxResult) {
+		s.k.Logger(ctx).Debug(helper.ErrSkippingMsg("ValidatorSlashed"))
+		return errors.New(hmTypes.ErrMsgSideTxRejected)
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgValidationFailed, hmTypes.LogKeyError, err)
+		return errors.New(helper.ErrInvalidSideTxMsg("MsgValidatorSlashed"))
+	}
+
+	// check for replay attack
+	sequence := helper.CalculateSequence(msg.BlockNumber, msg.LogIndex)
+
+	// check if the event has already been processed
+	if s.k.HasStakingSequence(ctx, sequence) {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgEventAlreadyProcessed, hmTypes.LogKeySequence, sequence)
+		return errors.New(hmTypes.ErrMsgOldEventsNotAllowed)
+	}
+
+	s.k.Logger(ctx).Debug("Persisting validator slashing", "sideTxResult", sideTxResult)
+
+	// fetch all the validators first, so that the slashing is applied entirely or not at all
+	validators := make([]types.Validator, 0, len(msg.Validators))
+	for _, v := range msg.Validators {
+		validator, err := s.k.GetValidatorFromValID(ctx, v.ValId)
+		if err != nil {
+			s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, hmTypes.LogKeyValidatorID, v.ValId)
+			return err
+		}
+
+		validator.VotingPower = v.VotingPower
+		validator.LastUpdated = sequence
+		if v.Jailed {
+			validator.Jailed = true
+		}
+
+		validators = append(validators, validator)
+	}
+
+	for _, validator := range validators {
+		if err := s.k.AddValidator(ctx, validator); err != nil {
+			s.k.Logger(ctx).Error("Error while slashing validator", hmTypes.LogKeyError, err, hmTypes.LogKeyValidatorID, validator.ValId)
+			return err
+		}
+	}
+
+	// save the staking sequence
+	err = s.k.SetStakingSequence(ctx, sequence)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgUnableToSetSequence, hmTypes.LogKeyError, err)
+		return err
+	}
+
+	txBytes := ctx.TxBytes()
+
+	for _, validator := range validators {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSlashed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, common.Bytes2Hex(txBytes)),
+				sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),
+				sdk.NewAttribute(types.AttributeKeySlashingNonce, strconv.FormatUint(msg.SlashingNonce, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ValId, 10)),
+				sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(validator.Jailed)),
+			),
+		})
+	}
+
+	return nil
+}
+
+// PostHandleMsgValidatorUnjail handles msg validator unjail
+func (s *sideMsgServer) PostHandleMsgValidatorUnjail(ctx sdk.Context, msgI sdk.Msg, sideTxResult sidetxs.Vote) error {
+	var err error
+	startTime := time.Now()
+	defer recordStakeMetric(api.PostHandleMsgValidatorUnjailMethod, api.PostType, startTime, &err)
+
+	msg, ok := msgI.(*types.MsgValidatorUnjail)
+	if !ok {
+		err := errors.New(helper.ErrTypeMismatch("MsgValidatorUnjail"))
+		s.k.Logger(ctx).Error(err.Error())
+		return err
+	}
+
+	// skip handler if validator unjail is not approved
+	if !helper.IsSideTxApproved(sideTxResult) {
+		s.k.Logger(ctx).Debug(helper.ErrSkippingMsg("ValidatorUnjail"))
+		return errors.New(hmTypes.ErrMsgSideTxRejected)
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgValidationFailed, hmTypes.LogKeyError, err)
+		return errors.New(helper.ErrInvalidSideTxMsg("MsgValidatorUnjail"))
+	}
+
+	// check for replay attack
+	sequence := helper.CalculateSequence(msg.BlockNumber, msg.LogIndex)
+
+	// check if the event has already been processed
+	if s.k.HasStakingSequence(ctx, sequence) {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgEventAlreadyProcessed, hmTypes.LogKeySequence, sequence)
+		return errors.New(hmTypes.ErrMsgOldEventsNotAllowed)
+	}
+
+	validator, err := s.k.GetValidatorFromValID(ctx, msg.ValId)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgFailedToFetchValidator, hmTypes.LogKeyValidatorID, msg.ValId)
+		return err
+	}
+
+	if !validator.Jailed {
+		s.k.Logger(ctx).Error("Validator is not jailed", hmTypes.LogKeyValidatorID, msg.ValId)
+		return types.ErrValNotJailed
+	}
+
+	s.k.Logger(ctx).Debug("Persisting validator unjail", "sideTxResult", sideTxResult)
+
+	validator.Jailed = false
+	validator.LastUpdated = sequence
+
+	if err := s.k.AddValidator(ctx, validator); err != nil {
+		s.k.Logger(ctx).Error("Error while unjailing validator", hmTypes.LogKeyError, err, hmTypes.LogKeyValidatorID, validator.ValId)
+		return err
+	}
+
+	// save the staking sequence
+	err = s.k.SetStakingSequence(ctx, sequence)
+	if err != nil {
+		s.k.Logger(ctx).Error(hmTypes.ErrMsgUnableToSetSequence, hmTypes.LogKeyError, err)
+		return err
+	}
+
+	txBytes := ctx.TxBytes()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, common.Bytes2Hex(txBytes)),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ValId, 10)),
+		),
+	})
+
+	return nil
+}
+
 // recordStakeMetric records metrics for side and post-handlers.
 func recordStakeMetric(method string, apiType string, start time.Time, err *error) {
 	success := *err == nil
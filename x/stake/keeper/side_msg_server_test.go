@@ -93,7 +93,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -131,7 +131,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(nil, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(nil, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -158,7 +158,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 
 		req.NoError(err)
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(nil, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -195,7 +195,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -232,7 +232,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -269,7 +269,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -307,7 +307,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -345,7 +345,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -383,7 +383,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -421,7 +421,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubKey.Bytes()[1:],
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(txHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, msgValJoin.LogIndex).Return(stakingInfoStaked, nil)
 
 		result := sideHandler(ctx, msgValJoin)
@@ -470,7 +470,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -493,7 +493,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeSignerUpdateEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, uint64(0)).Return(nil, nil)
 
 		result := sideHandler(ctx, msg)
@@ -515,7 +515,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -536,7 +536,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -557,7 +557,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -578,7 +578,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -599,7 +599,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgSignerUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ValId),
@@ -642,7 +642,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -677,7 +677,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(nil, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(nil, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -712,7 +712,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		validators[0].EndEpoch = 10
 
@@ -741,7 +741,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
 			User:              validator0Address,
@@ -775,7 +775,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -810,7 +810,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -844,7 +844,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakingInfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -907,7 +907,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -933,7 +933,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(nil, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(nil, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -960,7 +960,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, uint64(0)).Return(nil, nil)
 
 		result := sideHandler(ctx, msg)
@@ -980,7 +980,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -1007,7 +1007,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -1034,7 +1034,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -1060,7 +1060,7 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 		req.NoError(err)
 
 		txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
 
 		stakingInfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 			ValidatorId: new(big.Int).SetUint64(oldVal.ValId),
@@ -1074,6 +1074,208 @@ func (s *KeeperTestSuite) TestSideHandleMsgStakeUpdate() {
 	})
 }
 
+func (s *KeeperTestSuite) TestSideHandleMsgValidatorSlashed() {
+	keeper, checkpointKeeper, cmKeeper, sideHandler := s.stakeKeeper, s.checkpointKeeper, s.cmKeeper, s.sideHandler
+	ctx, req, contractCaller := s.ctx.WithBlockHeight(1), s.Require(), s.contractCaller
+
+	helper.SetValidatorJailHeight(1)
+	defer helper.SetValidatorJailHeight(0)
+
+	stakeSim.LoadRandomValidatorSet(req, 4, keeper, ctx, false, 0, 0)
+	checkpointKeeper.EXPECT().GetAckCount(ctx).AnyTimes().Return(uint64(1), nil)
+
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := common.Hash{}.Bytes()
+	chainParams, err := cmKeeper.GetParams(ctx)
+	req.NoError(err)
+	logIndex := uint64(0)
+	blockNumber := big.NewInt(10)
+	slashingNonce := big.NewInt(1)
+	amount, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+
+	slashed := []types.SlashedValidator{
+		{ValId: validators[0].ValId, VotingPower: 0, Jailed: true},
+		{ValId: validators[1].ValId, VotingPower: validators[1].VotingPower - 1, Jailed: false},
+	}
+
+	mockSlashing := func(jailedIDs ...uint64) {
+		contractCaller.Mock = mock.Mock{}
+		txReceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
+		contractCaller.On("DecodeSlashedEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, logIndex).Return(&stakinginfo.StakinginfoSlashed{
+			Nonce:  slashingNonce,
+			Amount: amount,
+		}, nil)
+
+		jailedLogs := make([]*stakinginfo.StakinginfoJailed, 0, len(jailedIDs))
+		for _, id := range jailedIDs {
+			jailedLogs = append(jailedLogs, &stakinginfo.StakinginfoJailed{ValidatorId: new(big.Int).SetUint64(id)})
+		}
+
+		contractCaller.On("DecodeJailedEvents", chainParams.ChainParams.StakingInfoAddress, txReceipt).Return(jailedLogs, nil)
+
+		for _, v := range slashed {
+			contractCaller.On("GetValidatorInfoAtBlock", v.ValId, blockNumber, chainParams.ChainParams.StakingInfoAddress).Return(types.Validator{ValId: v.ValId, VotingPower: v.VotingPower}, nil)
+		}
+	}
+
+	newMsg := func(slashedValidators []types.SlashedValidator) *types.MsgValidatorSlashed {
+		msg, err := types.NewMsgValidatorSlashed(
+			validators[0].Signer,
+			slashingNonce.Uint64(),
+			math.NewIntFromBigInt(amount),
+			slashedValidators,
+			msgTxHash,
+			logIndex,
+			blockNumber.Uint64(),
+		)
+		req.NoError(err)
+
+		return msg
+	}
+
+	s.Run("Success", func() {
+		mockSlashing(validators[0].ValId)
+
+		result := sideHandler(ctx, newMsg(slashed))
+		req.Equal(sidetxs.Vote_VOTE_YES, result, "Side tx handler should succeed")
+	})
+
+	s.Run("Before the validator jail height", func() {
+		mockSlashing(validators[0].ValId)
+
+		result := sideHandler(ctx.WithBlockHeight(0), newMsg(slashed))
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Missing jailed validator", func() {
+		mockSlashing(validators[0].ValId, validators[2].ValId)
+
+		result := sideHandler(ctx, newMsg(slashed))
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Jailed status mismatch", func() {
+		mockSlashing()
+
+		result := sideHandler(ctx, newMsg(slashed))
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Voting power mismatch", func() {
+		mockSlashing(validators[0].ValId)
+
+		invalid := []types.SlashedValidator{
+			slashed[0],
+			{ValId: slashed[1].ValId, VotingPower: slashed[1].VotingPower + 1},
+		}
+
+		result := sideHandler(ctx, newMsg(invalid))
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Invalid slashing nonce", func() {
+		mockSlashing(validators[0].ValId)
+
+		msg := newMsg(slashed)
+		msg.SlashingNonce++
+
+		result := sideHandler(ctx, msg)
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Invalid amount", func() {
+		mockSlashing(validators[0].ValId)
+
+		msg := newMsg(slashed)
+		msg.Amount = msg.Amount.AddRaw(1)
+
+		result := sideHandler(ctx, msg)
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+}
+
+func (s *KeeperTestSuite) TestSideHandleMsgValidatorUnjail() {
+	keeper, checkpointKeeper, cmKeeper, sideHandler := s.stakeKeeper, s.checkpointKeeper, s.cmKeeper, s.sideHandler
+	ctx, req, contractCaller := s.ctx.WithBlockHeight(1), s.Require(), s.contractCaller
+
+	helper.SetValidatorJailHeight(1)
+	defer helper.SetValidatorJailHeight(0)
+
+	stakeSim.LoadRandomValidatorSet(req, 4, keeper, ctx, false, 0, 0)
+	checkpointKeeper.EXPECT().GetAckCount(ctx).AnyTimes().Return(uint64(1), nil)
+
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := common.Hash{}.Bytes()
+	chainParams, err := cmKeeper.GetParams(ctx)
+	req.NoError(err)
+	logIndex := uint64(0)
+	blockNumber := big.NewInt(10)
+
+	jailedValidator := validators[0]
+	jailedValidator.Jailed = true
+	req.NoError(keeper.AddValidator(ctx, jailedValidator))
+
+	signerBytes, err := addressCodec.StringToBytes(jailedValidator.Signer)
+	req.NoError(err)
+
+	mockUnjail := func(signer common.Address) {
+		contractCaller.Mock = mock.Mock{}
+		txReceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, common.Hash(msgTxHash), chainParams.MainChainTxConfirmations, helper.ConfirmationMode(chainParams.MainChainConfirmationMode)).Return(txReceipt, nil)
+		contractCaller.On("DecodeUnJailedEvent", chainParams.ChainParams.StakingInfoAddress, txReceipt, logIndex).Return(&stakinginfo.StakinginfoUnJailed{
+			ValidatorId: new(big.Int).SetUint64(jailedValidator.ValId),
+			Signer:      signer,
+		}, nil)
+	}
+
+	s.Run("Success", func() {
+		mockUnjail(common.BytesToAddress(signerBytes))
+
+		msg, err := types.NewMsgValidatorUnjail(jailedValidator.Signer, jailedValidator.ValId, msgTxHash, logIndex, blockNumber.Uint64())
+		req.NoError(err)
+
+		result := sideHandler(ctx, msg)
+		req.Equal(sidetxs.Vote_VOTE_YES, result, "Side tx handler should succeed")
+	})
+
+	s.Run("Invalid signer", func() {
+		mockUnjail(common.BytesToAddress([]byte("invalid-signer")))
+
+		msg, err := types.NewMsgValidatorUnjail(jailedValidator.Signer, jailedValidator.ValId, msgTxHash, logIndex, blockNumber.Uint64())
+		req.NoError(err)
+
+		result := sideHandler(ctx, msg)
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Before the validator jail height", func() {
+		mockUnjail(common.BytesToAddress(signerBytes))
+
+		msg, err := types.NewMsgValidatorUnjail(jailedValidator.Signer, jailedValidator.ValId, msgTxHash, logIndex, blockNumber.Uint64())
+		req.NoError(err)
+
+		result := sideHandler(ctx.WithBlockHeight(0), msg)
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+
+	s.Run("Validator not jailed", func() {
+		mockUnjail(common.BytesToAddress(signerBytes))
+
+		msg, err := types.NewMsgValidatorUnjail(validators[1].Signer, validators[1].ValId, msgTxHash, logIndex, blockNumber.Uint64())
+		req.NoError(err)
+
+		result := sideHandler(ctx, msg)
+		req.Equal(sidetxs.Vote_VOTE_NO, result, "Side tx handler should fail")
+	})
+}
+
 func (s *KeeperTestSuite) TestPostHandleMsgValidatorJoin() {
 	keeper, postHandler := s.stakeKeeper, s.postHandler
 	ctx, req, contractCaller := s.ctx, s.Require(), s.contractCaller
@@ -1452,6 +1654,95 @@ func (s *KeeperTestSuite) TestPostHandleMsgStakeUpdate_ExitedValidator() {
 	})
 }
 
+func (s *KeeperTestSuite) TestPostHandleMsgValidatorSlashed() {
+	keeper, postHandler, checkpointKeeper := s.stakeKeeper, s.postHandler, s.checkpointKeeper
+	ctx, req := s.ctx, s.Require()
+
+	stakeSim.LoadRandomValidatorSet(req, 4, keeper, ctx, false, 0, 0)
+	checkpointKeeper.EXPECT().GetAckCount(gomock.Any()).AnyTimes().Return(uint64(1), nil)
+
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := common.Hash{}.Bytes()
+	blockNumber := big.NewInt(10)
+
+	slashed := []types.SlashedValidator{
+		{ValId: validators[0].ValId, VotingPower: 0, Jailed: true},
+		{ValId: validators[1].ValId, VotingPower: validators[1].VotingPower - 1, Jailed: false},
+	}
+
+	msg, err := types.NewMsgValidatorSlashed(
+		validators[0].Signer,
+		1,
+		math.NewInt(1000),
+		slashed,
+		msgTxHash,
+		0,
+		blockNumber.Uint64(),
+	)
+	req.NoError(err)
+
+	s.Run("No Success", func() {
+		postHandler(ctx, msg, sidetxs.Vote_VOTE_NO)
+
+		validator, err := keeper.GetValidatorFromValID(ctx, validators[0].ValId)
+		req.NoError(err)
+		req.False(validator.Jailed)
+	})
+
+	s.Run("Success", func() {
+		postHandler(ctx, msg, sidetxs.Vote_VOTE_YES)
+
+		jailed, err := keeper.GetValidatorFromValID(ctx, validators[0].ValId)
+		req.NoError(err)
+		req.True(jailed.Jailed)
+		req.Equal(int64(0), jailed.VotingPower)
+
+		updated, err := keeper.GetValidatorFromValID(ctx, validators[1].ValId)
+		req.NoError(err)
+		req.False(updated.Jailed)
+		req.Equal(slashed[1].VotingPower, updated.VotingPower)
+
+		sequence := helper.CalculateSequence(blockNumber.Uint64(), 0)
+		req.True(keeper.HasStakingSequence(ctx, sequence))
+	})
+}
+
+func (s *KeeperTestSuite) TestPostHandleMsgValidatorUnjail() {
+	keeper, postHandler, checkpointKeeper := s.stakeKeeper, s.postHandler, s.checkpointKeeper
+	ctx, req := s.ctx, s.Require()
+
+	stakeSim.LoadRandomValidatorSet(req, 4, keeper, ctx, false, 0, 0)
+	checkpointKeeper.EXPECT().GetAckCount(gomock.Any()).AnyTimes().Return(uint64(1), nil)
+
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := common.Hash{}.Bytes()
+	blockNumber := big.NewInt(10)
+
+	jailedValidator := validators[0]
+	jailedValidator.Jailed = true
+	req.NoError(keeper.AddValidator(ctx, jailedValidator))
+
+	msg, err := types.NewMsgValidatorUnjail(jailedValidator.Signer, jailedValidator.ValId, msgTxHash, 0, blockNumber.Uint64())
+	req.NoError(err)
+
+	s.Run("No Success", func() {
+		postHandler(ctx, msg, sidetxs.Vote_VOTE_NO)
+
+		validator, err := keeper.GetValidatorFromValID(ctx, jailedValidator.ValId)
+		req.NoError(err)
+		req.True(validator.Jailed)
+	})
+
+	s.Run("Success", func() {
+		postHandler(ctx, msg, sidetxs.Vote_VOTE_YES)
+
+		validator, err := keeper.GetValidatorFromValID(ctx, jailedValidator.ValId)
+		req.NoError(err)
+		req.False(validator.Jailed)
+		req.Equal(jailedValidator.Nonce, validator.Nonce)
+	})
+}
+
 func TestEventCheck(t *testing.T) {
 	t.Parallel()
 
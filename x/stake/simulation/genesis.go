@@ -3,31 +3,59 @@ package simulation
 import (
 	"math/rand"
 	"strconv"
-	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
-	stakeSim "github.com/0xPolygon/heimdall-v2/x/stake/testutil"
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
 	"github.com/0xPolygon/heimdall-v2/x/stake/types"
 )
 
+const (
+	VotingPower     = "voting_power"
+	StakingSequence = "staking_sequence"
+)
+
+// genVotingPower returns a randomized voting power
+func genVotingPower(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 10000))
+}
+
+// genStakingSequence returns a random staking sequence
+func genStakingSequence(r *rand.Rand) string {
+	return strconv.Itoa(simulation.RandIntBetween(r, 1000, 100000))
+}
+
+// RandomizedGenState generates a random GenesisState for stake, where the first bonded simulation accounts are the validators,
+// so that the app simulation can sign their vote extensions
 func RandomizedGenState(simState *module.SimulationState) {
-	s1 := rand.NewSource(time.Now().UnixNano())
-	r1 := rand.New(s1) //nolint:gosec // weak random is acceptable for simulations
-	n := 5
+	n := min(int(simState.NumBonded), len(simState.Accounts))
+
+	validators := make([]*types.Validator, n)
 	stakingSequence := make([]string, n)
 
-	for i := range stakingSequence {
-		stakingSequence[i] = strconv.Itoa(simulation.RandIntBetween(r1, 1000, 100000))
-	}
+	for i := range n {
+		var (
+			votingPower int64
+			sequence    string
+		)
 
-	randValidators := stakeSim.GenRandomVals(n, 0, 10, uint64(10), false, 1, 0)
+		simState.AppParams.GetOrGenerate(VotingPower, &votingPower, simState.Rand, func(r *rand.Rand) {
+			votingPower = genVotingPower(r)
+		})
 
-	validators := make([]*types.Validator, n)
+		simState.AppParams.GetOrGenerate(StakingSequence, &sequence, simState.Rand, func(r *rand.Rand) {
+			sequence = genStakingSequence(r)
+		})
 
-	for i := range len(randValidators) {
-		validators[i] = &randValidators[i]
+		account := simState.Accounts[i]
+		validators[i] = &types.Validator{
+			ValId:       uint64(i) + 1,
+			VotingPower: votingPower,
+			PubKey:      account.PubKey.Bytes(),
+			Signer:      util.FormatAddress(account.Address.String()),
+		}
+		stakingSequence[i] = sequence
 	}
 
 	validatorSet := types.NewValidatorSet(validators)
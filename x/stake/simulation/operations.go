@@ -0,0 +1,453 @@
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
+	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/stake/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/stake/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgValidatorJoin    = "op_weight_msg_validator_join"
+	OpWeightMsgStakeUpdate      = "op_weight_msg_stake_update"
+	OpWeightMsgSignerUpdate     = "op_weight_msg_signer_update"
+	OpWeightMsgValidatorExit    = "op_weight_msg_validator_exit"
+	OpWeightMsgValidatorSlashed = "op_weight_msg_validator_slashed"
+	OpWeightMsgValidatorUnjail  = "op_weight_msg_validator_unjail"
+	OpWeightMsgRegisterBlsKey   = "op_weight_msg_register_bls_key"
+
+	DefaultWeightMsgValidatorJoin    = 10
+	DefaultWeightMsgStakeUpdate      = 30
+	DefaultWeightMsgSignerUpdate     = 10
+	DefaultWeightMsgValidatorExit    = 5
+	DefaultWeightMsgValidatorSlashed = 5
+	DefaultWeightMsgValidatorUnjail  = 10
+	DefaultWeightMsgRegisterBlsKey   = 10
+)
+
+// minActiveValidators is the number of active validators the exits and the jailing leave in the validator set,
+// so that the simulated chain keeps producing blocks
+const minActiveValidators = 2
+
+// maxSimulatedPower bounds the voting power of the simulated stakes
+const maxSimulatedPower = 10000
+
+// WeightedOperations returns all the operations from the stake module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper) []hmSimulation.WeightedOperation {
+	var (
+		weightMsgValidatorJoin    int
+		weightMsgStakeUpdate      int
+		weightMsgSignerUpdate     int
+		weightMsgValidatorExit    int
+		weightMsgValidatorSlashed int
+		weightMsgValidatorUnjail  int
+		weightMsgRegisterBlsKey   int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgValidatorJoin, &weightMsgValidatorJoin, nil, func(_ *rand.Rand) {
+		weightMsgValidatorJoin = DefaultWeightMsgValidatorJoin
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgStakeUpdate, &weightMsgStakeUpdate, nil, func(_ *rand.Rand) {
+		weightMsgStakeUpdate = DefaultWeightMsgStakeUpdate
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgSignerUpdate, &weightMsgSignerUpdate, nil, func(_ *rand.Rand) {
+		weightMsgSignerUpdate = DefaultWeightMsgSignerUpdate
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgValidatorExit, &weightMsgValidatorExit, nil, func(_ *rand.Rand) {
+		weightMsgValidatorExit = DefaultWeightMsgValidatorExit
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgValidatorSlashed, &weightMsgValidatorSlashed, nil, func(_ *rand.Rand) {
+		weightMsgValidatorSlashed = DefaultWeightMsgValidatorSlashed
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgValidatorUnjail, &weightMsgValidatorUnjail, nil, func(_ *rand.Rand) {
+		weightMsgValidatorUnjail = DefaultWeightMsgValidatorUnjail
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgRegisterBlsKey, &weightMsgRegisterBlsKey, nil, func(_ *rand.Rand) {
+		weightMsgRegisterBlsKey = DefaultWeightMsgRegisterBlsKey
+	})
+
+	return []hmSimulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgValidatorJoin, SimulateMsgValidatorJoin(k)),
+		hmSimulation.NewWeightedOperation(weightMsgStakeUpdate, SimulateMsgStakeUpdate(k)),
+		hmSimulation.NewWeightedOperation(weightMsgSignerUpdate, SimulateMsgSignerUpdate(k)),
+		hmSimulation.NewWeightedOperation(weightMsgValidatorExit, SimulateMsgValidatorExit(k)),
+		hmSimulation.NewWeightedOperation(weightMsgValidatorSlashed, SimulateMsgValidatorSlashed(k)),
+		hmSimulation.NewWeightedOperation(weightMsgValidatorUnjail, SimulateMsgValidatorUnjail(k)),
+		hmSimulation.NewWeightedOperation(weightMsgRegisterBlsKey, SimulateMsgRegisterBlsKey(k)),
+	}
+}
+
+// SimulateMsgValidatorJoin generates a MsgValidatorJoin staking a simulation account which isn't a validator yet
+func SimulateMsgValidatorJoin(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgValidatorJoin{})
+
+		candidates := make([]simtypes.Account, 0, len(accs))
+		for _, acc := range accs {
+			if _, err := k.GetValidatorInfo(ctx, util.FormatAddress(acc.Address.String())); err != nil {
+				candidates = append(candidates, acc)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "all the accounts are validators"), nil
+		}
+
+		validatorAcc, _ := simtypes.RandomAcc(r, candidates)
+
+		var valID uint64
+		for _, validator := range k.GetAllValidators(ctx) {
+			valID = max(valID, validator.ValId)
+		}
+
+		valID++
+
+		amount := randomStakeAmount(r)
+		nonce := uint64(r.Intn(maxSimulatedPower))
+		activationEpoch := uint64(r.Intn(2))
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgValidatorJoin(
+			validatorAcc.Address.String(),
+			valID,
+			activationEpoch,
+			amount,
+			validatorAcc.PubKey,
+			txHash.Bytes(),
+			logIndex,
+			blockNumber,
+			nonce,
+		)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeValidatorJoinEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoStaked{
+			Signer:          common.BytesToAddress(validatorAcc.Address),
+			ValidatorId:     new(big.Int).SetUint64(valID),
+			Nonce:           new(big.Int).SetUint64(nonce),
+			ActivationEpoch: new(big.Int).SetUint64(activationEpoch),
+			Amount:          amount.BigInt(),
+			Total:           amount.BigInt(),
+			SignerPubkey:    validatorAcc.PubKey.Bytes()[1:],
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, validatorAcc, ""), nil
+	}
+}
+
+// SimulateMsgStakeUpdate generates a MsgStakeUpdate changing the stake of a random validator
+func SimulateMsgStakeUpdate(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgStakeUpdate{})
+
+		validator, ok := randomValidator(r, ctx, k, func(v *types.Validator) bool { return v.EndEpoch == 0 })
+		if !ok {
+			return hmSimulation.NoOpMsg(msgType, "no validator to update"), nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		newAmount := randomStakeAmount(r)
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgStakeUpdate(from.Address.String(), validator.ValId, newAmount, txHash.Bytes(), logIndex, blockNumber, validator.Nonce+1)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeValidatorStakeUpdateEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(validator.ValId),
+			Nonce:       new(big.Int).SetUint64(msg.Nonce),
+			NewAmount:   newAmount.BigInt(),
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, from, ""), nil
+	}
+}
+
+// SimulateMsgSignerUpdate generates a MsgSignerUpdate moving a random validator to a simulation account
+// which isn't a validator yet
+func SimulateMsgSignerUpdate(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSignerUpdate{})
+
+		validator, ok := randomValidator(r, ctx, k, func(v *types.Validator) bool { return v.EndEpoch == 0 })
+		if !ok {
+			return hmSimulation.NoOpMsg(msgType, "no validator to update"), nil
+		}
+
+		candidates := make([]simtypes.Account, 0, len(accs))
+		for _, acc := range accs {
+			if _, err := k.GetValidatorInfo(ctx, util.FormatAddress(acc.Address.String())); err != nil {
+				candidates = append(candidates, acc)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no account left for the new signer"), nil
+		}
+
+		newSigner, _ := simtypes.RandomAcc(r, candidates)
+		from, _ := simtypes.RandomAcc(r, accs)
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgSignerUpdate(
+			from.Address.String(),
+			validator.ValId,
+			newSigner.PubKey.Bytes(),
+			txHash.Bytes(),
+			logIndex,
+			blockNumber,
+			validator.Nonce+1,
+		)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeSignerUpdateEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoSignerChange{
+			ValidatorId:  new(big.Int).SetUint64(validator.ValId),
+			Nonce:        new(big.Int).SetUint64(msg.Nonce),
+			OldSigner:    common.HexToAddress(validator.Signer),
+			NewSigner:    common.BytesToAddress(newSigner.Address),
+			SignerPubkey: newSigner.PubKey.Bytes()[1:],
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, from, ""), nil
+	}
+}
+
+// SimulateMsgValidatorExit generates a MsgValidatorExit unstaking a random validator,
+// as long as enough validators remain active
+func SimulateMsgValidatorExit(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgValidatorExit{})
+
+		if countActiveValidators(ctx, k) <= minActiveValidators {
+			return hmSimulation.NoOpMsg(msgType, "not enough active validators"), nil
+		}
+
+		validator, ok := randomValidator(r, ctx, k, func(v *types.Validator) bool { return v.EndEpoch == 0 && !v.Jailed })
+		if !ok {
+			return hmSimulation.NoOpMsg(msgType, "no validator to unstake"), nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		deactivationEpoch := validator.StartEpoch + uint64(simtypes.RandIntBetween(r, 1, 100))
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgValidatorExit(from.Address.String(), validator.ValId, deactivationEpoch, txHash.Bytes(), logIndex, blockNumber, validator.Nonce+1)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeValidatorExitEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoUnstakeInit{
+			User:              common.HexToAddress(validator.Signer),
+			ValidatorId:       new(big.Int).SetUint64(validator.ValId),
+			Nonce:             new(big.Int).SetUint64(msg.Nonce),
+			DeactivationEpoch: new(big.Int).SetUint64(deactivationEpoch),
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, from, ""), nil
+	}
+}
+
+// SimulateMsgValidatorSlashed generates a MsgValidatorSlashed slashing a few random validators,
+// jailing some of them as long as enough validators remain active
+func SimulateMsgValidatorSlashed(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgValidatorSlashed{})
+
+		validators := k.GetAllValidators(ctx)
+		if len(validators) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no validator to slash"), nil
+		}
+
+		r.Shuffle(len(validators), func(i, j int) {
+			validators[i], validators[j] = validators[j], validators[i]
+		})
+
+		var (
+			jailable     = countActiveValidators(ctx, k) - minActiveValidators
+			slashed      = make([]types.SlashedValidator, 0, 3)
+			jailedLogs   = make([]*stakinginfo.StakinginfoJailed, 0, 3)
+			slashedPower int64
+		)
+
+		for _, validator := range validators[:min(len(validators), simtypes.RandIntBetween(r, 1, 4))] {
+			votingPower := r.Int63n(validator.VotingPower + 1)
+			jailed := !validator.Jailed && jailable > 0 && r.Intn(4) == 0
+			if jailed {
+				jailable--
+
+				jailedLogs = append(jailedLogs, &stakinginfo.StakinginfoJailed{
+					ValidatorId: new(big.Int).SetUint64(validator.ValId),
+					Signer:      common.HexToAddress(validator.Signer),
+				})
+			}
+
+			slashed = append(slashed, types.SlashedValidator{
+				ValId:       validator.ValId,
+				VotingPower: votingPower,
+				Jailed:      jailed,
+			})
+			slashedPower += validator.VotingPower - votingPower
+
+			contractCaller.On("GetValidatorInfoAtBlock", validator.ValId, mock.Anything, mock.Anything).Return(types.Validator{
+				ValId:       validator.ValId,
+				VotingPower: votingPower,
+			}, nil).Once()
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		amount := sdkmath.NewIntFromBigInt(powerToAmount(slashedPower))
+		slashingNonce := uint64(r.Int63n(maxSimulatedPower))
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgValidatorSlashed(from.Address.String(), slashingNonce, amount, slashed, txHash.Bytes(), logIndex, blockNumber)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeSlashedEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoSlashed{
+			Nonce:  new(big.Int).SetUint64(slashingNonce),
+			Amount: amount.BigInt(),
+		}, nil)
+		contractCaller.On("DecodeJailedEvents", mock.Anything, receipt).Return(jailedLogs, nil)
+
+		return hmSimulation.NewOperationMsg(msg, from, ""), nil
+	}
+}
+
+// SimulateMsgValidatorUnjail generates a MsgValidatorUnjail for a random jailed validator
+func SimulateMsgValidatorUnjail(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgValidatorUnjail{})
+
+		validator, ok := randomValidator(r, ctx, k, func(v *types.Validator) bool { return v.Jailed })
+		if !ok {
+			return hmSimulation.NoOpMsg(msgType, "no jailed validator"), nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg, err := types.NewMsgValidatorUnjail(from.Address.String(), validator.ValId, txHash.Bytes(), logIndex, blockNumber)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the msg"), err
+		}
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeUnJailedEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoUnJailed{
+			ValidatorId: new(big.Int).SetUint64(validator.ValId),
+			Signer:      common.HexToAddress(validator.Signer),
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, from, ""), nil
+	}
+}
+
+// SimulateMsgRegisterBlsKey generates a MsgRegisterBlsKey registering a new BLS key for a random active validator,
+// once the BLS checkpoints are enabled
+func SimulateMsgRegisterBlsKey(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterBlsKey{})
+
+		if !helper.IsBlsCheckpoint(ctx.BlockHeight() + 1) {
+			return hmSimulation.NoOpMsg(msgType, "bls checkpoints are not enabled"), nil
+		}
+
+		validator, ok := randomValidator(r, ctx, k, func(v *types.Validator) bool { return v.EndEpoch == 0 && !v.Jailed })
+		if !ok {
+			return hmSimulation.NoOpMsg(msgType, "no active validator"), nil
+		}
+
+		signer, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromHex(validator.Signer))
+		if !found {
+			return hmSimulation.NoOpMsg(msgType, "validator signer is not a simulation account"), nil
+		}
+
+		// draw a non-zero secret key below the order of the BLS12-381 scalar field
+		secretKey := make([]byte, 32)
+		r.Read(secretKey)
+		secretKey[0] &= 0x3f
+		secretKey[31] |= 0x01
+
+		blsPubKey, err := helper.BlsPubKeyFromSecretKey(secretKey)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to derive the bls public key"), err
+		}
+
+		proofOfPossession, err := helper.BlsProofOfPossession(secretKey)
+		if err != nil {
+			return hmSimulation.NoOpMsg(msgType, "unable to create the proof of possession"), err
+		}
+
+		msg := types.NewMsgRegisterBlsKey(signer.Address.String(), blsPubKey, proofOfPossession)
+
+		return hmSimulation.NewOperationMsg(msg, signer, ""), nil
+	}
+}
+
+// randomValidator returns a random validator among the ones matching filter
+func randomValidator(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, filter func(v *types.Validator) bool) (types.Validator, bool) {
+	var validators []types.Validator
+	for _, validator := range k.GetAllValidators(ctx) {
+		if filter(validator) {
+			validators = append(validators, *validator)
+		}
+	}
+
+	if len(validators) == 0 {
+		return types.Validator{}, false
+	}
+
+	return validators[r.Intn(len(validators))], true
+}
+
+// countActiveValidators returns the number of current validators neither jailed nor unstaking
+func countActiveValidators(ctx sdk.Context, k *keeper.Keeper) int {
+	count := 0
+	for _, validator := range k.GetCurrentValidators(ctx) {
+		if validator.EndEpoch == 0 && !validator.Jailed {
+			count++
+		}
+	}
+
+	return count
+}
+
+// randomStakeAmount returns the amount of a random stake, in the 18 decimals of the staking token
+func randomStakeAmount(r *rand.Rand) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(powerToAmount(int64(simtypes.RandIntBetween(r, 1, maxSimulatedPower))))
+}
+
+// powerToAmount returns the stake amount of power
+func powerToAmount(power int64) *big.Int {
+	decimals18 := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	return new(big.Int).Mul(big.NewInt(power), decimals18)
+}
@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgStakeUpdate{}, "heimdall-v2/stake/MsgStakeUpdate")
 	legacy.RegisterAminoMsg(cdc, &MsgSignerUpdate{}, "heimdall-v2/stake/MsgSignerUpdate")
 	legacy.RegisterAminoMsg(cdc, &MsgValidatorExit{}, "heimdall-v2/stake/MsgValidatorExit")
+	legacy.RegisterAminoMsg(cdc, &MsgValidatorSlashed{}, "heimdallv2/stake/MsgValidatorSlashed")
+	legacy.RegisterAminoMsg(cdc, &MsgValidatorUnjail{}, "heimdallv2/stake/MsgValidatorUnjail")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterBlsKey{}, "heimdallv2/stake/MsgRegisterBlsKey")
 }
 
 // RegisterInterfaces registers the x/stake interfaces types with the interface registry
@@ -24,6 +27,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgStakeUpdate{},
 		&MsgSignerUpdate{},
 		&MsgValidatorExit{},
+		&MsgValidatorSlashed{},
+		&MsgValidatorUnjail{},
+		&MsgRegisterBlsKey{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
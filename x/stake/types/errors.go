@@ -16,4 +16,13 @@ var (
 
 	// ErrValUnBonded is returned when the respective validator is already unBonded
 	ErrValUnBonded = errorsmod.Register(ModuleName, 6, "validator already unBonded")
+
+	// ErrValNotJailed is returned when the respective validator is not jailed
+	ErrValNotJailed = errorsmod.Register(ModuleName, 7, "validator not jailed")
+
+	// ErrInvalidBlsKey is returned when a BLS key or its proof of possession is invalid
+	ErrInvalidBlsKey = errorsmod.Register(ModuleName, 8, "invalid bls key")
+
+	// ErrBlsKeyAlreadyRegistered is returned when a BLS key is already registered by another validator
+	ErrBlsKeyAlreadyRegistered = errorsmod.Register(ModuleName, 9, "bls key already registered")
 )
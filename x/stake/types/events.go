@@ -2,14 +2,20 @@ package types
 
 // stake module event types
 var (
-	EventTypeValidatorJoin = "validator-join"
-	EventTypeSignerUpdate  = "signer-update"
-	EventTypeStakeUpdate   = "stake-update"
-	EventTypeValidatorExit = "validator-exit"
+	EventTypeValidatorJoin  = "validator-join"
+	EventTypeSignerUpdate   = "signer-update"
+	EventTypeStakeUpdate    = "stake-update"
+	EventTypeValidatorExit  = "validator-exit"
+	EventTypeSlashed        = "validator-slashed"
+	EventTypeUnjail         = "validator-unjail"
+	EventTypeRegisterBlsKey = "register-bls-key"
 
 	AttributeKeySigner         = "signer"
 	AttributeKeyValidatorID    = "validator-id"
 	AttributeKeyValidatorNonce = "validator-nonce"
+	AttributeKeySlashingNonce  = "slashing-nonce"
+	AttributeKeyJailed         = "jailed"
+	AttributeKeyBlsPubKey      = "bls-pub-key"
 
 	AttributeValueCategory = ModuleName
 )
@@ -38,6 +38,8 @@ type GenesisState struct {
 	LastBlockTxs LastBlockTxs `protobuf:"bytes,5,opt,name=last_block_txs,json=lastBlockTxs,proto3" json:"last_block_txs"`
 	// Validator set from two blocks ago.
 	PenultimateBlockValidatorSet ValidatorSet `protobuf:"bytes,6,opt,name=penultimate_block_validator_set,json=penultimateBlockValidatorSet,proto3" json:"penultimate_block_validator_set"`
+	// BLS keys registered by the validators.
+	ValidatorBlsPubKeys []ValidatorBlsPubKey `protobuf:"bytes,7,rep,name=validator_bls_pub_keys,json=validatorBlsPubKeys,proto3" json:"validator_bls_pub_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +117,13 @@ func (m *GenesisState) GetPenultimateBlockValidatorSet() ValidatorSet {
 	return ValidatorSet{}
 }
 
+func (m *GenesisState) GetValidatorBlsPubKeys() []ValidatorBlsPubKey {
+	if m != nil {
+		return m.ValidatorBlsPubKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "heimdallv2.stake.GenesisState")
 }
@@ -122,32 +131,34 @@ func init() {
 func init() { proto.RegisterFile("heimdallv2/stake/genesis.proto", fileDescriptor_57f4e91e5a6c0e82) }
 
 var fileDescriptor_57f4e91e5a6c0e82 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x6a, 0xdb, 0x40,
-	0x14, 0x85, 0xa5, 0xfa, 0x07, 0x3c, 0x36, 0xc5, 0x56, 0x5b, 0x50, 0x5d, 0x33, 0x16, 0x85, 0x82,
-	0x29, 0x54, 0x2a, 0xea, 0x1b, 0x68, 0xd1, 0x76, 0x11, 0x48, 0x88, 0x43, 0x16, 0x81, 0x20, 0xc6,
-	0xf2, 0x20, 0x4f, 0x3c, 0xd2, 0x28, 0x9a, 0x91, 0x90, 0xdf, 0x22, 0xcb, 0x3c, 0x42, 0x96, 0x79,
-	0x0c, 0x2f, 0xbd, 0xcc, 0x2a, 0x04, 0x7b, 0x91, 0xd7, 0x08, 0xd6, 0x8f, 0x23, 0xc7, 0x49, 0xc0,
-	0x1b, 0x21, 0xee, 0x39, 0xf7, 0xbb, 0x67, 0x66, 0x2e, 0x80, 0x13, 0x4c, 0xbc, 0x31, 0xa2, 0x34,
-	0x36, 0x0d, 0x2e, 0xd0, 0x14, 0x1b, 0x2e, 0xf6, 0x31, 0x27, 0x5c, 0x0f, 0x42, 0x26, 0x98, 0xd2,
-	0x7e, 0xd6, 0xf5, 0x54, 0xef, 0x7e, 0x76, 0x99, 0xcb, 0x52, 0xd1, 0x58, 0xff, 0x65, 0xbe, 0x6e,
-	0x07, 0x79, 0xc4, 0x67, 0x46, 0xfa, 0xcd, 0x4b, 0x3f, 0x76, 0xd0, 0x14, 0x71, 0x61, 0x8f, 0x28,
-	0x73, 0xa6, 0xb6, 0x48, 0xf2, 0x09, 0x5d, 0x6d, 0xc7, 0x16, 0x23, 0x4a, 0xc6, 0x48, 0xb0, 0x30,
-	0x73, 0x7c, 0xbf, 0xae, 0x82, 0xd6, 0xbf, 0x2c, 0xd5, 0x50, 0x20, 0x81, 0x95, 0xbf, 0x00, 0x6c,
-	0x3c, 0x5c, 0x95, 0xb5, 0xca, 0xa0, 0x69, 0x7e, 0xd3, 0x5f, 0x26, 0xd5, 0x4f, 0x0b, 0x8f, 0xd5,
-	0x98, 0xdf, 0xf7, 0xe5, 0x9b, 0xc7, 0xdb, 0x9f, 0xf2, 0x71, 0xa9, 0x53, 0x39, 0x07, 0x5f, 0x9c,
-	0x28, 0x0c, 0xb1, 0x2f, 0xec, 0x4d, 0xd5, 0xe6, 0x58, 0xa8, 0x1f, 0x34, 0x79, 0xd0, 0x34, 0xe1,
-	0x3b, 0xc8, 0x21, 0x16, 0x29, 0x55, 0xca, 0xa8, 0x9f, 0x72, 0x4e, 0x59, 0x57, 0x4c, 0xd0, 0x59,
-	0x77, 0x11, 0xdf, 0xb5, 0x39, 0xbe, 0x8c, 0xb0, 0xef, 0x60, 0xae, 0x56, 0xb4, 0xca, 0xa0, 0x61,
-	0xd5, 0xb2, 0xb6, 0x76, 0xae, 0x0f, 0x0b, 0x59, 0xb9, 0x00, 0xbd, 0x20, 0xc4, 0x31, 0x61, 0x11,
-	0xcf, 0x6f, 0x6a, 0x3b, 0x59, 0x75, 0xdf, 0x64, 0x5f, 0x0b, 0x9c, 0xb5, 0xa6, 0x6d, 0xe5, 0x3b,
-	0x04, 0x1f, 0xb7, 0x5f, 0x44, 0xad, 0xbd, 0x45, 0x3f, 0x40, 0x5c, 0xa4, 0x80, 0x93, 0x84, 0x97,
-	0xe9, 0x2d, 0x5a, 0x12, 0x94, 0x00, 0xf4, 0x03, 0xec, 0x47, 0x54, 0x10, 0x0f, 0x09, 0xfc, 0x6a,
-	0xfe, 0xfa, 0xbe, 0xf9, 0x7b, 0x25, 0xe2, 0xce, 0x11, 0xac, 0xff, 0xf3, 0x25, 0x94, 0x17, 0x4b,
-	0x28, 0x3f, 0x2c, 0xa1, 0x7c, 0xb5, 0x82, 0xd2, 0x62, 0x05, 0xa5, 0xbb, 0x15, 0x94, 0xce, 0x74,
-	0x97, 0x88, 0x49, 0x34, 0xd2, 0x1d, 0xe6, 0x19, 0xbf, 0x93, 0x23, 0x46, 0x67, 0x2e, 0xf3, 0x8d,
-	0x62, 0xec, 0xaf, 0xd8, 0x34, 0x92, 0x7c, 0xdd, 0xc4, 0x2c, 0xc0, 0x7c, 0x54, 0x4f, 0x77, 0xed,
-	0xcf, 0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x02, 0xd4, 0x77, 0x11, 0x03, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x6a, 0xd4, 0x40,
+	0x14, 0xc6, 0x37, 0x6e, 0x5b, 0xe9, 0xb4, 0x48, 0x1b, 0xff, 0x10, 0xd7, 0x32, 0x0d, 0xa2, 0xb0,
+	0x08, 0x26, 0x12, 0xdf, 0x20, 0x17, 0x2a, 0x28, 0x58, 0x5c, 0xf1, 0x42, 0x90, 0x30, 0xc9, 0x1e,
+	0xd2, 0x71, 0x27, 0x99, 0x98, 0x33, 0x09, 0xc9, 0x5b, 0xf8, 0x18, 0x5e, 0xfa, 0x18, 0xbd, 0xec,
+	0xa5, 0x57, 0x22, 0xbb, 0x17, 0xbe, 0x85, 0xc8, 0xe6, 0x4f, 0x9b, 0x18, 0x5b, 0xd8, 0x9b, 0x10,
+	0xe6, 0xfb, 0xce, 0x6f, 0xbe, 0xe4, 0x7c, 0x84, 0x9e, 0x02, 0x8f, 0xe6, 0x4c, 0x88, 0xdc, 0xb1,
+	0x51, 0xb1, 0x05, 0xd8, 0x21, 0xc4, 0x80, 0x1c, 0xad, 0x24, 0x95, 0x4a, 0xea, 0x07, 0x97, 0xba,
+	0x55, 0xe9, 0x93, 0x3b, 0xa1, 0x0c, 0x65, 0x25, 0xda, 0xeb, 0xb7, 0xda, 0x37, 0x39, 0x64, 0x11,
+	0x8f, 0xa5, 0x5d, 0x3d, 0x9b, 0xa3, 0xc7, 0x03, 0xb4, 0x60, 0xa8, 0x3c, 0x5f, 0xc8, 0x60, 0xe1,
+	0xa9, 0xa2, 0xb9, 0x61, 0x62, 0x0e, 0x6c, 0x39, 0x13, 0x7c, 0xce, 0x94, 0x4c, 0x6b, 0xc7, 0xc3,
+	0x3f, 0x5b, 0x64, 0xff, 0x65, 0x9d, 0x6a, 0xa6, 0x98, 0x02, 0xfd, 0x05, 0x21, 0x17, 0x1e, 0x34,
+	0x34, 0x73, 0x3c, 0xdd, 0x73, 0x1e, 0x58, 0xff, 0x26, 0xb5, 0x3e, 0xb4, 0x1e, 0x77, 0xf7, 0xec,
+	0xe7, 0xb1, 0xf6, 0xed, 0xf7, 0xf7, 0x27, 0xda, 0xbb, 0xce, 0xa4, 0xfe, 0x89, 0xdc, 0x0d, 0xb2,
+	0x34, 0x85, 0x58, 0x79, 0x17, 0xa7, 0x1e, 0x82, 0x32, 0x6e, 0x98, 0xda, 0x74, 0xcf, 0xa1, 0xd7,
+	0x20, 0x67, 0xa0, 0x2a, 0xea, 0xa8, 0xa6, 0xde, 0x6e, 0x38, 0x5d, 0x5d, 0x77, 0xc8, 0xe1, 0x7a,
+	0x8a, 0xc7, 0xa1, 0x87, 0xf0, 0x25, 0x83, 0x38, 0x00, 0x34, 0xc6, 0xe6, 0x78, 0xba, 0xeb, 0x6e,
+	0xd7, 0x63, 0x07, 0x8d, 0x3e, 0x6b, 0x65, 0xfd, 0x33, 0x39, 0x4a, 0x52, 0xc8, 0xb9, 0xcc, 0xb0,
+	0xf9, 0x53, 0xfd, 0x64, 0x5b, 0x9b, 0x26, 0xbb, 0xdf, 0xe2, 0xdc, 0x35, 0xad, 0x97, 0xef, 0x2d,
+	0xb9, 0xd5, 0xdf, 0x88, 0xb1, 0x7d, 0x15, 0xfd, 0x0d, 0x43, 0x55, 0x01, 0xde, 0x17, 0xd8, 0xa5,
+	0xef, 0x8b, 0x8e, 0xa0, 0x27, 0xe4, 0x38, 0x81, 0x38, 0x13, 0x8a, 0x47, 0x4c, 0xc1, 0x7f, 0xf3,
+	0xef, 0x6c, 0x9a, 0xff, 0xa8, 0x43, 0x1c, 0x7e, 0xc2, 0x9c, 0xdc, 0xbb, 0xe4, 0xfb, 0x02, 0xbd,
+	0x24, 0xf3, 0xbd, 0x05, 0x94, 0x68, 0xdc, 0xac, 0x5a, 0xf1, 0xe8, 0xba, 0x56, 0x08, 0x3c, 0xc9,
+	0xfc, 0xd7, 0x50, 0xf6, 0x16, 0x99, 0x0f, 0x64, 0x74, 0x5f, 0x9d, 0x2d, 0xa9, 0x76, 0xbe, 0xa4,
+	0xda, 0xaf, 0x25, 0xd5, 0xbe, 0xae, 0xe8, 0xe8, 0x7c, 0x45, 0x47, 0x3f, 0x56, 0x74, 0xf4, 0xd1,
+	0x0a, 0xb9, 0x3a, 0xcd, 0x7c, 0x2b, 0x90, 0x91, 0xfd, 0xac, 0x38, 0x91, 0xa2, 0x0c, 0x65, 0x6c,
+	0xb7, 0x77, 0x3e, 0xcd, 0x1d, 0xbb, 0x68, 0x4a, 0xad, 0xca, 0x04, 0xd0, 0xdf, 0xa9, 0x1a, 0xfd,
+	0xfc, 0xef, 0x00, 0x7b, 0xd0, 0x77, 0xb8, 0x77, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -170,6 +181,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorBlsPubKeys) > 0 {
+		for iNdEx := len(m.ValidatorBlsPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBlsPubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.PenultimateBlockValidatorSet.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -273,6 +298,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.PenultimateBlockValidatorSet.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorBlsPubKeys) > 0 {
+		for _, e := range m.ValidatorBlsPubKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -509,6 +540,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBlsPubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBlsPubKeys = append(m.ValidatorBlsPubKeys, ValidatorBlsPubKey{})
+			if err := m.ValidatorBlsPubKeys[len(m.ValidatorBlsPubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
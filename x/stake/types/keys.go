@@ -27,6 +27,8 @@ var (
 	LastBlockTxsKey                 = []byte{0x26} // key to store last block's txs
 	PreviousBlockValidatorSetKey    = []byte{0x27} // key to store the previous block's validator set
 	PenultimateBlockValidatorSetKey = []byte{0x28} // key to store the validator set from 2 blocks ago
+	BlsPubKeyKey                    = []byte{0x29} // prefix for the bls key registered by each validator
+	BlsPubKeyOwnerKey               = []byte{0x2A} // prefix for the validator owning each registered bls key
 )
 
 type PubKey [65]byte
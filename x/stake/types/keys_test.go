@@ -157,6 +157,8 @@ func TestKeyUniqueness(t *testing.T) {
 			types.LastBlockTxsKey,
 			types.PreviousBlockValidatorSetKey,
 			types.PenultimateBlockValidatorSetKey,
+			types.BlsPubKeyKey,
+			types.BlsPubKeyOwnerKey,
 		}
 
 		seen := make(map[byte]bool)
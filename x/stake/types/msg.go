@@ -23,6 +23,9 @@ var (
 	_ sdk.Msg = &MsgStakeUpdate{}
 	_ sdk.Msg = &MsgSignerUpdate{}
 	_ sdk.Msg = &MsgValidatorExit{}
+	_ sdk.Msg = &MsgValidatorSlashed{}
+	_ sdk.Msg = &MsgValidatorUnjail{}
+	_ sdk.Msg = &MsgRegisterBlsKey{}
 )
 
 // NewMsgValidatorJoin creates a new MsgCreateValidator instance.
@@ -216,3 +219,132 @@ func (msg MsgValidatorExit) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgValidatorSlashed creates a new MsgValidatorSlashed instance.
+func NewMsgValidatorSlashed(
+	from string, slashingNonce uint64, amount sdkmath.Int,
+	validators []SlashedValidator, txHash []byte, logIndex uint64,
+	blockNumber uint64,
+) (*MsgValidatorSlashed, error) {
+	return &MsgValidatorSlashed{
+		From:          util.FormatAddress(from),
+		SlashingNonce: slashingNonce,
+		Amount:        amount,
+		Validators:    validators,
+		TxHash:        txHash,
+		LogIndex:      logIndex,
+		BlockNumber:   blockNumber,
+	}, nil
+}
+
+// ValidateBasic validates the validator slashed msg before it is executed
+func (msg MsgValidatorSlashed) ValidateBasic() error {
+	ac := hexCodec.NewHexCodec()
+	addrBytes, err := ac.StringToBytes(msg.From)
+	if err != nil {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	accAddr := sdk.AccAddress(addrBytes)
+
+	if accAddr.Empty() {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return ErrInvalidMsg.Wrapf("invalid amount %v", msg.Amount)
+	}
+
+	if len(msg.Validators) == 0 {
+		return ErrInvalidMsg.Wrap("slashed validators can't be empty")
+	}
+
+	seen := make(map[uint64]struct{}, len(msg.Validators))
+	for _, v := range msg.Validators {
+		if v.ValId == uint64(0) {
+			return ErrInvalidMsg.Wrapf(errInvalidValidatorID, v.ValId)
+		}
+
+		if _, ok := seen[v.ValId]; ok {
+			return ErrInvalidMsg.Wrapf("duplicate slashed validator %v", v.ValId)
+		}
+		seen[v.ValId] = struct{}{}
+
+		if v.VotingPower < 0 {
+			return ErrInvalidMsg.Wrapf("invalid voting power %v for validator %v", v.VotingPower, v.ValId)
+		}
+	}
+
+	if len(msg.TxHash) != common.HashLength {
+		return ErrInvalidMsg.Wrapf(errInvalidTxHash, msg.TxHash)
+	}
+
+	return nil
+}
+
+// NewMsgValidatorUnjail creates a new MsgValidatorUnjail instance.
+func NewMsgValidatorUnjail(
+	from string, id uint64, txHash []byte, logIndex uint64,
+	blockNumber uint64,
+) (*MsgValidatorUnjail, error) {
+	return &MsgValidatorUnjail{
+		From:        util.FormatAddress(from),
+		ValId:       id,
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}, nil
+}
+
+// ValidateBasic validates the validator unjail msg before it is executed
+func (msg MsgValidatorUnjail) ValidateBasic() error {
+	if msg.ValId == uint64(0) {
+		return ErrInvalidMsg.Wrapf(errInvalidValidatorID, msg.ValId)
+	}
+
+	ac := hexCodec.NewHexCodec()
+	addrBytes, err := ac.StringToBytes(msg.From)
+	if err != nil {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	accAddr := sdk.AccAddress(addrBytes)
+
+	if accAddr.Empty() {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	if len(msg.TxHash) != common.HashLength {
+		return ErrInvalidMsg.Wrapf(errInvalidTxHash, msg.TxHash)
+	}
+
+	return nil
+}
+
+// NewMsgRegisterBlsKey creates a new MsgRegisterBlsKey instance.
+func NewMsgRegisterBlsKey(from string, blsPubKey []byte, proofOfPossession []byte) *MsgRegisterBlsKey {
+	return &MsgRegisterBlsKey{
+		From:              util.FormatAddress(from),
+		BlsPubKey:         blsPubKey,
+		ProofOfPossession: proofOfPossession,
+	}
+}
+
+// ValidateBasic validates the register bls key msg before it is executed
+func (msg MsgRegisterBlsKey) ValidateBasic() error {
+	ac := hexCodec.NewHexCodec()
+	addrBytes, err := ac.StringToBytes(msg.From)
+	if err != nil {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	if sdk.AccAddress(addrBytes).Empty() {
+		return ErrInvalidMsg.Wrapf(errInvalidProposer, msg.From)
+	}
+
+	if len(msg.BlsPubKey) == 0 || len(msg.ProofOfPossession) == 0 {
+		return ErrInvalidMsg.Wrap("bls pub key and proof of possession are required")
+	}
+
+	return nil
+}
@@ -756,6 +756,100 @@ func (m *QueryCurrentProposerResponse) GetValidator() Validator {
 	return Validator{}
 }
 
+// QueryValidatorBlsPubKeyRequest is the request type for the
+// GetValidatorBlsPubKey query.
+type QueryValidatorBlsPubKeyRequest struct {
+	// Validator ID to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryValidatorBlsPubKeyRequest) Reset()         { *m = QueryValidatorBlsPubKeyRequest{} }
+func (m *QueryValidatorBlsPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBlsPubKeyRequest) ProtoMessage()    {}
+func (*QueryValidatorBlsPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64a607450adf12fe, []int{16}
+}
+func (m *QueryValidatorBlsPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBlsPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBlsPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBlsPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBlsPubKeyRequest.Merge(m, src)
+}
+func (m *QueryValidatorBlsPubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBlsPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBlsPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBlsPubKeyRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorBlsPubKeyRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryValidatorBlsPubKeyResponse is the response type for the
+// GetValidatorBlsPubKey query.
+type QueryValidatorBlsPubKeyResponse struct {
+	// Compressed BLS12-381 public key registered by the validator.
+	BlsPubKey []byte `protobuf:"bytes,1,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+}
+
+func (m *QueryValidatorBlsPubKeyResponse) Reset()         { *m = QueryValidatorBlsPubKeyResponse{} }
+func (m *QueryValidatorBlsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBlsPubKeyResponse) ProtoMessage()    {}
+func (*QueryValidatorBlsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64a607450adf12fe, []int{17}
+}
+func (m *QueryValidatorBlsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBlsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBlsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBlsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBlsPubKeyResponse.Merge(m, src)
+}
+func (m *QueryValidatorBlsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBlsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBlsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBlsPubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorBlsPubKeyResponse) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentValidatorSetRequest)(nil), "heimdallv2.stake.QueryCurrentValidatorSetRequest")
 	proto.RegisterType((*QueryCurrentValidatorSetResponse)(nil), "heimdallv2.stake.QueryCurrentValidatorSetResponse")
@@ -773,67 +867,74 @@ func init() {
 	proto.RegisterType((*QueryProposersResponse)(nil), "heimdallv2.stake.QueryProposersResponse")
 	proto.RegisterType((*QueryCurrentProposerRequest)(nil), "heimdallv2.stake.QueryCurrentProposerRequest")
 	proto.RegisterType((*QueryCurrentProposerResponse)(nil), "heimdallv2.stake.QueryCurrentProposerResponse")
+	proto.RegisterType((*QueryValidatorBlsPubKeyRequest)(nil), "heimdallv2.stake.QueryValidatorBlsPubKeyRequest")
+	proto.RegisterType((*QueryValidatorBlsPubKeyResponse)(nil), "heimdallv2.stake.QueryValidatorBlsPubKeyResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/stake/query.proto", fileDescriptor_64a607450adf12fe) }
 
 var fileDescriptor_64a607450adf12fe = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x94, 0x94, 0x66, 0xda, 0x4a, 0x65, 0xfa, 0x16, 0x9c, 0xd4, 0x0d, 0x16, 0x7d,
-	0xa1, 0x28, 0x36, 0x0d, 0x5c, 0x90, 0x7a, 0x69, 0x00, 0xa5, 0xb9, 0xd0, 0xd2, 0x46, 0x1c, 0x40,
-	0x22, 0x72, 0xeb, 0x91, 0x63, 0xe1, 0x78, 0x52, 0xcf, 0x24, 0x38, 0xaa, 0x7a, 0x00, 0x09, 0x09,
-	0xc4, 0x01, 0x24, 0x6e, 0x5c, 0xb9, 0x70, 0xec, 0x85, 0xef, 0xd0, 0x63, 0xa5, 0xbd, 0xec, 0x69,
-	0xb5, 0x6a, 0x57, 0xda, 0xaf, 0xb1, 0xf2, 0x78, 0xfc, 0x12, 0x27, 0xde, 0xa4, 0x52, 0x2f, 0x55,
-	0xf4, 0xbc, 0xfe, 0xfc, 0x3c, 0xcf, 0xfc, 0x55, 0x50, 0x6a, 0x23, 0xb3, 0xa3, 0x6b, 0x96, 0xd5,
-	0xaf, 0xaa, 0x84, 0x6a, 0x3f, 0x22, 0xf5, 0xb2, 0x87, 0x9c, 0x81, 0xd2, 0x75, 0x30, 0xc5, 0x70,
-	0x29, 0xf2, 0x2a, 0xcc, 0x2b, 0xbe, 0xa7, 0x75, 0x4c, 0x1b, 0xab, 0xec, 0xaf, 0x1f, 0x24, 0x16,
-	0x2f, 0x30, 0xe9, 0x60, 0xe2, 0x27, 0xaa, 0xfd, 0xfd, 0x78, 0x05, 0x71, 0xc5, 0xc0, 0x06, 0x66,
-	0x3f, 0x55, 0xef, 0x17, 0xb7, 0x96, 0x0c, 0x8c, 0x0d, 0x0b, 0xa9, 0x5a, 0xd7, 0x54, 0x35, 0xdb,
-	0xc6, 0x54, 0xa3, 0x26, 0xb6, 0x09, 0xf7, 0x96, 0x47, 0x98, 0xfa, 0x9a, 0x65, 0xea, 0x1a, 0xc5,
-	0x8e, 0x1f, 0x21, 0x7f, 0x00, 0x36, 0xbf, 0xf1, 0x9a, 0x7c, 0xd1, 0x73, 0x1c, 0x64, 0xd3, 0x6f,
-	0x03, 0xf7, 0x19, 0xa2, 0xa7, 0xe8, 0xb2, 0x87, 0x08, 0x95, 0x1d, 0x50, 0x4e, 0x0f, 0x21, 0x5d,
-	0x6c, 0x13, 0x04, 0xbf, 0x06, 0x8b, 0x61, 0xe5, 0x16, 0x41, 0xb4, 0x20, 0x94, 0x85, 0xdd, 0xf9,
-	0xaa, 0xa4, 0x24, 0x3f, 0x5b, 0x89, 0xa7, 0xd7, 0xf2, 0xb7, 0x2f, 0x36, 0x33, 0xff, 0xbd, 0xbe,
-	0xd9, 0x13, 0x4e, 0x17, 0xfa, 0x31, 0x87, 0x7c, 0x00, 0x20, 0xeb, 0x79, 0x66, 0x1a, 0x36, 0x72,
-	0x38, 0x09, 0xdc, 0x06, 0xf3, 0x7d, 0xcd, 0x6a, 0x69, 0xba, 0xee, 0x20, 0x42, 0x58, 0x8f, 0x7c,
-	0x2d, 0xe7, 0xe7, 0x83, 0xbe, 0x66, 0x1d, 0xfa, 0x0e, 0xf9, 0x7b, 0xb0, 0x3c, 0x94, 0xcd, 0x21,
-	0xbf, 0x04, 0xf9, 0xb0, 0x09, 0x07, 0x2c, 0xbe, 0x05, 0x30, 0x4e, 0x17, 0x25, 0xca, 0x0a, 0x58,
-	0x65, 0xc5, 0xc3, 0xb8, 0x80, 0x6e, 0x15, 0x64, 0x4d, 0x9d, 0xd5, 0x7d, 0x27, 0x80, 0xca, 0x9a,
-	0xba, 0xfc, 0x03, 0x58, 0x4b, 0xc6, 0x3f, 0x29, 0x4f, 0x81, 0xd7, 0x6f, 0x62, 0xaa, 0x59, 0x27,
-	0xf8, 0xa7, 0x70, 0x5c, 0xf2, 0x21, 0x58, 0x1f, 0xf1, 0xf0, 0xd6, 0xdb, 0x60, 0x9e, 0x7a, 0xd6,
-	0x56, 0xd7, 0x33, 0xb3, 0xe6, 0x33, 0xe1, 0x24, 0x69, 0x18, 0x2f, 0x7f, 0x05, 0x8a, 0xc3, 0xf0,
-	0x67, 0x54, 0xa3, 0x3d, 0xf2, 0xd8, 0x85, 0x1c, 0x80, 0xd2, 0xf8, 0x32, 0x1c, 0xa7, 0x04, 0x66,
-	0x4d, 0xd2, 0xc2, 0x96, 0x3f, 0xbe, 0xb9, 0xa0, 0x44, 0xce, 0x24, 0xc7, 0x96, 0x37, 0xc1, 0x82,
-	0xbf, 0x4e, 0x6f, 0x1e, 0x0d, 0xcf, 0xd4, 0x74, 0x03, 0x02, 0x09, 0xbc, 0x4b, 0xdd, 0x56, 0x5b,
-	0x23, 0xed, 0xe1, 0xee, 0xb3, 0xd4, 0x3d, 0xd2, 0x48, 0x1b, 0xca, 0x20, 0x6f, 0x61, 0xa3, 0x65,
-	0xda, 0x3a, 0x72, 0x0b, 0xd9, 0xf8, 0x6e, 0xe6, 0x2c, 0x6c, 0x34, 0x3c, 0xb3, 0xfc, 0x39, 0x78,
-	0x7f, 0x4c, 0xfd, 0xa9, 0xd0, 0x3e, 0xe3, 0xc7, 0x70, 0xe2, 0xe0, 0x2e, 0x26, 0xc8, 0x09, 0x27,
-	0x53, 0x04, 0x39, 0x6a, 0x76, 0x10, 0x19, 0xbe, 0x07, 0xdf, 0x16, 0x9e, 0x44, 0x2c, 0x2b, 0x3a,
-	0x89, 0x6e, 0x60, 0x2c, 0x08, 0xe5, 0x99, 0xc7, 0x9c, 0x44, 0x98, 0x28, 0x6f, 0xf0, 0xad, 0xf1,
-	0x17, 0x1b, 0xb4, 0x09, 0xee, 0x42, 0xe7, 0xdb, 0x18, 0x71, 0x3f, 0xe5, 0x5d, 0x56, 0xff, 0xcf,
-	0x83, 0x1c, 0x6b, 0x03, 0xff, 0x15, 0xc0, 0x5a, 0x1d, 0xd1, 0x31, 0xfa, 0x01, 0xf7, 0x47, 0xeb,
-	0x4e, 0x90, 0x23, 0xb1, 0xfa, 0x98, 0x14, 0xff, 0x8b, 0x64, 0xf9, 0x37, 0x8f, 0xee, 0x97, 0x67,
-	0xaf, 0xfe, 0xce, 0xae, 0xc3, 0xd5, 0xa4, 0x14, 0x92, 0x0a, 0x41, 0x14, 0xfe, 0x21, 0x00, 0x58,
-	0x47, 0xd4, 0xd7, 0x8c, 0xda, 0x80, 0x9f, 0x2e, 0xfc, 0x30, 0xa5, 0xdd, 0x90, 0x32, 0x89, 0x5b,
-	0x13, 0xa2, 0x38, 0xc7, 0x6e, 0xc4, 0xb1, 0x01, 0x8b, 0x9c, 0x83, 0xb0, 0x18, 0xf5, 0x2a, 0xf6,
-	0x92, 0xae, 0xe1, 0xef, 0x02, 0x58, 0xaa, 0xa3, 0xe8, 0x6b, 0x6a, 0x83, 0x86, 0x0e, 0x77, 0x52,
-	0xba, 0x24, 0xa5, 0x48, 0xdc, 0x9d, 0x1c, 0x38, 0xd5, 0x64, 0xd4, 0x2b, 0x53, 0xbf, 0x86, 0x37,
-	0x02, 0x28, 0xc6, 0x59, 0xfc, 0xc7, 0x1b, 0x8d, 0xa8, 0x32, 0xa9, 0xdb, 0x90, 0x68, 0x88, 0xca,
-	0xb4, 0xe1, 0x1c, 0xb1, 0x1a, 0x21, 0xee, 0xc0, 0xad, 0x24, 0x62, 0x85, 0xb0, 0xe8, 0xc4, 0xf8,
-	0x7e, 0x15, 0xc0, 0x62, 0x1d, 0xd1, 0x48, 0xf9, 0x60, 0xda, 0x48, 0x46, 0x64, 0x53, 0xfc, 0x68,
-	0x8a, 0x48, 0x8e, 0xb6, 0x19, 0xa1, 0xad, 0x40, 0xc8, 0xd1, 0x98, 0x7c, 0x56, 0x98, 0xb0, 0xc2,
-	0x9f, 0x05, 0xb0, 0xd0, 0x20, 0x4c, 0x58, 0x9a, 0xee, 0xb1, 0xa5, 0xc3, 0xbd, 0xb4, 0x43, 0x19,
-	0xd5, 0x36, 0xf1, 0xe3, 0xa9, 0x62, 0x39, 0x4a, 0x81, 0x51, 0x40, 0xb8, 0xc4, 0x29, 0x4c, 0x52,
-	0xc1, 0x96, 0x5e, 0xa1, 0x2e, 0xfc, 0xc7, 0x3f, 0xec, 0xc4, 0x6b, 0x4f, 0xdd, 0xda, 0x78, 0xd1,
-	0x48, 0xdd, 0x5a, 0x8a, 0x88, 0xc8, 0x5b, 0xd1, 0x68, 0x44, 0x58, 0xe0, 0x50, 0xa1, 0x44, 0xa9,
-	0x17, 0x7e, 0x1a, 0xfc, 0x53, 0x00, 0xcb, 0x75, 0x14, 0xa6, 0x93, 0xda, 0xa0, 0xe9, 0x49, 0x64,
-	0xea, 0xa9, 0x27, 0x85, 0x36, 0xf5, 0xd4, 0x47, 0xb4, 0x75, 0x12, 0xd1, 0x15, 0xd3, 0xe6, 0xeb,
-	0xda, 0xd1, 0xed, 0xbd, 0x24, 0xdc, 0xdd, 0x4b, 0xc2, 0xcb, 0x7b, 0x49, 0xf8, 0xeb, 0x41, 0xca,
-	0xdc, 0x3d, 0x48, 0x99, 0xe7, 0x0f, 0x52, 0xe6, 0x3b, 0xc5, 0x30, 0x69, 0xbb, 0x77, 0xae, 0x5c,
-	0xe0, 0x8e, 0xfa, 0x89, 0x7b, 0x82, 0xad, 0x81, 0x81, 0x6d, 0x35, 0x68, 0x5f, 0xe9, 0x57, 0x55,
-	0x37, 0x38, 0x81, 0x41, 0x17, 0x91, 0xf3, 0x59, 0xf6, 0x2f, 0xd6, 0xa7, 0x6f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x44, 0xf5, 0x43, 0x61, 0x1a, 0x0a, 0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x29, 0x09, 0xf5, 0x4b, 0x2a, 0x85, 0x69, 0xd3, 0x9a, 0xb5, 0xbb, 0x49, 0x17,
+	0xd2, 0x86, 0xa2, 0xdd, 0x6d, 0x0c, 0x12, 0x42, 0xea, 0xa5, 0x06, 0xe4, 0x44, 0x48, 0x34, 0x34,
+	0x11, 0x07, 0x90, 0xb0, 0xd6, 0xd9, 0xd1, 0x7a, 0x95, 0xf1, 0xce, 0x76, 0x67, 0x6c, 0xbc, 0x8a,
+	0x72, 0x00, 0x09, 0x09, 0xc4, 0x01, 0x24, 0x6e, 0x5c, 0xb9, 0x70, 0xe0, 0xd0, 0x3f, 0xa3, 0xc7,
+	0x4a, 0x5c, 0x38, 0x21, 0x94, 0x54, 0xe2, 0xdf, 0x40, 0x3b, 0x3b, 0xfb, 0xc3, 0x6b, 0xbb, 0x76,
+	0xa4, 0x5c, 0x2a, 0x6b, 0xde, 0xf7, 0xbd, 0xf7, 0xd9, 0x37, 0x6f, 0xbe, 0x0d, 0xd4, 0xbb, 0xd8,
+	0xeb, 0x39, 0x36, 0x21, 0x83, 0x86, 0xc5, 0xb8, 0x7d, 0x8c, 0xad, 0xa7, 0x7d, 0x1c, 0x46, 0x66,
+	0x10, 0x52, 0x4e, 0xd1, 0x5a, 0x1e, 0x35, 0x45, 0x54, 0x7d, 0xc3, 0xee, 0x79, 0x3e, 0xb5, 0xc4,
+	0xbf, 0x89, 0x48, 0xad, 0x1d, 0x51, 0xd6, 0xa3, 0x2c, 0x49, 0xb4, 0x06, 0x3b, 0xc5, 0x0a, 0xea,
+	0x0d, 0x97, 0xba, 0x54, 0xfc, 0xb4, 0xe2, 0x5f, 0xf2, 0xb4, 0xee, 0x52, 0xea, 0x12, 0x6c, 0xd9,
+	0x81, 0x67, 0xd9, 0xbe, 0x4f, 0xb9, 0xcd, 0x3d, 0xea, 0x33, 0x19, 0xdd, 0x1c, 0x63, 0x1a, 0xd8,
+	0xc4, 0x73, 0x6c, 0x4e, 0xc3, 0x44, 0xa1, 0xdf, 0x81, 0x8d, 0xcf, 0xe3, 0x26, 0x1f, 0xf5, 0xc3,
+	0x10, 0xfb, 0xfc, 0x8b, 0x34, 0x7c, 0x80, 0xf9, 0x13, 0xfc, 0xb4, 0x8f, 0x19, 0xd7, 0x43, 0xd8,
+	0x9c, 0x2e, 0x61, 0x01, 0xf5, 0x19, 0x46, 0x9f, 0xc1, 0xb5, 0xac, 0x72, 0x9b, 0x61, 0x5e, 0x55,
+	0x36, 0x95, 0xed, 0x95, 0x86, 0x66, 0x96, 0x3f, 0xdb, 0x2c, 0xa6, 0x37, 0x2b, 0xcf, 0xff, 0xd9,
+	0x58, 0xf8, 0xe3, 0xbf, 0x67, 0xf7, 0x95, 0x27, 0xab, 0x83, 0x42, 0x40, 0x7f, 0x08, 0x48, 0xf4,
+	0x3c, 0xf0, 0x5c, 0x1f, 0x87, 0x92, 0x04, 0xdd, 0x85, 0x95, 0x81, 0x4d, 0xda, 0xb6, 0xe3, 0x84,
+	0x98, 0x31, 0xd1, 0xa3, 0xd2, 0x5c, 0x4a, 0xf2, 0x61, 0x60, 0x93, 0x47, 0x49, 0x40, 0xff, 0x0a,
+	0xae, 0x8f, 0x64, 0x4b, 0xc8, 0x8f, 0xa1, 0x92, 0x35, 0x91, 0x80, 0xb5, 0x57, 0x00, 0x16, 0xe9,
+	0xf2, 0x44, 0xdd, 0x84, 0x75, 0x51, 0x3c, 0xd3, 0xa5, 0x74, 0xeb, 0xb0, 0xe8, 0x39, 0xa2, 0xee,
+	0x6b, 0x29, 0xd4, 0xa2, 0xe7, 0xe8, 0x5f, 0xc3, 0xcd, 0xb2, 0xfe, 0x52, 0x79, 0xaa, 0xb2, 0xfe,
+	0x21, 0xe5, 0x36, 0xd9, 0xa7, 0xdf, 0x64, 0xe3, 0xd2, 0x1f, 0xc1, 0xad, 0xb1, 0x88, 0x6c, 0x7d,
+	0x17, 0x56, 0x78, 0x7c, 0xda, 0x0e, 0xe2, 0x63, 0xd1, 0xfc, 0x4a, 0x36, 0x49, 0x9e, 0xe9, 0xf5,
+	0x4f, 0xa0, 0x36, 0x0a, 0x7f, 0xc0, 0x6d, 0xde, 0x67, 0x17, 0xbd, 0x90, 0x87, 0x50, 0x9f, 0x5c,
+	0x46, 0xe2, 0xd4, 0x61, 0xd9, 0x63, 0x6d, 0x4a, 0x92, 0xf1, 0x5d, 0x4d, 0x4b, 0x2c, 0x79, 0xec,
+	0x31, 0x89, 0x27, 0x58, 0x4d, 0xae, 0x33, 0x9e, 0xc7, 0x5e, 0x7c, 0x74, 0x38, 0x4c, 0x09, 0x34,
+	0x78, 0x9d, 0x0f, 0xdb, 0x5d, 0x9b, 0x75, 0x47, 0xbb, 0x2f, 0xf3, 0xe1, 0xae, 0xcd, 0xba, 0x48,
+	0x87, 0x0a, 0xa1, 0x6e, 0xdb, 0xf3, 0x1d, 0x3c, 0xac, 0x2e, 0x16, 0xef, 0xe6, 0x2a, 0xa1, 0xee,
+	0x5e, 0x7c, 0xac, 0x7f, 0x08, 0x6f, 0x4e, 0xa8, 0x3f, 0x17, 0xda, 0xfb, 0x72, 0x19, 0xf6, 0x43,
+	0x1a, 0x50, 0x86, 0xc3, 0x6c, 0x32, 0x35, 0x58, 0xe2, 0x5e, 0x0f, 0xb3, 0xd1, 0x7d, 0x48, 0xce,
+	0xb2, 0x95, 0x28, 0x64, 0xe5, 0x2b, 0x11, 0xa4, 0x87, 0x55, 0x65, 0xf3, 0xca, 0x45, 0x56, 0x22,
+	0x4b, 0xd4, 0x6f, 0x43, 0xad, 0xf8, 0x62, 0xd3, 0x36, 0xe9, 0x5e, 0x38, 0x50, 0x9f, 0x1c, 0xbe,
+	0xd4, 0xbd, 0xfc, 0x00, 0xb4, 0xd1, 0x3b, 0x6f, 0x12, 0xb6, 0xdf, 0xef, 0x7c, 0x8a, 0xa3, 0x19,
+	0x0f, 0x66, 0x17, 0x36, 0xa6, 0x26, 0x4a, 0xc2, 0x2d, 0x58, 0xe9, 0x10, 0xd6, 0x0e, 0xfa, 0x9d,
+	0xf6, 0x31, 0x8e, 0x44, 0x89, 0xd5, 0xb4, 0x44, 0xa5, 0x93, 0xca, 0x1b, 0x2f, 0x01, 0x96, 0x44,
+	0x29, 0xf4, 0xbb, 0x02, 0x37, 0x5b, 0x98, 0x4f, 0xb0, 0x30, 0xb4, 0x33, 0xfe, 0x69, 0x33, 0x1c,
+	0x51, 0x6d, 0x5c, 0x24, 0x25, 0x41, 0xd6, 0xf5, 0x1f, 0x62, 0xba, 0xef, 0xfe, 0x7a, 0xf9, 0xeb,
+	0xe2, 0x2d, 0xb4, 0x5e, 0x76, 0x63, 0x66, 0x30, 0xcc, 0xd1, 0x4f, 0x0a, 0xa0, 0x16, 0xe6, 0x89,
+	0x6d, 0x35, 0x23, 0xf9, 0x7a, 0xd0, 0xdb, 0x53, 0xda, 0x8d, 0x98, 0xa3, 0xba, 0x35, 0x43, 0x25,
+	0x39, 0xb6, 0x73, 0x8e, 0xdb, 0xa8, 0x26, 0x39, 0x98, 0xd0, 0x58, 0x27, 0x85, 0xc7, 0x7c, 0x8a,
+	0x7e, 0x54, 0x60, 0xad, 0x85, 0xf3, 0xaf, 0x69, 0x46, 0x7b, 0x0e, 0xba, 0x37, 0xa5, 0x4b, 0xd9,
+	0x0d, 0xd5, 0xed, 0xd9, 0xc2, 0xb9, 0x26, 0x63, 0x9d, 0x78, 0xce, 0x29, 0x7a, 0xa6, 0x40, 0xad,
+	0xc8, 0x92, 0xf8, 0x47, 0x3e, 0x22, 0x63, 0x56, 0xb7, 0x11, 0xdf, 0x52, 0xcd, 0x79, 0xe5, 0x12,
+	0xb1, 0x91, 0x23, 0xde, 0x43, 0x5b, 0x65, 0x44, 0x83, 0x09, 0x75, 0x69, 0x7c, 0xdf, 0x2b, 0x70,
+	0xad, 0x85, 0x79, 0x6e, 0xbe, 0x68, 0xda, 0x48, 0xc6, 0x9c, 0x5b, 0x7d, 0x67, 0x0e, 0xa5, 0x44,
+	0xdb, 0xc8, 0xd1, 0x6e, 0x20, 0x24, 0xd1, 0x84, 0x83, 0x1b, 0xc2, 0xdb, 0xd1, 0xb7, 0x0a, 0xac,
+	0xee, 0x31, 0xe1, 0x6d, 0x87, 0xc3, 0xc7, 0xc4, 0x41, 0xf7, 0xa7, 0x2d, 0xca, 0xb8, 0xbd, 0xaa,
+	0xef, 0xce, 0xa5, 0x95, 0x28, 0x55, 0x41, 0x81, 0xd0, 0x9a, 0xa4, 0xf0, 0x98, 0x41, 0x89, 0x63,
+	0xf0, 0x21, 0xfa, 0x2d, 0x59, 0xec, 0x92, 0xe1, 0x4c, 0xbd, 0xb5, 0xc9, 0xbe, 0xa5, 0x9a, 0xf3,
+	0xca, 0x25, 0xcf, 0x56, 0x3e, 0x1a, 0x15, 0x55, 0x25, 0x54, 0xe6, 0x92, 0xd6, 0x51, 0x92, 0x86,
+	0x7e, 0x56, 0xe0, 0x7a, 0x0b, 0x67, 0xe9, 0xac, 0x19, 0x1d, 0xc6, 0x2e, 0x3d, 0x75, 0xd5, 0xcb,
+	0x5e, 0xaf, 0x6e, 0xcf, 0x16, 0xce, 0x47, 0x74, 0x22, 0xfe, 0x7b, 0x38, 0x45, 0x7f, 0x2a, 0xb0,
+	0x3e, 0xf2, 0xf2, 0x52, 0x47, 0x43, 0x0f, 0x66, 0x2d, 0x6e, 0xd9, 0x64, 0xd5, 0x9d, 0x0b, 0x64,
+	0x48, 0x4a, 0x33, 0xa7, 0x7c, 0x0b, 0xdd, 0x19, 0xdb, 0xf6, 0x0e, 0x61, 0x46, 0xd0, 0xef, 0x18,
+	0xc7, 0x38, 0x12, 0x8f, 0xb3, 0xb9, 0xfb, 0xfc, 0x4c, 0x53, 0x5e, 0x9c, 0x69, 0xca, 0xbf, 0x67,
+	0x9a, 0xf2, 0xcb, 0xb9, 0xb6, 0xf0, 0xe2, 0x5c, 0x5b, 0xf8, 0xfb, 0x5c, 0x5b, 0xf8, 0xd2, 0x74,
+	0x3d, 0xde, 0xed, 0x77, 0xcc, 0x23, 0xda, 0xb3, 0x1e, 0x0c, 0xf7, 0x29, 0x89, 0x5c, 0xea, 0x5b,
+	0x29, 0x90, 0x31, 0x68, 0x58, 0xc3, 0x74, 0x63, 0xa3, 0x00, 0xb3, 0xce, 0xb2, 0xf8, 0xa3, 0xf4,
+	0xbd, 0xff, 0x07, 0x00, 0xf9, 0xcf, 0x9a, 0xe7, 0x4c, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -864,6 +965,8 @@ type QueryClient interface {
 	GetCurrentProposer(ctx context.Context, in *QueryCurrentProposerRequest, opts ...grpc.CallOption) (*QueryCurrentProposerResponse, error)
 	// GetProposersByTimes returns the next N proposers in the rotation sequence.
 	GetProposersByTimes(ctx context.Context, in *QueryProposersRequest, opts ...grpc.CallOption) (*QueryProposersResponse, error)
+	// GetValidatorBlsPubKey queries the BLS key registered by a validator.
+	GetValidatorBlsPubKey(ctx context.Context, in *QueryValidatorBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorBlsPubKeyResponse, error)
 }
 
 type queryClient struct {
@@ -946,6 +1049,15 @@ func (c *queryClient) GetProposersByTimes(ctx context.Context, in *QueryProposer
 	return out, nil
 }
 
+func (c *queryClient) GetValidatorBlsPubKey(ctx context.Context, in *QueryValidatorBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorBlsPubKeyResponse, error) {
+	out := new(QueryValidatorBlsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.stake.Query/GetValidatorBlsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetCurrentValidatorSet queries the current active validator set.
@@ -964,6 +1076,8 @@ type QueryServer interface {
 	GetCurrentProposer(context.Context, *QueryCurrentProposerRequest) (*QueryCurrentProposerResponse, error)
 	// GetProposersByTimes returns the next N proposers in the rotation sequence.
 	GetProposersByTimes(context.Context, *QueryProposersRequest) (*QueryProposersResponse, error)
+	// GetValidatorBlsPubKey queries the BLS key registered by a validator.
+	GetValidatorBlsPubKey(context.Context, *QueryValidatorBlsPubKeyRequest) (*QueryValidatorBlsPubKeyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -994,6 +1108,9 @@ func (*UnimplementedQueryServer) GetCurrentProposer(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) GetProposersByTimes(ctx context.Context, req *QueryProposersRequest) (*QueryProposersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProposersByTimes not implemented")
 }
+func (*UnimplementedQueryServer) GetValidatorBlsPubKey(ctx context.Context, req *QueryValidatorBlsPubKeyRequest) (*QueryValidatorBlsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBlsPubKey not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1143,6 +1260,25 @@ func _Query_GetProposersByTimes_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetValidatorBlsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBlsPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorBlsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.stake.Query/GetValidatorBlsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorBlsPubKey(ctx, req.(*QueryValidatorBlsPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.stake.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1179,6 +1315,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProposersByTimes",
 			Handler:    _Query_GetProposersByTimes_Handler,
 		},
+		{
+			MethodName: "GetValidatorBlsPubKey",
+			Handler:    _Query_GetValidatorBlsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/stake/query.proto",
@@ -1667,6 +1807,64 @@ func (m *QueryCurrentProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorBlsPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlsPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlsPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBlsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1866,6 +2064,31 @@ func (m *QueryCurrentProposerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorBlsPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryValidatorBlsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3050,6 +3273,159 @@ func (m *QueryCurrentProposerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorBlsPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlsPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlsPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBlsPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlsPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -556,6 +556,406 @@ func (m *MsgValidatorExitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgValidatorExitResponse proto.InternalMessageInfo
 
+// SlashedValidator describes the effect of a root chain slashing on a single
+// validator.
+type SlashedValidator struct {
+	ValId       uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	VotingPower int64  `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	Jailed      bool   `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *SlashedValidator) Reset()         { *m = SlashedValidator{} }
+func (m *SlashedValidator) String() string { return proto.CompactTextString(m) }
+func (*SlashedValidator) ProtoMessage()    {}
+func (*SlashedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{8}
+}
+func (m *SlashedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedValidator.Merge(m, src)
+}
+func (m *SlashedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedValidator proto.InternalMessageInfo
+
+func (m *SlashedValidator) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+func (m *SlashedValidator) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *SlashedValidator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+// MsgValidatorSlashed defines the message for applying a slashing, reported by
+// the Slashed event of the root chain, to the validators it affected.
+type MsgValidatorSlashed struct {
+	From          string                `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	SlashingNonce uint64                `protobuf:"varint,2,opt,name=slashing_nonce,json=slashingNonce,proto3" json:"slashing_nonce,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Validators    []SlashedValidator    `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+	TxHash        []byte                `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex      uint64                `protobuf:"varint,6,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber   uint64                `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *MsgValidatorSlashed) Reset()         { *m = MsgValidatorSlashed{} }
+func (m *MsgValidatorSlashed) String() string { return proto.CompactTextString(m) }
+func (*MsgValidatorSlashed) ProtoMessage()    {}
+func (*MsgValidatorSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{9}
+}
+func (m *MsgValidatorSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgValidatorSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidatorSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgValidatorSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidatorSlashed.Merge(m, src)
+}
+func (m *MsgValidatorSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgValidatorSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidatorSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgValidatorSlashed proto.InternalMessageInfo
+
+func (m *MsgValidatorSlashed) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgValidatorSlashed) GetSlashingNonce() uint64 {
+	if m != nil {
+		return m.SlashingNonce
+	}
+	return 0
+}
+
+func (m *MsgValidatorSlashed) GetValidators() []SlashedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *MsgValidatorSlashed) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *MsgValidatorSlashed) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgValidatorSlashed) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// MsgValidatorSlashedResponse defines the response for MsgValidatorSlashed.
+type MsgValidatorSlashedResponse struct {
+}
+
+func (m *MsgValidatorSlashedResponse) Reset()         { *m = MsgValidatorSlashedResponse{} }
+func (m *MsgValidatorSlashedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgValidatorSlashedResponse) ProtoMessage()    {}
+func (*MsgValidatorSlashedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{10}
+}
+func (m *MsgValidatorSlashedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgValidatorSlashedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidatorSlashedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgValidatorSlashedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidatorSlashedResponse.Merge(m, src)
+}
+func (m *MsgValidatorSlashedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgValidatorSlashedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidatorSlashedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgValidatorSlashedResponse proto.InternalMessageInfo
+
+// MsgValidatorUnjail defines the message for a validator being unjailed on the
+// root chain.
+type MsgValidatorUnjail struct {
+	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ValId       uint64 `protobuf:"varint,2,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	TxHash      []byte `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,4,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *MsgValidatorUnjail) Reset()         { *m = MsgValidatorUnjail{} }
+func (m *MsgValidatorUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgValidatorUnjail) ProtoMessage()    {}
+func (*MsgValidatorUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{11}
+}
+func (m *MsgValidatorUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgValidatorUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidatorUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgValidatorUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidatorUnjail.Merge(m, src)
+}
+func (m *MsgValidatorUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgValidatorUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidatorUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgValidatorUnjail proto.InternalMessageInfo
+
+func (m *MsgValidatorUnjail) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgValidatorUnjail) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+func (m *MsgValidatorUnjail) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *MsgValidatorUnjail) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgValidatorUnjail) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// MsgValidatorUnjailResponse defines the response for MsgValidatorUnjail.
+type MsgValidatorUnjailResponse struct {
+}
+
+func (m *MsgValidatorUnjailResponse) Reset()         { *m = MsgValidatorUnjailResponse{} }
+func (m *MsgValidatorUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgValidatorUnjailResponse) ProtoMessage()    {}
+func (*MsgValidatorUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{12}
+}
+func (m *MsgValidatorUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgValidatorUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidatorUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgValidatorUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidatorUnjailResponse.Merge(m, src)
+}
+func (m *MsgValidatorUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgValidatorUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidatorUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgValidatorUnjailResponse proto.InternalMessageInfo
+
+// MsgRegisterBlsKey defines the message for a validator registering the BLS
+// key signing its checkpoints, replacing the one registered before.
+type MsgRegisterBlsKey struct {
+	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	BlsPubKey         []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+	ProofOfPossession []byte `protobuf:"bytes,3,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
+}
+
+func (m *MsgRegisterBlsKey) Reset()         { *m = MsgRegisterBlsKey{} }
+func (m *MsgRegisterBlsKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBlsKey) ProtoMessage()    {}
+func (*MsgRegisterBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{13}
+}
+func (m *MsgRegisterBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBlsKey.Merge(m, src)
+}
+func (m *MsgRegisterBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBlsKey proto.InternalMessageInfo
+
+func (m *MsgRegisterBlsKey) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgRegisterBlsKey) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
+func (m *MsgRegisterBlsKey) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
+// MsgRegisterBlsKeyResponse defines the response for MsgRegisterBlsKey.
+type MsgRegisterBlsKeyResponse struct {
+}
+
+func (m *MsgRegisterBlsKeyResponse) Reset()         { *m = MsgRegisterBlsKeyResponse{} }
+func (m *MsgRegisterBlsKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBlsKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterBlsKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26734657616f623d, []int{14}
+}
+func (m *MsgRegisterBlsKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBlsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBlsKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBlsKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBlsKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterBlsKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBlsKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBlsKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBlsKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgValidatorJoin)(nil), "heimdallv2.stake.MsgValidatorJoin")
 	proto.RegisterType((*MsgValidatorJoinResponse)(nil), "heimdallv2.stake.MsgValidatorJoinResponse")
@@ -565,59 +965,86 @@ func init() {
 	proto.RegisterType((*MsgSignerUpdateResponse)(nil), "heimdallv2.stake.MsgSignerUpdateResponse")
 	proto.RegisterType((*MsgValidatorExit)(nil), "heimdallv2.stake.MsgValidatorExit")
 	proto.RegisterType((*MsgValidatorExitResponse)(nil), "heimdallv2.stake.MsgValidatorExitResponse")
+	proto.RegisterType((*SlashedValidator)(nil), "heimdallv2.stake.SlashedValidator")
+	proto.RegisterType((*MsgValidatorSlashed)(nil), "heimdallv2.stake.MsgValidatorSlashed")
+	proto.RegisterType((*MsgValidatorSlashedResponse)(nil), "heimdallv2.stake.MsgValidatorSlashedResponse")
+	proto.RegisterType((*MsgValidatorUnjail)(nil), "heimdallv2.stake.MsgValidatorUnjail")
+	proto.RegisterType((*MsgValidatorUnjailResponse)(nil), "heimdallv2.stake.MsgValidatorUnjailResponse")
+	proto.RegisterType((*MsgRegisterBlsKey)(nil), "heimdallv2.stake.MsgRegisterBlsKey")
+	proto.RegisterType((*MsgRegisterBlsKeyResponse)(nil), "heimdallv2.stake.MsgRegisterBlsKeyResponse")
 }
 
 func init() { proto.RegisterFile("heimdallv2/stake/tx.proto", fileDescriptor_26734657616f623d) }
 
 var fileDescriptor_26734657616f623d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x18, 0x86, 0xe3, 0xfc, 0xb5, 0x99, 0xe6, 0xf4, 0x67, 0xd4, 0x73, 0xea, 0xe6, 0x9c, 0x93, 0xa4,
-	0x5e, 0x85, 0xa0, 0xda, 0x6d, 0xf8, 0x93, 0xba, 0x6b, 0xa5, 0x4a, 0x2d, 0xa8, 0xa8, 0x6a, 0x05,
-	0x12, 0x08, 0xc9, 0x9a, 0xc4, 0x83, 0x63, 0xc5, 0x9e, 0x89, 0x32, 0x93, 0x34, 0xd9, 0x21, 0x36,
-	0x20, 0x56, 0x5c, 0x42, 0x97, 0x2c, 0xbb, 0xe0, 0x0a, 0x58, 0x75, 0x59, 0xb1, 0x02, 0x24, 0x2a,
-	0xd4, 0x4a, 0x94, 0xcb, 0x40, 0xb6, 0x93, 0x74, 0x62, 0x0c, 0xae, 0x50, 0x11, 0x6c, 0x92, 0xf8,
-	0x7b, 0xdf, 0xf1, 0x8c, 0xde, 0xe7, 0xcb, 0x67, 0x83, 0xf9, 0x3a, 0xb6, 0x1c, 0x03, 0xd9, 0x76,
-	0xa7, 0xa2, 0x31, 0x8e, 0x1a, 0x58, 0xe3, 0x5d, 0xb5, 0xd9, 0xa2, 0x9c, 0xc2, 0xe9, 0x73, 0x49,
-	0xf5, 0xa4, 0xdc, 0xac, 0x49, 0x4d, 0xea, 0x89, 0x9a, 0xfb, 0xcb, 0xf7, 0xe5, 0xe6, 0x6b, 0x94,
-	0x39, 0x94, 0xe9, 0xbe, 0xe0, 0x5f, 0xf4, 0xa5, 0x39, 0xff, 0x4a, 0x73, 0x98, 0xa9, 0x75, 0x96,
-	0xdd, 0xaf, 0xbe, 0x30, 0x83, 0x1c, 0x8b, 0x50, 0xcd, 0xfb, 0xf4, 0x4b, 0xca, 0x9b, 0x04, 0x98,
-	0xde, 0x62, 0xe6, 0x7d, 0x64, 0x5b, 0x06, 0xe2, 0xb4, 0x75, 0x9b, 0x5a, 0x04, 0x2e, 0x83, 0xe4,
-	0xe3, 0x16, 0x75, 0x64, 0xa9, 0x28, 0x95, 0x32, 0x6b, 0xff, 0xbf, 0x7d, 0xbd, 0x38, 0xdb, 0xdf,
-	0x60, 0xd5, 0x30, 0x5a, 0x98, 0xb1, 0x5d, 0xde, 0xb2, 0x88, 0xf9, 0xea, 0xec, 0xa0, 0x2c, 0xed,
-	0x78, 0x56, 0xf8, 0x1f, 0x48, 0x77, 0x90, 0xad, 0x5b, 0x86, 0x1c, 0x2f, 0x4a, 0xa5, 0xe4, 0x5a,
-	0xca, 0x17, 0x53, 0x1d, 0x64, 0x6f, 0x1a, 0x70, 0x09, 0x4c, 0xa3, 0x1a, 0xb7, 0x3a, 0x88, 0x5b,
-	0x94, 0xe8, 0xb8, 0x49, 0x6b, 0x75, 0x39, 0x21, 0xfa, 0xa6, 0xce, 0xe5, 0x75, 0x57, 0x85, 0x2b,
-	0x20, 0x8d, 0x1c, 0xda, 0x26, 0x5c, 0x4e, 0x7a, 0x87, 0x50, 0x0e, 0x8f, 0x0b, 0xb1, 0x0f, 0xc7,
-	0x85, 0xbf, 0xfd, 0x83, 0x30, 0xa3, 0xa1, 0x5a, 0x54, 0x73, 0x10, 0xaf, 0xab, 0x9b, 0x84, 0xfb,
-	0x37, 0xe9, 0xaf, 0x80, 0x57, 0xc1, 0x24, 0xb3, 0x4c, 0x82, 0x5b, 0x7a, 0xb3, 0x5d, 0xd5, 0x1b,
-	0xb8, 0x27, 0xa7, 0x8a, 0x52, 0x29, 0x3b, 0xd8, 0x2b, 0xeb, 0x8b, 0xdb, 0xed, 0xea, 0x1d, 0xdc,
-	0x83, 0x79, 0x30, 0xc6, 0xbb, 0x7a, 0x1d, 0xb1, 0xba, 0x9c, 0x16, 0x5d, 0x69, 0xde, 0xdd, 0x40,
-	0xac, 0x0e, 0x15, 0x90, 0xb1, 0xa9, 0xa9, 0x5b, 0xc4, 0xc0, 0x5d, 0x79, 0x4c, 0x3c, 0xf3, 0xb8,
-	0x4d, 0xcd, 0x4d, 0xb7, 0x0c, 0x4b, 0x20, 0x5b, 0xb5, 0x69, 0xad, 0xa1, 0x93, 0xb6, 0x53, 0xc5,
-	0x2d, 0x79, 0x5c, 0xb4, 0x4d, 0x78, 0xd2, 0x5d, 0x4f, 0x81, 0xff, 0x82, 0x14, 0xa1, 0xa4, 0x86,
-	0xe5, 0xcc, 0x48, 0x4a, 0x5e, 0x6d, 0xe5, 0xd6, 0xf3, 0xfd, 0x82, 0xf4, 0x65, 0xbf, 0x10, 0x7b,
-	0x7a, 0x76, 0x50, 0xf6, 0x62, 0x7d, 0x71, 0x76, 0x50, 0x5e, 0xf8, 0xa6, 0x57, 0x82, 0xbc, 0x94,
-	0x1c, 0x90, 0x83, 0xb5, 0x1d, 0xcc, 0x9a, 0x94, 0x30, 0xac, 0x7c, 0x8e, 0x83, 0xc9, 0x2d, 0x66,
-	0xee, 0xba, 0x2b, 0xef, 0x35, 0x0d, 0xc4, 0xf1, 0xe5, 0xe3, 0x5d, 0x05, 0x80, 0xe0, 0x3d, 0xbd,
-	0x0f, 0x2c, 0x71, 0x61, 0x60, 0x19, 0x82, 0xf7, 0x56, 0x7d, 0x66, 0x02, 0x86, 0x64, 0x24, 0x86,
-	0xd4, 0xc5, 0x30, 0xa4, 0xa3, 0x31, 0x8c, 0x85, 0x60, 0xb8, 0x11, 0x8a, 0xa1, 0x10, 0x86, 0x41,
-	0x48, 0x55, 0x91, 0xc1, 0x3f, 0xa3, 0x95, 0x21, 0x82, 0xf7, 0x71, 0x30, 0xe5, 0x4a, 0x5e, 0xdb,
-	0xfd, 0x2a, 0x06, 0x15, 0x00, 0x5d, 0x06, 0x81, 0xc6, 0x4f, 0x88, 0x59, 0x4e, 0x11, 0xbc, 0xb7,
-	0xfb, 0x9d, 0xde, 0xff, 0xdd, 0xa1, 0xdf, 0x0c, 0x0d, 0xbd, 0x18, 0x1a, 0xba, 0x90, 0xa3, 0x32,
-	0x0f, 0xe6, 0x02, 0xa5, 0x61, 0xec, 0x1f, 0xe3, 0xa3, 0xa3, 0x6d, 0xbd, 0x6b, 0xf1, 0xcb, 0xcf,
-	0xfd, 0x3a, 0x80, 0x06, 0xfe, 0xf1, 0x70, 0x9b, 0x11, 0x0d, 0xfe, 0x78, 0xfb, 0x83, 0x92, 0xff,
-	0x99, 0xa9, 0xe3, 0x46, 0x19, 0x9c, 0x3a, 0x6e, 0x6d, 0x90, 0x7d, 0xe5, 0x59, 0x02, 0x24, 0xb6,
-	0x98, 0x09, 0x75, 0xf0, 0xd7, 0xe8, 0xa3, 0x45, 0x51, 0x83, 0xcf, 0x37, 0x35, 0x38, 0xba, 0x72,
-	0xe5, 0x68, 0xcf, 0x60, 0x23, 0xf8, 0x00, 0x4c, 0x88, 0xa3, 0xad, 0x18, 0xba, 0x54, 0x70, 0xe4,
-	0x4a, 0x51, 0x8e, 0xe1, 0xad, 0x1f, 0x81, 0xec, 0xc8, 0x5f, 0x76, 0x21, 0x7c, 0xa5, 0x60, 0xc9,
-	0x5d, 0x89, 0xb4, 0x0c, 0xef, 0x2e, 0x26, 0xe3, 0x75, 0x66, 0x44, 0x32, 0xae, 0x27, 0x2a, 0x19,
-	0x11, 0x41, 0x2e, 0xf5, 0xc4, 0xa5, 0xbc, 0xb6, 0x71, 0x78, 0x92, 0x97, 0x8e, 0x4e, 0xf2, 0xd2,
-	0xa7, 0x93, 0xbc, 0xf4, 0xf2, 0x34, 0x1f, 0x3b, 0x3a, 0xcd, 0xc7, 0xde, 0x9d, 0xe6, 0x63, 0x0f,
-	0x55, 0xd3, 0xe2, 0xf5, 0x76, 0x55, 0xad, 0x51, 0x47, 0x5b, 0xea, 0x6e, 0x53, 0xbb, 0x67, 0x52,
-	0xa2, 0x0d, 0x36, 0x58, 0xec, 0x54, 0xb4, 0xee, 0xe0, 0xe5, 0xa4, 0xd7, 0xc4, 0xac, 0x9a, 0xf6,
-	0xde, 0x18, 0xae, 0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe4, 0x3c, 0x19, 0xbd, 0x08, 0x00,
-	0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xe3, 0x24, 0x6d, 0x5e, 0xb3, 0x6d, 0xea, 0x5d, 0x58, 0xd7, 0xdd, 0x26, 0x59, 0xef,
+	0xae, 0x14, 0xb2, 0xdb, 0x78, 0x37, 0xb0, 0xfc, 0xc8, 0xad, 0x91, 0x56, 0xda, 0x82, 0xb2, 0x54,
+	0xad, 0x16, 0x09, 0x84, 0x64, 0x39, 0xf1, 0xd4, 0x31, 0x75, 0x66, 0x22, 0x8f, 0x93, 0xa6, 0x37,
+	0x04, 0x17, 0xc4, 0x01, 0xf1, 0x27, 0xec, 0x11, 0x89, 0x4b, 0x0f, 0x1c, 0x39, 0x71, 0xda, 0xe3,
+	0x8a, 0x13, 0x20, 0x51, 0xa1, 0x56, 0xa2, 0xfc, 0x19, 0xc8, 0x76, 0xe2, 0x4e, 0x5c, 0x37, 0x0e,
+	0x55, 0x11, 0x5c, 0xda, 0xe4, 0x7d, 0xdf, 0xcc, 0x9b, 0xf9, 0xbe, 0x37, 0x6f, 0x26, 0xb0, 0xd2,
+	0x41, 0x66, 0x57, 0xd7, 0x2c, 0x6b, 0x50, 0x53, 0xa8, 0xa3, 0xed, 0x21, 0xc5, 0x19, 0x56, 0x7b,
+	0x36, 0x71, 0x88, 0x90, 0x3f, 0x83, 0xaa, 0x1e, 0x24, 0xdd, 0x30, 0x88, 0x41, 0x3c, 0x50, 0x71,
+	0x3f, 0xf9, 0x3c, 0x69, 0xa5, 0x4d, 0x68, 0x97, 0x50, 0xd5, 0x07, 0xfc, 0x2f, 0x23, 0xe8, 0xa6,
+	0xff, 0x4d, 0xe9, 0x52, 0x43, 0x19, 0x3c, 0x72, 0xff, 0x8d, 0x80, 0x65, 0xad, 0x6b, 0x62, 0xa2,
+	0x78, 0x7f, 0xfd, 0x90, 0xfc, 0x13, 0x0f, 0xf9, 0x26, 0x35, 0x3e, 0xd2, 0x2c, 0x53, 0xd7, 0x1c,
+	0x62, 0xbf, 0x4f, 0x4c, 0x2c, 0x3c, 0x82, 0xd4, 0xae, 0x4d, 0xba, 0x22, 0x57, 0xe2, 0xca, 0xd9,
+	0xc6, 0xda, 0xcf, 0x3f, 0xac, 0xdf, 0x18, 0x25, 0xd8, 0xd0, 0x75, 0x1b, 0x51, 0xba, 0xe3, 0xd8,
+	0x26, 0x36, 0xbe, 0x3b, 0x3d, 0xac, 0x70, 0xdb, 0x1e, 0x55, 0xb8, 0x05, 0x99, 0x81, 0x66, 0xa9,
+	0xa6, 0x2e, 0x26, 0x4b, 0x5c, 0x39, 0xd5, 0x48, 0xfb, 0x60, 0x7a, 0xa0, 0x59, 0x9b, 0xba, 0xf0,
+	0x10, 0xf2, 0x5a, 0xdb, 0x31, 0x07, 0x9a, 0x63, 0x12, 0xac, 0xa2, 0x1e, 0x69, 0x77, 0x44, 0x9e,
+	0xe5, 0x2d, 0x9d, 0xc1, 0x4f, 0x5c, 0x54, 0xa8, 0x43, 0x46, 0xeb, 0x92, 0x3e, 0x76, 0xc4, 0x94,
+	0xb7, 0x08, 0xf9, 0xe5, 0x51, 0x31, 0xf1, 0xdb, 0x51, 0xf1, 0x35, 0x7f, 0x21, 0x54, 0xdf, 0xab,
+	0x9a, 0x44, 0xe9, 0x6a, 0x4e, 0xa7, 0xba, 0x89, 0x1d, 0x7f, 0x92, 0xd1, 0x08, 0xe1, 0x3e, 0x2c,
+	0x52, 0xd3, 0xc0, 0xc8, 0x56, 0x7b, 0xfd, 0x96, 0xba, 0x87, 0x0e, 0xc4, 0x74, 0x89, 0x2b, 0xe7,
+	0xc6, 0xb9, 0x72, 0x3e, 0xb8, 0xd5, 0x6f, 0x7d, 0x80, 0x0e, 0x84, 0x02, 0xcc, 0x39, 0x43, 0xb5,
+	0xa3, 0xd1, 0x8e, 0x98, 0x61, 0x59, 0x19, 0x67, 0xf8, 0x54, 0xa3, 0x1d, 0x41, 0x86, 0xac, 0x45,
+	0x0c, 0xd5, 0xc4, 0x3a, 0x1a, 0x8a, 0x73, 0xec, 0x9a, 0xe7, 0x2d, 0x62, 0x6c, 0xba, 0x61, 0xa1,
+	0x0c, 0xb9, 0x96, 0x45, 0xda, 0x7b, 0x2a, 0xee, 0x77, 0x5b, 0xc8, 0x16, 0xe7, 0x59, 0xda, 0x82,
+	0x07, 0x3d, 0xf3, 0x10, 0x61, 0x15, 0xd2, 0x98, 0xe0, 0x36, 0x12, 0xb3, 0x13, 0x2a, 0x79, 0xb1,
+	0xfa, 0x3b, 0x5f, 0xbd, 0x28, 0x72, 0x7f, 0xbd, 0x28, 0x26, 0xbe, 0x38, 0x3d, 0xac, 0x78, 0xb2,
+	0x7e, 0x7d, 0x7a, 0x58, 0xb9, 0x7d, 0xae, 0x56, 0xc2, 0x7e, 0xc9, 0x12, 0x88, 0xe1, 0xd8, 0x36,
+	0xa2, 0x3d, 0x82, 0x29, 0x92, 0xff, 0x4c, 0xc2, 0x62, 0x93, 0x1a, 0x3b, 0xee, 0xc8, 0xe7, 0x3d,
+	0x5d, 0x73, 0xd0, 0xd5, 0xdb, 0xbb, 0x01, 0x80, 0xd1, 0xbe, 0x3a, 0x32, 0x8c, 0x9f, 0xd9, 0xb0,
+	0x2c, 0x46, 0xfb, 0x1b, 0xbe, 0x67, 0x8c, 0x0d, 0xa9, 0x58, 0x1b, 0xd2, 0xb3, 0xd9, 0x90, 0x89,
+	0xb7, 0x61, 0x2e, 0xc2, 0x86, 0xc7, 0x91, 0x36, 0x14, 0xa3, 0x6c, 0x60, 0x54, 0x95, 0x45, 0x78,
+	0x7d, 0x32, 0x12, 0x58, 0xf0, 0x6b, 0x12, 0x96, 0x5c, 0xc8, 0x2b, 0xbb, 0x7f, 0xcb, 0x83, 0x1a,
+	0x08, 0xae, 0x07, 0xa1, 0xc2, 0xe7, 0x59, 0x2d, 0x97, 0x30, 0xda, 0xdf, 0xb9, 0xa0, 0xf6, 0xff,
+	0x6b, 0xd1, 0xdf, 0x8e, 0x14, 0xbd, 0x14, 0x29, 0x3a, 0xa3, 0xa3, 0xbc, 0x02, 0x37, 0x43, 0xa1,
+	0x40, 0xf6, 0xdf, 0x93, 0x93, 0xad, 0xed, 0xc9, 0xd0, 0x74, 0xae, 0x5e, 0xf7, 0xb7, 0x40, 0xd0,
+	0xd1, 0xf4, 0xe6, 0xb6, 0xac, 0xa3, 0x70, 0x7b, 0xfb, 0x1f, 0x29, 0x7f, 0x99, 0xae, 0xe3, 0x4a,
+	0x19, 0xee, 0x3a, 0x6e, 0x2c, 0xd0, 0xfe, 0x4b, 0x0e, 0xf2, 0x3b, 0x96, 0x46, 0x3b, 0x48, 0x0f,
+	0x08, 0x8c, 0x90, 0x5c, 0x84, 0x90, 0x65, 0xc8, 0x0d, 0x88, 0x63, 0x62, 0x43, 0xed, 0x91, 0x7d,
+	0x64, 0x7b, 0x62, 0xf3, 0xc1, 0x76, 0x7c, 0x68, 0xcb, 0x45, 0x84, 0x35, 0xc8, 0x7c, 0xa6, 0x99,
+	0x16, 0xd2, 0x3d, 0x99, 0xe7, 0x03, 0xed, 0xfc, 0x60, 0x7d, 0x7e, 0xbc, 0x21, 0xf9, 0x7b, 0x1e,
+	0xae, 0xb3, 0x4b, 0x1c, 0xad, 0xe8, 0x32, 0x45, 0xf0, 0x00, 0x16, 0xa9, 0x3b, 0xda, 0x5d, 0x9f,
+	0xaf, 0xe5, 0x44, 0x31, 0x5c, 0x1b, 0x83, 0xcf, 0x5c, 0x8c, 0xb9, 0xbd, 0xf8, 0x7f, 0x7c, 0x7b,
+	0x35, 0x01, 0x06, 0xe3, 0x05, 0x53, 0x31, 0x55, 0xe2, 0xcb, 0x0b, 0x35, 0xb9, 0x1a, 0x7e, 0x15,
+	0x54, 0xc3, 0xea, 0x36, 0xb2, 0x6e, 0x0e, 0x7f, 0x2a, 0x66, 0x02, 0xb6, 0xd2, 0xd2, 0xb1, 0x95,
+	0x96, 0x99, 0xad, 0xd2, 0xe6, 0x2e, 0xaa, 0xb4, 0x7a, 0x3d, 0xb2, 0x98, 0xee, 0x4e, 0x2d, 0xa6,
+	0xd1, 0x4e, 0xe4, 0x35, 0x58, 0x8d, 0x08, 0x07, 0x25, 0xf5, 0x4d, 0x12, 0x04, 0x16, 0x7f, 0x8e,
+	0x5d, 0xbf, 0xaf, 0xfe, 0x40, 0x33, 0x82, 0xf1, 0xb1, 0x82, 0xa5, 0x66, 0x13, 0x2c, 0x7d, 0xa1,
+	0x60, 0xef, 0x45, 0x0a, 0x76, 0x67, 0xaa, 0x60, 0xfe, 0xce, 0xe5, 0x5b, 0x20, 0x9d, 0x8f, 0x06,
+	0x72, 0x1d, 0x71, 0xb0, 0xdc, 0xa4, 0xc6, 0x36, 0x32, 0x4c, 0xea, 0x20, 0xbb, 0x61, 0x51, 0xb7,
+	0xe3, 0x5f, 0x42, 0xad, 0x7b, 0xb0, 0xd0, 0xb2, 0x68, 0x70, 0xa3, 0x24, 0x59, 0x4d, 0xb2, 0x2d,
+	0x8b, 0x8e, 0xee, 0x92, 0xc7, 0x70, 0xbd, 0x67, 0x13, 0xb2, 0xab, 0x92, 0x5d, 0xb5, 0x47, 0x28,
+	0x45, 0x94, 0x9a, 0x04, 0x4f, 0x4a, 0xb8, 0xec, 0x31, 0x3e, 0xdc, 0xdd, 0x0a, 0xf0, 0xfa, 0xbb,
+	0x91, 0xfb, 0x97, 0xa3, 0xf6, 0x3f, 0xb9, 0x15, 0x79, 0x15, 0x56, 0xce, 0x05, 0xc7, 0xbb, 0xaf,
+	0xfd, 0x98, 0x06, 0xbe, 0x49, 0x0d, 0x41, 0x85, 0x6b, 0x93, 0x4f, 0xdb, 0x88, 0x93, 0x14, 0x7e,
+	0x3a, 0x49, 0x95, 0x78, 0xce, 0x38, 0x91, 0xf0, 0x31, 0x2c, 0xb0, 0x4f, 0xab, 0x52, 0xe4, 0x50,
+	0x86, 0x21, 0x95, 0xe3, 0x18, 0xc1, 0xd4, 0x9f, 0x42, 0x6e, 0xe2, 0xc9, 0x70, 0x3b, 0x7a, 0x24,
+	0x43, 0x91, 0xde, 0x88, 0xa5, 0x04, 0xb3, 0xb3, 0xca, 0x78, 0x37, 0x63, 0x8c, 0x32, 0x2e, 0x47,
+	0xaa, 0xc4, 0x73, 0x82, 0x04, 0x1d, 0xc8, 0x9f, 0x6b, 0xbc, 0xf7, 0xa6, 0x8f, 0x1f, 0xd1, 0xa4,
+	0xf5, 0x99, 0x68, 0x41, 0x26, 0x04, 0x4b, 0xe1, 0xae, 0x70, 0x77, 0xfa, 0x0c, 0x3e, 0x4b, 0x7a,
+	0x30, 0x0b, 0x2b, 0x48, 0xd3, 0x82, 0xc5, 0xd0, 0x69, 0xba, 0x13, 0x39, 0x7e, 0x92, 0x24, 0xdd,
+	0x9f, 0x81, 0x34, 0xce, 0x21, 0xa5, 0x3f, 0x77, 0x0f, 0x4a, 0xe3, 0xe9, 0xcb, 0xe3, 0x02, 0xf7,
+	0xea, 0xb8, 0xc0, 0xfd, 0x71, 0x5c, 0xe0, 0xbe, 0x3d, 0x29, 0x24, 0x5e, 0x9d, 0x14, 0x12, 0xbf,
+	0x9c, 0x14, 0x12, 0x9f, 0x54, 0x0d, 0xd3, 0xe9, 0xf4, 0x5b, 0xd5, 0x36, 0xe9, 0x2a, 0x0f, 0x87,
+	0x5b, 0xc4, 0x3a, 0x30, 0x08, 0x56, 0xc6, 0x19, 0xd6, 0x07, 0x35, 0x65, 0x38, 0xfe, 0x45, 0x79,
+	0xd0, 0x43, 0xb4, 0x95, 0xf1, 0x7e, 0xe6, 0xbd, 0xf9, 0xf7, 0x00, 0xd4, 0x1f, 0x94, 0x03, 0x72,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -640,6 +1067,13 @@ type MsgClient interface {
 	SignerUpdate(ctx context.Context, in *MsgSignerUpdate, opts ...grpc.CallOption) (*MsgSignerUpdateResponse, error)
 	// ValidatorExit processes a validator exiting the network.
 	ValidatorExit(ctx context.Context, in *MsgValidatorExit, opts ...grpc.CallOption) (*MsgValidatorExitResponse, error)
+	// ValidatorSlashed applies a root chain slashing to the affected validators.
+	ValidatorSlashed(ctx context.Context, in *MsgValidatorSlashed, opts ...grpc.CallOption) (*MsgValidatorSlashedResponse, error)
+	// ValidatorUnjail processes a validator being unjailed on the root chain.
+	ValidatorUnjail(ctx context.Context, in *MsgValidatorUnjail, opts ...grpc.CallOption) (*MsgValidatorUnjailResponse, error)
+	// RegisterBlsKey registers the BLS key signing the checkpoints of a
+	// validator.
+	RegisterBlsKey(ctx context.Context, in *MsgRegisterBlsKey, opts ...grpc.CallOption) (*MsgRegisterBlsKeyResponse, error)
 }
 
 type msgClient struct {
@@ -686,19 +1120,53 @@ func (c *msgClient) ValidatorExit(ctx context.Context, in *MsgValidatorExit, opt
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ValidatorJoin processes a new validator joining the network.
-	ValidatorJoin(context.Context, *MsgValidatorJoin) (*MsgValidatorJoinResponse, error)
-	// StakeUpdate processes an update to a validator's stake amount.
-	StakeUpdate(context.Context, *MsgStakeUpdate) (*MsgStakeUpdateResponse, error)
-	// SignerUpdate processes an update to a validator's signer public key.
-	SignerUpdate(context.Context, *MsgSignerUpdate) (*MsgSignerUpdateResponse, error)
-	// ValidatorExit processes a validator exiting the network.
-	ValidatorExit(context.Context, *MsgValidatorExit) (*MsgValidatorExitResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+func (c *msgClient) ValidatorSlashed(ctx context.Context, in *MsgValidatorSlashed, opts ...grpc.CallOption) (*MsgValidatorSlashedResponse, error) {
+	out := new(MsgValidatorSlashedResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.stake.Msg/ValidatorSlashed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ValidatorUnjail(ctx context.Context, in *MsgValidatorUnjail, opts ...grpc.CallOption) (*MsgValidatorUnjailResponse, error) {
+	out := new(MsgValidatorUnjailResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.stake.Msg/ValidatorUnjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBlsKey(ctx context.Context, in *MsgRegisterBlsKey, opts ...grpc.CallOption) (*MsgRegisterBlsKeyResponse, error) {
+	out := new(MsgRegisterBlsKeyResponse)
+	err := c.cc.Invoke(ctx, "/heimdallv2.stake.Msg/RegisterBlsKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ValidatorJoin processes a new validator joining the network.
+	ValidatorJoin(context.Context, *MsgValidatorJoin) (*MsgValidatorJoinResponse, error)
+	// StakeUpdate processes an update to a validator's stake amount.
+	StakeUpdate(context.Context, *MsgStakeUpdate) (*MsgStakeUpdateResponse, error)
+	// SignerUpdate processes an update to a validator's signer public key.
+	SignerUpdate(context.Context, *MsgSignerUpdate) (*MsgSignerUpdateResponse, error)
+	// ValidatorExit processes a validator exiting the network.
+	ValidatorExit(context.Context, *MsgValidatorExit) (*MsgValidatorExitResponse, error)
+	// ValidatorSlashed applies a root chain slashing to the affected validators.
+	ValidatorSlashed(context.Context, *MsgValidatorSlashed) (*MsgValidatorSlashedResponse, error)
+	// ValidatorUnjail processes a validator being unjailed on the root chain.
+	ValidatorUnjail(context.Context, *MsgValidatorUnjail) (*MsgValidatorUnjailResponse, error)
+	// RegisterBlsKey registers the BLS key signing the checkpoints of a
+	// validator.
+	RegisterBlsKey(context.Context, *MsgRegisterBlsKey) (*MsgRegisterBlsKeyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
@@ -714,6 +1182,15 @@ func (*UnimplementedMsgServer) SignerUpdate(ctx context.Context, req *MsgSignerU
 func (*UnimplementedMsgServer) ValidatorExit(ctx context.Context, req *MsgValidatorExit) (*MsgValidatorExitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExit not implemented")
 }
+func (*UnimplementedMsgServer) ValidatorSlashed(ctx context.Context, req *MsgValidatorSlashed) (*MsgValidatorSlashedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashed not implemented")
+}
+func (*UnimplementedMsgServer) ValidatorUnjail(ctx context.Context, req *MsgValidatorUnjail) (*MsgValidatorUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnjail not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBlsKey(ctx context.Context, req *MsgRegisterBlsKey) (*MsgRegisterBlsKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBlsKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -791,6 +1268,61 @@ func _Msg_ValidatorExit_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ValidatorSlashed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidatorSlashed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidatorSlashed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.stake.Msg/ValidatorSlashed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidatorSlashed(ctx, req.(*MsgValidatorSlashed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ValidatorUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidatorUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidatorUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.stake.Msg/ValidatorUnjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidatorUnjail(ctx, req.(*MsgValidatorUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBlsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBlsKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBlsKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdallv2.stake.Msg/RegisterBlsKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBlsKey(ctx, req.(*MsgRegisterBlsKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdallv2.stake.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -811,6 +1343,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorExit",
 			Handler:    _Msg_ValidatorExit_Handler,
 		},
+		{
+			MethodName: "ValidatorSlashed",
+			Handler:    _Msg_ValidatorSlashed_Handler,
+		},
+		{
+			MethodName: "ValidatorUnjail",
+			Handler:    _Msg_ValidatorUnjail_Handler,
+		},
+		{
+			MethodName: "RegisterBlsKey",
+			Handler:    _Msg_RegisterBlsKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdallv2/stake/tx.proto",
@@ -1180,113 +1724,397 @@ func (m *MsgValidatorExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlashedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgValidatorJoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SlashedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ValId != 0 {
-		n += 1 + sovTx(uint64(m.ValId))
-	}
-	if m.ActivationEpoch != 0 {
-		n += 1 + sovTx(uint64(m.ActivationEpoch))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SignerPubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LogIndex != 0 {
-		n += 1 + sovTx(uint64(m.LogIndex))
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.BlockNumber))
+	if m.VotingPower != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if m.ValId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidatorJoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgValidatorSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStakeUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgValidatorSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidatorSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.ValId != 0 {
-		n += 1 + sovTx(uint64(m.ValId))
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.NewAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.LogIndex != 0 {
-		n += 1 + sovTx(uint64(m.LogIndex))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.BlockNumber))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashingNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashingNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgValidatorSlashedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidatorSlashedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidatorSlashedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignerUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgValidatorUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidatorUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidatorUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValidatorUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidatorUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidatorUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBlsKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBlsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBlsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgValidatorJoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValId != 0 {
+		n += 1 + sovTx(uint64(m.ValId))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ActivationEpoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SignerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgValidatorJoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValId != 0 {
+		n += 1 + sovTx(uint64(m.ValId))
+	}
+	l = m.NewAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgStakeUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignerUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.ValId != 0 {
 		n += 1 + sovTx(uint64(m.ValId))
@@ -1349,25 +2177,1032 @@ func (m *MsgValidatorExit) Size() (n int) {
 	if m.Nonce != 0 {
 		n += 1 + sovTx(uint64(m.Nonce))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgValidatorExitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SlashedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovTx(uint64(m.ValId))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovTx(uint64(m.VotingPower))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgValidatorSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingNonce != 0 {
+		n += 1 + sovTx(uint64(m.SlashingNonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *MsgValidatorSlashedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValidatorUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValId != 0 {
+		n += 1 + sovTx(uint64(m.ValId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *MsgValidatorUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterBlsKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidatorJoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidatorJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerPubKey = append(m.SignerPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerPubKey == nil {
+				m.SignerPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidatorJoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidatorJoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidatorJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignerUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSignerPubKey = append(m.NewSignerPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewSignerPubKey == nil {
+				m.NewSignerPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgValidatorExitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSignerUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignerUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignerUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,10 +3225,10 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidatorJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidatorExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidatorJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidatorExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1449,9 +3284,9 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationEpoch", wireType)
 			}
-			m.ActivationEpoch = 0
+			m.DeactivationEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1461,80 +3296,12 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				m.DeactivationEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerPubKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignerPubKey = append(m.SignerPubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SignerPubKey == nil {
-				m.SignerPubKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
@@ -1568,7 +3335,7 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
@@ -1587,7 +3354,7 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -1606,7 +3373,7 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1646,7 +3413,57 @@ func (m *MsgValidatorJoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidatorJoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorExitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidatorExitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidatorExitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1656,25 +3473,83 @@ func (m *MsgValidatorJoinResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidatorJoinResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidatorJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1696,7 +3571,7 @@ func (m *MsgValidatorJoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1719,10 +3594,10 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidatorSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidatorSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1759,9 +3634,9 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingNonce", wireType)
 			}
-			m.ValId = 0
+			m.SlashingNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1771,14 +3646,14 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValId |= uint64(b&0x7F) << shift
+				m.SlashingNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1806,15 +3681,15 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1824,31 +3699,31 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxHash == nil {
-				m.TxHash = []byte{}
+			m.Validators = append(m.Validators, SlashedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.LogIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1858,16 +3733,31 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
-			m.BlockNumber = 0
+			m.LogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1877,16 +3767,16 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
+				m.LogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.Nonce = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1896,7 +3786,7 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1922,7 +3812,7 @@ func (m *MsgStakeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorSlashedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,10 +3835,10 @@ func (m *MsgStakeUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidatorSlashedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidatorSlashedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1972,7 +3862,7 @@ func (m *MsgStakeUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorUnjail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,10 +3885,10 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignerUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidatorUnjail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidatorUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2053,40 +3943,6 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerPubKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewSignerPubKey = append(m.NewSignerPubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewSignerPubKey == nil {
-				m.NewSignerPubKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
@@ -2120,7 +3976,7 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
@@ -2139,7 +3995,7 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -2158,25 +4014,6 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2198,7 +4035,7 @@ func (m *MsgSignerUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignerUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValidatorUnjailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2221,10 +4058,10 @@ func (m *MsgSignerUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignerUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidatorUnjailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignerUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidatorUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2248,7 +4085,7 @@ func (m *MsgSignerUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBlsKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2271,10 +4108,10 @@ func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidatorExit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBlsKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidatorExit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2310,46 +4147,8 @@ func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
-			}
-			m.ValId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationEpoch", wireType)
-			}
-			m.DeactivationEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeactivationEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2376,16 +4175,16 @@ func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxHash == nil {
-				m.TxHash = []byte{}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
 			}
-			m.LogIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2395,49 +4194,26 @@ func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2459,7 +4235,7 @@ func (m *MsgValidatorExit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidatorExitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBlsKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2482,10 +4258,10 @@ func (m *MsgValidatorExitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidatorExitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBlsKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidatorExitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBlsKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
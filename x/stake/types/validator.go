@@ -220,8 +220,8 @@ func (*Validator) IsBonded() bool {
 }
 
 // IsJailed implements types.ValidatorI.
-func (*Validator) IsJailed() bool {
-	panic("unimplemented")
+func (v *Validator) IsJailed() bool {
+	return v.Jailed
 }
 
 // IsUnbonded implements types.ValidatorI.
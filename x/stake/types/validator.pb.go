@@ -200,47 +200,104 @@ func (m *ValidatorSet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSet proto.InternalMessageInfo
 
+// ValidatorBlsPubKey is the BLS key registered by a validator to sign the
+// checkpoints.
+type ValidatorBlsPubKey struct {
+	ValId     uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	BlsPubKey []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+}
+
+func (m *ValidatorBlsPubKey) Reset()         { *m = ValidatorBlsPubKey{} }
+func (m *ValidatorBlsPubKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBlsPubKey) ProtoMessage()    {}
+func (*ValidatorBlsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a505b479e81213bc, []int{2}
+}
+func (m *ValidatorBlsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBlsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBlsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBlsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBlsPubKey.Merge(m, src)
+}
+func (m *ValidatorBlsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBlsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBlsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBlsPubKey proto.InternalMessageInfo
+
+func (m *ValidatorBlsPubKey) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+func (m *ValidatorBlsPubKey) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Validator)(nil), "heimdallv2.stake.Validator")
 	proto.RegisterType((*ValidatorSet)(nil), "heimdallv2.stake.ValidatorSet")
+	proto.RegisterType((*ValidatorBlsPubKey)(nil), "heimdallv2.stake.ValidatorBlsPubKey")
 }
 
 func init() { proto.RegisterFile("heimdallv2/stake/validator.proto", fileDescriptor_a505b479e81213bc) }
 
 var fileDescriptor_a505b479e81213bc = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0xbf, 0x34, 0x6e, 0x72, 0xc9, 0xd0, 0x9e, 0x3a, 0xdc, 0xaf, 0xa5, 0x8e, 0x95,
-	0x01, 0x59, 0x48, 0xb5, 0x51, 0x2a, 0x16, 0x36, 0x22, 0x81, 0x40, 0x2c, 0x11, 0x15, 0x1d, 0x58,
-	0xac, 0x4b, 0xee, 0xe4, 0x1c, 0x75, 0x7c, 0xa7, 0xbb, 0x8b, 0x69, 0xde, 0x01, 0x23, 0x33, 0x53,
-	0x47, 0x46, 0x06, 0x5e, 0x44, 0xc7, 0x8a, 0x09, 0x16, 0x84, 0x92, 0x01, 0x5e, 0x06, 0xf2, 0xf9,
-	0x4f, 0x12, 0x26, 0x16, 0xcb, 0xf7, 0x7c, 0x3f, 0x8f, 0x9f, 0xe7, 0xf1, 0xf7, 0x39, 0xe8, 0xcd,
-	0x18, 0x9f, 0x53, 0x92, 0x24, 0xd9, 0x30, 0xd4, 0x86, 0x5c, 0xb1, 0x30, 0x23, 0x09, 0xa7, 0xc4,
-	0x08, 0x15, 0x48, 0x25, 0x8c, 0x40, 0x07, 0x1b, 0x22, 0xb0, 0xc4, 0xf1, 0x21, 0x99, 0xf3, 0x54,
-	0x84, 0xf6, 0x59, 0x40, 0xc7, 0xff, 0x4f, 0x85, 0x9e, 0x0b, 0x1d, 0xd9, 0x53, 0x58, 0x1c, 0x4a,
-	0xe9, 0x28, 0x16, 0xb1, 0x28, 0xe2, 0xf9, 0x5b, 0x11, 0x1d, 0x7c, 0x6c, 0xc2, 0xce, 0x65, 0x55,
-	0x09, 0xdd, 0x83, 0x4e, 0x46, 0x92, 0x88, 0x53, 0x0c, 0x3c, 0xe0, 0xef, 0x8d, 0x5a, 0x9f, 0x7e,
-	0x7d, 0x7e, 0x00, 0x5e, 0xb5, 0x32, 0x92, 0xbc, 0xa0, 0xe8, 0x3e, 0xec, 0x6a, 0x43, 0x94, 0x89,
-	0x98, 0x14, 0xd3, 0x19, 0xfe, 0x6f, 0x1b, 0x81, 0x56, 0x79, 0x9a, 0x0b, 0x68, 0x00, 0x3b, 0x2c,
-	0xa5, 0x25, 0xd5, 0xdc, 0xa6, 0xda, 0x2c, 0xa5, 0x05, 0x73, 0x02, 0x5b, 0xa9, 0x48, 0xa7, 0x0c,
-	0xef, 0xed, 0x14, 0xb2, 0x31, 0xe4, 0xc3, 0x5e, 0x26, 0x0c, 0x4f, 0xe3, 0x48, 0x8a, 0x77, 0x4c,
-	0xe1, 0x96, 0x07, 0xfc, 0x66, 0xc5, 0x74, 0x0b, 0x69, 0x9c, 0x2b, 0xc8, 0x85, 0xfb, 0x72, 0x31,
-	0x89, 0xae, 0xd8, 0x12, 0x3b, 0x1e, 0xf0, 0x7b, 0x15, 0xe4, 0xc8, 0xc5, 0xe4, 0x25, 0x5b, 0xa2,
-	0x47, 0xd0, 0xd1, 0x3c, 0x4e, 0x99, 0xc2, 0xfb, 0x1e, 0xf0, 0x3b, 0xa3, 0xd3, 0xaf, 0x5f, 0xce,
-	0x8e, 0xca, 0xdf, 0xf2, 0x84, 0x52, 0xc5, 0xb4, 0xbe, 0x30, 0x8a, 0xa7, 0x71, 0x99, 0x56, 0xc0,
-	0x79, 0x03, 0x09, 0xd1, 0x26, 0x5a, 0x48, 0x4a, 0x0c, 0xa3, 0xb8, 0x6d, 0x93, 0xab, 0x06, 0x72,
-	0xe9, 0x75, 0xa1, 0xa0, 0x53, 0xe8, 0xbc, 0x25, 0x3c, 0x61, 0x14, 0x77, 0x3c, 0xe0, 0xb7, 0xeb,
-	0xfa, 0x45, 0x10, 0x0d, 0xe1, 0xa1, 0x54, 0x42, 0x0a, 0xcd, 0x54, 0x24, 0x15, 0x17, 0x8a, 0x9b,
-	0x25, 0x86, 0xdb, 0xe3, 0x1c, 0x54, 0xfa, 0xb8, 0x94, 0x1f, 0xb7, 0xdf, 0xdf, 0xf4, 0xc1, 0xef,
-	0x9b, 0x3e, 0x18, 0x7c, 0x07, 0xb0, 0x57, 0x9b, 0x73, 0xc1, 0x0c, 0x7a, 0x06, 0x61, 0xbd, 0x16,
-	0x1a, 0x03, 0xaf, 0xe9, 0x77, 0x87, 0x27, 0xc1, 0xdf, 0x8b, 0x11, 0xd4, 0x39, 0xa3, 0xce, 0xed,
-	0x8f, 0x3e, 0x28, 0x1d, 0xda, 0x64, 0xa2, 0x11, 0x6c, 0x57, 0x65, 0xad, 0x8d, 0xff, 0xfe, 0x95,
-	0x3a, 0x0f, 0x9d, 0x43, 0x64, 0x84, 0x21, 0x49, 0xb4, 0x63, 0x55, 0x73, 0x67, 0x36, 0x0b, 0x5c,
-	0x6e, 0xfc, 0xb2, 0xb3, 0x35, 0xf2, 0xd9, 0x46, 0xcf, 0x6f, 0x57, 0x2e, 0xb8, 0x5b, 0xb9, 0xe0,
-	0xe7, 0xca, 0x05, 0x1f, 0xd6, 0x6e, 0xe3, 0x6e, 0xed, 0x36, 0xbe, 0xad, 0xdd, 0xc6, 0x9b, 0x20,
-	0xe6, 0x66, 0xb6, 0x98, 0x04, 0x53, 0x31, 0x0f, 0x1f, 0x5e, 0x8f, 0x45, 0xb2, 0x8c, 0x45, 0x1a,
-	0x56, 0xed, 0x9d, 0x65, 0xc3, 0xf0, 0xba, 0xbc, 0x22, 0x66, 0x29, 0x99, 0x9e, 0x38, 0x76, 0x93,
-	0xcf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xfa, 0xd7, 0x61, 0x43, 0x03, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0x13, 0x3f,
+	0x18, 0xc7, 0xe3, 0xa6, 0xb9, 0xe6, 0x9c, 0x0c, 0xad, 0xd5, 0xe1, 0x7e, 0xed, 0xaf, 0x97, 0x53,
+	0x24, 0xd0, 0x09, 0xa9, 0x77, 0x28, 0x15, 0x0b, 0x1b, 0x91, 0x40, 0x20, 0x96, 0x88, 0x8a, 0x4a,
+	0xb0, 0x9c, 0x7c, 0xb1, 0x75, 0x31, 0x75, 0xce, 0x27, 0xdb, 0x39, 0x9a, 0x77, 0xc0, 0xc8, 0xcc,
+	0xd4, 0x91, 0x91, 0x81, 0x17, 0xd1, 0xb1, 0x62, 0x82, 0x05, 0xa1, 0x64, 0x80, 0x97, 0x81, 0xe2,
+	0xfb, 0x93, 0x84, 0x01, 0xb1, 0x9c, 0xce, 0xcf, 0xf7, 0xf3, 0xf8, 0xf1, 0xe3, 0xef, 0x63, 0xe8,
+	0x4d, 0x28, 0x9b, 0x12, 0xcc, 0x79, 0x3e, 0x08, 0x95, 0xc6, 0x97, 0x34, 0xcc, 0x31, 0x67, 0x04,
+	0x6b, 0x21, 0x83, 0x4c, 0x0a, 0x2d, 0xd0, 0xfe, 0x9a, 0x08, 0x0c, 0x71, 0x74, 0x80, 0xa7, 0x2c,
+	0x15, 0xa1, 0xf9, 0x16, 0xd0, 0xd1, 0x7f, 0x63, 0xa1, 0xa6, 0x42, 0x45, 0x66, 0x15, 0x16, 0x8b,
+	0x52, 0x3a, 0x4c, 0x44, 0x22, 0x8a, 0xf8, 0xea, 0xaf, 0x88, 0xf6, 0x3f, 0x34, 0xa1, 0x7d, 0x51,
+	0x55, 0x42, 0xff, 0x43, 0x2b, 0xc7, 0x3c, 0x62, 0xc4, 0x01, 0x1e, 0xf0, 0x77, 0x87, 0xad, 0x8f,
+	0x3f, 0x3f, 0xdd, 0x03, 0x2f, 0x5a, 0x39, 0xe6, 0xcf, 0x08, 0xba, 0x0b, 0x3b, 0x4a, 0x63, 0xa9,
+	0x23, 0x9a, 0x89, 0xf1, 0xc4, 0xd9, 0xd9, 0x44, 0xa0, 0x51, 0x1e, 0xaf, 0x04, 0xd4, 0x87, 0x36,
+	0x4d, 0x49, 0x49, 0x35, 0x37, 0xa9, 0x36, 0x4d, 0x49, 0xc1, 0x1c, 0xc3, 0x56, 0x2a, 0xd2, 0x31,
+	0x75, 0x76, 0xb7, 0x0a, 0x99, 0x18, 0xf2, 0x61, 0x37, 0x17, 0x9a, 0xa5, 0x49, 0x94, 0x89, 0xb7,
+	0x54, 0x3a, 0x2d, 0x0f, 0xf8, 0xcd, 0x8a, 0xe9, 0x14, 0xd2, 0x68, 0xa5, 0x20, 0x17, 0xee, 0x65,
+	0xb3, 0x38, 0xba, 0xa4, 0x73, 0xc7, 0xf2, 0x80, 0xdf, 0xad, 0x20, 0x2b, 0x9b, 0xc5, 0xcf, 0xe9,
+	0x1c, 0x3d, 0x80, 0x96, 0x62, 0x49, 0x4a, 0xa5, 0xb3, 0xe7, 0x01, 0xdf, 0x1e, 0x9e, 0x7c, 0xf9,
+	0x7c, 0x7a, 0x58, 0x5e, 0xcb, 0x23, 0x42, 0x24, 0x55, 0xea, 0x5c, 0x4b, 0x96, 0x26, 0x65, 0x5a,
+	0x01, 0xaf, 0x0e, 0xc0, 0xb1, 0xd2, 0xd1, 0x2c, 0x23, 0x58, 0x53, 0xe2, 0xb4, 0x4d, 0x72, 0x75,
+	0x80, 0x95, 0xf4, 0xb2, 0x50, 0xd0, 0x09, 0xb4, 0xde, 0x60, 0xc6, 0x29, 0x71, 0x6c, 0x0f, 0xf8,
+	0xed, 0xba, 0x7e, 0x11, 0x44, 0x03, 0x78, 0x90, 0x49, 0x91, 0x09, 0x45, 0x65, 0x94, 0x49, 0x26,
+	0x24, 0xd3, 0x73, 0x07, 0x6e, 0xb6, 0xb3, 0x5f, 0xe9, 0xa3, 0x52, 0x7e, 0xd8, 0x7e, 0x77, 0xdd,
+	0x03, 0xbf, 0xae, 0x7b, 0xa0, 0xff, 0x0d, 0xc0, 0x6e, 0x6d, 0xce, 0x39, 0xd5, 0xe8, 0x09, 0x84,
+	0xf5, 0x58, 0x28, 0x07, 0x78, 0x4d, 0xbf, 0x33, 0x38, 0x0e, 0xfe, 0x1c, 0x8c, 0xa0, 0xce, 0x19,
+	0xda, 0x37, 0xdf, 0x7b, 0xa0, 0x74, 0x68, 0x9d, 0x89, 0x86, 0xb0, 0x5d, 0x95, 0x35, 0x36, 0xfe,
+	0xfb, 0x2e, 0x75, 0x1e, 0x3a, 0x83, 0x48, 0x0b, 0x8d, 0x79, 0xb4, 0x65, 0x55, 0x73, 0xab, 0x37,
+	0x03, 0x5c, 0xac, 0xfd, 0x32, 0xbd, 0x35, 0x4c, 0x6f, 0xaf, 0x20, 0x5a, 0x17, 0xe0, 0x6a, 0x54,
+	0xf8, 0xf5, 0xf7, 0x01, 0xbc, 0x03, 0x3b, 0x31, 0x57, 0x51, 0xe5, 0xf8, 0xce, 0xa6, 0xe3, 0x76,
+	0x5c, 0x6d, 0x32, 0x7c, 0x7a, 0xb3, 0x70, 0xc1, 0xed, 0xc2, 0x05, 0x3f, 0x16, 0x2e, 0x78, 0xbf,
+	0x74, 0x1b, 0xb7, 0x4b, 0xb7, 0xf1, 0x75, 0xe9, 0x36, 0x5e, 0x07, 0x09, 0xd3, 0x93, 0x59, 0x1c,
+	0x8c, 0xc5, 0x34, 0xbc, 0x7f, 0x35, 0x12, 0x7c, 0x9e, 0x88, 0x34, 0xac, 0x3a, 0x3f, 0xcd, 0x07,
+	0xe1, 0x55, 0xf9, 0xfa, 0xf4, 0x3c, 0xa3, 0x2a, 0xb6, 0xcc, 0x23, 0x39, 0xfb, 0x3d, 0x00, 0x25,
+	0x23, 0x63, 0xf5, 0x9e, 0x03, 0x00, 0x00,
 }
 
 func (this *Validator) Equal(that interface{}) bool {
@@ -467,6 +524,41 @@ func (m *ValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorBlsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBlsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBlsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValId != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValidator(v)
 	base := offset
@@ -542,6 +634,22 @@ func (m *ValidatorSet) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorBlsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovValidator(uint64(m.ValId))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	return n
+}
+
 func sovValidator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -969,6 +1077,109 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorBlsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBlsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBlsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipValidator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
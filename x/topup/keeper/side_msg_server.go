@@ -110,10 +110,11 @@ func (s sideMsgServer) SideHandleTopupTx(ctx sdk.Context, msgI sdk.Msg) sidetxs.
 		ctx,
 		s.k.contractCaller,
 		helper.ReceiptValidationParams{
-			TxHash:         msg.TxHash,
-			MsgBlockNumber: msg.BlockNumber,
-			Confirmations:  params.MainChainTxConfirmations,
-			ModuleName:     "topup",
+			TxHash:           msg.TxHash,
+			MsgBlockNumber:   msg.BlockNumber,
+			Confirmations:    params.MainChainTxConfirmations,
+			ConfirmationMode: helper.ConfirmationMode(params.MainChainConfirmationMode),
+			ModuleName:       "topup",
 		},
 		logger,
 	)
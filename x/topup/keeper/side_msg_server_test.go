@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/helper"
 	"github.com/0xPolygon/heimdall-v2/sidetxs"
 	chainmanagertypes "github.com/0xPolygon/heimdall-v2/x/chainmanager/types"
 	"github.com/0xPolygon/heimdall-v2/x/topup/testutil"
@@ -79,7 +80,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, mock.Anything, mock.Anything).Return(event, nil)
 
 		res := sideHandler(ctx, &msg)
@@ -107,7 +108,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			logIndex,
 			blockNumber,
 		)
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations).Return(nil, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations, helper.ConfirmationModeDefault).Return(nil, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", chainmanagertypes.DefaultParams().ChainParams.StateSenderAddress, nil, logIndex).Return(nil, nil)
 
 		res := sideHandler(ctx, &msg)
@@ -133,7 +134,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			logIndex,
 			blockNumber,
 		)
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations, helper.ConfirmationModeDefault).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", chainmanagertypes.DefaultParams().ChainParams.StateSenderAddress, txReceipt, logIndex).Return(nil, nil)
 
 		res := sideHandler(ctx, &msg)
@@ -165,7 +166,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations, helper.ConfirmationModeDefault).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", chainmanagertypes.DefaultParams().ChainParams.StateSenderAddress, txReceipt, logIndex).Return(event, nil)
 
 		res := sideHandler(ctx, &msg)
@@ -197,7 +198,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations, helper.ConfirmationModeDefault).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", chainmanagertypes.DefaultParams().ChainParams.StateSenderAddress, txReceipt, logIndex).Return(event, nil)
 
 		res := sideHandler(ctx, &msg)
@@ -230,7 +231,7 @@ func (s *KeeperTestSuite) TestSideHandleTopupTx() {
 			Fee:  new(big.Int).SetUint64(1),
 		}
 
-		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations).Return(txReceipt, nil)
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, hash, chainmanagertypes.DefaultParams().MainChainTxConfirmations, helper.ConfirmationModeDefault).Return(txReceipt, nil)
 		contractCaller.On("DecodeValidatorTopupFeesEvent", chainmanagertypes.DefaultParams().ChainParams.StateSenderAddress, txReceipt, logIndex).Return(event, nil)
 
 		res := sideHandler(ctx, &msg)
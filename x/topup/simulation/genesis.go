@@ -9,7 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
-	"github.com/0xPolygon/heimdall-v2/helper"
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
 	"github.com/0xPolygon/heimdall-v2/types"
 	topupTypes "github.com/0xPolygon/heimdall-v2/x/topup/types"
 )
@@ -21,15 +21,10 @@ func genSequenceNumber(r *rand.Rand) string {
 	return strconv.Itoa(simulation.RandIntBetween(r, 0, math.MaxInt32))
 }
 
-// RandomizeGenState returns a simulated topup genesis
+// RandomizeGenState returns a simulated topup genesis, with the dividend accounts of the first bonded
+// simulation accounts, which are the validators of the stake genesis
 func RandomizeGenState(simState *module.SimulationState) {
-	minAccounts := int64(1)
-	maxAccounts := int64(50)
-	numAccounts, err := helper.SecureRandomInt(minAccounts, maxAccounts)
-	if err != nil {
-		panic(err)
-	}
-	accounts := simulation.RandomAccounts(simState.Rand, int(numAccounts))
+	numAccounts := min(int(simState.NumBonded), len(simState.Accounts))
 
 	var (
 		sequences        = make([]string, numAccounts)
@@ -37,7 +32,7 @@ func RandomizeGenState(simState *module.SimulationState) {
 		sequenceNumber   string
 	)
 
-	for i := 0; i < int(numAccounts); i++ {
+	for i := 0; i < numAccounts; i++ {
 
 		simState.AppParams.GetOrGenerate(SequenceNumber, &sequenceNumber, simState.Rand, func(r *rand.Rand) {
 			sequenceNumber = genSequenceNumber(r)
@@ -47,7 +42,7 @@ func RandomizeGenState(simState *module.SimulationState) {
 
 		// create the dividend account for validator
 		dividendAccounts[i] = types.DividendAccount{
-			User:      accounts[i].Address.String(),
+			User:      util.FormatAddress(simState.Accounts[i].Address.String()),
 			FeeAmount: big.NewInt(0).String(),
 		}
 	}
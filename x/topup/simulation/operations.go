@@ -0,0 +1,112 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	util "github.com/0xPolygon/heimdall-v2/common/hex"
+	"github.com/0xPolygon/heimdall-v2/contracts/stakinginfo"
+	"github.com/0xPolygon/heimdall-v2/helper"
+	"github.com/0xPolygon/heimdall-v2/helper/mocks"
+	hmSimulation "github.com/0xPolygon/heimdall-v2/types/simulation"
+	"github.com/0xPolygon/heimdall-v2/x/topup/keeper"
+	"github.com/0xPolygon/heimdall-v2/x/topup/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgTopupTx       = "op_weight_msg_topup_tx"
+	OpWeightMsgWithdrawFeeTx = "op_weight_msg_withdraw_fee_tx"
+
+	DefaultWeightMsgTopupTx       = 50
+	DefaultWeightMsgWithdrawFeeTx = 20
+)
+
+// WeightedOperations returns all the operations from the topup module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper) []hmSimulation.WeightedOperation {
+	var (
+		weightMsgTopupTx       int
+		weightMsgWithdrawFeeTx int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgTopupTx, &weightMsgTopupTx, nil, func(_ *rand.Rand) {
+		weightMsgTopupTx = DefaultWeightMsgTopupTx
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgWithdrawFeeTx, &weightMsgWithdrawFeeTx, nil, func(_ *rand.Rand) {
+		weightMsgWithdrawFeeTx = DefaultWeightMsgWithdrawFeeTx
+	})
+
+	return []hmSimulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgTopupTx, SimulateMsgTopupTx()),
+		hmSimulation.NewWeightedOperation(weightMsgWithdrawFeeTx, SimulateMsgWithdrawFeeTx(k)),
+	}
+}
+
+// SimulateMsgTopupTx generates a MsgTopupTx topping up a random simulation account
+func SimulateMsgTopupTx() hmSimulation.Operation {
+	return func(r *rand.Rand, _ sdk.Context, accs []simtypes.Account, contractCaller *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		proposer, _ := simtypes.RandomAcc(r, accs)
+		user, _ := simtypes.RandomAcc(r, accs)
+
+		defaultFee := ante.DefaultFeeWantedPerTx[0].Amount
+		fee := defaultFee.Add(simtypes.RandomAmount(r, defaultFee.MulRaw(1000)))
+		txHash, blockNumber, logIndex := hmSimulation.RandomMainChainEvent(r)
+
+		msg := types.NewMsgTopupTx(proposer.Address.String(), util.FormatAddress(user.Address.String()), fee, txHash.Bytes(), logIndex, blockNumber)
+
+		receipt := hmSimulation.MockTxReceipt(contractCaller, txHash, blockNumber)
+		contractCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, receipt, logIndex).Return(&stakinginfo.StakinginfoTopUpFee{
+			User: common.BytesToAddress(user.Address),
+			Fee:  fee.BigInt(),
+		}, nil)
+
+		return hmSimulation.NewOperationMsg(msg, proposer, ""), nil
+	}
+}
+
+// SimulateMsgWithdrawFeeTx generates a MsgWithdrawFeeTx withdrawing part of the balance of a random account.
+// Once the withdrawals are restricted to the validators, the account is picked among them.
+// The full withdrawals aren't simulated, as they would leave the account unable to pay for its next txs.
+func SimulateMsgWithdrawFeeTx(k *keeper.Keeper) hmSimulation.Operation {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, _ *mocks.IContractCaller) (hmSimulation.OperationMsg, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgWithdrawFeeTx{})
+
+		candidates := accs
+		if helper.IsFeeWithdrawValidatorGate(ctx.BlockHeight() + 1) {
+			candidates = make([]simtypes.Account, 0, len(accs))
+			for _, acc := range accs {
+				if ok, err := k.StakeKeeper.IsCurrentValidatorByAddress(ctx, util.FormatAddress(acc.Address.String())); err == nil && ok {
+					candidates = append(candidates, acc)
+				}
+			}
+		}
+
+		if len(candidates) == 0 {
+			return hmSimulation.NoOpMsg(msgType, "no account allowed to withdraw"), nil
+		}
+
+		proposer, _ := simtypes.RandomAcc(r, candidates)
+
+		// keep the fee of the withdrawal tx in the account
+		spendable := k.BankKeeper.SpendableCoin(ctx, proposer.Address, sdk.DefaultBondDenom).Amount.Sub(ante.DefaultFeeWantedPerTx[0].Amount)
+		if !spendable.IsPositive() {
+			return hmSimulation.NoOpMsg(msgType, "balance too low"), nil
+		}
+
+		amount := simtypes.RandomAmount(r, spendable)
+		if amount.IsZero() {
+			amount = sdkmath.OneInt()
+		}
+
+		msg := types.NewMsgWithdrawFeeTx(proposer.Address.String(), amount)
+
+		return hmSimulation.NewOperationMsg(msg, proposer, ""), nil
+	}
+}
@@ -0,0 +1,97 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PreBlocker applies the upgrade plan due at the current height. Without a handler for the plan, the binary is
+// out of date: the plan is written to disk for the store loader of the next binary, and the node halts by
+// returning an error. With a handler scheduled for a future plan, the binary is too recent and the node halts too.
+// The plans at the heights skipped by the node operator are cleared instead.
+func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlock, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil && !errors.Is(err, types.ErrNoUpgradePlanFound) {
+		return nil, err
+	}
+	found := err == nil
+
+	// check once that the binary handles the last applied upgrade, unless an upgrade is applied now
+	if !k.DowngradeVerified() {
+		k.SetDowngradeVerified(true)
+
+		if !found || !plan.ShouldExecute(height) || k.IsSkipHeight(height) {
+			lastAppliedPlan, _, err := k.GetLastCompletedUpgrade(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if lastAppliedPlan != "" && !k.HasHandler(lastAppliedPlan) {
+				return nil, fmt.Errorf("wrong binary, the handler of the applied upgrade %s is missing", lastAppliedPlan)
+			}
+		}
+	}
+
+	if !found {
+		return &sdk.ResponsePreBlock{}, nil
+	}
+
+	logger := k.Logger(ctx)
+
+	if !plan.ShouldExecute(height) {
+		if k.HasHandler(plan.Name) {
+			msg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE %q - in binary but not executed on chain. Downgrade your binary", plan.Name)
+			logger.Error(msg)
+
+			return nil, errors.New(msg)
+		}
+
+		return &sdk.ResponsePreBlock{}, nil
+	}
+
+	if k.IsSkipHeight(height) {
+		logger.Info(fmt.Sprintf("UPGRADE %q SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info))
+
+		if err := k.ClearUpgradePlan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &sdk.ResponsePreBlock{}, nil
+	}
+
+	if !k.HasHandler(plan.Name) {
+		if err := k.DumpUpgradeInfoToDisk(height, plan); err != nil {
+			return nil, fmt.Errorf("unable to write upgrade info to filesystem: %w", err)
+		}
+
+		msg := BuildUpgradeNeededMsg(plan)
+		logger.Error(msg)
+
+		return nil, errors.New(msg)
+	}
+
+	logger.Info(fmt.Sprintf("applying upgrade %q at %s", plan.Name, plan.DueAt()))
+
+	sdkCtx = sdkCtx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := k.ApplyUpgrade(sdkCtx, plan); err != nil {
+		return nil, err
+	}
+
+	// the upgrade handler may change the consensus params
+	return &sdk.ResponsePreBlock{ConsensusParamsChanged: true}, nil
+}
+
+// BuildUpgradeNeededMsg returns the message of the nodes halting at an upgrade they have no handler for.
+func BuildUpgradeNeededMsg(plan types.Plan) string {
+	return fmt.Sprintf("UPGRADE %q NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
+}
@@ -0,0 +1,155 @@
+package upgrade_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/heimdall-v2/x/upgrade"
+)
+
+const (
+	testUpgradeName   = "test-upgrade"
+	testUpgradeHeight = 10
+)
+
+type testEnv struct {
+	ctx     sdk.Context
+	service corestore.KVStoreService
+	homeDir string
+}
+
+func newTestEnv(t *testing.T) testEnv {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	return testEnv{
+		ctx:     testCtx.Ctx,
+		service: runtime.NewKVStoreService(key),
+		homeDir: t.TempDir(),
+	}
+}
+
+// newKeeper returns an upgrade keeper on the store of the env, as the one of a restarted node.
+func (env testEnv) newKeeper(skipHeights ...int64) *keeper.Keeper {
+	skipUpgradeHeights := make(map[int64]bool)
+	for _, height := range skipHeights {
+		skipUpgradeHeights[height] = true
+	}
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	return keeper.NewKeeper(skipUpgradeHeights, env.service, encCfg.Codec, env.homeDir, nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+}
+
+func (env testEnv) ctxAt(height int64) sdk.Context {
+	return env.ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height})
+}
+
+func (env testEnv) schedulePlan(t *testing.T, k *keeper.Keeper) {
+	t.Helper()
+
+	plan := types.Plan{Name: testUpgradeName, Height: testUpgradeHeight, Info: "binary v2"}
+	require.NoError(t, k.ScheduleUpgrade(env.ctxAt(1), plan))
+}
+
+func noopHandler(_ context.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	return fromVM, nil
+}
+
+func TestPreBlockerWithoutPlan(t *testing.T) {
+	env := newTestEnv(t)
+
+	rsp, err := upgrade.PreBlocker(env.ctxAt(5), env.newKeeper())
+	require.NoError(t, err)
+	require.False(t, rsp.IsConsensusParamsChanged())
+}
+
+func TestPreBlockerHaltsWithoutHandler(t *testing.T) {
+	env := newTestEnv(t)
+	k := env.newKeeper()
+	env.schedulePlan(t, k)
+
+	_, err := upgrade.PreBlocker(env.ctxAt(testUpgradeHeight-1), k)
+	require.NoError(t, err)
+
+	_, err = upgrade.PreBlocker(env.ctxAt(testUpgradeHeight), k)
+	require.ErrorContains(t, err, `UPGRADE "test-upgrade" NEEDED at height: 10: binary v2`)
+
+	// the store loader of the next binary reads the plan
+	upgradeInfo, err := k.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, testUpgradeName, upgradeInfo.Name)
+	require.Equal(t, int64(testUpgradeHeight), upgradeInfo.Height)
+}
+
+func TestPreBlockerAppliesUpgrade(t *testing.T) {
+	env := newTestEnv(t)
+	k := env.newKeeper()
+	env.schedulePlan(t, k)
+
+	called := false
+	k.SetUpgradeHandler(testUpgradeName, func(ctx context.Context, plan types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		called = true
+		return module.VersionMap{"stake": 2}, nil
+	})
+
+	rsp, err := upgrade.PreBlocker(env.ctxAt(testUpgradeHeight), k)
+	require.NoError(t, err)
+	require.True(t, rsp.IsConsensusParamsChanged())
+	require.True(t, called)
+
+	_, err = k.GetUpgradePlan(env.ctx)
+	require.ErrorIs(t, err, types.ErrNoUpgradePlanFound)
+
+	doneHeight, err := k.GetDoneHeight(env.ctx, testUpgradeName)
+	require.NoError(t, err)
+	require.Equal(t, int64(testUpgradeHeight), doneHeight)
+
+	versionMap, err := k.GetModuleVersionMap(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"stake": 2}, versionMap)
+
+	// a node restarted with a binary not handling the applied upgrade halts
+	_, err = upgrade.PreBlocker(env.ctxAt(testUpgradeHeight+1), env.newKeeper())
+	require.ErrorContains(t, err, "the handler of the applied upgrade test-upgrade is missing")
+}
+
+func TestPreBlockerHandlerBeforeTrigger(t *testing.T) {
+	env := newTestEnv(t)
+	k := env.newKeeper()
+	env.schedulePlan(t, k)
+	k.SetUpgradeHandler(testUpgradeName, noopHandler)
+
+	_, err := upgrade.PreBlocker(env.ctxAt(testUpgradeHeight-1), k)
+	require.ErrorContains(t, err, "BINARY UPDATED BEFORE TRIGGER")
+}
+
+func TestPreBlockerSkipsUpgrade(t *testing.T) {
+	env := newTestEnv(t)
+	k := env.newKeeper(testUpgradeHeight)
+	env.schedulePlan(t, k)
+
+	rsp, err := upgrade.PreBlocker(env.ctxAt(testUpgradeHeight), k)
+	require.NoError(t, err)
+	require.False(t, rsp.IsConsensusParamsChanged())
+
+	_, err = k.GetUpgradePlan(env.ctx)
+	require.ErrorIs(t, err, types.ErrNoUpgradePlanFound)
+}
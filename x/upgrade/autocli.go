@@ -0,0 +1,59 @@
+package upgrade
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	upgradev1beta1 "cosmossdk.io/api/cosmos/upgrade/v1beta1"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: upgradev1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CurrentPlan",
+					Use:       "plan",
+					Short:     "Query the upgrade plan, if one is scheduled",
+				},
+				{
+					RpcMethod: "AppliedPlan",
+					Use:       "applied [upgrade-name]",
+					Short:     "Query the height at which an upgrade was applied",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "ModuleVersions",
+					Use:       "module-versions [module-name]",
+					Short:     "Query the consensus versions of the modules",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "module_name", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "Authority",
+					Use:       "authority",
+					Short:     "Query the upgrade authority address",
+				},
+				{
+					RpcMethod: "UpgradedConsensusState",
+					Skip:      true, // deprecated
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: upgradev1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SoftwareUpgrade",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CancelUpgrade",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
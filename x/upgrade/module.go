@@ -0,0 +1,128 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/upgrade/client/cli"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+)
+
+// ConsensusVersion defines the current x/upgrade module consensus version, the one of the cosmos-sdk upgrade store.
+const ConsensusVersion = 2
+
+var (
+	_ module.HasGenesis     = AppModule{}
+	_ module.HasServices    = AppModule{}
+	_ module.AppModuleBasic = AppModule{}
+
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasPreBlocker = AppModule{}
+)
+
+// Name returns the upgrade module's name.
+func (AppModule) Name() string { return types.ModuleName }
+
+// RegisterLegacyAminoCodec registers the upgrade module's types on the LegacyAmino codec.
+func (AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// DefaultGenesis returns an empty genesis state, as the upgrade plans aren't exported.
+func (AppModule) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
+	return []byte("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the upgrade module, which has no genesis state.
+func (AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, _ json.RawMessage) error {
+	return nil
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the upgrade module.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterInterfaces registers interfaces and implementations of the upgrade module.
+func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// GetTxCmd returns the commands submitting the governance proposals of software upgrades.
+func (am AppModule) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd(am.addressCodec)
+}
+
+// AppModule implements an application module for the upgrade module, wiring the cosmos-sdk upgrade keeper.
+type AppModule struct {
+	keeper       *keeper.Keeper
+	addressCodec address.Codec
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am AppModule) IsAppModule() {}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper *keeper.Keeper, addressCodec address.Codec) AppModule {
+	return AppModule{
+		keeper:       keeper,
+		addressCodec: addressCodec,
+	}
+}
+
+// QuerierRoute returns the upgrade module's querier route name.
+func (AppModule) QuerierRoute() string { return types.RouterKey }
+
+// InitGenesis records the module versions of the chain set by the app, from which the first upgrade migrates.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) {
+	versionMap := am.keeper.GetInitVersionMap()
+	if versionMap == nil {
+		return
+	}
+
+	if err := am.keeper.SetModuleVersionMap(ctx, versionMap); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the empty genesis state of the upgrade module.
+func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return am.DefaultGenesis(cdc)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// PreBlock applies the upgrade plan due at the current height, before anything else in the block.
+func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, error) {
+	return PreBlocker(ctx, am.keeper)
+}